@@ -2,7 +2,7 @@
 // versions:
 // 	protoc-gen-go v1.36.11
 // 	protoc        v3.21.12
-// source: catalog/v1/catalog.proto
+// source: api/proto/catalog/v1/catalog.proto
 
 package v1
 
@@ -57,11 +57,11 @@ func (x StationStatus) String() string {
 }
 
 func (StationStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_catalog_v1_catalog_proto_enumTypes[0].Descriptor()
+	return file_api_proto_catalog_v1_catalog_proto_enumTypes[0].Descriptor()
 }
 
 func (StationStatus) Type() protoreflect.EnumType {
-	return &file_catalog_v1_catalog_proto_enumTypes[0]
+	return &file_api_proto_catalog_v1_catalog_proto_enumTypes[0]
 }
 
 func (x StationStatus) Number() protoreflect.EnumNumber {
@@ -70,7 +70,7 @@ func (x StationStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StationStatus.Descriptor instead.
 func (StationStatus) EnumDescriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{0}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{0}
 }
 
 type RouteStatus int32
@@ -109,11 +109,11 @@ func (x RouteStatus) String() string {
 }
 
 func (RouteStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_catalog_v1_catalog_proto_enumTypes[1].Descriptor()
+	return file_api_proto_catalog_v1_catalog_proto_enumTypes[1].Descriptor()
 }
 
 func (RouteStatus) Type() protoreflect.EnumType {
-	return &file_catalog_v1_catalog_proto_enumTypes[1]
+	return &file_api_proto_catalog_v1_catalog_proto_enumTypes[1]
 }
 
 func (x RouteStatus) Number() protoreflect.EnumNumber {
@@ -122,7 +122,7 @@ func (x RouteStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RouteStatus.Descriptor instead.
 func (RouteStatus) EnumDescriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{1}
 }
 
 type TripStatus int32
@@ -173,11 +173,11 @@ func (x TripStatus) String() string {
 }
 
 func (TripStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_catalog_v1_catalog_proto_enumTypes[2].Descriptor()
+	return file_api_proto_catalog_v1_catalog_proto_enumTypes[2].Descriptor()
 }
 
 func (TripStatus) Type() protoreflect.EnumType {
-	return &file_catalog_v1_catalog_proto_enumTypes[2]
+	return &file_api_proto_catalog_v1_catalog_proto_enumTypes[2]
 }
 
 func (x TripStatus) Number() protoreflect.EnumNumber {
@@ -186,7 +186,7 @@ func (x TripStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TripStatus.Descriptor instead.
 func (TripStatus) EnumDescriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{2}
 }
 
 type SortOrder int32
@@ -231,11 +231,11 @@ func (x SortOrder) String() string {
 }
 
 func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
-	return file_catalog_v1_catalog_proto_enumTypes[3].Descriptor()
+	return file_api_proto_catalog_v1_catalog_proto_enumTypes[3].Descriptor()
 }
 
 func (SortOrder) Type() protoreflect.EnumType {
-	return &file_catalog_v1_catalog_proto_enumTypes[3]
+	return &file_api_proto_catalog_v1_catalog_proto_enumTypes[3]
 }
 
 func (x SortOrder) Number() protoreflect.EnumNumber {
@@ -244,7 +244,7 @@ func (x SortOrder) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortOrder.Descriptor instead.
 func (SortOrder) EnumDescriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{3}
 }
 
 type ScheduleStatus int32
@@ -280,11 +280,11 @@ func (x ScheduleStatus) String() string {
 }
 
 func (ScheduleStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_catalog_v1_catalog_proto_enumTypes[4].Descriptor()
+	return file_api_proto_catalog_v1_catalog_proto_enumTypes[4].Descriptor()
 }
 
 func (ScheduleStatus) Type() protoreflect.EnumType {
-	return &file_catalog_v1_catalog_proto_enumTypes[4]
+	return &file_api_proto_catalog_v1_catalog_proto_enumTypes[4]
 }
 
 func (x ScheduleStatus) Number() protoreflect.EnumNumber {
@@ -293,7 +293,7 @@ func (x ScheduleStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ScheduleStatus.Descriptor instead.
 func (ScheduleStatus) EnumDescriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{4}
 }
 
 type Station struct {
@@ -319,7 +319,7 @@ type Station struct {
 
 func (x *Station) Reset() {
 	*x = Station{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[0]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -331,7 +331,7 @@ func (x *Station) String() string {
 func (*Station) ProtoMessage() {}
 
 func (x *Station) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[0]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +344,7 @@ func (x *Station) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Station.ProtoReflect.Descriptor instead.
 func (*Station) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{0}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *Station) GetId() string {
@@ -471,7 +471,7 @@ type CreateStationRequest struct {
 
 func (x *CreateStationRequest) Reset() {
 	*x = CreateStationRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[1]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -483,7 +483,7 @@ func (x *CreateStationRequest) String() string {
 func (*CreateStationRequest) ProtoMessage() {}
 
 func (x *CreateStationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[1]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +496,7 @@ func (x *CreateStationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateStationRequest.ProtoReflect.Descriptor instead.
 func (*CreateStationRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *CreateStationRequest) GetOrganizationId() string {
@@ -586,7 +586,7 @@ type GetStationRequest struct {
 
 func (x *GetStationRequest) Reset() {
 	*x = GetStationRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[2]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -598,7 +598,7 @@ func (x *GetStationRequest) String() string {
 func (*GetStationRequest) ProtoMessage() {}
 
 func (x *GetStationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[2]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +611,7 @@ func (x *GetStationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStationRequest.ProtoReflect.Descriptor instead.
 func (*GetStationRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetStationRequest) GetId() string {
@@ -641,7 +641,7 @@ type ListStationsRequest struct {
 
 func (x *ListStationsRequest) Reset() {
 	*x = ListStationsRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[3]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -653,7 +653,7 @@ func (x *ListStationsRequest) String() string {
 func (*ListStationsRequest) ProtoMessage() {}
 
 func (x *ListStationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[3]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,7 +666,7 @@ func (x *ListStationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStationsRequest.ProtoReflect.Descriptor instead.
 func (*ListStationsRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListStationsRequest) GetOrganizationId() string {
@@ -715,7 +715,7 @@ type ListStationsResponse struct {
 
 func (x *ListStationsResponse) Reset() {
 	*x = ListStationsResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[4]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -727,7 +727,7 @@ func (x *ListStationsResponse) String() string {
 func (*ListStationsResponse) ProtoMessage() {}
 
 func (x *ListStationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[4]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,7 +740,7 @@ func (x *ListStationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStationsResponse.ProtoReflect.Descriptor instead.
 func (*ListStationsResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListStationsResponse) GetStations() []*Station {
@@ -778,7 +778,7 @@ type UpdateStationRequest struct {
 
 func (x *UpdateStationRequest) Reset() {
 	*x = UpdateStationRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[5]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -790,7 +790,7 @@ func (x *UpdateStationRequest) String() string {
 func (*UpdateStationRequest) ProtoMessage() {}
 
 func (x *UpdateStationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[5]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +803,7 @@ func (x *UpdateStationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateStationRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateStationRequest) GetId() string {
@@ -858,7 +858,7 @@ type DeleteStationRequest struct {
 
 func (x *DeleteStationRequest) Reset() {
 	*x = DeleteStationRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[6]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -870,7 +870,7 @@ func (x *DeleteStationRequest) String() string {
 func (*DeleteStationRequest) ProtoMessage() {}
 
 func (x *DeleteStationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[6]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,7 +883,7 @@ func (x *DeleteStationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteStationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteStationRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteStationRequest) GetId() string {
@@ -909,7 +909,7 @@ type DeleteStationResponse struct {
 
 func (x *DeleteStationResponse) Reset() {
 	*x = DeleteStationResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[7]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +921,7 @@ func (x *DeleteStationResponse) String() string {
 func (*DeleteStationResponse) ProtoMessage() {}
 
 func (x *DeleteStationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[7]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +934,7 @@ func (x *DeleteStationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteStationResponse.ProtoReflect.Descriptor instead.
 func (*DeleteStationResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteStationResponse) GetSuccess() bool {
@@ -966,7 +966,7 @@ type Route struct {
 
 func (x *Route) Reset() {
 	*x = Route{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[8]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -978,7 +978,7 @@ func (x *Route) String() string {
 func (*Route) ProtoMessage() {}
 
 func (x *Route) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[8]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -991,7 +991,7 @@ func (x *Route) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Route.ProtoReflect.Descriptor instead.
 func (*Route) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Route) GetId() string {
@@ -1105,7 +1105,7 @@ type RouteStop struct {
 
 func (x *RouteStop) Reset() {
 	*x = RouteStop{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[9]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1117,7 +1117,7 @@ func (x *RouteStop) String() string {
 func (*RouteStop) ProtoMessage() {}
 
 func (x *RouteStop) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[9]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,7 +1130,7 @@ func (x *RouteStop) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteStop.ProtoReflect.Descriptor instead.
 func (*RouteStop) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RouteStop) GetStationId() string {
@@ -1184,7 +1184,7 @@ type CreateRouteRequest struct {
 
 func (x *CreateRouteRequest) Reset() {
 	*x = CreateRouteRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[10]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1196,7 +1196,7 @@ func (x *CreateRouteRequest) String() string {
 func (*CreateRouteRequest) ProtoMessage() {}
 
 func (x *CreateRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[10]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,7 +1209,7 @@ func (x *CreateRouteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRouteRequest.ProtoReflect.Descriptor instead.
 func (*CreateRouteRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{10}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateRouteRequest) GetOrganizationId() string {
@@ -1278,7 +1278,7 @@ type GetRouteRequest struct {
 
 func (x *GetRouteRequest) Reset() {
 	*x = GetRouteRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[11]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1290,7 +1290,7 @@ func (x *GetRouteRequest) String() string {
 func (*GetRouteRequest) ProtoMessage() {}
 
 func (x *GetRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[11]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,7 +1303,7 @@ func (x *GetRouteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRouteRequest.ProtoReflect.Descriptor instead.
 func (*GetRouteRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{11}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetRouteRequest) GetId() string {
@@ -1333,7 +1333,7 @@ type ListRoutesRequest struct {
 
 func (x *ListRoutesRequest) Reset() {
 	*x = ListRoutesRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[12]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1345,7 +1345,7 @@ func (x *ListRoutesRequest) String() string {
 func (*ListRoutesRequest) ProtoMessage() {}
 
 func (x *ListRoutesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[12]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1358,7 +1358,7 @@ func (x *ListRoutesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoutesRequest.ProtoReflect.Descriptor instead.
 func (*ListRoutesRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListRoutesRequest) GetOrganizationId() string {
@@ -1407,7 +1407,7 @@ type ListRoutesResponse struct {
 
 func (x *ListRoutesResponse) Reset() {
 	*x = ListRoutesResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[13]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1419,7 +1419,7 @@ func (x *ListRoutesResponse) String() string {
 func (*ListRoutesResponse) ProtoMessage() {}
 
 func (x *ListRoutesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[13]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1432,7 +1432,7 @@ func (x *ListRoutesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoutesResponse.ProtoReflect.Descriptor instead.
 func (*ListRoutesResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{13}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListRoutesResponse) GetRoutes() []*Route {
@@ -1470,7 +1470,7 @@ type UpdateRouteRequest struct {
 
 func (x *UpdateRouteRequest) Reset() {
 	*x = UpdateRouteRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[14]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1482,7 +1482,7 @@ func (x *UpdateRouteRequest) String() string {
 func (*UpdateRouteRequest) ProtoMessage() {}
 
 func (x *UpdateRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[14]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1495,7 +1495,7 @@ func (x *UpdateRouteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRouteRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRouteRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{14}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateRouteRequest) GetId() string {
@@ -1550,7 +1550,7 @@ type DeleteRouteRequest struct {
 
 func (x *DeleteRouteRequest) Reset() {
 	*x = DeleteRouteRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[15]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1562,7 +1562,7 @@ func (x *DeleteRouteRequest) String() string {
 func (*DeleteRouteRequest) ProtoMessage() {}
 
 func (x *DeleteRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[15]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1575,7 +1575,7 @@ func (x *DeleteRouteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRouteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRouteRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{15}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteRouteRequest) GetId() string {
@@ -1601,7 +1601,7 @@ type DeleteRouteResponse struct {
 
 func (x *DeleteRouteResponse) Reset() {
 	*x = DeleteRouteResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[16]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1613,7 +1613,7 @@ func (x *DeleteRouteResponse) String() string {
 func (*DeleteRouteResponse) ProtoMessage() {}
 
 func (x *DeleteRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[16]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,7 +1626,7 @@ func (x *DeleteRouteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRouteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteRouteResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{16}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteRouteResponse) GetSuccess() bool {
@@ -1661,7 +1661,7 @@ type Trip struct {
 
 func (x *Trip) Reset() {
 	*x = Trip{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[17]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1673,7 +1673,7 @@ func (x *Trip) String() string {
 func (*Trip) ProtoMessage() {}
 
 func (x *Trip) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[17]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1686,7 +1686,7 @@ func (x *Trip) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trip.ProtoReflect.Descriptor instead.
 func (*Trip) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{17}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Trip) GetId() string {
@@ -1823,7 +1823,7 @@ type TripPricing struct {
 
 func (x *TripPricing) Reset() {
 	*x = TripPricing{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[18]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1835,7 +1835,7 @@ func (x *TripPricing) String() string {
 func (*TripPricing) ProtoMessage() {}
 
 func (x *TripPricing) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[18]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1848,7 +1848,7 @@ func (x *TripPricing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TripPricing.ProtoReflect.Descriptor instead.
 func (*TripPricing) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{18}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TripPricing) GetBasePricePaisa() int64 {
@@ -1913,7 +1913,7 @@ type SegmentPricing struct {
 
 func (x *SegmentPricing) Reset() {
 	*x = SegmentPricing{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[19]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1925,7 +1925,7 @@ func (x *SegmentPricing) String() string {
 func (*SegmentPricing) ProtoMessage() {}
 
 func (x *SegmentPricing) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[19]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1938,7 +1938,7 @@ func (x *SegmentPricing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SegmentPricing.ProtoReflect.Descriptor instead.
 func (*SegmentPricing) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{19}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SegmentPricing) GetFromStationId() string {
@@ -1990,7 +1990,7 @@ type TripSegment struct {
 
 func (x *TripSegment) Reset() {
 	*x = TripSegment{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[20]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2002,7 +2002,7 @@ func (x *TripSegment) String() string {
 func (*TripSegment) ProtoMessage() {}
 
 func (x *TripSegment) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[20]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2015,7 +2015,7 @@ func (x *TripSegment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TripSegment.ProtoReflect.Descriptor instead.
 func (*TripSegment) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{20}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TripSegment) GetSegmentIndex() int32 {
@@ -2076,7 +2076,7 @@ type CreateTripRequest struct {
 
 func (x *CreateTripRequest) Reset() {
 	*x = CreateTripRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[21]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2088,7 +2088,7 @@ func (x *CreateTripRequest) String() string {
 func (*CreateTripRequest) ProtoMessage() {}
 
 func (x *CreateTripRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[21]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2101,7 +2101,7 @@ func (x *CreateTripRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTripRequest.ProtoReflect.Descriptor instead.
 func (*CreateTripRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{21}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CreateTripRequest) GetOrganizationId() string {
@@ -2170,7 +2170,7 @@ type GetTripRequest struct {
 
 func (x *GetTripRequest) Reset() {
 	*x = GetTripRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[22]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2182,7 +2182,7 @@ func (x *GetTripRequest) String() string {
 func (*GetTripRequest) ProtoMessage() {}
 
 func (x *GetTripRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[22]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2195,7 +2195,7 @@ func (x *GetTripRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTripRequest.ProtoReflect.Descriptor instead.
 func (*GetTripRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{22}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetTripRequest) GetId() string {
@@ -2229,7 +2229,7 @@ type ListTripsRequest struct {
 
 func (x *ListTripsRequest) Reset() {
 	*x = ListTripsRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[23]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2241,7 +2241,7 @@ func (x *ListTripsRequest) String() string {
 func (*ListTripsRequest) ProtoMessage() {}
 
 func (x *ListTripsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[23]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2254,7 +2254,7 @@ func (x *ListTripsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTripsRequest.ProtoReflect.Descriptor instead.
 func (*ListTripsRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{23}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListTripsRequest) GetOrganizationId() string {
@@ -2331,7 +2331,7 @@ type ListTripsResponse struct {
 
 func (x *ListTripsResponse) Reset() {
 	*x = ListTripsResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[24]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2343,7 +2343,7 @@ func (x *ListTripsResponse) String() string {
 func (*ListTripsResponse) ProtoMessage() {}
 
 func (x *ListTripsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[24]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2356,7 +2356,7 @@ func (x *ListTripsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTripsResponse.ProtoReflect.Descriptor instead.
 func (*ListTripsResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{24}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListTripsResponse) GetTrips() []*Trip {
@@ -2393,7 +2393,7 @@ type UpdateTripRequest struct {
 
 func (x *UpdateTripRequest) Reset() {
 	*x = UpdateTripRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[25]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2405,7 +2405,7 @@ func (x *UpdateTripRequest) String() string {
 func (*UpdateTripRequest) ProtoMessage() {}
 
 func (x *UpdateTripRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[25]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,7 +2418,7 @@ func (x *UpdateTripRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTripRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTripRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{25}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *UpdateTripRequest) GetId() string {
@@ -2467,7 +2467,7 @@ type CancelTripRequest struct {
 
 func (x *CancelTripRequest) Reset() {
 	*x = CancelTripRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[26]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2479,7 +2479,7 @@ func (x *CancelTripRequest) String() string {
 func (*CancelTripRequest) ProtoMessage() {}
 
 func (x *CancelTripRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[26]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2492,7 +2492,7 @@ func (x *CancelTripRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTripRequest.ProtoReflect.Descriptor instead.
 func (*CancelTripRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{26}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CancelTripRequest) GetId() string {
@@ -2516,6 +2516,66 @@ func (x *CancelTripRequest) GetReason() string {
 	return ""
 }
 
+type ReassignTripVehicleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	VehicleId      string                 `protobuf:"bytes,3,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReassignTripVehicleRequest) Reset() {
+	*x = ReassignTripVehicleRequest{}
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignTripVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignTripVehicleRequest) ProtoMessage() {}
+
+func (x *ReassignTripVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignTripVehicleRequest.ProtoReflect.Descriptor instead.
+func (*ReassignTripVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReassignTripVehicleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReassignTripVehicleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ReassignTripVehicleRequest) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
 type SearchTripsRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional: search all or specific operator
@@ -2534,7 +2594,7 @@ type SearchTripsRequest struct {
 
 func (x *SearchTripsRequest) Reset() {
 	*x = SearchTripsRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[27]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2546,7 +2606,7 @@ func (x *SearchTripsRequest) String() string {
 func (*SearchTripsRequest) ProtoMessage() {}
 
 func (x *SearchTripsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[27]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2559,7 +2619,7 @@ func (x *SearchTripsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchTripsRequest.ProtoReflect.Descriptor instead.
 func (*SearchTripsRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{27}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SearchTripsRequest) GetOrganizationId() string {
@@ -2644,7 +2704,7 @@ type SearchTripsResponse struct {
 
 func (x *SearchTripsResponse) Reset() {
 	*x = SearchTripsResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[28]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2656,7 +2716,7 @@ func (x *SearchTripsResponse) String() string {
 func (*SearchTripsResponse) ProtoMessage() {}
 
 func (x *SearchTripsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[28]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2669,7 +2729,7 @@ func (x *SearchTripsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchTripsResponse.ProtoReflect.Descriptor instead.
 func (*SearchTripsResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{28}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SearchTripsResponse) GetResults() []*TripSearchResult {
@@ -2713,7 +2773,7 @@ type TripSearchResult struct {
 
 func (x *TripSearchResult) Reset() {
 	*x = TripSearchResult{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[29]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2725,7 +2785,7 @@ func (x *TripSearchResult) String() string {
 func (*TripSearchResult) ProtoMessage() {}
 
 func (x *TripSearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[29]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2738,7 +2798,7 @@ func (x *TripSearchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TripSearchResult.ProtoReflect.Descriptor instead.
 func (*TripSearchResult) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{29}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *TripSearchResult) GetTrip() *Trip {
@@ -2802,7 +2862,7 @@ type Schedule struct {
 
 func (x *Schedule) Reset() {
 	*x = Schedule{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[30]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2814,7 +2874,7 @@ func (x *Schedule) String() string {
 func (*Schedule) ProtoMessage() {}
 
 func (x *Schedule) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[30]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2827,7 +2887,7 @@ func (x *Schedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
 func (*Schedule) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{30}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Schedule) GetId() string {
@@ -2977,7 +3037,7 @@ type CreateScheduleRequest struct {
 
 func (x *CreateScheduleRequest) Reset() {
 	*x = CreateScheduleRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[31]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2989,7 +3049,7 @@ func (x *CreateScheduleRequest) String() string {
 func (*CreateScheduleRequest) ProtoMessage() {}
 
 func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[31]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3002,7 +3062,7 @@ func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
 func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{31}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CreateScheduleRequest) GetOrganizationId() string {
@@ -3116,7 +3176,7 @@ type ScheduleDefinition struct {
 
 func (x *ScheduleDefinition) Reset() {
 	*x = ScheduleDefinition{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[32]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3128,7 +3188,7 @@ func (x *ScheduleDefinition) String() string {
 func (*ScheduleDefinition) ProtoMessage() {}
 
 func (x *ScheduleDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[32]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3141,7 +3201,7 @@ func (x *ScheduleDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleDefinition.ProtoReflect.Descriptor instead.
 func (*ScheduleDefinition) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{32}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ScheduleDefinition) GetRouteId() string {
@@ -3238,7 +3298,7 @@ type BulkCreateSchedulesRequest struct {
 
 func (x *BulkCreateSchedulesRequest) Reset() {
 	*x = BulkCreateSchedulesRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[33]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3250,7 +3310,7 @@ func (x *BulkCreateSchedulesRequest) String() string {
 func (*BulkCreateSchedulesRequest) ProtoMessage() {}
 
 func (x *BulkCreateSchedulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[33]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3263,7 +3323,7 @@ func (x *BulkCreateSchedulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkCreateSchedulesRequest.ProtoReflect.Descriptor instead.
 func (*BulkCreateSchedulesRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{33}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *BulkCreateSchedulesRequest) GetOrganizationId() string {
@@ -3290,7 +3350,7 @@ type BulkCreateSchedulesResponse struct {
 
 func (x *BulkCreateSchedulesResponse) Reset() {
 	*x = BulkCreateSchedulesResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[34]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3302,7 +3362,7 @@ func (x *BulkCreateSchedulesResponse) String() string {
 func (*BulkCreateSchedulesResponse) ProtoMessage() {}
 
 func (x *BulkCreateSchedulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[34]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3315,7 +3375,7 @@ func (x *BulkCreateSchedulesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkCreateSchedulesResponse.ProtoReflect.Descriptor instead.
 func (*BulkCreateSchedulesResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{34}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *BulkCreateSchedulesResponse) GetSchedules() []*Schedule {
@@ -3342,7 +3402,7 @@ type GetScheduleRequest struct {
 
 func (x *GetScheduleRequest) Reset() {
 	*x = GetScheduleRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[35]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3354,7 +3414,7 @@ func (x *GetScheduleRequest) String() string {
 func (*GetScheduleRequest) ProtoMessage() {}
 
 func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[35]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3367,7 +3427,7 @@ func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
 func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{35}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetScheduleRequest) GetId() string {
@@ -3397,7 +3457,7 @@ type ListSchedulesRequest struct {
 
 func (x *ListSchedulesRequest) Reset() {
 	*x = ListSchedulesRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[36]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3409,7 +3469,7 @@ func (x *ListSchedulesRequest) String() string {
 func (*ListSchedulesRequest) ProtoMessage() {}
 
 func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[36]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3422,7 +3482,7 @@ func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
 func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{36}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ListSchedulesRequest) GetOrganizationId() string {
@@ -3471,7 +3531,7 @@ type ListSchedulesResponse struct {
 
 func (x *ListSchedulesResponse) Reset() {
 	*x = ListSchedulesResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[37]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3483,7 +3543,7 @@ func (x *ListSchedulesResponse) String() string {
 func (*ListSchedulesResponse) ProtoMessage() {}
 
 func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[37]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3496,7 +3556,7 @@ func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
 func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{37}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
@@ -3539,7 +3599,7 @@ type UpdateScheduleRequest struct {
 
 func (x *UpdateScheduleRequest) Reset() {
 	*x = UpdateScheduleRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[38]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3551,7 +3611,7 @@ func (x *UpdateScheduleRequest) String() string {
 func (*UpdateScheduleRequest) ProtoMessage() {}
 
 func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[38]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3564,7 +3624,7 @@ func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{38}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *UpdateScheduleRequest) GetId() string {
@@ -3654,7 +3714,7 @@ type DeleteScheduleRequest struct {
 
 func (x *DeleteScheduleRequest) Reset() {
 	*x = DeleteScheduleRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[39]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3666,7 +3726,7 @@ func (x *DeleteScheduleRequest) String() string {
 func (*DeleteScheduleRequest) ProtoMessage() {}
 
 func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[39]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3679,7 +3739,7 @@ func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
 func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{39}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DeleteScheduleRequest) GetId() string {
@@ -3705,7 +3765,7 @@ type DeleteScheduleResponse struct {
 
 func (x *DeleteScheduleResponse) Reset() {
 	*x = DeleteScheduleResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[40]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3717,7 +3777,7 @@ func (x *DeleteScheduleResponse) String() string {
 func (*DeleteScheduleResponse) ProtoMessage() {}
 
 func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[40]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3730,7 +3790,7 @@ func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteScheduleResponse.ProtoReflect.Descriptor instead.
 func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{40}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *DeleteScheduleResponse) GetSuccess() bool {
@@ -3754,7 +3814,7 @@ type ScheduleException struct {
 
 func (x *ScheduleException) Reset() {
 	*x = ScheduleException{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[41]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3766,7 +3826,7 @@ func (x *ScheduleException) String() string {
 func (*ScheduleException) ProtoMessage() {}
 
 func (x *ScheduleException) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[41]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3779,7 +3839,7 @@ func (x *ScheduleException) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleException.ProtoReflect.Descriptor instead.
 func (*ScheduleException) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{41}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ScheduleException) GetId() string {
@@ -3837,7 +3897,7 @@ type AddScheduleExceptionRequest struct {
 
 func (x *AddScheduleExceptionRequest) Reset() {
 	*x = AddScheduleExceptionRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[42]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3849,7 +3909,7 @@ func (x *AddScheduleExceptionRequest) String() string {
 func (*AddScheduleExceptionRequest) ProtoMessage() {}
 
 func (x *AddScheduleExceptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[42]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3862,7 +3922,7 @@ func (x *AddScheduleExceptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddScheduleExceptionRequest.ProtoReflect.Descriptor instead.
 func (*AddScheduleExceptionRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{42}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *AddScheduleExceptionRequest) GetScheduleId() string {
@@ -3910,7 +3970,7 @@ type ListScheduleExceptionsRequest struct {
 
 func (x *ListScheduleExceptionsRequest) Reset() {
 	*x = ListScheduleExceptionsRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[43]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3922,7 +3982,7 @@ func (x *ListScheduleExceptionsRequest) String() string {
 func (*ListScheduleExceptionsRequest) ProtoMessage() {}
 
 func (x *ListScheduleExceptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[43]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3935,7 +3995,7 @@ func (x *ListScheduleExceptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListScheduleExceptionsRequest.ProtoReflect.Descriptor instead.
 func (*ListScheduleExceptionsRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{43}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ListScheduleExceptionsRequest) GetScheduleId() string {
@@ -3961,7 +4021,7 @@ type ListScheduleExceptionsResponse struct {
 
 func (x *ListScheduleExceptionsResponse) Reset() {
 	*x = ListScheduleExceptionsResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[44]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3973,7 +4033,7 @@ func (x *ListScheduleExceptionsResponse) String() string {
 func (*ListScheduleExceptionsResponse) ProtoMessage() {}
 
 func (x *ListScheduleExceptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[44]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3986,7 +4046,7 @@ func (x *ListScheduleExceptionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListScheduleExceptionsResponse.ProtoReflect.Descriptor instead.
 func (*ListScheduleExceptionsResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{44}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ListScheduleExceptionsResponse) GetExceptions() []*ScheduleException {
@@ -4008,7 +4068,7 @@ type GenerateTripInstancesRequest struct {
 
 func (x *GenerateTripInstancesRequest) Reset() {
 	*x = GenerateTripInstancesRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[45]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4020,7 +4080,7 @@ func (x *GenerateTripInstancesRequest) String() string {
 func (*GenerateTripInstancesRequest) ProtoMessage() {}
 
 func (x *GenerateTripInstancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[45]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4033,7 +4093,7 @@ func (x *GenerateTripInstancesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateTripInstancesRequest.ProtoReflect.Descriptor instead.
 func (*GenerateTripInstancesRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{45}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GenerateTripInstancesRequest) GetScheduleId() string {
@@ -4074,7 +4134,7 @@ type GenerateTripInstancesResponse struct {
 
 func (x *GenerateTripInstancesResponse) Reset() {
 	*x = GenerateTripInstancesResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[46]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4086,7 +4146,7 @@ func (x *GenerateTripInstancesResponse) String() string {
 func (*GenerateTripInstancesResponse) ProtoMessage() {}
 
 func (x *GenerateTripInstancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[46]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4099,7 +4159,7 @@ func (x *GenerateTripInstancesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateTripInstancesResponse.ProtoReflect.Descriptor instead.
 func (*GenerateTripInstancesResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{46}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GenerateTripInstancesResponse) GetTrips() []*Trip {
@@ -4132,7 +4192,7 @@ type ListTripInstancesRequest struct {
 
 func (x *ListTripInstancesRequest) Reset() {
 	*x = ListTripInstancesRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[47]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4144,7 +4204,7 @@ func (x *ListTripInstancesRequest) String() string {
 func (*ListTripInstancesRequest) ProtoMessage() {}
 
 func (x *ListTripInstancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[47]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4157,7 +4217,7 @@ func (x *ListTripInstancesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTripInstancesRequest.ProtoReflect.Descriptor instead.
 func (*ListTripInstancesRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{47}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ListTripInstancesRequest) GetOrganizationId() string {
@@ -4227,7 +4287,7 @@ type ListTripInstancesResponse struct {
 
 func (x *ListTripInstancesResponse) Reset() {
 	*x = ListTripInstancesResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[48]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4239,7 +4299,7 @@ func (x *ListTripInstancesResponse) String() string {
 func (*ListTripInstancesResponse) ProtoMessage() {}
 
 func (x *ListTripInstancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[48]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4252,7 +4312,7 @@ func (x *ListTripInstancesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTripInstancesResponse.ProtoReflect.Descriptor instead.
 func (*ListTripInstancesResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{48}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ListTripInstancesResponse) GetResults() []*TripSearchResult {
@@ -4289,7 +4349,7 @@ type SearchFilters struct {
 
 func (x *SearchFilters) Reset() {
 	*x = SearchFilters{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[49]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4301,7 +4361,7 @@ func (x *SearchFilters) String() string {
 func (*SearchFilters) ProtoMessage() {}
 
 func (x *SearchFilters) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[49]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4314,7 +4374,7 @@ func (x *SearchFilters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchFilters.ProtoReflect.Descriptor instead.
 func (*SearchFilters) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{49}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *SearchFilters) GetVehicleTypes() []string {
@@ -4365,7 +4425,7 @@ type ScheduleVersion struct {
 
 func (x *ScheduleVersion) Reset() {
 	*x = ScheduleVersion{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[50]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4377,7 +4437,7 @@ func (x *ScheduleVersion) String() string {
 func (*ScheduleVersion) ProtoMessage() {}
 
 func (x *ScheduleVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[50]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4390,7 +4450,7 @@ func (x *ScheduleVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleVersion.ProtoReflect.Descriptor instead.
 func (*ScheduleVersion) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{50}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ScheduleVersion) GetId() string {
@@ -4438,7 +4498,7 @@ type GetScheduleHistoryRequest struct {
 
 func (x *GetScheduleHistoryRequest) Reset() {
 	*x = GetScheduleHistoryRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[51]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4450,7 +4510,7 @@ func (x *GetScheduleHistoryRequest) String() string {
 func (*GetScheduleHistoryRequest) ProtoMessage() {}
 
 func (x *GetScheduleHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[51]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4463,7 +4523,7 @@ func (x *GetScheduleHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScheduleHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetScheduleHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{51}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetScheduleHistoryRequest) GetScheduleId() string {
@@ -4489,7 +4549,7 @@ type GetScheduleHistoryResponse struct {
 
 func (x *GetScheduleHistoryResponse) Reset() {
 	*x = GetScheduleHistoryResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[52]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4501,7 +4561,7 @@ func (x *GetScheduleHistoryResponse) String() string {
 func (*GetScheduleHistoryResponse) ProtoMessage() {}
 
 func (x *GetScheduleHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[52]
+	mi := &file_api_proto_catalog_v1_catalog_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4514,7 +4574,7 @@ func (x *GetScheduleHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScheduleHistoryResponse.ProtoReflect.Descriptor instead.
 func (*GetScheduleHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{52}
+	return file_api_proto_catalog_v1_catalog_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetScheduleHistoryResponse) GetVersions() []*ScheduleVersion {
@@ -4524,11 +4584,11 @@ func (x *GetScheduleHistoryResponse) GetVersions() []*ScheduleVersion {
 	return nil
 }
 
-var File_catalog_v1_catalog_proto protoreflect.FileDescriptor
+var File_api_proto_catalog_v1_catalog_proto protoreflect.FileDescriptor
 
-const file_catalog_v1_catalog_proto_rawDesc = "" +
+const file_api_proto_catalog_v1_catalog_proto_rawDesc = "" +
 	"\n" +
-	"\x18catalog/v1/catalog.proto\x12\n" +
+	"\"api/proto/catalog/v1/catalog.proto\x12\n" +
 	"catalog.v1\"\xad\x03\n" +
 	"\aStation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
@@ -4749,7 +4809,12 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\x11CancelTripRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xfe\x02\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"t\n" +
+	"\x1aReassignTripVehicleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x03 \x01(\tR\tvehicleId\"\xfe\x02\n" +
 	"\x12SearchTripsRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
 	"\vorigin_city\x18\x02 \x01(\tR\n" +
@@ -4985,7 +5050,7 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\x0eScheduleStatus\x12\x1f\n" +
 	"\x1bSCHEDULE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16SCHEDULE_STATUS_ACTIVE\x10\x01\x12\x1c\n" +
-	"\x18SCHEDULE_STATUS_INACTIVE\x10\x022\xec\x10\n" +
+	"\x18SCHEDULE_STATUS_INACTIVE\x10\x022\xbd\x11\n" +
 	"\x0eCatalogService\x12F\n" +
 	"\rCreateStation\x12 .catalog.v1.CreateStationRequest\x1a\x13.catalog.v1.Station\x12@\n" +
 	"\n" +
@@ -5006,7 +5071,8 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\n" +
 	"UpdateTrip\x12\x1d.catalog.v1.UpdateTripRequest\x1a\x10.catalog.v1.Trip\x12=\n" +
 	"\n" +
-	"CancelTrip\x12\x1d.catalog.v1.CancelTripRequest\x1a\x10.catalog.v1.Trip\x12N\n" +
+	"CancelTrip\x12\x1d.catalog.v1.CancelTripRequest\x1a\x10.catalog.v1.Trip\x12O\n" +
+	"\x13ReassignTripVehicle\x12&.catalog.v1.ReassignTripVehicleRequest\x1a\x10.catalog.v1.Trip\x12N\n" +
 	"\vSearchTrips\x12\x1e.catalog.v1.SearchTripsRequest\x1a\x1f.catalog.v1.SearchTripsResponse\x12I\n" +
 	"\x0eCreateSchedule\x12!.catalog.v1.CreateScheduleRequest\x1a\x14.catalog.v1.Schedule\x12C\n" +
 	"\vGetSchedule\x12\x1e.catalog.v1.GetScheduleRequest\x1a\x14.catalog.v1.Schedule\x12T\n" +
@@ -5021,20 +5087,20 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\x12GetScheduleHistory\x12%.catalog.v1.GetScheduleHistoryRequest\x1a&.catalog.v1.GetScheduleHistoryResponseB:Z8github.com/MuhibNayem/Travio/server/api/proto/catalog/v1b\x06proto3"
 
 var (
-	file_catalog_v1_catalog_proto_rawDescOnce sync.Once
-	file_catalog_v1_catalog_proto_rawDescData []byte
+	file_api_proto_catalog_v1_catalog_proto_rawDescOnce sync.Once
+	file_api_proto_catalog_v1_catalog_proto_rawDescData []byte
 )
 
-func file_catalog_v1_catalog_proto_rawDescGZIP() []byte {
-	file_catalog_v1_catalog_proto_rawDescOnce.Do(func() {
-		file_catalog_v1_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_v1_catalog_proto_rawDesc), len(file_catalog_v1_catalog_proto_rawDesc)))
+func file_api_proto_catalog_v1_catalog_proto_rawDescGZIP() []byte {
+	file_api_proto_catalog_v1_catalog_proto_rawDescOnce.Do(func() {
+		file_api_proto_catalog_v1_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_catalog_v1_catalog_proto_rawDesc), len(file_api_proto_catalog_v1_catalog_proto_rawDesc)))
 	})
-	return file_catalog_v1_catalog_proto_rawDescData
+	return file_api_proto_catalog_v1_catalog_proto_rawDescData
 }
 
-var file_catalog_v1_catalog_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_catalog_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
-var file_catalog_v1_catalog_proto_goTypes = []any{
+var file_api_proto_catalog_v1_catalog_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_api_proto_catalog_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_api_proto_catalog_v1_catalog_proto_goTypes = []any{
 	(StationStatus)(0),                     // 0: catalog.v1.StationStatus
 	(RouteStatus)(0),                       // 1: catalog.v1.RouteStatus
 	(TripStatus)(0),                        // 2: catalog.v1.TripStatus
@@ -5067,38 +5133,39 @@ var file_catalog_v1_catalog_proto_goTypes = []any{
 	(*ListTripsResponse)(nil),              // 29: catalog.v1.ListTripsResponse
 	(*UpdateTripRequest)(nil),              // 30: catalog.v1.UpdateTripRequest
 	(*CancelTripRequest)(nil),              // 31: catalog.v1.CancelTripRequest
-	(*SearchTripsRequest)(nil),             // 32: catalog.v1.SearchTripsRequest
-	(*SearchTripsResponse)(nil),            // 33: catalog.v1.SearchTripsResponse
-	(*TripSearchResult)(nil),               // 34: catalog.v1.TripSearchResult
-	(*Schedule)(nil),                       // 35: catalog.v1.Schedule
-	(*CreateScheduleRequest)(nil),          // 36: catalog.v1.CreateScheduleRequest
-	(*ScheduleDefinition)(nil),             // 37: catalog.v1.ScheduleDefinition
-	(*BulkCreateSchedulesRequest)(nil),     // 38: catalog.v1.BulkCreateSchedulesRequest
-	(*BulkCreateSchedulesResponse)(nil),    // 39: catalog.v1.BulkCreateSchedulesResponse
-	(*GetScheduleRequest)(nil),             // 40: catalog.v1.GetScheduleRequest
-	(*ListSchedulesRequest)(nil),           // 41: catalog.v1.ListSchedulesRequest
-	(*ListSchedulesResponse)(nil),          // 42: catalog.v1.ListSchedulesResponse
-	(*UpdateScheduleRequest)(nil),          // 43: catalog.v1.UpdateScheduleRequest
-	(*DeleteScheduleRequest)(nil),          // 44: catalog.v1.DeleteScheduleRequest
-	(*DeleteScheduleResponse)(nil),         // 45: catalog.v1.DeleteScheduleResponse
-	(*ScheduleException)(nil),              // 46: catalog.v1.ScheduleException
-	(*AddScheduleExceptionRequest)(nil),    // 47: catalog.v1.AddScheduleExceptionRequest
-	(*ListScheduleExceptionsRequest)(nil),  // 48: catalog.v1.ListScheduleExceptionsRequest
-	(*ListScheduleExceptionsResponse)(nil), // 49: catalog.v1.ListScheduleExceptionsResponse
-	(*GenerateTripInstancesRequest)(nil),   // 50: catalog.v1.GenerateTripInstancesRequest
-	(*GenerateTripInstancesResponse)(nil),  // 51: catalog.v1.GenerateTripInstancesResponse
-	(*ListTripInstancesRequest)(nil),       // 52: catalog.v1.ListTripInstancesRequest
-	(*ListTripInstancesResponse)(nil),      // 53: catalog.v1.ListTripInstancesResponse
-	(*SearchFilters)(nil),                  // 54: catalog.v1.SearchFilters
-	(*ScheduleVersion)(nil),                // 55: catalog.v1.ScheduleVersion
-	(*GetScheduleHistoryRequest)(nil),      // 56: catalog.v1.GetScheduleHistoryRequest
-	(*GetScheduleHistoryResponse)(nil),     // 57: catalog.v1.GetScheduleHistoryResponse
-	nil,                                    // 58: catalog.v1.TripPricing.ClassPricesEntry
-	nil,                                    // 59: catalog.v1.TripPricing.SeatCategoryPricesEntry
-	nil,                                    // 60: catalog.v1.SegmentPricing.ClassPricesEntry
-	nil,                                    // 61: catalog.v1.SegmentPricing.SeatCategoryPricesEntry
-}
-var file_catalog_v1_catalog_proto_depIdxs = []int32{
+	(*ReassignTripVehicleRequest)(nil),     // 32: catalog.v1.ReassignTripVehicleRequest
+	(*SearchTripsRequest)(nil),             // 33: catalog.v1.SearchTripsRequest
+	(*SearchTripsResponse)(nil),            // 34: catalog.v1.SearchTripsResponse
+	(*TripSearchResult)(nil),               // 35: catalog.v1.TripSearchResult
+	(*Schedule)(nil),                       // 36: catalog.v1.Schedule
+	(*CreateScheduleRequest)(nil),          // 37: catalog.v1.CreateScheduleRequest
+	(*ScheduleDefinition)(nil),             // 38: catalog.v1.ScheduleDefinition
+	(*BulkCreateSchedulesRequest)(nil),     // 39: catalog.v1.BulkCreateSchedulesRequest
+	(*BulkCreateSchedulesResponse)(nil),    // 40: catalog.v1.BulkCreateSchedulesResponse
+	(*GetScheduleRequest)(nil),             // 41: catalog.v1.GetScheduleRequest
+	(*ListSchedulesRequest)(nil),           // 42: catalog.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),          // 43: catalog.v1.ListSchedulesResponse
+	(*UpdateScheduleRequest)(nil),          // 44: catalog.v1.UpdateScheduleRequest
+	(*DeleteScheduleRequest)(nil),          // 45: catalog.v1.DeleteScheduleRequest
+	(*DeleteScheduleResponse)(nil),         // 46: catalog.v1.DeleteScheduleResponse
+	(*ScheduleException)(nil),              // 47: catalog.v1.ScheduleException
+	(*AddScheduleExceptionRequest)(nil),    // 48: catalog.v1.AddScheduleExceptionRequest
+	(*ListScheduleExceptionsRequest)(nil),  // 49: catalog.v1.ListScheduleExceptionsRequest
+	(*ListScheduleExceptionsResponse)(nil), // 50: catalog.v1.ListScheduleExceptionsResponse
+	(*GenerateTripInstancesRequest)(nil),   // 51: catalog.v1.GenerateTripInstancesRequest
+	(*GenerateTripInstancesResponse)(nil),  // 52: catalog.v1.GenerateTripInstancesResponse
+	(*ListTripInstancesRequest)(nil),       // 53: catalog.v1.ListTripInstancesRequest
+	(*ListTripInstancesResponse)(nil),      // 54: catalog.v1.ListTripInstancesResponse
+	(*SearchFilters)(nil),                  // 55: catalog.v1.SearchFilters
+	(*ScheduleVersion)(nil),                // 56: catalog.v1.ScheduleVersion
+	(*GetScheduleHistoryRequest)(nil),      // 57: catalog.v1.GetScheduleHistoryRequest
+	(*GetScheduleHistoryResponse)(nil),     // 58: catalog.v1.GetScheduleHistoryResponse
+	nil,                                    // 59: catalog.v1.TripPricing.ClassPricesEntry
+	nil,                                    // 60: catalog.v1.TripPricing.SeatCategoryPricesEntry
+	nil,                                    // 61: catalog.v1.SegmentPricing.ClassPricesEntry
+	nil,                                    // 62: catalog.v1.SegmentPricing.SeatCategoryPricesEntry
+}
+var file_api_proto_catalog_v1_catalog_proto_depIdxs = []int32{
 	0,  // 0: catalog.v1.Station.status:type_name -> catalog.v1.StationStatus
 	5,  // 1: catalog.v1.ListStationsResponse.stations:type_name -> catalog.v1.Station
 	0,  // 2: catalog.v1.UpdateStationRequest.status:type_name -> catalog.v1.StationStatus
@@ -5113,19 +5180,19 @@ var file_catalog_v1_catalog_proto_depIdxs = []int32{
 	23, // 11: catalog.v1.Trip.pricing:type_name -> catalog.v1.TripPricing
 	2,  // 12: catalog.v1.Trip.status:type_name -> catalog.v1.TripStatus
 	25, // 13: catalog.v1.Trip.segments:type_name -> catalog.v1.TripSegment
-	58, // 14: catalog.v1.TripPricing.class_prices:type_name -> catalog.v1.TripPricing.ClassPricesEntry
-	59, // 15: catalog.v1.TripPricing.seat_category_prices:type_name -> catalog.v1.TripPricing.SeatCategoryPricesEntry
+	59, // 14: catalog.v1.TripPricing.class_prices:type_name -> catalog.v1.TripPricing.ClassPricesEntry
+	60, // 15: catalog.v1.TripPricing.seat_category_prices:type_name -> catalog.v1.TripPricing.SeatCategoryPricesEntry
 	24, // 16: catalog.v1.TripPricing.segment_prices:type_name -> catalog.v1.SegmentPricing
-	60, // 17: catalog.v1.SegmentPricing.class_prices:type_name -> catalog.v1.SegmentPricing.ClassPricesEntry
-	61, // 18: catalog.v1.SegmentPricing.seat_category_prices:type_name -> catalog.v1.SegmentPricing.SeatCategoryPricesEntry
+	61, // 17: catalog.v1.SegmentPricing.class_prices:type_name -> catalog.v1.SegmentPricing.ClassPricesEntry
+	62, // 18: catalog.v1.SegmentPricing.seat_category_prices:type_name -> catalog.v1.SegmentPricing.SeatCategoryPricesEntry
 	23, // 19: catalog.v1.CreateTripRequest.pricing:type_name -> catalog.v1.TripPricing
 	2,  // 20: catalog.v1.ListTripsRequest.status:type_name -> catalog.v1.TripStatus
 	22, // 21: catalog.v1.ListTripsResponse.trips:type_name -> catalog.v1.Trip
 	23, // 22: catalog.v1.UpdateTripRequest.pricing:type_name -> catalog.v1.TripPricing
 	2,  // 23: catalog.v1.UpdateTripRequest.status:type_name -> catalog.v1.TripStatus
 	3,  // 24: catalog.v1.SearchTripsRequest.sort_by:type_name -> catalog.v1.SortOrder
-	34, // 25: catalog.v1.SearchTripsResponse.results:type_name -> catalog.v1.TripSearchResult
-	54, // 26: catalog.v1.SearchTripsResponse.available_filters:type_name -> catalog.v1.SearchFilters
+	35, // 25: catalog.v1.SearchTripsResponse.results:type_name -> catalog.v1.TripSearchResult
+	55, // 26: catalog.v1.SearchTripsResponse.available_filters:type_name -> catalog.v1.SearchFilters
 	22, // 27: catalog.v1.TripSearchResult.trip:type_name -> catalog.v1.Trip
 	13, // 28: catalog.v1.TripSearchResult.route:type_name -> catalog.v1.Route
 	5,  // 29: catalog.v1.TripSearchResult.origin_station:type_name -> catalog.v1.Station
@@ -5134,18 +5201,18 @@ var file_catalog_v1_catalog_proto_depIdxs = []int32{
 	4,  // 32: catalog.v1.Schedule.status:type_name -> catalog.v1.ScheduleStatus
 	23, // 33: catalog.v1.CreateScheduleRequest.pricing:type_name -> catalog.v1.TripPricing
 	23, // 34: catalog.v1.ScheduleDefinition.pricing:type_name -> catalog.v1.TripPricing
-	37, // 35: catalog.v1.BulkCreateSchedulesRequest.schedules:type_name -> catalog.v1.ScheduleDefinition
-	35, // 36: catalog.v1.BulkCreateSchedulesResponse.schedules:type_name -> catalog.v1.Schedule
+	38, // 35: catalog.v1.BulkCreateSchedulesRequest.schedules:type_name -> catalog.v1.ScheduleDefinition
+	36, // 36: catalog.v1.BulkCreateSchedulesResponse.schedules:type_name -> catalog.v1.Schedule
 	4,  // 37: catalog.v1.ListSchedulesRequest.status:type_name -> catalog.v1.ScheduleStatus
-	35, // 38: catalog.v1.ListSchedulesResponse.schedules:type_name -> catalog.v1.Schedule
+	36, // 38: catalog.v1.ListSchedulesResponse.schedules:type_name -> catalog.v1.Schedule
 	23, // 39: catalog.v1.UpdateScheduleRequest.pricing:type_name -> catalog.v1.TripPricing
 	4,  // 40: catalog.v1.UpdateScheduleRequest.status:type_name -> catalog.v1.ScheduleStatus
-	46, // 41: catalog.v1.ListScheduleExceptionsResponse.exceptions:type_name -> catalog.v1.ScheduleException
+	47, // 41: catalog.v1.ListScheduleExceptionsResponse.exceptions:type_name -> catalog.v1.ScheduleException
 	22, // 42: catalog.v1.GenerateTripInstancesResponse.trips:type_name -> catalog.v1.Trip
 	2,  // 43: catalog.v1.ListTripInstancesRequest.status:type_name -> catalog.v1.TripStatus
-	34, // 44: catalog.v1.ListTripInstancesResponse.results:type_name -> catalog.v1.TripSearchResult
-	35, // 45: catalog.v1.ScheduleVersion.snapshot:type_name -> catalog.v1.Schedule
-	55, // 46: catalog.v1.GetScheduleHistoryResponse.versions:type_name -> catalog.v1.ScheduleVersion
+	35, // 44: catalog.v1.ListTripInstancesResponse.results:type_name -> catalog.v1.TripSearchResult
+	36, // 45: catalog.v1.ScheduleVersion.snapshot:type_name -> catalog.v1.Schedule
+	56, // 46: catalog.v1.GetScheduleHistoryResponse.versions:type_name -> catalog.v1.ScheduleVersion
 	6,  // 47: catalog.v1.CatalogService.CreateStation:input_type -> catalog.v1.CreateStationRequest
 	7,  // 48: catalog.v1.CatalogService.GetStation:input_type -> catalog.v1.GetStationRequest
 	8,  // 49: catalog.v1.CatalogService.ListStations:input_type -> catalog.v1.ListStationsRequest
@@ -5161,73 +5228,75 @@ var file_catalog_v1_catalog_proto_depIdxs = []int32{
 	28, // 59: catalog.v1.CatalogService.ListTrips:input_type -> catalog.v1.ListTripsRequest
 	30, // 60: catalog.v1.CatalogService.UpdateTrip:input_type -> catalog.v1.UpdateTripRequest
 	31, // 61: catalog.v1.CatalogService.CancelTrip:input_type -> catalog.v1.CancelTripRequest
-	32, // 62: catalog.v1.CatalogService.SearchTrips:input_type -> catalog.v1.SearchTripsRequest
-	36, // 63: catalog.v1.CatalogService.CreateSchedule:input_type -> catalog.v1.CreateScheduleRequest
-	40, // 64: catalog.v1.CatalogService.GetSchedule:input_type -> catalog.v1.GetScheduleRequest
-	41, // 65: catalog.v1.CatalogService.ListSchedules:input_type -> catalog.v1.ListSchedulesRequest
-	43, // 66: catalog.v1.CatalogService.UpdateSchedule:input_type -> catalog.v1.UpdateScheduleRequest
-	44, // 67: catalog.v1.CatalogService.DeleteSchedule:input_type -> catalog.v1.DeleteScheduleRequest
-	47, // 68: catalog.v1.CatalogService.AddScheduleException:input_type -> catalog.v1.AddScheduleExceptionRequest
-	48, // 69: catalog.v1.CatalogService.ListScheduleExceptions:input_type -> catalog.v1.ListScheduleExceptionsRequest
-	50, // 70: catalog.v1.CatalogService.GenerateTripInstances:input_type -> catalog.v1.GenerateTripInstancesRequest
-	52, // 71: catalog.v1.CatalogService.ListTripInstances:input_type -> catalog.v1.ListTripInstancesRequest
-	38, // 72: catalog.v1.CatalogService.CreateSchedules:input_type -> catalog.v1.BulkCreateSchedulesRequest
-	56, // 73: catalog.v1.CatalogService.GetScheduleHistory:input_type -> catalog.v1.GetScheduleHistoryRequest
-	5,  // 74: catalog.v1.CatalogService.CreateStation:output_type -> catalog.v1.Station
-	5,  // 75: catalog.v1.CatalogService.GetStation:output_type -> catalog.v1.Station
-	9,  // 76: catalog.v1.CatalogService.ListStations:output_type -> catalog.v1.ListStationsResponse
-	5,  // 77: catalog.v1.CatalogService.UpdateStation:output_type -> catalog.v1.Station
-	12, // 78: catalog.v1.CatalogService.DeleteStation:output_type -> catalog.v1.DeleteStationResponse
-	13, // 79: catalog.v1.CatalogService.CreateRoute:output_type -> catalog.v1.Route
-	13, // 80: catalog.v1.CatalogService.GetRoute:output_type -> catalog.v1.Route
-	18, // 81: catalog.v1.CatalogService.ListRoutes:output_type -> catalog.v1.ListRoutesResponse
-	13, // 82: catalog.v1.CatalogService.UpdateRoute:output_type -> catalog.v1.Route
-	21, // 83: catalog.v1.CatalogService.DeleteRoute:output_type -> catalog.v1.DeleteRouteResponse
-	22, // 84: catalog.v1.CatalogService.CreateTrip:output_type -> catalog.v1.Trip
-	22, // 85: catalog.v1.CatalogService.GetTrip:output_type -> catalog.v1.Trip
-	29, // 86: catalog.v1.CatalogService.ListTrips:output_type -> catalog.v1.ListTripsResponse
-	22, // 87: catalog.v1.CatalogService.UpdateTrip:output_type -> catalog.v1.Trip
-	22, // 88: catalog.v1.CatalogService.CancelTrip:output_type -> catalog.v1.Trip
-	33, // 89: catalog.v1.CatalogService.SearchTrips:output_type -> catalog.v1.SearchTripsResponse
-	35, // 90: catalog.v1.CatalogService.CreateSchedule:output_type -> catalog.v1.Schedule
-	35, // 91: catalog.v1.CatalogService.GetSchedule:output_type -> catalog.v1.Schedule
-	42, // 92: catalog.v1.CatalogService.ListSchedules:output_type -> catalog.v1.ListSchedulesResponse
-	35, // 93: catalog.v1.CatalogService.UpdateSchedule:output_type -> catalog.v1.Schedule
-	45, // 94: catalog.v1.CatalogService.DeleteSchedule:output_type -> catalog.v1.DeleteScheduleResponse
-	46, // 95: catalog.v1.CatalogService.AddScheduleException:output_type -> catalog.v1.ScheduleException
-	49, // 96: catalog.v1.CatalogService.ListScheduleExceptions:output_type -> catalog.v1.ListScheduleExceptionsResponse
-	51, // 97: catalog.v1.CatalogService.GenerateTripInstances:output_type -> catalog.v1.GenerateTripInstancesResponse
-	53, // 98: catalog.v1.CatalogService.ListTripInstances:output_type -> catalog.v1.ListTripInstancesResponse
-	39, // 99: catalog.v1.CatalogService.CreateSchedules:output_type -> catalog.v1.BulkCreateSchedulesResponse
-	57, // 100: catalog.v1.CatalogService.GetScheduleHistory:output_type -> catalog.v1.GetScheduleHistoryResponse
-	74, // [74:101] is the sub-list for method output_type
-	47, // [47:74] is the sub-list for method input_type
+	32, // 62: catalog.v1.CatalogService.ReassignTripVehicle:input_type -> catalog.v1.ReassignTripVehicleRequest
+	33, // 63: catalog.v1.CatalogService.SearchTrips:input_type -> catalog.v1.SearchTripsRequest
+	37, // 64: catalog.v1.CatalogService.CreateSchedule:input_type -> catalog.v1.CreateScheduleRequest
+	41, // 65: catalog.v1.CatalogService.GetSchedule:input_type -> catalog.v1.GetScheduleRequest
+	42, // 66: catalog.v1.CatalogService.ListSchedules:input_type -> catalog.v1.ListSchedulesRequest
+	44, // 67: catalog.v1.CatalogService.UpdateSchedule:input_type -> catalog.v1.UpdateScheduleRequest
+	45, // 68: catalog.v1.CatalogService.DeleteSchedule:input_type -> catalog.v1.DeleteScheduleRequest
+	48, // 69: catalog.v1.CatalogService.AddScheduleException:input_type -> catalog.v1.AddScheduleExceptionRequest
+	49, // 70: catalog.v1.CatalogService.ListScheduleExceptions:input_type -> catalog.v1.ListScheduleExceptionsRequest
+	51, // 71: catalog.v1.CatalogService.GenerateTripInstances:input_type -> catalog.v1.GenerateTripInstancesRequest
+	53, // 72: catalog.v1.CatalogService.ListTripInstances:input_type -> catalog.v1.ListTripInstancesRequest
+	39, // 73: catalog.v1.CatalogService.CreateSchedules:input_type -> catalog.v1.BulkCreateSchedulesRequest
+	57, // 74: catalog.v1.CatalogService.GetScheduleHistory:input_type -> catalog.v1.GetScheduleHistoryRequest
+	5,  // 75: catalog.v1.CatalogService.CreateStation:output_type -> catalog.v1.Station
+	5,  // 76: catalog.v1.CatalogService.GetStation:output_type -> catalog.v1.Station
+	9,  // 77: catalog.v1.CatalogService.ListStations:output_type -> catalog.v1.ListStationsResponse
+	5,  // 78: catalog.v1.CatalogService.UpdateStation:output_type -> catalog.v1.Station
+	12, // 79: catalog.v1.CatalogService.DeleteStation:output_type -> catalog.v1.DeleteStationResponse
+	13, // 80: catalog.v1.CatalogService.CreateRoute:output_type -> catalog.v1.Route
+	13, // 81: catalog.v1.CatalogService.GetRoute:output_type -> catalog.v1.Route
+	18, // 82: catalog.v1.CatalogService.ListRoutes:output_type -> catalog.v1.ListRoutesResponse
+	13, // 83: catalog.v1.CatalogService.UpdateRoute:output_type -> catalog.v1.Route
+	21, // 84: catalog.v1.CatalogService.DeleteRoute:output_type -> catalog.v1.DeleteRouteResponse
+	22, // 85: catalog.v1.CatalogService.CreateTrip:output_type -> catalog.v1.Trip
+	22, // 86: catalog.v1.CatalogService.GetTrip:output_type -> catalog.v1.Trip
+	29, // 87: catalog.v1.CatalogService.ListTrips:output_type -> catalog.v1.ListTripsResponse
+	22, // 88: catalog.v1.CatalogService.UpdateTrip:output_type -> catalog.v1.Trip
+	22, // 89: catalog.v1.CatalogService.CancelTrip:output_type -> catalog.v1.Trip
+	22, // 90: catalog.v1.CatalogService.ReassignTripVehicle:output_type -> catalog.v1.Trip
+	34, // 91: catalog.v1.CatalogService.SearchTrips:output_type -> catalog.v1.SearchTripsResponse
+	36, // 92: catalog.v1.CatalogService.CreateSchedule:output_type -> catalog.v1.Schedule
+	36, // 93: catalog.v1.CatalogService.GetSchedule:output_type -> catalog.v1.Schedule
+	43, // 94: catalog.v1.CatalogService.ListSchedules:output_type -> catalog.v1.ListSchedulesResponse
+	36, // 95: catalog.v1.CatalogService.UpdateSchedule:output_type -> catalog.v1.Schedule
+	46, // 96: catalog.v1.CatalogService.DeleteSchedule:output_type -> catalog.v1.DeleteScheduleResponse
+	47, // 97: catalog.v1.CatalogService.AddScheduleException:output_type -> catalog.v1.ScheduleException
+	50, // 98: catalog.v1.CatalogService.ListScheduleExceptions:output_type -> catalog.v1.ListScheduleExceptionsResponse
+	52, // 99: catalog.v1.CatalogService.GenerateTripInstances:output_type -> catalog.v1.GenerateTripInstancesResponse
+	54, // 100: catalog.v1.CatalogService.ListTripInstances:output_type -> catalog.v1.ListTripInstancesResponse
+	40, // 101: catalog.v1.CatalogService.CreateSchedules:output_type -> catalog.v1.BulkCreateSchedulesResponse
+	58, // 102: catalog.v1.CatalogService.GetScheduleHistory:output_type -> catalog.v1.GetScheduleHistoryResponse
+	75, // [75:103] is the sub-list for method output_type
+	47, // [47:75] is the sub-list for method input_type
 	47, // [47:47] is the sub-list for extension type_name
 	47, // [47:47] is the sub-list for extension extendee
 	0,  // [0:47] is the sub-list for field type_name
 }
 
-func init() { file_catalog_v1_catalog_proto_init() }
-func file_catalog_v1_catalog_proto_init() {
-	if File_catalog_v1_catalog_proto != nil {
+func init() { file_api_proto_catalog_v1_catalog_proto_init() }
+func file_api_proto_catalog_v1_catalog_proto_init() {
+	if File_api_proto_catalog_v1_catalog_proto != nil {
 		return
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_v1_catalog_proto_rawDesc), len(file_catalog_v1_catalog_proto_rawDesc)),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_catalog_v1_catalog_proto_rawDesc), len(file_api_proto_catalog_v1_catalog_proto_rawDesc)),
 			NumEnums:      5,
-			NumMessages:   57,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
-		GoTypes:           file_catalog_v1_catalog_proto_goTypes,
-		DependencyIndexes: file_catalog_v1_catalog_proto_depIdxs,
-		EnumInfos:         file_catalog_v1_catalog_proto_enumTypes,
-		MessageInfos:      file_catalog_v1_catalog_proto_msgTypes,
+		GoTypes:           file_api_proto_catalog_v1_catalog_proto_goTypes,
+		DependencyIndexes: file_api_proto_catalog_v1_catalog_proto_depIdxs,
+		EnumInfos:         file_api_proto_catalog_v1_catalog_proto_enumTypes,
+		MessageInfos:      file_api_proto_catalog_v1_catalog_proto_msgTypes,
 	}.Build()
-	File_catalog_v1_catalog_proto = out.File
-	file_catalog_v1_catalog_proto_goTypes = nil
-	file_catalog_v1_catalog_proto_depIdxs = nil
+	File_api_proto_catalog_v1_catalog_proto = out.File
+	file_api_proto_catalog_v1_catalog_proto_goTypes = nil
+	file_api_proto_catalog_v1_catalog_proto_depIdxs = nil
 }
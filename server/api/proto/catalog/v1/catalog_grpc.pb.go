@@ -2,7 +2,7 @@
 // versions:
 // - protoc-gen-go-grpc v1.6.0
 // - protoc             v3.21.12
-// source: catalog/v1/catalog.proto
+// source: api/proto/catalog/v1/catalog.proto
 
 package v1
 
@@ -34,6 +34,7 @@ const (
 	CatalogService_ListTrips_FullMethodName              = "/catalog.v1.CatalogService/ListTrips"
 	CatalogService_UpdateTrip_FullMethodName             = "/catalog.v1.CatalogService/UpdateTrip"
 	CatalogService_CancelTrip_FullMethodName             = "/catalog.v1.CatalogService/CancelTrip"
+	CatalogService_ReassignTripVehicle_FullMethodName    = "/catalog.v1.CatalogService/ReassignTripVehicle"
 	CatalogService_SearchTrips_FullMethodName            = "/catalog.v1.CatalogService/SearchTrips"
 	CatalogService_CreateSchedule_FullMethodName         = "/catalog.v1.CatalogService/CreateSchedule"
 	CatalogService_GetSchedule_FullMethodName            = "/catalog.v1.CatalogService/GetSchedule"
@@ -72,6 +73,8 @@ type CatalogServiceClient interface {
 	ListTrips(ctx context.Context, in *ListTripsRequest, opts ...grpc.CallOption) (*ListTripsResponse, error)
 	UpdateTrip(ctx context.Context, in *UpdateTripRequest, opts ...grpc.CallOption) (*Trip, error)
 	CancelTrip(ctx context.Context, in *CancelTripRequest, opts ...grpc.CallOption) (*Trip, error)
+	// Swap the vehicle running a trip; inventory moves the booked passengers onto its seats
+	ReassignTripVehicle(ctx context.Context, in *ReassignTripVehicleRequest, opts ...grpc.CallOption) (*Trip, error)
 	// Search (for frontend)
 	SearchTrips(ctx context.Context, in *SearchTripsRequest, opts ...grpc.CallOption) (*SearchTripsResponse, error)
 	// Recurring Schedules
@@ -246,6 +249,16 @@ func (c *catalogServiceClient) CancelTrip(ctx context.Context, in *CancelTripReq
 	return out, nil
 }
 
+func (c *catalogServiceClient) ReassignTripVehicle(ctx context.Context, in *ReassignTripVehicleRequest, opts ...grpc.CallOption) (*Trip, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trip)
+	err := c.cc.Invoke(ctx, CatalogService_ReassignTripVehicle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) SearchTrips(ctx context.Context, in *SearchTripsRequest, opts ...grpc.CallOption) (*SearchTripsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchTripsResponse)
@@ -390,6 +403,8 @@ type CatalogServiceServer interface {
 	ListTrips(context.Context, *ListTripsRequest) (*ListTripsResponse, error)
 	UpdateTrip(context.Context, *UpdateTripRequest) (*Trip, error)
 	CancelTrip(context.Context, *CancelTripRequest) (*Trip, error)
+	// Swap the vehicle running a trip; inventory moves the booked passengers onto its seats
+	ReassignTripVehicle(context.Context, *ReassignTripVehicleRequest) (*Trip, error)
 	// Search (for frontend)
 	SearchTrips(context.Context, *SearchTripsRequest) (*SearchTripsResponse, error)
 	// Recurring Schedules
@@ -459,6 +474,9 @@ func (UnimplementedCatalogServiceServer) UpdateTrip(context.Context, *UpdateTrip
 func (UnimplementedCatalogServiceServer) CancelTrip(context.Context, *CancelTripRequest) (*Trip, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelTrip not implemented")
 }
+func (UnimplementedCatalogServiceServer) ReassignTripVehicle(context.Context, *ReassignTripVehicleRequest) (*Trip, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignTripVehicle not implemented")
+}
 func (UnimplementedCatalogServiceServer) SearchTrips(context.Context, *SearchTripsRequest) (*SearchTripsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SearchTrips not implemented")
 }
@@ -786,6 +804,24 @@ func _CatalogService_CancelTrip_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_ReassignTripVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignTripVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ReassignTripVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ReassignTripVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ReassignTripVehicle(ctx, req.(*ReassignTripVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_SearchTrips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchTripsRequest)
 	if err := dec(in); err != nil {
@@ -1069,6 +1105,10 @@ var CatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelTrip",
 			Handler:    _CatalogService_CancelTrip_Handler,
 		},
+		{
+			MethodName: "ReassignTripVehicle",
+			Handler:    _CatalogService_ReassignTripVehicle_Handler,
+		},
 		{
 			MethodName: "SearchTrips",
 			Handler:    _CatalogService_SearchTrips_Handler,
@@ -1119,5 +1159,5 @@ var CatalogService_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "catalog/v1/catalog.proto",
+	Metadata: "api/proto/catalog/v1/catalog.proto",
 }
@@ -665,6 +665,7 @@ type BusConfig struct {
 	HasToilet      bool                   `protobuf:"varint,4,opt,name=has_toilet,json=hasToilet,proto3" json:"has_toilet,omitempty"`
 	HasSleeper     bool                   `protobuf:"varint,5,opt,name=has_sleeper,json=hasSleeper,proto3" json:"has_sleeper,omitempty"` // For overnight buses with berths
 	Categories     []*SeatCategory        `protobuf:"bytes,6,rep,name=categories,proto3" json:"categories,omitempty"`                    // Different seat tiers
+	Decks          int32                  `protobuf:"varint,7,opt,name=decks,proto3" json:"decks,omitempty"`                             // 2 for double-decker sleepers; 0 or 1 is a single deck
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -741,6 +742,13 @@ func (x *BusConfig) GetCategories() []*SeatCategory {
 	return nil
 }
 
+func (x *BusConfig) GetDecks() int32 {
+	if x != nil {
+		return x.Decks
+	}
+	return 0
+}
+
 type SeatCategory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // "Economy", "Business", "VIP"
@@ -847,17 +855,18 @@ func (x *TrainConfig) GetCoaches() []*TrainCoach {
 }
 
 type TrainCoach struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // e.g., "S1", "AC1", "B2"
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // "Shatabdi Chair Car", "Sleeper Coach"
-	Class         TrainCoachClass        `protobuf:"varint,3,opt,name=class,proto3,enum=fleet.v1.TrainCoachClass" json:"class,omitempty"`
-	Rows          int32                  `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`                                    // Number of compartment rows
-	SeatsPerRow   int32                  `protobuf:"varint,5,opt,name=seats_per_row,json=seatsPerRow,proto3" json:"seats_per_row,omitempty"` // 4 for 2+2, 6 for 3+3, 8 for 4+4
-	HasBerths     bool                   `protobuf:"varint,6,opt,name=has_berths,json=hasBerths,proto3" json:"has_berths,omitempty"`         // For sleeper coaches
-	BerthConfig   *BerthConfiguration    `protobuf:"bytes,7,opt,name=berth_config,json=berthConfig,proto3" json:"berth_config,omitempty"`
-	PricePaisa    int32                  `protobuf:"varint,8,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"` // Base price for this coach class
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // e.g., "S1", "AC1", "B2"
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // "Shatabdi Chair Car", "Sleeper Coach"
+	Class            TrainCoachClass        `protobuf:"varint,3,opt,name=class,proto3,enum=fleet.v1.TrainCoachClass" json:"class,omitempty"`
+	Rows             int32                  `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`                                    // Number of compartment rows
+	SeatsPerRow      int32                  `protobuf:"varint,5,opt,name=seats_per_row,json=seatsPerRow,proto3" json:"seats_per_row,omitempty"` // 4 for 2+2, 6 for 3+3, 8 for 4+4
+	HasBerths        bool                   `protobuf:"varint,6,opt,name=has_berths,json=hasBerths,proto3" json:"has_berths,omitempty"`         // For sleeper coaches
+	BerthConfig      *BerthConfiguration    `protobuf:"bytes,7,opt,name=berth_config,json=berthConfig,proto3" json:"berth_config,omitempty"`
+	PricePaisa       int32                  `protobuf:"varint,8,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`                   // Base price for this coach class
+	StandingCapacity int32                  `protobuf:"varint,9,opt,name=standing_capacity,json=standingCapacity,proto3" json:"standing_capacity,omitempty"` // Standing tickets sold on top of the seats
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *TrainCoach) Reset() {
@@ -946,6 +955,13 @@ func (x *TrainCoach) GetPricePaisa() int32 {
 	return 0
 }
 
+func (x *TrainCoach) GetStandingCapacity() int32 {
+	if x != nil {
+		return x.StandingCapacity
+	}
+	return 0
+}
+
 type BerthConfiguration struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	Type                 BerthType              `protobuf:"varint,1,opt,name=type,proto3,enum=fleet.v1.BerthType" json:"type,omitempty"`
@@ -1061,9 +1077,12 @@ type LaunchDeck struct {
 	Cols           int32 `protobuf:"varint,5,opt,name=cols,proto3" json:"cols,omitempty"`
 	SeatPricePaisa int32 `protobuf:"varint,6,opt,name=seat_price_paisa,json=seatPricePaisa,proto3" json:"seat_price_paisa,omitempty"`
 	// For cabin-based (VIP)
-	Cabins        []*LaunchCabin `protobuf:"bytes,7,rep,name=cabins,proto3" json:"cabins,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Cabins []*LaunchCabin `protobuf:"bytes,7,rep,name=cabins,proto3" json:"cabins,omitempty"`
+	// For unnumbered deck passengers
+	DeckCapacity   int32 `protobuf:"varint,8,opt,name=deck_capacity,json=deckCapacity,proto3" json:"deck_capacity,omitempty"`
+	DeckPricePaisa int32 `protobuf:"varint,9,opt,name=deck_price_paisa,json=deckPricePaisa,proto3" json:"deck_price_paisa,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *LaunchDeck) Reset() {
@@ -1145,6 +1164,20 @@ func (x *LaunchDeck) GetCabins() []*LaunchCabin {
 	return nil
 }
 
+func (x *LaunchDeck) GetDeckCapacity() int32 {
+	if x != nil {
+		return x.DeckCapacity
+	}
+	return 0
+}
+
+func (x *LaunchDeck) GetDeckPricePaisa() int32 {
+	if x != nil {
+		return x.DeckPricePaisa
+	}
+	return 0
+}
+
 type LaunchCabin struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                    // "C1", "C2"
@@ -1803,7 +1836,7 @@ const file_api_proto_fleet_v1_fleet_proto_rawDesc = "" +
 	"\x06launch\x18\x03 \x01(\v2\x16.fleet.v1.LaunchConfigH\x00R\x06launch\x12\x1a\n" +
 	"\bfeatures\x18\n" +
 	" \x03(\tR\bfeaturesB\b\n" +
-	"\x06layout\"\xe5\x01\n" +
+	"\x06layout\"\xfb\x01\n" +
 	"\tBusConfig\x12\x12\n" +
 	"\x04rows\x18\x01 \x01(\x05R\x04rows\x12\"\n" +
 	"\rseats_per_row\x18\x02 \x01(\x05R\vseatsPerRow\x12(\n" +
@@ -1814,14 +1847,15 @@ const file_api_proto_fleet_v1_fleet_proto_rawDesc = "" +
 	"hasSleeper\x126\n" +
 	"\n" +
 	"categories\x18\x06 \x03(\v2\x16.fleet.v1.SeatCategoryR\n" +
-	"categories\"^\n" +
+	"categories\x12\x14\n" +
+	"\x05decks\x18\a \x01(\x05R\x05decks\"^\n" +
 	"\fSeatCategory\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
 	"\vprice_paisa\x18\x02 \x01(\x05R\n" +
 	"pricePaisa\x12\x19\n" +
 	"\bseat_ids\x18\x03 \x03(\tR\aseatIds\"=\n" +
 	"\vTrainConfig\x12.\n" +
-	"\acoaches\x18\x01 \x03(\v2\x14.fleet.v1.TrainCoachR\acoaches\"\x9a\x02\n" +
+	"\acoaches\x18\x01 \x03(\v2\x14.fleet.v1.TrainCoachR\acoaches\"\xc7\x02\n" +
 	"\n" +
 	"TrainCoach\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -1833,13 +1867,14 @@ const file_api_proto_fleet_v1_fleet_proto_rawDesc = "" +
 	"has_berths\x18\x06 \x01(\bR\thasBerths\x12?\n" +
 	"\fberth_config\x18\a \x01(\v2\x1c.fleet.v1.BerthConfigurationR\vberthConfig\x12\x1f\n" +
 	"\vprice_paisa\x18\b \x01(\x05R\n" +
-	"pricePaisa\"\x9b\x01\n" +
+	"pricePaisa\x12+\n" +
+	"\x11standing_capacity\x18\t \x01(\x05R\x10standingCapacity\"\x9b\x01\n" +
 	"\x12BerthConfiguration\x12'\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x13.fleet.v1.BerthTypeR\x04type\x124\n" +
 	"\x16berths_per_compartment\x18\x02 \x01(\x05R\x14berthsPerCompartment\x12&\n" +
 	"\x0fhas_side_berths\x18\x03 \x01(\bR\rhasSideBerths\":\n" +
 	"\fLaunchConfig\x12*\n" +
-	"\x05decks\x18\x01 \x03(\v2\x14.fleet.v1.LaunchDeckR\x05decks\"\xdf\x01\n" +
+	"\x05decks\x18\x01 \x03(\v2\x14.fleet.v1.LaunchDeckR\x05decks\"\xae\x02\n" +
 	"\n" +
 	"LaunchDeck\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -1848,7 +1883,9 @@ const file_api_proto_fleet_v1_fleet_proto_rawDesc = "" +
 	"\x04rows\x18\x04 \x01(\x05R\x04rows\x12\x12\n" +
 	"\x04cols\x18\x05 \x01(\x05R\x04cols\x12(\n" +
 	"\x10seat_price_paisa\x18\x06 \x01(\x05R\x0eseatPricePaisa\x12-\n" +
-	"\x06cabins\x18\a \x03(\v2\x15.fleet.v1.LaunchCabinR\x06cabins\"\x81\x01\n" +
+	"\x06cabins\x18\a \x03(\v2\x15.fleet.v1.LaunchCabinR\x06cabins\x12#\n" +
+	"\rdeck_capacity\x18\b \x01(\x05R\fdeckCapacity\x12(\n" +
+	"\x10deck_price_paisa\x18\t \x01(\x05R\x0edeckPricePaisa\"\x81\x01\n" +
 	"\vLaunchCabin\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -121,8 +121,10 @@ type AnalyzeBookingRequest struct {
 	BookingsLast_24Hours int32 `protobuf:"varint,13,opt,name=bookings_last_24_hours,json=bookingsLast24Hours,proto3" json:"bookings_last_24_hours,omitempty"`
 	BookingsLastWeek     int32 `protobuf:"varint,14,opt,name=bookings_last_week,json=bookingsLastWeek,proto3" json:"bookings_last_week,omitempty"`
 	PreviousFraudFlags   int32 `protobuf:"varint,15,opt,name=previous_fraud_flags,json=previousFraudFlags,proto3" json:"previous_fraud_flags,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Client device fingerprint, when the frontend sends one
+	DeviceFingerprint string `protobuf:"bytes,16,opt,name=device_fingerprint,json=deviceFingerprint,proto3" json:"device_fingerprint,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *AnalyzeBookingRequest) Reset() {
@@ -260,6 +262,13 @@ func (x *AnalyzeBookingRequest) GetPreviousFraudFlags() int32 {
 	return 0
 }
 
+func (x *AnalyzeBookingRequest) GetDeviceFingerprint() string {
+	if x != nil {
+		return x.DeviceFingerprint
+	}
+	return ""
+}
+
 type AnalyzeBookingResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Risk score from 0-100 (higher = more risky)
@@ -617,7 +626,7 @@ const file_api_proto_fraud_v1_fraud_proto_rawDesc = "" +
 	"\x1eapi/proto/fraud/v1/fraud.proto\x12\bfraud.v1\"\x0f\n" +
 	"\rHealthRequest\"(\n" +
 	"\x0eHealthResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"\xdb\x04\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\x8a\x05\n" +
 	"\x15AnalyzeBookingRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x17\n" +
@@ -636,7 +645,8 @@ const file_api_proto_fraud_v1_fraud_proto_rawDesc = "" +
 	"\x12total_amount_paisa\x18\f \x01(\x03R\x10totalAmountPaisa\x123\n" +
 	"\x16bookings_last_24_hours\x18\r \x01(\x05R\x13bookingsLast24Hours\x12,\n" +
 	"\x12bookings_last_week\x18\x0e \x01(\x05R\x10bookingsLastWeek\x120\n" +
-	"\x14previous_fraud_flags\x18\x0f \x01(\x05R\x12previousFraudFlags\"\x82\x02\n" +
+	"\x14previous_fraud_flags\x18\x0f \x01(\x05R\x12previousFraudFlags\x12-\n" +
+	"\x12device_fingerprint\x18\x10 \x01(\tR\x11deviceFingerprint\"\x82\x02\n" +
 	"\x16AnalyzeBookingResponse\x12\x1d\n" +
 	"\n" +
 	"risk_score\x18\x01 \x01(\x05R\triskScore\x12\x1d\n" +
@@ -105,9 +105,11 @@ type Ticket struct {
 	CreatedAt  int64        `protobuf:"varint,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	ValidUntil int64        `protobuf:"varint,21,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
 	// Boarding
-	IsBoarded     bool   `protobuf:"varint,22,opt,name=is_boarded,json=isBoarded,proto3" json:"is_boarded,omitempty"`
-	BoardedAt     int64  `protobuf:"varint,23,opt,name=boarded_at,json=boardedAt,proto3" json:"boarded_at,omitempty"`
-	BoardedBy     string `protobuf:"bytes,24,opt,name=boarded_by,json=boardedBy,proto3" json:"boarded_by,omitempty"`
+	IsBoarded bool   `protobuf:"varint,22,opt,name=is_boarded,json=isBoarded,proto3" json:"is_boarded,omitempty"`
+	BoardedAt int64  `protobuf:"varint,23,opt,name=boarded_at,json=boardedAt,proto3" json:"boarded_at,omitempty"`
+	BoardedBy string `protobuf:"bytes,24,opt,name=boarded_by,json=boardedBy,proto3" json:"boarded_by,omitempty"`
+	// Booking reference of the order
+	Pnr           string `protobuf:"bytes,25,opt,name=pnr,proto3" json:"pnr,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -310,6 +312,13 @@ func (x *Ticket) GetBoardedBy() string {
 	return ""
 }
 
+func (x *Ticket) GetPnr() string {
+	if x != nil {
+		return x.Pnr
+	}
+	return ""
+}
+
 type GenerateTicketsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	BookingId      string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
@@ -324,6 +333,7 @@ type GenerateTicketsRequest struct {
 	Passengers     []*PassengerSeat       `protobuf:"bytes,10,rep,name=passengers,proto3" json:"passengers,omitempty"`
 	ContactEmail   string                 `protobuf:"bytes,11,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
 	ContactPhone   string                 `protobuf:"bytes,12,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	Pnr            string                 `protobuf:"bytes,13,opt,name=pnr,proto3" json:"pnr,omitempty"` // Booking reference of the order
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -442,6 +452,13 @@ func (x *GenerateTicketsRequest) GetContactPhone() string {
 	return ""
 }
 
+func (x *GenerateTicketsRequest) GetPnr() string {
+	if x != nil {
+		return x.Pnr
+	}
+	return ""
+}
+
 type PassengerSeat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Nid           string                 `protobuf:"bytes,1,opt,name=nid,proto3" json:"nid,omitempty"`
@@ -1202,7 +1219,7 @@ var File_api_proto_fulfillment_v1_fulfillment_proto protoreflect.FileDescriptor
 
 const file_api_proto_fulfillment_v1_fulfillment_proto_rawDesc = "" +
 	"\n" +
-	"*api/proto/fulfillment/v1/fulfillment.proto\x12\x0efulfillment.v1\"\x9d\x06\n" +
+	"*api/proto/fulfillment/v1/fulfillment.proto\x12\x0efulfillment.v1\"\xaf\x06\n" +
 	"\x06Ticket\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1240,7 +1257,8 @@ const file_api_proto_fulfillment_v1_fulfillment_proto_rawDesc = "" +
 	"\n" +
 	"boarded_at\x18\x17 \x01(\x03R\tboardedAt\x12\x1d\n" +
 	"\n" +
-	"boarded_by\x18\x18 \x01(\tR\tboardedBy\"\xc8\x03\n" +
+	"boarded_by\x18\x18 \x01(\tR\tboardedBy\x12\x10\n" +
+	"\x03pnr\x18\x19 \x01(\tR\x03pnr\"\xda\x03\n" +
 	"\x16GenerateTicketsRequest\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x19\n" +
@@ -1259,7 +1277,8 @@ const file_api_proto_fulfillment_v1_fulfillment_proto_rawDesc = "" +
 	" \x03(\v2\x1d.fulfillment.v1.PassengerSeatR\n" +
 	"passengers\x12#\n" +
 	"\rcontact_email\x18\v \x01(\tR\fcontactEmail\x12#\n" +
-	"\rcontact_phone\x18\f \x01(\tR\fcontactPhone\"\xaf\x01\n" +
+	"\rcontact_phone\x18\f \x01(\tR\fcontactPhone\x12\x10\n" +
+	"\x03pnr\x18\r \x01(\tR\x03pnr\"\xaf\x01\n" +
 	"\rPassengerSeat\x12\x10\n" +
 	"\x03nid\x18\x01 \x01(\tR\x03nid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
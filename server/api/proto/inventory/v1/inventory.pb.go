@@ -77,15 +77,18 @@ func (SeatStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type CheckAvailabilityRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	FromStationId  string                 `protobuf:"bytes,2,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"` // Boarding station
-	ToStationId    string                 `protobuf:"bytes,3,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`       // Alighting station
-	Passengers     int32                  `protobuf:"varint,4,opt,name=passengers,proto3" json:"passengers,omitempty"`
-	SeatClass      string                 `protobuf:"bytes,5,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"` // Optional: filter by class
-	OrganizationId string                 `protobuf:"bytes,6,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TripId          string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	FromStationId   string                 `protobuf:"bytes,2,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"` // Boarding station
+	ToStationId     string                 `protobuf:"bytes,3,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`       // Alighting station
+	Passengers      int32                  `protobuf:"varint,4,opt,name=passengers,proto3" json:"passengers,omitempty"`
+	SeatClass       string                 `protobuf:"bytes,5,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"` // Optional: filter by class
+	OrganizationId  string                 `protobuf:"bytes,6,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	QuotaClaims     *QuotaClaims           `protobuf:"bytes,7,opt,name=quota_claims,json=quotaClaims,proto3" json:"quota_claims,omitempty"`                // Quota seats are only counted for eligible callers
+	AllowSeatChange bool                   `protobuf:"varint,8,opt,name=allow_seat_change,json=allowSeatChange,proto3" json:"allow_seat_change,omitempty"` // Propose seat changes when no single seat covers the journey
+	CapacityItems   []*CapacityItem        `protobuf:"bytes,9,rep,name=capacity_items,json=capacityItems,proto3" json:"capacity_items,omitempty"`          // Unnumbered places wanted alongside the seats
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CheckAvailabilityRequest) Reset() {
@@ -160,15 +163,39 @@ func (x *CheckAvailabilityRequest) GetOrganizationId() string {
 	return ""
 }
 
+func (x *CheckAvailabilityRequest) GetQuotaClaims() *QuotaClaims {
+	if x != nil {
+		return x.QuotaClaims
+	}
+	return nil
+}
+
+func (x *CheckAvailabilityRequest) GetAllowSeatChange() bool {
+	if x != nil {
+		return x.AllowSeatChange
+	}
+	return false
+}
+
+func (x *CheckAvailabilityRequest) GetCapacityItems() []*CapacityItem {
+	if x != nil {
+		return x.CapacityItems
+	}
+	return nil
+}
+
 type CheckAvailabilityResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	IsAvailable    bool                   `protobuf:"varint,1,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
-	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
-	Seats          []*SeatAvailability    `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"` // Detailed per-seat info
-	PricePaisa     int64                  `protobuf:"varint,4,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
-	CheckedAt      int64                  `protobuf:"varint,5,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // For cache invalidation
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	IsAvailable     bool                    `protobuf:"varint,1,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	AvailableSeats  int32                   `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	Seats           []*SeatAvailability     `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"` // Detailed per-seat info
+	PricePaisa      int64                   `protobuf:"varint,4,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
+	CheckedAt       int64                   `protobuf:"varint,5,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // For cache invalidation
+	Quotas          []*QuotaAvailability    `protobuf:"bytes,6,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	SeatChangePlans []*SeatChangePlan       `protobuf:"bytes,7,rep,name=seat_change_plans,json=seatChangePlans,proto3" json:"seat_change_plans,omitempty"` // One per passenger, only with allow_seat_change
+	Capacity        []*CapacityAvailability `protobuf:"bytes,8,rep,name=capacity,proto3" json:"capacity,omitempty"`                                        // Deck and standing places free for the journey
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CheckAvailabilityResponse) Reset() {
@@ -236,32 +263,50 @@ func (x *CheckAvailabilityResponse) GetCheckedAt() int64 {
 	return 0
 }
 
-type SeatAvailability struct {
+func (x *CheckAvailabilityResponse) GetQuotas() []*QuotaAvailability {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+func (x *CheckAvailabilityResponse) GetSeatChangePlans() []*SeatChangePlan {
+	if x != nil {
+		return x.SeatChangePlans
+	}
+	return nil
+}
+
+func (x *CheckAvailabilityResponse) GetCapacity() []*CapacityAvailability {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+// CapacityItem is a quantity of unnumbered places of one class, one passenger each
+type CapacityItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
-	SeatNumber    string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	SeatClass     string                 `protobuf:"bytes,3,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
-	Status        SeatStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
-	HoldExpiresAt int64                  `protobuf:"varint,5,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"` // If held, when does it expire
-	SeatType      string                 `protobuf:"bytes,6,opt,name=seat_type,json=seatType,proto3" json:"seat_type,omitempty"`                   // window, aisle, middle
+	CapacityClass string                 `protobuf:"bytes,1,opt,name=capacity_class,json=capacityClass,proto3" json:"capacity_class,omitempty"` // deck, standing
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SeatAvailability) Reset() {
-	*x = SeatAvailability{}
+func (x *CapacityItem) Reset() {
+	*x = CapacityItem{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeatAvailability) String() string {
+func (x *CapacityItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeatAvailability) ProtoMessage() {}
+func (*CapacityItem) ProtoMessage() {}
 
-func (x *SeatAvailability) ProtoReflect() protoreflect.Message {
+func (x *CapacityItem) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -273,74 +318,49 @@ func (x *SeatAvailability) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeatAvailability.ProtoReflect.Descriptor instead.
-func (*SeatAvailability) Descriptor() ([]byte, []int) {
+// Deprecated: Use CapacityItem.ProtoReflect.Descriptor instead.
+func (*CapacityItem) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *SeatAvailability) GetSeatId() string {
-	if x != nil {
-		return x.SeatId
-	}
-	return ""
-}
-
-func (x *SeatAvailability) GetSeatNumber() string {
-	if x != nil {
-		return x.SeatNumber
-	}
-	return ""
-}
-
-func (x *SeatAvailability) GetSeatClass() string {
+func (x *CapacityItem) GetCapacityClass() string {
 	if x != nil {
-		return x.SeatClass
+		return x.CapacityClass
 	}
 	return ""
 }
 
-func (x *SeatAvailability) GetStatus() SeatStatus {
-	if x != nil {
-		return x.Status
-	}
-	return SeatStatus_SEAT_STATUS_UNSPECIFIED
-}
-
-func (x *SeatAvailability) GetHoldExpiresAt() int64 {
+func (x *CapacityItem) GetQuantity() int32 {
 	if x != nil {
-		return x.HoldExpiresAt
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *SeatAvailability) GetSeatType() string {
-	if x != nil {
-		return x.SeatType
-	}
-	return ""
-}
-
-type BatchCheckRequest struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Requests      []*CheckAvailabilityRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+type CapacityAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CapacityClass string                 `protobuf:"bytes,1,opt,name=capacity_class,json=capacityClass,proto3" json:"capacity_class,omitempty"`
+	MaxCount      int32                  `protobuf:"varint,2,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	Available     int32                  `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"` // Free on every segment of the journey
+	PricePaisa    int64                  `protobuf:"varint,4,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchCheckRequest) Reset() {
-	*x = BatchCheckRequest{}
+func (x *CapacityAvailability) Reset() {
+	*x = CapacityAvailability{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchCheckRequest) String() string {
+func (x *CapacityAvailability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCheckRequest) ProtoMessage() {}
+func (*CapacityAvailability) ProtoMessage() {}
 
-func (x *BatchCheckRequest) ProtoReflect() protoreflect.Message {
+func (x *CapacityAvailability) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -352,39 +372,63 @@ func (x *BatchCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCheckRequest.ProtoReflect.Descriptor instead.
-func (*BatchCheckRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CapacityAvailability.ProtoReflect.Descriptor instead.
+func (*CapacityAvailability) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *BatchCheckRequest) GetRequests() []*CheckAvailabilityRequest {
+func (x *CapacityAvailability) GetCapacityClass() string {
 	if x != nil {
-		return x.Requests
+		return x.CapacityClass
 	}
-	return nil
+	return ""
 }
 
-type BatchCheckResponse struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Results       []*CheckAvailabilityResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CapacityAvailability) GetMaxCount() int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
 }
 
-func (x *BatchCheckResponse) Reset() {
-	*x = BatchCheckResponse{}
+func (x *CapacityAvailability) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *CapacityAvailability) GetPricePaisa() int64 {
+	if x != nil {
+		return x.PricePaisa
+	}
+	return 0
+}
+
+// SeatChangePlan seats one passenger for the whole journey using as few seat changes as possible
+type SeatChangePlan struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PassengerIndex int32                  `protobuf:"varint,1,opt,name=passenger_index,json=passengerIndex,proto3" json:"passenger_index,omitempty"`
+	Legs           []*SeatLeg             `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	PricePaisa     int64                  `protobuf:"varint,3,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SeatChangePlan) Reset() {
+	*x = SeatChangePlan{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchCheckResponse) String() string {
+func (x *SeatChangePlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCheckResponse) ProtoMessage() {}
+func (*SeatChangePlan) ProtoMessage() {}
 
-func (x *BatchCheckResponse) ProtoReflect() protoreflect.Message {
+func (x *SeatChangePlan) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -396,46 +440,60 @@ func (x *BatchCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCheckResponse.ProtoReflect.Descriptor instead.
-func (*BatchCheckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeatChangePlan.ProtoReflect.Descriptor instead.
+func (*SeatChangePlan) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *BatchCheckResponse) GetResults() []*CheckAvailabilityResponse {
+func (x *SeatChangePlan) GetPassengerIndex() int32 {
 	if x != nil {
-		return x.Results
+		return x.PassengerIndex
+	}
+	return 0
+}
+
+func (x *SeatChangePlan) GetLegs() []*SeatLeg {
+	if x != nil {
+		return x.Legs
 	}
 	return nil
 }
 
-type HoldSeatsRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	TripId              string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	FromStationId       string                 `protobuf:"bytes,2,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
-	ToStationId         string                 `protobuf:"bytes,3,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
-	SeatIds             []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"` // Specific seats to hold
-	UserId              string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SessionId           string                 `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	HoldDurationSeconds int32                  `protobuf:"varint,7,opt,name=hold_duration_seconds,json=holdDurationSeconds,proto3" json:"hold_duration_seconds,omitempty"` // Default: 600 (10 min)
-	OrganizationId      string                 `protobuf:"bytes,8,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *SeatChangePlan) GetPricePaisa() int64 {
+	if x != nil {
+		return x.PricePaisa
+	}
+	return 0
 }
 
-func (x *HoldSeatsRequest) Reset() {
-	*x = HoldSeatsRequest{}
+// SeatLeg is the part of a journey spent in one seat
+type SeatLeg struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SeatId         string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	SeatNumber     string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	SeatClass      string                 `protobuf:"bytes,3,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	FromStationId  string                 `protobuf:"bytes,4,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId    string                 `protobuf:"bytes,5,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	SegmentIndexes []int32                `protobuf:"varint,6,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"`
+	PricePaisa     int64                  `protobuf:"varint,7,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"` // Fare prorated by segments covered
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SeatLeg) Reset() {
+	*x = SeatLeg{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HoldSeatsRequest) String() string {
+func (x *SeatLeg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HoldSeatsRequest) ProtoMessage() {}
+func (*SeatLeg) ProtoMessage() {}
 
-func (x *HoldSeatsRequest) ProtoReflect() protoreflect.Message {
+func (x *SeatLeg) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -447,93 +505,86 @@ func (x *HoldSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HoldSeatsRequest.ProtoReflect.Descriptor instead.
-func (*HoldSeatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeatLeg.ProtoReflect.Descriptor instead.
+func (*SeatLeg) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *HoldSeatsRequest) GetTripId() string {
+func (x *SeatLeg) GetSeatId() string {
 	if x != nil {
-		return x.TripId
+		return x.SeatId
 	}
 	return ""
 }
 
-func (x *HoldSeatsRequest) GetFromStationId() string {
+func (x *SeatLeg) GetSeatNumber() string {
 	if x != nil {
-		return x.FromStationId
+		return x.SeatNumber
 	}
 	return ""
 }
 
-func (x *HoldSeatsRequest) GetToStationId() string {
+func (x *SeatLeg) GetSeatClass() string {
 	if x != nil {
-		return x.ToStationId
+		return x.SeatClass
 	}
 	return ""
 }
 
-func (x *HoldSeatsRequest) GetSeatIds() []string {
-	if x != nil {
-		return x.SeatIds
-	}
-	return nil
-}
-
-func (x *HoldSeatsRequest) GetUserId() string {
+func (x *SeatLeg) GetFromStationId() string {
 	if x != nil {
-		return x.UserId
+		return x.FromStationId
 	}
 	return ""
 }
 
-func (x *HoldSeatsRequest) GetSessionId() string {
+func (x *SeatLeg) GetToStationId() string {
 	if x != nil {
-		return x.SessionId
+		return x.ToStationId
 	}
 	return ""
 }
 
-func (x *HoldSeatsRequest) GetHoldDurationSeconds() int32 {
+func (x *SeatLeg) GetSegmentIndexes() []int32 {
 	if x != nil {
-		return x.HoldDurationSeconds
+		return x.SegmentIndexes
 	}
-	return 0
+	return nil
 }
 
-func (x *HoldSeatsRequest) GetOrganizationId() string {
+func (x *SeatLeg) GetPricePaisa() int64 {
 	if x != nil {
-		return x.OrganizationId
+		return x.PricePaisa
 	}
-	return ""
+	return 0
 }
 
-type HoldSeatsResponse struct {
+// QuotaClaims describes what the caller is entitled to buy from reserved quotas
+type QuotaClaims struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	HeldSeatIds   []string               `protobuf:"bytes,3,rep,name=held_seat_ids,json=heldSeatIds,proto3" json:"held_seat_ids,omitempty"`
-	FailedSeatIds []string               `protobuf:"bytes,4,rep,name=failed_seat_ids,json=failedSeatIds,proto3" json:"failed_seat_ids,omitempty"`
-	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	FailureReason string                 `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	Female        bool                   `protobuf:"varint,1,opt,name=female,proto3" json:"female,omitempty"`
+	Disabled      bool                   `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"` // web, app, counter, partner
+	PartnerId     string                 `protobuf:"bytes,4,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Vip           bool                   `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HoldSeatsResponse) Reset() {
-	*x = HoldSeatsResponse{}
+func (x *QuotaClaims) Reset() {
+	*x = QuotaClaims{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HoldSeatsResponse) String() string {
+func (x *QuotaClaims) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HoldSeatsResponse) ProtoMessage() {}
+func (*QuotaClaims) ProtoMessage() {}
 
-func (x *HoldSeatsResponse) ProtoReflect() protoreflect.Message {
+func (x *QuotaClaims) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -545,76 +596,71 @@ func (x *HoldSeatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HoldSeatsResponse.ProtoReflect.Descriptor instead.
-func (*HoldSeatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuotaClaims.ProtoReflect.Descriptor instead.
+func (*QuotaClaims) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *HoldSeatsResponse) GetHoldId() string {
-	if x != nil {
-		return x.HoldId
-	}
-	return ""
-}
-
-func (x *HoldSeatsResponse) GetSuccess() bool {
+func (x *QuotaClaims) GetFemale() bool {
 	if x != nil {
-		return x.Success
+		return x.Female
 	}
 	return false
 }
 
-func (x *HoldSeatsResponse) GetHeldSeatIds() []string {
+func (x *QuotaClaims) GetDisabled() bool {
 	if x != nil {
-		return x.HeldSeatIds
+		return x.Disabled
 	}
-	return nil
+	return false
 }
 
-func (x *HoldSeatsResponse) GetFailedSeatIds() []string {
+func (x *QuotaClaims) GetChannel() string {
 	if x != nil {
-		return x.FailedSeatIds
+		return x.Channel
 	}
-	return nil
+	return ""
 }
 
-func (x *HoldSeatsResponse) GetExpiresAt() int64 {
+func (x *QuotaClaims) GetPartnerId() string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.PartnerId
 	}
-	return 0
+	return ""
 }
 
-func (x *HoldSeatsResponse) GetFailureReason() string {
+func (x *QuotaClaims) GetVip() bool {
 	if x != nil {
-		return x.FailureReason
+		return x.Vip
 	}
-	return ""
+	return false
 }
 
-type ReleaseSeatsRequest struct {
+type QuotaAvailability struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	HoldId         string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	QuotaId        string                 `protobuf:"bytes,1,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	QuotaType      string                 `protobuf:"bytes,3,opt,name=quota_type,json=quotaType,proto3" json:"quota_type,omitempty"` // ladies, disabled, counter, partner, vip
+	AvailableSeats int32                  `protobuf:"varint,4,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	Eligible       bool                   `protobuf:"varint,5,opt,name=eligible,proto3" json:"eligible,omitempty"` // Whether the caller may buy from this quota
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ReleaseSeatsRequest) Reset() {
-	*x = ReleaseSeatsRequest{}
+func (x *QuotaAvailability) Reset() {
+	*x = QuotaAvailability{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseSeatsRequest) String() string {
+func (x *QuotaAvailability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseSeatsRequest) ProtoMessage() {}
+func (*QuotaAvailability) ProtoMessage() {}
 
-func (x *ReleaseSeatsRequest) ProtoReflect() protoreflect.Message {
+func (x *QuotaAvailability) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -626,54 +672,72 @@ func (x *ReleaseSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseSeatsRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseSeatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuotaAvailability.ProtoReflect.Descriptor instead.
+func (*QuotaAvailability) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ReleaseSeatsRequest) GetHoldId() string {
+func (x *QuotaAvailability) GetQuotaId() string {
 	if x != nil {
-		return x.HoldId
+		return x.QuotaId
 	}
 	return ""
 }
 
-func (x *ReleaseSeatsRequest) GetUserId() string {
+func (x *QuotaAvailability) GetName() string {
 	if x != nil {
-		return x.UserId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ReleaseSeatsRequest) GetOrganizationId() string {
+func (x *QuotaAvailability) GetQuotaType() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.QuotaType
 	}
 	return ""
 }
 
-type ReleaseSeatsResponse struct {
+func (x *QuotaAvailability) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+func (x *QuotaAvailability) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+type SeatAvailability struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ReleasedCount int32                  `protobuf:"varint,2,opt,name=released_count,json=releasedCount,proto3" json:"released_count,omitempty"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	SeatNumber    string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	SeatClass     string                 `protobuf:"bytes,3,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	Status        SeatStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
+	HoldExpiresAt int64                  `protobuf:"varint,5,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"` // If held, when does it expire
+	SeatType      string                 `protobuf:"bytes,6,opt,name=seat_type,json=seatType,proto3" json:"seat_type,omitempty"`                   // window, aisle, middle
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseSeatsResponse) Reset() {
-	*x = ReleaseSeatsResponse{}
+func (x *SeatAvailability) Reset() {
+	*x = SeatAvailability{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseSeatsResponse) String() string {
+func (x *SeatAvailability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseSeatsResponse) ProtoMessage() {}
+func (*SeatAvailability) ProtoMessage() {}
 
-func (x *ReleaseSeatsResponse) ProtoReflect() protoreflect.Message {
+func (x *SeatAvailability) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -685,50 +749,74 @@ func (x *ReleaseSeatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseSeatsResponse.ProtoReflect.Descriptor instead.
-func (*ReleaseSeatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeatAvailability.ProtoReflect.Descriptor instead.
+func (*SeatAvailability) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ReleaseSeatsResponse) GetSuccess() bool {
+func (x *SeatAvailability) GetSeatId() string {
 	if x != nil {
-		return x.Success
+		return x.SeatId
 	}
-	return false
+	return ""
 }
 
-func (x *ReleaseSeatsResponse) GetReleasedCount() int32 {
+func (x *SeatAvailability) GetSeatNumber() string {
 	if x != nil {
-		return x.ReleasedCount
+		return x.SeatNumber
+	}
+	return ""
+}
+
+func (x *SeatAvailability) GetSeatClass() string {
+	if x != nil {
+		return x.SeatClass
+	}
+	return ""
+}
+
+func (x *SeatAvailability) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+func (x *SeatAvailability) GetHoldExpiresAt() int64 {
+	if x != nil {
+		return x.HoldExpiresAt
 	}
 	return 0
 }
 
-type ConfirmBookingRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	HoldId         string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
-	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Passengers     []*PassengerSeat       `protobuf:"bytes,4,rep,name=passengers,proto3" json:"passengers,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *SeatAvailability) GetSeatType() string {
+	if x != nil {
+		return x.SeatType
+	}
+	return ""
 }
 
-func (x *ConfirmBookingRequest) Reset() {
-	*x = ConfirmBookingRequest{}
+type BatchCheckRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Requests      []*CheckAvailabilityRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCheckRequest) Reset() {
+	*x = BatchCheckRequest{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmBookingRequest) String() string {
+func (x *BatchCheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmBookingRequest) ProtoMessage() {}
+func (*BatchCheckRequest) ProtoMessage() {}
 
-func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchCheckRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,69 +828,39 @@ func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmBookingRequest.ProtoReflect.Descriptor instead.
-func (*ConfirmBookingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCheckRequest.ProtoReflect.Descriptor instead.
+func (*BatchCheckRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ConfirmBookingRequest) GetHoldId() string {
-	if x != nil {
-		return x.HoldId
-	}
-	return ""
-}
-
-func (x *ConfirmBookingRequest) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
-}
-
-func (x *ConfirmBookingRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *ConfirmBookingRequest) GetPassengers() []*PassengerSeat {
+func (x *BatchCheckRequest) GetRequests() []*CheckAvailabilityRequest {
 	if x != nil {
-		return x.Passengers
+		return x.Requests
 	}
 	return nil
 }
 
-func (x *ConfirmBookingRequest) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
-}
-
-type PassengerSeat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
-	PassengerNid  string                 `protobuf:"bytes,2,opt,name=passenger_nid,json=passengerNid,proto3" json:"passenger_nid,omitempty"`
-	PassengerName string                 `protobuf:"bytes,3,opt,name=passenger_name,json=passengerName,proto3" json:"passenger_name,omitempty"`
+type BatchCheckResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Results       []*CheckAvailabilityResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PassengerSeat) Reset() {
-	*x = PassengerSeat{}
+func (x *BatchCheckResponse) Reset() {
+	*x = BatchCheckResponse{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PassengerSeat) String() string {
+func (x *BatchCheckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PassengerSeat) ProtoMessage() {}
+func (*BatchCheckResponse) ProtoMessage() {}
 
-func (x *PassengerSeat) ProtoReflect() protoreflect.Message {
+func (x *BatchCheckResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -814,56 +872,42 @@ func (x *PassengerSeat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PassengerSeat.ProtoReflect.Descriptor instead.
-func (*PassengerSeat) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCheckResponse.ProtoReflect.Descriptor instead.
+func (*BatchCheckResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PassengerSeat) GetSeatId() string {
-	if x != nil {
-		return x.SeatId
-	}
-	return ""
-}
-
-func (x *PassengerSeat) GetPassengerNid() string {
-	if x != nil {
-		return x.PassengerNid
-	}
-	return ""
-}
-
-func (x *PassengerSeat) GetPassengerName() string {
+func (x *BatchCheckResponse) GetResults() []*CheckAvailabilityResponse {
 	if x != nil {
-		return x.PassengerName
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-type ConfirmBookingResponse struct {
+type AvailabilityCountQuery struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	BookingId      string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	ConfirmedSeats []*ConfirmedSeat       `protobuf:"bytes,3,rep,name=confirmed_seats,json=confirmedSeats,proto3" json:"confirmed_seats,omitempty"`
-	FailureReason  string                 `protobuf:"bytes,4,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TripId         string                 `protobuf:"bytes,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	FromStationId  string                 `protobuf:"bytes,3,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"` // Empty with to_station_id: the whole route
+	ToStationId    string                 `protobuf:"bytes,4,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ConfirmBookingResponse) Reset() {
-	*x = ConfirmBookingResponse{}
+func (x *AvailabilityCountQuery) Reset() {
+	*x = AvailabilityCountQuery{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmBookingResponse) String() string {
+func (x *AvailabilityCountQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmBookingResponse) ProtoMessage() {}
+func (*AvailabilityCountQuery) ProtoMessage() {}
 
-func (x *ConfirmBookingResponse) ProtoReflect() protoreflect.Message {
+func (x *AvailabilityCountQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -875,62 +919,60 @@ func (x *ConfirmBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmBookingResponse.ProtoReflect.Descriptor instead.
-func (*ConfirmBookingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AvailabilityCountQuery.ProtoReflect.Descriptor instead.
+func (*AvailabilityCountQuery) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ConfirmBookingResponse) GetSuccess() bool {
+func (x *AvailabilityCountQuery) GetOrganizationId() string {
 	if x != nil {
-		return x.Success
+		return x.OrganizationId
 	}
-	return false
+	return ""
 }
 
-func (x *ConfirmBookingResponse) GetBookingId() string {
+func (x *AvailabilityCountQuery) GetTripId() string {
 	if x != nil {
-		return x.BookingId
+		return x.TripId
 	}
 	return ""
 }
 
-func (x *ConfirmBookingResponse) GetConfirmedSeats() []*ConfirmedSeat {
+func (x *AvailabilityCountQuery) GetFromStationId() string {
 	if x != nil {
-		return x.ConfirmedSeats
+		return x.FromStationId
 	}
-	return nil
+	return ""
 }
 
-func (x *ConfirmBookingResponse) GetFailureReason() string {
+func (x *AvailabilityCountQuery) GetToStationId() string {
 	if x != nil {
-		return x.FailureReason
+		return x.ToStationId
 	}
 	return ""
 }
 
-type ConfirmedSeat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
-	SeatNumber    string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	TicketId      string                 `protobuf:"bytes,3,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+type GetAvailabilityCountsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Queries       []*AvailabilityCountQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmedSeat) Reset() {
-	*x = ConfirmedSeat{}
+func (x *GetAvailabilityCountsRequest) Reset() {
+	*x = GetAvailabilityCountsRequest{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmedSeat) String() string {
+func (x *GetAvailabilityCountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmedSeat) ProtoMessage() {}
+func (*GetAvailabilityCountsRequest) ProtoMessage() {}
 
-func (x *ConfirmedSeat) ProtoReflect() protoreflect.Message {
+func (x *GetAvailabilityCountsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -942,56 +984,40 @@ func (x *ConfirmedSeat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmedSeat.ProtoReflect.Descriptor instead.
-func (*ConfirmedSeat) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAvailabilityCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityCountsRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ConfirmedSeat) GetSeatId() string {
-	if x != nil {
-		return x.SeatId
-	}
-	return ""
-}
-
-func (x *ConfirmedSeat) GetSeatNumber() string {
-	if x != nil {
-		return x.SeatNumber
-	}
-	return ""
-}
-
-func (x *ConfirmedSeat) GetTicketId() string {
+func (x *GetAvailabilityCountsRequest) GetQueries() []*AvailabilityCountQuery {
 	if x != nil {
-		return x.TicketId
+		return x.Queries
 	}
-	return ""
+	return nil
 }
 
-type GetSeatMapRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	FromStationId  string                 `protobuf:"bytes,2,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
-	ToStationId    string                 `protobuf:"bytes,3,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,4,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ClassAvailabilityCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatClass     string                 `protobuf:"bytes,1,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	Available     int32                  `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"` // Fewest free seats of the class on any segment of the journey
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSeatMapRequest) Reset() {
-	*x = GetSeatMapRequest{}
+func (x *ClassAvailabilityCount) Reset() {
+	*x = ClassAvailabilityCount{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSeatMapRequest) String() string {
+func (x *ClassAvailabilityCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSeatMapRequest) ProtoMessage() {}
+func (*ClassAvailabilityCount) ProtoMessage() {}
 
-func (x *GetSeatMapRequest) ProtoReflect() protoreflect.Message {
+func (x *ClassAvailabilityCount) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1003,65 +1029,53 @@ func (x *GetSeatMapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSeatMapRequest.ProtoReflect.Descriptor instead.
-func (*GetSeatMapRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClassAvailabilityCount.ProtoReflect.Descriptor instead.
+func (*ClassAvailabilityCount) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetSeatMapRequest) GetTripId() string {
+func (x *ClassAvailabilityCount) GetSeatClass() string {
 	if x != nil {
-		return x.TripId
+		return x.SeatClass
 	}
 	return ""
 }
 
-func (x *GetSeatMapRequest) GetFromStationId() string {
+func (x *ClassAvailabilityCount) GetAvailable() int32 {
 	if x != nil {
-		return x.FromStationId
+		return x.Available
 	}
-	return ""
+	return 0
 }
 
-func (x *GetSeatMapRequest) GetToStationId() string {
-	if x != nil {
-		return x.ToStationId
-	}
-	return ""
-}
-
-func (x *GetSeatMapRequest) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
-}
-
-type GetSeatMapResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VehicleId     string                 `protobuf:"bytes,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
-	VehicleType   string                 `protobuf:"bytes,2,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"`
-	TotalRows     int32                  `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
-	TotalColumns  int32                  `protobuf:"varint,4,opt,name=total_columns,json=totalColumns,proto3" json:"total_columns,omitempty"`
-	Rows          []*SeatRow             `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
-	Legend        *SeatMapLegend         `protobuf:"bytes,6,opt,name=legend,proto3" json:"legend,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AvailabilityCountResult struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	OrganizationId string                    `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TripId         string                    `protobuf:"bytes,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	FromStationId  string                    `protobuf:"bytes,3,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId    string                    `protobuf:"bytes,4,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	AvailableSeats int32                     `protobuf:"varint,5,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"` // Sum over classes
+	Classes        []*ClassAvailabilityCount `protobuf:"bytes,6,rep,name=classes,proto3" json:"classes,omitempty"`
+	Capacity       []*CapacityAvailability   `protobuf:"bytes,7,rep,name=capacity,proto3" json:"capacity,omitempty"` // Deck and standing places
+	Error          string                    `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`       // Set when the trip or stations are unknown
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetSeatMapResponse) Reset() {
-	*x = GetSeatMapResponse{}
+func (x *AvailabilityCountResult) Reset() {
+	*x = AvailabilityCountResult{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSeatMapResponse) String() string {
+func (x *AvailabilityCountResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSeatMapResponse) ProtoMessage() {}
+func (*AvailabilityCountResult) ProtoMessage() {}
 
-func (x *GetSeatMapResponse) ProtoReflect() protoreflect.Message {
+func (x *AvailabilityCountResult) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1073,75 +1087,88 @@ func (x *GetSeatMapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSeatMapResponse.ProtoReflect.Descriptor instead.
-func (*GetSeatMapResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AvailabilityCountResult.ProtoReflect.Descriptor instead.
+func (*AvailabilityCountResult) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetSeatMapResponse) GetVehicleId() string {
+func (x *AvailabilityCountResult) GetOrganizationId() string {
 	if x != nil {
-		return x.VehicleId
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GetSeatMapResponse) GetVehicleType() string {
+func (x *AvailabilityCountResult) GetTripId() string {
 	if x != nil {
-		return x.VehicleType
+		return x.TripId
 	}
 	return ""
 }
 
-func (x *GetSeatMapResponse) GetTotalRows() int32 {
+func (x *AvailabilityCountResult) GetFromStationId() string {
 	if x != nil {
-		return x.TotalRows
+		return x.FromStationId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSeatMapResponse) GetTotalColumns() int32 {
+func (x *AvailabilityCountResult) GetToStationId() string {
 	if x != nil {
-		return x.TotalColumns
+		return x.ToStationId
+	}
+	return ""
+}
+
+func (x *AvailabilityCountResult) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
 	}
 	return 0
 }
 
-func (x *GetSeatMapResponse) GetRows() []*SeatRow {
+func (x *AvailabilityCountResult) GetClasses() []*ClassAvailabilityCount {
 	if x != nil {
-		return x.Rows
+		return x.Classes
 	}
 	return nil
 }
 
-func (x *GetSeatMapResponse) GetLegend() *SeatMapLegend {
+func (x *AvailabilityCountResult) GetCapacity() []*CapacityAvailability {
 	if x != nil {
-		return x.Legend
+		return x.Capacity
 	}
 	return nil
 }
 
-type SeatRow struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RowNumber     int32                  `protobuf:"varint,1,opt,name=row_number,json=rowNumber,proto3" json:"row_number,omitempty"`
-	Seats         []*SeatCell            `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+func (x *AvailabilityCountResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetAvailabilityCountsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*AvailabilityCountResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // In query order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SeatRow) Reset() {
-	*x = SeatRow{}
+func (x *GetAvailabilityCountsResponse) Reset() {
+	*x = GetAvailabilityCountsResponse{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeatRow) String() string {
+func (x *GetAvailabilityCountsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeatRow) ProtoMessage() {}
+func (*GetAvailabilityCountsResponse) ProtoMessage() {}
 
-func (x *SeatRow) ProtoReflect() protoreflect.Message {
+func (x *GetAvailabilityCountsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1153,56 +1180,51 @@ func (x *SeatRow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeatRow.ProtoReflect.Descriptor instead.
-func (*SeatRow) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAvailabilityCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityCountsResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SeatRow) GetRowNumber() int32 {
-	if x != nil {
-		return x.RowNumber
-	}
-	return 0
-}
-
-func (x *SeatRow) GetSeats() []*SeatCell {
+func (x *GetAvailabilityCountsResponse) GetResults() []*AvailabilityCountResult {
 	if x != nil {
-		return x.Seats
+		return x.Results
 	}
 	return nil
 }
 
-type SeatCell struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
-	SeatNumber    string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	Column        int32                  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
-	SeatType      string                 `protobuf:"bytes,4,opt,name=seat_type,json=seatType,proto3" json:"seat_type,omitempty"` // window, aisle, middle, none (for gaps)
-	SeatClass     string                 `protobuf:"bytes,5,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
-	Status        SeatStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
-	PricePaisa    int64                  `protobuf:"varint,7,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
-	IsAccessible  bool                   `protobuf:"varint,8,opt,name=is_accessible,json=isAccessible,proto3" json:"is_accessible,omitempty"` // Wheelchair accessible
-	HasPower      bool                   `protobuf:"varint,9,opt,name=has_power,json=hasPower,proto3" json:"has_power,omitempty"`             // Power outlet
-	IsExitRow     bool                   `protobuf:"varint,10,opt,name=is_exit_row,json=isExitRow,proto3" json:"is_exit_row,omitempty"`
-	HoldExpiresAt int64                  `protobuf:"varint,11,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"` // Unix timestamp
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type HoldSeatsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TripId              string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	FromStationId       string                 `protobuf:"bytes,2,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId         string                 `protobuf:"bytes,3,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	SeatIds             []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"` // Specific seats to hold
+	UserId              string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId           string                 `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	HoldDurationSeconds int32                  `protobuf:"varint,7,opt,name=hold_duration_seconds,json=holdDurationSeconds,proto3" json:"hold_duration_seconds,omitempty"` // Default: 600 (10 min)
+	OrganizationId      string                 `protobuf:"bytes,8,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	SeatCount           int32                  `protobuf:"varint,9,opt,name=seat_count,json=seatCount,proto3" json:"seat_count,omitempty"` // Best-available mode: used when seat_ids is empty
+	Preferences         *SeatPreferences       `protobuf:"bytes,10,opt,name=preferences,proto3" json:"preferences,omitempty"`              // Best-available mode only
+	QuotaClaims         *QuotaClaims           `protobuf:"bytes,11,opt,name=quota_claims,json=quotaClaims,proto3" json:"quota_claims,omitempty"`
+	SeatLegs            []*SeatLegSelection    `protobuf:"bytes,12,rep,name=seat_legs,json=seatLegs,proto3" json:"seat_legs,omitempty"`                // Split-seat mode: replaces seat_ids
+	CapacityItems       []*CapacityItem        `protobuf:"bytes,13,rep,name=capacity_items,json=capacityItems,proto3" json:"capacity_items,omitempty"` // Deck or standing places, with or without seats
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *SeatCell) Reset() {
-	*x = SeatCell{}
+func (x *HoldSeatsRequest) Reset() {
+	*x = HoldSeatsRequest{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeatCell) String() string {
+func (x *HoldSeatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeatCell) ProtoMessage() {}
+func (*HoldSeatsRequest) ProtoMessage() {}
 
-func (x *SeatCell) ProtoReflect() protoreflect.Message {
+func (x *HoldSeatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1214,110 +1236,127 @@ func (x *SeatCell) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeatCell.ProtoReflect.Descriptor instead.
-func (*SeatCell) Descriptor() ([]byte, []int) {
+// Deprecated: Use HoldSeatsRequest.ProtoReflect.Descriptor instead.
+func (*HoldSeatsRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SeatCell) GetSeatId() string {
+func (x *HoldSeatsRequest) GetTripId() string {
 	if x != nil {
-		return x.SeatId
+		return x.TripId
 	}
 	return ""
 }
 
-func (x *SeatCell) GetSeatNumber() string {
+func (x *HoldSeatsRequest) GetFromStationId() string {
 	if x != nil {
-		return x.SeatNumber
+		return x.FromStationId
 	}
 	return ""
 }
 
-func (x *SeatCell) GetColumn() int32 {
+func (x *HoldSeatsRequest) GetToStationId() string {
 	if x != nil {
-		return x.Column
+		return x.ToStationId
 	}
-	return 0
+	return ""
 }
 
-func (x *SeatCell) GetSeatType() string {
+func (x *HoldSeatsRequest) GetSeatIds() []string {
 	if x != nil {
-		return x.SeatType
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *HoldSeatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *SeatCell) GetSeatClass() string {
+func (x *HoldSeatsRequest) GetSessionId() string {
 	if x != nil {
-		return x.SeatClass
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *SeatCell) GetStatus() SeatStatus {
+func (x *HoldSeatsRequest) GetHoldDurationSeconds() int32 {
 	if x != nil {
-		return x.Status
+		return x.HoldDurationSeconds
 	}
-	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *SeatCell) GetPricePaisa() int64 {
+func (x *HoldSeatsRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.PricePaisa
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *HoldSeatsRequest) GetSeatCount() int32 {
+	if x != nil {
+		return x.SeatCount
 	}
 	return 0
 }
 
-func (x *SeatCell) GetIsAccessible() bool {
+func (x *HoldSeatsRequest) GetPreferences() *SeatPreferences {
 	if x != nil {
-		return x.IsAccessible
+		return x.Preferences
 	}
-	return false
+	return nil
 }
 
-func (x *SeatCell) GetHasPower() bool {
+func (x *HoldSeatsRequest) GetQuotaClaims() *QuotaClaims {
 	if x != nil {
-		return x.HasPower
+		return x.QuotaClaims
 	}
-	return false
+	return nil
 }
 
-func (x *SeatCell) GetIsExitRow() bool {
+func (x *HoldSeatsRequest) GetSeatLegs() []*SeatLegSelection {
 	if x != nil {
-		return x.IsExitRow
+		return x.SeatLegs
 	}
-	return false
+	return nil
 }
 
-func (x *SeatCell) GetHoldExpiresAt() int64 {
+func (x *HoldSeatsRequest) GetCapacityItems() []*CapacityItem {
 	if x != nil {
-		return x.HoldExpiresAt
+		return x.CapacityItems
 	}
-	return 0
+	return nil
 }
 
-type SeatMapLegend struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusColors  map[string]string      `protobuf:"bytes,1,rep,name=status_colors,json=statusColors,proto3" json:"status_colors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // {"available": "#00FF00", "booked": "#FF0000"}
-	ClassColors   map[string]string      `protobuf:"bytes,2,rep,name=class_colors,json=classColors,proto3" json:"class_colors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SeatLegSelection places a passenger in a seat for part of the journey
+type SeatLegSelection struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PassengerIndex int32                  `protobuf:"varint,1,opt,name=passenger_index,json=passengerIndex,proto3" json:"passenger_index,omitempty"`
+	SeatId         string                 `protobuf:"bytes,2,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	FromStationId  string                 `protobuf:"bytes,3,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId    string                 `protobuf:"bytes,4,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SeatMapLegend) Reset() {
-	*x = SeatMapLegend{}
+func (x *SeatLegSelection) Reset() {
+	*x = SeatLegSelection{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeatMapLegend) String() string {
+func (x *SeatLegSelection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeatMapLegend) ProtoMessage() {}
+func (*SeatLegSelection) ProtoMessage() {}
 
-func (x *SeatMapLegend) ProtoReflect() protoreflect.Message {
+func (x *SeatLegSelection) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1329,50 +1368,63 @@ func (x *SeatMapLegend) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeatMapLegend.ProtoReflect.Descriptor instead.
-func (*SeatMapLegend) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeatLegSelection.ProtoReflect.Descriptor instead.
+func (*SeatLegSelection) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SeatMapLegend) GetStatusColors() map[string]string {
+func (x *SeatLegSelection) GetPassengerIndex() int32 {
 	if x != nil {
-		return x.StatusColors
+		return x.PassengerIndex
 	}
-	return nil
+	return 0
 }
 
-func (x *SeatMapLegend) GetClassColors() map[string]string {
+func (x *SeatLegSelection) GetSeatId() string {
 	if x != nil {
-		return x.ClassColors
+		return x.SeatId
 	}
-	return nil
+	return ""
 }
 
-type InitializeTripInventoryRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	VehicleId      string                 `protobuf:"bytes,3,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
-	Segments       []*SegmentDefinition   `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
-	SeatConfig     *SeatConfiguration     `protobuf:"bytes,5,opt,name=seat_config,json=seatConfig,proto3" json:"seat_config,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *SeatLegSelection) GetFromStationId() string {
+	if x != nil {
+		return x.FromStationId
+	}
+	return ""
 }
 
-func (x *InitializeTripInventoryRequest) Reset() {
-	*x = InitializeTripInventoryRequest{}
+func (x *SeatLegSelection) GetToStationId() string {
+	if x != nil {
+		return x.ToStationId
+	}
+	return ""
+}
+
+// SeatPreferences steers best-available seat allocation
+type SeatPreferences struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SeatClass       string                 `protobuf:"bytes,1,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	Position        string                 `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`                                       // window, aisle (empty = no preference)
+	RequireTogether bool                   `protobuf:"varint,3,opt,name=require_together,json=requireTogether,proto3" json:"require_together,omitempty"` // Fail rather than split the group across the vehicle
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SeatPreferences) Reset() {
+	*x = SeatPreferences{}
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitializeTripInventoryRequest) String() string {
+func (x *SeatPreferences) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitializeTripInventoryRequest) ProtoMessage() {}
+func (*SeatPreferences) ProtoMessage() {}
 
-func (x *InitializeTripInventoryRequest) ProtoReflect() protoreflect.Message {
+func (x *SeatPreferences) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1384,72 +1436,3988 @@ func (x *InitializeTripInventoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitializeTripInventoryRequest.ProtoReflect.Descriptor instead.
-func (*InitializeTripInventoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeatPreferences.ProtoReflect.Descriptor instead.
+func (*SeatPreferences) Descriptor() ([]byte, []int) {
 	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *InitializeTripInventoryRequest) GetTripId() string {
+func (x *SeatPreferences) GetSeatClass() string {
 	if x != nil {
-		return x.TripId
+		return x.SeatClass
 	}
 	return ""
 }
 
-func (x *InitializeTripInventoryRequest) GetOrganizationId() string {
+func (x *SeatPreferences) GetPosition() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Position
 	}
 	return ""
 }
 
-func (x *InitializeTripInventoryRequest) GetVehicleId() string {
+func (x *SeatPreferences) GetRequireTogether() bool {
 	if x != nil {
-		return x.VehicleId
+		return x.RequireTogether
 	}
-	return ""
+	return false
 }
 
-func (x *InitializeTripInventoryRequest) GetSegments() []*SegmentDefinition {
-	if x != nil {
-		return x.Segments
-	}
-	return nil
+type HoldSeatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	HeldSeatIds   []string               `protobuf:"bytes,3,rep,name=held_seat_ids,json=heldSeatIds,proto3" json:"held_seat_ids,omitempty"`
+	FailedSeatIds []string               `protobuf:"bytes,4,rep,name=failed_seat_ids,json=failedSeatIds,proto3" json:"failed_seat_ids,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	FailureReason string                 `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	HeldCapacity  []*CapacityItem        `protobuf:"bytes,7,rep,name=held_capacity,json=heldCapacity,proto3" json:"held_capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InitializeTripInventoryRequest) GetSeatConfig() *SeatConfiguration {
+func (x *HoldSeatsResponse) Reset() {
+	*x = HoldSeatsResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSeatsResponse) ProtoMessage() {}
+
+func (x *HoldSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSeatsResponse.ProtoReflect.Descriptor instead.
+func (*HoldSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HoldSeatsResponse) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *HoldSeatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HoldSeatsResponse) GetHeldSeatIds() []string {
+	if x != nil {
+		return x.HeldSeatIds
+	}
+	return nil
+}
+
+func (x *HoldSeatsResponse) GetFailedSeatIds() []string {
+	if x != nil {
+		return x.FailedSeatIds
+	}
+	return nil
+}
+
+func (x *HoldSeatsResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *HoldSeatsResponse) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *HoldSeatsResponse) GetHeldCapacity() []*CapacityItem {
+	if x != nil {
+		return x.HeldCapacity
+	}
+	return nil
+}
+
+type ReleaseSeatsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HoldId         string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatsRequest) Reset() {
+	*x = ReleaseSeatsRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatsRequest) ProtoMessage() {}
+
+func (x *ReleaseSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReleaseSeatsRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ReleaseSeatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReleaseSeatsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ReleaseSeatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ReleasedCount int32                  `protobuf:"varint,2,opt,name=released_count,json=releasedCount,proto3" json:"released_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatsResponse) Reset() {
+	*x = ReleaseSeatsResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatsResponse) ProtoMessage() {}
+
+func (x *ReleaseSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReleaseSeatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseSeatsResponse) GetReleasedCount() int32 {
+	if x != nil {
+		return x.ReleasedCount
+	}
+	return 0
+}
+
+// BlockSeatsRequest targets one trip, or with schedule_id every trip generated from the
+// schedule, including trips generated later. Seats are matched by ID or by seat number.
+type BlockSeatsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TripId         string                 `protobuf:"bytes,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	ScheduleId     string                 `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	SeatIds        []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	SeatNumbers    []string               `protobuf:"bytes,5,rep,name=seat_numbers,json=seatNumbers,proto3" json:"seat_numbers,omitempty"`
+	SegmentIndexes []int32                `protobuf:"varint,6,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"` // Empty = every segment
+	Reason         string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	UnblockAt      int64                  `protobuf:"varint,8,opt,name=unblock_at,json=unblockAt,proto3" json:"unblock_at,omitempty"` // Unix timestamp, 0 = until unblocked by hand
+	BlockedBy      string                 `protobuf:"bytes,9,opt,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BlockSeatsRequest) Reset() {
+	*x = BlockSeatsRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSeatsRequest) ProtoMessage() {}
+
+func (x *BlockSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSeatsRequest.ProtoReflect.Descriptor instead.
+func (*BlockSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BlockSeatsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *BlockSeatsRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *BlockSeatsRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *BlockSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *BlockSeatsRequest) GetSeatNumbers() []string {
+	if x != nil {
+		return x.SeatNumbers
+	}
+	return nil
+}
+
+func (x *BlockSeatsRequest) GetSegmentIndexes() []int32 {
+	if x != nil {
+		return x.SegmentIndexes
+	}
+	return nil
+}
+
+func (x *BlockSeatsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockSeatsRequest) GetUnblockAt() int64 {
+	if x != nil {
+		return x.UnblockAt
+	}
+	return 0
+}
+
+func (x *BlockSeatsRequest) GetBlockedBy() string {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return ""
+}
+
+type BlockSeatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockedCount  int32                  `protobuf:"varint,1,opt,name=blocked_count,json=blockedCount,proto3" json:"blocked_count,omitempty"`
+	Trips         []*TripSeatBlockResult `protobuf:"bytes,2,rep,name=trips,proto3" json:"trips,omitempty"`
+	BlockId       string                 `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"` // Schedule blocks only, used to lift the block
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockSeatsResponse) Reset() {
+	*x = BlockSeatsResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSeatsResponse) ProtoMessage() {}
+
+func (x *BlockSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSeatsResponse.ProtoReflect.Descriptor instead.
+func (*BlockSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BlockSeatsResponse) GetBlockedCount() int32 {
+	if x != nil {
+		return x.BlockedCount
+	}
+	return 0
+}
+
+func (x *BlockSeatsResponse) GetTrips() []*TripSeatBlockResult {
+	if x != nil {
+		return x.Trips
+	}
+	return nil
+}
+
+func (x *BlockSeatsResponse) GetBlockId() string {
+	if x != nil {
+		return x.BlockId
+	}
+	return ""
+}
+
+type TripSeatBlockResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	BlockedSeatIds []string               `protobuf:"bytes,2,rep,name=blocked_seat_ids,json=blockedSeatIds,proto3" json:"blocked_seat_ids,omitempty"`
+	FailedSeatIds  []string               `protobuf:"bytes,3,rep,name=failed_seat_ids,json=failedSeatIds,proto3" json:"failed_seat_ids,omitempty"` // Held or booked on a requested segment
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TripSeatBlockResult) Reset() {
+	*x = TripSeatBlockResult{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripSeatBlockResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripSeatBlockResult) ProtoMessage() {}
+
+func (x *TripSeatBlockResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripSeatBlockResult.ProtoReflect.Descriptor instead.
+func (*TripSeatBlockResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TripSeatBlockResult) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *TripSeatBlockResult) GetBlockedSeatIds() []string {
+	if x != nil {
+		return x.BlockedSeatIds
+	}
+	return nil
+}
+
+func (x *TripSeatBlockResult) GetFailedSeatIds() []string {
+	if x != nil {
+		return x.FailedSeatIds
+	}
+	return nil
+}
+
+// UnblockSeatsRequest lifts blocks on one trip by seat, or a schedule block by block_id
+type UnblockSeatsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TripId         string                 `protobuf:"bytes,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	ScheduleId     string                 `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	BlockId        string                 `protobuf:"bytes,4,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	SeatIds        []string               `protobuf:"bytes,5,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	SeatNumbers    []string               `protobuf:"bytes,6,rep,name=seat_numbers,json=seatNumbers,proto3" json:"seat_numbers,omitempty"`
+	SegmentIndexes []int32                `protobuf:"varint,7,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"` // Empty = every segment
+	UnblockedBy    string                 `protobuf:"bytes,8,opt,name=unblocked_by,json=unblockedBy,proto3" json:"unblocked_by,omitempty"`                  // Recorded in the seat ledger
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnblockSeatsRequest) Reset() {
+	*x = UnblockSeatsRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockSeatsRequest) ProtoMessage() {}
+
+func (x *UnblockSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockSeatsRequest.ProtoReflect.Descriptor instead.
+func (*UnblockSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UnblockSeatsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UnblockSeatsRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *UnblockSeatsRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *UnblockSeatsRequest) GetBlockId() string {
+	if x != nil {
+		return x.BlockId
+	}
+	return ""
+}
+
+func (x *UnblockSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *UnblockSeatsRequest) GetSeatNumbers() []string {
+	if x != nil {
+		return x.SeatNumbers
+	}
+	return nil
+}
+
+func (x *UnblockSeatsRequest) GetSegmentIndexes() []int32 {
+	if x != nil {
+		return x.SegmentIndexes
+	}
+	return nil
+}
+
+func (x *UnblockSeatsRequest) GetUnblockedBy() string {
+	if x != nil {
+		return x.UnblockedBy
+	}
+	return ""
+}
+
+type UnblockSeatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UnblockedCount int32                  `protobuf:"varint,1,opt,name=unblocked_count,json=unblockedCount,proto3" json:"unblocked_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnblockSeatsResponse) Reset() {
+	*x = UnblockSeatsResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockSeatsResponse) ProtoMessage() {}
+
+func (x *UnblockSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockSeatsResponse.ProtoReflect.Descriptor instead.
+func (*UnblockSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UnblockSeatsResponse) GetUnblockedCount() int32 {
+	if x != nil {
+		return x.UnblockedCount
+	}
+	return 0
+}
+
+type ExtendHoldRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HoldId          string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationId  string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ExtendBySeconds int32                  `protobuf:"varint,4,opt,name=extend_by_seconds,json=extendBySeconds,proto3" json:"extend_by_seconds,omitempty"` // Default: the organization's extension step
+	// Set by the order service while a booking waits for a fraud analyst: the hold runs
+	// until extend_by_seconds from now, outside the hold policy's limits
+	ForReview     bool `protobuf:"varint,5,opt,name=for_review,json=forReview,proto3" json:"for_review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendHoldRequest) Reset() {
+	*x = ExtendHoldRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendHoldRequest) ProtoMessage() {}
+
+func (x *ExtendHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendHoldRequest.ProtoReflect.Descriptor instead.
+func (*ExtendHoldRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExtendHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ExtendHoldRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExtendHoldRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ExtendHoldRequest) GetExtendBySeconds() int32 {
+	if x != nil {
+		return x.ExtendBySeconds
+	}
+	return 0
+}
+
+func (x *ExtendHoldRequest) GetForReview() bool {
+	if x != nil {
+		return x.ForReview
+	}
+	return false
+}
+
+type ExtendHoldResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Success             bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ExpiresAt           int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ExtensionsRemaining int32                  `protobuf:"varint,3,opt,name=extensions_remaining,json=extensionsRemaining,proto3" json:"extensions_remaining,omitempty"`
+	FailureReason       string                 `protobuf:"bytes,4,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ExtendHoldResponse) Reset() {
+	*x = ExtendHoldResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendHoldResponse) ProtoMessage() {}
+
+func (x *ExtendHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendHoldResponse.ProtoReflect.Descriptor instead.
+func (*ExtendHoldResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExtendHoldResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExtendHoldResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *ExtendHoldResponse) GetExtensionsRemaining() int32 {
+	if x != nil {
+		return x.ExtensionsRemaining
+	}
+	return 0
+}
+
+func (x *ExtendHoldResponse) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+// HoldPolicy caps how long an organization's holds may live
+type HoldPolicy struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId         string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	MaxHoldLifetimeSeconds int32                  `protobuf:"varint,2,opt,name=max_hold_lifetime_seconds,json=maxHoldLifetimeSeconds,proto3" json:"max_hold_lifetime_seconds,omitempty"` // Measured from hold creation
+	MaxExtensions          int32                  `protobuf:"varint,3,opt,name=max_extensions,json=maxExtensions,proto3" json:"max_extensions,omitempty"`
+	ExtensionSeconds       int32                  `protobuf:"varint,4,opt,name=extension_seconds,json=extensionSeconds,proto3" json:"extension_seconds,omitempty"` // Default extension step
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *HoldPolicy) Reset() {
+	*x = HoldPolicy{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldPolicy) ProtoMessage() {}
+
+func (x *HoldPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldPolicy.ProtoReflect.Descriptor instead.
+func (*HoldPolicy) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HoldPolicy) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *HoldPolicy) GetMaxHoldLifetimeSeconds() int32 {
+	if x != nil {
+		return x.MaxHoldLifetimeSeconds
+	}
+	return 0
+}
+
+func (x *HoldPolicy) GetMaxExtensions() int32 {
+	if x != nil {
+		return x.MaxExtensions
+	}
+	return 0
+}
+
+func (x *HoldPolicy) GetExtensionSeconds() int32 {
+	if x != nil {
+		return x.ExtensionSeconds
+	}
+	return 0
+}
+
+type SetHoldPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *HoldPolicy            `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHoldPolicyRequest) Reset() {
+	*x = SetHoldPolicyRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHoldPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHoldPolicyRequest) ProtoMessage() {}
+
+func (x *SetHoldPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHoldPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetHoldPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetHoldPolicyRequest) GetPolicy() *HoldPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetHoldPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHoldPolicyResponse) Reset() {
+	*x = SetHoldPolicyResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHoldPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHoldPolicyResponse) ProtoMessage() {}
+
+func (x *SetHoldPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHoldPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetHoldPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetHoldPolicyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetHoldPolicyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetHoldPolicyRequest) Reset() {
+	*x = GetHoldPolicyRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldPolicyRequest) ProtoMessage() {}
+
+func (x *GetHoldPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetHoldPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetHoldPolicyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetHoldPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *HoldPolicy            `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHoldPolicyResponse) Reset() {
+	*x = GetHoldPolicyResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldPolicyResponse) ProtoMessage() {}
+
+func (x *GetHoldPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetHoldPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetHoldPolicyResponse) GetPolicy() *HoldPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type ConfirmBookingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HoldId         string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Passengers     []*PassengerSeat       `protobuf:"bytes,4,rep,name=passengers,proto3" json:"passengers,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConfirmBookingRequest) Reset() {
+	*x = ConfirmBookingRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmBookingRequest) ProtoMessage() {}
+
+func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmBookingRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmBookingRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConfirmBookingRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ConfirmBookingRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ConfirmBookingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfirmBookingRequest) GetPassengers() []*PassengerSeat {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+func (x *ConfirmBookingRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type PassengerSeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	PassengerNid  string                 `protobuf:"bytes,2,opt,name=passenger_nid,json=passengerNid,proto3" json:"passenger_nid,omitempty"`
+	PassengerName string                 `protobuf:"bytes,3,opt,name=passenger_name,json=passengerName,proto3" json:"passenger_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PassengerSeat) Reset() {
+	*x = PassengerSeat{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PassengerSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassengerSeat) ProtoMessage() {}
+
+func (x *PassengerSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassengerSeat.ProtoReflect.Descriptor instead.
+func (*PassengerSeat) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PassengerSeat) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *PassengerSeat) GetPassengerNid() string {
+	if x != nil {
+		return x.PassengerNid
+	}
+	return ""
+}
+
+func (x *PassengerSeat) GetPassengerName() string {
+	if x != nil {
+		return x.PassengerName
+	}
+	return ""
+}
+
+type ConfirmBookingResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	BookingId      string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	ConfirmedSeats []*ConfirmedSeat       `protobuf:"bytes,3,rep,name=confirmed_seats,json=confirmedSeats,proto3" json:"confirmed_seats,omitempty"`
+	FailureReason  string                 `protobuf:"bytes,4,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConfirmBookingResponse) Reset() {
+	*x = ConfirmBookingResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmBookingResponse) ProtoMessage() {}
+
+func (x *ConfirmBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmBookingResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmBookingResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ConfirmBookingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfirmBookingResponse) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ConfirmBookingResponse) GetConfirmedSeats() []*ConfirmedSeat {
+	if x != nil {
+		return x.ConfirmedSeats
+	}
+	return nil
+}
+
+func (x *ConfirmBookingResponse) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+type ConfirmedSeat struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SeatId         string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	SeatNumber     string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	TicketId       string                 `protobuf:"bytes,3,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	PassengerIndex int32                  `protobuf:"varint,4,opt,name=passenger_index,json=passengerIndex,proto3" json:"passenger_index,omitempty"`
+	FromStationId  string                 `protobuf:"bytes,5,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"` // Split-seat legs only
+	ToStationId    string                 `protobuf:"bytes,6,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	SeatClass      string                 `protobuf:"bytes,7,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	PricePaisa     int64                  `protobuf:"varint,8,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
+	CapacityClass  string                 `protobuf:"bytes,9,opt,name=capacity_class,json=capacityClass,proto3" json:"capacity_class,omitempty"` // Capacity tickets only; seat_id is then empty
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConfirmedSeat) Reset() {
+	*x = ConfirmedSeat{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmedSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmedSeat) ProtoMessage() {}
+
+func (x *ConfirmedSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmedSeat.ProtoReflect.Descriptor instead.
+func (*ConfirmedSeat) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ConfirmedSeat) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *ConfirmedSeat) GetSeatNumber() string {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return ""
+}
+
+func (x *ConfirmedSeat) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *ConfirmedSeat) GetPassengerIndex() int32 {
+	if x != nil {
+		return x.PassengerIndex
+	}
+	return 0
+}
+
+func (x *ConfirmedSeat) GetFromStationId() string {
+	if x != nil {
+		return x.FromStationId
+	}
+	return ""
+}
+
+func (x *ConfirmedSeat) GetToStationId() string {
+	if x != nil {
+		return x.ToStationId
+	}
+	return ""
+}
+
+func (x *ConfirmedSeat) GetSeatClass() string {
+	if x != nil {
+		return x.SeatClass
+	}
+	return ""
+}
+
+func (x *ConfirmedSeat) GetPricePaisa() int64 {
+	if x != nil {
+		return x.PricePaisa
+	}
+	return 0
+}
+
+func (x *ConfirmedSeat) GetCapacityClass() string {
+	if x != nil {
+		return x.CapacityClass
+	}
+	return ""
+}
+
+type CancelBookingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookingId      string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Cancels only these passengers, leaving the rest of the booking confirmed
+	PassengerIndexes []int32 `protobuf:"varint,4,rep,packed,name=passenger_indexes,json=passengerIndexes,proto3" json:"passenger_indexes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CancelBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetPassengerIndexes() []int32 {
+	if x != nil {
+		return x.PassengerIndexes
+	}
+	return nil
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ReleasedCount int32                  `protobuf:"varint,2,opt,name=released_count,json=releasedCount,proto3" json:"released_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CancelBookingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelBookingResponse) GetReleasedCount() int32 {
+	if x != nil {
+		return x.ReleasedCount
+	}
+	return 0
+}
+
+type TransferPassengerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookingId      string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PassengerIndex int32                  `protobuf:"varint,4,opt,name=passenger_index,json=passengerIndex,proto3" json:"passenger_index,omitempty"`
+	PassengerNid   string                 `protobuf:"bytes,5,opt,name=passenger_nid,json=passengerNid,proto3" json:"passenger_nid,omitempty"`
+	PassengerName  string                 `protobuf:"bytes,6,opt,name=passenger_name,json=passengerName,proto3" json:"passenger_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransferPassengerRequest) Reset() {
+	*x = TransferPassengerRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferPassengerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPassengerRequest) ProtoMessage() {}
+
+func (x *TransferPassengerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPassengerRequest.ProtoReflect.Descriptor instead.
+func (*TransferPassengerRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TransferPassengerRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *TransferPassengerRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *TransferPassengerRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *TransferPassengerRequest) GetPassengerIndex() int32 {
+	if x != nil {
+		return x.PassengerIndex
+	}
+	return 0
+}
+
+func (x *TransferPassengerRequest) GetPassengerNid() string {
+	if x != nil {
+		return x.PassengerNid
+	}
+	return ""
+}
+
+func (x *TransferPassengerRequest) GetPassengerName() string {
+	if x != nil {
+		return x.PassengerName
+	}
+	return ""
+}
+
+type TransferPassengerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatsUpdated  int32                  `protobuf:"varint,1,opt,name=seats_updated,json=seatsUpdated,proto3" json:"seats_updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferPassengerResponse) Reset() {
+	*x = TransferPassengerResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferPassengerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPassengerResponse) ProtoMessage() {}
+
+func (x *TransferPassengerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPassengerResponse.ProtoReflect.Descriptor instead.
+func (*TransferPassengerResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TransferPassengerResponse) GetSeatsUpdated() int32 {
+	if x != nil {
+		return x.SeatsUpdated
+	}
+	return 0
+}
+
+type GetSeatMapRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TripId              string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	FromStationId       string                 `protobuf:"bytes,2,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId         string                 `protobuf:"bytes,3,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	OrganizationId      string                 `protobuf:"bytes,4,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	IncludeBlockDetails bool                   `protobuf:"varint,5,opt,name=include_block_details,json=includeBlockDetails,proto3" json:"include_block_details,omitempty"` // Staff only: return why and until when seats are blocked
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetSeatMapRequest) Reset() {
+	*x = GetSeatMapRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMapRequest) ProtoMessage() {}
+
+func (x *GetSeatMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatMapRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatMapRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetSeatMapRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *GetSeatMapRequest) GetFromStationId() string {
+	if x != nil {
+		return x.FromStationId
+	}
+	return ""
+}
+
+func (x *GetSeatMapRequest) GetToStationId() string {
+	if x != nil {
+		return x.ToStationId
+	}
+	return ""
+}
+
+func (x *GetSeatMapRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetSeatMapRequest) GetIncludeBlockDetails() bool {
+	if x != nil {
+		return x.IncludeBlockDetails
+	}
+	return false
+}
+
+type GetSeatMapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VehicleId     string                 `protobuf:"bytes,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	VehicleType   string                 `protobuf:"bytes,2,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"`
+	TotalRows     int32                  `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	TotalColumns  int32                  `protobuf:"varint,4,opt,name=total_columns,json=totalColumns,proto3" json:"total_columns,omitempty"`
+	Rows          []*SeatRow             `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"` // All seats, flattened across sections
+	Legend        *SeatMapLegend         `protobuf:"bytes,6,opt,name=legend,proto3" json:"legend,omitempty"`
+	Sections      []*SeatSection         `protobuf:"bytes,7,rep,name=sections,proto3" json:"sections,omitempty"` // True layout: decks, coaches, cabin blocks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatMapResponse) Reset() {
+	*x = GetSeatMapResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMapResponse) ProtoMessage() {}
+
+func (x *GetSeatMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatMapResponse.ProtoReflect.Descriptor instead.
+func (*GetSeatMapResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetSeatMapResponse) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
+func (x *GetSeatMapResponse) GetVehicleType() string {
+	if x != nil {
+		return x.VehicleType
+	}
+	return ""
+}
+
+func (x *GetSeatMapResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *GetSeatMapResponse) GetTotalColumns() int32 {
+	if x != nil {
+		return x.TotalColumns
+	}
+	return 0
+}
+
+func (x *GetSeatMapResponse) GetRows() []*SeatRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *GetSeatMapResponse) GetLegend() *SeatMapLegend {
+	if x != nil {
+		return x.Legend
+	}
+	return nil
+}
+
+func (x *GetSeatMapResponse) GetSections() []*SeatSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+// SeatSection is one independently drawn area of a vehicle
+type SeatSection struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SectionId         string                 `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"` // "main", "D1", "S1"
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                            // "Lower Deck", "Shovan Chair Coach"
+	Kind              string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`                            // deck, coach, cabin
+	Level             int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`                         // Deck level (1 = lower) for double-deckers and launches
+	TotalRows         int32                  `protobuf:"varint,5,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	TotalColumns      int32                  `protobuf:"varint,6,opt,name=total_columns,json=totalColumns,proto3" json:"total_columns,omitempty"`
+	AisleAfterColumns []int32                `protobuf:"varint,7,rep,packed,name=aisle_after_columns,json=aisleAfterColumns,proto3" json:"aisle_after_columns,omitempty"`
+	Rows              []*SeatRow             `protobuf:"bytes,8,rep,name=rows,proto3" json:"rows,omitempty"` // Includes gap cells so rows render on a fixed grid
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SeatSection) Reset() {
+	*x = SeatSection{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatSection) ProtoMessage() {}
+
+func (x *SeatSection) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatSection.ProtoReflect.Descriptor instead.
+func (*SeatSection) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SeatSection) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *SeatSection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SeatSection) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SeatSection) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *SeatSection) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *SeatSection) GetTotalColumns() int32 {
+	if x != nil {
+		return x.TotalColumns
+	}
+	return 0
+}
+
+func (x *SeatSection) GetAisleAfterColumns() []int32 {
+	if x != nil {
+		return x.AisleAfterColumns
+	}
+	return nil
+}
+
+func (x *SeatSection) GetRows() []*SeatRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type SeatRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RowNumber     int32                  `protobuf:"varint,1,opt,name=row_number,json=rowNumber,proto3" json:"row_number,omitempty"`
+	Seats         []*SeatCell            `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatRow) Reset() {
+	*x = SeatRow{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatRow) ProtoMessage() {}
+
+func (x *SeatRow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatRow.ProtoReflect.Descriptor instead.
+func (*SeatRow) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SeatRow) GetRowNumber() int32 {
+	if x != nil {
+		return x.RowNumber
+	}
+	return 0
+}
+
+func (x *SeatRow) GetSeats() []*SeatCell {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type SeatCell struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SeatId          string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	SeatNumber      string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	Column          int32                  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	SeatType        string                 `protobuf:"bytes,4,opt,name=seat_type,json=seatType,proto3" json:"seat_type,omitempty"` // window, aisle, middle, none (for gaps)
+	SeatClass       string                 `protobuf:"bytes,5,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	Status          SeatStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
+	PricePaisa      int64                  `protobuf:"varint,7,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
+	IsAccessible    bool                   `protobuf:"varint,8,opt,name=is_accessible,json=isAccessible,proto3" json:"is_accessible,omitempty"` // Wheelchair accessible
+	HasPower        bool                   `protobuf:"varint,9,opt,name=has_power,json=hasPower,proto3" json:"has_power,omitempty"`             // Power outlet
+	IsExitRow       bool                   `protobuf:"varint,10,opt,name=is_exit_row,json=isExitRow,proto3" json:"is_exit_row,omitempty"`
+	HoldExpiresAt   int64                  `protobuf:"varint,11,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"` // Unix timestamp
+	Row             int32                  `protobuf:"varint,12,opt,name=row,proto3" json:"row,omitempty"`
+	SectionId       string                 `protobuf:"bytes,13,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Berth           string                 `protobuf:"bytes,14,opt,name=berth,proto3" json:"berth,omitempty"`                                            // LB, MB, UB, SL, SU for sleeper coaches
+	SegmentStatuses []*SegmentSeatStatus   `protobuf:"bytes,15,rep,name=segment_statuses,json=segmentStatuses,proto3" json:"segment_statuses,omitempty"` // Per segment of the requested journey
+	QuotaType       string                 `protobuf:"bytes,16,opt,name=quota_type,json=quotaType,proto3" json:"quota_type,omitempty"`                   // Set while the seat is reserved for a quota
+	BlockReason     string                 `protobuf:"bytes,17,opt,name=block_reason,json=blockReason,proto3" json:"block_reason,omitempty"`             // Staff only, see include_block_details
+	BlockedUntil    int64                  `protobuf:"varint,18,opt,name=blocked_until,json=blockedUntil,proto3" json:"blocked_until,omitempty"`         // Staff only; 0 = blocked until unblocked by hand
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SeatCell) Reset() {
+	*x = SeatCell{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatCell) ProtoMessage() {}
+
+func (x *SeatCell) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatCell.ProtoReflect.Descriptor instead.
+func (*SeatCell) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SeatCell) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *SeatCell) GetSeatNumber() string {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return ""
+}
+
+func (x *SeatCell) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *SeatCell) GetSeatType() string {
+	if x != nil {
+		return x.SeatType
+	}
+	return ""
+}
+
+func (x *SeatCell) GetSeatClass() string {
+	if x != nil {
+		return x.SeatClass
+	}
+	return ""
+}
+
+func (x *SeatCell) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+func (x *SeatCell) GetPricePaisa() int64 {
+	if x != nil {
+		return x.PricePaisa
+	}
+	return 0
+}
+
+func (x *SeatCell) GetIsAccessible() bool {
+	if x != nil {
+		return x.IsAccessible
+	}
+	return false
+}
+
+func (x *SeatCell) GetHasPower() bool {
+	if x != nil {
+		return x.HasPower
+	}
+	return false
+}
+
+func (x *SeatCell) GetIsExitRow() bool {
+	if x != nil {
+		return x.IsExitRow
+	}
+	return false
+}
+
+func (x *SeatCell) GetHoldExpiresAt() int64 {
+	if x != nil {
+		return x.HoldExpiresAt
+	}
+	return 0
+}
+
+func (x *SeatCell) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *SeatCell) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *SeatCell) GetBerth() string {
+	if x != nil {
+		return x.Berth
+	}
+	return ""
+}
+
+func (x *SeatCell) GetSegmentStatuses() []*SegmentSeatStatus {
+	if x != nil {
+		return x.SegmentStatuses
+	}
+	return nil
+}
+
+func (x *SeatCell) GetQuotaType() string {
+	if x != nil {
+		return x.QuotaType
+	}
+	return ""
+}
+
+func (x *SeatCell) GetBlockReason() string {
+	if x != nil {
+		return x.BlockReason
+	}
+	return ""
+}
+
+func (x *SeatCell) GetBlockedUntil() int64 {
+	if x != nil {
+		return x.BlockedUntil
+	}
+	return 0
+}
+
+type SegmentSeatStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SegmentIndex  int32                  `protobuf:"varint,1,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	Status        SeatStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentSeatStatus) Reset() {
+	*x = SegmentSeatStatus{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentSeatStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentSeatStatus) ProtoMessage() {}
+
+func (x *SegmentSeatStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentSeatStatus.ProtoReflect.Descriptor instead.
+func (*SegmentSeatStatus) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SegmentSeatStatus) GetSegmentIndex() int32 {
+	if x != nil {
+		return x.SegmentIndex
+	}
+	return 0
+}
+
+func (x *SegmentSeatStatus) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+type SeatMapLegend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusColors  map[string]string      `protobuf:"bytes,1,rep,name=status_colors,json=statusColors,proto3" json:"status_colors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // {"available": "#00FF00", "booked": "#FF0000"}
+	ClassColors   map[string]string      `protobuf:"bytes,2,rep,name=class_colors,json=classColors,proto3" json:"class_colors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatMapLegend) Reset() {
+	*x = SeatMapLegend{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatMapLegend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMapLegend) ProtoMessage() {}
+
+func (x *SeatMapLegend) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMapLegend.ProtoReflect.Descriptor instead.
+func (*SeatMapLegend) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SeatMapLegend) GetStatusColors() map[string]string {
+	if x != nil {
+		return x.StatusColors
+	}
+	return nil
+}
+
+func (x *SeatMapLegend) GetClassColors() map[string]string {
+	if x != nil {
+		return x.ClassColors
+	}
+	return nil
+}
+
+type InitializeTripInventoryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	VehicleId      string                 `protobuf:"bytes,3,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	Segments       []*SegmentDefinition   `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	SeatConfig     *SeatConfiguration     `protobuf:"bytes,5,opt,name=seat_config,json=seatConfig,proto3" json:"seat_config,omitempty"`
+	Quotas         []*QuotaDefinition     `protobuf:"bytes,6,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	ScheduleId     string                 `protobuf:"bytes,7,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"` // Schedule-wide seat blocks are applied to the new trip
+	Capacity       []*CapacityDefinition  `protobuf:"bytes,8,rep,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InitializeTripInventoryRequest) Reset() {
+	*x = InitializeTripInventoryRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitializeTripInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitializeTripInventoryRequest) ProtoMessage() {}
+
+func (x *InitializeTripInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitializeTripInventoryRequest.ProtoReflect.Descriptor instead.
+func (*InitializeTripInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *InitializeTripInventoryRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *InitializeTripInventoryRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *InitializeTripInventoryRequest) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
+func (x *InitializeTripInventoryRequest) GetSegments() []*SegmentDefinition {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *InitializeTripInventoryRequest) GetSeatConfig() *SeatConfiguration {
+	if x != nil {
+		return x.SeatConfig
+	}
+	return nil
+}
+
+func (x *InitializeTripInventoryRequest) GetQuotas() []*QuotaDefinition {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+func (x *InitializeTripInventoryRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *InitializeTripInventoryRequest) GetCapacity() []*CapacityDefinition {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+// CapacityDefinition sells unnumbered places, such as deck passengers or standing tickets, by count
+type CapacityDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CapacityClass string                 `protobuf:"bytes,1,opt,name=capacity_class,json=capacityClass,proto3" json:"capacity_class,omitempty"`
+	MaxCount      int32                  `protobuf:"varint,2,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"` // Per segment
+	PricePaisa    int64                  `protobuf:"varint,3,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapacityDefinition) Reset() {
+	*x = CapacityDefinition{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapacityDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapacityDefinition) ProtoMessage() {}
+
+func (x *CapacityDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapacityDefinition.ProtoReflect.Descriptor instead.
+func (*CapacityDefinition) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CapacityDefinition) GetCapacityClass() string {
+	if x != nil {
+		return x.CapacityClass
+	}
+	return ""
+}
+
+func (x *CapacityDefinition) GetMaxCount() int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *CapacityDefinition) GetPricePaisa() int64 {
+	if x != nil {
+		return x.PricePaisa
+	}
+	return 0
+}
+
+// QuotaDefinition reserves seats for an eligible group until a cutoff before departure
+type QuotaDefinition struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	QuotaId                       string                 `protobuf:"bytes,1,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`
+	Name                          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	QuotaType                     string                 `protobuf:"bytes,3,opt,name=quota_type,json=quotaType,proto3" json:"quota_type,omitempty"` // ladies, disabled, counter, partner, vip
+	SeatIds                       []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	SegmentIndexes                []int32                `protobuf:"varint,5,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"`                                           // Empty = every segment
+	PartnerIds                    []string               `protobuf:"bytes,6,rep,name=partner_ids,json=partnerIds,proto3" json:"partner_ids,omitempty"`                                                               // Partner quotas: allowed resellers
+	ReleaseMinutesBeforeDeparture int32                  `protobuf:"varint,7,opt,name=release_minutes_before_departure,json=releaseMinutesBeforeDeparture,proto3" json:"release_minutes_before_departure,omitempty"` // 0 = never released automatically
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *QuotaDefinition) Reset() {
+	*x = QuotaDefinition{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaDefinition) ProtoMessage() {}
+
+func (x *QuotaDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaDefinition.ProtoReflect.Descriptor instead.
+func (*QuotaDefinition) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *QuotaDefinition) GetQuotaId() string {
+	if x != nil {
+		return x.QuotaId
+	}
+	return ""
+}
+
+func (x *QuotaDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QuotaDefinition) GetQuotaType() string {
+	if x != nil {
+		return x.QuotaType
+	}
+	return ""
+}
+
+func (x *QuotaDefinition) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *QuotaDefinition) GetSegmentIndexes() []int32 {
+	if x != nil {
+		return x.SegmentIndexes
+	}
+	return nil
+}
+
+func (x *QuotaDefinition) GetPartnerIds() []string {
+	if x != nil {
+		return x.PartnerIds
+	}
+	return nil
+}
+
+func (x *QuotaDefinition) GetReleaseMinutesBeforeDeparture() int32 {
+	if x != nil {
+		return x.ReleaseMinutesBeforeDeparture
+	}
+	return 0
+}
+
+type SegmentDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SegmentIndex  int32                  `protobuf:"varint,1,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	FromStationId string                 `protobuf:"bytes,2,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId   string                 `protobuf:"bytes,3,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	DepartureTime int64                  `protobuf:"varint,4,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalTime   int64                  `protobuf:"varint,5,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentDefinition) Reset() {
+	*x = SegmentDefinition{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentDefinition) ProtoMessage() {}
+
+func (x *SegmentDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentDefinition.ProtoReflect.Descriptor instead.
+func (*SegmentDefinition) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SegmentDefinition) GetSegmentIndex() int32 {
+	if x != nil {
+		return x.SegmentIndex
+	}
+	return 0
+}
+
+func (x *SegmentDefinition) GetFromStationId() string {
+	if x != nil {
+		return x.FromStationId
+	}
+	return ""
+}
+
+func (x *SegmentDefinition) GetToStationId() string {
+	if x != nil {
+		return x.ToStationId
+	}
+	return ""
+}
+
+func (x *SegmentDefinition) GetDepartureTime() int64 {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return 0
+}
+
+func (x *SegmentDefinition) GetArrivalTime() int64 {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return 0
+}
+
+type SeatConfiguration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalSeats    int32                  `protobuf:"varint,1,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	Seats         []*SeatDefinition      `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	Layout        *SeatLayoutDefinition  `protobuf:"bytes,3,opt,name=layout,proto3" json:"layout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatConfiguration) Reset() {
+	*x = SeatConfiguration{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatConfiguration) ProtoMessage() {}
+
+func (x *SeatConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatConfiguration.ProtoReflect.Descriptor instead.
+func (*SeatConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SeatConfiguration) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *SeatConfiguration) GetSeats() []*SeatDefinition {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *SeatConfiguration) GetLayout() *SeatLayoutDefinition {
+	if x != nil {
+		return x.Layout
+	}
+	return nil
+}
+
+type SeatLayoutDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VehicleType   string                 `protobuf:"bytes,1,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"` // bus, train, launch
+	Sections      []*SectionDefinition   `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatLayoutDefinition) Reset() {
+	*x = SeatLayoutDefinition{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatLayoutDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatLayoutDefinition) ProtoMessage() {}
+
+func (x *SeatLayoutDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatLayoutDefinition.ProtoReflect.Descriptor instead.
+func (*SeatLayoutDefinition) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SeatLayoutDefinition) GetVehicleType() string {
+	if x != nil {
+		return x.VehicleType
+	}
+	return ""
+}
+
+func (x *SeatLayoutDefinition) GetSections() []*SectionDefinition {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type SectionDefinition struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SectionId         string                 `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind              string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Level             int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	Rows              int32                  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns           int32                  `protobuf:"varint,6,opt,name=columns,proto3" json:"columns,omitempty"`
+	AisleAfterColumns []int32                `protobuf:"varint,7,rep,packed,name=aisle_after_columns,json=aisleAfterColumns,proto3" json:"aisle_after_columns,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SectionDefinition) Reset() {
+	*x = SectionDefinition{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionDefinition) ProtoMessage() {}
+
+func (x *SectionDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionDefinition.ProtoReflect.Descriptor instead.
+func (*SectionDefinition) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SectionDefinition) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *SectionDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SectionDefinition) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SectionDefinition) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *SectionDefinition) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *SectionDefinition) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *SectionDefinition) GetAisleAfterColumns() []int32 {
+	if x != nil {
+		return x.AisleAfterColumns
+	}
+	return nil
+}
+
+type SeatDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	SeatNumber    string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	Row           int32                  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Column        int32                  `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
+	SeatType      string                 `protobuf:"bytes,5,opt,name=seat_type,json=seatType,proto3" json:"seat_type,omitempty"`
+	SeatClass     string                 `protobuf:"bytes,6,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	PricePaisa    int64                  `protobuf:"varint,7,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
+	IsAccessible  bool                   `protobuf:"varint,8,opt,name=is_accessible,json=isAccessible,proto3" json:"is_accessible,omitempty"`
+	HasPower      bool                   `protobuf:"varint,9,opt,name=has_power,json=hasPower,proto3" json:"has_power,omitempty"`
+	SectionId     string                 `protobuf:"bytes,10,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Berth         string                 `protobuf:"bytes,11,opt,name=berth,proto3" json:"berth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatDefinition) Reset() {
+	*x = SeatDefinition{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatDefinition) ProtoMessage() {}
+
+func (x *SeatDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatDefinition.ProtoReflect.Descriptor instead.
+func (*SeatDefinition) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SeatDefinition) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *SeatDefinition) GetSeatNumber() string {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return ""
+}
+
+func (x *SeatDefinition) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *SeatDefinition) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *SeatDefinition) GetSeatType() string {
+	if x != nil {
+		return x.SeatType
+	}
+	return ""
+}
+
+func (x *SeatDefinition) GetSeatClass() string {
+	if x != nil {
+		return x.SeatClass
+	}
+	return ""
+}
+
+func (x *SeatDefinition) GetPricePaisa() int64 {
+	if x != nil {
+		return x.PricePaisa
+	}
+	return 0
+}
+
+func (x *SeatDefinition) GetIsAccessible() bool {
+	if x != nil {
+		return x.IsAccessible
+	}
+	return false
+}
+
+func (x *SeatDefinition) GetHasPower() bool {
+	if x != nil {
+		return x.HasPower
+	}
+	return false
+}
+
+func (x *SeatDefinition) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *SeatDefinition) GetBerth() string {
+	if x != nil {
+		return x.Berth
+	}
+	return ""
+}
+
+type InitializeTripInventoryResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	SegmentsCreated int32                  `protobuf:"varint,2,opt,name=segments_created,json=segmentsCreated,proto3" json:"segments_created,omitempty"`
+	SeatsCreated    int32                  `protobuf:"varint,3,opt,name=seats_created,json=seatsCreated,proto3" json:"seats_created,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InitializeTripInventoryResponse) Reset() {
+	*x = InitializeTripInventoryResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitializeTripInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitializeTripInventoryResponse) ProtoMessage() {}
+
+func (x *InitializeTripInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitializeTripInventoryResponse.ProtoReflect.Descriptor instead.
+func (*InitializeTripInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *InitializeTripInventoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InitializeTripInventoryResponse) GetSegmentsCreated() int32 {
+	if x != nil {
+		return x.SegmentsCreated
+	}
+	return 0
+}
+
+func (x *InitializeTripInventoryResponse) GetSeatsCreated() int32 {
+	if x != nil {
+		return x.SeatsCreated
+	}
+	return 0
+}
+
+type UpdateInventoryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Updates        []*SeatUpdate          `protobuf:"bytes,3,rep,name=updates,proto3" json:"updates,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateInventoryRequest) Reset() {
+	*x = UpdateInventoryRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInventoryRequest) ProtoMessage() {}
+
+func (x *UpdateInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInventoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateInventoryRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *UpdateInventoryRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateInventoryRequest) GetUpdates() []*SeatUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+type SeatUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	NewStatus     SeatStatus             `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3,enum=inventory.v1.SeatStatus" json:"new_status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // "maintenance", "staff_reserved", etc.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatUpdate) Reset() {
+	*x = SeatUpdate{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatUpdate) ProtoMessage() {}
+
+func (x *SeatUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatUpdate.ProtoReflect.Descriptor instead.
+func (*SeatUpdate) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SeatUpdate) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *SeatUpdate) GetNewStatus() SeatStatus {
+	if x != nil {
+		return x.NewStatus
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+func (x *SeatUpdate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	UpdatedCount  int32                  `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInventoryResponse) Reset() {
+	*x = UpdateInventoryResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInventoryResponse) ProtoMessage() {}
+
+func (x *UpdateInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInventoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateInventoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateInventoryResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+type JoinWaitlistRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SeatClass      string                 `protobuf:"bytes,3,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	RequestedSeats int32                  `protobuf:"varint,4,opt,name=requested_seats,json=requestedSeats,proto3" json:"requested_seats,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	FromStationId  string                 `protobuf:"bytes,6,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId    string                 `protobuf:"bytes,7,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *JoinWaitlistRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetSeatClass() string {
+	if x != nil {
+		return x.SeatClass
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetRequestedSeats() int32 {
+	if x != nil {
+		return x.RequestedSeats
+	}
+	return 0
+}
+
+func (x *JoinWaitlistRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetFromStationId() string {
+	if x != nil {
+		return x.FromStationId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetToStationId() string {
+	if x != nil {
+		return x.ToStationId
+	}
+	return ""
+}
+
+type JoinWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistResponse) Reset() {
+	*x = JoinWaitlistResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistResponse) ProtoMessage() {}
+
+func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *JoinWaitlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *JoinWaitlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JoinWaitlistResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type GetUserWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserWaitlistRequest) Reset() {
+	*x = GetUserWaitlistRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserWaitlistRequest) ProtoMessage() {}
+
+func (x *GetUserWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*GetUserWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetUserWaitlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type WaitlistEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	SeatClass      string                 `protobuf:"bytes,3,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	RequestedSeats int32                  `protobuf:"varint,4,opt,name=requested_seats,json=requestedSeats,proto3" json:"requested_seats,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FromStationId  string                 `protobuf:"bytes,7,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId    string                 `protobuf:"bytes,8,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	Position       int32                  `protobuf:"varint,9,opt,name=position,proto3" json:"position,omitempty"`                                      // 1-based, only for pending entries
+	HoldId         string                 `protobuf:"bytes,10,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`                            // Set while an offer is outstanding
+	OfferExpiresAt int64                  `protobuf:"varint,11,opt,name=offer_expires_at,json=offerExpiresAt,proto3" json:"offer_expires_at,omitempty"` // Unix timestamp
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *WaitlistEntry) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetSeatClass() string {
+	if x != nil {
+		return x.SeatClass
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetRequestedSeats() int32 {
+	if x != nil {
+		return x.RequestedSeats
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetFromStationId() string {
+	if x != nil {
+		return x.FromStationId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetToStationId() string {
+	if x != nil {
+		return x.ToStationId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetOfferExpiresAt() int64 {
+	if x != nil {
+		return x.OfferExpiresAt
+	}
+	return 0
+}
+
+type GetUserWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WaitlistEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserWaitlistResponse) Reset() {
+	*x = GetUserWaitlistResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserWaitlistResponse) ProtoMessage() {}
+
+func (x *GetUserWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*GetUserWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetUserWaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RespondWaitlistOfferRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Accept         bool                   `protobuf:"varint,4,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RespondWaitlistOfferRequest) Reset() {
+	*x = RespondWaitlistOfferRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondWaitlistOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondWaitlistOfferRequest) ProtoMessage() {}
+
+func (x *RespondWaitlistOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondWaitlistOfferRequest.ProtoReflect.Descriptor instead.
+func (*RespondWaitlistOfferRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RespondWaitlistOfferRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *RespondWaitlistOfferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RespondWaitlistOfferRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RespondWaitlistOfferRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondWaitlistOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	HoldId        string                 `protobuf:"bytes,2,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"` // Use for checkout when accepted
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondWaitlistOfferResponse) Reset() {
+	*x = RespondWaitlistOfferResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondWaitlistOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondWaitlistOfferResponse) ProtoMessage() {}
+
+func (x *RespondWaitlistOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondWaitlistOfferResponse.ProtoReflect.Descriptor instead.
+func (*RespondWaitlistOfferResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RespondWaitlistOfferResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RespondWaitlistOfferResponse) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *RespondWaitlistOfferResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RespondWaitlistOfferResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetTripManifestRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTripManifestRequest) Reset() {
+	*x = GetTripManifestRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTripManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripManifestRequest) ProtoMessage() {}
+
+func (x *GetTripManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripManifestRequest.ProtoReflect.Descriptor instead.
+func (*GetTripManifestRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetTripManifestRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *GetTripManifestRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetTripManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Passengers    []*ManifestPassenger   `protobuf:"bytes,2,rep,name=passengers,proto3" json:"passengers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTripManifestResponse) Reset() {
+	*x = GetTripManifestResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTripManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripManifestResponse) ProtoMessage() {}
+
+func (x *GetTripManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripManifestResponse.ProtoReflect.Descriptor instead.
+func (*GetTripManifestResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetTripManifestResponse) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *GetTripManifestResponse) GetPassengers() []*ManifestPassenger {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+type ManifestPassenger struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BookingId            string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	PassengerName        string                 `protobuf:"bytes,2,opt,name=passenger_name,json=passengerName,proto3" json:"passenger_name,omitempty"`
+	PassengerNid         string                 `protobuf:"bytes,3,opt,name=passenger_nid,json=passengerNid,proto3" json:"passenger_nid,omitempty"`
+	FromStationId        string                 `protobuf:"bytes,4,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId          string                 `protobuf:"bytes,5,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	Legs                 []*ManifestLeg         `protobuf:"bytes,6,rep,name=legs,proto3" json:"legs,omitempty"`                                                                 // In travel order
+	SeatChangeStationIds []string               `protobuf:"bytes,7,rep,name=seat_change_station_ids,json=seatChangeStationIds,proto3" json:"seat_change_station_ids,omitempty"` // Where the passenger moves seats
+	CapacityClass        string                 `protobuf:"bytes,8,opt,name=capacity_class,json=capacityClass,proto3" json:"capacity_class,omitempty"`                          // Deck or standing passengers, who have no seat
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ManifestPassenger) Reset() {
+	*x = ManifestPassenger{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManifestPassenger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestPassenger) ProtoMessage() {}
+
+func (x *ManifestPassenger) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestPassenger.ProtoReflect.Descriptor instead.
+func (*ManifestPassenger) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ManifestPassenger) GetBookingId() string {
 	if x != nil {
-		return x.SeatConfig
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ManifestPassenger) GetPassengerName() string {
+	if x != nil {
+		return x.PassengerName
+	}
+	return ""
+}
+
+func (x *ManifestPassenger) GetPassengerNid() string {
+	if x != nil {
+		return x.PassengerNid
+	}
+	return ""
+}
+
+func (x *ManifestPassenger) GetFromStationId() string {
+	if x != nil {
+		return x.FromStationId
+	}
+	return ""
+}
+
+func (x *ManifestPassenger) GetToStationId() string {
+	if x != nil {
+		return x.ToStationId
+	}
+	return ""
+}
+
+func (x *ManifestPassenger) GetLegs() []*ManifestLeg {
+	if x != nil {
+		return x.Legs
 	}
 	return nil
 }
 
-type SegmentDefinition struct {
+func (x *ManifestPassenger) GetSeatChangeStationIds() []string {
+	if x != nil {
+		return x.SeatChangeStationIds
+	}
+	return nil
+}
+
+func (x *ManifestPassenger) GetCapacityClass() string {
+	if x != nil {
+		return x.CapacityClass
+	}
+	return ""
+}
+
+type ManifestLeg struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SegmentIndex  int32                  `protobuf:"varint,1,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
-	FromStationId string                 `protobuf:"bytes,2,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
-	ToStationId   string                 `protobuf:"bytes,3,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
-	DepartureTime int64                  `protobuf:"varint,4,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
-	ArrivalTime   int64                  `protobuf:"varint,5,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	SeatNumber    string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	FromStationId string                 `protobuf:"bytes,3,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId   string                 `protobuf:"bytes,4,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	TicketId      string                 `protobuf:"bytes,5,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SegmentDefinition) Reset() {
-	*x = SegmentDefinition{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[19]
+func (x *ManifestLeg) Reset() {
+	*x = ManifestLeg{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SegmentDefinition) String() string {
+func (x *ManifestLeg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SegmentDefinition) ProtoMessage() {}
+func (*ManifestLeg) ProtoMessage() {}
 
-func (x *SegmentDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[19]
+func (x *ManifestLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestLeg.ProtoReflect.Descriptor instead.
+func (*ManifestLeg) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ManifestLeg) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *ManifestLeg) GetSeatNumber() string {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return ""
+}
+
+func (x *ManifestLeg) GetFromStationId() string {
+	if x != nil {
+		return x.FromStationId
+	}
+	return ""
+}
+
+func (x *ManifestLeg) GetToStationId() string {
+	if x != nil {
+		return x.ToStationId
+	}
+	return ""
+}
+
+func (x *ManifestLeg) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+type GetReaccommodationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UnplacedOnly   bool                   `protobuf:"varint,3,opt,name=unplaced_only,json=unplacedOnly,proto3" json:"unplaced_only,omitempty"` // Only passengers still waiting for a refund or another trip
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetReaccommodationsRequest) Reset() {
+	*x = GetReaccommodationsRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReaccommodationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReaccommodationsRequest) ProtoMessage() {}
+
+func (x *GetReaccommodationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReaccommodationsRequest.ProtoReflect.Descriptor instead.
+func (*GetReaccommodationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetReaccommodationsRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *GetReaccommodationsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetReaccommodationsRequest) GetUnplacedOnly() bool {
+	if x != nil {
+		return x.UnplacedOnly
+	}
+	return false
+}
+
+type GetReaccommodationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Entries       []*Reaccommodation     `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReaccommodationsResponse) Reset() {
+	*x = GetReaccommodationsResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReaccommodationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReaccommodationsResponse) ProtoMessage() {}
+
+func (x *GetReaccommodationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReaccommodationsResponse.ProtoReflect.Descriptor instead.
+func (*GetReaccommodationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetReaccommodationsResponse) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *GetReaccommodationsResponse) GetEntries() []*Reaccommodation {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type Reaccommodation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceId    string                 `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`       // Booking or hold ID
+	ReferenceType  string                 `protobuf:"bytes,2,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"` // booking, hold
+	OrderId        string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PassengerIndex int32                  `protobuf:"varint,4,opt,name=passenger_index,json=passengerIndex,proto3" json:"passenger_index,omitempty"`
+	PassengerName  string                 `protobuf:"bytes,5,opt,name=passenger_name,json=passengerName,proto3" json:"passenger_name,omitempty"`
+	PassengerNid   string                 `protobuf:"bytes,6,opt,name=passenger_nid,json=passengerNid,proto3" json:"passenger_nid,omitempty"`
+	SeatClass      string                 `protobuf:"bytes,7,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	OldSeatId      string                 `protobuf:"bytes,8,opt,name=old_seat_id,json=oldSeatId,proto3" json:"old_seat_id,omitempty"`
+	OldSeatNumber  string                 `protobuf:"bytes,9,opt,name=old_seat_number,json=oldSeatNumber,proto3" json:"old_seat_number,omitempty"`
+	NewSeatId      string                 `protobuf:"bytes,10,opt,name=new_seat_id,json=newSeatId,proto3" json:"new_seat_id,omitempty"` // Empty when unplaced
+	NewSeatNumber  string                 `protobuf:"bytes,11,opt,name=new_seat_number,json=newSeatNumber,proto3" json:"new_seat_number,omitempty"`
+	OldTicketId    string                 `protobuf:"bytes,12,opt,name=old_ticket_id,json=oldTicketId,proto3" json:"old_ticket_id,omitempty"`
+	NewTicketId    string                 `protobuf:"bytes,13,opt,name=new_ticket_id,json=newTicketId,proto3" json:"new_ticket_id,omitempty"` // Reissued ticket for moved booking passengers
+	Status         string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`                                // moved, unplaced
+	OldVehicleId   string                 `protobuf:"bytes,15,opt,name=old_vehicle_id,json=oldVehicleId,proto3" json:"old_vehicle_id,omitempty"`
+	NewVehicleId   string                 `protobuf:"bytes,16,opt,name=new_vehicle_id,json=newVehicleId,proto3" json:"new_vehicle_id,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Reaccommodation) Reset() {
+	*x = Reaccommodation{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaccommodation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaccommodation) ProtoMessage() {}
+
+func (x *Reaccommodation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,72 +5425,157 @@ func (x *SegmentDefinition) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaccommodation.ProtoReflect.Descriptor instead.
+func (*Reaccommodation) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Reaccommodation) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *Reaccommodation) GetReferenceType() string {
+	if x != nil {
+		return x.ReferenceType
+	}
+	return ""
+}
+
+func (x *Reaccommodation) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Reaccommodation) GetPassengerIndex() int32 {
+	if x != nil {
+		return x.PassengerIndex
+	}
+	return 0
+}
+
+func (x *Reaccommodation) GetPassengerName() string {
+	if x != nil {
+		return x.PassengerName
+	}
+	return ""
+}
+
+func (x *Reaccommodation) GetPassengerNid() string {
+	if x != nil {
+		return x.PassengerNid
+	}
+	return ""
+}
+
+func (x *Reaccommodation) GetSeatClass() string {
+	if x != nil {
+		return x.SeatClass
+	}
+	return ""
+}
+
+func (x *Reaccommodation) GetOldSeatId() string {
+	if x != nil {
+		return x.OldSeatId
+	}
+	return ""
+}
+
+func (x *Reaccommodation) GetOldSeatNumber() string {
+	if x != nil {
+		return x.OldSeatNumber
+	}
+	return ""
+}
+
+func (x *Reaccommodation) GetNewSeatId() string {
+	if x != nil {
+		return x.NewSeatId
+	}
+	return ""
+}
+
+func (x *Reaccommodation) GetNewSeatNumber() string {
+	if x != nil {
+		return x.NewSeatNumber
+	}
+	return ""
 }
 
-// Deprecated: Use SegmentDefinition.ProtoReflect.Descriptor instead.
-func (*SegmentDefinition) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+func (x *Reaccommodation) GetOldTicketId() string {
+	if x != nil {
+		return x.OldTicketId
+	}
+	return ""
 }
 
-func (x *SegmentDefinition) GetSegmentIndex() int32 {
+func (x *Reaccommodation) GetNewTicketId() string {
 	if x != nil {
-		return x.SegmentIndex
+		return x.NewTicketId
 	}
-	return 0
+	return ""
 }
 
-func (x *SegmentDefinition) GetFromStationId() string {
+func (x *Reaccommodation) GetStatus() string {
 	if x != nil {
-		return x.FromStationId
+		return x.Status
 	}
 	return ""
 }
 
-func (x *SegmentDefinition) GetToStationId() string {
+func (x *Reaccommodation) GetOldVehicleId() string {
 	if x != nil {
-		return x.ToStationId
+		return x.OldVehicleId
 	}
 	return ""
 }
 
-func (x *SegmentDefinition) GetDepartureTime() int64 {
+func (x *Reaccommodation) GetNewVehicleId() string {
 	if x != nil {
-		return x.DepartureTime
+		return x.NewVehicleId
 	}
-	return 0
+	return ""
 }
 
-func (x *SegmentDefinition) GetArrivalTime() int64 {
+func (x *Reaccommodation) GetCreatedAt() int64 {
 	if x != nil {
-		return x.ArrivalTime
+		return x.CreatedAt
 	}
 	return 0
 }
 
-type SeatConfiguration struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalSeats    int32                  `protobuf:"varint,1,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
-	Seats         []*SeatDefinition      `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetSeatLedgerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	SeatId         string                 `protobuf:"bytes,3,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"` // Empty = every seat
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SeatConfiguration) Reset() {
-	*x = SeatConfiguration{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[20]
+func (x *GetSeatLedgerRequest) Reset() {
+	*x = GetSeatLedgerRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeatConfiguration) String() string {
+func (x *GetSeatLedgerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeatConfiguration) ProtoMessage() {}
+func (*GetSeatLedgerRequest) ProtoMessage() {}
 
-func (x *SeatConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[20]
+func (x *GetSeatLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,55 +5586,55 @@ func (x *SeatConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeatConfiguration.ProtoReflect.Descriptor instead.
-func (*SeatConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetSeatLedgerRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *SeatConfiguration) GetTotalSeats() int32 {
+func (x *GetSeatLedgerRequest) GetTripId() string {
 	if x != nil {
-		return x.TotalSeats
+		return x.TripId
 	}
-	return 0
+	return ""
 }
 
-func (x *SeatConfiguration) GetSeats() []*SeatDefinition {
+func (x *GetSeatLedgerRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Seats
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-type SeatDefinition struct {
+func (x *GetSeatLedgerRequest) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+type GetSeatLedgerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
-	SeatNumber    string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	Row           int32                  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
-	Column        int32                  `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
-	SeatType      string                 `protobuf:"bytes,5,opt,name=seat_type,json=seatType,proto3" json:"seat_type,omitempty"`
-	SeatClass     string                 `protobuf:"bytes,6,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
-	PricePaisa    int64                  `protobuf:"varint,7,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
-	IsAccessible  bool                   `protobuf:"varint,8,opt,name=is_accessible,json=isAccessible,proto3" json:"is_accessible,omitempty"`
-	HasPower      bool                   `protobuf:"varint,9,opt,name=has_power,json=hasPower,proto3" json:"has_power,omitempty"`
+	TripId        string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Entries       []*SeatTransition      `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SeatDefinition) Reset() {
-	*x = SeatDefinition{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[21]
+func (x *GetSeatLedgerResponse) Reset() {
+	*x = GetSeatLedgerResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeatDefinition) String() string {
+func (x *GetSeatLedgerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeatDefinition) ProtoMessage() {}
+func (*GetSeatLedgerResponse) ProtoMessage() {}
 
-func (x *SeatDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[21]
+func (x *GetSeatLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1592,98 +5645,55 @@ func (x *SeatDefinition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeatDefinition.ProtoReflect.Descriptor instead.
-func (*SeatDefinition) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *SeatDefinition) GetSeatId() string {
-	if x != nil {
-		return x.SeatId
-	}
-	return ""
-}
-
-func (x *SeatDefinition) GetSeatNumber() string {
-	if x != nil {
-		return x.SeatNumber
-	}
-	return ""
-}
-
-func (x *SeatDefinition) GetRow() int32 {
-	if x != nil {
-		return x.Row
-	}
-	return 0
-}
-
-func (x *SeatDefinition) GetColumn() int32 {
-	if x != nil {
-		return x.Column
-	}
-	return 0
-}
-
-func (x *SeatDefinition) GetSeatType() string {
-	if x != nil {
-		return x.SeatType
-	}
-	return ""
+// Deprecated: Use GetSeatLedgerResponse.ProtoReflect.Descriptor instead.
+func (*GetSeatLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *SeatDefinition) GetSeatClass() string {
+func (x *GetSeatLedgerResponse) GetTripId() string {
 	if x != nil {
-		return x.SeatClass
+		return x.TripId
 	}
 	return ""
 }
 
-func (x *SeatDefinition) GetPricePaisa() int64 {
-	if x != nil {
-		return x.PricePaisa
-	}
-	return 0
-}
-
-func (x *SeatDefinition) GetIsAccessible() bool {
-	if x != nil {
-		return x.IsAccessible
-	}
-	return false
-}
-
-func (x *SeatDefinition) GetHasPower() bool {
+func (x *GetSeatLedgerResponse) GetEntries() []*SeatTransition {
 	if x != nil {
-		return x.HasPower
+		return x.Entries
 	}
-	return false
+	return nil
 }
 
-type InitializeTripInventoryResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	SegmentsCreated int32                  `protobuf:"varint,2,opt,name=segments_created,json=segmentsCreated,proto3" json:"segments_created,omitempty"`
-	SeatsCreated    int32                  `protobuf:"varint,3,opt,name=seats_created,json=seatsCreated,proto3" json:"seats_created,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SeatTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SegmentIndex  int32                  `protobuf:"varint,1,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	SeatId        string                 `protobuf:"bytes,2,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Actor         string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"` // User ID, or hold-sweeper, seat-unblocker, reaccommodation, reconciler, system
+	HoldId        string                 `protobuf:"bytes,6,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,7,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	RecordedAt    int64                  `protobuf:"varint,9,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InitializeTripInventoryResponse) Reset() {
-	*x = InitializeTripInventoryResponse{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[22]
+func (x *SeatTransition) Reset() {
+	*x = SeatTransition{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitializeTripInventoryResponse) String() string {
+func (x *SeatTransition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitializeTripInventoryResponse) ProtoMessage() {}
+func (*SeatTransition) ProtoMessage() {}
 
-func (x *InitializeTripInventoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[22]
+func (x *SeatTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1694,116 +5704,109 @@ func (x *InitializeTripInventoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitializeTripInventoryResponse.ProtoReflect.Descriptor instead.
-func (*InitializeTripInventoryResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SeatTransition.ProtoReflect.Descriptor instead.
+func (*SeatTransition) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *InitializeTripInventoryResponse) GetSuccess() bool {
+func (x *SeatTransition) GetSegmentIndex() int32 {
 	if x != nil {
-		return x.Success
+		return x.SegmentIndex
 	}
-	return false
+	return 0
 }
 
-func (x *InitializeTripInventoryResponse) GetSegmentsCreated() int32 {
+func (x *SeatTransition) GetSeatId() string {
 	if x != nil {
-		return x.SegmentsCreated
+		return x.SeatId
 	}
-	return 0
+	return ""
 }
 
-func (x *InitializeTripInventoryResponse) GetSeatsCreated() int32 {
+func (x *SeatTransition) GetFromStatus() string {
 	if x != nil {
-		return x.SeatsCreated
+		return x.FromStatus
 	}
-	return 0
-}
-
-type UpdateInventoryRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Updates        []*SeatUpdate          `protobuf:"bytes,3,rep,name=updates,proto3" json:"updates,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *UpdateInventoryRequest) Reset() {
-	*x = UpdateInventoryRequest{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateInventoryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*UpdateInventoryRequest) ProtoMessage() {}
-
-func (x *UpdateInventoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[23]
+func (x *SeatTransition) GetToStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ToStatus
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateInventoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateInventoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+func (x *SeatTransition) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
 }
 
-func (x *UpdateInventoryRequest) GetTripId() string {
+func (x *SeatTransition) GetHoldId() string {
 	if x != nil {
-		return x.TripId
+		return x.HoldId
 	}
 	return ""
 }
 
-func (x *UpdateInventoryRequest) GetOrganizationId() string {
+func (x *SeatTransition) GetBookingId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.BookingId
 	}
 	return ""
 }
 
-func (x *UpdateInventoryRequest) GetUpdates() []*SeatUpdate {
+func (x *SeatTransition) GetReason() string {
 	if x != nil {
-		return x.Updates
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type SeatUpdate struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
-	NewStatus     SeatStatus             `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3,enum=inventory.v1.SeatStatus" json:"new_status,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // "maintenance", "staff_reserved", etc.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SeatTransition) GetRecordedAt() int64 {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return 0
 }
 
-func (x *SeatUpdate) Reset() {
-	*x = SeatUpdate{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[24]
+// ReleaseTranche holds seats back until release_at, or until a time before each trip's departure.
+// Give seat_ids or seat_numbers, or a percentage of the trip's seats. Without a release time
+// the tranche stays held back until released by hand.
+type ReleaseTranche struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	TrancheId                   string                 `protobuf:"bytes,1,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	Name                        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Percentage                  int32                  `protobuf:"varint,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	SeatIds                     []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"` // On trip tranches: the seats held back
+	SeatNumbers                 []string               `protobuf:"bytes,5,rep,name=seat_numbers,json=seatNumbers,proto3" json:"seat_numbers,omitempty"`
+	ReleaseAt                   int64                  `protobuf:"varint,6,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`                                                           // Unix timestamp
+	ReleaseHoursBeforeDeparture int32                  `protobuf:"varint,7,opt,name=release_hours_before_departure,json=releaseHoursBeforeDeparture,proto3" json:"release_hours_before_departure,omitempty"` // Used when release_at is 0
+	Status                      string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                                                                                   // pending, released
+	ReleasedAt                  int64                  `protobuf:"varint,9,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+	TripId                      string                 `protobuf:"bytes,10,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	ScheduleId                  string                 `protobuf:"bytes,11,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ReleaseTranche) Reset() {
+	*x = ReleaseTranche{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeatUpdate) String() string {
+func (x *ReleaseTranche) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeatUpdate) ProtoMessage() {}
+func (*ReleaseTranche) ProtoMessage() {}
 
-func (x *SeatUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[24]
+func (x *ReleaseTranche) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1814,110 +5817,116 @@ func (x *SeatUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeatUpdate.ProtoReflect.Descriptor instead.
-func (*SeatUpdate) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ReleaseTranche.ProtoReflect.Descriptor instead.
+func (*ReleaseTranche) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *SeatUpdate) GetSeatId() string {
+func (x *ReleaseTranche) GetTrancheId() string {
 	if x != nil {
-		return x.SeatId
+		return x.TrancheId
 	}
 	return ""
 }
 
-func (x *SeatUpdate) GetNewStatus() SeatStatus {
+func (x *ReleaseTranche) GetName() string {
 	if x != nil {
-		return x.NewStatus
+		return x.Name
 	}
-	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *SeatUpdate) GetReason() string {
+func (x *ReleaseTranche) GetPercentage() int32 {
 	if x != nil {
-		return x.Reason
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *ReleaseTranche) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
 	}
-	return ""
+	return nil
 }
 
-type UpdateInventoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	UpdatedCount  int32                  `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ReleaseTranche) GetSeatNumbers() []string {
+	if x != nil {
+		return x.SeatNumbers
+	}
+	return nil
 }
 
-func (x *UpdateInventoryResponse) Reset() {
-	*x = UpdateInventoryResponse{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[25]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ReleaseTranche) GetReleaseAt() int64 {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return 0
 }
 
-func (x *UpdateInventoryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReleaseTranche) GetReleaseHoursBeforeDeparture() int32 {
+	if x != nil {
+		return x.ReleaseHoursBeforeDeparture
+	}
+	return 0
 }
 
-func (*UpdateInventoryResponse) ProtoMessage() {}
-
-func (x *UpdateInventoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[25]
+func (x *ReleaseTranche) GetStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateInventoryResponse.ProtoReflect.Descriptor instead.
-func (*UpdateInventoryResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+func (x *ReleaseTranche) GetReleasedAt() int64 {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return 0
 }
 
-func (x *UpdateInventoryResponse) GetSuccess() bool {
+func (x *ReleaseTranche) GetTripId() string {
 	if x != nil {
-		return x.Success
+		return x.TripId
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateInventoryResponse) GetUpdatedCount() int32 {
+func (x *ReleaseTranche) GetScheduleId() string {
 	if x != nil {
-		return x.UpdatedCount
+		return x.ScheduleId
 	}
-	return 0
+	return ""
 }
 
-type JoinWaitlistRequest struct {
+// CreateReleaseTranchesRequest targets one trip, or with schedule_id every trip generated
+// from the schedule, including trips generated later
+type CreateReleaseTranchesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SeatClass      string                 `protobuf:"bytes,3,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
-	RequestedSeats int32                  `protobuf:"varint,4,opt,name=requested_seats,json=requestedSeats,proto3" json:"requested_seats,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TripId         string                 `protobuf:"bytes,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	ScheduleId     string                 `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Tranches       []*ReleaseTranche      `protobuf:"bytes,4,rep,name=tranches,proto3" json:"tranches,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *JoinWaitlistRequest) Reset() {
-	*x = JoinWaitlistRequest{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[26]
+func (x *CreateReleaseTranchesRequest) Reset() {
+	*x = CreateReleaseTranchesRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinWaitlistRequest) String() string {
+func (x *CreateReleaseTranchesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinWaitlistRequest) ProtoMessage() {}
+func (*CreateReleaseTranchesRequest) ProtoMessage() {}
 
-func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[26]
+func (x *CreateReleaseTranchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1928,70 +5937,68 @@ func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
-func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CreateReleaseTranchesRequest.ProtoReflect.Descriptor instead.
+func (*CreateReleaseTranchesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *JoinWaitlistRequest) GetTripId() string {
+func (x *CreateReleaseTranchesRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.TripId
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *JoinWaitlistRequest) GetUserId() string {
+func (x *CreateReleaseTranchesRequest) GetTripId() string {
 	if x != nil {
-		return x.UserId
+		return x.TripId
 	}
 	return ""
 }
 
-func (x *JoinWaitlistRequest) GetSeatClass() string {
+func (x *CreateReleaseTranchesRequest) GetScheduleId() string {
 	if x != nil {
-		return x.SeatClass
+		return x.ScheduleId
 	}
 	return ""
 }
 
-func (x *JoinWaitlistRequest) GetRequestedSeats() int32 {
+func (x *CreateReleaseTranchesRequest) GetTranches() []*ReleaseTranche {
 	if x != nil {
-		return x.RequestedSeats
+		return x.Tranches
 	}
-	return 0
+	return nil
 }
 
-func (x *JoinWaitlistRequest) GetOrganizationId() string {
+func (x *CreateReleaseTranchesRequest) GetCreatedBy() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.CreatedBy
 	}
 	return ""
 }
 
-type JoinWaitlistResponse struct {
+type CreateReleaseTranchesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Tranches      []*ReleaseTranche      `protobuf:"bytes,1,rep,name=tranches,proto3" json:"tranches,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinWaitlistResponse) Reset() {
-	*x = JoinWaitlistResponse{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[27]
+func (x *CreateReleaseTranchesResponse) Reset() {
+	*x = CreateReleaseTranchesResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinWaitlistResponse) String() string {
+func (x *CreateReleaseTranchesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinWaitlistResponse) ProtoMessage() {}
+func (*CreateReleaseTranchesResponse) ProtoMessage() {}
 
-func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[27]
+func (x *CreateReleaseTranchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2002,54 +6009,100 @@ func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinWaitlistResponse.ProtoReflect.Descriptor instead.
-func (*JoinWaitlistResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use CreateReleaseTranchesResponse.ProtoReflect.Descriptor instead.
+func (*CreateReleaseTranchesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *JoinWaitlistResponse) GetSuccess() bool {
+func (x *CreateReleaseTranchesResponse) GetTranches() []*ReleaseTranche {
 	if x != nil {
-		return x.Success
+		return x.Tranches
 	}
-	return false
+	return nil
 }
 
-func (x *JoinWaitlistResponse) GetMessage() string {
+type ListReleaseTranchesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TripId         string                 `protobuf:"bytes,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	ScheduleId     string                 `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListReleaseTranchesRequest) Reset() {
+	*x = ListReleaseTranchesRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReleaseTranchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReleaseTranchesRequest) ProtoMessage() {}
+
+func (x *ListReleaseTranchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[81]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReleaseTranchesRequest.ProtoReflect.Descriptor instead.
+func (*ListReleaseTranchesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListReleaseTranchesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *JoinWaitlistResponse) GetPosition() int32 {
+func (x *ListReleaseTranchesRequest) GetTripId() string {
 	if x != nil {
-		return x.Position
+		return x.TripId
 	}
-	return 0
+	return ""
 }
 
-type GetUserWaitlistRequest struct {
+func (x *ListReleaseTranchesRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type ListReleaseTranchesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tranches      []*ReleaseTranche      `protobuf:"bytes,1,rep,name=tranches,proto3" json:"tranches,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserWaitlistRequest) Reset() {
-	*x = GetUserWaitlistRequest{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[28]
+func (x *ListReleaseTranchesResponse) Reset() {
+	*x = ListReleaseTranchesResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserWaitlistRequest) String() string {
+func (x *ListReleaseTranchesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserWaitlistRequest) ProtoMessage() {}
+func (*ListReleaseTranchesResponse) ProtoMessage() {}
 
-func (x *GetUserWaitlistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[28]
+func (x *ListReleaseTranchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2060,45 +6113,45 @@ func (x *GetUserWaitlistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserWaitlistRequest.ProtoReflect.Descriptor instead.
-func (*GetUserWaitlistRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListReleaseTranchesResponse.ProtoReflect.Descriptor instead.
+func (*ListReleaseTranchesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GetUserWaitlistRequest) GetUserId() string {
+func (x *ListReleaseTranchesResponse) GetTranches() []*ReleaseTranche {
 	if x != nil {
-		return x.UserId
+		return x.Tranches
 	}
-	return ""
+	return nil
 }
 
-type WaitlistEntry struct {
+// ReleaseTrancheRequest puts a tranche on sale now, ahead of its release time
+type ReleaseTrancheRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	SeatClass      string                 `protobuf:"bytes,3,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
-	RequestedSeats int32                  `protobuf:"varint,4,opt,name=requested_seats,json=requestedSeats,proto3" json:"requested_seats,omitempty"`
-	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt      string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TripId         string                 `protobuf:"bytes,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	ScheduleId     string                 `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	TrancheId      string                 `protobuf:"bytes,4,opt,name=tranche_id,json=trancheId,proto3" json:"tranche_id,omitempty"`
+	ReleasedBy     string                 `protobuf:"bytes,5,opt,name=released_by,json=releasedBy,proto3" json:"released_by,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *WaitlistEntry) Reset() {
-	*x = WaitlistEntry{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[29]
+func (x *ReleaseTrancheRequest) Reset() {
+	*x = ReleaseTrancheRequest{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WaitlistEntry) String() string {
+func (x *ReleaseTrancheRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WaitlistEntry) ProtoMessage() {}
+func (*ReleaseTrancheRequest) ProtoMessage() {}
 
-func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[29]
+func (x *ReleaseTrancheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2109,75 +6162,68 @@ func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
-func (*WaitlistEntry) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *WaitlistEntry) GetTripId() string {
-	if x != nil {
-		return x.TripId
-	}
-	return ""
+// Deprecated: Use ReleaseTrancheRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseTrancheRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *WaitlistEntry) GetOrganizationId() string {
+func (x *ReleaseTrancheRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *WaitlistEntry) GetSeatClass() string {
+func (x *ReleaseTrancheRequest) GetTripId() string {
 	if x != nil {
-		return x.SeatClass
+		return x.TripId
 	}
 	return ""
 }
 
-func (x *WaitlistEntry) GetRequestedSeats() int32 {
+func (x *ReleaseTrancheRequest) GetScheduleId() string {
 	if x != nil {
-		return x.RequestedSeats
+		return x.ScheduleId
 	}
-	return 0
+	return ""
 }
 
-func (x *WaitlistEntry) GetStatus() string {
+func (x *ReleaseTrancheRequest) GetTrancheId() string {
 	if x != nil {
-		return x.Status
+		return x.TrancheId
 	}
 	return ""
 }
 
-func (x *WaitlistEntry) GetCreatedAt() string {
+func (x *ReleaseTrancheRequest) GetReleasedBy() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ReleasedBy
 	}
 	return ""
 }
 
-type GetUserWaitlistResponse struct {
+type ReleaseTrancheResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*WaitlistEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	ReleasedCount int32                  `protobuf:"varint,1,opt,name=released_count,json=releasedCount,proto3" json:"released_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserWaitlistResponse) Reset() {
-	*x = GetUserWaitlistResponse{}
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[30]
+func (x *ReleaseTrancheResponse) Reset() {
+	*x = ReleaseTrancheResponse{}
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserWaitlistResponse) String() string {
+func (x *ReleaseTrancheResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserWaitlistResponse) ProtoMessage() {}
+func (*ReleaseTrancheResponse) ProtoMessage() {}
 
-func (x *GetUserWaitlistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[30]
+func (x *ReleaseTrancheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_inventory_v1_inventory_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2188,23 +6234,23 @@ func (x *GetUserWaitlistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserWaitlistResponse.ProtoReflect.Descriptor instead.
-func (*GetUserWaitlistResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ReleaseTrancheResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseTrancheResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_inventory_v1_inventory_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetUserWaitlistResponse) GetEntries() []*WaitlistEntry {
+func (x *ReleaseTrancheResponse) GetReleasedCount() int32 {
 	if x != nil {
-		return x.Entries
+		return x.ReleasedCount
 	}
-	return nil
+	return 0
 }
 
 var File_api_proto_inventory_v1_inventory_proto protoreflect.FileDescriptor
 
 const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
-	"&api/proto/inventory/v1/inventory.proto\x12\finventory.v1\"\xe7\x01\n" +
+	"&api/proto/inventory/v1/inventory.proto\x12\finventory.v1\"\x94\x03\n" +
 	"\x18CheckAvailabilityRequest\x12\x17\n" +
 	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12&\n" +
 	"\x0ffrom_station_id\x18\x02 \x01(\tR\rfromStationId\x12\"\n" +
@@ -2214,7 +6260,10 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"passengers\x12\x1d\n" +
 	"\n" +
 	"seat_class\x18\x05 \x01(\tR\tseatClass\x12'\n" +
-	"\x0forganization_id\x18\x06 \x01(\tR\x0eorganizationId\"\xdd\x01\n" +
+	"\x0forganization_id\x18\x06 \x01(\tR\x0eorganizationId\x12<\n" +
+	"\fquota_claims\x18\a \x01(\v2\x19.inventory.v1.QuotaClaimsR\vquotaClaims\x12*\n" +
+	"\x11allow_seat_change\x18\b \x01(\bR\x0fallowSeatChange\x12A\n" +
+	"\x0ecapacity_items\x18\t \x03(\v2\x1a.inventory.v1.CapacityItemR\rcapacityItems\"\xa0\x03\n" +
 	"\x19CheckAvailabilityResponse\x12!\n" +
 	"\fis_available\x18\x01 \x01(\bR\visAvailable\x12'\n" +
 	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats\x124\n" +
@@ -2222,7 +6271,49 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"\vprice_paisa\x18\x04 \x01(\x03R\n" +
 	"pricePaisa\x12\x1d\n" +
 	"\n" +
-	"checked_at\x18\x05 \x01(\x03R\tcheckedAt\"\xe2\x01\n" +
+	"checked_at\x18\x05 \x01(\x03R\tcheckedAt\x127\n" +
+	"\x06quotas\x18\x06 \x03(\v2\x1f.inventory.v1.QuotaAvailabilityR\x06quotas\x12H\n" +
+	"\x11seat_change_plans\x18\a \x03(\v2\x1c.inventory.v1.SeatChangePlanR\x0fseatChangePlans\x12>\n" +
+	"\bcapacity\x18\b \x03(\v2\".inventory.v1.CapacityAvailabilityR\bcapacity\"Q\n" +
+	"\fCapacityItem\x12%\n" +
+	"\x0ecapacity_class\x18\x01 \x01(\tR\rcapacityClass\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x99\x01\n" +
+	"\x14CapacityAvailability\x12%\n" +
+	"\x0ecapacity_class\x18\x01 \x01(\tR\rcapacityClass\x12\x1b\n" +
+	"\tmax_count\x18\x02 \x01(\x05R\bmaxCount\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x05R\tavailable\x12\x1f\n" +
+	"\vprice_paisa\x18\x04 \x01(\x03R\n" +
+	"pricePaisa\"\x85\x01\n" +
+	"\x0eSeatChangePlan\x12'\n" +
+	"\x0fpassenger_index\x18\x01 \x01(\x05R\x0epassengerIndex\x12)\n" +
+	"\x04legs\x18\x02 \x03(\v2\x15.inventory.v1.SeatLegR\x04legs\x12\x1f\n" +
+	"\vprice_paisa\x18\x03 \x01(\x03R\n" +
+	"pricePaisa\"\xf8\x01\n" +
+	"\aSeatLeg\x12\x17\n" +
+	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x1f\n" +
+	"\vseat_number\x18\x02 \x01(\tR\n" +
+	"seatNumber\x12\x1d\n" +
+	"\n" +
+	"seat_class\x18\x03 \x01(\tR\tseatClass\x12&\n" +
+	"\x0ffrom_station_id\x18\x04 \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\x05 \x01(\tR\vtoStationId\x12'\n" +
+	"\x0fsegment_indexes\x18\x06 \x03(\x05R\x0esegmentIndexes\x12\x1f\n" +
+	"\vprice_paisa\x18\a \x01(\x03R\n" +
+	"pricePaisa\"\x8c\x01\n" +
+	"\vQuotaClaims\x12\x16\n" +
+	"\x06female\x18\x01 \x01(\bR\x06female\x12\x1a\n" +
+	"\bdisabled\x18\x02 \x01(\bR\bdisabled\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x1d\n" +
+	"\n" +
+	"partner_id\x18\x04 \x01(\tR\tpartnerId\x12\x10\n" +
+	"\x03vip\x18\x05 \x01(\bR\x03vip\"\xa6\x01\n" +
+	"\x11QuotaAvailability\x12\x19\n" +
+	"\bquota_id\x18\x01 \x01(\tR\aquotaId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"quota_type\x18\x03 \x01(\tR\tquotaType\x12'\n" +
+	"\x0favailable_seats\x18\x04 \x01(\x05R\x0eavailableSeats\x12\x1a\n" +
+	"\beligible\x18\x05 \x01(\bR\beligible\"\xe2\x01\n" +
 	"\x10SeatAvailability\x12\x17\n" +
 	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x1f\n" +
 	"\vseat_number\x18\x02 \x01(\tR\n" +
@@ -2235,7 +6326,29 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x11BatchCheckRequest\x12B\n" +
 	"\brequests\x18\x01 \x03(\v2&.inventory.v1.CheckAvailabilityRequestR\brequests\"W\n" +
 	"\x12BatchCheckResponse\x12A\n" +
-	"\aresults\x18\x01 \x03(\v2'.inventory.v1.CheckAvailabilityResponseR\aresults\"\xa7\x02\n" +
+	"\aresults\x18\x01 \x03(\v2'.inventory.v1.CheckAvailabilityResponseR\aresults\"\xa6\x01\n" +
+	"\x16AvailabilityCountQuery\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\tR\x06tripId\x12&\n" +
+	"\x0ffrom_station_id\x18\x03 \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\x04 \x01(\tR\vtoStationId\"^\n" +
+	"\x1cGetAvailabilityCountsRequest\x12>\n" +
+	"\aqueries\x18\x01 \x03(\v2$.inventory.v1.AvailabilityCountQueryR\aqueries\"U\n" +
+	"\x16ClassAvailabilityCount\x12\x1d\n" +
+	"\n" +
+	"seat_class\x18\x01 \x01(\tR\tseatClass\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\x05R\tavailable\"\xe6\x02\n" +
+	"\x17AvailabilityCountResult\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\tR\x06tripId\x12&\n" +
+	"\x0ffrom_station_id\x18\x03 \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\x04 \x01(\tR\vtoStationId\x12'\n" +
+	"\x0favailable_seats\x18\x05 \x01(\x05R\x0eavailableSeats\x12>\n" +
+	"\aclasses\x18\x06 \x03(\v2$.inventory.v1.ClassAvailabilityCountR\aclasses\x12>\n" +
+	"\bcapacity\x18\a \x03(\v2\".inventory.v1.CapacityAvailabilityR\bcapacity\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"`\n" +
+	"\x1dGetAvailabilityCountsResponse\x12?\n" +
+	"\aresults\x18\x01 \x03(\v2%.inventory.v1.AvailabilityCountResultR\aresults\"\xc5\x04\n" +
 	"\x10HoldSeatsRequest\x12\x17\n" +
 	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12&\n" +
 	"\x0ffrom_station_id\x18\x02 \x01(\tR\rfromStationId\x12\"\n" +
@@ -2245,7 +6358,24 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
 	"session_id\x18\x06 \x01(\tR\tsessionId\x122\n" +
 	"\x15hold_duration_seconds\x18\a \x01(\x05R\x13holdDurationSeconds\x12'\n" +
-	"\x0forganization_id\x18\b \x01(\tR\x0eorganizationId\"\xd8\x01\n" +
+	"\x0forganization_id\x18\b \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"seat_count\x18\t \x01(\x05R\tseatCount\x12?\n" +
+	"\vpreferences\x18\n" +
+	" \x01(\v2\x1d.inventory.v1.SeatPreferencesR\vpreferences\x12<\n" +
+	"\fquota_claims\x18\v \x01(\v2\x19.inventory.v1.QuotaClaimsR\vquotaClaims\x12;\n" +
+	"\tseat_legs\x18\f \x03(\v2\x1e.inventory.v1.SeatLegSelectionR\bseatLegs\x12A\n" +
+	"\x0ecapacity_items\x18\r \x03(\v2\x1a.inventory.v1.CapacityItemR\rcapacityItems\"\xa0\x01\n" +
+	"\x10SeatLegSelection\x12'\n" +
+	"\x0fpassenger_index\x18\x01 \x01(\x05R\x0epassengerIndex\x12\x17\n" +
+	"\aseat_id\x18\x02 \x01(\tR\x06seatId\x12&\n" +
+	"\x0ffrom_station_id\x18\x03 \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\x04 \x01(\tR\vtoStationId\"w\n" +
+	"\x0fSeatPreferences\x12\x1d\n" +
+	"\n" +
+	"seat_class\x18\x01 \x01(\tR\tseatClass\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\tR\bposition\x12)\n" +
+	"\x10require_together\x18\x03 \x01(\bR\x0frequireTogether\"\x99\x02\n" +
 	"\x11HoldSeatsResponse\x12\x17\n" +
 	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\"\n" +
@@ -2253,14 +6383,75 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x0ffailed_seat_ids\x18\x04 \x03(\tR\rfailedSeatIds\x12\x1d\n" +
 	"\n" +
 	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x12%\n" +
-	"\x0efailure_reason\x18\x06 \x01(\tR\rfailureReason\"p\n" +
+	"\x0efailure_reason\x18\x06 \x01(\tR\rfailureReason\x12?\n" +
+	"\rheld_capacity\x18\a \x03(\v2\x1a.inventory.v1.CapacityItemR\fheldCapacity\"p\n" +
 	"\x13ReleaseSeatsRequest\x12\x17\n" +
 	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
 	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\"W\n" +
 	"\x14ReleaseSeatsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
-	"\x0ereleased_count\x18\x02 \x01(\x05R\rreleasedCount\"\xca\x01\n" +
+	"\x0ereleased_count\x18\x02 \x01(\x05R\rreleasedCount\"\xb3\x02\n" +
+	"\x11BlockSeatsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\tR\x06tripId\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\tR\n" +
+	"scheduleId\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x12!\n" +
+	"\fseat_numbers\x18\x05 \x03(\tR\vseatNumbers\x12'\n" +
+	"\x0fsegment_indexes\x18\x06 \x03(\x05R\x0esegmentIndexes\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"unblock_at\x18\b \x01(\x03R\tunblockAt\x12\x1d\n" +
+	"\n" +
+	"blocked_by\x18\t \x01(\tR\tblockedBy\"\x8d\x01\n" +
+	"\x12BlockSeatsResponse\x12#\n" +
+	"\rblocked_count\x18\x01 \x01(\x05R\fblockedCount\x127\n" +
+	"\x05trips\x18\x02 \x03(\v2!.inventory.v1.TripSeatBlockResultR\x05trips\x12\x19\n" +
+	"\bblock_id\x18\x03 \x01(\tR\ablockId\"\x80\x01\n" +
+	"\x13TripSeatBlockResult\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12(\n" +
+	"\x10blocked_seat_ids\x18\x02 \x03(\tR\x0eblockedSeatIds\x12&\n" +
+	"\x0ffailed_seat_ids\x18\x03 \x03(\tR\rfailedSeatIds\"\x9d\x02\n" +
+	"\x13UnblockSeatsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\tR\x06tripId\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\tR\n" +
+	"scheduleId\x12\x19\n" +
+	"\bblock_id\x18\x04 \x01(\tR\ablockId\x12\x19\n" +
+	"\bseat_ids\x18\x05 \x03(\tR\aseatIds\x12!\n" +
+	"\fseat_numbers\x18\x06 \x03(\tR\vseatNumbers\x12'\n" +
+	"\x0fsegment_indexes\x18\a \x03(\x05R\x0esegmentIndexes\x12!\n" +
+	"\funblocked_by\x18\b \x01(\tR\vunblockedBy\"?\n" +
+	"\x14UnblockSeatsResponse\x12'\n" +
+	"\x0funblocked_count\x18\x01 \x01(\x05R\x0eunblockedCount\"\xb9\x01\n" +
+	"\x11ExtendHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12*\n" +
+	"\x11extend_by_seconds\x18\x04 \x01(\x05R\x0fextendBySeconds\x12\x1d\n" +
+	"\n" +
+	"for_review\x18\x05 \x01(\bR\tforReview\"\xa7\x01\n" +
+	"\x12ExtendHoldResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\x121\n" +
+	"\x14extensions_remaining\x18\x03 \x01(\x05R\x13extensionsRemaining\x12%\n" +
+	"\x0efailure_reason\x18\x04 \x01(\tR\rfailureReason\"\xc4\x01\n" +
+	"\n" +
+	"HoldPolicy\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x129\n" +
+	"\x19max_hold_lifetime_seconds\x18\x02 \x01(\x05R\x16maxHoldLifetimeSeconds\x12%\n" +
+	"\x0emax_extensions\x18\x03 \x01(\x05R\rmaxExtensions\x12+\n" +
+	"\x11extension_seconds\x18\x04 \x01(\x05R\x10extensionSeconds\"H\n" +
+	"\x14SetHoldPolicyRequest\x120\n" +
+	"\x06policy\x18\x01 \x01(\v2\x18.inventory.v1.HoldPolicyR\x06policy\"1\n" +
+	"\x15SetHoldPolicyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"?\n" +
+	"\x14GetHoldPolicyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"I\n" +
+	"\x15GetHoldPolicyResponse\x120\n" +
+	"\x06policy\x18\x01 \x01(\v2\x18.inventory.v1.HoldPolicyR\x06policy\"\xca\x01\n" +
 	"\x15ConfirmBookingRequest\x12\x17\n" +
 	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
@@ -2278,17 +6469,45 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
 	"booking_id\x18\x02 \x01(\tR\tbookingId\x12D\n" +
 	"\x0fconfirmed_seats\x18\x03 \x03(\v2\x1b.inventory.v1.ConfirmedSeatR\x0econfirmedSeats\x12%\n" +
-	"\x0efailure_reason\x18\x04 \x01(\tR\rfailureReason\"f\n" +
+	"\x0efailure_reason\x18\x04 \x01(\tR\rfailureReason\"\xc2\x02\n" +
 	"\rConfirmedSeat\x12\x17\n" +
 	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x1f\n" +
 	"\vseat_number\x18\x02 \x01(\tR\n" +
 	"seatNumber\x12\x1b\n" +
-	"\tticket_id\x18\x03 \x01(\tR\bticketId\"\xa1\x01\n" +
+	"\tticket_id\x18\x03 \x01(\tR\bticketId\x12'\n" +
+	"\x0fpassenger_index\x18\x04 \x01(\x05R\x0epassengerIndex\x12&\n" +
+	"\x0ffrom_station_id\x18\x05 \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\x06 \x01(\tR\vtoStationId\x12\x1d\n" +
+	"\n" +
+	"seat_class\x18\a \x01(\tR\tseatClass\x12\x1f\n" +
+	"\vprice_paisa\x18\b \x01(\x03R\n" +
+	"pricePaisa\x12%\n" +
+	"\x0ecapacity_class\x18\t \x01(\tR\rcapacityClass\"\xa6\x01\n" +
+	"\x14CancelBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12+\n" +
+	"\x11passenger_indexes\x18\x04 \x03(\x05R\x10passengerIndexes\"X\n" +
+	"\x15CancelBookingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x0ereleased_count\x18\x02 \x01(\x05R\rreleasedCount\"\xf2\x01\n" +
+	"\x18TransferPassengerRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12'\n" +
+	"\x0fpassenger_index\x18\x04 \x01(\x05R\x0epassengerIndex\x12#\n" +
+	"\rpassenger_nid\x18\x05 \x01(\tR\fpassengerNid\x12%\n" +
+	"\x0epassenger_name\x18\x06 \x01(\tR\rpassengerName\"@\n" +
+	"\x19TransferPassengerResponse\x12#\n" +
+	"\rseats_updated\x18\x01 \x01(\x05R\fseatsUpdated\"\xd5\x01\n" +
 	"\x11GetSeatMapRequest\x12\x17\n" +
 	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12&\n" +
 	"\x0ffrom_station_id\x18\x02 \x01(\tR\rfromStationId\x12\"\n" +
 	"\rto_station_id\x18\x03 \x01(\tR\vtoStationId\x12'\n" +
-	"\x0forganization_id\x18\x04 \x01(\tR\x0eorganizationId\"\xfa\x01\n" +
+	"\x0forganization_id\x18\x04 \x01(\tR\x0eorganizationId\x122\n" +
+	"\x15include_block_details\x18\x05 \x01(\bR\x13includeBlockDetails\"\xb1\x02\n" +
 	"\x12GetSeatMapResponse\x12\x1d\n" +
 	"\n" +
 	"vehicle_id\x18\x01 \x01(\tR\tvehicleId\x12!\n" +
@@ -2297,11 +6516,23 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"total_rows\x18\x03 \x01(\x05R\ttotalRows\x12#\n" +
 	"\rtotal_columns\x18\x04 \x01(\x05R\ftotalColumns\x12)\n" +
 	"\x04rows\x18\x05 \x03(\v2\x15.inventory.v1.SeatRowR\x04rows\x123\n" +
-	"\x06legend\x18\x06 \x01(\v2\x1b.inventory.v1.SeatMapLegendR\x06legend\"V\n" +
+	"\x06legend\x18\x06 \x01(\v2\x1b.inventory.v1.SeatMapLegendR\x06legend\x125\n" +
+	"\bsections\x18\a \x03(\v2\x19.inventory.v1.SeatSectionR\bsections\"\x89\x02\n" +
+	"\vSeatSection\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x01 \x01(\tR\tsectionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x05 \x01(\x05R\ttotalRows\x12#\n" +
+	"\rtotal_columns\x18\x06 \x01(\x05R\ftotalColumns\x12.\n" +
+	"\x13aisle_after_columns\x18\a \x03(\x05R\x11aisleAfterColumns\x12)\n" +
+	"\x04rows\x18\b \x03(\v2\x15.inventory.v1.SeatRowR\x04rows\"V\n" +
 	"\aSeatRow\x12\x1d\n" +
 	"\n" +
 	"row_number\x18\x01 \x01(\x05R\trowNumber\x12,\n" +
-	"\x05seats\x18\x02 \x03(\v2\x16.inventory.v1.SeatCellR\x05seats\"\xf5\x02\n" +
+	"\x05seats\x18\x02 \x03(\v2\x16.inventory.v1.SeatCellR\x05seats\"\xef\x04\n" +
 	"\bSeatCell\x12\x17\n" +
 	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x1f\n" +
 	"\vseat_number\x18\x02 \x01(\tR\n" +
@@ -2317,7 +6548,19 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"\thas_power\x18\t \x01(\bR\bhasPower\x12\x1e\n" +
 	"\vis_exit_row\x18\n" +
 	" \x01(\bR\tisExitRow\x12&\n" +
-	"\x0fhold_expires_at\x18\v \x01(\x03R\rholdExpiresAt\"\xb5\x02\n" +
+	"\x0fhold_expires_at\x18\v \x01(\x03R\rholdExpiresAt\x12\x10\n" +
+	"\x03row\x18\f \x01(\x05R\x03row\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\r \x01(\tR\tsectionId\x12\x14\n" +
+	"\x05berth\x18\x0e \x01(\tR\x05berth\x12J\n" +
+	"\x10segment_statuses\x18\x0f \x03(\v2\x1f.inventory.v1.SegmentSeatStatusR\x0fsegmentStatuses\x12\x1d\n" +
+	"\n" +
+	"quota_type\x18\x10 \x01(\tR\tquotaType\x12!\n" +
+	"\fblock_reason\x18\x11 \x01(\tR\vblockReason\x12#\n" +
+	"\rblocked_until\x18\x12 \x01(\x03R\fblockedUntil\"j\n" +
+	"\x11SegmentSeatStatus\x12#\n" +
+	"\rsegment_index\x18\x01 \x01(\x05R\fsegmentIndex\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.inventory.v1.SeatStatusR\x06status\"\xb5\x02\n" +
 	"\rSeatMapLegend\x12R\n" +
 	"\rstatus_colors\x18\x01 \x03(\v2-.inventory.v1.SeatMapLegend.StatusColorsEntryR\fstatusColors\x12O\n" +
 	"\fclass_colors\x18\x02 \x03(\v2,.inventory.v1.SeatMapLegend.ClassColorsEntryR\vclassColors\x1a?\n" +
@@ -2326,7 +6569,7 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
 	"\x10ClassColorsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x80\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x96\x03\n" +
 	"\x1eInitializeTripInventoryRequest\x12\x17\n" +
 	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1d\n" +
@@ -2334,17 +6577,49 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"vehicle_id\x18\x03 \x01(\tR\tvehicleId\x12;\n" +
 	"\bsegments\x18\x04 \x03(\v2\x1f.inventory.v1.SegmentDefinitionR\bsegments\x12@\n" +
 	"\vseat_config\x18\x05 \x01(\v2\x1f.inventory.v1.SeatConfigurationR\n" +
-	"seatConfig\"\xce\x01\n" +
+	"seatConfig\x125\n" +
+	"\x06quotas\x18\x06 \x03(\v2\x1d.inventory.v1.QuotaDefinitionR\x06quotas\x12\x1f\n" +
+	"\vschedule_id\x18\a \x01(\tR\n" +
+	"scheduleId\x12<\n" +
+	"\bcapacity\x18\b \x03(\v2 .inventory.v1.CapacityDefinitionR\bcapacity\"y\n" +
+	"\x12CapacityDefinition\x12%\n" +
+	"\x0ecapacity_class\x18\x01 \x01(\tR\rcapacityClass\x12\x1b\n" +
+	"\tmax_count\x18\x02 \x01(\x05R\bmaxCount\x12\x1f\n" +
+	"\vprice_paisa\x18\x03 \x01(\x03R\n" +
+	"pricePaisa\"\x8d\x02\n" +
+	"\x0fQuotaDefinition\x12\x19\n" +
+	"\bquota_id\x18\x01 \x01(\tR\aquotaId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"quota_type\x18\x03 \x01(\tR\tquotaType\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x12'\n" +
+	"\x0fsegment_indexes\x18\x05 \x03(\x05R\x0esegmentIndexes\x12\x1f\n" +
+	"\vpartner_ids\x18\x06 \x03(\tR\n" +
+	"partnerIds\x12G\n" +
+	" release_minutes_before_departure\x18\a \x01(\x05R\x1dreleaseMinutesBeforeDeparture\"\xce\x01\n" +
 	"\x11SegmentDefinition\x12#\n" +
 	"\rsegment_index\x18\x01 \x01(\x05R\fsegmentIndex\x12&\n" +
 	"\x0ffrom_station_id\x18\x02 \x01(\tR\rfromStationId\x12\"\n" +
 	"\rto_station_id\x18\x03 \x01(\tR\vtoStationId\x12%\n" +
 	"\x0edeparture_time\x18\x04 \x01(\x03R\rdepartureTime\x12!\n" +
-	"\farrival_time\x18\x05 \x01(\x03R\varrivalTime\"h\n" +
+	"\farrival_time\x18\x05 \x01(\x03R\varrivalTime\"\xa4\x01\n" +
 	"\x11SeatConfiguration\x12\x1f\n" +
 	"\vtotal_seats\x18\x01 \x01(\x05R\n" +
 	"totalSeats\x122\n" +
-	"\x05seats\x18\x02 \x03(\v2\x1c.inventory.v1.SeatDefinitionR\x05seats\"\x93\x02\n" +
+	"\x05seats\x18\x02 \x03(\v2\x1c.inventory.v1.SeatDefinitionR\x05seats\x12:\n" +
+	"\x06layout\x18\x03 \x01(\v2\".inventory.v1.SeatLayoutDefinitionR\x06layout\"v\n" +
+	"\x14SeatLayoutDefinition\x12!\n" +
+	"\fvehicle_type\x18\x01 \x01(\tR\vvehicleType\x12;\n" +
+	"\bsections\x18\x02 \x03(\v2\x1f.inventory.v1.SectionDefinitionR\bsections\"\xce\x01\n" +
+	"\x11SectionDefinition\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x01 \x01(\tR\tsectionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12\x12\n" +
+	"\x04rows\x18\x05 \x01(\x05R\x04rows\x12\x18\n" +
+	"\acolumns\x18\x06 \x01(\x05R\acolumns\x12.\n" +
+	"\x13aisle_after_columns\x18\a \x03(\x05R\x11aisleAfterColumns\"\xc8\x02\n" +
 	"\x0eSeatDefinition\x12\x17\n" +
 	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x1f\n" +
 	"\vseat_number\x18\x02 \x01(\tR\n" +
@@ -2357,7 +6632,11 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"\vprice_paisa\x18\a \x01(\x03R\n" +
 	"pricePaisa\x12#\n" +
 	"\ris_accessible\x18\b \x01(\bR\fisAccessible\x12\x1b\n" +
-	"\thas_power\x18\t \x01(\bR\bhasPower\"\x8b\x01\n" +
+	"\thas_power\x18\t \x01(\bR\bhasPower\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\n" +
+	" \x01(\tR\tsectionId\x12\x14\n" +
+	"\x05berth\x18\v \x01(\tR\x05berth\"\x8b\x01\n" +
 	"\x1fInitializeTripInventoryResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12)\n" +
 	"\x10segments_created\x18\x02 \x01(\x05R\x0fsegmentsCreated\x12#\n" +
@@ -2374,20 +6653,22 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\"X\n" +
 	"\x17UpdateInventoryResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
-	"\rupdated_count\x18\x02 \x01(\x05R\fupdatedCount\"\xb8\x01\n" +
+	"\rupdated_count\x18\x02 \x01(\x05R\fupdatedCount\"\x84\x02\n" +
 	"\x13JoinWaitlistRequest\x12\x17\n" +
 	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"seat_class\x18\x03 \x01(\tR\tseatClass\x12'\n" +
 	"\x0frequested_seats\x18\x04 \x01(\x05R\x0erequestedSeats\x12'\n" +
-	"\x0forganization_id\x18\x05 \x01(\tR\x0eorganizationId\"f\n" +
+	"\x0forganization_id\x18\x05 \x01(\tR\x0eorganizationId\x12&\n" +
+	"\x0ffrom_station_id\x18\x06 \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\a \x01(\tR\vtoStationId\"f\n" +
 	"\x14JoinWaitlistResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
 	"\bposition\x18\x03 \x01(\x05R\bposition\"1\n" +
 	"\x16GetUserWaitlistRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd0\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xfb\x02\n" +
 	"\rWaitlistEntry\x12\x17\n" +
 	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1d\n" +
@@ -2396,28 +6677,182 @@ const file_api_proto_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x0frequested_seats\x18\x04 \x01(\x05R\x0erequestedSeats\x12\x16\n" +
 	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\"P\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12&\n" +
+	"\x0ffrom_station_id\x18\a \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\b \x01(\tR\vtoStationId\x12\x1a\n" +
+	"\bposition\x18\t \x01(\x05R\bposition\x12\x17\n" +
+	"\ahold_id\x18\n" +
+	" \x01(\tR\x06holdId\x12(\n" +
+	"\x10offer_expires_at\x18\v \x01(\x03R\x0eofferExpiresAt\"P\n" +
 	"\x17GetUserWaitlistResponse\x125\n" +
-	"\aentries\x18\x01 \x03(\v2\x1b.inventory.v1.WaitlistEntryR\aentries*\x8b\x01\n" +
+	"\aentries\x18\x01 \x03(\v2\x1b.inventory.v1.WaitlistEntryR\aentries\"\x90\x01\n" +
+	"\x1bRespondWaitlistOfferRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06accept\x18\x04 \x01(\bR\x06accept\"\x8a\x01\n" +
+	"\x1cRespondWaitlistOfferResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x17\n" +
+	"\ahold_id\x18\x02 \x01(\tR\x06holdId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"Z\n" +
+	"\x16GetTripManifestRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"s\n" +
+	"\x17GetTripManifestResponse\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12?\n" +
+	"\n" +
+	"passengers\x18\x02 \x03(\v2\x1f.inventory.v1.ManifestPassengerR\n" +
+	"passengers\"\xd7\x02\n" +
+	"\x11ManifestPassenger\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12%\n" +
+	"\x0epassenger_name\x18\x02 \x01(\tR\rpassengerName\x12#\n" +
+	"\rpassenger_nid\x18\x03 \x01(\tR\fpassengerNid\x12&\n" +
+	"\x0ffrom_station_id\x18\x04 \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\x05 \x01(\tR\vtoStationId\x12-\n" +
+	"\x04legs\x18\x06 \x03(\v2\x19.inventory.v1.ManifestLegR\x04legs\x125\n" +
+	"\x17seat_change_station_ids\x18\a \x03(\tR\x14seatChangeStationIds\x12%\n" +
+	"\x0ecapacity_class\x18\b \x01(\tR\rcapacityClass\"\xb0\x01\n" +
+	"\vManifestLeg\x12\x17\n" +
+	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x1f\n" +
+	"\vseat_number\x18\x02 \x01(\tR\n" +
+	"seatNumber\x12&\n" +
+	"\x0ffrom_station_id\x18\x03 \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\x04 \x01(\tR\vtoStationId\x12\x1b\n" +
+	"\tticket_id\x18\x05 \x01(\tR\bticketId\"\x83\x01\n" +
+	"\x1aGetReaccommodationsRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\runplaced_only\x18\x03 \x01(\bR\funplacedOnly\"o\n" +
+	"\x1bGetReaccommodationsResponse\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x127\n" +
+	"\aentries\x18\x02 \x03(\v2\x1d.inventory.v1.ReaccommodationR\aentries\"\xe5\x04\n" +
+	"\x0fReaccommodation\x12!\n" +
+	"\freference_id\x18\x01 \x01(\tR\vreferenceId\x12%\n" +
+	"\x0ereference_type\x18\x02 \x01(\tR\rreferenceType\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12'\n" +
+	"\x0fpassenger_index\x18\x04 \x01(\x05R\x0epassengerIndex\x12%\n" +
+	"\x0epassenger_name\x18\x05 \x01(\tR\rpassengerName\x12#\n" +
+	"\rpassenger_nid\x18\x06 \x01(\tR\fpassengerNid\x12\x1d\n" +
+	"\n" +
+	"seat_class\x18\a \x01(\tR\tseatClass\x12\x1e\n" +
+	"\vold_seat_id\x18\b \x01(\tR\toldSeatId\x12&\n" +
+	"\x0fold_seat_number\x18\t \x01(\tR\roldSeatNumber\x12\x1e\n" +
+	"\vnew_seat_id\x18\n" +
+	" \x01(\tR\tnewSeatId\x12&\n" +
+	"\x0fnew_seat_number\x18\v \x01(\tR\rnewSeatNumber\x12\"\n" +
+	"\rold_ticket_id\x18\f \x01(\tR\voldTicketId\x12\"\n" +
+	"\rnew_ticket_id\x18\r \x01(\tR\vnewTicketId\x12\x16\n" +
+	"\x06status\x18\x0e \x01(\tR\x06status\x12$\n" +
+	"\x0eold_vehicle_id\x18\x0f \x01(\tR\foldVehicleId\x12$\n" +
+	"\x0enew_vehicle_id\x18\x10 \x01(\tR\fnewVehicleId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\x03R\tcreatedAt\"q\n" +
+	"\x14GetSeatLedgerRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\aseat_id\x18\x03 \x01(\tR\x06seatId\"h\n" +
+	"\x15GetSeatLedgerResponse\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x126\n" +
+	"\aentries\x18\x02 \x03(\v2\x1c.inventory.v1.SeatTransitionR\aentries\"\x93\x02\n" +
+	"\x0eSeatTransition\x12#\n" +
+	"\rsegment_index\x18\x01 \x01(\x05R\fsegmentIndex\x12\x17\n" +
+	"\aseat_id\x18\x02 \x01(\tR\x06seatId\x12\x1f\n" +
+	"\vfrom_status\x18\x03 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x04 \x01(\tR\btoStatus\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x17\n" +
+	"\ahold_id\x18\x06 \x01(\tR\x06holdId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\a \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x1f\n" +
+	"\vrecorded_at\x18\t \x01(\x03R\n" +
+	"recordedAt\"\xf8\x02\n" +
+	"\x0eReleaseTranche\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x01 \x01(\tR\ttrancheId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x03 \x01(\x05R\n" +
+	"percentage\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x12!\n" +
+	"\fseat_numbers\x18\x05 \x03(\tR\vseatNumbers\x12\x1d\n" +
+	"\n" +
+	"release_at\x18\x06 \x01(\x03R\treleaseAt\x12C\n" +
+	"\x1erelease_hours_before_departure\x18\a \x01(\x05R\x1breleaseHoursBeforeDeparture\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1f\n" +
+	"\vreleased_at\x18\t \x01(\x03R\n" +
+	"releasedAt\x12\x17\n" +
+	"\atrip_id\x18\n" +
+	" \x01(\tR\x06tripId\x12\x1f\n" +
+	"\vschedule_id\x18\v \x01(\tR\n" +
+	"scheduleId\"\xda\x01\n" +
+	"\x1cCreateReleaseTranchesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\tR\x06tripId\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\tR\n" +
+	"scheduleId\x128\n" +
+	"\btranches\x18\x04 \x03(\v2\x1c.inventory.v1.ReleaseTrancheR\btranches\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\"Y\n" +
+	"\x1dCreateReleaseTranchesResponse\x128\n" +
+	"\btranches\x18\x01 \x03(\v2\x1c.inventory.v1.ReleaseTrancheR\btranches\"\x7f\n" +
+	"\x1aListReleaseTranchesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\tR\x06tripId\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\tR\n" +
+	"scheduleId\"W\n" +
+	"\x1bListReleaseTranchesResponse\x128\n" +
+	"\btranches\x18\x01 \x03(\v2\x1c.inventory.v1.ReleaseTrancheR\btranches\"\xba\x01\n" +
+	"\x15ReleaseTrancheRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\tR\x06tripId\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\tR\n" +
+	"scheduleId\x12\x1d\n" +
+	"\n" +
+	"tranche_id\x18\x04 \x01(\tR\ttrancheId\x12\x1f\n" +
+	"\vreleased_by\x18\x05 \x01(\tR\n" +
+	"releasedBy\"?\n" +
+	"\x16ReleaseTrancheResponse\x12%\n" +
+	"\x0ereleased_count\x18\x01 \x01(\x05R\rreleasedCount*\x8b\x01\n" +
 	"\n" +
 	"SeatStatus\x12\x1b\n" +
 	"\x17SEAT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15SEAT_STATUS_AVAILABLE\x10\x01\x12\x14\n" +
 	"\x10SEAT_STATUS_HELD\x10\x02\x12\x16\n" +
 	"\x12SEAT_STATUS_BOOKED\x10\x03\x12\x17\n" +
-	"\x13SEAT_STATUS_BLOCKED\x10\x042\xb7\a\n" +
+	"\x13SEAT_STATUS_BLOCKED\x10\x042\xe6\x12\n" +
 	"\x10InventoryService\x12d\n" +
 	"\x11CheckAvailability\x12&.inventory.v1.CheckAvailabilityRequest\x1a'.inventory.v1.CheckAvailabilityResponse\x12[\n" +
-	"\x16BatchCheckAvailability\x12\x1f.inventory.v1.BatchCheckRequest\x1a .inventory.v1.BatchCheckResponse\x12L\n" +
+	"\x16BatchCheckAvailability\x12\x1f.inventory.v1.BatchCheckRequest\x1a .inventory.v1.BatchCheckResponse\x12p\n" +
+	"\x15GetAvailabilityCounts\x12*.inventory.v1.GetAvailabilityCountsRequest\x1a+.inventory.v1.GetAvailabilityCountsResponse\x12L\n" +
 	"\tHoldSeats\x12\x1e.inventory.v1.HoldSeatsRequest\x1a\x1f.inventory.v1.HoldSeatsResponse\x12U\n" +
-	"\fReleaseSeats\x12!.inventory.v1.ReleaseSeatsRequest\x1a\".inventory.v1.ReleaseSeatsResponse\x12[\n" +
+	"\fReleaseSeats\x12!.inventory.v1.ReleaseSeatsRequest\x1a\".inventory.v1.ReleaseSeatsResponse\x12O\n" +
+	"\n" +
+	"ExtendHold\x12\x1f.inventory.v1.ExtendHoldRequest\x1a .inventory.v1.ExtendHoldResponse\x12O\n" +
+	"\n" +
+	"BlockSeats\x12\x1f.inventory.v1.BlockSeatsRequest\x1a .inventory.v1.BlockSeatsResponse\x12U\n" +
+	"\fUnblockSeats\x12!.inventory.v1.UnblockSeatsRequest\x1a\".inventory.v1.UnblockSeatsResponse\x12X\n" +
+	"\rSetHoldPolicy\x12\".inventory.v1.SetHoldPolicyRequest\x1a#.inventory.v1.SetHoldPolicyResponse\x12X\n" +
+	"\rGetHoldPolicy\x12\".inventory.v1.GetHoldPolicyRequest\x1a#.inventory.v1.GetHoldPolicyResponse\x12[\n" +
 	"\x0eConfirmBooking\x12#.inventory.v1.ConfirmBookingRequest\x1a$.inventory.v1.ConfirmBookingResponse\x12O\n" +
 	"\n" +
 	"GetSeatMap\x12\x1f.inventory.v1.GetSeatMapRequest\x1a .inventory.v1.GetSeatMapResponse\x12v\n" +
 	"\x17InitializeTripInventory\x12,.inventory.v1.InitializeTripInventoryRequest\x1a-.inventory.v1.InitializeTripInventoryResponse\x12^\n" +
-	"\x0fUpdateInventory\x12$.inventory.v1.UpdateInventoryRequest\x1a%.inventory.v1.UpdateInventoryResponse\x12U\n" +
+	"\x0fUpdateInventory\x12$.inventory.v1.UpdateInventoryRequest\x1a%.inventory.v1.UpdateInventoryResponse\x12X\n" +
+	"\rCancelBooking\x12\".inventory.v1.CancelBookingRequest\x1a#.inventory.v1.CancelBookingResponse\x12d\n" +
+	"\x11TransferPassenger\x12&.inventory.v1.TransferPassengerRequest\x1a'.inventory.v1.TransferPassengerResponse\x12U\n" +
 	"\fJoinWaitlist\x12!.inventory.v1.JoinWaitlistRequest\x1a\".inventory.v1.JoinWaitlistResponse\x12^\n" +
-	"\x0fGetUserWaitlist\x12$.inventory.v1.GetUserWaitlistRequest\x1a%.inventory.v1.GetUserWaitlistResponseB<Z:github.com/MuhibNayem/Travio/server/api/proto/inventory/v1b\x06proto3"
+	"\x0fGetUserWaitlist\x12$.inventory.v1.GetUserWaitlistRequest\x1a%.inventory.v1.GetUserWaitlistResponse\x12m\n" +
+	"\x14RespondWaitlistOffer\x12).inventory.v1.RespondWaitlistOfferRequest\x1a*.inventory.v1.RespondWaitlistOfferResponse\x12^\n" +
+	"\x0fGetTripManifest\x12$.inventory.v1.GetTripManifestRequest\x1a%.inventory.v1.GetTripManifestResponse\x12j\n" +
+	"\x13GetReaccommodations\x12(.inventory.v1.GetReaccommodationsRequest\x1a).inventory.v1.GetReaccommodationsResponse\x12X\n" +
+	"\rGetSeatLedger\x12\".inventory.v1.GetSeatLedgerRequest\x1a#.inventory.v1.GetSeatLedgerResponse\x12p\n" +
+	"\x15CreateReleaseTranches\x12*.inventory.v1.CreateReleaseTranchesRequest\x1a+.inventory.v1.CreateReleaseTranchesResponse\x12j\n" +
+	"\x13ListReleaseTranches\x12(.inventory.v1.ListReleaseTranchesRequest\x1a).inventory.v1.ListReleaseTranchesResponse\x12[\n" +
+	"\x0eReleaseTranche\x12#.inventory.v1.ReleaseTrancheRequest\x1a$.inventory.v1.ReleaseTrancheResponseB<Z:github.com/MuhibNayem/Travio/server/api/proto/inventory/v1b\x06proto3"
 
 var (
 	file_api_proto_inventory_v1_inventory_proto_rawDescOnce sync.Once
@@ -2432,87 +6867,204 @@ func file_api_proto_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 }
 
 var file_api_proto_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_proto_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_api_proto_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
 var file_api_proto_inventory_v1_inventory_proto_goTypes = []any{
 	(SeatStatus)(0),                         // 0: inventory.v1.SeatStatus
 	(*CheckAvailabilityRequest)(nil),        // 1: inventory.v1.CheckAvailabilityRequest
 	(*CheckAvailabilityResponse)(nil),       // 2: inventory.v1.CheckAvailabilityResponse
-	(*SeatAvailability)(nil),                // 3: inventory.v1.SeatAvailability
-	(*BatchCheckRequest)(nil),               // 4: inventory.v1.BatchCheckRequest
-	(*BatchCheckResponse)(nil),              // 5: inventory.v1.BatchCheckResponse
-	(*HoldSeatsRequest)(nil),                // 6: inventory.v1.HoldSeatsRequest
-	(*HoldSeatsResponse)(nil),               // 7: inventory.v1.HoldSeatsResponse
-	(*ReleaseSeatsRequest)(nil),             // 8: inventory.v1.ReleaseSeatsRequest
-	(*ReleaseSeatsResponse)(nil),            // 9: inventory.v1.ReleaseSeatsResponse
-	(*ConfirmBookingRequest)(nil),           // 10: inventory.v1.ConfirmBookingRequest
-	(*PassengerSeat)(nil),                   // 11: inventory.v1.PassengerSeat
-	(*ConfirmBookingResponse)(nil),          // 12: inventory.v1.ConfirmBookingResponse
-	(*ConfirmedSeat)(nil),                   // 13: inventory.v1.ConfirmedSeat
-	(*GetSeatMapRequest)(nil),               // 14: inventory.v1.GetSeatMapRequest
-	(*GetSeatMapResponse)(nil),              // 15: inventory.v1.GetSeatMapResponse
-	(*SeatRow)(nil),                         // 16: inventory.v1.SeatRow
-	(*SeatCell)(nil),                        // 17: inventory.v1.SeatCell
-	(*SeatMapLegend)(nil),                   // 18: inventory.v1.SeatMapLegend
-	(*InitializeTripInventoryRequest)(nil),  // 19: inventory.v1.InitializeTripInventoryRequest
-	(*SegmentDefinition)(nil),               // 20: inventory.v1.SegmentDefinition
-	(*SeatConfiguration)(nil),               // 21: inventory.v1.SeatConfiguration
-	(*SeatDefinition)(nil),                  // 22: inventory.v1.SeatDefinition
-	(*InitializeTripInventoryResponse)(nil), // 23: inventory.v1.InitializeTripInventoryResponse
-	(*UpdateInventoryRequest)(nil),          // 24: inventory.v1.UpdateInventoryRequest
-	(*SeatUpdate)(nil),                      // 25: inventory.v1.SeatUpdate
-	(*UpdateInventoryResponse)(nil),         // 26: inventory.v1.UpdateInventoryResponse
-	(*JoinWaitlistRequest)(nil),             // 27: inventory.v1.JoinWaitlistRequest
-	(*JoinWaitlistResponse)(nil),            // 28: inventory.v1.JoinWaitlistResponse
-	(*GetUserWaitlistRequest)(nil),          // 29: inventory.v1.GetUserWaitlistRequest
-	(*WaitlistEntry)(nil),                   // 30: inventory.v1.WaitlistEntry
-	(*GetUserWaitlistResponse)(nil),         // 31: inventory.v1.GetUserWaitlistResponse
-	nil,                                     // 32: inventory.v1.SeatMapLegend.StatusColorsEntry
-	nil,                                     // 33: inventory.v1.SeatMapLegend.ClassColorsEntry
+	(*CapacityItem)(nil),                    // 3: inventory.v1.CapacityItem
+	(*CapacityAvailability)(nil),            // 4: inventory.v1.CapacityAvailability
+	(*SeatChangePlan)(nil),                  // 5: inventory.v1.SeatChangePlan
+	(*SeatLeg)(nil),                         // 6: inventory.v1.SeatLeg
+	(*QuotaClaims)(nil),                     // 7: inventory.v1.QuotaClaims
+	(*QuotaAvailability)(nil),               // 8: inventory.v1.QuotaAvailability
+	(*SeatAvailability)(nil),                // 9: inventory.v1.SeatAvailability
+	(*BatchCheckRequest)(nil),               // 10: inventory.v1.BatchCheckRequest
+	(*BatchCheckResponse)(nil),              // 11: inventory.v1.BatchCheckResponse
+	(*AvailabilityCountQuery)(nil),          // 12: inventory.v1.AvailabilityCountQuery
+	(*GetAvailabilityCountsRequest)(nil),    // 13: inventory.v1.GetAvailabilityCountsRequest
+	(*ClassAvailabilityCount)(nil),          // 14: inventory.v1.ClassAvailabilityCount
+	(*AvailabilityCountResult)(nil),         // 15: inventory.v1.AvailabilityCountResult
+	(*GetAvailabilityCountsResponse)(nil),   // 16: inventory.v1.GetAvailabilityCountsResponse
+	(*HoldSeatsRequest)(nil),                // 17: inventory.v1.HoldSeatsRequest
+	(*SeatLegSelection)(nil),                // 18: inventory.v1.SeatLegSelection
+	(*SeatPreferences)(nil),                 // 19: inventory.v1.SeatPreferences
+	(*HoldSeatsResponse)(nil),               // 20: inventory.v1.HoldSeatsResponse
+	(*ReleaseSeatsRequest)(nil),             // 21: inventory.v1.ReleaseSeatsRequest
+	(*ReleaseSeatsResponse)(nil),            // 22: inventory.v1.ReleaseSeatsResponse
+	(*BlockSeatsRequest)(nil),               // 23: inventory.v1.BlockSeatsRequest
+	(*BlockSeatsResponse)(nil),              // 24: inventory.v1.BlockSeatsResponse
+	(*TripSeatBlockResult)(nil),             // 25: inventory.v1.TripSeatBlockResult
+	(*UnblockSeatsRequest)(nil),             // 26: inventory.v1.UnblockSeatsRequest
+	(*UnblockSeatsResponse)(nil),            // 27: inventory.v1.UnblockSeatsResponse
+	(*ExtendHoldRequest)(nil),               // 28: inventory.v1.ExtendHoldRequest
+	(*ExtendHoldResponse)(nil),              // 29: inventory.v1.ExtendHoldResponse
+	(*HoldPolicy)(nil),                      // 30: inventory.v1.HoldPolicy
+	(*SetHoldPolicyRequest)(nil),            // 31: inventory.v1.SetHoldPolicyRequest
+	(*SetHoldPolicyResponse)(nil),           // 32: inventory.v1.SetHoldPolicyResponse
+	(*GetHoldPolicyRequest)(nil),            // 33: inventory.v1.GetHoldPolicyRequest
+	(*GetHoldPolicyResponse)(nil),           // 34: inventory.v1.GetHoldPolicyResponse
+	(*ConfirmBookingRequest)(nil),           // 35: inventory.v1.ConfirmBookingRequest
+	(*PassengerSeat)(nil),                   // 36: inventory.v1.PassengerSeat
+	(*ConfirmBookingResponse)(nil),          // 37: inventory.v1.ConfirmBookingResponse
+	(*ConfirmedSeat)(nil),                   // 38: inventory.v1.ConfirmedSeat
+	(*CancelBookingRequest)(nil),            // 39: inventory.v1.CancelBookingRequest
+	(*CancelBookingResponse)(nil),           // 40: inventory.v1.CancelBookingResponse
+	(*TransferPassengerRequest)(nil),        // 41: inventory.v1.TransferPassengerRequest
+	(*TransferPassengerResponse)(nil),       // 42: inventory.v1.TransferPassengerResponse
+	(*GetSeatMapRequest)(nil),               // 43: inventory.v1.GetSeatMapRequest
+	(*GetSeatMapResponse)(nil),              // 44: inventory.v1.GetSeatMapResponse
+	(*SeatSection)(nil),                     // 45: inventory.v1.SeatSection
+	(*SeatRow)(nil),                         // 46: inventory.v1.SeatRow
+	(*SeatCell)(nil),                        // 47: inventory.v1.SeatCell
+	(*SegmentSeatStatus)(nil),               // 48: inventory.v1.SegmentSeatStatus
+	(*SeatMapLegend)(nil),                   // 49: inventory.v1.SeatMapLegend
+	(*InitializeTripInventoryRequest)(nil),  // 50: inventory.v1.InitializeTripInventoryRequest
+	(*CapacityDefinition)(nil),              // 51: inventory.v1.CapacityDefinition
+	(*QuotaDefinition)(nil),                 // 52: inventory.v1.QuotaDefinition
+	(*SegmentDefinition)(nil),               // 53: inventory.v1.SegmentDefinition
+	(*SeatConfiguration)(nil),               // 54: inventory.v1.SeatConfiguration
+	(*SeatLayoutDefinition)(nil),            // 55: inventory.v1.SeatLayoutDefinition
+	(*SectionDefinition)(nil),               // 56: inventory.v1.SectionDefinition
+	(*SeatDefinition)(nil),                  // 57: inventory.v1.SeatDefinition
+	(*InitializeTripInventoryResponse)(nil), // 58: inventory.v1.InitializeTripInventoryResponse
+	(*UpdateInventoryRequest)(nil),          // 59: inventory.v1.UpdateInventoryRequest
+	(*SeatUpdate)(nil),                      // 60: inventory.v1.SeatUpdate
+	(*UpdateInventoryResponse)(nil),         // 61: inventory.v1.UpdateInventoryResponse
+	(*JoinWaitlistRequest)(nil),             // 62: inventory.v1.JoinWaitlistRequest
+	(*JoinWaitlistResponse)(nil),            // 63: inventory.v1.JoinWaitlistResponse
+	(*GetUserWaitlistRequest)(nil),          // 64: inventory.v1.GetUserWaitlistRequest
+	(*WaitlistEntry)(nil),                   // 65: inventory.v1.WaitlistEntry
+	(*GetUserWaitlistResponse)(nil),         // 66: inventory.v1.GetUserWaitlistResponse
+	(*RespondWaitlistOfferRequest)(nil),     // 67: inventory.v1.RespondWaitlistOfferRequest
+	(*RespondWaitlistOfferResponse)(nil),    // 68: inventory.v1.RespondWaitlistOfferResponse
+	(*GetTripManifestRequest)(nil),          // 69: inventory.v1.GetTripManifestRequest
+	(*GetTripManifestResponse)(nil),         // 70: inventory.v1.GetTripManifestResponse
+	(*ManifestPassenger)(nil),               // 71: inventory.v1.ManifestPassenger
+	(*ManifestLeg)(nil),                     // 72: inventory.v1.ManifestLeg
+	(*GetReaccommodationsRequest)(nil),      // 73: inventory.v1.GetReaccommodationsRequest
+	(*GetReaccommodationsResponse)(nil),     // 74: inventory.v1.GetReaccommodationsResponse
+	(*Reaccommodation)(nil),                 // 75: inventory.v1.Reaccommodation
+	(*GetSeatLedgerRequest)(nil),            // 76: inventory.v1.GetSeatLedgerRequest
+	(*GetSeatLedgerResponse)(nil),           // 77: inventory.v1.GetSeatLedgerResponse
+	(*SeatTransition)(nil),                  // 78: inventory.v1.SeatTransition
+	(*ReleaseTranche)(nil),                  // 79: inventory.v1.ReleaseTranche
+	(*CreateReleaseTranchesRequest)(nil),    // 80: inventory.v1.CreateReleaseTranchesRequest
+	(*CreateReleaseTranchesResponse)(nil),   // 81: inventory.v1.CreateReleaseTranchesResponse
+	(*ListReleaseTranchesRequest)(nil),      // 82: inventory.v1.ListReleaseTranchesRequest
+	(*ListReleaseTranchesResponse)(nil),     // 83: inventory.v1.ListReleaseTranchesResponse
+	(*ReleaseTrancheRequest)(nil),           // 84: inventory.v1.ReleaseTrancheRequest
+	(*ReleaseTrancheResponse)(nil),          // 85: inventory.v1.ReleaseTrancheResponse
+	nil,                                     // 86: inventory.v1.SeatMapLegend.StatusColorsEntry
+	nil,                                     // 87: inventory.v1.SeatMapLegend.ClassColorsEntry
 }
 var file_api_proto_inventory_v1_inventory_proto_depIdxs = []int32{
-	3,  // 0: inventory.v1.CheckAvailabilityResponse.seats:type_name -> inventory.v1.SeatAvailability
-	0,  // 1: inventory.v1.SeatAvailability.status:type_name -> inventory.v1.SeatStatus
-	1,  // 2: inventory.v1.BatchCheckRequest.requests:type_name -> inventory.v1.CheckAvailabilityRequest
-	2,  // 3: inventory.v1.BatchCheckResponse.results:type_name -> inventory.v1.CheckAvailabilityResponse
-	11, // 4: inventory.v1.ConfirmBookingRequest.passengers:type_name -> inventory.v1.PassengerSeat
-	13, // 5: inventory.v1.ConfirmBookingResponse.confirmed_seats:type_name -> inventory.v1.ConfirmedSeat
-	16, // 6: inventory.v1.GetSeatMapResponse.rows:type_name -> inventory.v1.SeatRow
-	18, // 7: inventory.v1.GetSeatMapResponse.legend:type_name -> inventory.v1.SeatMapLegend
-	17, // 8: inventory.v1.SeatRow.seats:type_name -> inventory.v1.SeatCell
-	0,  // 9: inventory.v1.SeatCell.status:type_name -> inventory.v1.SeatStatus
-	32, // 10: inventory.v1.SeatMapLegend.status_colors:type_name -> inventory.v1.SeatMapLegend.StatusColorsEntry
-	33, // 11: inventory.v1.SeatMapLegend.class_colors:type_name -> inventory.v1.SeatMapLegend.ClassColorsEntry
-	20, // 12: inventory.v1.InitializeTripInventoryRequest.segments:type_name -> inventory.v1.SegmentDefinition
-	21, // 13: inventory.v1.InitializeTripInventoryRequest.seat_config:type_name -> inventory.v1.SeatConfiguration
-	22, // 14: inventory.v1.SeatConfiguration.seats:type_name -> inventory.v1.SeatDefinition
-	25, // 15: inventory.v1.UpdateInventoryRequest.updates:type_name -> inventory.v1.SeatUpdate
-	0,  // 16: inventory.v1.SeatUpdate.new_status:type_name -> inventory.v1.SeatStatus
-	30, // 17: inventory.v1.GetUserWaitlistResponse.entries:type_name -> inventory.v1.WaitlistEntry
-	1,  // 18: inventory.v1.InventoryService.CheckAvailability:input_type -> inventory.v1.CheckAvailabilityRequest
-	4,  // 19: inventory.v1.InventoryService.BatchCheckAvailability:input_type -> inventory.v1.BatchCheckRequest
-	6,  // 20: inventory.v1.InventoryService.HoldSeats:input_type -> inventory.v1.HoldSeatsRequest
-	8,  // 21: inventory.v1.InventoryService.ReleaseSeats:input_type -> inventory.v1.ReleaseSeatsRequest
-	10, // 22: inventory.v1.InventoryService.ConfirmBooking:input_type -> inventory.v1.ConfirmBookingRequest
-	14, // 23: inventory.v1.InventoryService.GetSeatMap:input_type -> inventory.v1.GetSeatMapRequest
-	19, // 24: inventory.v1.InventoryService.InitializeTripInventory:input_type -> inventory.v1.InitializeTripInventoryRequest
-	24, // 25: inventory.v1.InventoryService.UpdateInventory:input_type -> inventory.v1.UpdateInventoryRequest
-	27, // 26: inventory.v1.InventoryService.JoinWaitlist:input_type -> inventory.v1.JoinWaitlistRequest
-	29, // 27: inventory.v1.InventoryService.GetUserWaitlist:input_type -> inventory.v1.GetUserWaitlistRequest
-	2,  // 28: inventory.v1.InventoryService.CheckAvailability:output_type -> inventory.v1.CheckAvailabilityResponse
-	5,  // 29: inventory.v1.InventoryService.BatchCheckAvailability:output_type -> inventory.v1.BatchCheckResponse
-	7,  // 30: inventory.v1.InventoryService.HoldSeats:output_type -> inventory.v1.HoldSeatsResponse
-	9,  // 31: inventory.v1.InventoryService.ReleaseSeats:output_type -> inventory.v1.ReleaseSeatsResponse
-	12, // 32: inventory.v1.InventoryService.ConfirmBooking:output_type -> inventory.v1.ConfirmBookingResponse
-	15, // 33: inventory.v1.InventoryService.GetSeatMap:output_type -> inventory.v1.GetSeatMapResponse
-	23, // 34: inventory.v1.InventoryService.InitializeTripInventory:output_type -> inventory.v1.InitializeTripInventoryResponse
-	26, // 35: inventory.v1.InventoryService.UpdateInventory:output_type -> inventory.v1.UpdateInventoryResponse
-	28, // 36: inventory.v1.InventoryService.JoinWaitlist:output_type -> inventory.v1.JoinWaitlistResponse
-	31, // 37: inventory.v1.InventoryService.GetUserWaitlist:output_type -> inventory.v1.GetUserWaitlistResponse
-	28, // [28:38] is the sub-list for method output_type
-	18, // [18:28] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	7,  // 0: inventory.v1.CheckAvailabilityRequest.quota_claims:type_name -> inventory.v1.QuotaClaims
+	3,  // 1: inventory.v1.CheckAvailabilityRequest.capacity_items:type_name -> inventory.v1.CapacityItem
+	9,  // 2: inventory.v1.CheckAvailabilityResponse.seats:type_name -> inventory.v1.SeatAvailability
+	8,  // 3: inventory.v1.CheckAvailabilityResponse.quotas:type_name -> inventory.v1.QuotaAvailability
+	5,  // 4: inventory.v1.CheckAvailabilityResponse.seat_change_plans:type_name -> inventory.v1.SeatChangePlan
+	4,  // 5: inventory.v1.CheckAvailabilityResponse.capacity:type_name -> inventory.v1.CapacityAvailability
+	6,  // 6: inventory.v1.SeatChangePlan.legs:type_name -> inventory.v1.SeatLeg
+	0,  // 7: inventory.v1.SeatAvailability.status:type_name -> inventory.v1.SeatStatus
+	1,  // 8: inventory.v1.BatchCheckRequest.requests:type_name -> inventory.v1.CheckAvailabilityRequest
+	2,  // 9: inventory.v1.BatchCheckResponse.results:type_name -> inventory.v1.CheckAvailabilityResponse
+	12, // 10: inventory.v1.GetAvailabilityCountsRequest.queries:type_name -> inventory.v1.AvailabilityCountQuery
+	14, // 11: inventory.v1.AvailabilityCountResult.classes:type_name -> inventory.v1.ClassAvailabilityCount
+	4,  // 12: inventory.v1.AvailabilityCountResult.capacity:type_name -> inventory.v1.CapacityAvailability
+	15, // 13: inventory.v1.GetAvailabilityCountsResponse.results:type_name -> inventory.v1.AvailabilityCountResult
+	19, // 14: inventory.v1.HoldSeatsRequest.preferences:type_name -> inventory.v1.SeatPreferences
+	7,  // 15: inventory.v1.HoldSeatsRequest.quota_claims:type_name -> inventory.v1.QuotaClaims
+	18, // 16: inventory.v1.HoldSeatsRequest.seat_legs:type_name -> inventory.v1.SeatLegSelection
+	3,  // 17: inventory.v1.HoldSeatsRequest.capacity_items:type_name -> inventory.v1.CapacityItem
+	3,  // 18: inventory.v1.HoldSeatsResponse.held_capacity:type_name -> inventory.v1.CapacityItem
+	25, // 19: inventory.v1.BlockSeatsResponse.trips:type_name -> inventory.v1.TripSeatBlockResult
+	30, // 20: inventory.v1.SetHoldPolicyRequest.policy:type_name -> inventory.v1.HoldPolicy
+	30, // 21: inventory.v1.GetHoldPolicyResponse.policy:type_name -> inventory.v1.HoldPolicy
+	36, // 22: inventory.v1.ConfirmBookingRequest.passengers:type_name -> inventory.v1.PassengerSeat
+	38, // 23: inventory.v1.ConfirmBookingResponse.confirmed_seats:type_name -> inventory.v1.ConfirmedSeat
+	46, // 24: inventory.v1.GetSeatMapResponse.rows:type_name -> inventory.v1.SeatRow
+	49, // 25: inventory.v1.GetSeatMapResponse.legend:type_name -> inventory.v1.SeatMapLegend
+	45, // 26: inventory.v1.GetSeatMapResponse.sections:type_name -> inventory.v1.SeatSection
+	46, // 27: inventory.v1.SeatSection.rows:type_name -> inventory.v1.SeatRow
+	47, // 28: inventory.v1.SeatRow.seats:type_name -> inventory.v1.SeatCell
+	0,  // 29: inventory.v1.SeatCell.status:type_name -> inventory.v1.SeatStatus
+	48, // 30: inventory.v1.SeatCell.segment_statuses:type_name -> inventory.v1.SegmentSeatStatus
+	0,  // 31: inventory.v1.SegmentSeatStatus.status:type_name -> inventory.v1.SeatStatus
+	86, // 32: inventory.v1.SeatMapLegend.status_colors:type_name -> inventory.v1.SeatMapLegend.StatusColorsEntry
+	87, // 33: inventory.v1.SeatMapLegend.class_colors:type_name -> inventory.v1.SeatMapLegend.ClassColorsEntry
+	53, // 34: inventory.v1.InitializeTripInventoryRequest.segments:type_name -> inventory.v1.SegmentDefinition
+	54, // 35: inventory.v1.InitializeTripInventoryRequest.seat_config:type_name -> inventory.v1.SeatConfiguration
+	52, // 36: inventory.v1.InitializeTripInventoryRequest.quotas:type_name -> inventory.v1.QuotaDefinition
+	51, // 37: inventory.v1.InitializeTripInventoryRequest.capacity:type_name -> inventory.v1.CapacityDefinition
+	57, // 38: inventory.v1.SeatConfiguration.seats:type_name -> inventory.v1.SeatDefinition
+	55, // 39: inventory.v1.SeatConfiguration.layout:type_name -> inventory.v1.SeatLayoutDefinition
+	56, // 40: inventory.v1.SeatLayoutDefinition.sections:type_name -> inventory.v1.SectionDefinition
+	60, // 41: inventory.v1.UpdateInventoryRequest.updates:type_name -> inventory.v1.SeatUpdate
+	0,  // 42: inventory.v1.SeatUpdate.new_status:type_name -> inventory.v1.SeatStatus
+	65, // 43: inventory.v1.GetUserWaitlistResponse.entries:type_name -> inventory.v1.WaitlistEntry
+	71, // 44: inventory.v1.GetTripManifestResponse.passengers:type_name -> inventory.v1.ManifestPassenger
+	72, // 45: inventory.v1.ManifestPassenger.legs:type_name -> inventory.v1.ManifestLeg
+	75, // 46: inventory.v1.GetReaccommodationsResponse.entries:type_name -> inventory.v1.Reaccommodation
+	78, // 47: inventory.v1.GetSeatLedgerResponse.entries:type_name -> inventory.v1.SeatTransition
+	79, // 48: inventory.v1.CreateReleaseTranchesRequest.tranches:type_name -> inventory.v1.ReleaseTranche
+	79, // 49: inventory.v1.CreateReleaseTranchesResponse.tranches:type_name -> inventory.v1.ReleaseTranche
+	79, // 50: inventory.v1.ListReleaseTranchesResponse.tranches:type_name -> inventory.v1.ReleaseTranche
+	1,  // 51: inventory.v1.InventoryService.CheckAvailability:input_type -> inventory.v1.CheckAvailabilityRequest
+	10, // 52: inventory.v1.InventoryService.BatchCheckAvailability:input_type -> inventory.v1.BatchCheckRequest
+	13, // 53: inventory.v1.InventoryService.GetAvailabilityCounts:input_type -> inventory.v1.GetAvailabilityCountsRequest
+	17, // 54: inventory.v1.InventoryService.HoldSeats:input_type -> inventory.v1.HoldSeatsRequest
+	21, // 55: inventory.v1.InventoryService.ReleaseSeats:input_type -> inventory.v1.ReleaseSeatsRequest
+	28, // 56: inventory.v1.InventoryService.ExtendHold:input_type -> inventory.v1.ExtendHoldRequest
+	23, // 57: inventory.v1.InventoryService.BlockSeats:input_type -> inventory.v1.BlockSeatsRequest
+	26, // 58: inventory.v1.InventoryService.UnblockSeats:input_type -> inventory.v1.UnblockSeatsRequest
+	31, // 59: inventory.v1.InventoryService.SetHoldPolicy:input_type -> inventory.v1.SetHoldPolicyRequest
+	33, // 60: inventory.v1.InventoryService.GetHoldPolicy:input_type -> inventory.v1.GetHoldPolicyRequest
+	35, // 61: inventory.v1.InventoryService.ConfirmBooking:input_type -> inventory.v1.ConfirmBookingRequest
+	43, // 62: inventory.v1.InventoryService.GetSeatMap:input_type -> inventory.v1.GetSeatMapRequest
+	50, // 63: inventory.v1.InventoryService.InitializeTripInventory:input_type -> inventory.v1.InitializeTripInventoryRequest
+	59, // 64: inventory.v1.InventoryService.UpdateInventory:input_type -> inventory.v1.UpdateInventoryRequest
+	39, // 65: inventory.v1.InventoryService.CancelBooking:input_type -> inventory.v1.CancelBookingRequest
+	41, // 66: inventory.v1.InventoryService.TransferPassenger:input_type -> inventory.v1.TransferPassengerRequest
+	62, // 67: inventory.v1.InventoryService.JoinWaitlist:input_type -> inventory.v1.JoinWaitlistRequest
+	64, // 68: inventory.v1.InventoryService.GetUserWaitlist:input_type -> inventory.v1.GetUserWaitlistRequest
+	67, // 69: inventory.v1.InventoryService.RespondWaitlistOffer:input_type -> inventory.v1.RespondWaitlistOfferRequest
+	69, // 70: inventory.v1.InventoryService.GetTripManifest:input_type -> inventory.v1.GetTripManifestRequest
+	73, // 71: inventory.v1.InventoryService.GetReaccommodations:input_type -> inventory.v1.GetReaccommodationsRequest
+	76, // 72: inventory.v1.InventoryService.GetSeatLedger:input_type -> inventory.v1.GetSeatLedgerRequest
+	80, // 73: inventory.v1.InventoryService.CreateReleaseTranches:input_type -> inventory.v1.CreateReleaseTranchesRequest
+	82, // 74: inventory.v1.InventoryService.ListReleaseTranches:input_type -> inventory.v1.ListReleaseTranchesRequest
+	84, // 75: inventory.v1.InventoryService.ReleaseTranche:input_type -> inventory.v1.ReleaseTrancheRequest
+	2,  // 76: inventory.v1.InventoryService.CheckAvailability:output_type -> inventory.v1.CheckAvailabilityResponse
+	11, // 77: inventory.v1.InventoryService.BatchCheckAvailability:output_type -> inventory.v1.BatchCheckResponse
+	16, // 78: inventory.v1.InventoryService.GetAvailabilityCounts:output_type -> inventory.v1.GetAvailabilityCountsResponse
+	20, // 79: inventory.v1.InventoryService.HoldSeats:output_type -> inventory.v1.HoldSeatsResponse
+	22, // 80: inventory.v1.InventoryService.ReleaseSeats:output_type -> inventory.v1.ReleaseSeatsResponse
+	29, // 81: inventory.v1.InventoryService.ExtendHold:output_type -> inventory.v1.ExtendHoldResponse
+	24, // 82: inventory.v1.InventoryService.BlockSeats:output_type -> inventory.v1.BlockSeatsResponse
+	27, // 83: inventory.v1.InventoryService.UnblockSeats:output_type -> inventory.v1.UnblockSeatsResponse
+	32, // 84: inventory.v1.InventoryService.SetHoldPolicy:output_type -> inventory.v1.SetHoldPolicyResponse
+	34, // 85: inventory.v1.InventoryService.GetHoldPolicy:output_type -> inventory.v1.GetHoldPolicyResponse
+	37, // 86: inventory.v1.InventoryService.ConfirmBooking:output_type -> inventory.v1.ConfirmBookingResponse
+	44, // 87: inventory.v1.InventoryService.GetSeatMap:output_type -> inventory.v1.GetSeatMapResponse
+	58, // 88: inventory.v1.InventoryService.InitializeTripInventory:output_type -> inventory.v1.InitializeTripInventoryResponse
+	61, // 89: inventory.v1.InventoryService.UpdateInventory:output_type -> inventory.v1.UpdateInventoryResponse
+	40, // 90: inventory.v1.InventoryService.CancelBooking:output_type -> inventory.v1.CancelBookingResponse
+	42, // 91: inventory.v1.InventoryService.TransferPassenger:output_type -> inventory.v1.TransferPassengerResponse
+	63, // 92: inventory.v1.InventoryService.JoinWaitlist:output_type -> inventory.v1.JoinWaitlistResponse
+	66, // 93: inventory.v1.InventoryService.GetUserWaitlist:output_type -> inventory.v1.GetUserWaitlistResponse
+	68, // 94: inventory.v1.InventoryService.RespondWaitlistOffer:output_type -> inventory.v1.RespondWaitlistOfferResponse
+	70, // 95: inventory.v1.InventoryService.GetTripManifest:output_type -> inventory.v1.GetTripManifestResponse
+	74, // 96: inventory.v1.InventoryService.GetReaccommodations:output_type -> inventory.v1.GetReaccommodationsResponse
+	77, // 97: inventory.v1.InventoryService.GetSeatLedger:output_type -> inventory.v1.GetSeatLedgerResponse
+	81, // 98: inventory.v1.InventoryService.CreateReleaseTranches:output_type -> inventory.v1.CreateReleaseTranchesResponse
+	83, // 99: inventory.v1.InventoryService.ListReleaseTranches:output_type -> inventory.v1.ListReleaseTranchesResponse
+	85, // 100: inventory.v1.InventoryService.ReleaseTranche:output_type -> inventory.v1.ReleaseTrancheResponse
+	76, // [76:101] is the sub-list for method output_type
+	51, // [51:76] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_inventory_v1_inventory_proto_init() }
@@ -2526,7 +7078,7 @@ func file_api_proto_inventory_v1_inventory_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_inventory_v1_inventory_proto_rawDesc), len(file_api_proto_inventory_v1_inventory_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   33,
+			NumMessages:   87,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
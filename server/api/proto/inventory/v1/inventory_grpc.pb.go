@@ -21,14 +21,29 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	InventoryService_CheckAvailability_FullMethodName       = "/inventory.v1.InventoryService/CheckAvailability"
 	InventoryService_BatchCheckAvailability_FullMethodName  = "/inventory.v1.InventoryService/BatchCheckAvailability"
+	InventoryService_GetAvailabilityCounts_FullMethodName   = "/inventory.v1.InventoryService/GetAvailabilityCounts"
 	InventoryService_HoldSeats_FullMethodName               = "/inventory.v1.InventoryService/HoldSeats"
 	InventoryService_ReleaseSeats_FullMethodName            = "/inventory.v1.InventoryService/ReleaseSeats"
+	InventoryService_ExtendHold_FullMethodName              = "/inventory.v1.InventoryService/ExtendHold"
+	InventoryService_BlockSeats_FullMethodName              = "/inventory.v1.InventoryService/BlockSeats"
+	InventoryService_UnblockSeats_FullMethodName            = "/inventory.v1.InventoryService/UnblockSeats"
+	InventoryService_SetHoldPolicy_FullMethodName           = "/inventory.v1.InventoryService/SetHoldPolicy"
+	InventoryService_GetHoldPolicy_FullMethodName           = "/inventory.v1.InventoryService/GetHoldPolicy"
 	InventoryService_ConfirmBooking_FullMethodName          = "/inventory.v1.InventoryService/ConfirmBooking"
 	InventoryService_GetSeatMap_FullMethodName              = "/inventory.v1.InventoryService/GetSeatMap"
 	InventoryService_InitializeTripInventory_FullMethodName = "/inventory.v1.InventoryService/InitializeTripInventory"
 	InventoryService_UpdateInventory_FullMethodName         = "/inventory.v1.InventoryService/UpdateInventory"
+	InventoryService_CancelBooking_FullMethodName           = "/inventory.v1.InventoryService/CancelBooking"
+	InventoryService_TransferPassenger_FullMethodName       = "/inventory.v1.InventoryService/TransferPassenger"
 	InventoryService_JoinWaitlist_FullMethodName            = "/inventory.v1.InventoryService/JoinWaitlist"
 	InventoryService_GetUserWaitlist_FullMethodName         = "/inventory.v1.InventoryService/GetUserWaitlist"
+	InventoryService_RespondWaitlistOffer_FullMethodName    = "/inventory.v1.InventoryService/RespondWaitlistOffer"
+	InventoryService_GetTripManifest_FullMethodName         = "/inventory.v1.InventoryService/GetTripManifest"
+	InventoryService_GetReaccommodations_FullMethodName     = "/inventory.v1.InventoryService/GetReaccommodations"
+	InventoryService_GetSeatLedger_FullMethodName           = "/inventory.v1.InventoryService/GetSeatLedger"
+	InventoryService_CreateReleaseTranches_FullMethodName   = "/inventory.v1.InventoryService/CreateReleaseTranches"
+	InventoryService_ListReleaseTranches_FullMethodName     = "/inventory.v1.InventoryService/ListReleaseTranches"
+	InventoryService_ReleaseTranche_FullMethodName          = "/inventory.v1.InventoryService/ReleaseTranche"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -42,10 +57,20 @@ type InventoryServiceClient interface {
 	CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...grpc.CallOption) (*CheckAvailabilityResponse, error)
 	// Bulk availability for search results
 	BatchCheckAvailability(ctx context.Context, in *BatchCheckRequest, opts ...grpc.CallOption) (*BatchCheckResponse, error)
+	// Cheap seat counts for listing pages, many trips and station pairs per call (counter table, eventually consistent)
+	GetAvailabilityCounts(ctx context.Context, in *GetAvailabilityCountsRequest, opts ...grpc.CallOption) (*GetAvailabilityCountsResponse, error)
 	// Hold seats temporarily (WRITE - with TTL)
 	HoldSeats(ctx context.Context, in *HoldSeatsRequest, opts ...grpc.CallOption) (*HoldSeatsResponse, error)
 	// Release held seats (on timeout or user cancel)
 	ReleaseSeats(ctx context.Context, in *ReleaseSeatsRequest, opts ...grpc.CallOption) (*ReleaseSeatsResponse, error)
+	// Extend an active hold, e.g. while the user is inside a wallet app
+	ExtendHold(ctx context.Context, in *ExtendHoldRequest, opts ...grpc.CallOption) (*ExtendHoldResponse, error)
+	// Operator: take seats out of sale (staff, broken seats, VIPs) on a trip or every trip of a schedule
+	BlockSeats(ctx context.Context, in *BlockSeatsRequest, opts ...grpc.CallOption) (*BlockSeatsResponse, error)
+	UnblockSeats(ctx context.Context, in *UnblockSeatsRequest, opts ...grpc.CallOption) (*UnblockSeatsResponse, error)
+	// Admin: per-organization hold lifetime and extension limits
+	SetHoldPolicy(ctx context.Context, in *SetHoldPolicyRequest, opts ...grpc.CallOption) (*SetHoldPolicyResponse, error)
+	GetHoldPolicy(ctx context.Context, in *GetHoldPolicyRequest, opts ...grpc.CallOption) (*GetHoldPolicyResponse, error)
 	// Confirm booking (convert hold to confirmed)
 	ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*ConfirmBookingResponse, error)
 	// Get seat map with availability status
@@ -54,9 +79,24 @@ type InventoryServiceClient interface {
 	InitializeTripInventory(ctx context.Context, in *InitializeTripInventoryRequest, opts ...grpc.CallOption) (*InitializeTripInventoryResponse, error)
 	// Admin: Bulk update inventory (cancellations, adjustments)
 	UpdateInventory(ctx context.Context, in *UpdateInventoryRequest, opts ...grpc.CallOption) (*UpdateInventoryResponse, error)
+	// Cancel a confirmed booking and return its seats to the pool
+	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	// Moves a passenger's seats to a new holder after a ticket transfer
+	TransferPassenger(ctx context.Context, in *TransferPassengerRequest, opts ...grpc.CallOption) (*TransferPassengerResponse, error)
 	// Waitlist
 	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error)
 	GetUserWaitlist(ctx context.Context, in *GetUserWaitlistRequest, opts ...grpc.CallOption) (*GetUserWaitlistResponse, error)
+	RespondWaitlistOffer(ctx context.Context, in *RespondWaitlistOfferRequest, opts ...grpc.CallOption) (*RespondWaitlistOfferResponse, error)
+	// Conductor manifest: confirmed passengers and the seat they occupy on each leg
+	GetTripManifest(ctx context.Context, in *GetTripManifestRequest, opts ...grpc.CallOption) (*GetTripManifestResponse, error)
+	// Re-accommodation: where passengers were moved when the trip changed vehicle, and who could not be placed
+	GetReaccommodations(ctx context.Context, in *GetReaccommodationsRequest, opts ...grpc.CallOption) (*GetReaccommodationsResponse, error)
+	// Seat ledger: every seat status change on a trip, oldest first
+	GetSeatLedger(ctx context.Context, in *GetSeatLedgerRequest, opts ...grpc.CallOption) (*GetSeatLedgerResponse, error)
+	// Operator: hold seats back from sale until timed release tranches, for pre-sale launches
+	CreateReleaseTranches(ctx context.Context, in *CreateReleaseTranchesRequest, opts ...grpc.CallOption) (*CreateReleaseTranchesResponse, error)
+	ListReleaseTranches(ctx context.Context, in *ListReleaseTranchesRequest, opts ...grpc.CallOption) (*ListReleaseTranchesResponse, error)
+	ReleaseTranche(ctx context.Context, in *ReleaseTrancheRequest, opts ...grpc.CallOption) (*ReleaseTrancheResponse, error)
 }
 
 type inventoryServiceClient struct {
@@ -87,6 +127,16 @@ func (c *inventoryServiceClient) BatchCheckAvailability(ctx context.Context, in
 	return out, nil
 }
 
+func (c *inventoryServiceClient) GetAvailabilityCounts(ctx context.Context, in *GetAvailabilityCountsRequest, opts ...grpc.CallOption) (*GetAvailabilityCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailabilityCountsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetAvailabilityCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) HoldSeats(ctx context.Context, in *HoldSeatsRequest, opts ...grpc.CallOption) (*HoldSeatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HoldSeatsResponse)
@@ -107,6 +157,56 @@ func (c *inventoryServiceClient) ReleaseSeats(ctx context.Context, in *ReleaseSe
 	return out, nil
 }
 
+func (c *inventoryServiceClient) ExtendHold(ctx context.Context, in *ExtendHoldRequest, opts ...grpc.CallOption) (*ExtendHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendHoldResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ExtendHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) BlockSeats(ctx context.Context, in *BlockSeatsRequest, opts ...grpc.CallOption) (*BlockSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockSeatsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_BlockSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UnblockSeats(ctx context.Context, in *UnblockSeatsRequest, opts ...grpc.CallOption) (*UnblockSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockSeatsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UnblockSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetHoldPolicy(ctx context.Context, in *SetHoldPolicyRequest, opts ...grpc.CallOption) (*SetHoldPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetHoldPolicyResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetHoldPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetHoldPolicy(ctx context.Context, in *GetHoldPolicyRequest, opts ...grpc.CallOption) (*GetHoldPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHoldPolicyResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetHoldPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*ConfirmBookingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ConfirmBookingResponse)
@@ -147,6 +247,26 @@ func (c *inventoryServiceClient) UpdateInventory(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *inventoryServiceClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBookingResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CancelBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) TransferPassenger(ctx context.Context, in *TransferPassengerRequest, opts ...grpc.CallOption) (*TransferPassengerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferPassengerResponse)
+	err := c.cc.Invoke(ctx, InventoryService_TransferPassenger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(JoinWaitlistResponse)
@@ -167,6 +287,76 @@ func (c *inventoryServiceClient) GetUserWaitlist(ctx context.Context, in *GetUse
 	return out, nil
 }
 
+func (c *inventoryServiceClient) RespondWaitlistOffer(ctx context.Context, in *RespondWaitlistOfferRequest, opts ...grpc.CallOption) (*RespondWaitlistOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondWaitlistOfferResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RespondWaitlistOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetTripManifest(ctx context.Context, in *GetTripManifestRequest, opts ...grpc.CallOption) (*GetTripManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTripManifestResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetTripManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetReaccommodations(ctx context.Context, in *GetReaccommodationsRequest, opts ...grpc.CallOption) (*GetReaccommodationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReaccommodationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetReaccommodations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetSeatLedger(ctx context.Context, in *GetSeatLedgerRequest, opts ...grpc.CallOption) (*GetSeatLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeatLedgerResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetSeatLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreateReleaseTranches(ctx context.Context, in *CreateReleaseTranchesRequest, opts ...grpc.CallOption) (*CreateReleaseTranchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReleaseTranchesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreateReleaseTranches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListReleaseTranches(ctx context.Context, in *ListReleaseTranchesRequest, opts ...grpc.CallOption) (*ListReleaseTranchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReleaseTranchesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListReleaseTranches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReleaseTranche(ctx context.Context, in *ReleaseTrancheRequest, opts ...grpc.CallOption) (*ReleaseTrancheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseTrancheResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReleaseTranche_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
@@ -178,10 +368,20 @@ type InventoryServiceServer interface {
 	CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error)
 	// Bulk availability for search results
 	BatchCheckAvailability(context.Context, *BatchCheckRequest) (*BatchCheckResponse, error)
+	// Cheap seat counts for listing pages, many trips and station pairs per call (counter table, eventually consistent)
+	GetAvailabilityCounts(context.Context, *GetAvailabilityCountsRequest) (*GetAvailabilityCountsResponse, error)
 	// Hold seats temporarily (WRITE - with TTL)
 	HoldSeats(context.Context, *HoldSeatsRequest) (*HoldSeatsResponse, error)
 	// Release held seats (on timeout or user cancel)
 	ReleaseSeats(context.Context, *ReleaseSeatsRequest) (*ReleaseSeatsResponse, error)
+	// Extend an active hold, e.g. while the user is inside a wallet app
+	ExtendHold(context.Context, *ExtendHoldRequest) (*ExtendHoldResponse, error)
+	// Operator: take seats out of sale (staff, broken seats, VIPs) on a trip or every trip of a schedule
+	BlockSeats(context.Context, *BlockSeatsRequest) (*BlockSeatsResponse, error)
+	UnblockSeats(context.Context, *UnblockSeatsRequest) (*UnblockSeatsResponse, error)
+	// Admin: per-organization hold lifetime and extension limits
+	SetHoldPolicy(context.Context, *SetHoldPolicyRequest) (*SetHoldPolicyResponse, error)
+	GetHoldPolicy(context.Context, *GetHoldPolicyRequest) (*GetHoldPolicyResponse, error)
 	// Confirm booking (convert hold to confirmed)
 	ConfirmBooking(context.Context, *ConfirmBookingRequest) (*ConfirmBookingResponse, error)
 	// Get seat map with availability status
@@ -190,9 +390,24 @@ type InventoryServiceServer interface {
 	InitializeTripInventory(context.Context, *InitializeTripInventoryRequest) (*InitializeTripInventoryResponse, error)
 	// Admin: Bulk update inventory (cancellations, adjustments)
 	UpdateInventory(context.Context, *UpdateInventoryRequest) (*UpdateInventoryResponse, error)
+	// Cancel a confirmed booking and return its seats to the pool
+	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	// Moves a passenger's seats to a new holder after a ticket transfer
+	TransferPassenger(context.Context, *TransferPassengerRequest) (*TransferPassengerResponse, error)
 	// Waitlist
 	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error)
 	GetUserWaitlist(context.Context, *GetUserWaitlistRequest) (*GetUserWaitlistResponse, error)
+	RespondWaitlistOffer(context.Context, *RespondWaitlistOfferRequest) (*RespondWaitlistOfferResponse, error)
+	// Conductor manifest: confirmed passengers and the seat they occupy on each leg
+	GetTripManifest(context.Context, *GetTripManifestRequest) (*GetTripManifestResponse, error)
+	// Re-accommodation: where passengers were moved when the trip changed vehicle, and who could not be placed
+	GetReaccommodations(context.Context, *GetReaccommodationsRequest) (*GetReaccommodationsResponse, error)
+	// Seat ledger: every seat status change on a trip, oldest first
+	GetSeatLedger(context.Context, *GetSeatLedgerRequest) (*GetSeatLedgerResponse, error)
+	// Operator: hold seats back from sale until timed release tranches, for pre-sale launches
+	CreateReleaseTranches(context.Context, *CreateReleaseTranchesRequest) (*CreateReleaseTranchesResponse, error)
+	ListReleaseTranches(context.Context, *ListReleaseTranchesRequest) (*ListReleaseTranchesResponse, error)
+	ReleaseTranche(context.Context, *ReleaseTrancheRequest) (*ReleaseTrancheResponse, error)
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
@@ -209,12 +424,30 @@ func (UnimplementedInventoryServiceServer) CheckAvailability(context.Context, *C
 func (UnimplementedInventoryServiceServer) BatchCheckAvailability(context.Context, *BatchCheckRequest) (*BatchCheckResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BatchCheckAvailability not implemented")
 }
+func (UnimplementedInventoryServiceServer) GetAvailabilityCounts(context.Context, *GetAvailabilityCountsRequest) (*GetAvailabilityCountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAvailabilityCounts not implemented")
+}
 func (UnimplementedInventoryServiceServer) HoldSeats(context.Context, *HoldSeatsRequest) (*HoldSeatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method HoldSeats not implemented")
 }
 func (UnimplementedInventoryServiceServer) ReleaseSeats(context.Context, *ReleaseSeatsRequest) (*ReleaseSeatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReleaseSeats not implemented")
 }
+func (UnimplementedInventoryServiceServer) ExtendHold(context.Context, *ExtendHoldRequest) (*ExtendHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExtendHold not implemented")
+}
+func (UnimplementedInventoryServiceServer) BlockSeats(context.Context, *BlockSeatsRequest) (*BlockSeatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BlockSeats not implemented")
+}
+func (UnimplementedInventoryServiceServer) UnblockSeats(context.Context, *UnblockSeatsRequest) (*UnblockSeatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnblockSeats not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetHoldPolicy(context.Context, *SetHoldPolicyRequest) (*SetHoldPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetHoldPolicy not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetHoldPolicy(context.Context, *GetHoldPolicyRequest) (*GetHoldPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHoldPolicy not implemented")
+}
 func (UnimplementedInventoryServiceServer) ConfirmBooking(context.Context, *ConfirmBookingRequest) (*ConfirmBookingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ConfirmBooking not implemented")
 }
@@ -227,12 +460,39 @@ func (UnimplementedInventoryServiceServer) InitializeTripInventory(context.Conte
 func (UnimplementedInventoryServiceServer) UpdateInventory(context.Context, *UpdateInventoryRequest) (*UpdateInventoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateInventory not implemented")
 }
+func (UnimplementedInventoryServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedInventoryServiceServer) TransferPassenger(context.Context, *TransferPassengerRequest) (*TransferPassengerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferPassenger not implemented")
+}
 func (UnimplementedInventoryServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method JoinWaitlist not implemented")
 }
 func (UnimplementedInventoryServiceServer) GetUserWaitlist(context.Context, *GetUserWaitlistRequest) (*GetUserWaitlistResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserWaitlist not implemented")
 }
+func (UnimplementedInventoryServiceServer) RespondWaitlistOffer(context.Context, *RespondWaitlistOfferRequest) (*RespondWaitlistOfferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RespondWaitlistOffer not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetTripManifest(context.Context, *GetTripManifestRequest) (*GetTripManifestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTripManifest not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetReaccommodations(context.Context, *GetReaccommodationsRequest) (*GetReaccommodationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReaccommodations not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetSeatLedger(context.Context, *GetSeatLedgerRequest) (*GetSeatLedgerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSeatLedger not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateReleaseTranches(context.Context, *CreateReleaseTranchesRequest) (*CreateReleaseTranchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateReleaseTranches not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListReleaseTranches(context.Context, *ListReleaseTranchesRequest) (*ListReleaseTranchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReleaseTranches not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReleaseTranche(context.Context, *ReleaseTrancheRequest) (*ReleaseTrancheResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseTranche not implemented")
+}
 func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
@@ -290,6 +550,24 @@ func _InventoryService_BatchCheckAvailability_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_GetAvailabilityCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetAvailabilityCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetAvailabilityCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetAvailabilityCounts(ctx, req.(*GetAvailabilityCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_HoldSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HoldSeatsRequest)
 	if err := dec(in); err != nil {
@@ -326,6 +604,96 @@ func _InventoryService_ReleaseSeats_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_ExtendHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ExtendHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ExtendHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ExtendHold(ctx, req.(*ExtendHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_BlockSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).BlockSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_BlockSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).BlockSeats(ctx, req.(*BlockSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UnblockSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UnblockSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UnblockSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UnblockSeats(ctx, req.(*UnblockSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetHoldPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHoldPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetHoldPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetHoldPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetHoldPolicy(ctx, req.(*SetHoldPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetHoldPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHoldPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetHoldPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetHoldPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetHoldPolicy(ctx, req.(*GetHoldPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_ConfirmBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConfirmBookingRequest)
 	if err := dec(in); err != nil {
@@ -398,6 +766,42 @@ func _InventoryService_UpdateInventory_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_TransferPassenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferPassengerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).TransferPassenger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_TransferPassenger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).TransferPassenger(ctx, req.(*TransferPassengerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JoinWaitlistRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +838,132 @@ func _InventoryService_GetUserWaitlist_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_RespondWaitlistOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondWaitlistOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RespondWaitlistOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RespondWaitlistOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RespondWaitlistOffer(ctx, req.(*RespondWaitlistOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetTripManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetTripManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetTripManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetTripManifest(ctx, req.(*GetTripManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetReaccommodations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReaccommodationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetReaccommodations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetReaccommodations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetReaccommodations(ctx, req.(*GetReaccommodationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetSeatLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetSeatLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetSeatLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetSeatLedger(ctx, req.(*GetSeatLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateReleaseTranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReleaseTranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateReleaseTranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateReleaseTranches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateReleaseTranches(ctx, req.(*CreateReleaseTranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListReleaseTranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleaseTranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListReleaseTranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListReleaseTranches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListReleaseTranches(ctx, req.(*ListReleaseTranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReleaseTranche_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseTrancheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseTranche(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseTranche_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseTranche(ctx, req.(*ReleaseTrancheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -449,6 +979,10 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchCheckAvailability",
 			Handler:    _InventoryService_BatchCheckAvailability_Handler,
 		},
+		{
+			MethodName: "GetAvailabilityCounts",
+			Handler:    _InventoryService_GetAvailabilityCounts_Handler,
+		},
 		{
 			MethodName: "HoldSeats",
 			Handler:    _InventoryService_HoldSeats_Handler,
@@ -457,6 +991,26 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseSeats",
 			Handler:    _InventoryService_ReleaseSeats_Handler,
 		},
+		{
+			MethodName: "ExtendHold",
+			Handler:    _InventoryService_ExtendHold_Handler,
+		},
+		{
+			MethodName: "BlockSeats",
+			Handler:    _InventoryService_BlockSeats_Handler,
+		},
+		{
+			MethodName: "UnblockSeats",
+			Handler:    _InventoryService_UnblockSeats_Handler,
+		},
+		{
+			MethodName: "SetHoldPolicy",
+			Handler:    _InventoryService_SetHoldPolicy_Handler,
+		},
+		{
+			MethodName: "GetHoldPolicy",
+			Handler:    _InventoryService_GetHoldPolicy_Handler,
+		},
 		{
 			MethodName: "ConfirmBooking",
 			Handler:    _InventoryService_ConfirmBooking_Handler,
@@ -473,6 +1027,14 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInventory",
 			Handler:    _InventoryService_UpdateInventory_Handler,
 		},
+		{
+			MethodName: "CancelBooking",
+			Handler:    _InventoryService_CancelBooking_Handler,
+		},
+		{
+			MethodName: "TransferPassenger",
+			Handler:    _InventoryService_TransferPassenger_Handler,
+		},
 		{
 			MethodName: "JoinWaitlist",
 			Handler:    _InventoryService_JoinWaitlist_Handler,
@@ -481,6 +1043,34 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserWaitlist",
 			Handler:    _InventoryService_GetUserWaitlist_Handler,
 		},
+		{
+			MethodName: "RespondWaitlistOffer",
+			Handler:    _InventoryService_RespondWaitlistOffer_Handler,
+		},
+		{
+			MethodName: "GetTripManifest",
+			Handler:    _InventoryService_GetTripManifest_Handler,
+		},
+		{
+			MethodName: "GetReaccommodations",
+			Handler:    _InventoryService_GetReaccommodations_Handler,
+		},
+		{
+			MethodName: "GetSeatLedger",
+			Handler:    _InventoryService_GetSeatLedger_Handler,
+		},
+		{
+			MethodName: "CreateReleaseTranches",
+			Handler:    _InventoryService_CreateReleaseTranches_Handler,
+		},
+		{
+			MethodName: "ListReleaseTranches",
+			Handler:    _InventoryService_ListReleaseTranches_Handler,
+		},
+		{
+			MethodName: "ReleaseTranche",
+			Handler:    _InventoryService_ReleaseTranche_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/inventory/v1/inventory.proto",
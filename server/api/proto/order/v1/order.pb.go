@@ -32,6 +32,7 @@ const (
 	OrderStatus_ORDER_STATUS_EXPIRED        OrderStatus = 5 // Hold expired
 	OrderStatus_ORDER_STATUS_REFUND_PENDING OrderStatus = 6
 	OrderStatus_ORDER_STATUS_REFUNDED       OrderStatus = 7
+	OrderStatus_ORDER_STATUS_REVIEW_PENDING OrderStatus = 8 // Held by the fraud check for an analyst
 )
 
 // Enum value maps for OrderStatus.
@@ -45,6 +46,7 @@ var (
 		5: "ORDER_STATUS_EXPIRED",
 		6: "ORDER_STATUS_REFUND_PENDING",
 		7: "ORDER_STATUS_REFUNDED",
+		8: "ORDER_STATUS_REVIEW_PENDING",
 	}
 	OrderStatus_value = map[string]int32{
 		"ORDER_STATUS_UNSPECIFIED":    0,
@@ -55,6 +57,7 @@ var (
 		"ORDER_STATUS_EXPIRED":        5,
 		"ORDER_STATUS_REFUND_PENDING": 6,
 		"ORDER_STATUS_REFUNDED":       7,
+		"ORDER_STATUS_REVIEW_PENDING": 8,
 	}
 )
 
@@ -88,12 +91,13 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 type PaymentStatus int32
 
 const (
-	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED PaymentStatus = 0
-	PaymentStatus_PAYMENT_STATUS_PENDING     PaymentStatus = 1
-	PaymentStatus_PAYMENT_STATUS_AUTHORIZED  PaymentStatus = 2
-	PaymentStatus_PAYMENT_STATUS_CAPTURED    PaymentStatus = 3
-	PaymentStatus_PAYMENT_STATUS_FAILED      PaymentStatus = 4
-	PaymentStatus_PAYMENT_STATUS_REFUNDED    PaymentStatus = 5
+	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED        PaymentStatus = 0
+	PaymentStatus_PAYMENT_STATUS_PENDING            PaymentStatus = 1
+	PaymentStatus_PAYMENT_STATUS_AUTHORIZED         PaymentStatus = 2
+	PaymentStatus_PAYMENT_STATUS_CAPTURED           PaymentStatus = 3
+	PaymentStatus_PAYMENT_STATUS_FAILED             PaymentStatus = 4
+	PaymentStatus_PAYMENT_STATUS_REFUNDED           PaymentStatus = 5
+	PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED PaymentStatus = 6 // Refund policy kept part of the payment
 )
 
 // Enum value maps for PaymentStatus.
@@ -105,14 +109,16 @@ var (
 		3: "PAYMENT_STATUS_CAPTURED",
 		4: "PAYMENT_STATUS_FAILED",
 		5: "PAYMENT_STATUS_REFUNDED",
+		6: "PAYMENT_STATUS_PARTIALLY_REFUNDED",
 	}
 	PaymentStatus_value = map[string]int32{
-		"PAYMENT_STATUS_UNSPECIFIED": 0,
-		"PAYMENT_STATUS_PENDING":     1,
-		"PAYMENT_STATUS_AUTHORIZED":  2,
-		"PAYMENT_STATUS_CAPTURED":    3,
-		"PAYMENT_STATUS_FAILED":      4,
-		"PAYMENT_STATUS_REFUNDED":    5,
+		"PAYMENT_STATUS_UNSPECIFIED":        0,
+		"PAYMENT_STATUS_PENDING":            1,
+		"PAYMENT_STATUS_AUTHORIZED":         2,
+		"PAYMENT_STATUS_CAPTURED":           3,
+		"PAYMENT_STATUS_FAILED":             4,
+		"PAYMENT_STATUS_REFUNDED":           5,
+		"PAYMENT_STATUS_PARTIALLY_REFUNDED": 6,
 	}
 )
 
@@ -152,6 +158,9 @@ const (
 	SagaStatus_SAGA_STATUS_COMPENSATING SagaStatus = 3
 	SagaStatus_SAGA_STATUS_COMPENSATED  SagaStatus = 4
 	SagaStatus_SAGA_STATUS_FAILED       SagaStatus = 5
+	SagaStatus_SAGA_STATUS_PENDING      SagaStatus = 6
+	SagaStatus_SAGA_STATUS_RESOLVED     SagaStatus = 7 // Closed by staff after a manual fix
+	SagaStatus_SAGA_STATUS_SUSPENDED    SagaStatus = 8 // Paused by a step until resumed or aborted
 )
 
 // Enum value maps for SagaStatus.
@@ -163,6 +172,9 @@ var (
 		3: "SAGA_STATUS_COMPENSATING",
 		4: "SAGA_STATUS_COMPENSATED",
 		5: "SAGA_STATUS_FAILED",
+		6: "SAGA_STATUS_PENDING",
+		7: "SAGA_STATUS_RESOLVED",
+		8: "SAGA_STATUS_SUSPENDED",
 	}
 	SagaStatus_value = map[string]int32{
 		"SAGA_STATUS_UNSPECIFIED":  0,
@@ -171,6 +183,9 @@ var (
 		"SAGA_STATUS_COMPENSATING": 3,
 		"SAGA_STATUS_COMPENSATED":  4,
 		"SAGA_STATUS_FAILED":       5,
+		"SAGA_STATUS_PENDING":      6,
+		"SAGA_STATUS_RESOLVED":     7,
+		"SAGA_STATUS_SUSPENDED":    8,
 	}
 )
 
@@ -210,6 +225,7 @@ const (
 	StepStatus_STEP_STATUS_COMPLETED   StepStatus = 3
 	StepStatus_STEP_STATUS_FAILED      StepStatus = 4
 	StepStatus_STEP_STATUS_COMPENSATED StepStatus = 5
+	StepStatus_STEP_STATUS_INTERRUPTED StepStatus = 6 // Cut short by a restart
 )
 
 // Enum value maps for StepStatus.
@@ -221,6 +237,7 @@ var (
 		3: "STEP_STATUS_COMPLETED",
 		4: "STEP_STATUS_FAILED",
 		5: "STEP_STATUS_COMPENSATED",
+		6: "STEP_STATUS_INTERRUPTED",
 	}
 	StepStatus_value = map[string]int32{
 		"STEP_STATUS_UNSPECIFIED": 0,
@@ -229,6 +246,7 @@ var (
 		"STEP_STATUS_COMPLETED":   3,
 		"STEP_STATUS_FAILED":      4,
 		"STEP_STATUS_COMPENSATED": 5,
+		"STEP_STATUS_INTERRUPTED": 6,
 	}
 )
 
@@ -290,8 +308,26 @@ type Order struct {
 	UpdatedAt int64 `protobuf:"varint,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	ExpiresAt int64 `protobuf:"varint,22,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // For pending orders
 	// Contact
-	ContactEmail  string `protobuf:"bytes,23,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
-	ContactPhone  string `protobuf:"bytes,24,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	ContactEmail string `protobuf:"bytes,23,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	ContactPhone string `protobuf:"bytes,24,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	// Set once the order is cancelled
+	RefundBreakdown *RefundBreakdown `protobuf:"bytes,25,opt,name=refund_breakdown,json=refundBreakdown,proto3" json:"refund_breakdown,omitempty"`
+	// Passengers cancelled while the rest of the order stayed confirmed
+	PassengerCancellations []*PassengerCancellation `protobuf:"bytes,26,rep,name=passenger_cancellations,json=passengerCancellations,proto3" json:"passenger_cancellations,omitempty"`
+	// Round-trip and multi-leg orders: every leg, the first included. The trip, stations,
+	// booking, passengers and seats above are the first leg's; totals cover all legs.
+	Legs []*OrderLeg `protobuf:"bytes,27,rep,name=legs,proto3" json:"legs,omitempty"`
+	// Date and seat changes made to the confirmed order, oldest first
+	Changes []*OrderChange `protobuf:"bytes,28,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Set when the fraud check held the booking for manual review
+	FraudReview *FraudReview `protobuf:"bytes,29,opt,name=fraud_review,json=fraudReview,proto3" json:"fraud_review,omitempty"`
+	// Counter sales: the agent who sold the order and the shift it was sold in
+	AgentId string `protobuf:"bytes,30,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	ShiftId string `protobuf:"bytes,31,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	// Set when the order is charged to a corporate account
+	AccountId string `protobuf:"bytes,32,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Short booking reference printed on tickets; empty for orders placed before PNRs
+	Pnr           string `protobuf:"bytes,33,opt,name=pnr,proto3" json:"pnr,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -494,6 +530,201 @@ func (x *Order) GetContactPhone() string {
 	return ""
 }
 
+func (x *Order) GetRefundBreakdown() *RefundBreakdown {
+	if x != nil {
+		return x.RefundBreakdown
+	}
+	return nil
+}
+
+func (x *Order) GetPassengerCancellations() []*PassengerCancellation {
+	if x != nil {
+		return x.PassengerCancellations
+	}
+	return nil
+}
+
+func (x *Order) GetLegs() []*OrderLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *Order) GetChanges() []*OrderChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *Order) GetFraudReview() *FraudReview {
+	if x != nil {
+		return x.FraudReview
+	}
+	return nil
+}
+
+func (x *Order) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *Order) GetShiftId() string {
+	if x != nil {
+		return x.ShiftId
+	}
+	return ""
+}
+
+func (x *Order) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Order) GetPnr() string {
+	if x != nil {
+		return x.Pnr
+	}
+	return ""
+}
+
+type OrderLeg struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TripId          string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	FromStationId   string                 `protobuf:"bytes,2,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId     string                 `protobuf:"bytes,3,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	HoldId          string                 `protobuf:"bytes,4,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	BookingId       string                 `protobuf:"bytes,5,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Passengers      []*Passenger           `protobuf:"bytes,6,rep,name=passengers,proto3" json:"passengers,omitempty"`
+	Seats           []*BookedSeat          `protobuf:"bytes,7,rep,name=seats,proto3" json:"seats,omitempty"`
+	SubtotalPaisa   int64                  `protobuf:"varint,8,opt,name=subtotal_paisa,json=subtotalPaisa,proto3" json:"subtotal_paisa,omitempty"`
+	TaxPaisa        int64                  `protobuf:"varint,9,opt,name=tax_paisa,json=taxPaisa,proto3" json:"tax_paisa,omitempty"`
+	BookingFeePaisa int64                  `protobuf:"varint,10,opt,name=booking_fee_paisa,json=bookingFeePaisa,proto3" json:"booking_fee_paisa,omitempty"`
+	DiscountPaisa   int64                  `protobuf:"varint,11,opt,name=discount_paisa,json=discountPaisa,proto3" json:"discount_paisa,omitempty"`
+	TotalPaisa      int64                  `protobuf:"varint,12,opt,name=total_paisa,json=totalPaisa,proto3" json:"total_paisa,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderLeg) Reset() {
+	*x = OrderLeg{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderLeg) ProtoMessage() {}
+
+func (x *OrderLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderLeg.ProtoReflect.Descriptor instead.
+func (*OrderLeg) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderLeg) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *OrderLeg) GetFromStationId() string {
+	if x != nil {
+		return x.FromStationId
+	}
+	return ""
+}
+
+func (x *OrderLeg) GetToStationId() string {
+	if x != nil {
+		return x.ToStationId
+	}
+	return ""
+}
+
+func (x *OrderLeg) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *OrderLeg) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *OrderLeg) GetPassengers() []*Passenger {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+func (x *OrderLeg) GetSeats() []*BookedSeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *OrderLeg) GetSubtotalPaisa() int64 {
+	if x != nil {
+		return x.SubtotalPaisa
+	}
+	return 0
+}
+
+func (x *OrderLeg) GetTaxPaisa() int64 {
+	if x != nil {
+		return x.TaxPaisa
+	}
+	return 0
+}
+
+func (x *OrderLeg) GetBookingFeePaisa() int64 {
+	if x != nil {
+		return x.BookingFeePaisa
+	}
+	return 0
+}
+
+func (x *OrderLeg) GetDiscountPaisa() int64 {
+	if x != nil {
+		return x.DiscountPaisa
+	}
+	return 0
+}
+
+func (x *OrderLeg) GetTotalPaisa() int64 {
+	if x != nil {
+		return x.TotalPaisa
+	}
+	return 0
+}
+
 type Passenger struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Nid           string                 `protobuf:"bytes,1,opt,name=nid,proto3" json:"nid,omitempty"`
@@ -504,13 +735,15 @@ type Passenger struct {
 	Gender        string                 `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender,omitempty"`
 	Age           int32                  `protobuf:"varint,7,opt,name=age,proto3" json:"age,omitempty"`
 	NidVerified   bool                   `protobuf:"varint,8,opt,name=nid_verified,json=nidVerified,proto3" json:"nid_verified,omitempty"`
+	CapacityClass string                 `protobuf:"bytes,9,opt,name=capacity_class,json=capacityClass,proto3" json:"capacity_class,omitempty"` // Deck or standing passengers, who have no seat
+	Cancelled     bool                   `protobuf:"varint,10,opt,name=cancelled,proto3" json:"cancelled,omitempty"`                            // Cancelled out of an order that stayed confirmed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Passenger) Reset() {
 	*x = Passenger{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[1]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -522,7 +755,7 @@ func (x *Passenger) String() string {
 func (*Passenger) ProtoMessage() {}
 
 func (x *Passenger) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[1]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,7 +768,7 @@ func (x *Passenger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Passenger.ProtoReflect.Descriptor instead.
 func (*Passenger) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Passenger) GetNid() string {
@@ -594,20 +827,38 @@ func (x *Passenger) GetNidVerified() bool {
 	return false
 }
 
+func (x *Passenger) GetCapacityClass() string {
+	if x != nil {
+		return x.CapacityClass
+	}
+	return ""
+}
+
+func (x *Passenger) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
 type BookedSeat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
-	SeatNumber    string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	SeatClass     string                 `protobuf:"bytes,3,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
-	TicketId      string                 `protobuf:"bytes,4,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
-	PricePaisa    int64                  `protobuf:"varint,5,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SeatId         string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	SeatNumber     string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	SeatClass      string                 `protobuf:"bytes,3,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	TicketId       string                 `protobuf:"bytes,4,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	PricePaisa     int64                  `protobuf:"varint,5,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
+	PassengerIndex int32                  `protobuf:"varint,6,opt,name=passenger_index,json=passengerIndex,proto3" json:"passenger_index,omitempty"`
+	FromStationId  string                 `protobuf:"bytes,7,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"` // Split-seat journeys: where this seat leg starts
+	ToStationId    string                 `protobuf:"bytes,8,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	CapacityClass  string                 `protobuf:"bytes,9,opt,name=capacity_class,json=capacityClass,proto3" json:"capacity_class,omitempty"` // Capacity tickets only; seat_id is then empty
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BookedSeat) Reset() {
 	*x = BookedSeat{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[2]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -619,7 +870,7 @@ func (x *BookedSeat) String() string {
 func (*BookedSeat) ProtoMessage() {}
 
 func (x *BookedSeat) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[2]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,7 +883,7 @@ func (x *BookedSeat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BookedSeat.ProtoReflect.Descriptor instead.
 func (*BookedSeat) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BookedSeat) GetSeatId() string {
@@ -670,22 +921,57 @@ func (x *BookedSeat) GetPricePaisa() int64 {
 	return 0
 }
 
+func (x *BookedSeat) GetPassengerIndex() int32 {
+	if x != nil {
+		return x.PassengerIndex
+	}
+	return 0
+}
+
+func (x *BookedSeat) GetFromStationId() string {
+	if x != nil {
+		return x.FromStationId
+	}
+	return ""
+}
+
+func (x *BookedSeat) GetToStationId() string {
+	if x != nil {
+		return x.ToStationId
+	}
+	return ""
+}
+
+func (x *BookedSeat) GetCapacityClass() string {
+	if x != nil {
+		return x.CapacityClass
+	}
+	return ""
+}
+
 type SagaState struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SagaId        string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
-	Status        SagaStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=order.v1.SagaStatus" json:"status,omitempty"`
-	CurrentStep   string                 `protobuf:"bytes,3,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
-	Steps         []*SagaStep            `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
-	FailureReason string                 `protobuf:"bytes,5,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
-	StartedAt     int64                  `protobuf:"varint,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	CompletedAt   int64                  `protobuf:"varint,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SagaId           string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	Status           SagaStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=order.v1.SagaStatus" json:"status,omitempty"`
+	CurrentStep      string                 `protobuf:"bytes,3,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
+	Steps            []*SagaStep            `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	FailureReason    string                 `protobuf:"bytes,5,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	StartedAt        int64                  `protobuf:"varint,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt      int64                  `protobuf:"varint,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Name             string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"` // booking, cancellation
+	OrganizationId   string                 `protobuf:"bytes,9,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	CurrentStepIndex int32                  `protobuf:"varint,10,opt,name=current_step_index,json=currentStepIndex,proto3" json:"current_step_index,omitempty"`                                    // -1 before the first step starts
+	References       map[string]string      `protobuf:"bytes,11,rep,name=references,proto3" json:"references,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // order_id, payment_id, booking_id, refund_id, ...
+	Owner            string                 `protobuf:"bytes,12,opt,name=owner,proto3" json:"owner,omitempty"`                                                                                     // Instance holding the lease, empty once finished
+	LeaseUntil       int64                  `protobuf:"varint,13,opt,name=lease_until,json=leaseUntil,proto3" json:"lease_until,omitempty"`
+	UpdatedAt        int64                  `protobuf:"varint,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *SagaState) Reset() {
 	*x = SagaState{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[3]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -697,7 +983,7 @@ func (x *SagaState) String() string {
 func (*SagaState) ProtoMessage() {}
 
 func (x *SagaState) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[3]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +996,7 @@ func (x *SagaState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SagaState.ProtoReflect.Descriptor instead.
 func (*SagaState) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SagaState) GetSagaId() string {
@@ -762,6 +1048,55 @@ func (x *SagaState) GetCompletedAt() int64 {
 	return 0
 }
 
+func (x *SagaState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SagaState) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SagaState) GetCurrentStepIndex() int32 {
+	if x != nil {
+		return x.CurrentStepIndex
+	}
+	return 0
+}
+
+func (x *SagaState) GetReferences() map[string]string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *SagaState) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SagaState) GetLeaseUntil() int64 {
+	if x != nil {
+		return x.LeaseUntil
+	}
+	return 0
+}
+
+func (x *SagaState) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
 type SagaStep struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -776,7 +1111,7 @@ type SagaStep struct {
 
 func (x *SagaStep) Reset() {
 	*x = SagaStep{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[4]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -788,7 +1123,7 @@ func (x *SagaStep) String() string {
 func (*SagaStep) ProtoMessage() {}
 
 func (x *SagaStep) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[4]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,7 +1136,7 @@ func (x *SagaStep) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SagaStep.ProtoReflect.Descriptor instead.
 func (*SagaStep) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SagaStep) GetName() string {
@@ -860,13 +1195,24 @@ type CreateOrderRequest struct {
 	ContactPhone   string                 `protobuf:"bytes,10,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
 	CouponCode     string                 `protobuf:"bytes,11,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`             // Optional discount
 	IdempotencyKey string                 `protobuf:"bytes,12,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // For retry safety
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Round-trip and multi-leg orders: one entry per trip, paid with one charge and confirmed
+	// together. The single-trip fields above are ignored when legs are set.
+	Legs []*LegRequest `protobuf:"bytes,13,rep,name=legs,proto3" json:"legs,omitempty"`
+	// Client the order was placed from, for fraud scoring
+	IpAddress         string `protobuf:"bytes,14,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent         string `protobuf:"bytes,15,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	DeviceFingerprint string `protobuf:"bytes,16,opt,name=device_fingerprint,json=deviceFingerprint,proto3" json:"device_fingerprint,omitempty"`
+	// Counter agent selling the order; only agents take cash
+	AgentId string `protobuf:"bytes,17,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// Reserved seat quotas the seats may be held from, as vetted by the gateway
+	QuotaClaims   *QuotaClaims `protobuf:"bytes,18,opt,name=quota_claims,json=quotaClaims,proto3" json:"quota_claims,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
 	*x = CreateOrderRequest{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[5]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -878,7 +1224,7 @@ func (x *CreateOrderRequest) String() string {
 func (*CreateOrderRequest) ProtoMessage() {}
 
 func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[5]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,7 +1237,7 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateOrderRequest) GetOrganizationId() string {
@@ -978,21 +1324,140 @@ func (x *CreateOrderRequest) GetIdempotencyKey() string {
 	return ""
 }
 
-type PassengerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *CreateOrderRequest) GetLegs() []*LegRequest {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetDeviceFingerprint() string {
+	if x != nil {
+		return x.DeviceFingerprint
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetQuotaClaims() *QuotaClaims {
+	if x != nil {
+		return x.QuotaClaims
+	}
+	return nil
+}
+
+type LegRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	FromStationId string                 `protobuf:"bytes,2,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	ToStationId   string                 `protobuf:"bytes,3,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	HoldId        string                 `protobuf:"bytes,4,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"` // Optional: the leg's inventory hold
+	Passengers    []*PassengerRequest    `protobuf:"bytes,5,rep,name=passengers,proto3" json:"passengers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegRequest) Reset() {
+	*x = LegRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegRequest) ProtoMessage() {}
+
+func (x *LegRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegRequest.ProtoReflect.Descriptor instead.
+func (*LegRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LegRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *LegRequest) GetFromStationId() string {
+	if x != nil {
+		return x.FromStationId
+	}
+	return ""
+}
+
+func (x *LegRequest) GetToStationId() string {
+	if x != nil {
+		return x.ToStationId
+	}
+	return ""
+}
+
+func (x *LegRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *LegRequest) GetPassengers() []*PassengerRequest {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+type PassengerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	Nid           string                 `protobuf:"bytes,1,opt,name=nid,proto3" json:"nid,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	SeatId        string                 `protobuf:"bytes,3,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
 	Gender        string                 `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
 	Age           int32                  `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
-	DateOfBirth   string                 `protobuf:"bytes,6,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"` // For NID verification
+	DateOfBirth   string                 `protobuf:"bytes,6,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`     // For NID verification
+	CapacityClass string                 `protobuf:"bytes,7,opt,name=capacity_class,json=capacityClass,proto3" json:"capacity_class,omitempty"` // Deck or standing passengers: set instead of seat_id
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PassengerRequest) Reset() {
 	*x = PassengerRequest{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[6]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1004,7 +1469,7 @@ func (x *PassengerRequest) String() string {
 func (*PassengerRequest) ProtoMessage() {}
 
 func (x *PassengerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[6]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1482,7 @@ func (x *PassengerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PassengerRequest.ProtoReflect.Descriptor instead.
 func (*PassengerRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PassengerRequest) GetNid() string {
@@ -1062,19 +1527,104 @@ func (x *PassengerRequest) GetDateOfBirth() string {
 	return ""
 }
 
+func (x *PassengerRequest) GetCapacityClass() string {
+	if x != nil {
+		return x.CapacityClass
+	}
+	return ""
+}
+
+// QuotaClaims describes what the buyer is entitled to buy from reserved seat quotas
+type QuotaClaims struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Female        bool                   `protobuf:"varint,1,opt,name=female,proto3" json:"female,omitempty"`
+	Disabled      bool                   `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"` // web, app, counter, partner
+	PartnerId     string                 `protobuf:"bytes,4,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Vip           bool                   `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaClaims) Reset() {
+	*x = QuotaClaims{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaClaims) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaClaims) ProtoMessage() {}
+
+func (x *QuotaClaims) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaClaims.ProtoReflect.Descriptor instead.
+func (*QuotaClaims) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QuotaClaims) GetFemale() bool {
+	if x != nil {
+		return x.Female
+	}
+	return false
+}
+
+func (x *QuotaClaims) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *QuotaClaims) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *QuotaClaims) GetPartnerId() string {
+	if x != nil {
+		return x.PartnerId
+	}
+	return ""
+}
+
+func (x *QuotaClaims) GetVip() bool {
+	if x != nil {
+		return x.Vip
+	}
+	return false
+}
+
 type PaymentMethod struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`   // card, bkash, nagad, bank
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`   // card, bkash, nagad, bank, cash (counter agents only), account
 	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // Payment gateway token
 	CardLastFour  string                 `protobuf:"bytes,3,opt,name=card_last_four,json=cardLastFour,proto3" json:"card_last_four,omitempty"`
 	CardBrand     string                 `protobuf:"bytes,4,opt,name=card_brand,json=cardBrand,proto3" json:"card_brand,omitempty"`
+	AccountId     string                 `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Corporate account charged; account payments only
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PaymentMethod) Reset() {
 	*x = PaymentMethod{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[7]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +1636,7 @@ func (x *PaymentMethod) String() string {
 func (*PaymentMethod) ProtoMessage() {}
 
 func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[7]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +1649,7 @@ func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
 func (*PaymentMethod) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PaymentMethod) GetType() string {
@@ -1130,6 +1680,13 @@ func (x *PaymentMethod) GetCardBrand() string {
 	return ""
 }
 
+func (x *PaymentMethod) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
 type CreateOrderResponse struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Order              *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
@@ -1141,7 +1698,7 @@ type CreateOrderResponse struct {
 
 func (x *CreateOrderResponse) Reset() {
 	*x = CreateOrderResponse{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[8]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1153,7 +1710,7 @@ func (x *CreateOrderResponse) String() string {
 func (*CreateOrderResponse) ProtoMessage() {}
 
 func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[8]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,7 +1723,7 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateOrderResponse) GetOrder() *Order {
@@ -1200,7 +1757,7 @@ type GetOrderRequest struct {
 
 func (x *GetOrderRequest) Reset() {
 	*x = GetOrderRequest{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[9]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1212,7 +1769,7 @@ func (x *GetOrderRequest) String() string {
 func (*GetOrderRequest) ProtoMessage() {}
 
 func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[9]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,7 +1782,7 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetOrderRequest) GetOrderId() string {
@@ -1242,32 +1799,29 @@ func (x *GetOrderRequest) GetUserId() string {
 	return ""
 }
 
-type ListOrdersRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional: filter by operator
-	Status         OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`            // Optional: filter by status
-	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken      string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetOrderByPNRRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pnr           string                 `protobuf:"bytes,1,opt,name=pnr,proto3" json:"pnr,omitempty"`
+	Contact       string                 `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"` // Phone number or email the order was booked with
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[10]
+func (x *GetOrderByPNRRequest) Reset() {
+	*x = GetOrderByPNRRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *GetOrderByPNRRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*GetOrderByPNRRequest) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[10]
+func (x *GetOrderByPNRRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1278,70 +1832,50 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *ListOrdersRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use GetOrderByPNRRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderByPNRRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListOrdersRequest) GetOrganizationId() string {
+func (x *GetOrderByPNRRequest) GetPnr() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Pnr
 	}
 	return ""
 }
 
-func (x *ListOrdersRequest) GetStatus() OrderStatus {
-	if x != nil {
-		return x.Status
-	}
-	return OrderStatus_ORDER_STATUS_UNSPECIFIED
-}
-
-func (x *ListOrdersRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListOrdersRequest) GetPageToken() string {
+func (x *GetOrderByPNRRequest) GetContact() string {
 	if x != nil {
-		return x.PageToken
+		return x.Contact
 	}
 	return ""
 }
 
-type ListOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CancelOrderByPNRRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Pnr                 string                 `protobuf:"bytes,1,opt,name=pnr,proto3" json:"pnr,omitempty"`
+	Contact             string                 `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"`
+	Reason              string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpectedRefundPaisa int64                  `protobuf:"varint,4,opt,name=expected_refund_paisa,json=expectedRefundPaisa,proto3" json:"expected_refund_paisa,omitempty"` // Optional: quoted refund; a lower refund rejects the cancellation
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[11]
+func (x *CancelOrderByPNRRequest) Reset() {
+	*x = CancelOrderByPNRRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *CancelOrderByPNRRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*CancelOrderByPNRRequest) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[11]
+func (x *CancelOrderByPNRRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1352,44 +1886,188 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CancelOrderByPNRRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderByPNRRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListOrdersResponse) GetOrders() []*Order {
+func (x *CancelOrderByPNRRequest) GetPnr() string {
 	if x != nil {
-		return x.Orders
+		return x.Pnr
 	}
-	return nil
+	return ""
 }
 
-func (x *ListOrdersResponse) GetNextPageToken() string {
+func (x *CancelOrderByPNRRequest) GetContact() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Contact
 	}
 	return ""
 }
 
-func (x *ListOrdersResponse) GetTotalCount() int32 {
+func (x *CancelOrderByPNRRequest) GetReason() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelOrderByPNRRequest) GetExpectedRefundPaisa() int64 {
+	if x != nil {
+		return x.ExpectedRefundPaisa
 	}
 	return 0
 }
 
-type CancelOrderRequest struct {
+type ListOrdersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional: filter by operator
+	Status         OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`            // Optional: filter by status
+	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *ListOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListOrdersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CancelOrderRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	OrderId             string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId              string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason              string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpectedRefundPaisa int64                  `protobuf:"varint,4,opt,name=expected_refund_paisa,json=expectedRefundPaisa,proto3" json:"expected_refund_paisa,omitempty"` // Optional: quoted refund; a lower refund rejects the cancellation
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
 func (x *CancelOrderRequest) Reset() {
 	*x = CancelOrderRequest{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[12]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1401,7 +2079,7 @@ func (x *CancelOrderRequest) String() string {
 func (*CancelOrderRequest) ProtoMessage() {}
 
 func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[12]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,7 +2092,7 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
 func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CancelOrderRequest) GetOrderId() string {
@@ -1438,6 +2116,13 @@ func (x *CancelOrderRequest) GetReason() string {
 	return ""
 }
 
+func (x *CancelOrderRequest) GetExpectedRefundPaisa() int64 {
+	if x != nil {
+		return x.ExpectedRefundPaisa
+	}
+	return 0
+}
+
 type CancelOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1449,7 +2134,7 @@ type CancelOrderResponse struct {
 
 func (x *CancelOrderResponse) Reset() {
 	*x = CancelOrderResponse{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[13]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1461,7 +2146,7 @@ func (x *CancelOrderResponse) String() string {
 func (*CancelOrderResponse) ProtoMessage() {}
 
 func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[13]
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1474,7 +2159,7 @@ func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
 func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{13}
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CancelOrderResponse) GetSuccess() bool {
@@ -1484,45 +2169,3972 @@ func (x *CancelOrderResponse) GetSuccess() bool {
 	return false
 }
 
-func (x *CancelOrderResponse) GetOrder() *Order {
+func (x *CancelOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *CancelOrderResponse) GetRefund() *RefundInfo {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type CancelPassengersRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	OrderId             string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId              string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PassengerIndexes    []int32                `protobuf:"varint,3,rep,packed,name=passenger_indexes,json=passengerIndexes,proto3" json:"passenger_indexes,omitempty"`
+	SeatIds             []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"` // Cancels the passengers holding these seats
+	Reason              string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpectedRefundPaisa int64                  `protobuf:"varint,6,opt,name=expected_refund_paisa,json=expectedRefundPaisa,proto3" json:"expected_refund_paisa,omitempty"` // Optional: quoted refund; a lower refund rejects the cancellation
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CancelPassengersRequest) Reset() {
+	*x = CancelPassengersRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPassengersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPassengersRequest) ProtoMessage() {}
+
+func (x *CancelPassengersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPassengersRequest.ProtoReflect.Descriptor instead.
+func (*CancelPassengersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelPassengersRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelPassengersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelPassengersRequest) GetPassengerIndexes() []int32 {
+	if x != nil {
+		return x.PassengerIndexes
+	}
+	return nil
+}
+
+func (x *CancelPassengersRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *CancelPassengersRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelPassengersRequest) GetExpectedRefundPaisa() int64 {
+	if x != nil {
+		return x.ExpectedRefundPaisa
+	}
+	return 0
+}
+
+type CancelPassengersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Order         *Order                 `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"` // With totals reduced, or cancelled when no passenger is left
+	Refund        *RefundInfo            `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPassengersResponse) Reset() {
+	*x = CancelPassengersResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPassengersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPassengersResponse) ProtoMessage() {}
+
+func (x *CancelPassengersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPassengersResponse.ProtoReflect.Descriptor instead.
+func (*CancelPassengersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CancelPassengersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelPassengersResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *CancelPassengersResponse) GetRefund() *RefundInfo {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type PassengerCancellation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PassengerIndexes []int32                `protobuf:"varint,1,rep,packed,name=passenger_indexes,json=passengerIndexes,proto3" json:"passenger_indexes,omitempty"`
+	Seats            []*BookedSeat          `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	Reason           string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RefundId         string                 `protobuf:"bytes,4,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	Refund           *RefundBreakdown       `protobuf:"bytes,5,opt,name=refund,proto3" json:"refund,omitempty"`
+	CancelledAt      int64                  `protobuf:"varint,6,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PassengerCancellation) Reset() {
+	*x = PassengerCancellation{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PassengerCancellation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassengerCancellation) ProtoMessage() {}
+
+func (x *PassengerCancellation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassengerCancellation.ProtoReflect.Descriptor instead.
+func (*PassengerCancellation) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PassengerCancellation) GetPassengerIndexes() []int32 {
+	if x != nil {
+		return x.PassengerIndexes
+	}
+	return nil
+}
+
+func (x *PassengerCancellation) GetSeats() []*BookedSeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *PassengerCancellation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PassengerCancellation) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *PassengerCancellation) GetRefund() *RefundBreakdown {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *PassengerCancellation) GetCancelledAt() int64 {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return 0
+}
+
+type RefundInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RefundId            string                 `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	AmountPaisa         int64                  `protobuf:"varint,2,opt,name=amount_paisa,json=amountPaisa,proto3" json:"amount_paisa,omitempty"`
+	Status              string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // completed, not_refundable
+	EstimatedCompletion int64                  `protobuf:"varint,4,opt,name=estimated_completion,json=estimatedCompletion,proto3" json:"estimated_completion,omitempty"`
+	Breakdown           *RefundBreakdown       `protobuf:"bytes,5,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RefundInfo) Reset() {
+	*x = RefundInfo{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundInfo) ProtoMessage() {}
+
+func (x *RefundInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundInfo.ProtoReflect.Descriptor instead.
+func (*RefundInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RefundInfo) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *RefundInfo) GetAmountPaisa() int64 {
+	if x != nil {
+		return x.AmountPaisa
+	}
+	return 0
+}
+
+func (x *RefundInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RefundInfo) GetEstimatedCompletion() int64 {
+	if x != nil {
+		return x.EstimatedCompletion
+	}
+	return 0
+}
+
+func (x *RefundInfo) GetBreakdown() *RefundBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+type RefundBreakdown struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId              string                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"` // Empty for the default policy
+	PolicyName            string                 `protobuf:"bytes,2,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	DepartureTime         int64                  `protobuf:"varint,3,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	HoursBeforeDeparture  float64                `protobuf:"fixed64,4,opt,name=hours_before_departure,json=hoursBeforeDeparture,proto3" json:"hours_before_departure,omitempty"`
+	RefundPercent         int32                  `protobuf:"varint,5,opt,name=refund_percent,json=refundPercent,proto3" json:"refund_percent,omitempty"`
+	FarePaisa             int64                  `protobuf:"varint,6,opt,name=fare_paisa,json=farePaisa,proto3" json:"fare_paisa,omitempty"` // Subtotal less discount
+	FareRefundPaisa       int64                  `protobuf:"varint,7,opt,name=fare_refund_paisa,json=fareRefundPaisa,proto3" json:"fare_refund_paisa,omitempty"`
+	TaxPaisa              int64                  `protobuf:"varint,8,opt,name=tax_paisa,json=taxPaisa,proto3" json:"tax_paisa,omitempty"`
+	TaxRefundPaisa        int64                  `protobuf:"varint,9,opt,name=tax_refund_paisa,json=taxRefundPaisa,proto3" json:"tax_refund_paisa,omitempty"`
+	BookingFeePaisa       int64                  `protobuf:"varint,10,opt,name=booking_fee_paisa,json=bookingFeePaisa,proto3" json:"booking_fee_paisa,omitempty"`
+	BookingFeeRefundPaisa int64                  `protobuf:"varint,11,opt,name=booking_fee_refund_paisa,json=bookingFeeRefundPaisa,proto3" json:"booking_fee_refund_paisa,omitempty"`
+	RefundPaisa           int64                  `protobuf:"varint,12,opt,name=refund_paisa,json=refundPaisa,proto3" json:"refund_paisa,omitempty"`
+	RetainedPaisa         int64                  `protobuf:"varint,13,opt,name=retained_paisa,json=retainedPaisa,proto3" json:"retained_paisa,omitempty"`
+	QuotedAt              int64                  `protobuf:"varint,14,opt,name=quoted_at,json=quotedAt,proto3" json:"quoted_at,omitempty"`
+	// Multi-leg orders: each leg quoted under its own trip's policy; the totals above add them up
+	Legs          []*RefundBreakdown `protobuf:"bytes,15,rep,name=legs,proto3" json:"legs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundBreakdown) Reset() {
+	*x = RefundBreakdown{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundBreakdown) ProtoMessage() {}
+
+func (x *RefundBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundBreakdown.ProtoReflect.Descriptor instead.
+func (*RefundBreakdown) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RefundBreakdown) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *RefundBreakdown) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *RefundBreakdown) GetDepartureTime() int64 {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetHoursBeforeDeparture() float64 {
+	if x != nil {
+		return x.HoursBeforeDeparture
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetRefundPercent() int32 {
+	if x != nil {
+		return x.RefundPercent
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetFarePaisa() int64 {
+	if x != nil {
+		return x.FarePaisa
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetFareRefundPaisa() int64 {
+	if x != nil {
+		return x.FareRefundPaisa
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetTaxPaisa() int64 {
+	if x != nil {
+		return x.TaxPaisa
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetTaxRefundPaisa() int64 {
+	if x != nil {
+		return x.TaxRefundPaisa
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetBookingFeePaisa() int64 {
+	if x != nil {
+		return x.BookingFeePaisa
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetBookingFeeRefundPaisa() int64 {
+	if x != nil {
+		return x.BookingFeeRefundPaisa
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetRefundPaisa() int64 {
+	if x != nil {
+		return x.RefundPaisa
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetRetainedPaisa() int64 {
+	if x != nil {
+		return x.RetainedPaisa
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetQuotedAt() int64 {
+	if x != nil {
+		return x.QuotedAt
+	}
+	return 0
+}
+
+func (x *RefundBreakdown) GetLegs() []*RefundBreakdown {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+type ChangeOrderRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	OrderId                string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId                 string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TripId                 string                 `protobuf:"bytes,3,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`                                                      // Optional: another trip of the route; the same trip when empty
+	SeatIds                []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`                                                   // New seat of each remaining passenger, in order; empty for deck and standing
+	HoldId                 string                 `protobuf:"bytes,5,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`                                                      // Optional: hold already placed on the new seats
+	PaymentToken           string                 `protobuf:"bytes,6,opt,name=payment_token,json=paymentToken,proto3" json:"payment_token,omitempty"`                                    // Required when the change costs more
+	ExpectedAmountDuePaisa int64                  `protobuf:"varint,7,opt,name=expected_amount_due_paisa,json=expectedAmountDuePaisa,proto3" json:"expected_amount_due_paisa,omitempty"` // Quoted amount due; a higher amount rejects the change
+	CheckExpectedAmount    bool                   `protobuf:"varint,8,opt,name=check_expected_amount,json=checkExpectedAmount,proto3" json:"check_expected_amount,omitempty"`            // Whether expected_amount_due_paisa is set
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ChangeOrderRequest) Reset() {
+	*x = ChangeOrderRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeOrderRequest) ProtoMessage() {}
+
+func (x *ChangeOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeOrderRequest.ProtoReflect.Descriptor instead.
+func (*ChangeOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChangeOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ChangeOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangeOrderRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *ChangeOrderRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *ChangeOrderRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ChangeOrderRequest) GetPaymentToken() string {
+	if x != nil {
+		return x.PaymentToken
+	}
+	return ""
+}
+
+func (x *ChangeOrderRequest) GetExpectedAmountDuePaisa() int64 {
+	if x != nil {
+		return x.ExpectedAmountDuePaisa
+	}
+	return 0
+}
+
+func (x *ChangeOrderRequest) GetCheckExpectedAmount() bool {
+	if x != nil {
+		return x.CheckExpectedAmount
+	}
+	return false
+}
+
+type ChangeOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Order         *Order                 `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	Change        *OrderChange           `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeOrderResponse) Reset() {
+	*x = ChangeOrderResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeOrderResponse) ProtoMessage() {}
+
+func (x *ChangeOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeOrderResponse.ProtoReflect.Descriptor instead.
+func (*ChangeOrderResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChangeOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ChangeOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ChangeOrderResponse) GetChange() *OrderChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type ChangeQuote struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId             string                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"` // Empty for the default policy
+	PolicyName           string                 `protobuf:"bytes,2,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	DepartureTime        int64                  `protobuf:"varint,3,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"` // Of the trip booked now
+	HoursBeforeDeparture float64                `protobuf:"fixed64,4,opt,name=hours_before_departure,json=hoursBeforeDeparture,proto3" json:"hours_before_departure,omitempty"`
+	NewTripId            string                 `protobuf:"bytes,5,opt,name=new_trip_id,json=newTripId,proto3" json:"new_trip_id,omitempty"`
+	OldTotalPaisa        int64                  `protobuf:"varint,6,opt,name=old_total_paisa,json=oldTotalPaisa,proto3" json:"old_total_paisa,omitempty"`
+	NewTotalPaisa        int64                  `protobuf:"varint,7,opt,name=new_total_paisa,json=newTotalPaisa,proto3" json:"new_total_paisa,omitempty"`
+	FareDifferencePaisa  int64                  `protobuf:"varint,8,opt,name=fare_difference_paisa,json=fareDifferencePaisa,proto3" json:"fare_difference_paisa,omitempty"`
+	ChangeFeePaisa       int64                  `protobuf:"varint,9,opt,name=change_fee_paisa,json=changeFeePaisa,proto3" json:"change_fee_paisa,omitempty"`
+	AmountDuePaisa       int64                  `protobuf:"varint,10,opt,name=amount_due_paisa,json=amountDuePaisa,proto3" json:"amount_due_paisa,omitempty"` // Collected when positive, refunded when negative
+	QuotedAt             int64                  `protobuf:"varint,11,opt,name=quoted_at,json=quotedAt,proto3" json:"quoted_at,omitempty"`
+	Currency             string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChangeQuote) Reset() {
+	*x = ChangeQuote{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeQuote) ProtoMessage() {}
+
+func (x *ChangeQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeQuote.ProtoReflect.Descriptor instead.
+func (*ChangeQuote) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChangeQuote) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *ChangeQuote) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *ChangeQuote) GetDepartureTime() int64 {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return 0
+}
+
+func (x *ChangeQuote) GetHoursBeforeDeparture() float64 {
+	if x != nil {
+		return x.HoursBeforeDeparture
+	}
+	return 0
+}
+
+func (x *ChangeQuote) GetNewTripId() string {
+	if x != nil {
+		return x.NewTripId
+	}
+	return ""
+}
+
+func (x *ChangeQuote) GetOldTotalPaisa() int64 {
+	if x != nil {
+		return x.OldTotalPaisa
+	}
+	return 0
+}
+
+func (x *ChangeQuote) GetNewTotalPaisa() int64 {
+	if x != nil {
+		return x.NewTotalPaisa
+	}
+	return 0
+}
+
+func (x *ChangeQuote) GetFareDifferencePaisa() int64 {
+	if x != nil {
+		return x.FareDifferencePaisa
+	}
+	return 0
+}
+
+func (x *ChangeQuote) GetChangeFeePaisa() int64 {
+	if x != nil {
+		return x.ChangeFeePaisa
+	}
+	return 0
+}
+
+func (x *ChangeQuote) GetAmountDuePaisa() int64 {
+	if x != nil {
+		return x.AmountDuePaisa
+	}
+	return 0
+}
+
+func (x *ChangeQuote) GetQuotedAt() int64 {
+	if x != nil {
+		return x.QuotedAt
+	}
+	return 0
+}
+
+func (x *ChangeQuote) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type OrderChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldTripId     string                 `protobuf:"bytes,1,opt,name=old_trip_id,json=oldTripId,proto3" json:"old_trip_id,omitempty"`
+	OldBookingId  string                 `protobuf:"bytes,2,opt,name=old_booking_id,json=oldBookingId,proto3" json:"old_booking_id,omitempty"`
+	OldSeats      []*BookedSeat          `protobuf:"bytes,3,rep,name=old_seats,json=oldSeats,proto3" json:"old_seats,omitempty"`
+	NewTripId     string                 `protobuf:"bytes,4,opt,name=new_trip_id,json=newTripId,proto3" json:"new_trip_id,omitempty"`
+	NewBookingId  string                 `protobuf:"bytes,5,opt,name=new_booking_id,json=newBookingId,proto3" json:"new_booking_id,omitempty"`
+	Quote         *ChangeQuote           `protobuf:"bytes,6,opt,name=quote,proto3" json:"quote,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,7,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`           // Charge collecting the amount due
+	RefundId      string                 `protobuf:"bytes,8,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`              // Refund of a cheaper change
+	RefundFailed  bool                   `protobuf:"varint,9,opt,name=refund_failed,json=refundFailed,proto3" json:"refund_failed,omitempty"` // The refund owed is left to staff
+	ChangedAt     int64                  `protobuf:"varint,10,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderChange) Reset() {
+	*x = OrderChange{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderChange) ProtoMessage() {}
+
+func (x *OrderChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderChange.ProtoReflect.Descriptor instead.
+func (*OrderChange) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OrderChange) GetOldTripId() string {
+	if x != nil {
+		return x.OldTripId
+	}
+	return ""
+}
+
+func (x *OrderChange) GetOldBookingId() string {
+	if x != nil {
+		return x.OldBookingId
+	}
+	return ""
+}
+
+func (x *OrderChange) GetOldSeats() []*BookedSeat {
+	if x != nil {
+		return x.OldSeats
+	}
+	return nil
+}
+
+func (x *OrderChange) GetNewTripId() string {
+	if x != nil {
+		return x.NewTripId
+	}
+	return ""
+}
+
+func (x *OrderChange) GetNewBookingId() string {
+	if x != nil {
+		return x.NewBookingId
+	}
+	return ""
+}
+
+func (x *OrderChange) GetQuote() *ChangeQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *OrderChange) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *OrderChange) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *OrderChange) GetRefundFailed() bool {
+	if x != nil {
+		return x.RefundFailed
+	}
+	return false
+}
+
+func (x *OrderChange) GetChangedAt() int64 {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return 0
+}
+
+type TransferPassengerRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrderId          string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PassengerIndex   int32                  `protobuf:"varint,3,opt,name=passenger_index,json=passengerIndex,proto3" json:"passenger_index,omitempty"`
+	NewNid           string                 `protobuf:"bytes,4,opt,name=new_nid,json=newNid,proto3" json:"new_nid,omitempty"`
+	NewName          string                 `protobuf:"bytes,5,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	NewDateOfBirth   string                 `protobuf:"bytes,6,opt,name=new_date_of_birth,json=newDateOfBirth,proto3" json:"new_date_of_birth,omitempty"`      // Checked with the NID service
+	PaymentToken     string                 `protobuf:"bytes,7,opt,name=payment_token,json=paymentToken,proto3" json:"payment_token,omitempty"`                // Required when the transfer has a fee
+	ExpectedFeePaisa int64                  `protobuf:"varint,8,opt,name=expected_fee_paisa,json=expectedFeePaisa,proto3" json:"expected_fee_paisa,omitempty"` // Quoted fee; a higher fee rejects the transfer
+	CheckExpectedFee bool                   `protobuf:"varint,9,opt,name=check_expected_fee,json=checkExpectedFee,proto3" json:"check_expected_fee,omitempty"` // Whether expected_fee_paisa is set
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TransferPassengerRequest) Reset() {
+	*x = TransferPassengerRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferPassengerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPassengerRequest) ProtoMessage() {}
+
+func (x *TransferPassengerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPassengerRequest.ProtoReflect.Descriptor instead.
+func (*TransferPassengerRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TransferPassengerRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *TransferPassengerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TransferPassengerRequest) GetPassengerIndex() int32 {
+	if x != nil {
+		return x.PassengerIndex
+	}
+	return 0
+}
+
+func (x *TransferPassengerRequest) GetNewNid() string {
+	if x != nil {
+		return x.NewNid
+	}
+	return ""
+}
+
+func (x *TransferPassengerRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *TransferPassengerRequest) GetNewDateOfBirth() string {
+	if x != nil {
+		return x.NewDateOfBirth
+	}
+	return ""
+}
+
+func (x *TransferPassengerRequest) GetPaymentToken() string {
+	if x != nil {
+		return x.PaymentToken
+	}
+	return ""
+}
+
+func (x *TransferPassengerRequest) GetExpectedFeePaisa() int64 {
+	if x != nil {
+		return x.ExpectedFeePaisa
+	}
+	return 0
+}
+
+func (x *TransferPassengerRequest) GetCheckExpectedFee() bool {
+	if x != nil {
+		return x.CheckExpectedFee
+	}
+	return false
+}
+
+type TransferPassengerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Order         *Order                 `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	Transfer      *PassengerTransfer     `protobuf:"bytes,3,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferPassengerResponse) Reset() {
+	*x = TransferPassengerResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferPassengerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPassengerResponse) ProtoMessage() {}
+
+func (x *TransferPassengerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPassengerResponse.ProtoReflect.Descriptor instead.
+func (*TransferPassengerResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TransferPassengerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TransferPassengerResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *TransferPassengerResponse) GetTransfer() *PassengerTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type GetTransferQuoteRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PassengerIndex int32                  `protobuf:"varint,3,opt,name=passenger_index,json=passengerIndex,proto3" json:"passenger_index,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTransferQuoteRequest) Reset() {
+	*x = GetTransferQuoteRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferQuoteRequest) ProtoMessage() {}
+
+func (x *GetTransferQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTransferQuoteRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetTransferQuoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTransferQuoteRequest) GetPassengerIndex() int32 {
+	if x != nil {
+		return x.PassengerIndex
+	}
+	return 0
+}
+
+type TransferQuote struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId             string                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"` // Empty for the default policy
+	PolicyName           string                 `protobuf:"bytes,2,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	DepartureTime        int64                  `protobuf:"varint,3,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	HoursBeforeDeparture float64                `protobuf:"fixed64,4,opt,name=hours_before_departure,json=hoursBeforeDeparture,proto3" json:"hours_before_departure,omitempty"`
+	FeePaisa             int64                  `protobuf:"varint,5,opt,name=fee_paisa,json=feePaisa,proto3" json:"fee_paisa,omitempty"`
+	QuotedAt             int64                  `protobuf:"varint,6,opt,name=quoted_at,json=quotedAt,proto3" json:"quoted_at,omitempty"`
+	Currency             string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TransferQuote) Reset() {
+	*x = TransferQuote{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferQuote) ProtoMessage() {}
+
+func (x *TransferQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferQuote.ProtoReflect.Descriptor instead.
+func (*TransferQuote) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TransferQuote) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *TransferQuote) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *TransferQuote) GetDepartureTime() int64 {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return 0
+}
+
+func (x *TransferQuote) GetHoursBeforeDeparture() float64 {
+	if x != nil {
+		return x.HoursBeforeDeparture
+	}
+	return 0
+}
+
+func (x *TransferQuote) GetFeePaisa() int64 {
+	if x != nil {
+		return x.FeePaisa
+	}
+	return 0
+}
+
+func (x *TransferQuote) GetQuotedAt() int64 {
+	if x != nil {
+		return x.QuotedAt
+	}
+	return 0
+}
+
+func (x *TransferQuote) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type PassengerTransfer struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TripId         string                 `protobuf:"bytes,5,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	PassengerIndex int32                  `protobuf:"varint,6,opt,name=passenger_index,json=passengerIndex,proto3" json:"passenger_index,omitempty"`
+	OldNid         string                 `protobuf:"bytes,7,opt,name=old_nid,json=oldNid,proto3" json:"old_nid,omitempty"`
+	OldName        string                 `protobuf:"bytes,8,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewNid         string                 `protobuf:"bytes,9,opt,name=new_nid,json=newNid,proto3" json:"new_nid,omitempty"`
+	NewName        string                 `protobuf:"bytes,10,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	FeePaisa       int64                  `protobuf:"varint,11,opt,name=fee_paisa,json=feePaisa,proto3" json:"fee_paisa,omitempty"`
+	PaymentId      string                 `protobuf:"bytes,12,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"` // Charge collecting the fee
+	TransferredAt  int64                  `protobuf:"varint,13,opt,name=transferred_at,json=transferredAt,proto3" json:"transferred_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PassengerTransfer) Reset() {
+	*x = PassengerTransfer{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PassengerTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassengerTransfer) ProtoMessage() {}
+
+func (x *PassengerTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassengerTransfer.ProtoReflect.Descriptor instead.
+func (*PassengerTransfer) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PassengerTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PassengerTransfer) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PassengerTransfer) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *PassengerTransfer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PassengerTransfer) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *PassengerTransfer) GetPassengerIndex() int32 {
+	if x != nil {
+		return x.PassengerIndex
+	}
+	return 0
+}
+
+func (x *PassengerTransfer) GetOldNid() string {
+	if x != nil {
+		return x.OldNid
+	}
+	return ""
+}
+
+func (x *PassengerTransfer) GetOldName() string {
+	if x != nil {
+		return x.OldName
+	}
+	return ""
+}
+
+func (x *PassengerTransfer) GetNewNid() string {
+	if x != nil {
+		return x.NewNid
+	}
+	return ""
+}
+
+func (x *PassengerTransfer) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *PassengerTransfer) GetFeePaisa() int64 {
+	if x != nil {
+		return x.FeePaisa
+	}
+	return 0
+}
+
+func (x *PassengerTransfer) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *PassengerTransfer) GetTransferredAt() int64 {
+	if x != nil {
+		return x.TransferredAt
+	}
+	return 0
+}
+
+type ListTransfersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional for admins: every organization when empty
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nid            string                 `protobuf:"bytes,4,opt,name=nid,proto3" json:"nid,omitempty"` // Matches either the old or the new passenger
+	Limit          int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest) ProtoMessage() {}
+
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListTransfersRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetNid() string {
+	if x != nil {
+		return x.Nid
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*PassengerTransfer   `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersResponse) ProtoMessage() {}
+
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListTransfersResponse) GetTransfers() []*PassengerTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *ListTransfersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetRefundQuoteRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Optional: quote cancelling only these passengers, or the passengers holding these seats
+	PassengerIndexes []int32  `protobuf:"varint,3,rep,packed,name=passenger_indexes,json=passengerIndexes,proto3" json:"passenger_indexes,omitempty"`
+	SeatIds          []string `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetRefundQuoteRequest) Reset() {
+	*x = GetRefundQuoteRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefundQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundQuoteRequest) ProtoMessage() {}
+
+func (x *GetRefundQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetRefundQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetRefundQuoteRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetRefundQuoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRefundQuoteRequest) GetPassengerIndexes() []int32 {
+	if x != nil {
+		return x.PassengerIndexes
+	}
+	return nil
+}
+
+func (x *GetRefundQuoteRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type GetRefundQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *RefundBreakdown       `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	TotalPaisa    int64                  `protobuf:"varint,2,opt,name=total_paisa,json=totalPaisa,proto3" json:"total_paisa,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefundQuoteResponse) Reset() {
+	*x = GetRefundQuoteResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefundQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundQuoteResponse) ProtoMessage() {}
+
+func (x *GetRefundQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetRefundQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetRefundQuoteResponse) GetQuote() *RefundBreakdown {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *GetRefundQuoteResponse) GetTotalPaisa() int64 {
+	if x != nil {
+		return x.TotalPaisa
+	}
+	return 0
+}
+
+func (x *GetRefundQuoteResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type RefundPolicy struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId      string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	RouteId             string                 `protobuf:"bytes,3,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`                // Optional: any route when empty
+	VehicleClass        string                 `protobuf:"bytes,4,opt,name=vehicle_class,json=vehicleClass,proto3" json:"vehicle_class,omitempty"` // Optional: any class when empty
+	Name                string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Tiers               []*RefundTier          `protobuf:"bytes,6,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	RefundBookingFee    bool                   `protobuf:"varint,7,opt,name=refund_booking_fee,json=refundBookingFee,proto3" json:"refund_booking_fee,omitempty"`
+	CreatedAt           int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ChangeFeePaisa      int64                  `protobuf:"varint,10,opt,name=change_fee_paisa,json=changeFeePaisa,proto3" json:"change_fee_paisa,omitempty"`                // Per passenger, on date and seat changes
+	ChangeCutoffHours   int32                  `protobuf:"varint,11,opt,name=change_cutoff_hours,json=changeCutoffHours,proto3" json:"change_cutoff_hours,omitempty"`       // No changes this close to departure
+	TransferFeePaisa    int64                  `protobuf:"varint,12,opt,name=transfer_fee_paisa,json=transferFeePaisa,proto3" json:"transfer_fee_paisa,omitempty"`          // Per passenger, on ticket transfers
+	TransferCutoffHours int32                  `protobuf:"varint,13,opt,name=transfer_cutoff_hours,json=transferCutoffHours,proto3" json:"transfer_cutoff_hours,omitempty"` // No transfers this close to departure
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RefundPolicy) Reset() {
+	*x = RefundPolicy{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPolicy) ProtoMessage() {}
+
+func (x *RefundPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPolicy.ProtoReflect.Descriptor instead.
+func (*RefundPolicy) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RefundPolicy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RefundPolicy) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RefundPolicy) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *RefundPolicy) GetVehicleClass() string {
+	if x != nil {
+		return x.VehicleClass
+	}
+	return ""
+}
+
+func (x *RefundPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RefundPolicy) GetTiers() []*RefundTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *RefundPolicy) GetRefundBookingFee() bool {
+	if x != nil {
+		return x.RefundBookingFee
+	}
+	return false
+}
+
+func (x *RefundPolicy) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RefundPolicy) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *RefundPolicy) GetChangeFeePaisa() int64 {
+	if x != nil {
+		return x.ChangeFeePaisa
+	}
+	return 0
+}
+
+func (x *RefundPolicy) GetChangeCutoffHours() int32 {
+	if x != nil {
+		return x.ChangeCutoffHours
+	}
+	return 0
+}
+
+func (x *RefundPolicy) GetTransferFeePaisa() int64 {
+	if x != nil {
+		return x.TransferFeePaisa
+	}
+	return 0
+}
+
+func (x *RefundPolicy) GetTransferCutoffHours() int32 {
+	if x != nil {
+		return x.TransferCutoffHours
+	}
+	return 0
+}
+
+type RefundTier struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	MinHoursBeforeDeparture int32                  `protobuf:"varint,1,opt,name=min_hours_before_departure,json=minHoursBeforeDeparture,proto3" json:"min_hours_before_departure,omitempty"`
+	RefundPercent           int32                  `protobuf:"varint,2,opt,name=refund_percent,json=refundPercent,proto3" json:"refund_percent,omitempty"` // 0-100 of fare and tax
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RefundTier) Reset() {
+	*x = RefundTier{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTier) ProtoMessage() {}
+
+func (x *RefundTier) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTier.ProtoReflect.Descriptor instead.
+func (*RefundTier) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RefundTier) GetMinHoursBeforeDeparture() int32 {
+	if x != nil {
+		return x.MinHoursBeforeDeparture
+	}
+	return 0
+}
+
+func (x *RefundTier) GetRefundPercent() int32 {
+	if x != nil {
+		return x.RefundPercent
+	}
+	return 0
+}
+
+type ListRefundPoliciesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRefundPoliciesRequest) Reset() {
+	*x = ListRefundPoliciesRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundPoliciesRequest) ProtoMessage() {}
+
+func (x *ListRefundPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListRefundPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListRefundPoliciesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListRefundPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*RefundPolicy        `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundPoliciesResponse) Reset() {
+	*x = ListRefundPoliciesResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundPoliciesResponse) ProtoMessage() {}
+
+func (x *ListRefundPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListRefundPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListRefundPoliciesResponse) GetPolicies() []*RefundPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type DeleteRefundPolicyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PolicyId       string                 `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteRefundPolicyRequest) Reset() {
+	*x = DeleteRefundPolicyRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRefundPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRefundPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteRefundPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRefundPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRefundPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteRefundPolicyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteRefundPolicyRequest) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+type DeleteRefundPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRefundPolicyResponse) Reset() {
+	*x = DeleteRefundPolicyResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRefundPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRefundPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteRefundPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRefundPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRefundPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteRefundPolicyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderStatusRequest) Reset() {
+	*x = GetOrderStatusRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderStatusRequest) ProtoMessage() {}
+
+func (x *GetOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetOrderStatusRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetOrderStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type OrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        OrderStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`
+	Saga          *SagaState             `protobuf:"bytes,2,opt,name=saga,proto3" json:"saga,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusResponse) Reset() {
+	*x = OrderStatusResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusResponse) ProtoMessage() {}
+
+func (x *OrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*OrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *OrderStatusResponse) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderStatusResponse) GetSaga() *SagaState {
+	if x != nil {
+		return x.Saga
+	}
+	return nil
+}
+
+func (x *OrderStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RetryOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryOrderRequest) Reset() {
+	*x = RetryOrderRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryOrderRequest) ProtoMessage() {}
+
+func (x *RetryOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryOrderRequest.ProtoReflect.Descriptor instead.
+func (*RetryOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RetryOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RetryOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RetryOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Order         *Order                 `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryOrderResponse) Reset() {
+	*x = RetryOrderResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryOrderResponse) ProtoMessage() {}
+
+func (x *RetryOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryOrderResponse.ProtoReflect.Descriptor instead.
+func (*RetryOrderResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RetryOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RetryOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type ListSagasRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Statuses       []SagaStatus           `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=order.v1.SagaStatus" json:"statuses,omitempty"`  // Optional: any status when empty
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional
+	MinAgeSeconds  int64                  `protobuf:"varint,3,opt,name=min_age_seconds,json=minAgeSeconds,proto3" json:"min_age_seconds,omitempty"` // Optional: only sagas started at least this long ago
+	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSagasRequest) Reset() {
+	*x = ListSagasRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSagasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSagasRequest) ProtoMessage() {}
+
+func (x *ListSagasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSagasRequest.ProtoReflect.Descriptor instead.
+func (*ListSagasRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListSagasRequest) GetStatuses() []SagaStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListSagasRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListSagasRequest) GetMinAgeSeconds() int64 {
+	if x != nil {
+		return x.MinAgeSeconds
+	}
+	return 0
+}
+
+func (x *ListSagasRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSagasRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSagasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sagas         []*SagaState           `protobuf:"bytes,1,rep,name=sagas,proto3" json:"sagas,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSagasResponse) Reset() {
+	*x = ListSagasResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSagasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSagasResponse) ProtoMessage() {}
+
+func (x *ListSagasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSagasResponse.ProtoReflect.Descriptor instead.
+func (*ListSagasResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListSagasResponse) GetSagas() []*SagaState {
+	if x != nil {
+		return x.Sagas
+	}
+	return nil
+}
+
+func (x *ListSagasResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSagasResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetSagaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SagaId        string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSagaRequest) Reset() {
+	*x = GetSagaRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSagaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSagaRequest) ProtoMessage() {}
+
+func (x *GetSagaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSagaRequest.ProtoReflect.Descriptor instead.
+func (*GetSagaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetSagaRequest) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+type GetSagaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Saga          *SagaState             `protobuf:"bytes,1,opt,name=saga,proto3" json:"saga,omitempty"`
+	Audit         []*SagaAuditEntry      `protobuf:"bytes,2,rep,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSagaResponse) Reset() {
+	*x = GetSagaResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSagaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSagaResponse) ProtoMessage() {}
+
+func (x *GetSagaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSagaResponse.ProtoReflect.Descriptor instead.
+func (*GetSagaResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetSagaResponse) GetSaga() *SagaState {
+	if x != nil {
+		return x.Saga
+	}
+	return nil
+}
+
+func (x *GetSagaResponse) GetAudit() []*SagaAuditEntry {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type SagaAuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // saga_retry, saga_compensate, saga_resolve
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,3,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // reason, from_step, status_from, status_to, error
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SagaAuditEntry) Reset() {
+	*x = SagaAuditEntry{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SagaAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SagaAuditEntry) ProtoMessage() {}
+
+func (x *SagaAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SagaAuditEntry.ProtoReflect.Descriptor instead.
+func (*SagaAuditEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SagaAuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SagaAuditEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *SagaAuditEntry) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *SagaAuditEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type RetrySagaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SagaId        string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	FromStep      string                 `protobuf:"bytes,2,opt,name=from_step,json=fromStep,proto3" json:"from_step,omitempty"` // Optional: first step not in effect when empty
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetrySagaRequest) Reset() {
+	*x = RetrySagaRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrySagaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrySagaRequest) ProtoMessage() {}
+
+func (x *RetrySagaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrySagaRequest.ProtoReflect.Descriptor instead.
+func (*RetrySagaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RetrySagaRequest) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *RetrySagaRequest) GetFromStep() string {
+	if x != nil {
+		return x.FromStep
+	}
+	return ""
+}
+
+func (x *RetrySagaRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *RetrySagaRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CompensateSagaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SagaId        string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompensateSagaRequest) Reset() {
+	*x = CompensateSagaRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompensateSagaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompensateSagaRequest) ProtoMessage() {}
+
+func (x *CompensateSagaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompensateSagaRequest.ProtoReflect.Descriptor instead.
+func (*CompensateSagaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CompensateSagaRequest) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *CompensateSagaRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *CompensateSagaRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ResolveSagaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SagaId        string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // What was fixed by hand
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveSagaRequest) Reset() {
+	*x = ResolveSagaRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveSagaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSagaRequest) ProtoMessage() {}
+
+func (x *ResolveSagaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSagaRequest.ProtoReflect.Descriptor instead.
+func (*ResolveSagaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ResolveSagaRequest) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *ResolveSagaRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ResolveSagaRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SagaActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Saga          *SagaState             `protobuf:"bytes,1,opt,name=saga,proto3" json:"saga,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SagaActionResponse) Reset() {
+	*x = SagaActionResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SagaActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SagaActionResponse) ProtoMessage() {}
+
+func (x *SagaActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SagaActionResponse.ProtoReflect.Descriptor instead.
+func (*SagaActionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SagaActionResponse) GetSaga() *SagaState {
+	if x != nil {
+		return x.Saga
+	}
+	return nil
+}
+
+type FraudReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RiskScore     int32                  `protobuf:"varint,1,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"` // 0-100
+	RiskLevel     string                 `protobuf:"bytes,2,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	Summary       string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	RiskFactors   []string               `protobuf:"bytes,4,rep,name=risk_factors,json=riskFactors,proto3" json:"risk_factors,omitempty"`
+	Decision      string                 `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision,omitempty"` // approved, rejected; empty while pending
+	ReviewerId    string                 `protobuf:"bytes,6,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	FlaggedAt     int64                  `protobuf:"varint,8,opt,name=flagged_at,json=flaggedAt,proto3" json:"flagged_at,omitempty"`
+	DecidedAt     int64                  `protobuf:"varint,9,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FraudReview) Reset() {
+	*x = FraudReview{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FraudReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudReview) ProtoMessage() {}
+
+func (x *FraudReview) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FraudReview.ProtoReflect.Descriptor instead.
+func (*FraudReview) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FraudReview) GetRiskScore() int32 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+func (x *FraudReview) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *FraudReview) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *FraudReview) GetRiskFactors() []string {
+	if x != nil {
+		return x.RiskFactors
+	}
+	return nil
+}
+
+func (x *FraudReview) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *FraudReview) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *FraudReview) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *FraudReview) GetFlaggedAt() int64 {
+	if x != nil {
+		return x.FlaggedAt
+	}
+	return 0
+}
+
+func (x *FraudReview) GetDecidedAt() int64 {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return 0
+}
+
+type ListFraudReviewsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListFraudReviewsRequest) Reset() {
+	*x = ListFraudReviewsRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudReviewsRequest) ProtoMessage() {}
+
+func (x *ListFraudReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListFraudReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListFraudReviewsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListFraudReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFraudReviewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ReviewOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewOrderRequest) Reset() {
+	*x = ReviewOrderRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewOrderRequest) ProtoMessage() {}
+
+func (x *ReviewOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReviewOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReviewOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReviewOrderRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewOrderRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewOrderRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AgentShift struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId    string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AgentId           string                 `protobuf:"bytes,3,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // open, closed
+	OpeningFloatPaisa int64                  `protobuf:"varint,5,opt,name=opening_float_paisa,json=openingFloatPaisa,proto3" json:"opening_float_paisa,omitempty"`
+	CashSalesPaisa    int64                  `protobuf:"varint,6,opt,name=cash_sales_paisa,json=cashSalesPaisa,proto3" json:"cash_sales_paisa,omitempty"`       // Running total of confirmed cash orders
+	CashRefundsPaisa  int64                  `protobuf:"varint,7,opt,name=cash_refunds_paisa,json=cashRefundsPaisa,proto3" json:"cash_refunds_paisa,omitempty"` // Running total of cash paid back
+	Currency          string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	OpenedAt          int64                  `protobuf:"varint,9,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	CountedCashPaisa  int64                  `protobuf:"varint,10,opt,name=counted_cash_paisa,json=countedCashPaisa,proto3" json:"counted_cash_paisa,omitempty"` // Set when closed
+	ExpectedCashPaisa int64                  `protobuf:"varint,11,opt,name=expected_cash_paisa,json=expectedCashPaisa,proto3" json:"expected_cash_paisa,omitempty"`
+	VariancePaisa     int64                  `protobuf:"varint,12,opt,name=variance_paisa,json=variancePaisa,proto3" json:"variance_paisa,omitempty"` // Counted less expected; negative is a shortfall
+	Note              string                 `protobuf:"bytes,13,opt,name=note,proto3" json:"note,omitempty"`
+	ClosedAt          int64                  `protobuf:"varint,14,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AgentShift) Reset() {
+	*x = AgentShift{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentShift) ProtoMessage() {}
+
+func (x *AgentShift) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentShift.ProtoReflect.Descriptor instead.
+func (*AgentShift) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AgentShift) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AgentShift) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AgentShift) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *AgentShift) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AgentShift) GetOpeningFloatPaisa() int64 {
+	if x != nil {
+		return x.OpeningFloatPaisa
+	}
+	return 0
+}
+
+func (x *AgentShift) GetCashSalesPaisa() int64 {
+	if x != nil {
+		return x.CashSalesPaisa
+	}
+	return 0
+}
+
+func (x *AgentShift) GetCashRefundsPaisa() int64 {
+	if x != nil {
+		return x.CashRefundsPaisa
+	}
+	return 0
+}
+
+func (x *AgentShift) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AgentShift) GetOpenedAt() int64 {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return 0
+}
+
+func (x *AgentShift) GetCountedCashPaisa() int64 {
+	if x != nil {
+		return x.CountedCashPaisa
+	}
+	return 0
+}
+
+func (x *AgentShift) GetExpectedCashPaisa() int64 {
+	if x != nil {
+		return x.ExpectedCashPaisa
+	}
+	return 0
+}
+
+func (x *AgentShift) GetVariancePaisa() int64 {
+	if x != nil {
+		return x.VariancePaisa
+	}
+	return 0
+}
+
+func (x *AgentShift) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AgentShift) GetClosedAt() int64 {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return 0
+}
+
+type ShiftReport struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Shift                *AgentShift            `protobuf:"bytes,1,opt,name=shift,proto3" json:"shift,omitempty"`
+	ConfirmedOrders      int32                  `protobuf:"varint,2,opt,name=confirmed_orders,json=confirmedOrders,proto3" json:"confirmed_orders,omitempty"`
+	ConfirmedCashPaisa   int64                  `protobuf:"varint,3,opt,name=confirmed_cash_paisa,json=confirmedCashPaisa,proto3" json:"confirmed_cash_paisa,omitempty"`       // Totals of the cash orders that were confirmed
+	PendingOrders        int32                  `protobuf:"varint,4,opt,name=pending_orders,json=pendingOrders,proto3" json:"pending_orders,omitempty"`                        // Still booking or awaiting fraud review
+	UnrecordedSalesPaisa int64                  `protobuf:"varint,5,opt,name=unrecorded_sales_paisa,json=unrecordedSalesPaisa,proto3" json:"unrecorded_sales_paisa,omitempty"` // Confirmed cash orders missing from the running total
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ShiftReport) Reset() {
+	*x = ShiftReport{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShiftReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftReport) ProtoMessage() {}
+
+func (x *ShiftReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftReport.ProtoReflect.Descriptor instead.
+func (*ShiftReport) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ShiftReport) GetShift() *AgentShift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+func (x *ShiftReport) GetConfirmedOrders() int32 {
+	if x != nil {
+		return x.ConfirmedOrders
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetConfirmedCashPaisa() int64 {
+	if x != nil {
+		return x.ConfirmedCashPaisa
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetPendingOrders() int32 {
+	if x != nil {
+		return x.PendingOrders
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetUnrecordedSalesPaisa() int64 {
+	if x != nil {
+		return x.UnrecordedSalesPaisa
+	}
+	return 0
+}
+
+type OpenShiftRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId    string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AgentId           string                 `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	OpeningFloatPaisa int64                  `protobuf:"varint,3,opt,name=opening_float_paisa,json=openingFloatPaisa,proto3" json:"opening_float_paisa,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OpenShiftRequest) Reset() {
+	*x = OpenShiftRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenShiftRequest) ProtoMessage() {}
+
+func (x *OpenShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenShiftRequest.ProtoReflect.Descriptor instead.
+func (*OpenShiftRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *OpenShiftRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OpenShiftRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *OpenShiftRequest) GetOpeningFloatPaisa() int64 {
+	if x != nil {
+		return x.OpeningFloatPaisa
+	}
+	return 0
+}
+
+type GetCurrentShiftRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AgentId        string                 `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCurrentShiftRequest) Reset() {
+	*x = GetCurrentShiftRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentShiftRequest) ProtoMessage() {}
+
+func (x *GetCurrentShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentShiftRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentShiftRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetCurrentShiftRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetCurrentShiftRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+type GetShiftReportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ShiftId        string                 `protobuf:"bytes,2,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetShiftReportRequest) Reset() {
+	*x = GetShiftReportRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShiftReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShiftReportRequest) ProtoMessage() {}
+
+func (x *GetShiftReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShiftReportRequest.ProtoReflect.Descriptor instead.
+func (*GetShiftReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetShiftReportRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetShiftReportRequest) GetShiftId() string {
+	if x != nil {
+		return x.ShiftId
+	}
+	return ""
+}
+
+type CloseShiftRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AgentId          string                 `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	CountedCashPaisa int64                  `protobuf:"varint,3,opt,name=counted_cash_paisa,json=countedCashPaisa,proto3" json:"counted_cash_paisa,omitempty"`
+	Note             string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CloseShiftRequest) Reset() {
+	*x = CloseShiftRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseShiftRequest) ProtoMessage() {}
+
+func (x *CloseShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseShiftRequest.ProtoReflect.Descriptor instead.
+func (*CloseShiftRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CloseShiftRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CloseShiftRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *CloseShiftRequest) GetCountedCashPaisa() int64 {
+	if x != nil {
+		return x.CountedCashPaisa
+	}
+	return 0
+}
+
+func (x *CloseShiftRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CorporateAccount struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Empty to create
+	OrganizationId   string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Kind             string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`     // corporate, group
+	Status           string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // active, suspended
+	BillingEmail     string                 `protobuf:"bytes,6,opt,name=billing_email,json=billingEmail,proto3" json:"billing_email,omitempty"`
+	Currency         string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreditLimitPaisa int64                  `protobuf:"varint,8,opt,name=credit_limit_paisa,json=creditLimitPaisa,proto3" json:"credit_limit_paisa,omitempty"`
+	OutstandingPaisa int64                  `protobuf:"varint,9,opt,name=outstanding_paisa,json=outstandingPaisa,proto3" json:"outstanding_paisa,omitempty"` // Charged and not yet paid
+	PaymentTermsDays int32                  `protobuf:"varint,10,opt,name=payment_terms_days,json=paymentTermsDays,proto3" json:"payment_terms_days,omitempty"`
+	BookerIds        []string               `protobuf:"bytes,11,rep,name=booker_ids,json=bookerIds,proto3" json:"booker_ids,omitempty"`             // Users who may book on the account
+	Travellers       []*ApprovedTraveller   `protobuf:"bytes,12,rep,name=travellers,proto3" json:"travellers,omitempty"`                            // Empty approves any passenger
+	MaxGroupSize     int32                  `protobuf:"varint,13,opt,name=max_group_size,json=maxGroupSize,proto3" json:"max_group_size,omitempty"` // Tickets per trip in place of the anti-scalp limits; 0 keeps them
+	CreatedAt        int64                  `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        int64                  `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CorporateAccount) Reset() {
+	*x = CorporateAccount{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorporateAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorporateAccount) ProtoMessage() {}
+
+func (x *CorporateAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorporateAccount.ProtoReflect.Descriptor instead.
+func (*CorporateAccount) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CorporateAccount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CorporateAccount) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CorporateAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CorporateAccount) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CorporateAccount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CorporateAccount) GetBillingEmail() string {
+	if x != nil {
+		return x.BillingEmail
+	}
+	return ""
+}
+
+func (x *CorporateAccount) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CorporateAccount) GetCreditLimitPaisa() int64 {
+	if x != nil {
+		return x.CreditLimitPaisa
+	}
+	return 0
+}
+
+func (x *CorporateAccount) GetOutstandingPaisa() int64 {
+	if x != nil {
+		return x.OutstandingPaisa
+	}
+	return 0
+}
+
+func (x *CorporateAccount) GetPaymentTermsDays() int32 {
+	if x != nil {
+		return x.PaymentTermsDays
+	}
+	return 0
+}
+
+func (x *CorporateAccount) GetBookerIds() []string {
+	if x != nil {
+		return x.BookerIds
+	}
+	return nil
+}
+
+func (x *CorporateAccount) GetTravellers() []*ApprovedTraveller {
+	if x != nil {
+		return x.Travellers
+	}
+	return nil
+}
+
+func (x *CorporateAccount) GetMaxGroupSize() int32 {
+	if x != nil {
+		return x.MaxGroupSize
+	}
+	return 0
+}
+
+func (x *CorporateAccount) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CorporateAccount) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ApprovedTraveller struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nid           string                 `protobuf:"bytes,1,opt,name=nid,proto3" json:"nid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovedTraveller) Reset() {
+	*x = ApprovedTraveller{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovedTraveller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovedTraveller) ProtoMessage() {}
+
+func (x *ApprovedTraveller) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovedTraveller.ProtoReflect.Descriptor instead.
+func (*ApprovedTraveller) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ApprovedTraveller) GetNid() string {
+	if x != nil {
+		return x.Nid
+	}
+	return ""
+}
+
+func (x *ApprovedTraveller) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AccountInvoice struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PeriodStart    int64                  `protobuf:"varint,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd      int64                  `protobuf:"varint,5,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"` // Exclusive
+	TotalPaisa     int64                  `protobuf:"varint,6,opt,name=total_paisa,json=totalPaisa,proto3" json:"total_paisa,omitempty"`
+	Currency       string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status         string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // issued, paid
+	IssuedAt       int64                  `protobuf:"varint,9,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	DueAt          int64                  `protobuf:"varint,10,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	PaidAt         int64                  `protobuf:"varint,11,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	Lines          []*AccountCharge       `protobuf:"bytes,12,rep,name=lines,proto3" json:"lines,omitempty"` // GetAccountInvoice only
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AccountInvoice) Reset() {
+	*x = AccountInvoice{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountInvoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountInvoice) ProtoMessage() {}
+
+func (x *AccountInvoice) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountInvoice.ProtoReflect.Descriptor instead.
+func (*AccountInvoice) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AccountInvoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccountInvoice) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountInvoice) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AccountInvoice) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *AccountInvoice) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *AccountInvoice) GetTotalPaisa() int64 {
+	if x != nil {
+		return x.TotalPaisa
+	}
+	return 0
+}
+
+func (x *AccountInvoice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AccountInvoice) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccountInvoice) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *AccountInvoice) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+func (x *AccountInvoice) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *AccountInvoice) GetLines() []*AccountCharge {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type AccountCharge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	AmountPaisa   int64                  `protobuf:"varint,3,opt,name=amount_paisa,json=amountPaisa,proto3" json:"amount_paisa,omitempty"` // Negative for refunds
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountCharge) Reset() {
+	*x = AccountCharge{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountCharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountCharge) ProtoMessage() {}
+
+func (x *AccountCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountCharge.ProtoReflect.Descriptor instead.
+func (*AccountCharge) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AccountCharge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccountCharge) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AccountCharge) GetAmountPaisa() int64 {
+	if x != nil {
+		return x.AmountPaisa
+	}
+	return 0
+}
+
+func (x *AccountCharge) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AccountCharge) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type SaveCorporateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *CorporateAccount      `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCorporateAccountRequest) Reset() {
+	*x = SaveCorporateAccountRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCorporateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCorporateAccountRequest) ProtoMessage() {}
+
+func (x *SaveCorporateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCorporateAccountRequest.ProtoReflect.Descriptor instead.
+func (*SaveCorporateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SaveCorporateAccountRequest) GetAccount() *CorporateAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *SaveCorporateAccountRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type GetCorporateAccountRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCorporateAccountRequest) Reset() {
+	*x = GetCorporateAccountRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCorporateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCorporateAccountRequest) ProtoMessage() {}
+
+func (x *GetCorporateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCorporateAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetCorporateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetCorporateAccountRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Order
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *CancelOrderResponse) GetRefund() *RefundInfo {
+func (x *GetCorporateAccountRequest) GetAccountId() string {
 	if x != nil {
-		return x.Refund
+		return x.AccountId
 	}
-	return nil
+	return ""
 }
 
-type RefundInfo struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	RefundId            string                 `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
-	AmountPaisa         int64                  `protobuf:"varint,2,opt,name=amount_paisa,json=amountPaisa,proto3" json:"amount_paisa,omitempty"`
-	Status              string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	EstimatedCompletion int64                  `protobuf:"varint,4,opt,name=estimated_completion,json=estimatedCompletion,proto3" json:"estimated_completion,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type ListCorporateAccountsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RefundInfo) Reset() {
-	*x = RefundInfo{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[14]
+func (x *ListCorporateAccountsRequest) Reset() {
+	*x = ListCorporateAccountsRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefundInfo) String() string {
+func (x *ListCorporateAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefundInfo) ProtoMessage() {}
+func (*ListCorporateAccountsRequest) ProtoMessage() {}
 
-func (x *RefundInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[14]
+func (x *ListCorporateAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,62 +6145,85 @@ func (x *RefundInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefundInfo.ProtoReflect.Descriptor instead.
-func (*RefundInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListCorporateAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListCorporateAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *RefundInfo) GetRefundId() string {
+func (x *ListCorporateAccountsRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.RefundId
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *RefundInfo) GetAmountPaisa() int64 {
-	if x != nil {
-		return x.AmountPaisa
-	}
-	return 0
+type ListCorporateAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*CorporateAccount    `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefundInfo) GetStatus() string {
+func (x *ListCorporateAccountsResponse) Reset() {
+	*x = ListCorporateAccountsResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCorporateAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCorporateAccountsResponse) ProtoMessage() {}
+
+func (x *ListCorporateAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[72]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RefundInfo) GetEstimatedCompletion() int64 {
+// Deprecated: Use ListCorporateAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListCorporateAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListCorporateAccountsResponse) GetAccounts() []*CorporateAccount {
 	if x != nil {
-		return x.EstimatedCompletion
+		return x.Accounts
 	}
-	return 0
+	return nil
 }
 
-type GetOrderStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListAccountInvoicesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetOrderStatusRequest) Reset() {
-	*x = GetOrderStatusRequest{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[15]
+func (x *ListAccountInvoicesRequest) Reset() {
+	*x = ListAccountInvoicesRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderStatusRequest) String() string {
+func (x *ListAccountInvoicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderStatusRequest) ProtoMessage() {}
+func (*ListAccountInvoicesRequest) ProtoMessage() {}
 
-func (x *GetOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[15]
+func (x *ListAccountInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1599,49 +6234,47 @@ func (x *GetOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListAccountInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetOrderStatusRequest) GetOrderId() string {
+func (x *ListAccountInvoicesRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.OrderId
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GetOrderStatusRequest) GetUserId() string {
+func (x *ListAccountInvoicesRequest) GetAccountId() string {
 	if x != nil {
-		return x.UserId
+		return x.AccountId
 	}
 	return ""
 }
 
-type OrderStatusResponse struct {
+type ListAccountInvoicesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        OrderStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`
-	Saga          *SagaState             `protobuf:"bytes,2,opt,name=saga,proto3" json:"saga,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Invoices      []*AccountInvoice      `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderStatusResponse) Reset() {
-	*x = OrderStatusResponse{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[16]
+func (x *ListAccountInvoicesResponse) Reset() {
+	*x = ListAccountInvoicesResponse{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderStatusResponse) String() string {
+func (x *ListAccountInvoicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderStatusResponse) ProtoMessage() {}
+func (*ListAccountInvoicesResponse) ProtoMessage() {}
 
-func (x *OrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[16]
+func (x *ListAccountInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1652,55 +6285,42 @@ func (x *OrderStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderStatusResponse.ProtoReflect.Descriptor instead.
-func (*OrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *OrderStatusResponse) GetStatus() OrderStatus {
-	if x != nil {
-		return x.Status
-	}
-	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+// Deprecated: Use ListAccountInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *OrderStatusResponse) GetSaga() *SagaState {
+func (x *ListAccountInvoicesResponse) GetInvoices() []*AccountInvoice {
 	if x != nil {
-		return x.Saga
+		return x.Invoices
 	}
 	return nil
 }
 
-func (x *OrderStatusResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type RetryOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetAccountInvoiceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	InvoiceId      string                 `protobuf:"bytes,3,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RetryOrderRequest) Reset() {
-	*x = RetryOrderRequest{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[17]
+func (x *GetAccountInvoiceRequest) Reset() {
+	*x = GetAccountInvoiceRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RetryOrderRequest) String() string {
+func (x *GetAccountInvoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetryOrderRequest) ProtoMessage() {}
+func (*GetAccountInvoiceRequest) ProtoMessage() {}
 
-func (x *RetryOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[17]
+func (x *GetAccountInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1711,48 +6331,57 @@ func (x *RetryOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetryOrderRequest.ProtoReflect.Descriptor instead.
-func (*RetryOrderRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetAccountInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *RetryOrderRequest) GetOrderId() string {
+func (x *GetAccountInvoiceRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.OrderId
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *RetryOrderRequest) GetUserId() string {
+func (x *GetAccountInvoiceRequest) GetAccountId() string {
 	if x != nil {
-		return x.UserId
+		return x.AccountId
 	}
 	return ""
 }
 
-type RetryOrderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Order         *Order                 `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetAccountInvoiceRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
 }
 
-func (x *RetryOrderResponse) Reset() {
-	*x = RetryOrderResponse{}
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[18]
+type MarkInvoicePaidRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	InvoiceId      string                 `protobuf:"bytes,3,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	ActorId        string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkInvoicePaidRequest) Reset() {
+	*x = MarkInvoicePaidRequest{}
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RetryOrderResponse) String() string {
+func (x *MarkInvoicePaidRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetryOrderResponse) ProtoMessage() {}
+func (*MarkInvoicePaidRequest) ProtoMessage() {}
 
-func (x *RetryOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_order_v1_order_proto_msgTypes[18]
+func (x *MarkInvoicePaidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1763,30 +6392,45 @@ func (x *RetryOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetryOrderResponse.ProtoReflect.Descriptor instead.
-func (*RetryOrderResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use MarkInvoicePaidRequest.ProtoReflect.Descriptor instead.
+func (*MarkInvoicePaidRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *RetryOrderResponse) GetSuccess() bool {
+func (x *MarkInvoicePaidRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Success
+		return x.OrganizationId
 	}
-	return false
+	return ""
 }
 
-func (x *RetryOrderResponse) GetOrder() *Order {
+func (x *MarkInvoicePaidRequest) GetAccountId() string {
 	if x != nil {
-		return x.Order
+		return x.AccountId
 	}
-	return nil
+	return ""
+}
+
+func (x *MarkInvoicePaidRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *MarkInvoicePaidRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
 }
 
 var File_api_proto_order_v1_order_proto protoreflect.FileDescriptor
 
 const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/proto/order/v1/order.proto\x12\border.v1\"\xfb\x06\n" +
+	"\x1eapi/proto/order/v1/order.proto\x12\border.v1\"\x95\n" +
+	"\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x17\n" +
@@ -1821,7 +6465,35 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"\n" +
 	"expires_at\x18\x16 \x01(\x03R\texpiresAt\x12#\n" +
 	"\rcontact_email\x18\x17 \x01(\tR\fcontactEmail\x12#\n" +
-	"\rcontact_phone\x18\x18 \x01(\tR\fcontactPhone\"\xd7\x01\n" +
+	"\rcontact_phone\x18\x18 \x01(\tR\fcontactPhone\x12D\n" +
+	"\x10refund_breakdown\x18\x19 \x01(\v2\x19.order.v1.RefundBreakdownR\x0frefundBreakdown\x12X\n" +
+	"\x17passenger_cancellations\x18\x1a \x03(\v2\x1f.order.v1.PassengerCancellationR\x16passengerCancellations\x12&\n" +
+	"\x04legs\x18\x1b \x03(\v2\x12.order.v1.OrderLegR\x04legs\x12/\n" +
+	"\achanges\x18\x1c \x03(\v2\x15.order.v1.OrderChangeR\achanges\x128\n" +
+	"\ffraud_review\x18\x1d \x01(\v2\x15.order.v1.FraudReviewR\vfraudReview\x12\x19\n" +
+	"\bagent_id\x18\x1e \x01(\tR\aagentId\x12\x19\n" +
+	"\bshift_id\x18\x1f \x01(\tR\ashiftId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18  \x01(\tR\taccountId\x12\x10\n" +
+	"\x03pnr\x18! \x01(\tR\x03pnr\"\xc0\x03\n" +
+	"\bOrderLeg\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12&\n" +
+	"\x0ffrom_station_id\x18\x02 \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\x03 \x01(\tR\vtoStationId\x12\x17\n" +
+	"\ahold_id\x18\x04 \x01(\tR\x06holdId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x05 \x01(\tR\tbookingId\x123\n" +
+	"\n" +
+	"passengers\x18\x06 \x03(\v2\x13.order.v1.PassengerR\n" +
+	"passengers\x12*\n" +
+	"\x05seats\x18\a \x03(\v2\x14.order.v1.BookedSeatR\x05seats\x12%\n" +
+	"\x0esubtotal_paisa\x18\b \x01(\x03R\rsubtotalPaisa\x12\x1b\n" +
+	"\ttax_paisa\x18\t \x01(\x03R\btaxPaisa\x12*\n" +
+	"\x11booking_fee_paisa\x18\n" +
+	" \x01(\x03R\x0fbookingFeePaisa\x12%\n" +
+	"\x0ediscount_paisa\x18\v \x01(\x03R\rdiscountPaisa\x12\x1f\n" +
+	"\vtotal_paisa\x18\f \x01(\x03R\n" +
+	"totalPaisa\"\x9c\x02\n" +
 	"\tPassenger\x12\x10\n" +
 	"\x03nid\x18\x01 \x01(\tR\x03nid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
@@ -1832,7 +6504,10 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"seat_class\x18\x05 \x01(\tR\tseatClass\x12\x16\n" +
 	"\x06gender\x18\x06 \x01(\tR\x06gender\x12\x10\n" +
 	"\x03age\x18\a \x01(\x05R\x03age\x12!\n" +
-	"\fnid_verified\x18\b \x01(\bR\vnidVerified\"\xa3\x01\n" +
+	"\fnid_verified\x18\b \x01(\bR\vnidVerified\x12%\n" +
+	"\x0ecapacity_class\x18\t \x01(\tR\rcapacityClass\x12\x1c\n" +
+	"\tcancelled\x18\n" +
+	" \x01(\bR\tcancelled\"\xbf\x02\n" +
 	"\n" +
 	"BookedSeat\x12\x17\n" +
 	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x1f\n" +
@@ -1842,7 +6517,11 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"seat_class\x18\x03 \x01(\tR\tseatClass\x12\x1b\n" +
 	"\tticket_id\x18\x04 \x01(\tR\bticketId\x12\x1f\n" +
 	"\vprice_paisa\x18\x05 \x01(\x03R\n" +
-	"pricePaisa\"\x88\x02\n" +
+	"pricePaisa\x12'\n" +
+	"\x0fpassenger_index\x18\x06 \x01(\x05R\x0epassengerIndex\x12&\n" +
+	"\x0ffrom_station_id\x18\a \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\b \x01(\tR\vtoStationId\x12%\n" +
+	"\x0ecapacity_class\x18\t \x01(\tR\rcapacityClass\"\xcd\x04\n" +
 	"\tSagaState\x12\x17\n" +
 	"\asaga_id\x18\x01 \x01(\tR\x06sagaId\x12,\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x14.order.v1.SagaStatusR\x06status\x12!\n" +
@@ -1851,7 +6530,22 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"\x0efailure_reason\x18\x05 \x01(\tR\rfailureReason\x12\x1d\n" +
 	"\n" +
 	"started_at\x18\x06 \x01(\x03R\tstartedAt\x12!\n" +
-	"\fcompleted_at\x18\a \x01(\x03R\vcompletedAt\"\xc6\x01\n" +
+	"\fcompleted_at\x18\a \x01(\x03R\vcompletedAt\x12\x12\n" +
+	"\x04name\x18\b \x01(\tR\x04name\x12'\n" +
+	"\x0forganization_id\x18\t \x01(\tR\x0eorganizationId\x12,\n" +
+	"\x12current_step_index\x18\n" +
+	" \x01(\x05R\x10currentStepIndex\x12C\n" +
+	"\n" +
+	"references\x18\v \x03(\v2#.order.v1.SagaState.ReferencesEntryR\n" +
+	"references\x12\x14\n" +
+	"\x05owner\x18\f \x01(\tR\x05owner\x12\x1f\n" +
+	"\vlease_until\x18\r \x01(\x03R\n" +
+	"leaseUntil\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\x03R\tupdatedAt\x1a=\n" +
+	"\x0fReferencesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc6\x01\n" +
 	"\bSagaStep\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x14.order.v1.StepStatusR\x06status\x12\x14\n" +
@@ -1859,7 +6553,7 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"\n" +
 	"started_at\x18\x04 \x01(\x03R\tstartedAt\x12!\n" +
 	"\fcompleted_at\x18\x05 \x01(\x03R\vcompletedAt\x12 \n" +
-	"\vcompensated\x18\x06 \x01(\bR\vcompensated\"\xe4\x03\n" +
+	"\vcompensated\x18\x06 \x01(\bR\vcompensated\"\xd0\x05\n" +
 	"\x12CreateOrderRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
@@ -1876,27 +6570,62 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	" \x01(\tR\fcontactPhone\x12\x1f\n" +
 	"\vcoupon_code\x18\v \x01(\tR\n" +
 	"couponCode\x12'\n" +
-	"\x0fidempotency_key\x18\f \x01(\tR\x0eidempotencyKey\"\x9f\x01\n" +
+	"\x0fidempotency_key\x18\f \x01(\tR\x0eidempotencyKey\x12(\n" +
+	"\x04legs\x18\r \x03(\v2\x14.order.v1.LegRequestR\x04legs\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x0e \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x0f \x01(\tR\tuserAgent\x12-\n" +
+	"\x12device_fingerprint\x18\x10 \x01(\tR\x11deviceFingerprint\x12\x19\n" +
+	"\bagent_id\x18\x11 \x01(\tR\aagentId\x128\n" +
+	"\fquota_claims\x18\x12 \x01(\v2\x15.order.v1.QuotaClaimsR\vquotaClaims\"\xc6\x01\n" +
+	"\n" +
+	"LegRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12&\n" +
+	"\x0ffrom_station_id\x18\x02 \x01(\tR\rfromStationId\x12\"\n" +
+	"\rto_station_id\x18\x03 \x01(\tR\vtoStationId\x12\x17\n" +
+	"\ahold_id\x18\x04 \x01(\tR\x06holdId\x12:\n" +
+	"\n" +
+	"passengers\x18\x05 \x03(\v2\x1a.order.v1.PassengerRequestR\n" +
+	"passengers\"\xc6\x01\n" +
 	"\x10PassengerRequest\x12\x10\n" +
 	"\x03nid\x18\x01 \x01(\tR\x03nid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
 	"\aseat_id\x18\x03 \x01(\tR\x06seatId\x12\x16\n" +
 	"\x06gender\x18\x04 \x01(\tR\x06gender\x12\x10\n" +
 	"\x03age\x18\x05 \x01(\x05R\x03age\x12\"\n" +
-	"\rdate_of_birth\x18\x06 \x01(\tR\vdateOfBirth\"~\n" +
+	"\rdate_of_birth\x18\x06 \x01(\tR\vdateOfBirth\x12%\n" +
+	"\x0ecapacity_class\x18\a \x01(\tR\rcapacityClass\"\x8c\x01\n" +
+	"\vQuotaClaims\x12\x16\n" +
+	"\x06female\x18\x01 \x01(\bR\x06female\x12\x1a\n" +
+	"\bdisabled\x18\x02 \x01(\bR\bdisabled\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x1d\n" +
+	"\n" +
+	"partner_id\x18\x04 \x01(\tR\tpartnerId\x12\x10\n" +
+	"\x03vip\x18\x05 \x01(\bR\x03vip\"\x9d\x01\n" +
 	"\rPaymentMethod\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12$\n" +
 	"\x0ecard_last_four\x18\x03 \x01(\tR\fcardLastFour\x12\x1d\n" +
 	"\n" +
-	"card_brand\x18\x04 \x01(\tR\tcardBrand\"\x97\x01\n" +
+	"card_brand\x18\x04 \x01(\tR\tcardBrand\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\tR\taccountId\"\x97\x01\n" +
 	"\x13CreateOrderResponse\x12%\n" +
 	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\x120\n" +
 	"\x14payment_redirect_url\x18\x02 \x01(\tR\x12paymentRedirectUrl\x12'\n" +
 	"\x0frequires_action\x18\x03 \x01(\bR\x0erequiresAction\"E\n" +
 	"\x0fGetOrderRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xc0\x01\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"B\n" +
+	"\x14GetOrderByPNRRequest\x12\x10\n" +
+	"\x03pnr\x18\x01 \x01(\tR\x03pnr\x12\x18\n" +
+	"\acontact\x18\x02 \x01(\tR\acontact\"\x91\x01\n" +
+	"\x17CancelOrderByPNRRequest\x12\x10\n" +
+	"\x03pnr\x18\x01 \x01(\tR\x03pnr\x12\x18\n" +
+	"\acontact\x18\x02 \x01(\tR\acontact\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x122\n" +
+	"\x15expected_refund_paisa\x18\x04 \x01(\x03R\x13expectedRefundPaisa\"\xc0\x01\n" +
 	"\x11ListOrdersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12-\n" +
@@ -1908,21 +6637,195 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"\x06orders\x18\x01 \x03(\v2\x0f.order.v1.OrderR\x06orders\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
 	"\vtotal_count\x18\x03 \x01(\x05R\n" +
-	"totalCount\"`\n" +
+	"totalCount\"\x94\x01\n" +
 	"\x12CancelOrderRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x84\x01\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x122\n" +
+	"\x15expected_refund_paisa\x18\x04 \x01(\x03R\x13expectedRefundPaisa\"\x84\x01\n" +
 	"\x13CancelOrderResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
 	"\x05order\x18\x02 \x01(\v2\x0f.order.v1.OrderR\x05order\x12,\n" +
-	"\x06refund\x18\x03 \x01(\v2\x14.order.v1.RefundInfoR\x06refund\"\x97\x01\n" +
+	"\x06refund\x18\x03 \x01(\v2\x14.order.v1.RefundInfoR\x06refund\"\xe1\x01\n" +
+	"\x17CancelPassengersRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12+\n" +
+	"\x11passenger_indexes\x18\x03 \x03(\x05R\x10passengerIndexes\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x122\n" +
+	"\x15expected_refund_paisa\x18\x06 \x01(\x03R\x13expectedRefundPaisa\"\x89\x01\n" +
+	"\x18CancelPassengersResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x05order\x18\x02 \x01(\v2\x0f.order.v1.OrderR\x05order\x12,\n" +
+	"\x06refund\x18\x03 \x01(\v2\x14.order.v1.RefundInfoR\x06refund\"\xfb\x01\n" +
+	"\x15PassengerCancellation\x12+\n" +
+	"\x11passenger_indexes\x18\x01 \x03(\x05R\x10passengerIndexes\x12*\n" +
+	"\x05seats\x18\x02 \x03(\v2\x14.order.v1.BookedSeatR\x05seats\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1b\n" +
+	"\trefund_id\x18\x04 \x01(\tR\brefundId\x121\n" +
+	"\x06refund\x18\x05 \x01(\v2\x19.order.v1.RefundBreakdownR\x06refund\x12!\n" +
+	"\fcancelled_at\x18\x06 \x01(\x03R\vcancelledAt\"\xd0\x01\n" +
 	"\n" +
 	"RefundInfo\x12\x1b\n" +
 	"\trefund_id\x18\x01 \x01(\tR\brefundId\x12!\n" +
 	"\famount_paisa\x18\x02 \x01(\x03R\vamountPaisa\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x121\n" +
-	"\x14estimated_completion\x18\x04 \x01(\x03R\x13estimatedCompletion\"K\n" +
+	"\x14estimated_completion\x18\x04 \x01(\x03R\x13estimatedCompletion\x127\n" +
+	"\tbreakdown\x18\x05 \x01(\v2\x19.order.v1.RefundBreakdownR\tbreakdown\"\xe0\x04\n" +
+	"\x0fRefundBreakdown\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\tR\bpolicyId\x12\x1f\n" +
+	"\vpolicy_name\x18\x02 \x01(\tR\n" +
+	"policyName\x12%\n" +
+	"\x0edeparture_time\x18\x03 \x01(\x03R\rdepartureTime\x124\n" +
+	"\x16hours_before_departure\x18\x04 \x01(\x01R\x14hoursBeforeDeparture\x12%\n" +
+	"\x0erefund_percent\x18\x05 \x01(\x05R\rrefundPercent\x12\x1d\n" +
+	"\n" +
+	"fare_paisa\x18\x06 \x01(\x03R\tfarePaisa\x12*\n" +
+	"\x11fare_refund_paisa\x18\a \x01(\x03R\x0ffareRefundPaisa\x12\x1b\n" +
+	"\ttax_paisa\x18\b \x01(\x03R\btaxPaisa\x12(\n" +
+	"\x10tax_refund_paisa\x18\t \x01(\x03R\x0etaxRefundPaisa\x12*\n" +
+	"\x11booking_fee_paisa\x18\n" +
+	" \x01(\x03R\x0fbookingFeePaisa\x127\n" +
+	"\x18booking_fee_refund_paisa\x18\v \x01(\x03R\x15bookingFeeRefundPaisa\x12!\n" +
+	"\frefund_paisa\x18\f \x01(\x03R\vrefundPaisa\x12%\n" +
+	"\x0eretained_paisa\x18\r \x01(\x03R\rretainedPaisa\x12\x1b\n" +
+	"\tquoted_at\x18\x0e \x01(\x03R\bquotedAt\x12-\n" +
+	"\x04legs\x18\x0f \x03(\v2\x19.order.v1.RefundBreakdownR\x04legs\"\xa9\x02\n" +
+	"\x12ChangeOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\atrip_id\x18\x03 \x01(\tR\x06tripId\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x12\x17\n" +
+	"\ahold_id\x18\x05 \x01(\tR\x06holdId\x12#\n" +
+	"\rpayment_token\x18\x06 \x01(\tR\fpaymentToken\x129\n" +
+	"\x19expected_amount_due_paisa\x18\a \x01(\x03R\x16expectedAmountDuePaisa\x122\n" +
+	"\x15check_expected_amount\x18\b \x01(\bR\x13checkExpectedAmount\"\x85\x01\n" +
+	"\x13ChangeOrderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x05order\x18\x02 \x01(\v2\x0f.order.v1.OrderR\x05order\x12-\n" +
+	"\x06change\x18\x03 \x01(\v2\x15.order.v1.OrderChangeR\x06change\"\xd9\x03\n" +
+	"\vChangeQuote\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\tR\bpolicyId\x12\x1f\n" +
+	"\vpolicy_name\x18\x02 \x01(\tR\n" +
+	"policyName\x12%\n" +
+	"\x0edeparture_time\x18\x03 \x01(\x03R\rdepartureTime\x124\n" +
+	"\x16hours_before_departure\x18\x04 \x01(\x01R\x14hoursBeforeDeparture\x12\x1e\n" +
+	"\vnew_trip_id\x18\x05 \x01(\tR\tnewTripId\x12&\n" +
+	"\x0fold_total_paisa\x18\x06 \x01(\x03R\roldTotalPaisa\x12&\n" +
+	"\x0fnew_total_paisa\x18\a \x01(\x03R\rnewTotalPaisa\x122\n" +
+	"\x15fare_difference_paisa\x18\b \x01(\x03R\x13fareDifferencePaisa\x12(\n" +
+	"\x10change_fee_paisa\x18\t \x01(\x03R\x0echangeFeePaisa\x12(\n" +
+	"\x10amount_due_paisa\x18\n" +
+	" \x01(\x03R\x0eamountDuePaisa\x12\x1b\n" +
+	"\tquoted_at\x18\v \x01(\x03R\bquotedAt\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\"\xf9\x02\n" +
+	"\vOrderChange\x12\x1e\n" +
+	"\vold_trip_id\x18\x01 \x01(\tR\toldTripId\x12$\n" +
+	"\x0eold_booking_id\x18\x02 \x01(\tR\foldBookingId\x121\n" +
+	"\told_seats\x18\x03 \x03(\v2\x14.order.v1.BookedSeatR\boldSeats\x12\x1e\n" +
+	"\vnew_trip_id\x18\x04 \x01(\tR\tnewTripId\x12$\n" +
+	"\x0enew_booking_id\x18\x05 \x01(\tR\fnewBookingId\x12+\n" +
+	"\x05quote\x18\x06 \x01(\v2\x15.order.v1.ChangeQuoteR\x05quote\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\a \x01(\tR\tpaymentId\x12\x1b\n" +
+	"\trefund_id\x18\b \x01(\tR\brefundId\x12#\n" +
+	"\rrefund_failed\x18\t \x01(\bR\frefundFailed\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\n" +
+	" \x01(\x03R\tchangedAt\"\xd7\x02\n" +
+	"\x18TransferPassengerRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x0fpassenger_index\x18\x03 \x01(\x05R\x0epassengerIndex\x12\x17\n" +
+	"\anew_nid\x18\x04 \x01(\tR\x06newNid\x12\x19\n" +
+	"\bnew_name\x18\x05 \x01(\tR\anewName\x12)\n" +
+	"\x11new_date_of_birth\x18\x06 \x01(\tR\x0enewDateOfBirth\x12#\n" +
+	"\rpayment_token\x18\a \x01(\tR\fpaymentToken\x12,\n" +
+	"\x12expected_fee_paisa\x18\b \x01(\x03R\x10expectedFeePaisa\x12,\n" +
+	"\x12check_expected_fee\x18\t \x01(\bR\x10checkExpectedFee\"\x95\x01\n" +
+	"\x19TransferPassengerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x05order\x18\x02 \x01(\v2\x0f.order.v1.OrderR\x05order\x127\n" +
+	"\btransfer\x18\x03 \x01(\v2\x1b.order.v1.PassengerTransferR\btransfer\"v\n" +
+	"\x17GetTransferQuoteRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x0fpassenger_index\x18\x03 \x01(\x05R\x0epassengerIndex\"\x80\x02\n" +
+	"\rTransferQuote\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\tR\bpolicyId\x12\x1f\n" +
+	"\vpolicy_name\x18\x02 \x01(\tR\n" +
+	"policyName\x12%\n" +
+	"\x0edeparture_time\x18\x03 \x01(\x03R\rdepartureTime\x124\n" +
+	"\x16hours_before_departure\x18\x04 \x01(\x01R\x14hoursBeforeDeparture\x12\x1b\n" +
+	"\tfee_paisa\x18\x05 \x01(\x03R\bfeePaisa\x12\x1b\n" +
+	"\tquoted_at\x18\x06 \x01(\x03R\bquotedAt\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\"\x8d\x03\n" +
+	"\x11PassengerTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x17\n" +
+	"\atrip_id\x18\x05 \x01(\tR\x06tripId\x12'\n" +
+	"\x0fpassenger_index\x18\x06 \x01(\x05R\x0epassengerIndex\x12\x17\n" +
+	"\aold_nid\x18\a \x01(\tR\x06oldNid\x12\x19\n" +
+	"\bold_name\x18\b \x01(\tR\aoldName\x12\x17\n" +
+	"\anew_nid\x18\t \x01(\tR\x06newNid\x12\x19\n" +
+	"\bnew_name\x18\n" +
+	" \x01(\tR\anewName\x12\x1b\n" +
+	"\tfee_paisa\x18\v \x01(\x03R\bfeePaisa\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\f \x01(\tR\tpaymentId\x12%\n" +
+	"\x0etransferred_at\x18\r \x01(\x03R\rtransferredAt\"\xb3\x01\n" +
+	"\x14ListTransfersRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03nid\x18\x04 \x01(\tR\x03nid\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"h\n" +
+	"\x15ListTransfersResponse\x129\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x1b.order.v1.PassengerTransferR\ttransfers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x93\x01\n" +
+	"\x15GetRefundQuoteRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12+\n" +
+	"\x11passenger_indexes\x18\x03 \x03(\x05R\x10passengerIndexes\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\"\x86\x01\n" +
+	"\x16GetRefundQuoteResponse\x12/\n" +
+	"\x05quote\x18\x01 \x01(\v2\x19.order.v1.RefundBreakdownR\x05quote\x12\x1f\n" +
+	"\vtotal_paisa\x18\x02 \x01(\x03R\n" +
+	"totalPaisa\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\xef\x03\n" +
+	"\fRefundPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\broute_id\x18\x03 \x01(\tR\arouteId\x12#\n" +
+	"\rvehicle_class\x18\x04 \x01(\tR\fvehicleClass\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12*\n" +
+	"\x05tiers\x18\x06 \x03(\v2\x14.order.v1.RefundTierR\x05tiers\x12,\n" +
+	"\x12refund_booking_fee\x18\a \x01(\bR\x10refundBookingFee\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt\x12(\n" +
+	"\x10change_fee_paisa\x18\n" +
+	" \x01(\x03R\x0echangeFeePaisa\x12.\n" +
+	"\x13change_cutoff_hours\x18\v \x01(\x05R\x11changeCutoffHours\x12,\n" +
+	"\x12transfer_fee_paisa\x18\f \x01(\x03R\x10transferFeePaisa\x122\n" +
+	"\x15transfer_cutoff_hours\x18\r \x01(\x05R\x13transferCutoffHours\"p\n" +
+	"\n" +
+	"RefundTier\x12;\n" +
+	"\x1amin_hours_before_departure\x18\x01 \x01(\x05R\x17minHoursBeforeDeparture\x12%\n" +
+	"\x0erefund_percent\x18\x02 \x01(\x05R\rrefundPercent\"D\n" +
+	"\x19ListRefundPoliciesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"P\n" +
+	"\x1aListRefundPoliciesResponse\x122\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x16.order.v1.RefundPolicyR\bpolicies\"a\n" +
+	"\x19DeleteRefundPolicyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpolicy_id\x18\x02 \x01(\tR\bpolicyId\"6\n" +
+	"\x1aDeleteRefundPolicyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"K\n" +
 	"\x15GetOrderStatusRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x87\x01\n" +
@@ -1935,7 +6838,191 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"U\n" +
 	"\x12RetryOrderResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
-	"\x05order\x18\x02 \x01(\v2\x0f.order.v1.OrderR\x05order*\xec\x01\n" +
+	"\x05order\x18\x02 \x01(\v2\x0f.order.v1.OrderR\x05order\"\xd1\x01\n" +
+	"\x10ListSagasRequest\x120\n" +
+	"\bstatuses\x18\x01 \x03(\x0e2\x14.order.v1.SagaStatusR\bstatuses\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12&\n" +
+	"\x0fmin_age_seconds\x18\x03 \x01(\x03R\rminAgeSeconds\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\x87\x01\n" +
+	"\x11ListSagasResponse\x12)\n" +
+	"\x05sagas\x18\x01 \x03(\v2\x13.order.v1.SagaStateR\x05sagas\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\")\n" +
+	"\x0eGetSagaRequest\x12\x17\n" +
+	"\asaga_id\x18\x01 \x01(\tR\x06sagaId\"j\n" +
+	"\x0fGetSagaResponse\x12'\n" +
+	"\x04saga\x18\x01 \x01(\v2\x13.order.v1.SagaStateR\x04saga\x12.\n" +
+	"\x05audit\x18\x02 \x03(\v2\x18.order.v1.SagaAuditEntryR\x05audit\"\xdf\x01\n" +
+	"\x0eSagaAuditEntry\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12?\n" +
+	"\adetails\x18\x03 \x03(\v2%.order.v1.SagaAuditEntry.DetailsEntryR\adetails\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"{\n" +
+	"\x10RetrySagaRequest\x12\x17\n" +
+	"\asaga_id\x18\x01 \x01(\tR\x06sagaId\x12\x1b\n" +
+	"\tfrom_step\x18\x02 \x01(\tR\bfromStep\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"c\n" +
+	"\x15CompensateSagaRequest\x12\x17\n" +
+	"\asaga_id\x18\x01 \x01(\tR\x06sagaId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"`\n" +
+	"\x12ResolveSagaRequest\x12\x17\n" +
+	"\asaga_id\x18\x01 \x01(\tR\x06sagaId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"=\n" +
+	"\x12SagaActionResponse\x12'\n" +
+	"\x04saga\x18\x01 \x01(\v2\x13.order.v1.SagaStateR\x04saga\"\x97\x02\n" +
+	"\vFraudReview\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\x01 \x01(\x05R\triskScore\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x02 \x01(\tR\triskLevel\x12\x18\n" +
+	"\asummary\x18\x03 \x01(\tR\asummary\x12!\n" +
+	"\frisk_factors\x18\x04 \x03(\tR\vriskFactors\x12\x1a\n" +
+	"\bdecision\x18\x05 \x01(\tR\bdecision\x12\x1f\n" +
+	"\vreviewer_id\x18\x06 \x01(\tR\n" +
+	"reviewerId\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"flagged_at\x18\b \x01(\x03R\tflaggedAt\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\t \x01(\x03R\tdecidedAt\"~\n" +
+	"\x17ListFraudReviewsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"~\n" +
+	"\x12ReviewOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\tR\n" +
+	"reviewerId\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\xef\x03\n" +
+	"\n" +
+	"AgentShift\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\bagent_id\x18\x03 \x01(\tR\aagentId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12.\n" +
+	"\x13opening_float_paisa\x18\x05 \x01(\x03R\x11openingFloatPaisa\x12(\n" +
+	"\x10cash_sales_paisa\x18\x06 \x01(\x03R\x0ecashSalesPaisa\x12,\n" +
+	"\x12cash_refunds_paisa\x18\a \x01(\x03R\x10cashRefundsPaisa\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1b\n" +
+	"\topened_at\x18\t \x01(\x03R\bopenedAt\x12,\n" +
+	"\x12counted_cash_paisa\x18\n" +
+	" \x01(\x03R\x10countedCashPaisa\x12.\n" +
+	"\x13expected_cash_paisa\x18\v \x01(\x03R\x11expectedCashPaisa\x12%\n" +
+	"\x0evariance_paisa\x18\f \x01(\x03R\rvariancePaisa\x12\x12\n" +
+	"\x04note\x18\r \x01(\tR\x04note\x12\x1b\n" +
+	"\tclosed_at\x18\x0e \x01(\x03R\bclosedAt\"\xf3\x01\n" +
+	"\vShiftReport\x12*\n" +
+	"\x05shift\x18\x01 \x01(\v2\x14.order.v1.AgentShiftR\x05shift\x12)\n" +
+	"\x10confirmed_orders\x18\x02 \x01(\x05R\x0fconfirmedOrders\x120\n" +
+	"\x14confirmed_cash_paisa\x18\x03 \x01(\x03R\x12confirmedCashPaisa\x12%\n" +
+	"\x0epending_orders\x18\x04 \x01(\x05R\rpendingOrders\x124\n" +
+	"\x16unrecorded_sales_paisa\x18\x05 \x01(\x03R\x14unrecordedSalesPaisa\"\x86\x01\n" +
+	"\x10OpenShiftRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\bagent_id\x18\x02 \x01(\tR\aagentId\x12.\n" +
+	"\x13opening_float_paisa\x18\x03 \x01(\x03R\x11openingFloatPaisa\"\\\n" +
+	"\x16GetCurrentShiftRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\bagent_id\x18\x02 \x01(\tR\aagentId\"[\n" +
+	"\x15GetShiftReportRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\bshift_id\x18\x02 \x01(\tR\ashiftId\"\x99\x01\n" +
+	"\x11CloseShiftRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\bagent_id\x18\x02 \x01(\tR\aagentId\x12,\n" +
+	"\x12counted_cash_paisa\x18\x03 \x01(\x03R\x10countedCashPaisa\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\x95\x04\n" +
+	"\x10CorporateAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
+	"\rbilling_email\x18\x06 \x01(\tR\fbillingEmail\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12,\n" +
+	"\x12credit_limit_paisa\x18\b \x01(\x03R\x10creditLimitPaisa\x12+\n" +
+	"\x11outstanding_paisa\x18\t \x01(\x03R\x10outstandingPaisa\x12,\n" +
+	"\x12payment_terms_days\x18\n" +
+	" \x01(\x05R\x10paymentTermsDays\x12\x1d\n" +
+	"\n" +
+	"booker_ids\x18\v \x03(\tR\tbookerIds\x12;\n" +
+	"\n" +
+	"travellers\x18\f \x03(\v2\x1b.order.v1.ApprovedTravellerR\n" +
+	"travellers\x12$\n" +
+	"\x0emax_group_size\x18\r \x01(\x05R\fmaxGroupSize\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\x03R\tupdatedAt\"9\n" +
+	"\x11ApprovedTraveller\x12\x10\n" +
+	"\x03nid\x18\x01 \x01(\tR\x03nid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xfb\x02\n" +
+	"\x0eAccountInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12!\n" +
+	"\fperiod_start\x18\x04 \x01(\x03R\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x05 \x01(\x03R\tperiodEnd\x12\x1f\n" +
+	"\vtotal_paisa\x18\x06 \x01(\x03R\n" +
+	"totalPaisa\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1b\n" +
+	"\tissued_at\x18\t \x01(\x03R\bissuedAt\x12\x15\n" +
+	"\x06due_at\x18\n" +
+	" \x01(\x03R\x05dueAt\x12\x17\n" +
+	"\apaid_at\x18\v \x01(\x03R\x06paidAt\x12-\n" +
+	"\x05lines\x18\f \x03(\v2\x17.order.v1.AccountChargeR\x05lines\"\x9e\x01\n" +
+	"\rAccountCharge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12!\n" +
+	"\famount_paisa\x18\x03 \x01(\x03R\vamountPaisa\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"n\n" +
+	"\x1bSaveCorporateAccountRequest\x124\n" +
+	"\aaccount\x18\x01 \x01(\v2\x1a.order.v1.CorporateAccountR\aaccount\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\"d\n" +
+	"\x1aGetCorporateAccountRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\"G\n" +
+	"\x1cListCorporateAccountsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"W\n" +
+	"\x1dListCorporateAccountsResponse\x126\n" +
+	"\baccounts\x18\x01 \x03(\v2\x1a.order.v1.CorporateAccountR\baccounts\"d\n" +
+	"\x1aListAccountInvoicesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\"S\n" +
+	"\x1bListAccountInvoicesResponse\x124\n" +
+	"\binvoices\x18\x01 \x03(\v2\x18.order.v1.AccountInvoiceR\binvoices\"\x81\x01\n" +
+	"\x18GetAccountInvoiceRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x03 \x01(\tR\tinvoiceId\"\x9a\x01\n" +
+	"\x16MarkInvoicePaidRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x03 \x01(\tR\tinvoiceId\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId*\x8d\x02\n" +
 	"\vOrderStatus\x12\x1c\n" +
 	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14ORDER_STATUS_PENDING\x10\x01\x12\x1a\n" +
@@ -1944,14 +7031,16 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"\x16ORDER_STATUS_CANCELLED\x10\x04\x12\x18\n" +
 	"\x14ORDER_STATUS_EXPIRED\x10\x05\x12\x1f\n" +
 	"\x1bORDER_STATUS_REFUND_PENDING\x10\x06\x12\x19\n" +
-	"\x15ORDER_STATUS_REFUNDED\x10\a*\xbf\x01\n" +
+	"\x15ORDER_STATUS_REFUNDED\x10\a\x12\x1f\n" +
+	"\x1bORDER_STATUS_REVIEW_PENDING\x10\b*\xe6\x01\n" +
 	"\rPaymentStatus\x12\x1e\n" +
 	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16PAYMENT_STATUS_PENDING\x10\x01\x12\x1d\n" +
 	"\x19PAYMENT_STATUS_AUTHORIZED\x10\x02\x12\x1b\n" +
 	"\x17PAYMENT_STATUS_CAPTURED\x10\x03\x12\x19\n" +
 	"\x15PAYMENT_STATUS_FAILED\x10\x04\x12\x1b\n" +
-	"\x17PAYMENT_STATUS_REFUNDED\x10\x05*\xb0\x01\n" +
+	"\x17PAYMENT_STATUS_REFUNDED\x10\x05\x12%\n" +
+	"!PAYMENT_STATUS_PARTIALLY_REFUNDED\x10\x06*\xfe\x01\n" +
 	"\n" +
 	"SagaStatus\x12\x1b\n" +
 	"\x17SAGA_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
@@ -1959,7 +7048,10 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"\x15SAGA_STATUS_COMPLETED\x10\x02\x12\x1c\n" +
 	"\x18SAGA_STATUS_COMPENSATING\x10\x03\x12\x1b\n" +
 	"\x17SAGA_STATUS_COMPENSATED\x10\x04\x12\x16\n" +
-	"\x12SAGA_STATUS_FAILED\x10\x05*\xab\x01\n" +
+	"\x12SAGA_STATUS_FAILED\x10\x05\x12\x17\n" +
+	"\x13SAGA_STATUS_PENDING\x10\x06\x12\x18\n" +
+	"\x14SAGA_STATUS_RESOLVED\x10\a\x12\x19\n" +
+	"\x15SAGA_STATUS_SUSPENDED\x10\b*\xc8\x01\n" +
 	"\n" +
 	"StepStatus\x12\x1b\n" +
 	"\x17STEP_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
@@ -1967,7 +7059,8 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"\x13STEP_STATUS_RUNNING\x10\x02\x12\x19\n" +
 	"\x15STEP_STATUS_COMPLETED\x10\x03\x12\x16\n" +
 	"\x12STEP_STATUS_FAILED\x10\x04\x12\x1b\n" +
-	"\x17STEP_STATUS_COMPENSATED\x10\x052\xc2\x03\n" +
+	"\x17STEP_STATUS_COMPENSATED\x10\x05\x12\x1b\n" +
+	"\x17STEP_STATUS_INTERRUPTED\x10\x062\xb2\x16\n" +
 	"\fOrderService\x12J\n" +
 	"\vCreateOrder\x12\x1c.order.v1.CreateOrderRequest\x1a\x1d.order.v1.CreateOrderResponse\x126\n" +
 	"\bGetOrder\x12\x19.order.v1.GetOrderRequest\x1a\x0f.order.v1.Order\x12G\n" +
@@ -1976,7 +7069,38 @@ const file_api_proto_order_v1_order_proto_rawDesc = "" +
 	"\vCancelOrder\x12\x1c.order.v1.CancelOrderRequest\x1a\x1d.order.v1.CancelOrderResponse\x12P\n" +
 	"\x0eGetOrderStatus\x12\x1f.order.v1.GetOrderStatusRequest\x1a\x1d.order.v1.OrderStatusResponse\x12G\n" +
 	"\n" +
-	"RetryOrder\x12\x1b.order.v1.RetryOrderRequest\x1a\x1c.order.v1.RetryOrderResponseB8Z6github.com/MuhibNayem/Travio/server/api/proto/order/v1b\x06proto3"
+	"RetryOrder\x12\x1b.order.v1.RetryOrderRequest\x1a\x1c.order.v1.RetryOrderResponse\x12Y\n" +
+	"\x10CancelPassengers\x12!.order.v1.CancelPassengersRequest\x1a\".order.v1.CancelPassengersResponse\x12S\n" +
+	"\x0eGetRefundQuote\x12\x1f.order.v1.GetRefundQuoteRequest\x1a .order.v1.GetRefundQuoteResponse\x12J\n" +
+	"\vChangeOrder\x12\x1c.order.v1.ChangeOrderRequest\x1a\x1d.order.v1.ChangeOrderResponse\x12E\n" +
+	"\x0eGetChangeQuote\x12\x1c.order.v1.ChangeOrderRequest\x1a\x15.order.v1.ChangeQuote\x12\\\n" +
+	"\x11TransferPassenger\x12\".order.v1.TransferPassengerRequest\x1a#.order.v1.TransferPassengerResponse\x12N\n" +
+	"\x10GetTransferQuote\x12!.order.v1.GetTransferQuoteRequest\x1a\x17.order.v1.TransferQuote\x12P\n" +
+	"\rListTransfers\x12\x1e.order.v1.ListTransfersRequest\x1a\x1f.order.v1.ListTransfersResponse\x12@\n" +
+	"\rGetOrderByPNR\x12\x1e.order.v1.GetOrderByPNRRequest\x1a\x0f.order.v1.Order\x12W\n" +
+	"\x13GetRefundQuoteByPNR\x12\x1e.order.v1.GetOrderByPNRRequest\x1a .order.v1.GetRefundQuoteResponse\x12T\n" +
+	"\x10CancelOrderByPNR\x12!.order.v1.CancelOrderByPNRRequest\x1a\x1d.order.v1.CancelOrderResponse\x12A\n" +
+	"\x0fSetRefundPolicy\x12\x16.order.v1.RefundPolicy\x1a\x16.order.v1.RefundPolicy\x12_\n" +
+	"\x12ListRefundPolicies\x12#.order.v1.ListRefundPoliciesRequest\x1a$.order.v1.ListRefundPoliciesResponse\x12_\n" +
+	"\x12DeleteRefundPolicy\x12#.order.v1.DeleteRefundPolicyRequest\x1a$.order.v1.DeleteRefundPolicyResponse\x12D\n" +
+	"\tListSagas\x12\x1a.order.v1.ListSagasRequest\x1a\x1b.order.v1.ListSagasResponse\x12>\n" +
+	"\aGetSaga\x12\x18.order.v1.GetSagaRequest\x1a\x19.order.v1.GetSagaResponse\x12E\n" +
+	"\tRetrySaga\x12\x1a.order.v1.RetrySagaRequest\x1a\x1c.order.v1.SagaActionResponse\x12O\n" +
+	"\x0eCompensateSaga\x12\x1f.order.v1.CompensateSagaRequest\x1a\x1c.order.v1.SagaActionResponse\x12I\n" +
+	"\vResolveSaga\x12\x1c.order.v1.ResolveSagaRequest\x1a\x1c.order.v1.SagaActionResponse\x12S\n" +
+	"\x10ListFraudReviews\x12!.order.v1.ListFraudReviewsRequest\x1a\x1c.order.v1.ListOrdersResponse\x12<\n" +
+	"\vReviewOrder\x12\x1c.order.v1.ReviewOrderRequest\x1a\x0f.order.v1.Order\x12=\n" +
+	"\tOpenShift\x12\x1a.order.v1.OpenShiftRequest\x1a\x14.order.v1.AgentShift\x12J\n" +
+	"\x0fGetCurrentShift\x12 .order.v1.GetCurrentShiftRequest\x1a\x15.order.v1.ShiftReport\x12H\n" +
+	"\x0eGetShiftReport\x12\x1f.order.v1.GetShiftReportRequest\x1a\x15.order.v1.ShiftReport\x12@\n" +
+	"\n" +
+	"CloseShift\x12\x1b.order.v1.CloseShiftRequest\x1a\x15.order.v1.ShiftReport\x12Y\n" +
+	"\x14SaveCorporateAccount\x12%.order.v1.SaveCorporateAccountRequest\x1a\x1a.order.v1.CorporateAccount\x12W\n" +
+	"\x13GetCorporateAccount\x12$.order.v1.GetCorporateAccountRequest\x1a\x1a.order.v1.CorporateAccount\x12h\n" +
+	"\x15ListCorporateAccounts\x12&.order.v1.ListCorporateAccountsRequest\x1a'.order.v1.ListCorporateAccountsResponse\x12b\n" +
+	"\x13ListAccountInvoices\x12$.order.v1.ListAccountInvoicesRequest\x1a%.order.v1.ListAccountInvoicesResponse\x12Q\n" +
+	"\x11GetAccountInvoice\x12\".order.v1.GetAccountInvoiceRequest\x1a\x18.order.v1.AccountInvoice\x12M\n" +
+	"\x0fMarkInvoicePaid\x12 .order.v1.MarkInvoicePaidRequest\x1a\x18.order.v1.AccountInvoiceB8Z6github.com/MuhibNayem/Travio/server/api/proto/order/v1b\x06proto3"
 
 var (
 	file_api_proto_order_v1_order_proto_rawDescOnce sync.Once
@@ -1991,68 +7115,227 @@ func file_api_proto_order_v1_order_proto_rawDescGZIP() []byte {
 }
 
 var file_api_proto_order_v1_order_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_api_proto_order_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_api_proto_order_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_api_proto_order_v1_order_proto_goTypes = []any{
-	(OrderStatus)(0),              // 0: order.v1.OrderStatus
-	(PaymentStatus)(0),            // 1: order.v1.PaymentStatus
-	(SagaStatus)(0),               // 2: order.v1.SagaStatus
-	(StepStatus)(0),               // 3: order.v1.StepStatus
-	(*Order)(nil),                 // 4: order.v1.Order
-	(*Passenger)(nil),             // 5: order.v1.Passenger
-	(*BookedSeat)(nil),            // 6: order.v1.BookedSeat
-	(*SagaState)(nil),             // 7: order.v1.SagaState
-	(*SagaStep)(nil),              // 8: order.v1.SagaStep
-	(*CreateOrderRequest)(nil),    // 9: order.v1.CreateOrderRequest
-	(*PassengerRequest)(nil),      // 10: order.v1.PassengerRequest
-	(*PaymentMethod)(nil),         // 11: order.v1.PaymentMethod
-	(*CreateOrderResponse)(nil),   // 12: order.v1.CreateOrderResponse
-	(*GetOrderRequest)(nil),       // 13: order.v1.GetOrderRequest
-	(*ListOrdersRequest)(nil),     // 14: order.v1.ListOrdersRequest
-	(*ListOrdersResponse)(nil),    // 15: order.v1.ListOrdersResponse
-	(*CancelOrderRequest)(nil),    // 16: order.v1.CancelOrderRequest
-	(*CancelOrderResponse)(nil),   // 17: order.v1.CancelOrderResponse
-	(*RefundInfo)(nil),            // 18: order.v1.RefundInfo
-	(*GetOrderStatusRequest)(nil), // 19: order.v1.GetOrderStatusRequest
-	(*OrderStatusResponse)(nil),   // 20: order.v1.OrderStatusResponse
-	(*RetryOrderRequest)(nil),     // 21: order.v1.RetryOrderRequest
-	(*RetryOrderResponse)(nil),    // 22: order.v1.RetryOrderResponse
+	(OrderStatus)(0),                      // 0: order.v1.OrderStatus
+	(PaymentStatus)(0),                    // 1: order.v1.PaymentStatus
+	(SagaStatus)(0),                       // 2: order.v1.SagaStatus
+	(StepStatus)(0),                       // 3: order.v1.StepStatus
+	(*Order)(nil),                         // 4: order.v1.Order
+	(*OrderLeg)(nil),                      // 5: order.v1.OrderLeg
+	(*Passenger)(nil),                     // 6: order.v1.Passenger
+	(*BookedSeat)(nil),                    // 7: order.v1.BookedSeat
+	(*SagaState)(nil),                     // 8: order.v1.SagaState
+	(*SagaStep)(nil),                      // 9: order.v1.SagaStep
+	(*CreateOrderRequest)(nil),            // 10: order.v1.CreateOrderRequest
+	(*LegRequest)(nil),                    // 11: order.v1.LegRequest
+	(*PassengerRequest)(nil),              // 12: order.v1.PassengerRequest
+	(*QuotaClaims)(nil),                   // 13: order.v1.QuotaClaims
+	(*PaymentMethod)(nil),                 // 14: order.v1.PaymentMethod
+	(*CreateOrderResponse)(nil),           // 15: order.v1.CreateOrderResponse
+	(*GetOrderRequest)(nil),               // 16: order.v1.GetOrderRequest
+	(*GetOrderByPNRRequest)(nil),          // 17: order.v1.GetOrderByPNRRequest
+	(*CancelOrderByPNRRequest)(nil),       // 18: order.v1.CancelOrderByPNRRequest
+	(*ListOrdersRequest)(nil),             // 19: order.v1.ListOrdersRequest
+	(*ListOrdersResponse)(nil),            // 20: order.v1.ListOrdersResponse
+	(*CancelOrderRequest)(nil),            // 21: order.v1.CancelOrderRequest
+	(*CancelOrderResponse)(nil),           // 22: order.v1.CancelOrderResponse
+	(*CancelPassengersRequest)(nil),       // 23: order.v1.CancelPassengersRequest
+	(*CancelPassengersResponse)(nil),      // 24: order.v1.CancelPassengersResponse
+	(*PassengerCancellation)(nil),         // 25: order.v1.PassengerCancellation
+	(*RefundInfo)(nil),                    // 26: order.v1.RefundInfo
+	(*RefundBreakdown)(nil),               // 27: order.v1.RefundBreakdown
+	(*ChangeOrderRequest)(nil),            // 28: order.v1.ChangeOrderRequest
+	(*ChangeOrderResponse)(nil),           // 29: order.v1.ChangeOrderResponse
+	(*ChangeQuote)(nil),                   // 30: order.v1.ChangeQuote
+	(*OrderChange)(nil),                   // 31: order.v1.OrderChange
+	(*TransferPassengerRequest)(nil),      // 32: order.v1.TransferPassengerRequest
+	(*TransferPassengerResponse)(nil),     // 33: order.v1.TransferPassengerResponse
+	(*GetTransferQuoteRequest)(nil),       // 34: order.v1.GetTransferQuoteRequest
+	(*TransferQuote)(nil),                 // 35: order.v1.TransferQuote
+	(*PassengerTransfer)(nil),             // 36: order.v1.PassengerTransfer
+	(*ListTransfersRequest)(nil),          // 37: order.v1.ListTransfersRequest
+	(*ListTransfersResponse)(nil),         // 38: order.v1.ListTransfersResponse
+	(*GetRefundQuoteRequest)(nil),         // 39: order.v1.GetRefundQuoteRequest
+	(*GetRefundQuoteResponse)(nil),        // 40: order.v1.GetRefundQuoteResponse
+	(*RefundPolicy)(nil),                  // 41: order.v1.RefundPolicy
+	(*RefundTier)(nil),                    // 42: order.v1.RefundTier
+	(*ListRefundPoliciesRequest)(nil),     // 43: order.v1.ListRefundPoliciesRequest
+	(*ListRefundPoliciesResponse)(nil),    // 44: order.v1.ListRefundPoliciesResponse
+	(*DeleteRefundPolicyRequest)(nil),     // 45: order.v1.DeleteRefundPolicyRequest
+	(*DeleteRefundPolicyResponse)(nil),    // 46: order.v1.DeleteRefundPolicyResponse
+	(*GetOrderStatusRequest)(nil),         // 47: order.v1.GetOrderStatusRequest
+	(*OrderStatusResponse)(nil),           // 48: order.v1.OrderStatusResponse
+	(*RetryOrderRequest)(nil),             // 49: order.v1.RetryOrderRequest
+	(*RetryOrderResponse)(nil),            // 50: order.v1.RetryOrderResponse
+	(*ListSagasRequest)(nil),              // 51: order.v1.ListSagasRequest
+	(*ListSagasResponse)(nil),             // 52: order.v1.ListSagasResponse
+	(*GetSagaRequest)(nil),                // 53: order.v1.GetSagaRequest
+	(*GetSagaResponse)(nil),               // 54: order.v1.GetSagaResponse
+	(*SagaAuditEntry)(nil),                // 55: order.v1.SagaAuditEntry
+	(*RetrySagaRequest)(nil),              // 56: order.v1.RetrySagaRequest
+	(*CompensateSagaRequest)(nil),         // 57: order.v1.CompensateSagaRequest
+	(*ResolveSagaRequest)(nil),            // 58: order.v1.ResolveSagaRequest
+	(*SagaActionResponse)(nil),            // 59: order.v1.SagaActionResponse
+	(*FraudReview)(nil),                   // 60: order.v1.FraudReview
+	(*ListFraudReviewsRequest)(nil),       // 61: order.v1.ListFraudReviewsRequest
+	(*ReviewOrderRequest)(nil),            // 62: order.v1.ReviewOrderRequest
+	(*AgentShift)(nil),                    // 63: order.v1.AgentShift
+	(*ShiftReport)(nil),                   // 64: order.v1.ShiftReport
+	(*OpenShiftRequest)(nil),              // 65: order.v1.OpenShiftRequest
+	(*GetCurrentShiftRequest)(nil),        // 66: order.v1.GetCurrentShiftRequest
+	(*GetShiftReportRequest)(nil),         // 67: order.v1.GetShiftReportRequest
+	(*CloseShiftRequest)(nil),             // 68: order.v1.CloseShiftRequest
+	(*CorporateAccount)(nil),              // 69: order.v1.CorporateAccount
+	(*ApprovedTraveller)(nil),             // 70: order.v1.ApprovedTraveller
+	(*AccountInvoice)(nil),                // 71: order.v1.AccountInvoice
+	(*AccountCharge)(nil),                 // 72: order.v1.AccountCharge
+	(*SaveCorporateAccountRequest)(nil),   // 73: order.v1.SaveCorporateAccountRequest
+	(*GetCorporateAccountRequest)(nil),    // 74: order.v1.GetCorporateAccountRequest
+	(*ListCorporateAccountsRequest)(nil),  // 75: order.v1.ListCorporateAccountsRequest
+	(*ListCorporateAccountsResponse)(nil), // 76: order.v1.ListCorporateAccountsResponse
+	(*ListAccountInvoicesRequest)(nil),    // 77: order.v1.ListAccountInvoicesRequest
+	(*ListAccountInvoicesResponse)(nil),   // 78: order.v1.ListAccountInvoicesResponse
+	(*GetAccountInvoiceRequest)(nil),      // 79: order.v1.GetAccountInvoiceRequest
+	(*MarkInvoicePaidRequest)(nil),        // 80: order.v1.MarkInvoicePaidRequest
+	nil,                                   // 81: order.v1.SagaState.ReferencesEntry
+	nil,                                   // 82: order.v1.SagaAuditEntry.DetailsEntry
 }
 var file_api_proto_order_v1_order_proto_depIdxs = []int32{
-	5,  // 0: order.v1.Order.passengers:type_name -> order.v1.Passenger
+	6,  // 0: order.v1.Order.passengers:type_name -> order.v1.Passenger
 	1,  // 1: order.v1.Order.payment_status:type_name -> order.v1.PaymentStatus
-	6,  // 2: order.v1.Order.seats:type_name -> order.v1.BookedSeat
+	7,  // 2: order.v1.Order.seats:type_name -> order.v1.BookedSeat
 	0,  // 3: order.v1.Order.status:type_name -> order.v1.OrderStatus
-	7,  // 4: order.v1.Order.saga_state:type_name -> order.v1.SagaState
-	2,  // 5: order.v1.SagaState.status:type_name -> order.v1.SagaStatus
-	8,  // 6: order.v1.SagaState.steps:type_name -> order.v1.SagaStep
-	3,  // 7: order.v1.SagaStep.status:type_name -> order.v1.StepStatus
-	10, // 8: order.v1.CreateOrderRequest.passengers:type_name -> order.v1.PassengerRequest
-	11, // 9: order.v1.CreateOrderRequest.payment_method:type_name -> order.v1.PaymentMethod
-	4,  // 10: order.v1.CreateOrderResponse.order:type_name -> order.v1.Order
-	0,  // 11: order.v1.ListOrdersRequest.status:type_name -> order.v1.OrderStatus
-	4,  // 12: order.v1.ListOrdersResponse.orders:type_name -> order.v1.Order
-	4,  // 13: order.v1.CancelOrderResponse.order:type_name -> order.v1.Order
-	18, // 14: order.v1.CancelOrderResponse.refund:type_name -> order.v1.RefundInfo
-	0,  // 15: order.v1.OrderStatusResponse.status:type_name -> order.v1.OrderStatus
-	7,  // 16: order.v1.OrderStatusResponse.saga:type_name -> order.v1.SagaState
-	4,  // 17: order.v1.RetryOrderResponse.order:type_name -> order.v1.Order
-	9,  // 18: order.v1.OrderService.CreateOrder:input_type -> order.v1.CreateOrderRequest
-	13, // 19: order.v1.OrderService.GetOrder:input_type -> order.v1.GetOrderRequest
-	14, // 20: order.v1.OrderService.ListOrders:input_type -> order.v1.ListOrdersRequest
-	16, // 21: order.v1.OrderService.CancelOrder:input_type -> order.v1.CancelOrderRequest
-	19, // 22: order.v1.OrderService.GetOrderStatus:input_type -> order.v1.GetOrderStatusRequest
-	21, // 23: order.v1.OrderService.RetryOrder:input_type -> order.v1.RetryOrderRequest
-	12, // 24: order.v1.OrderService.CreateOrder:output_type -> order.v1.CreateOrderResponse
-	4,  // 25: order.v1.OrderService.GetOrder:output_type -> order.v1.Order
-	15, // 26: order.v1.OrderService.ListOrders:output_type -> order.v1.ListOrdersResponse
-	17, // 27: order.v1.OrderService.CancelOrder:output_type -> order.v1.CancelOrderResponse
-	20, // 28: order.v1.OrderService.GetOrderStatus:output_type -> order.v1.OrderStatusResponse
-	22, // 29: order.v1.OrderService.RetryOrder:output_type -> order.v1.RetryOrderResponse
-	24, // [24:30] is the sub-list for method output_type
-	18, // [18:24] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	8,  // 4: order.v1.Order.saga_state:type_name -> order.v1.SagaState
+	27, // 5: order.v1.Order.refund_breakdown:type_name -> order.v1.RefundBreakdown
+	25, // 6: order.v1.Order.passenger_cancellations:type_name -> order.v1.PassengerCancellation
+	5,  // 7: order.v1.Order.legs:type_name -> order.v1.OrderLeg
+	31, // 8: order.v1.Order.changes:type_name -> order.v1.OrderChange
+	60, // 9: order.v1.Order.fraud_review:type_name -> order.v1.FraudReview
+	6,  // 10: order.v1.OrderLeg.passengers:type_name -> order.v1.Passenger
+	7,  // 11: order.v1.OrderLeg.seats:type_name -> order.v1.BookedSeat
+	2,  // 12: order.v1.SagaState.status:type_name -> order.v1.SagaStatus
+	9,  // 13: order.v1.SagaState.steps:type_name -> order.v1.SagaStep
+	81, // 14: order.v1.SagaState.references:type_name -> order.v1.SagaState.ReferencesEntry
+	3,  // 15: order.v1.SagaStep.status:type_name -> order.v1.StepStatus
+	12, // 16: order.v1.CreateOrderRequest.passengers:type_name -> order.v1.PassengerRequest
+	14, // 17: order.v1.CreateOrderRequest.payment_method:type_name -> order.v1.PaymentMethod
+	11, // 18: order.v1.CreateOrderRequest.legs:type_name -> order.v1.LegRequest
+	13, // 19: order.v1.CreateOrderRequest.quota_claims:type_name -> order.v1.QuotaClaims
+	12, // 20: order.v1.LegRequest.passengers:type_name -> order.v1.PassengerRequest
+	4,  // 21: order.v1.CreateOrderResponse.order:type_name -> order.v1.Order
+	0,  // 22: order.v1.ListOrdersRequest.status:type_name -> order.v1.OrderStatus
+	4,  // 23: order.v1.ListOrdersResponse.orders:type_name -> order.v1.Order
+	4,  // 24: order.v1.CancelOrderResponse.order:type_name -> order.v1.Order
+	26, // 25: order.v1.CancelOrderResponse.refund:type_name -> order.v1.RefundInfo
+	4,  // 26: order.v1.CancelPassengersResponse.order:type_name -> order.v1.Order
+	26, // 27: order.v1.CancelPassengersResponse.refund:type_name -> order.v1.RefundInfo
+	7,  // 28: order.v1.PassengerCancellation.seats:type_name -> order.v1.BookedSeat
+	27, // 29: order.v1.PassengerCancellation.refund:type_name -> order.v1.RefundBreakdown
+	27, // 30: order.v1.RefundInfo.breakdown:type_name -> order.v1.RefundBreakdown
+	27, // 31: order.v1.RefundBreakdown.legs:type_name -> order.v1.RefundBreakdown
+	4,  // 32: order.v1.ChangeOrderResponse.order:type_name -> order.v1.Order
+	31, // 33: order.v1.ChangeOrderResponse.change:type_name -> order.v1.OrderChange
+	7,  // 34: order.v1.OrderChange.old_seats:type_name -> order.v1.BookedSeat
+	30, // 35: order.v1.OrderChange.quote:type_name -> order.v1.ChangeQuote
+	4,  // 36: order.v1.TransferPassengerResponse.order:type_name -> order.v1.Order
+	36, // 37: order.v1.TransferPassengerResponse.transfer:type_name -> order.v1.PassengerTransfer
+	36, // 38: order.v1.ListTransfersResponse.transfers:type_name -> order.v1.PassengerTransfer
+	27, // 39: order.v1.GetRefundQuoteResponse.quote:type_name -> order.v1.RefundBreakdown
+	42, // 40: order.v1.RefundPolicy.tiers:type_name -> order.v1.RefundTier
+	41, // 41: order.v1.ListRefundPoliciesResponse.policies:type_name -> order.v1.RefundPolicy
+	0,  // 42: order.v1.OrderStatusResponse.status:type_name -> order.v1.OrderStatus
+	8,  // 43: order.v1.OrderStatusResponse.saga:type_name -> order.v1.SagaState
+	4,  // 44: order.v1.RetryOrderResponse.order:type_name -> order.v1.Order
+	2,  // 45: order.v1.ListSagasRequest.statuses:type_name -> order.v1.SagaStatus
+	8,  // 46: order.v1.ListSagasResponse.sagas:type_name -> order.v1.SagaState
+	8,  // 47: order.v1.GetSagaResponse.saga:type_name -> order.v1.SagaState
+	55, // 48: order.v1.GetSagaResponse.audit:type_name -> order.v1.SagaAuditEntry
+	82, // 49: order.v1.SagaAuditEntry.details:type_name -> order.v1.SagaAuditEntry.DetailsEntry
+	8,  // 50: order.v1.SagaActionResponse.saga:type_name -> order.v1.SagaState
+	63, // 51: order.v1.ShiftReport.shift:type_name -> order.v1.AgentShift
+	70, // 52: order.v1.CorporateAccount.travellers:type_name -> order.v1.ApprovedTraveller
+	72, // 53: order.v1.AccountInvoice.lines:type_name -> order.v1.AccountCharge
+	69, // 54: order.v1.SaveCorporateAccountRequest.account:type_name -> order.v1.CorporateAccount
+	69, // 55: order.v1.ListCorporateAccountsResponse.accounts:type_name -> order.v1.CorporateAccount
+	71, // 56: order.v1.ListAccountInvoicesResponse.invoices:type_name -> order.v1.AccountInvoice
+	10, // 57: order.v1.OrderService.CreateOrder:input_type -> order.v1.CreateOrderRequest
+	16, // 58: order.v1.OrderService.GetOrder:input_type -> order.v1.GetOrderRequest
+	19, // 59: order.v1.OrderService.ListOrders:input_type -> order.v1.ListOrdersRequest
+	21, // 60: order.v1.OrderService.CancelOrder:input_type -> order.v1.CancelOrderRequest
+	47, // 61: order.v1.OrderService.GetOrderStatus:input_type -> order.v1.GetOrderStatusRequest
+	49, // 62: order.v1.OrderService.RetryOrder:input_type -> order.v1.RetryOrderRequest
+	23, // 63: order.v1.OrderService.CancelPassengers:input_type -> order.v1.CancelPassengersRequest
+	39, // 64: order.v1.OrderService.GetRefundQuote:input_type -> order.v1.GetRefundQuoteRequest
+	28, // 65: order.v1.OrderService.ChangeOrder:input_type -> order.v1.ChangeOrderRequest
+	28, // 66: order.v1.OrderService.GetChangeQuote:input_type -> order.v1.ChangeOrderRequest
+	32, // 67: order.v1.OrderService.TransferPassenger:input_type -> order.v1.TransferPassengerRequest
+	34, // 68: order.v1.OrderService.GetTransferQuote:input_type -> order.v1.GetTransferQuoteRequest
+	37, // 69: order.v1.OrderService.ListTransfers:input_type -> order.v1.ListTransfersRequest
+	17, // 70: order.v1.OrderService.GetOrderByPNR:input_type -> order.v1.GetOrderByPNRRequest
+	17, // 71: order.v1.OrderService.GetRefundQuoteByPNR:input_type -> order.v1.GetOrderByPNRRequest
+	18, // 72: order.v1.OrderService.CancelOrderByPNR:input_type -> order.v1.CancelOrderByPNRRequest
+	41, // 73: order.v1.OrderService.SetRefundPolicy:input_type -> order.v1.RefundPolicy
+	43, // 74: order.v1.OrderService.ListRefundPolicies:input_type -> order.v1.ListRefundPoliciesRequest
+	45, // 75: order.v1.OrderService.DeleteRefundPolicy:input_type -> order.v1.DeleteRefundPolicyRequest
+	51, // 76: order.v1.OrderService.ListSagas:input_type -> order.v1.ListSagasRequest
+	53, // 77: order.v1.OrderService.GetSaga:input_type -> order.v1.GetSagaRequest
+	56, // 78: order.v1.OrderService.RetrySaga:input_type -> order.v1.RetrySagaRequest
+	57, // 79: order.v1.OrderService.CompensateSaga:input_type -> order.v1.CompensateSagaRequest
+	58, // 80: order.v1.OrderService.ResolveSaga:input_type -> order.v1.ResolveSagaRequest
+	61, // 81: order.v1.OrderService.ListFraudReviews:input_type -> order.v1.ListFraudReviewsRequest
+	62, // 82: order.v1.OrderService.ReviewOrder:input_type -> order.v1.ReviewOrderRequest
+	65, // 83: order.v1.OrderService.OpenShift:input_type -> order.v1.OpenShiftRequest
+	66, // 84: order.v1.OrderService.GetCurrentShift:input_type -> order.v1.GetCurrentShiftRequest
+	67, // 85: order.v1.OrderService.GetShiftReport:input_type -> order.v1.GetShiftReportRequest
+	68, // 86: order.v1.OrderService.CloseShift:input_type -> order.v1.CloseShiftRequest
+	73, // 87: order.v1.OrderService.SaveCorporateAccount:input_type -> order.v1.SaveCorporateAccountRequest
+	74, // 88: order.v1.OrderService.GetCorporateAccount:input_type -> order.v1.GetCorporateAccountRequest
+	75, // 89: order.v1.OrderService.ListCorporateAccounts:input_type -> order.v1.ListCorporateAccountsRequest
+	77, // 90: order.v1.OrderService.ListAccountInvoices:input_type -> order.v1.ListAccountInvoicesRequest
+	79, // 91: order.v1.OrderService.GetAccountInvoice:input_type -> order.v1.GetAccountInvoiceRequest
+	80, // 92: order.v1.OrderService.MarkInvoicePaid:input_type -> order.v1.MarkInvoicePaidRequest
+	15, // 93: order.v1.OrderService.CreateOrder:output_type -> order.v1.CreateOrderResponse
+	4,  // 94: order.v1.OrderService.GetOrder:output_type -> order.v1.Order
+	20, // 95: order.v1.OrderService.ListOrders:output_type -> order.v1.ListOrdersResponse
+	22, // 96: order.v1.OrderService.CancelOrder:output_type -> order.v1.CancelOrderResponse
+	48, // 97: order.v1.OrderService.GetOrderStatus:output_type -> order.v1.OrderStatusResponse
+	50, // 98: order.v1.OrderService.RetryOrder:output_type -> order.v1.RetryOrderResponse
+	24, // 99: order.v1.OrderService.CancelPassengers:output_type -> order.v1.CancelPassengersResponse
+	40, // 100: order.v1.OrderService.GetRefundQuote:output_type -> order.v1.GetRefundQuoteResponse
+	29, // 101: order.v1.OrderService.ChangeOrder:output_type -> order.v1.ChangeOrderResponse
+	30, // 102: order.v1.OrderService.GetChangeQuote:output_type -> order.v1.ChangeQuote
+	33, // 103: order.v1.OrderService.TransferPassenger:output_type -> order.v1.TransferPassengerResponse
+	35, // 104: order.v1.OrderService.GetTransferQuote:output_type -> order.v1.TransferQuote
+	38, // 105: order.v1.OrderService.ListTransfers:output_type -> order.v1.ListTransfersResponse
+	4,  // 106: order.v1.OrderService.GetOrderByPNR:output_type -> order.v1.Order
+	40, // 107: order.v1.OrderService.GetRefundQuoteByPNR:output_type -> order.v1.GetRefundQuoteResponse
+	22, // 108: order.v1.OrderService.CancelOrderByPNR:output_type -> order.v1.CancelOrderResponse
+	41, // 109: order.v1.OrderService.SetRefundPolicy:output_type -> order.v1.RefundPolicy
+	44, // 110: order.v1.OrderService.ListRefundPolicies:output_type -> order.v1.ListRefundPoliciesResponse
+	46, // 111: order.v1.OrderService.DeleteRefundPolicy:output_type -> order.v1.DeleteRefundPolicyResponse
+	52, // 112: order.v1.OrderService.ListSagas:output_type -> order.v1.ListSagasResponse
+	54, // 113: order.v1.OrderService.GetSaga:output_type -> order.v1.GetSagaResponse
+	59, // 114: order.v1.OrderService.RetrySaga:output_type -> order.v1.SagaActionResponse
+	59, // 115: order.v1.OrderService.CompensateSaga:output_type -> order.v1.SagaActionResponse
+	59, // 116: order.v1.OrderService.ResolveSaga:output_type -> order.v1.SagaActionResponse
+	20, // 117: order.v1.OrderService.ListFraudReviews:output_type -> order.v1.ListOrdersResponse
+	4,  // 118: order.v1.OrderService.ReviewOrder:output_type -> order.v1.Order
+	63, // 119: order.v1.OrderService.OpenShift:output_type -> order.v1.AgentShift
+	64, // 120: order.v1.OrderService.GetCurrentShift:output_type -> order.v1.ShiftReport
+	64, // 121: order.v1.OrderService.GetShiftReport:output_type -> order.v1.ShiftReport
+	64, // 122: order.v1.OrderService.CloseShift:output_type -> order.v1.ShiftReport
+	69, // 123: order.v1.OrderService.SaveCorporateAccount:output_type -> order.v1.CorporateAccount
+	69, // 124: order.v1.OrderService.GetCorporateAccount:output_type -> order.v1.CorporateAccount
+	76, // 125: order.v1.OrderService.ListCorporateAccounts:output_type -> order.v1.ListCorporateAccountsResponse
+	78, // 126: order.v1.OrderService.ListAccountInvoices:output_type -> order.v1.ListAccountInvoicesResponse
+	71, // 127: order.v1.OrderService.GetAccountInvoice:output_type -> order.v1.AccountInvoice
+	71, // 128: order.v1.OrderService.MarkInvoicePaid:output_type -> order.v1.AccountInvoice
+	93, // [93:129] is the sub-list for method output_type
+	57, // [57:93] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_order_v1_order_proto_init() }
@@ -2066,7 +7349,7 @@ func file_api_proto_order_v1_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_order_v1_order_proto_rawDesc), len(file_api_proto_order_v1_order_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   19,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
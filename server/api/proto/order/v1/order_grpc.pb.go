@@ -19,12 +19,42 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_CreateOrder_FullMethodName    = "/order.v1.OrderService/CreateOrder"
-	OrderService_GetOrder_FullMethodName       = "/order.v1.OrderService/GetOrder"
-	OrderService_ListOrders_FullMethodName     = "/order.v1.OrderService/ListOrders"
-	OrderService_CancelOrder_FullMethodName    = "/order.v1.OrderService/CancelOrder"
-	OrderService_GetOrderStatus_FullMethodName = "/order.v1.OrderService/GetOrderStatus"
-	OrderService_RetryOrder_FullMethodName     = "/order.v1.OrderService/RetryOrder"
+	OrderService_CreateOrder_FullMethodName           = "/order.v1.OrderService/CreateOrder"
+	OrderService_GetOrder_FullMethodName              = "/order.v1.OrderService/GetOrder"
+	OrderService_ListOrders_FullMethodName            = "/order.v1.OrderService/ListOrders"
+	OrderService_CancelOrder_FullMethodName           = "/order.v1.OrderService/CancelOrder"
+	OrderService_GetOrderStatus_FullMethodName        = "/order.v1.OrderService/GetOrderStatus"
+	OrderService_RetryOrder_FullMethodName            = "/order.v1.OrderService/RetryOrder"
+	OrderService_CancelPassengers_FullMethodName      = "/order.v1.OrderService/CancelPassengers"
+	OrderService_GetRefundQuote_FullMethodName        = "/order.v1.OrderService/GetRefundQuote"
+	OrderService_ChangeOrder_FullMethodName           = "/order.v1.OrderService/ChangeOrder"
+	OrderService_GetChangeQuote_FullMethodName        = "/order.v1.OrderService/GetChangeQuote"
+	OrderService_TransferPassenger_FullMethodName     = "/order.v1.OrderService/TransferPassenger"
+	OrderService_GetTransferQuote_FullMethodName      = "/order.v1.OrderService/GetTransferQuote"
+	OrderService_ListTransfers_FullMethodName         = "/order.v1.OrderService/ListTransfers"
+	OrderService_GetOrderByPNR_FullMethodName         = "/order.v1.OrderService/GetOrderByPNR"
+	OrderService_GetRefundQuoteByPNR_FullMethodName   = "/order.v1.OrderService/GetRefundQuoteByPNR"
+	OrderService_CancelOrderByPNR_FullMethodName      = "/order.v1.OrderService/CancelOrderByPNR"
+	OrderService_SetRefundPolicy_FullMethodName       = "/order.v1.OrderService/SetRefundPolicy"
+	OrderService_ListRefundPolicies_FullMethodName    = "/order.v1.OrderService/ListRefundPolicies"
+	OrderService_DeleteRefundPolicy_FullMethodName    = "/order.v1.OrderService/DeleteRefundPolicy"
+	OrderService_ListSagas_FullMethodName             = "/order.v1.OrderService/ListSagas"
+	OrderService_GetSaga_FullMethodName               = "/order.v1.OrderService/GetSaga"
+	OrderService_RetrySaga_FullMethodName             = "/order.v1.OrderService/RetrySaga"
+	OrderService_CompensateSaga_FullMethodName        = "/order.v1.OrderService/CompensateSaga"
+	OrderService_ResolveSaga_FullMethodName           = "/order.v1.OrderService/ResolveSaga"
+	OrderService_ListFraudReviews_FullMethodName      = "/order.v1.OrderService/ListFraudReviews"
+	OrderService_ReviewOrder_FullMethodName           = "/order.v1.OrderService/ReviewOrder"
+	OrderService_OpenShift_FullMethodName             = "/order.v1.OrderService/OpenShift"
+	OrderService_GetCurrentShift_FullMethodName       = "/order.v1.OrderService/GetCurrentShift"
+	OrderService_GetShiftReport_FullMethodName        = "/order.v1.OrderService/GetShiftReport"
+	OrderService_CloseShift_FullMethodName            = "/order.v1.OrderService/CloseShift"
+	OrderService_SaveCorporateAccount_FullMethodName  = "/order.v1.OrderService/SaveCorporateAccount"
+	OrderService_GetCorporateAccount_FullMethodName   = "/order.v1.OrderService/GetCorporateAccount"
+	OrderService_ListCorporateAccounts_FullMethodName = "/order.v1.OrderService/ListCorporateAccounts"
+	OrderService_ListAccountInvoices_FullMethodName   = "/order.v1.OrderService/ListAccountInvoices"
+	OrderService_GetAccountInvoice_FullMethodName     = "/order.v1.OrderService/GetAccountInvoice"
+	OrderService_MarkInvoicePaid_FullMethodName       = "/order.v1.OrderService/MarkInvoicePaid"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -45,6 +75,61 @@ type OrderServiceClient interface {
 	GetOrderStatus(ctx context.Context, in *GetOrderStatusRequest, opts ...grpc.CallOption) (*OrderStatusResponse, error)
 	// Retry failed order (resume saga)
 	RetryOrder(ctx context.Context, in *RetryOrderRequest, opts ...grpc.CallOption) (*RetryOrderResponse, error)
+	// Cancel some passengers of an order; the rest of the order stays confirmed
+	CancelPassengers(ctx context.Context, in *CancelPassengersRequest, opts ...grpc.CallOption) (*CancelPassengersResponse, error)
+	// Quote the refund of cancelling an order (or some of its passengers) now, before the user confirms
+	GetRefundQuote(ctx context.Context, in *GetRefundQuoteRequest, opts ...grpc.CallOption) (*GetRefundQuoteResponse, error)
+	// Move a confirmed order to another trip of its route or to other seats, collecting
+	// or refunding the fare difference and change fee
+	ChangeOrder(ctx context.Context, in *ChangeOrderRequest, opts ...grpc.CallOption) (*ChangeOrderResponse, error)
+	// Quote what a date or seat change would cost now, before the user confirms
+	GetChangeQuote(ctx context.Context, in *ChangeOrderRequest, opts ...grpc.CallOption) (*ChangeQuote, error)
+	// Hand a passenger's ticket to another traveller, verifying their NID and collecting
+	// the transfer fee; the old ticket is cancelled and a new one issued
+	TransferPassenger(ctx context.Context, in *TransferPassengerRequest, opts ...grpc.CallOption) (*TransferPassengerResponse, error)
+	// Quote what transferring a passenger's ticket would cost now, before the user confirms
+	GetTransferQuote(ctx context.Context, in *GetTransferQuoteRequest, opts ...grpc.CallOption) (*TransferQuote, error)
+	// Ticket transfer history by order, user or NID, for spotting resold tickets (staff)
+	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	// --- Guest access by PNR and the contact the order was booked with ---
+	GetOrderByPNR(ctx context.Context, in *GetOrderByPNRRequest, opts ...grpc.CallOption) (*Order, error)
+	GetRefundQuoteByPNR(ctx context.Context, in *GetOrderByPNRRequest, opts ...grpc.CallOption) (*GetRefundQuoteResponse, error)
+	CancelOrderByPNR(ctx context.Context, in *CancelOrderByPNRRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	// Create or replace the policy for an organization, route and vehicle class
+	SetRefundPolicy(ctx context.Context, in *RefundPolicy, opts ...grpc.CallOption) (*RefundPolicy, error)
+	ListRefundPolicies(ctx context.Context, in *ListRefundPoliciesRequest, opts ...grpc.CallOption) (*ListRefundPoliciesResponse, error)
+	DeleteRefundPolicy(ctx context.Context, in *DeleteRefundPolicyRequest, opts ...grpc.CallOption) (*DeleteRefundPolicyResponse, error)
+	// List persisted sagas by status, age and organization
+	ListSagas(ctx context.Context, in *ListSagasRequest, opts ...grpc.CallOption) (*ListSagasResponse, error)
+	// Inspect a saga's steps, references and admin audit trail
+	GetSaga(ctx context.Context, in *GetSagaRequest, opts ...grpc.CallOption) (*GetSagaResponse, error)
+	// Force a saga to run again from a step
+	RetrySaga(ctx context.Context, in *RetrySagaRequest, opts ...grpc.CallOption) (*SagaActionResponse, error)
+	// Force a saga to undo every step still in effect
+	CompensateSaga(ctx context.Context, in *CompensateSagaRequest, opts ...grpc.CallOption) (*SagaActionResponse, error)
+	// Close a saga staff fixed by hand
+	ResolveSaga(ctx context.Context, in *ResolveSagaRequest, opts ...grpc.CallOption) (*SagaActionResponse, error)
+	// List the orders the fraud check held for manual review, oldest first
+	ListFraudReviews(ctx context.Context, in *ListFraudReviewsRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	// Approve a held order, which resumes its booking, or reject it, which releases its seats
+	ReviewOrder(ctx context.Context, in *ReviewOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	// Open a till session with the cash already in the drawer
+	OpenShift(ctx context.Context, in *OpenShiftRequest, opts ...grpc.CallOption) (*AgentShift, error)
+	// Report on the agent's open shift so far
+	GetCurrentShift(ctx context.Context, in *GetCurrentShiftRequest, opts ...grpc.CallOption) (*ShiftReport, error)
+	// Report on any of an organization's shifts
+	GetShiftReport(ctx context.Context, in *GetShiftReportRequest, opts ...grpc.CallOption) (*ShiftReport, error)
+	// Close the agent's open shift with the counted cash, returning the closing report
+	CloseShift(ctx context.Context, in *CloseShiftRequest, opts ...grpc.CallOption) (*ShiftReport, error)
+	// Create or update a corporate or group account
+	SaveCorporateAccount(ctx context.Context, in *SaveCorporateAccountRequest, opts ...grpc.CallOption) (*CorporateAccount, error)
+	GetCorporateAccount(ctx context.Context, in *GetCorporateAccountRequest, opts ...grpc.CallOption) (*CorporateAccount, error)
+	ListCorporateAccounts(ctx context.Context, in *ListCorporateAccountsRequest, opts ...grpc.CallOption) (*ListCorporateAccountsResponse, error)
+	// An account's monthly invoices, newest first
+	ListAccountInvoices(ctx context.Context, in *ListAccountInvoicesRequest, opts ...grpc.CallOption) (*ListAccountInvoicesResponse, error)
+	GetAccountInvoice(ctx context.Context, in *GetAccountInvoiceRequest, opts ...grpc.CallOption) (*AccountInvoice, error)
+	// Record an invoice's payment, giving its total back to the account's credit
+	MarkInvoicePaid(ctx context.Context, in *MarkInvoicePaidRequest, opts ...grpc.CallOption) (*AccountInvoice, error)
 }
 
 type orderServiceClient struct {
@@ -115,6 +200,306 @@ func (c *orderServiceClient) RetryOrder(ctx context.Context, in *RetryOrderReque
 	return out, nil
 }
 
+func (c *orderServiceClient) CancelPassengers(ctx context.Context, in *CancelPassengersRequest, opts ...grpc.CallOption) (*CancelPassengersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPassengersResponse)
+	err := c.cc.Invoke(ctx, OrderService_CancelPassengers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetRefundQuote(ctx context.Context, in *GetRefundQuoteRequest, opts ...grpc.CallOption) (*GetRefundQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRefundQuoteResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetRefundQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ChangeOrder(ctx context.Context, in *ChangeOrderRequest, opts ...grpc.CallOption) (*ChangeOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_ChangeOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetChangeQuote(ctx context.Context, in *ChangeOrderRequest, opts ...grpc.CallOption) (*ChangeQuote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeQuote)
+	err := c.cc.Invoke(ctx, OrderService_GetChangeQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) TransferPassenger(ctx context.Context, in *TransferPassengerRequest, opts ...grpc.CallOption) (*TransferPassengerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferPassengerResponse)
+	err := c.cc.Invoke(ctx, OrderService_TransferPassenger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetTransferQuote(ctx context.Context, in *GetTransferQuoteRequest, opts ...grpc.CallOption) (*TransferQuote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferQuote)
+	err := c.cc.Invoke(ctx, OrderService_GetTransferQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransfersResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderByPNR(ctx context.Context, in *GetOrderByPNRRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderByPNR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetRefundQuoteByPNR(ctx context.Context, in *GetOrderByPNRRequest, opts ...grpc.CallOption) (*GetRefundQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRefundQuoteResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetRefundQuoteByPNR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CancelOrderByPNR(ctx context.Context, in *CancelOrderByPNRRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_CancelOrderByPNR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SetRefundPolicy(ctx context.Context, in *RefundPolicy, opts ...grpc.CallOption) (*RefundPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundPolicy)
+	err := c.cc.Invoke(ctx, OrderService_SetRefundPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListRefundPolicies(ctx context.Context, in *ListRefundPoliciesRequest, opts ...grpc.CallOption) (*ListRefundPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRefundPoliciesResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListRefundPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) DeleteRefundPolicy(ctx context.Context, in *DeleteRefundPolicyRequest, opts ...grpc.CallOption) (*DeleteRefundPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRefundPolicyResponse)
+	err := c.cc.Invoke(ctx, OrderService_DeleteRefundPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListSagas(ctx context.Context, in *ListSagasRequest, opts ...grpc.CallOption) (*ListSagasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSagasResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListSagas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetSaga(ctx context.Context, in *GetSagaRequest, opts ...grpc.CallOption) (*GetSagaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSagaResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetSaga_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RetrySaga(ctx context.Context, in *RetrySagaRequest, opts ...grpc.CallOption) (*SagaActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SagaActionResponse)
+	err := c.cc.Invoke(ctx, OrderService_RetrySaga_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CompensateSaga(ctx context.Context, in *CompensateSagaRequest, opts ...grpc.CallOption) (*SagaActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SagaActionResponse)
+	err := c.cc.Invoke(ctx, OrderService_CompensateSaga_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ResolveSaga(ctx context.Context, in *ResolveSagaRequest, opts ...grpc.CallOption) (*SagaActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SagaActionResponse)
+	err := c.cc.Invoke(ctx, OrderService_ResolveSaga_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListFraudReviews(ctx context.Context, in *ListFraudReviewsRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListFraudReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ReviewOrder(ctx context.Context, in *ReviewOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, OrderService_ReviewOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OpenShift(ctx context.Context, in *OpenShiftRequest, opts ...grpc.CallOption) (*AgentShift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentShift)
+	err := c.cc.Invoke(ctx, OrderService_OpenShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetCurrentShift(ctx context.Context, in *GetCurrentShiftRequest, opts ...grpc.CallOption) (*ShiftReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftReport)
+	err := c.cc.Invoke(ctx, OrderService_GetCurrentShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetShiftReport(ctx context.Context, in *GetShiftReportRequest, opts ...grpc.CallOption) (*ShiftReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftReport)
+	err := c.cc.Invoke(ctx, OrderService_GetShiftReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CloseShift(ctx context.Context, in *CloseShiftRequest, opts ...grpc.CallOption) (*ShiftReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftReport)
+	err := c.cc.Invoke(ctx, OrderService_CloseShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SaveCorporateAccount(ctx context.Context, in *SaveCorporateAccountRequest, opts ...grpc.CallOption) (*CorporateAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorporateAccount)
+	err := c.cc.Invoke(ctx, OrderService_SaveCorporateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetCorporateAccount(ctx context.Context, in *GetCorporateAccountRequest, opts ...grpc.CallOption) (*CorporateAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorporateAccount)
+	err := c.cc.Invoke(ctx, OrderService_GetCorporateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListCorporateAccounts(ctx context.Context, in *ListCorporateAccountsRequest, opts ...grpc.CallOption) (*ListCorporateAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCorporateAccountsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListCorporateAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListAccountInvoices(ctx context.Context, in *ListAccountInvoicesRequest, opts ...grpc.CallOption) (*ListAccountInvoicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountInvoicesResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListAccountInvoices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetAccountInvoice(ctx context.Context, in *GetAccountInvoiceRequest, opts ...grpc.CallOption) (*AccountInvoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountInvoice)
+	err := c.cc.Invoke(ctx, OrderService_GetAccountInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) MarkInvoicePaid(ctx context.Context, in *MarkInvoicePaidRequest, opts ...grpc.CallOption) (*AccountInvoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountInvoice)
+	err := c.cc.Invoke(ctx, OrderService_MarkInvoicePaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -133,6 +518,61 @@ type OrderServiceServer interface {
 	GetOrderStatus(context.Context, *GetOrderStatusRequest) (*OrderStatusResponse, error)
 	// Retry failed order (resume saga)
 	RetryOrder(context.Context, *RetryOrderRequest) (*RetryOrderResponse, error)
+	// Cancel some passengers of an order; the rest of the order stays confirmed
+	CancelPassengers(context.Context, *CancelPassengersRequest) (*CancelPassengersResponse, error)
+	// Quote the refund of cancelling an order (or some of its passengers) now, before the user confirms
+	GetRefundQuote(context.Context, *GetRefundQuoteRequest) (*GetRefundQuoteResponse, error)
+	// Move a confirmed order to another trip of its route or to other seats, collecting
+	// or refunding the fare difference and change fee
+	ChangeOrder(context.Context, *ChangeOrderRequest) (*ChangeOrderResponse, error)
+	// Quote what a date or seat change would cost now, before the user confirms
+	GetChangeQuote(context.Context, *ChangeOrderRequest) (*ChangeQuote, error)
+	// Hand a passenger's ticket to another traveller, verifying their NID and collecting
+	// the transfer fee; the old ticket is cancelled and a new one issued
+	TransferPassenger(context.Context, *TransferPassengerRequest) (*TransferPassengerResponse, error)
+	// Quote what transferring a passenger's ticket would cost now, before the user confirms
+	GetTransferQuote(context.Context, *GetTransferQuoteRequest) (*TransferQuote, error)
+	// Ticket transfer history by order, user or NID, for spotting resold tickets (staff)
+	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	// --- Guest access by PNR and the contact the order was booked with ---
+	GetOrderByPNR(context.Context, *GetOrderByPNRRequest) (*Order, error)
+	GetRefundQuoteByPNR(context.Context, *GetOrderByPNRRequest) (*GetRefundQuoteResponse, error)
+	CancelOrderByPNR(context.Context, *CancelOrderByPNRRequest) (*CancelOrderResponse, error)
+	// Create or replace the policy for an organization, route and vehicle class
+	SetRefundPolicy(context.Context, *RefundPolicy) (*RefundPolicy, error)
+	ListRefundPolicies(context.Context, *ListRefundPoliciesRequest) (*ListRefundPoliciesResponse, error)
+	DeleteRefundPolicy(context.Context, *DeleteRefundPolicyRequest) (*DeleteRefundPolicyResponse, error)
+	// List persisted sagas by status, age and organization
+	ListSagas(context.Context, *ListSagasRequest) (*ListSagasResponse, error)
+	// Inspect a saga's steps, references and admin audit trail
+	GetSaga(context.Context, *GetSagaRequest) (*GetSagaResponse, error)
+	// Force a saga to run again from a step
+	RetrySaga(context.Context, *RetrySagaRequest) (*SagaActionResponse, error)
+	// Force a saga to undo every step still in effect
+	CompensateSaga(context.Context, *CompensateSagaRequest) (*SagaActionResponse, error)
+	// Close a saga staff fixed by hand
+	ResolveSaga(context.Context, *ResolveSagaRequest) (*SagaActionResponse, error)
+	// List the orders the fraud check held for manual review, oldest first
+	ListFraudReviews(context.Context, *ListFraudReviewsRequest) (*ListOrdersResponse, error)
+	// Approve a held order, which resumes its booking, or reject it, which releases its seats
+	ReviewOrder(context.Context, *ReviewOrderRequest) (*Order, error)
+	// Open a till session with the cash already in the drawer
+	OpenShift(context.Context, *OpenShiftRequest) (*AgentShift, error)
+	// Report on the agent's open shift so far
+	GetCurrentShift(context.Context, *GetCurrentShiftRequest) (*ShiftReport, error)
+	// Report on any of an organization's shifts
+	GetShiftReport(context.Context, *GetShiftReportRequest) (*ShiftReport, error)
+	// Close the agent's open shift with the counted cash, returning the closing report
+	CloseShift(context.Context, *CloseShiftRequest) (*ShiftReport, error)
+	// Create or update a corporate or group account
+	SaveCorporateAccount(context.Context, *SaveCorporateAccountRequest) (*CorporateAccount, error)
+	GetCorporateAccount(context.Context, *GetCorporateAccountRequest) (*CorporateAccount, error)
+	ListCorporateAccounts(context.Context, *ListCorporateAccountsRequest) (*ListCorporateAccountsResponse, error)
+	// An account's monthly invoices, newest first
+	ListAccountInvoices(context.Context, *ListAccountInvoicesRequest) (*ListAccountInvoicesResponse, error)
+	GetAccountInvoice(context.Context, *GetAccountInvoiceRequest) (*AccountInvoice, error)
+	// Record an invoice's payment, giving its total back to the account's credit
+	MarkInvoicePaid(context.Context, *MarkInvoicePaidRequest) (*AccountInvoice, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -161,6 +601,96 @@ func (UnimplementedOrderServiceServer) GetOrderStatus(context.Context, *GetOrder
 func (UnimplementedOrderServiceServer) RetryOrder(context.Context, *RetryOrderRequest) (*RetryOrderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RetryOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) CancelPassengers(context.Context, *CancelPassengersRequest) (*CancelPassengersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelPassengers not implemented")
+}
+func (UnimplementedOrderServiceServer) GetRefundQuote(context.Context, *GetRefundQuoteRequest) (*GetRefundQuoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRefundQuote not implemented")
+}
+func (UnimplementedOrderServiceServer) ChangeOrder(context.Context, *ChangeOrderRequest) (*ChangeOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) GetChangeQuote(context.Context, *ChangeOrderRequest) (*ChangeQuote, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChangeQuote not implemented")
+}
+func (UnimplementedOrderServiceServer) TransferPassenger(context.Context, *TransferPassengerRequest) (*TransferPassengerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferPassenger not implemented")
+}
+func (UnimplementedOrderServiceServer) GetTransferQuote(context.Context, *GetTransferQuoteRequest) (*TransferQuote, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransferQuote not implemented")
+}
+func (UnimplementedOrderServiceServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderByPNR(context.Context, *GetOrderByPNRRequest) (*Order, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOrderByPNR not implemented")
+}
+func (UnimplementedOrderServiceServer) GetRefundQuoteByPNR(context.Context, *GetOrderByPNRRequest) (*GetRefundQuoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRefundQuoteByPNR not implemented")
+}
+func (UnimplementedOrderServiceServer) CancelOrderByPNR(context.Context, *CancelOrderByPNRRequest) (*CancelOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelOrderByPNR not implemented")
+}
+func (UnimplementedOrderServiceServer) SetRefundPolicy(context.Context, *RefundPolicy) (*RefundPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRefundPolicy not implemented")
+}
+func (UnimplementedOrderServiceServer) ListRefundPolicies(context.Context, *ListRefundPoliciesRequest) (*ListRefundPoliciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRefundPolicies not implemented")
+}
+func (UnimplementedOrderServiceServer) DeleteRefundPolicy(context.Context, *DeleteRefundPolicyRequest) (*DeleteRefundPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRefundPolicy not implemented")
+}
+func (UnimplementedOrderServiceServer) ListSagas(context.Context, *ListSagasRequest) (*ListSagasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSagas not implemented")
+}
+func (UnimplementedOrderServiceServer) GetSaga(context.Context, *GetSagaRequest) (*GetSagaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSaga not implemented")
+}
+func (UnimplementedOrderServiceServer) RetrySaga(context.Context, *RetrySagaRequest) (*SagaActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetrySaga not implemented")
+}
+func (UnimplementedOrderServiceServer) CompensateSaga(context.Context, *CompensateSagaRequest) (*SagaActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompensateSaga not implemented")
+}
+func (UnimplementedOrderServiceServer) ResolveSaga(context.Context, *ResolveSagaRequest) (*SagaActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveSaga not implemented")
+}
+func (UnimplementedOrderServiceServer) ListFraudReviews(context.Context, *ListFraudReviewsRequest) (*ListOrdersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFraudReviews not implemented")
+}
+func (UnimplementedOrderServiceServer) ReviewOrder(context.Context, *ReviewOrderRequest) (*Order, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) OpenShift(context.Context, *OpenShiftRequest) (*AgentShift, error) {
+	return nil, status.Error(codes.Unimplemented, "method OpenShift not implemented")
+}
+func (UnimplementedOrderServiceServer) GetCurrentShift(context.Context, *GetCurrentShiftRequest) (*ShiftReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCurrentShift not implemented")
+}
+func (UnimplementedOrderServiceServer) GetShiftReport(context.Context, *GetShiftReportRequest) (*ShiftReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetShiftReport not implemented")
+}
+func (UnimplementedOrderServiceServer) CloseShift(context.Context, *CloseShiftRequest) (*ShiftReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseShift not implemented")
+}
+func (UnimplementedOrderServiceServer) SaveCorporateAccount(context.Context, *SaveCorporateAccountRequest) (*CorporateAccount, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveCorporateAccount not implemented")
+}
+func (UnimplementedOrderServiceServer) GetCorporateAccount(context.Context, *GetCorporateAccountRequest) (*CorporateAccount, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCorporateAccount not implemented")
+}
+func (UnimplementedOrderServiceServer) ListCorporateAccounts(context.Context, *ListCorporateAccountsRequest) (*ListCorporateAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCorporateAccounts not implemented")
+}
+func (UnimplementedOrderServiceServer) ListAccountInvoices(context.Context, *ListAccountInvoicesRequest) (*ListAccountInvoicesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccountInvoices not implemented")
+}
+func (UnimplementedOrderServiceServer) GetAccountInvoice(context.Context, *GetAccountInvoiceRequest) (*AccountInvoice, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountInvoice not implemented")
+}
+func (UnimplementedOrderServiceServer) MarkInvoicePaid(context.Context, *MarkInvoicePaidRequest) (*AccountInvoice, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkInvoicePaid not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -290,18 +820,558 @@ func _OrderService_RetryOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var OrderService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "order.v1.OrderService",
-	HandlerType: (*OrderServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateOrder",
-			Handler:    _OrderService_CreateOrder_Handler,
-		},
-		{
+func _OrderService_CancelPassengers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPassengersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelPassengers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CancelPassengers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelPassengers(ctx, req.(*CancelPassengersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetRefundQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefundQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetRefundQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetRefundQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetRefundQuote(ctx, req.(*GetRefundQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ChangeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ChangeOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ChangeOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ChangeOrder(ctx, req.(*ChangeOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetChangeQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetChangeQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetChangeQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetChangeQuote(ctx, req.(*ChangeOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_TransferPassenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferPassengerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).TransferPassenger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_TransferPassenger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).TransferPassenger(ctx, req.(*TransferPassengerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetTransferQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetTransferQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetTransferQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetTransferQuote(ctx, req.(*GetTransferQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderByPNR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderByPNRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderByPNR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderByPNR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderByPNR(ctx, req.(*GetOrderByPNRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetRefundQuoteByPNR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderByPNRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetRefundQuoteByPNR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetRefundQuoteByPNR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetRefundQuoteByPNR(ctx, req.(*GetOrderByPNRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CancelOrderByPNR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderByPNRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelOrderByPNR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CancelOrderByPNR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelOrderByPNR(ctx, req.(*CancelOrderByPNRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SetRefundPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SetRefundPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SetRefundPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SetRefundPolicy(ctx, req.(*RefundPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListRefundPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefundPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListRefundPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListRefundPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListRefundPolicies(ctx, req.(*ListRefundPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_DeleteRefundPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRefundPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).DeleteRefundPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_DeleteRefundPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).DeleteRefundPolicy(ctx, req.(*DeleteRefundPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListSagas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSagasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListSagas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListSagas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListSagas(ctx, req.(*ListSagasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetSaga_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSagaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetSaga(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetSaga_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetSaga(ctx, req.(*GetSagaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RetrySaga_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrySagaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RetrySaga(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RetrySaga_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RetrySaga(ctx, req.(*RetrySagaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CompensateSaga_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompensateSagaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CompensateSaga(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CompensateSaga_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CompensateSaga(ctx, req.(*CompensateSagaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ResolveSaga_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveSagaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ResolveSaga(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ResolveSaga_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ResolveSaga(ctx, req.(*ResolveSagaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListFraudReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFraudReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListFraudReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListFraudReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListFraudReviews(ctx, req.(*ListFraudReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ReviewOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ReviewOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ReviewOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ReviewOrder(ctx, req.(*ReviewOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OpenShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OpenShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OpenShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OpenShift(ctx, req.(*OpenShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetCurrentShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetCurrentShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetCurrentShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetCurrentShift(ctx, req.(*GetCurrentShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetShiftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShiftReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetShiftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetShiftReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetShiftReport(ctx, req.(*GetShiftReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CloseShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CloseShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CloseShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CloseShift(ctx, req.(*CloseShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SaveCorporateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCorporateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SaveCorporateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SaveCorporateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SaveCorporateAccount(ctx, req.(*SaveCorporateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetCorporateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCorporateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetCorporateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetCorporateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetCorporateAccount(ctx, req.(*GetCorporateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListCorporateAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCorporateAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListCorporateAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListCorporateAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListCorporateAccounts(ctx, req.(*ListCorporateAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListAccountInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListAccountInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListAccountInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListAccountInvoices(ctx, req.(*ListAccountInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetAccountInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetAccountInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetAccountInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetAccountInvoice(ctx, req.(*GetAccountInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_MarkInvoicePaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkInvoicePaidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).MarkInvoicePaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_MarkInvoicePaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).MarkInvoicePaid(ctx, req.(*MarkInvoicePaidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "order.v1.OrderService",
+	HandlerType: (*OrderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrder",
+			Handler:    _OrderService_CreateOrder_Handler,
+		},
+		{
 			MethodName: "GetOrder",
 			Handler:    _OrderService_GetOrder_Handler,
 		},
@@ -321,6 +1391,126 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RetryOrder",
 			Handler:    _OrderService_RetryOrder_Handler,
 		},
+		{
+			MethodName: "CancelPassengers",
+			Handler:    _OrderService_CancelPassengers_Handler,
+		},
+		{
+			MethodName: "GetRefundQuote",
+			Handler:    _OrderService_GetRefundQuote_Handler,
+		},
+		{
+			MethodName: "ChangeOrder",
+			Handler:    _OrderService_ChangeOrder_Handler,
+		},
+		{
+			MethodName: "GetChangeQuote",
+			Handler:    _OrderService_GetChangeQuote_Handler,
+		},
+		{
+			MethodName: "TransferPassenger",
+			Handler:    _OrderService_TransferPassenger_Handler,
+		},
+		{
+			MethodName: "GetTransferQuote",
+			Handler:    _OrderService_GetTransferQuote_Handler,
+		},
+		{
+			MethodName: "ListTransfers",
+			Handler:    _OrderService_ListTransfers_Handler,
+		},
+		{
+			MethodName: "GetOrderByPNR",
+			Handler:    _OrderService_GetOrderByPNR_Handler,
+		},
+		{
+			MethodName: "GetRefundQuoteByPNR",
+			Handler:    _OrderService_GetRefundQuoteByPNR_Handler,
+		},
+		{
+			MethodName: "CancelOrderByPNR",
+			Handler:    _OrderService_CancelOrderByPNR_Handler,
+		},
+		{
+			MethodName: "SetRefundPolicy",
+			Handler:    _OrderService_SetRefundPolicy_Handler,
+		},
+		{
+			MethodName: "ListRefundPolicies",
+			Handler:    _OrderService_ListRefundPolicies_Handler,
+		},
+		{
+			MethodName: "DeleteRefundPolicy",
+			Handler:    _OrderService_DeleteRefundPolicy_Handler,
+		},
+		{
+			MethodName: "ListSagas",
+			Handler:    _OrderService_ListSagas_Handler,
+		},
+		{
+			MethodName: "GetSaga",
+			Handler:    _OrderService_GetSaga_Handler,
+		},
+		{
+			MethodName: "RetrySaga",
+			Handler:    _OrderService_RetrySaga_Handler,
+		},
+		{
+			MethodName: "CompensateSaga",
+			Handler:    _OrderService_CompensateSaga_Handler,
+		},
+		{
+			MethodName: "ResolveSaga",
+			Handler:    _OrderService_ResolveSaga_Handler,
+		},
+		{
+			MethodName: "ListFraudReviews",
+			Handler:    _OrderService_ListFraudReviews_Handler,
+		},
+		{
+			MethodName: "ReviewOrder",
+			Handler:    _OrderService_ReviewOrder_Handler,
+		},
+		{
+			MethodName: "OpenShift",
+			Handler:    _OrderService_OpenShift_Handler,
+		},
+		{
+			MethodName: "GetCurrentShift",
+			Handler:    _OrderService_GetCurrentShift_Handler,
+		},
+		{
+			MethodName: "GetShiftReport",
+			Handler:    _OrderService_GetShiftReport_Handler,
+		},
+		{
+			MethodName: "CloseShift",
+			Handler:    _OrderService_CloseShift_Handler,
+		},
+		{
+			MethodName: "SaveCorporateAccount",
+			Handler:    _OrderService_SaveCorporateAccount_Handler,
+		},
+		{
+			MethodName: "GetCorporateAccount",
+			Handler:    _OrderService_GetCorporateAccount_Handler,
+		},
+		{
+			MethodName: "ListCorporateAccounts",
+			Handler:    _OrderService_ListCorporateAccounts_Handler,
+		},
+		{
+			MethodName: "ListAccountInvoices",
+			Handler:    _OrderService_ListAccountInvoices_Handler,
+		},
+		{
+			MethodName: "GetAccountInvoice",
+			Handler:    _OrderService_GetAccountInvoice_Handler,
+		},
+		{
+			MethodName: "MarkInvoicePaid",
+			Handler:    _OrderService_MarkInvoicePaid_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/order/v1/order.proto",
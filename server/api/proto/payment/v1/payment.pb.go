@@ -1,8 +1,8 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.11
-// 	protoc        v6.33.2
-// source: payment/v1/payment.proto
+// 	protoc        v3.21.12
+// source: api/proto/payment/v1/payment.proto
 
 package v1
 
@@ -41,7 +41,7 @@ type CreatePaymentRequest struct {
 
 func (x *CreatePaymentRequest) Reset() {
 	*x = CreatePaymentRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[0]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -53,7 +53,7 @@ func (x *CreatePaymentRequest) String() string {
 func (*CreatePaymentRequest) ProtoMessage() {}
 
 func (x *CreatePaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[0]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -66,7 +66,7 @@ func (x *CreatePaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentRequest.ProtoReflect.Descriptor instead.
 func (*CreatePaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *CreatePaymentRequest) GetOrderId() string {
@@ -166,7 +166,7 @@ type CreatePaymentResponse struct {
 
 func (x *CreatePaymentResponse) Reset() {
 	*x = CreatePaymentResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[1]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -178,7 +178,7 @@ func (x *CreatePaymentResponse) String() string {
 func (*CreatePaymentResponse) ProtoMessage() {}
 
 func (x *CreatePaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[1]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,7 +191,7 @@ func (x *CreatePaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentResponse.ProtoReflect.Descriptor instead.
 func (*CreatePaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *CreatePaymentResponse) GetPaymentId() string {
@@ -239,7 +239,7 @@ type VerifyPaymentRequest struct {
 
 func (x *VerifyPaymentRequest) Reset() {
 	*x = VerifyPaymentRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -251,7 +251,7 @@ func (x *VerifyPaymentRequest) String() string {
 func (*VerifyPaymentRequest) ProtoMessage() {}
 
 func (x *VerifyPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +264,7 @@ func (x *VerifyPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyPaymentRequest.ProtoReflect.Descriptor instead.
 func (*VerifyPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *VerifyPaymentRequest) GetGateway() string {
@@ -291,7 +291,7 @@ type CapturePaymentRequest struct {
 
 func (x *CapturePaymentRequest) Reset() {
 	*x = CapturePaymentRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -303,7 +303,7 @@ func (x *CapturePaymentRequest) String() string {
 func (*CapturePaymentRequest) ProtoMessage() {}
 
 func (x *CapturePaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +316,7 @@ func (x *CapturePaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CapturePaymentRequest.ProtoReflect.Descriptor instead.
 func (*CapturePaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CapturePaymentRequest) GetGateway() string {
@@ -349,7 +349,7 @@ type PaymentStatusResponse struct {
 
 func (x *PaymentStatusResponse) Reset() {
 	*x = PaymentStatusResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[4]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -361,7 +361,7 @@ func (x *PaymentStatusResponse) String() string {
 func (*PaymentStatusResponse) ProtoMessage() {}
 
 func (x *PaymentStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[4]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +374,7 @@ func (x *PaymentStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentStatusResponse.ProtoReflect.Descriptor instead.
 func (*PaymentStatusResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PaymentStatusResponse) GetTransactionId() string {
@@ -445,7 +445,7 @@ type RefundPaymentRequest struct {
 
 func (x *RefundPaymentRequest) Reset() {
 	*x = RefundPaymentRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +457,7 @@ func (x *RefundPaymentRequest) String() string {
 func (*RefundPaymentRequest) ProtoMessage() {}
 
 func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +470,7 @@ func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
 func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RefundPaymentRequest) GetGateway() string {
@@ -501,6 +501,59 @@ func (x *RefundPaymentRequest) GetReason() string {
 	return ""
 }
 
+// Cancels the pending payment of an order that was abandoned before it was paid
+type CancelPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPaymentRequest) Reset() {
+	*x = CancelPaymentRequest{}
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentRequest) ProtoMessage() {}
+
+func (x *CancelPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentRequest.ProtoReflect.Descriptor instead.
+func (*CancelPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelPaymentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type RefundResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RefundId      string                 `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
@@ -513,7 +566,7 @@ type RefundResponse struct {
 
 func (x *RefundResponse) Reset() {
 	*x = RefundResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -525,7 +578,7 @@ func (x *RefundResponse) String() string {
 func (*RefundResponse) ProtoMessage() {}
 
 func (x *RefundResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +591,7 @@ func (x *RefundResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefundResponse.ProtoReflect.Descriptor instead.
 func (*RefundResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RefundResponse) GetRefundId() string {
@@ -579,7 +632,7 @@ type IPNRequest struct {
 
 func (x *IPNRequest) Reset() {
 	*x = IPNRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +644,7 @@ func (x *IPNRequest) String() string {
 func (*IPNRequest) ProtoMessage() {}
 
 func (x *IPNRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +657,7 @@ func (x *IPNRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPNRequest.ProtoReflect.Descriptor instead.
 func (*IPNRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *IPNRequest) GetGateway() string {
@@ -633,7 +686,7 @@ type IPNResponse struct {
 
 func (x *IPNResponse) Reset() {
 	*x = IPNResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -645,7 +698,7 @@ func (x *IPNResponse) String() string {
 func (*IPNResponse) ProtoMessage() {}
 
 func (x *IPNResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +711,7 @@ func (x *IPNResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPNResponse.ProtoReflect.Descriptor instead.
 func (*IPNResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *IPNResponse) GetValid() bool {
@@ -701,7 +754,7 @@ type UpdatePaymentConfigRequest struct {
 
 func (x *UpdatePaymentConfigRequest) Reset() {
 	*x = UpdatePaymentConfigRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -713,7 +766,7 @@ func (x *UpdatePaymentConfigRequest) String() string {
 func (*UpdatePaymentConfigRequest) ProtoMessage() {}
 
 func (x *UpdatePaymentConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +779,7 @@ func (x *UpdatePaymentConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePaymentConfigRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePaymentConfigRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdatePaymentConfigRequest) GetOrganizationId() string {
@@ -767,7 +820,7 @@ type UpdatePaymentConfigResponse struct {
 
 func (x *UpdatePaymentConfigResponse) Reset() {
 	*x = UpdatePaymentConfigResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -779,7 +832,7 @@ func (x *UpdatePaymentConfigResponse) String() string {
 func (*UpdatePaymentConfigResponse) ProtoMessage() {}
 
 func (x *UpdatePaymentConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +845,7 @@ func (x *UpdatePaymentConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePaymentConfigResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePaymentConfigResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{10}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdatePaymentConfigResponse) GetSuccess() bool {
@@ -818,7 +871,7 @@ type GetPaymentConfigRequest struct {
 
 func (x *GetPaymentConfigRequest) Reset() {
 	*x = GetPaymentConfigRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -830,7 +883,7 @@ func (x *GetPaymentConfigRequest) String() string {
 func (*GetPaymentConfigRequest) ProtoMessage() {}
 
 func (x *GetPaymentConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +896,7 @@ func (x *GetPaymentConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetPaymentConfigRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{11}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetPaymentConfigRequest) GetOrganizationId() string {
@@ -864,7 +917,7 @@ type GetPaymentConfigResponse struct {
 
 func (x *GetPaymentConfigResponse) Reset() {
 	*x = GetPaymentConfigResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -876,7 +929,7 @@ func (x *GetPaymentConfigResponse) String() string {
 func (*GetPaymentConfigResponse) ProtoMessage() {}
 
 func (x *GetPaymentConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	mi := &file_api_proto_payment_v1_payment_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,7 +942,7 @@ func (x *GetPaymentConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetPaymentConfigResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_payment_v1_payment_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetPaymentConfigResponse) GetOrganizationId() string {
@@ -913,11 +966,11 @@ func (x *GetPaymentConfigResponse) GetIsActive() bool {
 	return false
 }
 
-var File_payment_v1_payment_proto protoreflect.FileDescriptor
+var File_api_proto_payment_v1_payment_proto protoreflect.FileDescriptor
 
-const file_payment_v1_payment_proto_rawDesc = "" +
+const file_api_proto_payment_v1_payment_proto_rawDesc = "" +
 	"\n" +
-	"\x18payment/v1/payment.proto\x12\n" +
+	"\"api/proto/payment/v1/payment.proto\x12\n" +
 	"payment.v1\"\xac\x03\n" +
 	"\x14CreatePaymentRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12!\n" +
@@ -965,7 +1018,10 @@ const file_payment_v1_payment_proto_rawDesc = "" +
 	"\agateway\x18\x01 \x01(\tR\agateway\x12%\n" +
 	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12!\n" +
 	"\famount_paisa\x18\x03 \x01(\x03R\vamountPaisa\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x8f\x01\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"I\n" +
+	"\x14CancelPaymentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x8f\x01\n" +
 	"\x0eRefundResponse\x12\x1b\n" +
 	"\trefund_id\x18\x01 \x01(\tR\brefundId\x12%\n" +
 	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12!\n" +
@@ -996,88 +1052,92 @@ const file_payment_v1_payment_proto_rawDesc = "" +
 	"\x18GetPaymentConfigResponse\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x18\n" +
 	"\agateway\x18\x02 \x01(\tR\agateway\x12\x1b\n" +
-	"\tis_active\x18\x03 \x01(\bR\bisActive2\xe8\x04\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive2\xbe\x05\n" +
 	"\x0ePaymentService\x12T\n" +
 	"\rCreatePayment\x12 .payment.v1.CreatePaymentRequest\x1a!.payment.v1.CreatePaymentResponse\x12T\n" +
 	"\rVerifyPayment\x12 .payment.v1.VerifyPaymentRequest\x1a!.payment.v1.PaymentStatusResponse\x12V\n" +
 	"\x0eCapturePayment\x12!.payment.v1.CapturePaymentRequest\x1a!.payment.v1.PaymentStatusResponse\x12M\n" +
-	"\rRefundPayment\x12 .payment.v1.RefundPaymentRequest\x1a\x1a.payment.v1.RefundResponse\x12<\n" +
+	"\rRefundPayment\x12 .payment.v1.RefundPaymentRequest\x1a\x1a.payment.v1.RefundResponse\x12T\n" +
+	"\rCancelPayment\x12 .payment.v1.CancelPaymentRequest\x1a!.payment.v1.PaymentStatusResponse\x12<\n" +
 	"\tHandleIPN\x12\x16.payment.v1.IPNRequest\x1a\x17.payment.v1.IPNResponse\x12f\n" +
 	"\x13UpdatePaymentConfig\x12&.payment.v1.UpdatePaymentConfigRequest\x1a'.payment.v1.UpdatePaymentConfigResponse\x12]\n" +
 	"\x10GetPaymentConfig\x12#.payment.v1.GetPaymentConfigRequest\x1a$.payment.v1.GetPaymentConfigResponseB:Z8github.com/MuhibNayem/Travio/server/api/proto/payment/v1b\x06proto3"
 
 var (
-	file_payment_v1_payment_proto_rawDescOnce sync.Once
-	file_payment_v1_payment_proto_rawDescData []byte
+	file_api_proto_payment_v1_payment_proto_rawDescOnce sync.Once
+	file_api_proto_payment_v1_payment_proto_rawDescData []byte
 )
 
-func file_payment_v1_payment_proto_rawDescGZIP() []byte {
-	file_payment_v1_payment_proto_rawDescOnce.Do(func() {
-		file_payment_v1_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_payment_v1_payment_proto_rawDesc), len(file_payment_v1_payment_proto_rawDesc)))
+func file_api_proto_payment_v1_payment_proto_rawDescGZIP() []byte {
+	file_api_proto_payment_v1_payment_proto_rawDescOnce.Do(func() {
+		file_api_proto_payment_v1_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_payment_v1_payment_proto_rawDesc), len(file_api_proto_payment_v1_payment_proto_rawDesc)))
 	})
-	return file_payment_v1_payment_proto_rawDescData
+	return file_api_proto_payment_v1_payment_proto_rawDescData
 }
 
-var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_payment_v1_payment_proto_goTypes = []any{
+var file_api_proto_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_api_proto_payment_v1_payment_proto_goTypes = []any{
 	(*CreatePaymentRequest)(nil),        // 0: payment.v1.CreatePaymentRequest
 	(*CreatePaymentResponse)(nil),       // 1: payment.v1.CreatePaymentResponse
 	(*VerifyPaymentRequest)(nil),        // 2: payment.v1.VerifyPaymentRequest
 	(*CapturePaymentRequest)(nil),       // 3: payment.v1.CapturePaymentRequest
 	(*PaymentStatusResponse)(nil),       // 4: payment.v1.PaymentStatusResponse
 	(*RefundPaymentRequest)(nil),        // 5: payment.v1.RefundPaymentRequest
-	(*RefundResponse)(nil),              // 6: payment.v1.RefundResponse
-	(*IPNRequest)(nil),                  // 7: payment.v1.IPNRequest
-	(*IPNResponse)(nil),                 // 8: payment.v1.IPNResponse
-	(*UpdatePaymentConfigRequest)(nil),  // 9: payment.v1.UpdatePaymentConfigRequest
-	(*UpdatePaymentConfigResponse)(nil), // 10: payment.v1.UpdatePaymentConfigResponse
-	(*GetPaymentConfigRequest)(nil),     // 11: payment.v1.GetPaymentConfigRequest
-	(*GetPaymentConfigResponse)(nil),    // 12: payment.v1.GetPaymentConfigResponse
-	nil,                                 // 13: payment.v1.UpdatePaymentConfigRequest.CredentialsEntry
-}
-var file_payment_v1_payment_proto_depIdxs = []int32{
-	13, // 0: payment.v1.UpdatePaymentConfigRequest.credentials:type_name -> payment.v1.UpdatePaymentConfigRequest.CredentialsEntry
+	(*CancelPaymentRequest)(nil),        // 6: payment.v1.CancelPaymentRequest
+	(*RefundResponse)(nil),              // 7: payment.v1.RefundResponse
+	(*IPNRequest)(nil),                  // 8: payment.v1.IPNRequest
+	(*IPNResponse)(nil),                 // 9: payment.v1.IPNResponse
+	(*UpdatePaymentConfigRequest)(nil),  // 10: payment.v1.UpdatePaymentConfigRequest
+	(*UpdatePaymentConfigResponse)(nil), // 11: payment.v1.UpdatePaymentConfigResponse
+	(*GetPaymentConfigRequest)(nil),     // 12: payment.v1.GetPaymentConfigRequest
+	(*GetPaymentConfigResponse)(nil),    // 13: payment.v1.GetPaymentConfigResponse
+	nil,                                 // 14: payment.v1.UpdatePaymentConfigRequest.CredentialsEntry
+}
+var file_api_proto_payment_v1_payment_proto_depIdxs = []int32{
+	14, // 0: payment.v1.UpdatePaymentConfigRequest.credentials:type_name -> payment.v1.UpdatePaymentConfigRequest.CredentialsEntry
 	0,  // 1: payment.v1.PaymentService.CreatePayment:input_type -> payment.v1.CreatePaymentRequest
 	2,  // 2: payment.v1.PaymentService.VerifyPayment:input_type -> payment.v1.VerifyPaymentRequest
 	3,  // 3: payment.v1.PaymentService.CapturePayment:input_type -> payment.v1.CapturePaymentRequest
 	5,  // 4: payment.v1.PaymentService.RefundPayment:input_type -> payment.v1.RefundPaymentRequest
-	7,  // 5: payment.v1.PaymentService.HandleIPN:input_type -> payment.v1.IPNRequest
-	9,  // 6: payment.v1.PaymentService.UpdatePaymentConfig:input_type -> payment.v1.UpdatePaymentConfigRequest
-	11, // 7: payment.v1.PaymentService.GetPaymentConfig:input_type -> payment.v1.GetPaymentConfigRequest
-	1,  // 8: payment.v1.PaymentService.CreatePayment:output_type -> payment.v1.CreatePaymentResponse
-	4,  // 9: payment.v1.PaymentService.VerifyPayment:output_type -> payment.v1.PaymentStatusResponse
-	4,  // 10: payment.v1.PaymentService.CapturePayment:output_type -> payment.v1.PaymentStatusResponse
-	6,  // 11: payment.v1.PaymentService.RefundPayment:output_type -> payment.v1.RefundResponse
-	8,  // 12: payment.v1.PaymentService.HandleIPN:output_type -> payment.v1.IPNResponse
-	10, // 13: payment.v1.PaymentService.UpdatePaymentConfig:output_type -> payment.v1.UpdatePaymentConfigResponse
-	12, // 14: payment.v1.PaymentService.GetPaymentConfig:output_type -> payment.v1.GetPaymentConfigResponse
-	8,  // [8:15] is the sub-list for method output_type
-	1,  // [1:8] is the sub-list for method input_type
+	6,  // 5: payment.v1.PaymentService.CancelPayment:input_type -> payment.v1.CancelPaymentRequest
+	8,  // 6: payment.v1.PaymentService.HandleIPN:input_type -> payment.v1.IPNRequest
+	10, // 7: payment.v1.PaymentService.UpdatePaymentConfig:input_type -> payment.v1.UpdatePaymentConfigRequest
+	12, // 8: payment.v1.PaymentService.GetPaymentConfig:input_type -> payment.v1.GetPaymentConfigRequest
+	1,  // 9: payment.v1.PaymentService.CreatePayment:output_type -> payment.v1.CreatePaymentResponse
+	4,  // 10: payment.v1.PaymentService.VerifyPayment:output_type -> payment.v1.PaymentStatusResponse
+	4,  // 11: payment.v1.PaymentService.CapturePayment:output_type -> payment.v1.PaymentStatusResponse
+	7,  // 12: payment.v1.PaymentService.RefundPayment:output_type -> payment.v1.RefundResponse
+	4,  // 13: payment.v1.PaymentService.CancelPayment:output_type -> payment.v1.PaymentStatusResponse
+	9,  // 14: payment.v1.PaymentService.HandleIPN:output_type -> payment.v1.IPNResponse
+	11, // 15: payment.v1.PaymentService.UpdatePaymentConfig:output_type -> payment.v1.UpdatePaymentConfigResponse
+	13, // 16: payment.v1.PaymentService.GetPaymentConfig:output_type -> payment.v1.GetPaymentConfigResponse
+	9,  // [9:17] is the sub-list for method output_type
+	1,  // [1:9] is the sub-list for method input_type
 	1,  // [1:1] is the sub-list for extension type_name
 	1,  // [1:1] is the sub-list for extension extendee
 	0,  // [0:1] is the sub-list for field type_name
 }
 
-func init() { file_payment_v1_payment_proto_init() }
-func file_payment_v1_payment_proto_init() {
-	if File_payment_v1_payment_proto != nil {
+func init() { file_api_proto_payment_v1_payment_proto_init() }
+func file_api_proto_payment_v1_payment_proto_init() {
+	if File_api_proto_payment_v1_payment_proto != nil {
 		return
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_v1_payment_proto_rawDesc), len(file_payment_v1_payment_proto_rawDesc)),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_payment_v1_payment_proto_rawDesc), len(file_api_proto_payment_v1_payment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
-		GoTypes:           file_payment_v1_payment_proto_goTypes,
-		DependencyIndexes: file_payment_v1_payment_proto_depIdxs,
-		MessageInfos:      file_payment_v1_payment_proto_msgTypes,
+		GoTypes:           file_api_proto_payment_v1_payment_proto_goTypes,
+		DependencyIndexes: file_api_proto_payment_v1_payment_proto_depIdxs,
+		MessageInfos:      file_api_proto_payment_v1_payment_proto_msgTypes,
 	}.Build()
-	File_payment_v1_payment_proto = out.File
-	file_payment_v1_payment_proto_goTypes = nil
-	file_payment_v1_payment_proto_depIdxs = nil
+	File_api_proto_payment_v1_payment_proto = out.File
+	file_api_proto_payment_v1_payment_proto_goTypes = nil
+	file_api_proto_payment_v1_payment_proto_depIdxs = nil
 }
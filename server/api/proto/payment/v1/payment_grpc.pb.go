@@ -2,7 +2,7 @@
 // versions:
 // - protoc-gen-go-grpc v1.6.0
 // - protoc             v6.33.2
-// source: payment/v1/payment.proto
+// source: api/proto/payment/v1/payment.proto
 
 package v1
 
@@ -23,6 +23,7 @@ const (
 	PaymentService_VerifyPayment_FullMethodName       = "/payment.v1.PaymentService/VerifyPayment"
 	PaymentService_CapturePayment_FullMethodName      = "/payment.v1.PaymentService/CapturePayment"
 	PaymentService_RefundPayment_FullMethodName       = "/payment.v1.PaymentService/RefundPayment"
+	PaymentService_CancelPayment_FullMethodName       = "/payment.v1.PaymentService/CancelPayment"
 	PaymentService_HandleIPN_FullMethodName           = "/payment.v1.PaymentService/HandleIPN"
 	PaymentService_UpdatePaymentConfig_FullMethodName = "/payment.v1.PaymentService/UpdatePaymentConfig"
 	PaymentService_GetPaymentConfig_FullMethodName    = "/payment.v1.PaymentService/GetPaymentConfig"
@@ -36,6 +37,7 @@ type PaymentServiceClient interface {
 	VerifyPayment(ctx context.Context, in *VerifyPaymentRequest, opts ...grpc.CallOption) (*PaymentStatusResponse, error)
 	CapturePayment(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*PaymentStatusResponse, error)
 	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundResponse, error)
+	CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*PaymentStatusResponse, error)
 	HandleIPN(ctx context.Context, in *IPNRequest, opts ...grpc.CallOption) (*IPNResponse, error)
 	// Admin/Config RPCs
 	UpdatePaymentConfig(ctx context.Context, in *UpdatePaymentConfigRequest, opts ...grpc.CallOption) (*UpdatePaymentConfigResponse, error)
@@ -90,6 +92,16 @@ func (c *paymentServiceClient) RefundPayment(ctx context.Context, in *RefundPaym
 	return out, nil
 }
 
+func (c *paymentServiceClient) CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*PaymentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentStatusResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CancelPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) HandleIPN(ctx context.Context, in *IPNRequest, opts ...grpc.CallOption) (*IPNResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IPNResponse)
@@ -128,6 +140,7 @@ type PaymentServiceServer interface {
 	VerifyPayment(context.Context, *VerifyPaymentRequest) (*PaymentStatusResponse, error)
 	CapturePayment(context.Context, *CapturePaymentRequest) (*PaymentStatusResponse, error)
 	RefundPayment(context.Context, *RefundPaymentRequest) (*RefundResponse, error)
+	CancelPayment(context.Context, *CancelPaymentRequest) (*PaymentStatusResponse, error)
 	HandleIPN(context.Context, *IPNRequest) (*IPNResponse, error)
 	// Admin/Config RPCs
 	UpdatePaymentConfig(context.Context, *UpdatePaymentConfigRequest) (*UpdatePaymentConfigResponse, error)
@@ -154,6 +167,9 @@ func (UnimplementedPaymentServiceServer) CapturePayment(context.Context, *Captur
 func (UnimplementedPaymentServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*RefundResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RefundPayment not implemented")
 }
+func (UnimplementedPaymentServiceServer) CancelPayment(context.Context, *CancelPaymentRequest) (*PaymentStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelPayment not implemented")
+}
 func (UnimplementedPaymentServiceServer) HandleIPN(context.Context, *IPNRequest) (*IPNResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method HandleIPN not implemented")
 }
@@ -256,6 +272,24 @@ func _PaymentService_RefundPayment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_CancelPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CancelPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CancelPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CancelPayment(ctx, req.(*CancelPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_HandleIPN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IPNRequest)
 	if err := dec(in); err != nil {
@@ -333,6 +367,10 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefundPayment",
 			Handler:    _PaymentService_RefundPayment_Handler,
 		},
+		{
+			MethodName: "CancelPayment",
+			Handler:    _PaymentService_CancelPayment_Handler,
+		},
 		{
 			MethodName: "HandleIPN",
 			Handler:    _PaymentService_HandleIPN_Handler,
@@ -347,5 +385,5 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "payment/v1/payment.proto",
+	Metadata: "api/proto/payment/v1/payment.proto",
 }
@@ -158,25 +158,26 @@ func (x *SearchTripsResponse) GetTotal() int32 {
 }
 
 type TripResult struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TripId          string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	VehicleType     string                 `protobuf:"bytes,2,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"`
-	VehicleClass    string                 `protobuf:"bytes,3,opt,name=vehicle_class,json=vehicleClass,proto3" json:"vehicle_class,omitempty"`
-	DepartureTime   int64                  `protobuf:"varint,4,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"` // Unix timestamp
-	ArrivalTime     int64                  `protobuf:"varint,5,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`       // Unix timestamp
-	PricePaisa      int64                  `protobuf:"varint,6,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
-	TotalSeats      int32                  `protobuf:"varint,7,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
-	AvailableSeats  int32                  `protobuf:"varint,8,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
-	FromStationId   string                 `protobuf:"bytes,9,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
-	FromStationName string                 `protobuf:"bytes,10,opt,name=from_station_name,json=fromStationName,proto3" json:"from_station_name,omitempty"`
-	FromCity        string                 `protobuf:"bytes,11,opt,name=from_city,json=fromCity,proto3" json:"from_city,omitempty"`
-	ToStationId     string                 `protobuf:"bytes,12,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
-	ToStationName   string                 `protobuf:"bytes,13,opt,name=to_station_name,json=toStationName,proto3" json:"to_station_name,omitempty"`
-	ToCity          string                 `protobuf:"bytes,14,opt,name=to_city,json=toCity,proto3" json:"to_city,omitempty"`
-	Date            string                 `protobuf:"bytes,15,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
-	Status          string                 `protobuf:"bytes,16,opt,name=status,proto3" json:"status,omitempty"`
-	RouteId         string                 `protobuf:"bytes,17,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
-	OrganizationId  string                 `protobuf:"bytes,18,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	TripId          string                  `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	VehicleType     string                  `protobuf:"bytes,2,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"`
+	VehicleClass    string                  `protobuf:"bytes,3,opt,name=vehicle_class,json=vehicleClass,proto3" json:"vehicle_class,omitempty"`
+	DepartureTime   int64                   `protobuf:"varint,4,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"` // Unix timestamp
+	ArrivalTime     int64                   `protobuf:"varint,5,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`       // Unix timestamp
+	PricePaisa      int64                   `protobuf:"varint,6,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
+	TotalSeats      int32                   `protobuf:"varint,7,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	AvailableSeats  int32                   `protobuf:"varint,8,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	FromStationId   string                  `protobuf:"bytes,9,opt,name=from_station_id,json=fromStationId,proto3" json:"from_station_id,omitempty"`
+	FromStationName string                  `protobuf:"bytes,10,opt,name=from_station_name,json=fromStationName,proto3" json:"from_station_name,omitempty"`
+	FromCity        string                  `protobuf:"bytes,11,opt,name=from_city,json=fromCity,proto3" json:"from_city,omitempty"`
+	ToStationId     string                  `protobuf:"bytes,12,opt,name=to_station_id,json=toStationId,proto3" json:"to_station_id,omitempty"`
+	ToStationName   string                  `protobuf:"bytes,13,opt,name=to_station_name,json=toStationName,proto3" json:"to_station_name,omitempty"`
+	ToCity          string                  `protobuf:"bytes,14,opt,name=to_city,json=toCity,proto3" json:"to_city,omitempty"`
+	Date            string                  `protobuf:"bytes,15,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Status          string                  `protobuf:"bytes,16,opt,name=status,proto3" json:"status,omitempty"`
+	RouteId         string                  `protobuf:"bytes,17,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	OrganizationId  string                  `protobuf:"bytes,18,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Capacity        []*CapacityAvailability `protobuf:"bytes,19,rep,name=capacity,proto3" json:"capacity,omitempty"` // Deck and standing places, kept current by inventory
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -337,6 +338,82 @@ func (x *TripResult) GetOrganizationId() string {
 	return ""
 }
 
+func (x *TripResult) GetCapacity() []*CapacityAvailability {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+// CapacityAvailability counts unnumbered places free over the whole route
+type CapacityAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CapacityClass string                 `protobuf:"bytes,1,opt,name=capacity_class,json=capacityClass,proto3" json:"capacity_class,omitempty"` // deck, standing
+	MaxCount      int32                  `protobuf:"varint,2,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	Available     int32                  `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	PricePaisa    int64                  `protobuf:"varint,4,opt,name=price_paisa,json=pricePaisa,proto3" json:"price_paisa,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapacityAvailability) Reset() {
+	*x = CapacityAvailability{}
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapacityAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapacityAvailability) ProtoMessage() {}
+
+func (x *CapacityAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapacityAvailability.ProtoReflect.Descriptor instead.
+func (*CapacityAvailability) Descriptor() ([]byte, []int) {
+	return file_api_proto_search_v1_search_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CapacityAvailability) GetCapacityClass() string {
+	if x != nil {
+		return x.CapacityClass
+	}
+	return ""
+}
+
+func (x *CapacityAvailability) GetMaxCount() int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *CapacityAvailability) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *CapacityAvailability) GetPricePaisa() int64 {
+	if x != nil {
+		return x.PricePaisa
+	}
+	return 0
+}
+
 type SearchStationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
@@ -347,7 +424,7 @@ type SearchStationsRequest struct {
 
 func (x *SearchStationsRequest) Reset() {
 	*x = SearchStationsRequest{}
-	mi := &file_api_proto_search_v1_search_proto_msgTypes[3]
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +436,7 @@ func (x *SearchStationsRequest) String() string {
 func (*SearchStationsRequest) ProtoMessage() {}
 
 func (x *SearchStationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_search_v1_search_proto_msgTypes[3]
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +449,7 @@ func (x *SearchStationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchStationsRequest.ProtoReflect.Descriptor instead.
 func (*SearchStationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_search_v1_search_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_search_v1_search_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SearchStationsRequest) GetQuery() string {
@@ -398,7 +475,7 @@ type SearchStationsResponse struct {
 
 func (x *SearchStationsResponse) Reset() {
 	*x = SearchStationsResponse{}
-	mi := &file_api_proto_search_v1_search_proto_msgTypes[4]
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -410,7 +487,7 @@ func (x *SearchStationsResponse) String() string {
 func (*SearchStationsResponse) ProtoMessage() {}
 
 func (x *SearchStationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_search_v1_search_proto_msgTypes[4]
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,7 +500,7 @@ func (x *SearchStationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchStationsResponse.ProtoReflect.Descriptor instead.
 func (*SearchStationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_search_v1_search_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_search_v1_search_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SearchStationsResponse) GetResults() []*StationResult {
@@ -445,7 +522,7 @@ type StationResult struct {
 
 func (x *StationResult) Reset() {
 	*x = StationResult{}
-	mi := &file_api_proto_search_v1_search_proto_msgTypes[5]
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +534,7 @@ func (x *StationResult) String() string {
 func (*StationResult) ProtoMessage() {}
 
 func (x *StationResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_search_v1_search_proto_msgTypes[5]
+	mi := &file_api_proto_search_v1_search_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +547,7 @@ func (x *StationResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StationResult.ProtoReflect.Descriptor instead.
 func (*StationResult) Descriptor() ([]byte, []int) {
-	return file_api_proto_search_v1_search_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_search_v1_search_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *StationResult) GetStationId() string {
@@ -515,7 +592,7 @@ const file_api_proto_search_v1_search_proto_rawDesc = "" +
 	"\x06offset\x18\x06 \x01(\x05R\x06offset\"\\\n" +
 	"\x13SearchTripsResponse\x12/\n" +
 	"\aresults\x18\x01 \x03(\v2\x15.search.v1.TripResultR\aresults\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xe8\x04\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xa5\x05\n" +
 	"\n" +
 	"TripResult\x12\x17\n" +
 	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12!\n" +
@@ -538,7 +615,14 @@ const file_api_proto_search_v1_search_proto_rawDesc = "" +
 	"\x04date\x18\x0f \x01(\tR\x04date\x12\x16\n" +
 	"\x06status\x18\x10 \x01(\tR\x06status\x12\x19\n" +
 	"\broute_id\x18\x11 \x01(\tR\arouteId\x12'\n" +
-	"\x0forganization_id\x18\x12 \x01(\tR\x0eorganizationId\"C\n" +
+	"\x0forganization_id\x18\x12 \x01(\tR\x0eorganizationId\x12;\n" +
+	"\bcapacity\x18\x13 \x03(\v2\x1f.search.v1.CapacityAvailabilityR\bcapacity\"\x99\x01\n" +
+	"\x14CapacityAvailability\x12%\n" +
+	"\x0ecapacity_class\x18\x01 \x01(\tR\rcapacityClass\x12\x1b\n" +
+	"\tmax_count\x18\x02 \x01(\x05R\bmaxCount\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x05R\tavailable\x12\x1f\n" +
+	"\vprice_paisa\x18\x04 \x01(\x03R\n" +
+	"pricePaisa\"C\n" +
 	"\x15SearchStationsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\"L\n" +
@@ -566,27 +650,29 @@ func file_api_proto_search_v1_search_proto_rawDescGZIP() []byte {
 	return file_api_proto_search_v1_search_proto_rawDescData
 }
 
-var file_api_proto_search_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_proto_search_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_api_proto_search_v1_search_proto_goTypes = []any{
 	(*SearchTripsRequest)(nil),     // 0: search.v1.SearchTripsRequest
 	(*SearchTripsResponse)(nil),    // 1: search.v1.SearchTripsResponse
 	(*TripResult)(nil),             // 2: search.v1.TripResult
-	(*SearchStationsRequest)(nil),  // 3: search.v1.SearchStationsRequest
-	(*SearchStationsResponse)(nil), // 4: search.v1.SearchStationsResponse
-	(*StationResult)(nil),          // 5: search.v1.StationResult
+	(*CapacityAvailability)(nil),   // 3: search.v1.CapacityAvailability
+	(*SearchStationsRequest)(nil),  // 4: search.v1.SearchStationsRequest
+	(*SearchStationsResponse)(nil), // 5: search.v1.SearchStationsResponse
+	(*StationResult)(nil),          // 6: search.v1.StationResult
 }
 var file_api_proto_search_v1_search_proto_depIdxs = []int32{
 	2, // 0: search.v1.SearchTripsResponse.results:type_name -> search.v1.TripResult
-	5, // 1: search.v1.SearchStationsResponse.results:type_name -> search.v1.StationResult
-	0, // 2: search.v1.SearchService.SearchTrips:input_type -> search.v1.SearchTripsRequest
-	3, // 3: search.v1.SearchService.SearchStations:input_type -> search.v1.SearchStationsRequest
-	1, // 4: search.v1.SearchService.SearchTrips:output_type -> search.v1.SearchTripsResponse
-	4, // 5: search.v1.SearchService.SearchStations:output_type -> search.v1.SearchStationsResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 1: search.v1.TripResult.capacity:type_name -> search.v1.CapacityAvailability
+	6, // 2: search.v1.SearchStationsResponse.results:type_name -> search.v1.StationResult
+	0, // 3: search.v1.SearchService.SearchTrips:input_type -> search.v1.SearchTripsRequest
+	4, // 4: search.v1.SearchService.SearchStations:input_type -> search.v1.SearchStationsRequest
+	1, // 5: search.v1.SearchService.SearchTrips:output_type -> search.v1.SearchTripsResponse
+	5, // 6: search.v1.SearchService.SearchStations:output_type -> search.v1.SearchStationsResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_search_v1_search_proto_init() }
@@ -600,7 +686,7 @@ func file_api_proto_search_v1_search_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_search_v1_search_proto_rawDesc), len(file_api_proto_search_v1_search_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -94,21 +94,35 @@ const (
 
 // Event types
 const (
-	EventOrderCreated      = "order.created"
-	EventOrderConfirmed    = "order.confirmed"
-	EventOrderCancelled    = "order.cancelled"
-	EventOrderFailed       = "order.failed"
-	EventPaymentAuthorized = "payment.authorized"
-	EventPaymentCaptured   = "payment.captured"
-	EventPaymentFailed     = "payment.failed"
-	EventPaymentRefunded   = "payment.refunded"
-	EventSeatsHeld         = "inventory.seats_held"
-	EventSeatsReleased     = "inventory.seats_released"
-	EventSeatsBooked       = "inventory.seats_booked"
-	EventTicketGenerated   = "fulfillment.ticket_generated"
-	EventNotificationSent  = "notification.sent"
-	EventTripCreated       = "trip.created"
-	EventTripUpdated       = "trip.updated"
+	EventOrderCreated             = "order.created"
+	EventOrderConfirmed           = "order.confirmed"
+	EventOrderCancelled           = "order.cancelled"
+	EventOrderFailed              = "order.failed"
+	EventOrderReaccommodated      = "order.reaccommodated"
+	EventOrderPassengersCancelled = "order.passengers_cancelled"
+	EventOrderChanged             = "order.changed"
+	EventOrderExpired             = "order.expired"
+	EventOrderReviewPending       = "order.review_pending"
+	EventAccountInvoiceIssued     = "order.account_invoice_issued"
+	EventPassengerTransferred     = "order.passenger_transferred"
+	EventPaymentAuthorized        = "payment.authorized"
+	EventPaymentCaptured          = "payment.captured"
+	EventPaymentFailed            = "payment.failed"
+	EventPaymentRefunded          = "payment.refunded"
+	EventSeatsHeld                = "inventory.seats_held"
+	EventSeatsReleased            = "inventory.seats_released"
+	EventSeatsBooked              = "inventory.seats_booked"
+	EventSeatsBlocked             = "inventory.seats_blocked"
+	EventCapacityUpdated          = "inventory.capacity_updated"
+	EventAvailabilityUpdated      = "inventory.availability_updated"
+	EventWaitlistOffered          = "inventory.waitlist_offered"
+	EventQuotaReleased            = "inventory.quota_released"
+	EventPassengersReaccommodated = "inventory.passengers_reaccommodated"
+	EventTrancheReleased          = "inventory.tranche_released"
+	EventTicketGenerated          = "fulfillment.ticket_generated"
+	EventNotificationSent         = "notification.sent"
+	EventTripCreated              = "trip.created"
+	EventTripUpdated              = "trip.updated"
 
 	EventStationCreated = "station.created"
 	EventEventCreated   = "event.created"
@@ -388,6 +388,7 @@ CREATE TABLE IF NOT EXISTS tickets (
     booking_id UUID,
     order_id UUID,
     organization_id UUID,
+    pnr VARCHAR(10) NOT NULL DEFAULT '', -- Booking reference of the order
     
     -- Trip Snapshot
     trip_id UUID,
@@ -427,6 +428,7 @@ CREATE INDEX IF NOT EXISTS idx_tickets_order_id ON tickets(order_id);
 CREATE INDEX IF NOT EXISTS idx_tickets_booking_id ON tickets(booking_id);
 CREATE INDEX IF NOT EXISTS idx_tickets_status ON tickets(status);
 CREATE INDEX IF NOT EXISTS idx_tickets_passenger_nid ON tickets(passenger_nid);
+CREATE INDEX IF NOT EXISTS idx_tickets_pnr ON tickets(pnr) WHERE pnr <> '';
 
 -- ==============================================================================
 -- 6. PRICING SERVICE (travio_pricing)
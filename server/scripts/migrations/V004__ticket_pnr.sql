@@ -0,0 +1,14 @@
+-- ============================================================================
+-- Ticket PNR Migration
+-- ============================================================================
+-- Version: V004
+-- Description: Print the order's short booking reference (PNR) on tickets
+-- Author: Travio Team
+-- Date: 2026-10-17
+
+\c travio_fulfillment
+
+-- Tickets issued before PNRs existed keep an empty one
+ALTER TABLE tickets ADD COLUMN IF NOT EXISTS pnr VARCHAR(10) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_tickets_pnr ON tickets(pnr) WHERE pnr <> '';
@@ -25,6 +25,7 @@ func NewPublisher(db *sql.DB) *Publisher {
 type TripCreatedPayload struct {
 	TripID          string               `json:"trip_id"`
 	OrganizationID  string               `json:"organization_id"`
+	ScheduleID      string               `json:"schedule_id,omitempty"`
 	RouteID         string               `json:"route_id"`
 	VehicleID       string               `json:"vehicle_id"`
 	VehicleType     string               `json:"vehicle_type"`
@@ -45,6 +46,8 @@ type TripCreatedPayload struct {
 	Pricing         domain.TripPricing   `json:"pricing"`
 	Segments        []domain.TripSegment `json:"segments"`
 	Status          string               `json:"status"`
+	// Set on trip.updated when the trip moved to another vehicle
+	PreviousVehicleID string `json:"previous_vehicle_id,omitempty"`
 }
 
 // PublishTripCreated publishes trip created event within a transaction
@@ -52,6 +55,7 @@ func (p *Publisher) PublishTripCreated(ctx context.Context, tx *sql.Tx, trip *do
 	payload := TripCreatedPayload{
 		TripID:          trip.ID,
 		OrganizationID:  trip.OrganizationID,
+		ScheduleID:      trip.ScheduleID,
 		RouteID:         trip.RouteID,
 		VehicleID:       trip.VehicleID,
 		VehicleType:     trip.VehicleType,
@@ -81,9 +85,23 @@ type TripUpdatedPayload TripCreatedPayload
 
 // PublishTripUpdated publishes trip updated event
 func (p *Publisher) PublishTripUpdated(ctx context.Context, tx *sql.Tx, trip *domain.Trip) error {
-	payload := TripUpdatedPayload{
+	payload := tripUpdatedPayload(trip)
+	return p.outbox.Publish(ctx, tx, kafka.TopicCatalog, kafka.EventTripUpdated, trip.ID, payload)
+}
+
+// PublishTripVehicleChanged publishes trip updated event for a trip moved off the previous
+// vehicle, so inventory re-accommodates its passengers on the new one
+func (p *Publisher) PublishTripVehicleChanged(ctx context.Context, tx *sql.Tx, trip *domain.Trip, previousVehicleID string) error {
+	payload := tripUpdatedPayload(trip)
+	payload.PreviousVehicleID = previousVehicleID
+	return p.outbox.Publish(ctx, tx, kafka.TopicCatalog, kafka.EventTripUpdated, trip.ID, payload)
+}
+
+func tripUpdatedPayload(trip *domain.Trip) TripUpdatedPayload {
+	return TripUpdatedPayload{
 		TripID:          trip.ID,
 		OrganizationID:  trip.OrganizationID,
+		ScheduleID:      trip.ScheduleID,
 		RouteID:         trip.RouteID,
 		VehicleID:       trip.VehicleID,
 		VehicleType:     trip.VehicleType,
@@ -105,5 +123,4 @@ func (p *Publisher) PublishTripUpdated(ctx context.Context, tx *sql.Tx, trip *do
 		Pricing:         trip.Pricing,
 		Segments:        trip.Segments,
 	}
-	return p.outbox.Publish(ctx, tx, kafka.TopicCatalog, kafka.EventTripUpdated, trip.ID, payload)
 }
@@ -2,11 +2,13 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	pb "github.com/MuhibNayem/Travio/server/api/proto/catalog/v1"
 	"github.com/MuhibNayem/Travio/server/services/catalog/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/catalog/internal/repository"
 	"github.com/MuhibNayem/Travio/server/services/catalog/internal/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -241,6 +243,22 @@ func (h *GrpcHandler) CancelTrip(ctx context.Context, req *pb.CancelTripRequest)
 	return tripToProto(trip), nil
 }
 
+func (h *GrpcHandler) ReassignTripVehicle(ctx context.Context, req *pb.ReassignTripVehicleRequest) (*pb.Trip, error) {
+	trip, err := h.catalogService.ReassignTripVehicle(ctx, req.Id, req.OrganizationId, req.VehicleId)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrTripNotFound):
+			return nil, status.Error(codes.NotFound, "trip not found")
+		case errors.Is(err, service.ErrInvalidVehicleReassignment):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrTripNotReassignable):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to reassign trip vehicle")
+	}
+	return tripToProto(trip), nil
+}
+
 // --- Schedule Handlers ---
 
 func (h *GrpcHandler) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.Schedule, error) {
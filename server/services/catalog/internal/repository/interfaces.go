@@ -27,6 +27,7 @@ type TripRepository interface {
 	List(ctx context.Context, orgID, routeID, scheduleID, serviceDateFrom, serviceDateTo string, limit, offset int) ([]*domain.Trip, int, error)
 	Search(ctx context.Context, orgID, originCity, destCity string, travelDate time.Time, limit, offset int) ([]*domain.Trip, int, error)
 	UpdateStatus(ctx context.Context, id, orgID, status string) error
+	ReassignVehicle(ctx context.Context, id, orgID, vehicleID string) error
 	DecrementSeats(ctx context.Context, id string, count int) error
 	CreateSegments(ctx context.Context, tripID string, segments []domain.TripSegment) error
 	GetSegments(ctx context.Context, tripID string) ([]domain.TripSegment, error)
@@ -680,6 +680,47 @@ func (r *PostgresTripRepository) UpdateStatus(ctx context.Context, id, orgID, st
 	return tx.Commit()
 }
 
+// ReassignVehicle moves the trip to another vehicle and publishes the change, with the
+// vehicle it left, so inventory re-accommodates the booked passengers
+func (r *PostgresTripRepository) ReassignVehicle(ctx context.Context, id, orgID, vehicleID string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var previousVehicleID string
+	err = tx.QueryRowContext(ctx, `SELECT vehicle_id FROM trips WHERE id = $1 AND organization_id = $2 FOR UPDATE`,
+		id, orgID).Scan(&previousVehicleID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTripNotFound
+		}
+		return err
+	}
+
+	query := `UPDATE trips SET vehicle_id = $1, updated_at = $2 WHERE id = $3 AND organization_id = $4`
+	if _, err := tx.ExecContext(ctx, query, vehicleID, time.Now(), id, orgID); err != nil {
+		return err
+	}
+
+	// Fetch trip details for event (read outside the transaction, patched below)
+	trip, err := r.GetByID(ctx, id, orgID)
+	if err != nil {
+		return err
+	}
+	trip.VehicleID = vehicleID
+	trip.UpdatedAt = time.Now()
+
+	if r.publisher != nil {
+		if err := r.publisher.PublishTripVehicleChanged(ctx, tx, trip, previousVehicleID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (r *PostgresTripRepository) DecrementSeats(ctx context.Context, id string, count int) error {
 	query := `UPDATE trips SET available_seats = available_seats - $1, updated_at = $2 
 			  WHERE id = $3 AND available_seats >= $1`
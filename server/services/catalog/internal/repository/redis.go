@@ -177,6 +177,14 @@ func (r *CachedTripRepository) UpdateStatus(ctx context.Context, id, orgID, stat
 	return err
 }
 
+func (r *CachedTripRepository) ReassignVehicle(ctx context.Context, id, orgID, vehicleID string) error {
+	err := r.next.ReassignVehicle(ctx, id, orgID, vehicleID)
+	if err == nil {
+		r.rdb.Del(ctx, fmt.Sprintf("trip:%s:%s", orgID, id))
+	}
+	return err
+}
+
 func (r *CachedTripRepository) DecrementSeats(ctx context.Context, id string, count int) error {
 	// Invalidate because seat count changed
 	// We might not know OrgID here easily if it's not passed.
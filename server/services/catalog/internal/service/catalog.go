@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -293,6 +294,49 @@ func (s *CatalogService) CancelTrip(ctx context.Context, id, orgID, reason strin
 	return s.tripRepo.GetByID(ctx, id, orgID)
 }
 
+// ReassignTripVehicle swaps the vehicle running a trip that has not left yet, such as
+// when a bus breaks down. Inventory re-accommodates the booked passengers on the new
+// vehicle's seats when it receives the trip.updated event.
+func (s *CatalogService) ReassignTripVehicle(ctx context.Context, id, orgID, vehicleID string) (*domain.Trip, error) {
+	if vehicleID == "" {
+		return nil, fmt.Errorf("%w: vehicle_id is required", ErrInvalidVehicleReassignment)
+	}
+	trip, err := s.tripRepo.GetByID(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	switch trip.Status {
+	case domain.TripStatusScheduled, domain.TripStatusDelayed, domain.TripStatusBoarding:
+	default:
+		return nil, fmt.Errorf("%w: trip is %s", ErrTripNotReassignable, trip.Status)
+	}
+	if trip.VehicleID == vehicleID {
+		return nil, fmt.Errorf("%w: trip already runs on this vehicle", ErrInvalidVehicleReassignment)
+	}
+
+	isBusy, err := s.tripRepo.CheckVehicleAvailability(ctx, vehicleID, trip.DepartureTime, trip.ArrivalTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check vehicle availability: %w", err)
+	}
+	if isBusy {
+		return nil, fmt.Errorf("%w: vehicle is already booked for this time slot", ErrTripNotReassignable)
+	}
+	if s.fleetClient != nil {
+		asset, err := s.fleetClient.GetAsset(ctx, vehicleID, orgID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch asset: %w", err)
+		}
+		if asset.Status == fleetpb.AssetStatus_ASSET_STATUS_MAINTENANCE {
+			return nil, fmt.Errorf("%w: vehicle is currently in MAINTENANCE status", ErrTripNotReassignable)
+		}
+	}
+
+	if err := s.tripRepo.ReassignVehicle(ctx, id, orgID, vehicleID); err != nil {
+		return nil, err
+	}
+	return s.tripRepo.GetByID(ctx, id, orgID)
+}
+
 // --- Schedule Operations ---
 
 func (s *CatalogService) CreateSchedule(ctx context.Context, schedule *domain.ScheduleTemplate) (*domain.ScheduleTemplate, error) {
@@ -613,6 +657,11 @@ type TripSearchResult struct {
 
 // --- Errors ---
 
+var (
+	ErrInvalidVehicleReassignment = errors.New("invalid vehicle reassignment")
+	ErrTripNotReassignable        = errors.New("trip cannot move to the vehicle")
+)
+
 type PlanLimitError struct {
 	Limit   string
 	Current int
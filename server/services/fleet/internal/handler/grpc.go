@@ -46,6 +46,7 @@ func (h *GRPCHandler) RegisterAsset(ctx context.Context, req *fleetv1.RegisterAs
 					"aisle_after_seat": layout.Bus.AisleAfterSeat,
 					"has_toilet":       layout.Bus.HasToilet,
 					"has_sleeper":      layout.Bus.HasSleeper,
+					"decks":            layout.Bus.Decks,
 				}
 				if len(layout.Bus.Categories) > 0 {
 					categories := make([]map[string]interface{}, 0)
@@ -73,6 +74,9 @@ func (h *GRPCHandler) RegisterAsset(ctx context.Context, req *fleetv1.RegisterAs
 						"has_berths":    c.HasBerths,
 						"price_paisa":   c.PricePaisa,
 					}
+					if c.StandingCapacity > 0 {
+						coach["standing_capacity"] = c.StandingCapacity
+					}
 					if c.BerthConfig != nil {
 						coach["berth_config"] = map[string]interface{}{
 							"type":                   c.BerthConfig.Type.String(),
@@ -96,6 +100,10 @@ func (h *GRPCHandler) RegisterAsset(ctx context.Context, req *fleetv1.RegisterAs
 						"cols":             d.Cols,
 						"seat_price_paisa": d.SeatPricePaisa,
 					}
+					if d.DeckCapacity > 0 {
+						deck["deck_capacity"] = d.DeckCapacity
+						deck["deck_price_paisa"] = d.DeckPricePaisa
+					}
 					if len(d.Cabins) > 0 {
 						cabins := make([]map[string]interface{}, 0)
 						for _, cab := range d.Cabins {
@@ -260,6 +268,32 @@ func mapAssetToProto(a *domain.Asset) *fleetv1.Asset {
 				if v, ok := busData["has_sleeper"].(bool); ok {
 					busConfig.HasSleeper = v
 				}
+				if v, ok := busData["decks"].(float64); ok {
+					busConfig.Decks = int32(v)
+				}
+				if categories, ok := busData["categories"].([]interface{}); ok {
+					for _, c := range categories {
+						catMap, ok := c.(map[string]interface{})
+						if !ok {
+							continue
+						}
+						cat := &fleetv1.SeatCategory{}
+						if v, ok := catMap["name"].(string); ok {
+							cat.Name = v
+						}
+						if v, ok := catMap["price_paisa"].(float64); ok {
+							cat.PricePaisa = int32(v)
+						}
+						if ids, ok := catMap["seat_ids"].([]interface{}); ok {
+							for _, id := range ids {
+								if v, ok := id.(string); ok {
+									cat.SeatIds = append(cat.SeatIds, v)
+								}
+							}
+						}
+						busConfig.Categories = append(busConfig.Categories, cat)
+					}
+				}
 				config.Layout = &fleetv1.Config_Bus{Bus: busConfig}
 			}
 
@@ -285,6 +319,9 @@ func mapAssetToProto(a *domain.Asset) *fleetv1.Asset {
 							if v, ok := coachMap["price_paisa"].(float64); ok {
 								coach.PricePaisa = int32(v)
 							}
+							if v, ok := coachMap["standing_capacity"].(float64); ok {
+								coach.StandingCapacity = int32(v)
+							}
 							trainConfig.Coaches = append(trainConfig.Coaches, coach)
 						}
 					}
@@ -314,6 +351,12 @@ func mapAssetToProto(a *domain.Asset) *fleetv1.Asset {
 							if v, ok := deckMap["seat_price_paisa"].(float64); ok {
 								deck.SeatPricePaisa = int32(v)
 							}
+							if v, ok := deckMap["deck_capacity"].(float64); ok {
+								deck.DeckCapacity = int32(v)
+							}
+							if v, ok := deckMap["deck_price_paisa"].(float64); ok {
+								deck.DeckPricePaisa = int32(v)
+							}
 							launchConfig.Decks = append(launchConfig.Decks, deck)
 						}
 					}
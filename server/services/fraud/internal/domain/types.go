@@ -55,11 +55,12 @@ type BookingAnalysisRequest struct {
 	PassengerCount int      `json:"passenger_count"`
 
 	// Booking behavior
-	BookingTimestamp time.Time `json:"booking_timestamp"`
-	IPAddress        string    `json:"ip_address"`
-	UserAgent        string    `json:"user_agent"`
-	PaymentMethod    string    `json:"payment_method"`
-	TotalAmountPaisa int64     `json:"total_amount_paisa"`
+	BookingTimestamp  time.Time `json:"booking_timestamp"`
+	IPAddress         string    `json:"ip_address"`
+	UserAgent         string    `json:"user_agent"`
+	DeviceFingerprint string    `json:"device_fingerprint,omitempty"`
+	PaymentMethod     string    `json:"payment_method"`
+	TotalAmountPaisa  int64     `json:"total_amount_paisa"`
 
 	// Historical context
 	BookingsLast24Hours int `json:"bookings_last_24_hours"`
@@ -38,6 +38,7 @@ func (h *GrpcHandler) AnalyzeBooking(ctx context.Context, req *pb.AnalyzeBooking
 		BookingTimestamp:    time.Unix(req.BookingTimestamp, 0),
 		IPAddress:           req.IpAddress,
 		UserAgent:           req.UserAgent,
+		DeviceFingerprint:   req.DeviceFingerprint,
 		PaymentMethod:       req.PaymentMethod,
 		TotalAmountPaisa:    req.TotalAmountPaisa,
 		BookingsLast24Hours: int(req.BookingsLast_24Hours),
@@ -98,7 +98,7 @@ func (a *Analyzer) AnalyzeDeviation(profile *UserProfile, event *BookingEvent) *
 	// 6. Device deviation
 	isKnownDevice := false
 	for _, d := range profile.DeviceFingerprints {
-		if d == event.UserAgent {
+		if d == event.Device() {
 			isKnownDevice = true
 			break
 		}
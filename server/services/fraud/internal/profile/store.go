@@ -65,7 +65,7 @@ func (s *Store) UpdateFromEvent(ctx context.Context, event *BookingEvent) error
 			BookingVelocity7d:  1.0,
 			CommonRoutes:       []string{event.Route},
 			CommonTimes:        []int{event.BookingTime.Hour()},
-			DeviceFingerprints: []string{event.UserAgent},
+			DeviceFingerprints: []string{event.Device()},
 			CommonIPs:          []string{event.IPAddress},
 			RiskScores:         []float64{event.RiskScore},
 			AvgRiskScore:       event.RiskScore,
@@ -95,7 +95,7 @@ func (s *Store) UpdateFromEvent(ctx context.Context, event *BookingEvent) error
 		profile.CommonTimes = appendUniqueInt(profile.CommonTimes, event.BookingTime.Hour(), 24)
 
 		// Add device fingerprint
-		profile.DeviceFingerprints = appendUnique(profile.DeviceFingerprints, event.UserAgent, 5)
+		profile.DeviceFingerprints = appendUnique(profile.DeviceFingerprints, event.Device(), 5)
 
 		// Add IP
 		profile.CommonIPs = appendUnique(profile.CommonIPs, event.IPAddress, 10)
@@ -46,8 +46,18 @@ type BookingEvent struct {
 	BookingTime time.Time
 	IPAddress   string
 	UserAgent   string
-	RiskScore   float64
-	WasBlocked  bool
+	// DeviceFingerprint identifies the client device; the user agent stands in without one
+	DeviceFingerprint string
+	RiskScore         float64
+	WasBlocked        bool
+}
+
+// Device returns the event's device fingerprint, or its user agent when it has none
+func (e *BookingEvent) Device() string {
+	if e.DeviceFingerprint != "" {
+		return e.DeviceFingerprint
+	}
+	return e.UserAgent
 }
 
 // DeviationResult represents the result of behavior deviation analysis.
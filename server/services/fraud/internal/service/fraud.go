@@ -81,13 +81,14 @@ func (s *FraudService) AnalyzeBooking(ctx context.Context, req *domain.BookingAn
 	if s.profileStore != nil && s.profileAnalyzer != nil {
 		userProfile, _ := s.profileStore.GetProfile(ctx, req.UserID)
 		event := &profile.BookingEvent{
-			UserID:      req.UserID,
-			OrderID:     req.OrderID,
-			TripID:      req.TripID,
-			AmountPaisa: req.TotalAmountPaisa,
-			BookingTime: req.BookingTimestamp,
-			IPAddress:   req.IPAddress,
-			UserAgent:   req.UserAgent,
+			UserID:            req.UserID,
+			OrderID:           req.OrderID,
+			TripID:            req.TripID,
+			AmountPaisa:       req.TotalAmountPaisa,
+			BookingTime:       req.BookingTimestamp,
+			IPAddress:         req.IPAddress,
+			UserAgent:         req.UserAgent,
+			DeviceFingerprint: req.DeviceFingerprint,
 		}
 		deviation := s.profileAnalyzer.AnalyzeDeviation(userProfile, event)
 		deviationScore = deviation.Score
@@ -162,6 +163,7 @@ Passenger Names: %s
 Booking Time: %s
 IP Address: %s
 User Agent: %s
+Device Fingerprint: %s
 Payment Method: %s
 Total Amount: %d paisa
 Bookings Last 24h: %d
@@ -171,7 +173,7 @@ Previous Fraud Flags: %d`,
 		strings.Join(req.PassengerNIDs, ", "),
 		strings.Join(req.PassengerNames, ", "),
 		req.BookingTimestamp.Format(time.RFC3339),
-		req.IPAddress, req.UserAgent, req.PaymentMethod,
+		req.IPAddress, req.UserAgent, req.DeviceFingerprint, req.PaymentMethod,
 		req.TotalAmountPaisa, req.BookingsLast24Hours,
 		req.BookingsLastWeek, req.PreviousFraudFlags,
 	)
@@ -226,15 +228,16 @@ Previous Fraud Flags: %d`,
 	if s.profileStore != nil {
 		go func() {
 			event := &profile.BookingEvent{
-				UserID:      req.UserID,
-				OrderID:     req.OrderID,
-				TripID:      req.TripID,
-				AmountPaisa: req.TotalAmountPaisa,
-				BookingTime: req.BookingTimestamp,
-				IPAddress:   req.IPAddress,
-				UserAgent:   req.UserAgent,
-				RiskScore:   float64(result.RiskScore),
-				WasBlocked:  result.ShouldBlock,
+				UserID:            req.UserID,
+				OrderID:           req.OrderID,
+				TripID:            req.TripID,
+				AmountPaisa:       req.TotalAmountPaisa,
+				BookingTime:       req.BookingTimestamp,
+				IPAddress:         req.IPAddress,
+				UserAgent:         req.UserAgent,
+				DeviceFingerprint: req.DeviceFingerprint,
+				RiskScore:         float64(result.RiskScore),
+				WasBlocked:        result.ShouldBlock,
 			}
 			if err := s.profileStore.UpdateFromEvent(context.Background(), event); err != nil {
 				logger.Warn("Failed to update user profile", "user_id", req.UserID, "error", err)
@@ -36,6 +36,10 @@ func NewOrderEventConsumer(brokers []string, fulfillmentSvc *service.Fulfillment
 
 	// Register handlers
 	consumer.RegisterHandler(kafka.EventOrderConfirmed, c.handleOrderConfirmed)
+	consumer.RegisterHandler(kafka.EventOrderReaccommodated, c.handleOrderReaccommodated)
+	consumer.RegisterHandler(kafka.EventOrderPassengersCancelled, c.handleOrderPassengersCancelled)
+	consumer.RegisterHandler(kafka.EventOrderChanged, c.handleOrderChanged)
+	consumer.RegisterHandler(kafka.EventPassengerTransferred, c.handlePassengerTransferred)
 
 	return c, nil
 }
@@ -79,7 +83,43 @@ func (c *OrderEventConsumer) handleOrderConfirmed(ctx context.Context, event *ka
 		return err
 	}
 
-	trip, err := c.catalogClient.GetTrip(ctx, payload.OrganizationID, payload.TripID)
+	// Multi-leg orders get the tickets of every leg, each on its own trip
+	legs := []*orderpb.Order{order}
+	tripIDs := []string{payload.TripID}
+	bookingIDs := []string{payload.BookingID}
+	if len(order.Legs) > 1 {
+		legs, tripIDs, bookingIDs = nil, nil, nil
+		for _, leg := range order.Legs {
+			legs = append(legs, &orderpb.Order{
+				Id:            order.Id,
+				FromStationId: leg.FromStationId,
+				ToStationId:   leg.ToStationId,
+				Passengers:    leg.Passengers,
+				Seats:         leg.Seats,
+				ContactEmail:  order.ContactEmail,
+				ContactPhone:  order.ContactPhone,
+			})
+			tripIDs = append(tripIDs, leg.TripId)
+			bookingIDs = append(bookingIDs, leg.BookingId)
+		}
+	}
+
+	for i, leg := range legs {
+		totalPaisa := payload.TotalPaisa
+		if len(order.Legs) > 1 {
+			totalPaisa = order.Legs[i].TotalPaisa
+		}
+		if err := c.generateLegTickets(ctx, &payload, leg, tripIDs[i], bookingIDs[i], totalPaisa); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateLegTickets issues the tickets of one trip of a confirmed order
+func (c *OrderEventConsumer) generateLegTickets(ctx context.Context, payload *OrderConfirmedPayload, order *orderpb.Order, tripID, bookingID string, totalPaisa int64) error {
+	trip, err := c.catalogClient.GetTrip(ctx, payload.OrganizationID, tripID)
 	if err != nil {
 		logger.Error("failed to fetch trip", "error", err)
 		return err
@@ -103,17 +143,26 @@ func (c *OrderEventConsumer) handleOrderConfirmed(ctx context.Context, event *ka
 		return err
 	}
 
-	passengers := buildPassengerSeats(order, payload.TotalPaisa)
+	passengers := buildPassengerSeats(order, totalPaisa)
 	if len(passengers) == 0 {
-		logger.Error("no passengers found for order", "order_id", payload.OrderID)
+		logger.Error("no passengers found for order", "order_id", payload.OrderID, "trip_id", tripID)
 		return fmt.Errorf("no passengers found for order %s", payload.OrderID)
 	}
 
+	stationNames := map[string]string{
+		order.FromStationId: origin.Name,
+		order.ToStationId:   destination.Name,
+	}
+	if err := c.resolveLegStations(ctx, payload.OrganizationID, passengers, stationNames); err != nil {
+		logger.Error("failed to fetch seat leg stations", "error", err)
+		return err
+	}
+
 	req := &service.GenerateTicketsReq{
-		BookingID:      payload.BookingID,
+		BookingID:      bookingID,
 		OrderID:        payload.OrderID,
 		OrganizationID: payload.OrganizationID,
-		TripID:         payload.TripID,
+		TripID:         tripID,
 		RouteName:      route.Name,
 		FromStation:    origin.Name,
 		ToStation:      destination.Name,
@@ -122,6 +171,7 @@ func (c *OrderEventConsumer) handleOrderConfirmed(ctx context.Context, event *ka
 		Passengers:     passengers,
 		ContactEmail:   order.ContactEmail,
 		ContactPhone:   order.ContactPhone,
+		PNR:            order.Pnr,
 	}
 
 	// Generate tickets
@@ -135,10 +185,249 @@ func (c *OrderEventConsumer) handleOrderConfirmed(ctx context.Context, event *ka
 	}
 
 	logger.Info("tickets generated successfully",
+		"order_id", payload.OrderID,
+		"trip_id", tripID,
+		"ticket_count", len(result.Tickets),
+	)
+	return nil
+}
+
+// SeatReassignmentPayload is one passenger's seat move in an OrderReaccommodated event
+type SeatReassignmentPayload struct {
+	PassengerIndex int    `json:"passenger_index"`
+	PassengerName  string `json:"passenger_name"`
+	PassengerNID   string `json:"passenger_nid"`
+	SeatClass      string `json:"seat_class"`
+	OldSeatNumber  string `json:"old_seat_number"`
+	NewSeatNumber  string `json:"new_seat_number"`
+}
+
+// OrderReaccommodatedPayload matches the event published when a vehicle change moves passengers
+type OrderReaccommodatedPayload struct {
+	OrderID        string                    `json:"order_id"`
+	OrganizationID string                    `json:"organization_id"`
+	TripID         string                    `json:"trip_id"`
+	BookingID      string                    `json:"booking_id"`
+	Moved          []SeatReassignmentPayload `json:"moved"`
+	Unplaced       []SeatReassignmentPayload `json:"unplaced"`
+}
+
+// handleOrderReaccommodated reissues the tickets of passengers moved to new seats.
+// Unplaced passengers keep their tickets until the order is refunded or moved.
+func (c *OrderEventConsumer) handleOrderReaccommodated(ctx context.Context, event *kafka.Event) error {
+	payloadBytes, err := json.Marshal(event.Payload)
+	if err != nil {
+		logger.Error("failed to marshal payload", "error", err)
+		return err
+	}
+
+	var payload OrderReaccommodatedPayload
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		logger.Error("failed to unmarshal OrderReaccommodated payload", "error", err)
+		return err
+	}
+
+	var changes []service.SeatChange
+	for _, moved := range payload.Moved {
+		if moved.OldSeatNumber == moved.NewSeatNumber {
+			continue
+		}
+		changes = append(changes, service.SeatChange{
+			PassengerNID:  moved.PassengerNID,
+			OldSeatNumber: moved.OldSeatNumber,
+			NewSeatNumber: moved.NewSeatNumber,
+		})
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	result, err := c.fulfillmentService.ReissueTickets(ctx, &service.ReissueTicketsReq{
+		OrderID:   payload.OrderID,
+		BookingID: payload.BookingID,
+		Changes:   changes,
+	})
+	if err != nil {
+		logger.Error("failed to reissue tickets",
+			"order_id", payload.OrderID,
+			"error", err,
+		)
+		return err
+	}
+
+	logger.Info("tickets reissued after re-accommodation",
 		"order_id", payload.OrderID,
 		"ticket_count", len(result.Tickets),
+		"unplaced", len(payload.Unplaced),
+	)
+	return nil
+}
+
+// CancelledPassengerPayload is one passenger in an OrderPassengersCancelled event
+type CancelledPassengerPayload struct {
+	PassengerIndex int      `json:"passenger_index"`
+	PassengerNID   string   `json:"passenger_nid"`
+	PassengerName  string   `json:"passenger_name"`
+	SeatNumbers    []string `json:"seat_numbers"`
+}
+
+// OrderPassengersCancelledPayload matches the event published when passengers are cancelled out of an order
+type OrderPassengersCancelledPayload struct {
+	OrderID    string                      `json:"order_id"`
+	BookingID  string                      `json:"booking_id"`
+	Passengers []CancelledPassengerPayload `json:"passengers"`
+	Reason     string                      `json:"reason"`
+}
+
+// handleOrderPassengersCancelled cancels the tickets of passengers cancelled out of an order
+func (c *OrderEventConsumer) handleOrderPassengersCancelled(ctx context.Context, event *kafka.Event) error {
+	payloadBytes, err := json.Marshal(event.Payload)
+	if err != nil {
+		logger.Error("failed to marshal payload", "error", err)
+		return err
+	}
+
+	var payload OrderPassengersCancelledPayload
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		logger.Error("failed to unmarshal OrderPassengersCancelled payload", "error", err)
+		return err
+	}
+
+	holders := make([]service.CancelledHolder, 0, len(payload.Passengers))
+	for _, p := range payload.Passengers {
+		holders = append(holders, service.CancelledHolder{
+			PassengerNID: p.PassengerNID,
+			SeatNumbers:  p.SeatNumbers,
+		})
+	}
+
+	cancelled, err := c.fulfillmentService.CancelPassengerTickets(ctx, payload.OrderID, holders)
+	if err != nil {
+		logger.Error("failed to cancel passenger tickets",
+			"order_id", payload.OrderID,
+			"error", err,
+		)
+		return err
+	}
+
+	logger.Info("tickets cancelled for cancelled passengers",
+		"order_id", payload.OrderID,
+		"ticket_count", len(cancelled),
+	)
+	return nil
+}
+
+// OrderChangedPayload matches the event published when an order moves to new seats or another trip
+type OrderChangedPayload struct {
+	OrderID        string `json:"order_id"`
+	UserID         string `json:"user_id"`
+	OrganizationID string `json:"organization_id"`
+	OldTripID      string `json:"old_trip_id"`
+	OldBookingID   string `json:"old_booking_id"`
+	TripID         string `json:"trip_id"`
+	BookingID      string `json:"booking_id"`
+	TotalPaisa     int64  `json:"total_paisa"`
+}
+
+// handleOrderChanged reissues the tickets of an order moved to a new booking: the old
+// booking's tickets are cancelled and the new booking is ticketed
+func (c *OrderEventConsumer) handleOrderChanged(ctx context.Context, event *kafka.Event) error {
+	payloadBytes, err := json.Marshal(event.Payload)
+	if err != nil {
+		logger.Error("failed to marshal payload", "error", err)
+		return err
+	}
+
+	var payload OrderChangedPayload
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		logger.Error("failed to unmarshal OrderChanged payload", "error", err)
+		return err
+	}
+
+	cancelled, err := c.fulfillmentService.CancelBookingTickets(ctx, payload.OrderID, payload.OldBookingID)
+	if err != nil {
+		logger.Error("failed to cancel tickets of old booking",
+			"order_id", payload.OrderID,
+			"error", err,
+		)
+		return err
+	}
+
+	order, err := c.orderClient.GetOrder(ctx, payload.OrderID, payload.UserID)
+	if err != nil {
+		logger.Error("failed to fetch order", "error", err)
+		return err
+	}
+
+	confirmed := &OrderConfirmedPayload{
+		OrderID:        payload.OrderID,
+		UserID:         payload.UserID,
+		OrganizationID: payload.OrganizationID,
+		TripID:         payload.TripID,
+		BookingID:      payload.BookingID,
+		TotalPaisa:     payload.TotalPaisa,
+	}
+	if err := c.generateLegTickets(ctx, confirmed, order, payload.TripID, payload.BookingID, payload.TotalPaisa); err != nil {
+		return err
+	}
+
+	logger.Info("tickets reissued after order change",
+		"order_id", payload.OrderID,
+		"old_booking_id", payload.OldBookingID,
+		"booking_id", payload.BookingID,
+		"cancelled", len(cancelled),
 	)
+	return nil
+}
+
+// PassengerTransferredPayload matches the event published when a passenger's ticket is
+// handed to a new traveller
+type PassengerTransferredPayload struct {
+	OrderID        string   `json:"order_id"`
+	BookingID      string   `json:"booking_id"`
+	PassengerIndex int      `json:"passenger_index"`
+	OldNID         string   `json:"old_nid"`
+	NewNID         string   `json:"new_nid"`
+	NewName        string   `json:"new_name"`
+	SeatNumbers    []string `json:"seat_numbers"`
+}
 
+// handlePassengerTransferred cancels the old holder's tickets and issues new ones to the
+// traveller who took their place
+func (c *OrderEventConsumer) handlePassengerTransferred(ctx context.Context, event *kafka.Event) error {
+	payloadBytes, err := json.Marshal(event.Payload)
+	if err != nil {
+		logger.Error("failed to marshal payload", "error", err)
+		return err
+	}
+
+	var payload PassengerTransferredPayload
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		logger.Error("failed to unmarshal PassengerTransferred payload", "error", err)
+		return err
+	}
+
+	resp, err := c.fulfillmentService.TransferTickets(ctx, &service.TransferTicketsReq{
+		OrderID:     payload.OrderID,
+		BookingID:   payload.BookingID,
+		OldNID:      payload.OldNID,
+		NewNID:      payload.NewNID,
+		NewName:     payload.NewName,
+		SeatNumbers: payload.SeatNumbers,
+	})
+	if err != nil {
+		logger.Error("failed to transfer tickets",
+			"order_id", payload.OrderID,
+			"error", err,
+		)
+		return err
+	}
+
+	logger.Info("tickets reissued after transfer",
+		"order_id", payload.OrderID,
+		"passenger_index", payload.PassengerIndex,
+		"ticket_count", len(resp.Tickets),
+	)
 	return nil
 }
 
@@ -147,6 +436,28 @@ func buildPassengerSeats(order *orderpb.Order, totalPaisa int64) []service.Passe
 		return nil
 	}
 
+	// Split-seat journeys: one ticket per seat leg, issued to the passenger on that leg
+	if hasSeatLegs(order) {
+		var passengers []service.PassengerSeat
+		for _, seat := range order.Seats {
+			var nid, name string
+			if idx := int(seat.PassengerIndex); idx < len(order.Passengers) {
+				nid, name = order.Passengers[idx].Nid, order.Passengers[idx].Name
+			}
+			passengers = append(passengers, service.PassengerSeat{
+				NID:         nid,
+				Name:        name,
+				SeatID:      seat.SeatId,
+				SeatNumber:  seat.SeatNumber,
+				SeatClass:   seat.SeatClass,
+				PricePaisa:  seat.PricePaisa,
+				FromStation: seat.FromStationId,
+				ToStation:   seat.ToStationId,
+			})
+		}
+		return passengers
+	}
+
 	seatPrices := make(map[string]int64)
 	seatClass := make(map[string]string)
 	seatNumber := make(map[string]string)
@@ -198,6 +509,37 @@ func buildPassengerSeats(order *orderpb.Order, totalPaisa int64) []service.Passe
 	return passengers
 }
 
+func hasSeatLegs(order *orderpb.Order) bool {
+	for _, seat := range order.Seats {
+		if seat.FromStationId != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveLegStations replaces seat leg station IDs with station names for the ticket
+func (c *OrderEventConsumer) resolveLegStations(ctx context.Context, orgID string, passengers []service.PassengerSeat, names map[string]string) error {
+	for i := range passengers {
+		if passengers[i].FromStation == "" {
+			continue
+		}
+		for _, station := range []*string{&passengers[i].FromStation, &passengers[i].ToStation} {
+			name, ok := names[*station]
+			if !ok {
+				st, err := c.catalogClient.GetStation(ctx, orgID, *station)
+				if err != nil {
+					return err
+				}
+				name = st.Name
+				names[*station] = name
+			}
+			*station = name
+		}
+	}
+	return nil
+}
+
 func max(a, b int) int {
 	if a > b {
 		return a
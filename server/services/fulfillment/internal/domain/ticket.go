@@ -4,6 +4,7 @@ import "time"
 
 type Ticket struct {
 	ID             string `json:"id"`
+	PNR            string `json:"pnr"` // Booking reference of the order
 	BookingID      string `json:"booking_id"`
 	OrderID        string `json:"order_id"`
 	OrganizationID string `json:"organization_id"`
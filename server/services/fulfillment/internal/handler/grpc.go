@@ -46,6 +46,7 @@ func (h *GrpcHandler) GenerateTickets(ctx context.Context, req *pb.GenerateTicke
 		Passengers:     passengers,
 		ContactEmail:   req.ContactEmail,
 		ContactPhone:   req.ContactPhone,
+		PNR:            req.Pnr,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -141,6 +142,7 @@ func ticketToProto(t *domain.Ticket) *pb.Ticket {
 	}
 	return &pb.Ticket{
 		Id:             t.ID,
+		Pnr:            t.PNR,
 		BookingId:      t.BookingID,
 		OrderId:        t.OrderID,
 		OrganizationId: t.OrganizationID,
@@ -48,6 +48,13 @@ func (g *Generator) GenerateTicketPDF(ticket *domain.Ticket, qrPNG []byte) ([]by
 		pdf.Ln(65)
 	}
 
+	// PNR, the reference passengers quote to find their booking
+	if ticket.PNR != "" {
+		pdf.SetFont("Arial", "B", 16)
+		pdf.SetTextColor(0, 0, 0)
+		pdf.CellFormat(0, 8, fmt.Sprintf("PNR: %s", ticket.PNR), "", 1, "C", false, 0, "")
+	}
+
 	// Ticket ID
 	pdf.SetFont("Arial", "", 10)
 	pdf.SetTextColor(128, 128, 128)
@@ -150,8 +157,14 @@ func (g *Generator) GenerateMultiTicketPDF(tickets []*domain.Ticket, qrPNGs map[
 		}
 
 		// Details
-		pdf.SetFont("Arial", "B", 11)
 		pdf.SetTextColor(0, 0, 0)
+		if ticket.PNR != "" {
+			pdf.SetFont("Arial", "B", 11)
+			pdf.CellFormat(40, 7, "PNR:", "", 0, "L", false, 0, "")
+			pdf.CellFormat(0, 7, ticket.PNR, "", 1, "L", false, 0, "")
+		}
+
+		pdf.SetFont("Arial", "B", 11)
 		pdf.CellFormat(40, 7, "Passenger:", "", 0, "L", false, 0, "")
 		pdf.SetFont("Arial", "", 11)
 		pdf.CellFormat(0, 7, ticket.PassengerName, "", 1, "L", false, 0, "")
@@ -46,6 +46,7 @@ type GenerateTicketsReq struct {
 	Passengers     []PassengerSeat
 	ContactEmail   string
 	ContactPhone   string
+	PNR            string
 }
 
 type PassengerSeat struct {
@@ -55,6 +56,9 @@ type PassengerSeat struct {
 	SeatNumber string
 	SeatClass  string
 	PricePaisa int64
+	// Split-seat journeys: the leg this seat covers (empty = the whole journey)
+	FromStation string
+	ToStation   string
 }
 
 type GenerateTicketsResp struct {
@@ -65,17 +69,20 @@ type GenerateTicketsResp struct {
 
 func (s *FulfillmentService) GenerateTickets(ctx context.Context, req *GenerateTicketsReq) (*GenerateTicketsResp, error) {
 	var tickets []*domain.Ticket
-	qrPNGs := make(map[string][]byte)
-
 	for _, p := range req.Passengers {
+		fromStation, toStation := req.FromStation, req.ToStation
+		if p.FromStation != "" {
+			fromStation, toStation = p.FromStation, p.ToStation
+		}
 		ticket := &domain.Ticket{
+			PNR:            req.PNR,
 			BookingID:      req.BookingID,
 			OrderID:        req.OrderID,
 			OrganizationID: req.OrganizationID,
 			TripID:         req.TripID,
 			RouteName:      req.RouteName,
-			FromStation:    req.FromStation,
-			ToStation:      req.ToStation,
+			FromStation:    fromStation,
+			ToStation:      toStation,
 			DepartureTime:  req.DepartureTime,
 			ArrivalTime:    req.ArrivalTime,
 			PassengerNID:   p.NID,
@@ -91,6 +98,191 @@ func (s *FulfillmentService) GenerateTickets(ctx context.Context, req *GenerateT
 		tickets = append(tickets, ticket)
 	}
 
+	objectKey := fmt.Sprintf("tickets/%s-%s.pdf", req.OrderID, req.BookingID)
+	return s.issueTickets(ctx, tickets, objectKey)
+}
+
+// SeatChange moves one ticket holder to another seat on the same trip
+type SeatChange struct {
+	PassengerNID  string
+	OldSeatNumber string
+	NewSeatNumber string
+}
+
+type ReissueTicketsReq struct {
+	OrderID   string
+	BookingID string
+	Changes   []SeatChange
+}
+
+// ReissueTickets cancels the active tickets of passengers who changed seats and
+// issues replacements on their new seats. Boarded tickets are left alone.
+func (s *FulfillmentService) ReissueTickets(ctx context.Context, req *ReissueTicketsReq) (*GenerateTicketsResp, error) {
+	existing, err := s.ticketRepo.ListByOrder(ctx, req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var replaced []*domain.Ticket
+	var tickets []*domain.Ticket
+	for _, change := range req.Changes {
+		for _, old := range existing {
+			if old.Status != domain.TicketStatusActive || old.IsBoarded || old.SeatNumber != change.OldSeatNumber {
+				continue
+			}
+			if change.PassengerNID != "" && old.PassengerNID != change.PassengerNID {
+				continue
+			}
+
+			ticket := *old
+			ticket.ID = ""
+			ticket.SeatNumber = change.NewSeatNumber
+			ticket.QRCodeData, ticket.QRCodeURL, ticket.PDFURL = "", "", ""
+			ticket.CreatedAt = time.Time{}
+			tickets = append(tickets, &ticket)
+			replaced = append(replaced, old)
+			old.Status = domain.TicketStatusCancelled // Not matched twice
+			break
+		}
+	}
+	if len(tickets) == 0 {
+		return &GenerateTicketsResp{}, nil
+	}
+
+	for _, old := range replaced {
+		if err := s.ticketRepo.UpdateStatus(ctx, old.ID, domain.TicketStatusCancelled); err != nil {
+			return nil, err
+		}
+	}
+
+	objectKey := fmt.Sprintf("tickets/%s-%s-%d.pdf", req.OrderID, req.BookingID, time.Now().Unix())
+	return s.issueTickets(ctx, tickets, objectKey)
+}
+
+type TransferTicketsReq struct {
+	OrderID     string
+	BookingID   string
+	OldNID      string
+	NewNID      string
+	NewName     string
+	SeatNumbers []string // Seats the passenger holds; every seat of the old holder when empty
+}
+
+// TransferTickets cancels the old holder's active tickets on an order and issues copies
+// in the new traveller's name, with new QR codes. Boarded tickets are left alone, and a
+// repeated transfer finds no tickets left to move.
+func (s *FulfillmentService) TransferTickets(ctx context.Context, req *TransferTicketsReq) (*GenerateTicketsResp, error) {
+	existing, err := s.ticketRepo.ListByOrder(ctx, req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	seats := make(map[string]bool, len(req.SeatNumbers))
+	for _, n := range req.SeatNumbers {
+		seats[n] = true
+	}
+
+	var replaced []*domain.Ticket
+	var tickets []*domain.Ticket
+	for _, old := range existing {
+		if old.Status != domain.TicketStatusActive || old.IsBoarded || old.PassengerNID != req.OldNID {
+			continue
+		}
+		if len(seats) > 0 && !seats[old.SeatNumber] {
+			continue
+		}
+
+		ticket := *old
+		ticket.ID = ""
+		ticket.PassengerNID = req.NewNID
+		ticket.PassengerName = req.NewName
+		ticket.QRCodeData, ticket.QRCodeURL, ticket.PDFURL = "", "", ""
+		ticket.CreatedAt = time.Time{}
+		tickets = append(tickets, &ticket)
+		replaced = append(replaced, old)
+	}
+	if len(tickets) == 0 {
+		return &GenerateTicketsResp{}, nil
+	}
+
+	for _, old := range replaced {
+		if err := s.ticketRepo.UpdateStatus(ctx, old.ID, domain.TicketStatusCancelled); err != nil {
+			return nil, err
+		}
+	}
+
+	objectKey := fmt.Sprintf("tickets/%s-%s-%d.pdf", req.OrderID, req.BookingID, time.Now().Unix())
+	return s.issueTickets(ctx, tickets, objectKey)
+}
+
+// CancelledHolder is a passenger cancelled out of an order and the seats they held;
+// capacity passengers have no seat numbers
+type CancelledHolder struct {
+	PassengerNID string
+	SeatNumbers  []string
+}
+
+// CancelPassengerTickets cancels the active tickets of passengers cancelled out of an
+// order. Other passengers' tickets stay valid and boarded tickets are left alone.
+// Returns the tickets cancelled.
+func (s *FulfillmentService) CancelPassengerTickets(ctx context.Context, orderID string, holders []CancelledHolder) ([]*domain.Ticket, error) {
+	existing, err := s.ticketRepo.ListByOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cancelled []*domain.Ticket
+	for _, holder := range holders {
+		seats := make(map[string]bool, len(holder.SeatNumbers))
+		for _, n := range holder.SeatNumbers {
+			seats[n] = true
+		}
+		if holder.PassengerNID == "" && len(seats) == 0 {
+			continue // Nothing identifies the passenger's tickets
+		}
+		for _, ticket := range existing {
+			if ticket.Status != domain.TicketStatusActive || ticket.IsBoarded || ticket.PassengerNID != holder.PassengerNID {
+				continue
+			}
+			if len(seats) > 0 && !seats[ticket.SeatNumber] {
+				continue
+			}
+			if err := s.ticketRepo.UpdateStatus(ctx, ticket.ID, domain.TicketStatusCancelled); err != nil {
+				return nil, err
+			}
+			ticket.Status = domain.TicketStatusCancelled
+			cancelled = append(cancelled, ticket)
+		}
+	}
+	return cancelled, nil
+}
+
+// CancelBookingTickets cancels the active tickets of one booking of an order, as when the
+// order moves to another booking. Boarded tickets are left alone. Returns the tickets cancelled.
+func (s *FulfillmentService) CancelBookingTickets(ctx context.Context, orderID, bookingID string) ([]*domain.Ticket, error) {
+	existing, err := s.ticketRepo.ListByOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cancelled []*domain.Ticket
+	for _, ticket := range existing {
+		if ticket.Status != domain.TicketStatusActive || ticket.IsBoarded || ticket.BookingID != bookingID {
+			continue
+		}
+		if err := s.ticketRepo.UpdateStatus(ctx, ticket.ID, domain.TicketStatusCancelled); err != nil {
+			return nil, err
+		}
+		ticket.Status = domain.TicketStatusCancelled
+		cancelled = append(cancelled, ticket)
+	}
+	return cancelled, nil
+}
+
+// issueTickets stores new tickets, renders their QR codes and combined PDF, and uploads the PDF
+func (s *FulfillmentService) issueTickets(ctx context.Context, tickets []*domain.Ticket, objectKey string) (*GenerateTicketsResp, error) {
+	qrPNGs := make(map[string][]byte)
+
 	// Create tickets in DB first to get IDs
 	if err := s.ticketRepo.CreateBatch(ctx, tickets); err != nil {
 		return nil, fmt.Errorf("failed to create tickets: %w", err)
@@ -113,7 +305,6 @@ func (s *FulfillmentService) GenerateTickets(ctx context.Context, req *GenerateT
 	}
 
 	// Upload to MinIO (Private)
-	objectKey := fmt.Sprintf("tickets/%s-%s.pdf", req.OrderID, req.BookingID)
 	_, err = s.storage.Upload(ctx, objectKey, pdfData, "application/pdf")
 	if err != nil {
 		return nil, fmt.Errorf("failed to upload PDF: %w", err)
@@ -40,6 +40,10 @@ func main() {
 	defer rateLimiter.Close()
 	r.Use(rateLimiter.Middleware)
 
+	// Stricter limit for the public PNR lookups, which take guesses at booking contacts
+	guestRateLimiter := middleware.NewNamedRateLimiter(cfg.RedisURL, "guest", 10, 60) // 10 req/min
+	defer guestRateLimiter.Close()
+
 	// Entitlement enforcement (requires active subscription for protected routes)
 	entitlementMW := middleware.NewEntitlementMiddleware(cfg.RedisURL)
 	r.Use(entitlementMW.Middleware)
@@ -76,6 +80,9 @@ func main() {
 		logger.Error("Failed to connect to inventory service", "error", err)
 	} else {
 		defer inventoryHandler.Close()
+		if catalogHandler != nil {
+			catalogHandler.SetInventoryHandler(inventoryHandler)
+		}
 	}
 
 	orderHandler, err := handler.NewOrderHandler(cfg.OrderURL, orderCB)
@@ -195,6 +202,11 @@ func main() {
 		fulfillmentHandler = handler.NewFulfillmentHandler(fulfillmentClient)
 	}
 
+	var guestHandler *handler.GuestHandler
+	if orderHandler != nil {
+		guestHandler = handler.NewGuestHandler(orderHandler, fulfillmentClient)
+	}
+
 	var queueHandler *handler.QueueHandler
 	if queueClient != nil {
 		queueHandler = handler.NewQueueHandler(queueClient)
@@ -278,6 +290,8 @@ func main() {
 			"/v1/fleet/location",  // Allow location updates without forced user token? Probably secure it.
 			"/v1/trips/",          // PUBLIC VIEW (SeatMap/Availability)
 			"/v1/holds",           // PUBLIC ACTION (Hold Seats)
+			"/v1/availability",    // PUBLIC VIEW (Availability counts for listing pages)
+			"/v1/guest/",          // PUBLIC (Guest order access by PNR + contact)
 			"/v1/trips/*/updates", // Public SSE for Seat Updates (Wildcard match might require custom logic, but let's try)
 		},
 	})
@@ -320,6 +334,11 @@ func main() {
 			r.Get("/trips/search", catalogHandler.SearchTrips)
 		}
 
+		// Availability counts for listing pages (public)
+		if inventoryHandler != nil {
+			r.Post("/availability/counts", inventoryHandler.GetAvailabilityCounts)
+		}
+
 		// Search routes (public)
 		if searchHandler != nil {
 			r.Get("/search/trips", searchHandler.SearchTrips)
@@ -409,6 +428,7 @@ func main() {
 			r.Post("/schedules/{scheduleId}/generate", catalogHandler.GenerateTripInstances)
 			r.Get("/trip-instances/{tripId}", catalogHandler.GetTripInstance)
 			r.Post("/trip-instances/{tripId}/cancel", catalogHandler.CancelTrip)
+			r.Post("/trip-instances/{tripId}/vehicle", catalogHandler.ReassignTripVehicle)
 		}
 
 		// Inventory routes (protected)
@@ -416,7 +436,32 @@ func main() {
 			r.Get("/trips/{tripId}/availability", inventoryHandler.CheckAvailability)
 			r.Get("/trips/{tripId}/seatmap", inventoryHandler.GetSeatMap)
 			r.Post("/holds", inventoryHandler.HoldSeats)
+			r.Patch("/holds/{holdId}", inventoryHandler.ExtendHold)
 			r.Delete("/holds/{holdId}", inventoryHandler.ReleaseHold)
+
+			r.Group(func(r chi.Router) {
+				r.Use(middleware.RequireRole("operator", "admin"))
+				r.Get("/trips/{tripId}/manifest", inventoryHandler.GetTripManifest)
+				r.Get("/trips/{tripId}/reaccommodations", inventoryHandler.GetReaccommodations)
+				r.Get("/trips/{tripId}/seat-ledger", inventoryHandler.GetSeatLedger)
+				r.Post("/trips/{tripId}/seats/block", inventoryHandler.BlockTripSeats)
+				r.Post("/trips/{tripId}/seats/unblock", inventoryHandler.UnblockTripSeats)
+				r.Post("/schedules/{scheduleId}/seats/block", inventoryHandler.BlockScheduleSeats)
+				r.Post("/schedules/{scheduleId}/seats/unblock", inventoryHandler.UnblockScheduleSeats)
+				r.Post("/trips/{tripId}/release-tranches", inventoryHandler.CreateTripReleaseTranches)
+				r.Get("/trips/{tripId}/release-tranches", inventoryHandler.ListTripReleaseTranches)
+				r.Post("/trips/{tripId}/release-tranches/{trancheId}/release", inventoryHandler.ReleaseTripTranche)
+				r.Post("/schedules/{scheduleId}/release-tranches", inventoryHandler.CreateScheduleReleaseTranches)
+				r.Get("/schedules/{scheduleId}/release-tranches", inventoryHandler.ListScheduleReleaseTranches)
+				r.Post("/schedules/{scheduleId}/release-tranches/{trancheId}/release", inventoryHandler.ReleaseScheduleTranche)
+			})
+
+			// Organization Hold Policy (Admin Only)
+			r.Route("/organizations/{orgId}/hold-policy", func(r chi.Router) {
+				r.Use(middleware.RequireRole("admin"))
+				r.Put("/", inventoryHandler.UpdateHoldPolicy)
+				r.Get("/", inventoryHandler.GetHoldPolicy)
+			})
 		}
 
 		// Order routes (protected)
@@ -424,7 +469,82 @@ func main() {
 			r.Post("/orders", orderHandler.CreateOrder)
 			r.Get("/orders", orderHandler.ListOrders)
 			r.Get("/orders/{orderId}", orderHandler.GetOrder)
+			r.Get("/orders/{orderId}/refund-quote", orderHandler.GetRefundQuote)
 			r.Post("/orders/{orderId}/cancel", orderHandler.CancelOrder)
+			r.Post("/orders/{orderId}/passengers/cancel", orderHandler.CancelPassengers)
+			r.Get("/orders/{orderId}/change-quote", orderHandler.GetChangeQuote)
+			r.Post("/orders/{orderId}/change", orderHandler.ChangeOrder)
+			r.Get("/orders/{orderId}/passengers/{index}/transfer-quote", orderHandler.GetTransferQuote)
+			r.Post("/orders/{orderId}/passengers/{index}/transfer", orderHandler.TransferPassenger)
+
+			r.Group(func(r chi.Router) {
+				r.Use(middleware.RequireRole("operator", "admin"))
+				r.Get("/refund-policies", orderHandler.ListRefundPolicies)
+				r.Put("/refund-policies", orderHandler.SetRefundPolicy)
+				r.Delete("/refund-policies/{policyId}", orderHandler.DeleteRefundPolicy)
+			})
+
+			// Saga administration (Admin Only)
+			r.Route("/sagas", func(r chi.Router) {
+				r.Use(middleware.RequireRole("admin"))
+				r.Get("/", orderHandler.ListSagas)
+				r.Get("/{sagaId}", orderHandler.GetSaga)
+				r.Post("/{sagaId}/retry", orderHandler.RetrySaga)
+				r.Post("/{sagaId}/compensate", orderHandler.CompensateSaga)
+				r.Post("/{sagaId}/resolve", orderHandler.ResolveSaga)
+			})
+
+			// Ticket transfer history, for spotting resold tickets (Admin Only)
+			r.Route("/transfers", func(r chi.Router) {
+				r.Use(middleware.RequireRole("admin"))
+				r.Get("/", orderHandler.ListTransfers)
+			})
+
+			// Fraud review of held bookings (Admin Only)
+			r.Route("/fraud-reviews", func(r chi.Router) {
+				r.Use(middleware.RequireRole("admin"))
+				r.Get("/", orderHandler.ListFraudReviews)
+				r.Post("/{orderId}", orderHandler.ReviewOrder)
+			})
+
+			// Counter sales for cash and the agents' till shifts (Agents)
+			r.Route("/counter", func(r chi.Router) {
+				r.Use(middleware.RequireRole("agent", "admin"))
+				r.Post("/orders", orderHandler.CreateCounterOrder)
+				r.Post("/shifts", orderHandler.OpenShift)
+				r.Get("/shifts/current", orderHandler.GetCurrentShift)
+				r.Post("/shifts/current/close", orderHandler.CloseShift)
+			})
+
+			// Shift reports (Admin Only)
+			r.Route("/shifts", func(r chi.Router) {
+				r.Use(middleware.RequireRole("admin"))
+				r.Get("/{shiftId}", orderHandler.GetShiftReport)
+			})
+
+			// Corporate and group accounts with their invoices (Operators)
+			r.Route("/accounts", func(r chi.Router) {
+				r.Use(middleware.RequireRole("operator", "admin"))
+				r.Get("/", orderHandler.ListCorporateAccounts)
+				r.Post("/", orderHandler.CreateCorporateAccount)
+				r.Get("/{accountId}", orderHandler.GetCorporateAccount)
+				r.Put("/{accountId}", orderHandler.UpdateCorporateAccount)
+				r.Get("/{accountId}/invoices", orderHandler.ListAccountInvoices)
+				r.Get("/{accountId}/invoices/{invoiceId}", orderHandler.GetAccountInvoice)
+				r.Post("/{accountId}/invoices/{invoiceId}/pay", orderHandler.MarkInvoicePaid)
+			})
+		}
+
+		// Guest order access by PNR and booking contact (public, stricter rate limit)
+		if guestHandler != nil {
+			r.Route("/guest/orders", func(r chi.Router) {
+				r.Use(guestRateLimiter.Middleware)
+				r.Post("/lookup", guestHandler.LookupOrder)
+				r.Post("/refund-quote", guestHandler.GetRefundQuote)
+				r.Post("/cancel", guestHandler.CancelOrder)
+				r.Post("/tickets", guestHandler.ListTickets)
+				r.Post("/tickets/{ticketId}/download", guestHandler.DownloadTicket)
+			})
 		}
 
 		// Payment routes (protected)
@@ -40,6 +40,7 @@ func New(brokers []string, realtimeMgr *realtime.Manager) (*EventConsumer, error
 	consumer.RegisterHandler(kafka.EventSeatsHeld, ec.handleSeatUpdate)
 	consumer.RegisterHandler(kafka.EventSeatsReleased, ec.handleSeatUpdate)
 	consumer.RegisterHandler(kafka.EventSeatsBooked, ec.handleSeatUpdate)
+	consumer.RegisterHandler(kafka.EventSeatsBlocked, ec.handleSeatUpdate)
 
 	return ec, nil
 }
@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	orderpb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/gateway/internal/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// CorporateAccountRequest is the settings of a corporate or group account
+type CorporateAccountRequest struct {
+	Name             string   `json:"name"`
+	Kind             string   `json:"kind"`             // corporate, group
+	Status           string   `json:"status,omitempty"` // active (default), suspended
+	BillingEmail     string   `json:"billing_email"`
+	CreditLimitPaisa int64    `json:"credit_limit_paisa"`
+	PaymentTermsDays int32    `json:"payment_terms_days,omitempty"`
+	BookerIDs        []string `json:"booker_ids"`
+	Travellers       []struct {
+		NID  string `json:"nid"`
+		Name string `json:"name"`
+	} `json:"travellers,omitempty"`
+	MaxGroupSize int32 `json:"max_group_size,omitempty"`
+}
+
+// CreateCorporateAccount opens a credit account for a company or tour group
+func (h *OrderHandler) CreateCorporateAccount(w http.ResponseWriter, r *http.Request) {
+	h.saveCorporateAccount(w, r, "", http.StatusCreated)
+}
+
+// UpdateCorporateAccount changes an account's settings
+func (h *OrderHandler) UpdateCorporateAccount(w http.ResponseWriter, r *http.Request) {
+	h.saveCorporateAccount(w, r, chi.URLParam(r, "accountId"), http.StatusOK)
+}
+
+func (h *OrderHandler) saveCorporateAccount(w http.ResponseWriter, r *http.Request, accountID string, code int) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	var req CorporateAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	account := &orderpb.CorporateAccount{
+		Id:               accountID,
+		OrganizationId:   orgID,
+		Name:             req.Name,
+		Kind:             req.Kind,
+		Status:           req.Status,
+		BillingEmail:     req.BillingEmail,
+		CreditLimitPaisa: req.CreditLimitPaisa,
+		PaymentTermsDays: req.PaymentTermsDays,
+		BookerIds:        req.BookerIDs,
+		MaxGroupSize:     req.MaxGroupSize,
+	}
+	for _, t := range req.Travellers {
+		account.Travellers = append(account.Travellers, &orderpb.ApprovedTraveller{Nid: t.NID, Name: t.Name})
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.SaveCorporateAccount(ctx, &orderpb.SaveCorporateAccountRequest{
+			Account: account,
+			ActorId: middleware.GetUserID(r.Context()),
+		})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to save account")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(accountToJSON(result.(*orderpb.CorporateAccount)))
+}
+
+// ListCorporateAccounts lists the accounts of the caller's organization
+func (h *OrderHandler) ListCorporateAccounts(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ListCorporateAccounts(ctx, &orderpb.ListCorporateAccountsRequest{
+			OrganizationId: middleware.GetOrgID(r.Context()),
+		})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to list accounts")
+		return
+	}
+	resp := result.(*orderpb.ListCorporateAccountsResponse)
+
+	accounts := make([]map[string]interface{}, 0, len(resp.Accounts))
+	for _, a := range resp.Accounts {
+		accounts = append(accounts, accountToJSON(a))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"accounts": accounts})
+}
+
+// GetCorporateAccount returns an account with its outstanding balance
+func (h *OrderHandler) GetCorporateAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetCorporateAccount(ctx, &orderpb.GetCorporateAccountRequest{
+			OrganizationId: middleware.GetOrgID(r.Context()),
+			AccountId:      chi.URLParam(r, "accountId"),
+		})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to get account")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(accountToJSON(result.(*orderpb.CorporateAccount)))
+}
+
+// ListAccountInvoices lists an account's monthly invoices, newest first
+func (h *OrderHandler) ListAccountInvoices(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ListAccountInvoices(ctx, &orderpb.ListAccountInvoicesRequest{
+			OrganizationId: middleware.GetOrgID(r.Context()),
+			AccountId:      chi.URLParam(r, "accountId"),
+		})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to list invoices")
+		return
+	}
+	resp := result.(*orderpb.ListAccountInvoicesResponse)
+
+	invoices := make([]map[string]interface{}, 0, len(resp.Invoices))
+	for _, inv := range resp.Invoices {
+		invoices = append(invoices, invoiceToJSON(inv))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"invoices": invoices})
+}
+
+// GetAccountInvoice returns an invoice with the orders and refunds billed on it
+func (h *OrderHandler) GetAccountInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetAccountInvoice(ctx, &orderpb.GetAccountInvoiceRequest{
+			OrganizationId: middleware.GetOrgID(r.Context()),
+			AccountId:      chi.URLParam(r, "accountId"),
+			InvoiceId:      chi.URLParam(r, "invoiceId"),
+		})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to get invoice")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(invoiceToJSON(result.(*orderpb.AccountInvoice)))
+}
+
+// MarkInvoicePaid records that an account paid an invoice
+func (h *OrderHandler) MarkInvoicePaid(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.MarkInvoicePaid(ctx, &orderpb.MarkInvoicePaidRequest{
+			OrganizationId: middleware.GetOrgID(r.Context()),
+			AccountId:      chi.URLParam(r, "accountId"),
+			InvoiceId:      chi.URLParam(r, "invoiceId"),
+			ActorId:        middleware.GetUserID(r.Context()),
+		})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to mark invoice paid")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(invoiceToJSON(result.(*orderpb.AccountInvoice)))
+}
+
+func accountToJSON(a *orderpb.CorporateAccount) map[string]interface{} {
+	travellers := make([]map[string]interface{}, 0, len(a.Travellers))
+	for _, t := range a.Travellers {
+		travellers = append(travellers, map[string]interface{}{"nid": t.Nid, "name": t.Name})
+	}
+	return map[string]interface{}{
+		"id":                     a.Id,
+		"name":                   a.Name,
+		"kind":                   a.Kind,
+		"status":                 a.Status,
+		"billing_email":          a.BillingEmail,
+		"currency":               a.Currency,
+		"credit_limit_paisa":     a.CreditLimitPaisa,
+		"outstanding_paisa":      a.OutstandingPaisa,
+		"available_credit_paisa": a.CreditLimitPaisa - a.OutstandingPaisa,
+		"payment_terms_days":     a.PaymentTermsDays,
+		"booker_ids":             a.BookerIds,
+		"travellers":             travellers,
+		"max_group_size":         a.MaxGroupSize,
+		"created_at":             unixToRFC3339(a.CreatedAt),
+		"updated_at":             unixToRFC3339(a.UpdatedAt),
+	}
+}
+
+func invoiceToJSON(inv *orderpb.AccountInvoice) map[string]interface{} {
+	out := map[string]interface{}{
+		"id":           inv.Id,
+		"account_id":   inv.AccountId,
+		"period_start": unixToRFC3339(inv.PeriodStart),
+		"period_end":   unixToRFC3339(inv.PeriodEnd),
+		"total_paisa":  inv.TotalPaisa,
+		"currency":     inv.Currency,
+		"status":       inv.Status,
+		"issued_at":    unixToRFC3339(inv.IssuedAt),
+		"due_at":       unixToRFC3339(inv.DueAt),
+		"paid_at":      unixToRFC3339(inv.PaidAt),
+	}
+	if len(inv.Lines) > 0 {
+		lines := make([]map[string]interface{}, 0, len(inv.Lines))
+		for _, l := range inv.Lines {
+			lines = append(lines, map[string]interface{}{
+				"order_id":     l.OrderId,
+				"amount_paisa": l.AmountPaisa,
+				"description":  l.Description,
+				"created_at":   unixToRFC3339(l.CreatedAt),
+			})
+		}
+		out["lines"] = lines
+	}
+	return out
+}
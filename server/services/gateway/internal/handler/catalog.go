@@ -11,6 +11,7 @@ import (
 	"time"
 
 	catalogpb "github.com/MuhibNayem/Travio/server/api/proto/catalog/v1"
+	inventorypb "github.com/MuhibNayem/Travio/server/api/proto/inventory/v1"
 	"github.com/MuhibNayem/Travio/server/pkg/logger"
 	"github.com/MuhibNayem/Travio/server/services/gateway/internal/middleware"
 	"github.com/go-chi/chi/v5"
@@ -25,6 +26,7 @@ type CatalogHandler struct {
 	catalogConn *grpc.ClientConn
 	client      catalogpb.CatalogServiceClient
 	cb          *middleware.CircuitBreaker
+	inventory   *InventoryHandler // Optional: live seat counts for trip listings
 }
 
 // NewCatalogHandler creates a catalog handler with gRPC connection
@@ -40,6 +42,19 @@ func NewCatalogHandler(catalogURL string, cb *middleware.CircuitBreaker) (*Catal
 	}, nil
 }
 
+// SetInventoryHandler lets trip listings show live seat counts from inventory
+func (h *CatalogHandler) SetInventoryHandler(inventory *InventoryHandler) {
+	h.inventory = inventory
+}
+
+// liveAvailableSeats returns inventory's seat counts for listed trips, keyed by trip ID
+func (h *CatalogHandler) liveAvailableSeats(ctx context.Context, queries []*inventorypb.AvailabilityCountQuery) map[string]int32 {
+	if h.inventory == nil {
+		return nil
+	}
+	return h.inventory.AvailableSeats(ctx, queries)
+}
+
 // ListStations returns all stations
 func (h *CatalogHandler) ListStations(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
@@ -121,6 +136,17 @@ func (h *CatalogHandler) SearchTrips(w http.ResponseWriter, r *http.Request) {
 	}
 	resp := result.(*catalogpb.SearchTripsResponse)
 
+	queries := make([]*inventorypb.AvailabilityCountQuery, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		queries = append(queries, &inventorypb.AvailabilityCountQuery{
+			OrganizationId: r.Trip.OrganizationId,
+			TripId:         r.Trip.Id,
+			FromStationId:  r.OriginStation.GetId(),
+			ToStationId:    r.DestinationStation.GetId(),
+		})
+	}
+	liveSeats := h.liveAvailableSeats(ctx, queries)
+
 	// Convert to JSON-friendly format
 	results := make([]map[string]interface{}, 0, len(resp.Results))
 	for _, r := range resp.Results {
@@ -129,6 +155,11 @@ func (h *CatalogHandler) SearchTrips(w http.ResponseWriter, r *http.Request) {
 		origin := r.OriginStation
 		dest := r.DestinationStation
 
+		availableSeats, ok := liveSeats[trip.Id]
+		if !ok {
+			availableSeats = trip.TotalSeats
+		}
+
 		// Get price from Pricing field
 		var price int64
 		if trip.Pricing != nil {
@@ -145,7 +176,7 @@ func (h *CatalogHandler) SearchTrips(w http.ResponseWriter, r *http.Request) {
 			"arrival_time":    time.Unix(trip.ArrivalTime, 0).Format(time.RFC3339),
 			"price":           price,
 			"class":           trip.VehicleClass,
-			"available_seats": trip.TotalSeats,
+			"available_seats": availableSeats,
 			"total_seats":     trip.TotalSeats,
 			"from":            origin.Name,
 			"from_city":       origin.City,
@@ -340,8 +371,20 @@ func (h *CatalogHandler) ListTripInstances(w http.ResponseWriter, r *http.Reques
 	}
 	resp := result.(*catalogpb.ListTripInstancesResponse)
 
+	queries := make([]*inventorypb.AvailabilityCountQuery, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		queries = append(queries, &inventorypb.AvailabilityCountQuery{
+			OrganizationId: r.Trip.OrganizationId,
+			TripId:         r.Trip.Id,
+		})
+	}
+	liveSeats := h.liveAvailableSeats(ctx, queries)
+
 	results := make([]map[string]interface{}, 0, len(resp.Results))
 	for _, r := range resp.Results {
+		if seats, ok := liveSeats[r.Trip.Id]; ok {
+			r.Trip.AvailableSeats = seats
+		}
 		results = append(results, map[string]interface{}{
 			"trip":                tripToJSON(r.Trip),
 			"route":               routeToJSON(r.Route),
@@ -473,6 +516,54 @@ func (h *CatalogHandler) CancelTrip(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(tripToJSON(resp))
 }
 
+// ReassignTripVehicle moves a trip instance onto another vehicle. Inventory then
+// re-accommodates the booked passengers on the new vehicle's seats.
+func (h *CatalogHandler) ReassignTripVehicle(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	tripID := chi.URLParam(r, "tripId")
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		orgID = r.Header.Get("X-Organization-ID")
+	}
+
+	var req struct {
+		VehicleID string `json:"vehicle_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ReassignTripVehicle(ctx, &catalogpb.ReassignTripVehicleRequest{
+			Id:             tripID,
+			OrganizationId: orgID,
+			VehicleId:      req.VehicleID,
+		})
+	})
+	if err != nil {
+		logger.Error("Failed to reassign trip vehicle", "trip_id", tripID, "error", err)
+		st, _ := status.FromError(err)
+		switch st.Code() {
+		case codes.InvalidArgument:
+			http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusBadRequest)
+		case codes.NotFound:
+			http.Error(w, `{"error": "trip not found"}`, http.StatusNotFound)
+		case codes.FailedPrecondition:
+			http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusConflict)
+		default:
+			http.Error(w, "Failed to reassign trip vehicle", http.StatusInternalServerError)
+		}
+		return
+	}
+	resp := result.(*catalogpb.Trip)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tripToJSON(resp))
+}
+
 // Schedule request/response helpers
 type ScheduleRequest struct {
 	RouteID              string             `json:"route_id"`
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	orderpb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/gateway/internal/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// ChangeOrderRequest moves a confirmed order to another trip of its route or to other seats
+type ChangeOrderRequest struct {
+	TripID       string   `json:"trip_id,omitempty"` // Same trip when empty
+	SeatIDs      []string `json:"seat_ids"`          // New seat of each remaining passenger, in order
+	HoldID       string   `json:"hold_id,omitempty"`
+	PaymentToken string   `json:"payment_token,omitempty"` // Required when the change costs more
+	// Amount due the user accepted from the quote; a higher amount rejects the change
+	ExpectedAmountDuePaisa *int64 `json:"expected_amount_due_paisa,omitempty"`
+}
+
+// ChangeOrder swaps the order's booking for the new trip or seats, collecting or refunding the difference
+func (h *OrderHandler) ChangeOrder(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 15*time.Second)
+	defer cancel()
+
+	var req ChangeOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	changeReq := &orderpb.ChangeOrderRequest{
+		OrderId:      chi.URLParam(r, "orderId"),
+		UserId:       middleware.GetUserID(r.Context()),
+		TripId:       req.TripID,
+		SeatIds:      req.SeatIDs,
+		HoldId:       req.HoldID,
+		PaymentToken: req.PaymentToken,
+	}
+	if req.ExpectedAmountDuePaisa != nil {
+		changeReq.ExpectedAmountDuePaisa = *req.ExpectedAmountDuePaisa
+		changeReq.CheckExpectedAmount = true
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ChangeOrder(ctx, changeReq)
+	})
+	if err != nil {
+		writeRefundError(w, err, "Failed to change order")
+		return
+	}
+	resp := result.(*orderpb.ChangeOrderResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": resp.Success,
+		"order":   orderToJSON(resp.Order),
+		"change":  orderChangeToJSON(resp.Change),
+	})
+}
+
+// GetChangeQuote shows what moving the order to the trip_id and repeated seat_id query
+// parameters would cost now
+func (h *OrderHandler) GetChangeQuote(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetChangeQuote(ctx, &orderpb.ChangeOrderRequest{
+			OrderId: chi.URLParam(r, "orderId"),
+			UserId:  middleware.GetUserID(r.Context()),
+			TripId:  r.URL.Query().Get("trip_id"),
+			SeatIds: r.URL.Query()["seat_id"],
+		})
+	})
+	if err != nil {
+		writeRefundError(w, err, "Failed to quote change")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"quote": changeQuoteToJSON(result.(*orderpb.ChangeQuote)),
+	})
+}
+
+func changeQuoteToJSON(q *orderpb.ChangeQuote) map[string]interface{} {
+	if q == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"policy_id":              q.PolicyId,
+		"policy_name":            q.PolicyName,
+		"departure_time":         unixToRFC3339(q.DepartureTime),
+		"hours_before_departure": q.HoursBeforeDeparture,
+		"new_trip_id":            q.NewTripId,
+		"old_total_paisa":        q.OldTotalPaisa,
+		"new_total_paisa":        q.NewTotalPaisa,
+		"fare_difference_paisa":  q.FareDifferencePaisa,
+		"change_fee_paisa":       q.ChangeFeePaisa,
+		"amount_due_paisa":       q.AmountDuePaisa,
+		"currency":               q.Currency,
+		"quoted_at":              unixToRFC3339(q.QuotedAt),
+	}
+}
+
+func orderChangeToJSON(c *orderpb.OrderChange) map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"old_trip_id":    c.OldTripId,
+		"old_booking_id": c.OldBookingId,
+		"old_seats":      seatsToJSON(c.OldSeats),
+		"new_trip_id":    c.NewTripId,
+		"new_booking_id": c.NewBookingId,
+		"quote":          changeQuoteToJSON(c.Quote),
+		"payment_id":     c.PaymentId,
+		"refund_id":      c.RefundId,
+		"refund_failed":  c.RefundFailed,
+		"changed_at":     unixToRFC3339(c.ChangedAt),
+	}
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	orderpb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/gateway/internal/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// CreateCounterOrder sells an order to a walk-in passenger for cash. The agent must have
+// an open shift; the booking is made before the response, so a confirmed order's tickets
+// can be printed from the links returned with it.
+func (h *OrderHandler) CreateCounterOrder(w http.ResponseWriter, r *http.Request) {
+	// Counter bookings run to the end before responding
+	ctx, cancel := context.WithTimeout(r.Context(), 60*time.Second)
+	defer cancel()
+
+	var req CreateOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	idempotencyKey := r.Header.Get("X-Idempotency-Key")
+	if idempotencyKey == "" {
+		idempotencyKey = req.IdempotencyKey
+	}
+	if idempotencyKey == "" {
+		http.Error(w, "Idempotency key required", http.StatusBadRequest)
+		return
+	}
+
+	// Seats sold at the counter may come from the counter quota
+	if req.QuotaClaims.Channel == "" {
+		req.QuotaClaims.Channel = "counter"
+	}
+	pbReq := createOrderRequestToProto(r, &req, idempotencyKey)
+	pbReq.PaymentMethod = &orderpb.PaymentMethod{Type: "cash"}
+	pbReq.AgentId = middleware.GetUserID(r.Context())
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.CreateOrder(ctx, pbReq)
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to create order")
+		return
+	}
+	order := result.(*orderpb.CreateOrderResponse).Order
+
+	out := orderToJSON(order)
+	code := http.StatusCreated
+	switch order.Status {
+	case orderpb.OrderStatus_ORDER_STATUS_CONFIRMED:
+		out["tickets_url"] = "/v1/orders/" + order.Id + "/tickets"
+	case orderpb.OrderStatus_ORDER_STATUS_FAILED:
+		code = http.StatusConflict // Nothing was sold; no cash should be taken
+	default:
+		code = http.StatusAccepted // Held for fraud review
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(out)
+}
+
+// openShiftRequest is the cash in an agent's drawer at the start of a shift
+type openShiftRequest struct {
+	OpeningFloatPaisa int64 `json:"opening_float_paisa"`
+}
+
+// OpenShift opens a till session for the calling agent
+func (h *OrderHandler) OpenShift(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	var body openShiftRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.OpenShift(ctx, &orderpb.OpenShiftRequest{
+			OrganizationId:    middleware.GetOrgID(r.Context()),
+			AgentId:           middleware.GetUserID(r.Context()),
+			OpeningFloatPaisa: body.OpeningFloatPaisa,
+		})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to open shift")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(shiftToJSON(result.(*orderpb.AgentShift)))
+}
+
+// GetCurrentShift reports on the calling agent's open shift so far
+func (h *OrderHandler) GetCurrentShift(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetCurrentShift(ctx, &orderpb.GetCurrentShiftRequest{
+			OrganizationId: middleware.GetOrgID(r.Context()),
+			AgentId:        middleware.GetUserID(r.Context()),
+		})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to get shift")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(shiftReportToJSON(result.(*orderpb.ShiftReport)))
+}
+
+// closeShiftRequest is the cash an agent counted in their drawer at the end of a shift
+type closeShiftRequest struct {
+	CountedCashPaisa int64  `json:"counted_cash_paisa"`
+	Note             string `json:"note"`
+}
+
+// CloseShift closes the calling agent's open shift and returns the closing report
+func (h *OrderHandler) CloseShift(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	var body closeShiftRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.CloseShift(ctx, &orderpb.CloseShiftRequest{
+			OrganizationId:   middleware.GetOrgID(r.Context()),
+			AgentId:          middleware.GetUserID(r.Context()),
+			CountedCashPaisa: body.CountedCashPaisa,
+			Note:             body.Note,
+		})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to close shift")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(shiftReportToJSON(result.(*orderpb.ShiftReport)))
+}
+
+// GetShiftReport reports on any shift of the caller's organization, for supervisors
+func (h *OrderHandler) GetShiftReport(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetShiftReport(ctx, &orderpb.GetShiftReportRequest{
+			OrganizationId: middleware.GetOrgID(r.Context()),
+			ShiftId:        chi.URLParam(r, "shiftId"),
+		})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to get shift report")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(shiftReportToJSON(result.(*orderpb.ShiftReport)))
+}
+
+func shiftToJSON(s *orderpb.AgentShift) map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"id":                  s.Id,
+		"agent_id":            s.AgentId,
+		"status":              s.Status,
+		"opening_float_paisa": s.OpeningFloatPaisa,
+		"cash_sales_paisa":    s.CashSalesPaisa,
+		"cash_refunds_paisa":  s.CashRefundsPaisa,
+		"currency":            s.Currency,
+		"opened_at":           unixToRFC3339(s.OpenedAt),
+		"counted_cash_paisa":  s.CountedCashPaisa,
+		"expected_cash_paisa": s.ExpectedCashPaisa,
+		"variance_paisa":      s.VariancePaisa,
+		"note":                s.Note,
+		"closed_at":           unixToRFC3339(s.ClosedAt),
+	}
+}
+
+func shiftReportToJSON(r *orderpb.ShiftReport) map[string]interface{} {
+	return map[string]interface{}{
+		"shift":                  shiftToJSON(r.Shift),
+		"confirmed_orders":       r.ConfirmedOrders,
+		"confirmed_cash_paisa":   r.ConfirmedCashPaisa,
+		"pending_orders":         r.PendingOrders,
+		"unrecorded_sales_paisa": r.UnrecordedSalesPaisa,
+	}
+}
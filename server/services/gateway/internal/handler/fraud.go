@@ -49,6 +49,7 @@ func (h *FraudHandler) AnalyzeBooking(w http.ResponseWriter, r *http.Request) {
 		BookingsLast24Hours int32    `json:"bookings_last_24_hours"`
 		BookingsLastWeek    int32    `json:"bookings_last_week"`
 		PreviousFraudFlags  int32    `json:"previous_fraud_flags"`
+		DeviceFingerprint   string   `json:"device_fingerprint"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -81,6 +82,7 @@ func (h *FraudHandler) AnalyzeBooking(w http.ResponseWriter, r *http.Request) {
 		BookingsLast_24Hours: req.BookingsLast24Hours,
 		BookingsLastWeek:     req.BookingsLastWeek,
 		PreviousFraudFlags:   req.PreviousFraudFlags,
+		DeviceFingerprint:    req.DeviceFingerprint,
 	})
 	if err != nil {
 		logger.Error("Fraud analysis failed", "error", err)
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	orderpb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/gateway/internal/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// ListFraudReviews lists the orders the fraud check held for an analyst, oldest first.
+// Query: organization_id, page_size, page_token.
+func (h *OrderHandler) ListFraudReviews(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	q := r.URL.Query()
+	req := &orderpb.ListFraudReviewsRequest{
+		OrganizationId: q.Get("organization_id"),
+		PageToken:      q.Get("page_token"),
+	}
+	if v := q.Get("page_size"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, `{"error": "invalid page_size"}`, http.StatusBadRequest)
+			return
+		}
+		req.PageSize = int32(size)
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ListFraudReviews(ctx, req)
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to list fraud reviews")
+		return
+	}
+	resp := result.(*orderpb.ListOrdersResponse)
+
+	orders := make([]map[string]interface{}, 0, len(resp.Orders))
+	for _, o := range resp.Orders {
+		orders = append(orders, orderToJSON(o))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"orders":    orders,
+		"next_page": resp.NextPageToken,
+		"total":     resp.TotalCount,
+	})
+}
+
+// reviewOrderRequest is an analyst's decision on a held order
+type reviewOrderRequest struct {
+	Decision string `json:"decision"` // approve or reject
+	Note     string `json:"note"`
+}
+
+// ReviewOrder approves a held order, resuming its booking, or rejects it, releasing its seats
+func (h *OrderHandler) ReviewOrder(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	var body reviewOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if body.Decision != "approve" && body.Decision != "reject" {
+		http.Error(w, `{"error": "decision must be approve or reject"}`, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ReviewOrder(ctx, &orderpb.ReviewOrderRequest{
+			OrderId:    chi.URLParam(r, "orderId"),
+			Approve:    body.Decision == "approve",
+			ReviewerId: middleware.GetUserID(r.Context()),
+			Note:       body.Note,
+		})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Fraud review failed")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(orderToJSON(result.(*orderpb.Order)))
+}
+
+// clientIP returns the client's address without its port
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
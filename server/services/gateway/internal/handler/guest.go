@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	orderpb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/gateway/internal/client"
+	"github.com/go-chi/chi/v5"
+)
+
+// GuestHandler lets customers without an account reach their booking by its PNR and
+// the phone number or email it was booked with. The routes are public, so they sit
+// behind a stricter rate limit.
+type GuestHandler struct {
+	orders      *OrderHandler
+	fulfillment *client.FulfillmentClient // Nil when the fulfillment service is unavailable
+}
+
+// NewGuestHandler creates a guest handler over the order handler and fulfillment client
+func NewGuestHandler(orders *OrderHandler, fulfillmentClient *client.FulfillmentClient) *GuestHandler {
+	return &GuestHandler{orders: orders, fulfillment: fulfillmentClient}
+}
+
+// GuestOrderRequest identifies a guest's order. It is sent in the body so the contact
+// details stay out of URLs and access logs.
+type GuestOrderRequest struct {
+	PNR     string `json:"pnr"`
+	Contact string `json:"contact"` // Phone number or email the order was booked with
+}
+
+// GuestCancelRequest cancels a guest's order
+type GuestCancelRequest struct {
+	GuestOrderRequest
+	Reason string `json:"reason"`
+	// Refund the guest accepted from the quote; a lower refund rejects the cancellation
+	ExpectedRefundPaisa int64 `json:"expected_refund_paisa"`
+}
+
+// LookupOrder returns the order matching the PNR and contact
+func (h *GuestHandler) LookupOrder(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	var req GuestOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	order, err := h.lookup(ctx, &req)
+	if err != nil {
+		writeRefundError(w, err, "Failed to find order")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(orderToJSON(order))
+}
+
+// GetRefundQuote shows the guest what cancelling the order now would refund
+func (h *GuestHandler) GetRefundQuote(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	var req GuestOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.orders.cb.Execute(func() (interface{}, error) {
+		return h.orders.client.GetRefundQuoteByPNR(ctx, &orderpb.GetOrderByPNRRequest{
+			Pnr:     req.PNR,
+			Contact: req.Contact,
+		})
+	})
+	if err != nil {
+		writeRefundError(w, err, "Failed to quote refund")
+		return
+	}
+	resp := result.(*orderpb.GetRefundQuoteResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"quote":       refundBreakdownToJSON(resp.Quote),
+		"total_paisa": resp.TotalPaisa,
+		"currency":    resp.Currency,
+	})
+}
+
+// CancelOrder cancels the guest's order under the refund policy
+func (h *GuestHandler) CancelOrder(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	var req GuestCancelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.orders.cb.Execute(func() (interface{}, error) {
+		return h.orders.client.CancelOrderByPNR(ctx, &orderpb.CancelOrderByPNRRequest{
+			Pnr:                 req.PNR,
+			Contact:             req.Contact,
+			Reason:              req.Reason,
+			ExpectedRefundPaisa: req.ExpectedRefundPaisa,
+		})
+	})
+	if err != nil {
+		writeRefundError(w, err, "Failed to cancel order")
+		return
+	}
+	resp := result.(*orderpb.CancelOrderResponse)
+
+	response := map[string]interface{}{
+		"success": resp.Success,
+		"order":   orderToJSON(resp.Order),
+	}
+	if resp.Refund != nil {
+		response["refund"] = refundInfoToJSON(resp.Refund)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListTickets returns the tickets issued for the guest's order
+func (h *GuestHandler) ListTickets(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	if h.fulfillment == nil {
+		http.Error(w, `{"error": "fulfillment service unavailable"}`, http.StatusServiceUnavailable)
+		return
+	}
+	var req GuestOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	order, err := h.lookup(ctx, &req)
+	if err != nil {
+		writeRefundError(w, err, "Failed to find order")
+		return
+	}
+	tickets, err := h.fulfillment.ListTickets(ctx, order.Id)
+	if err != nil {
+		logger.Error("Failed to get guest order tickets", "order_id", order.Id, "error", err)
+		http.Error(w, `{"error": "fulfillment service unavailable"}`, http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"tickets": tickets})
+}
+
+// DownloadTicket returns the PDF of one of the guest order's tickets
+func (h *GuestHandler) DownloadTicket(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	if h.fulfillment == nil {
+		http.Error(w, `{"error": "fulfillment service unavailable"}`, http.StatusServiceUnavailable)
+		return
+	}
+	var req GuestOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	order, err := h.lookup(ctx, &req)
+	if err != nil {
+		writeRefundError(w, err, "Failed to find order")
+		return
+	}
+	ticketID := chi.URLParam(r, "ticketId")
+	ticket, err := h.fulfillment.GetTicket(ctx, ticketID)
+	if err != nil || ticket.OrderId != order.Id {
+		http.Error(w, `{"error": "ticket not found"}`, http.StatusNotFound)
+		return
+	}
+
+	pdfResp, err := h.fulfillment.GetTicketPDF(ctx, ticketID)
+	if err != nil {
+		logger.Error("Failed to download guest ticket", "ticket_id", ticketID, "error", err)
+		http.Error(w, `{"error": "fulfillment service unavailable"}`, http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", pdfResp.ContentType)
+	w.Header().Set("Content-Disposition", "attachment; filename="+pdfResp.Filename)
+	w.Write(pdfResp.PdfData)
+}
+
+func (h *GuestHandler) lookup(ctx context.Context, req *GuestOrderRequest) (*orderpb.Order, error) {
+	result, err := h.orders.cb.Execute(func() (interface{}, error) {
+		return h.orders.client.GetOrderByPNR(ctx, &orderpb.GetOrderByPNRRequest{
+			Pnr:     req.PNR,
+			Contact: req.Contact,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*orderpb.Order), nil
+}
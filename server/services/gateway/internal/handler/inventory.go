@@ -3,15 +3,19 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	inventorypb "github.com/MuhibNayem/Travio/server/api/proto/inventory/v1"
 	"github.com/MuhibNayem/Travio/server/services/gateway/internal/middleware"
 	"github.com/go-chi/chi/v5"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 // InventoryHandler handles inventory-related REST endpoints
@@ -50,18 +54,32 @@ func (h *InventoryHandler) CheckAvailability(w http.ResponseWriter, r *http.Requ
 		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
 		return
 	}
+	capacityItems, err := parseCapacityItems(r.URL.Query().Get("capacity"))
+	if err != nil {
+		http.Error(w, `{"error": "capacity must look like deck:2,standing:1"}`, http.StatusBadRequest)
+		return
+	}
 	passengers, _ := strconv.Atoi(r.URL.Query().Get("passengers"))
-	if passengers == 0 {
+	if passengers == 0 && len(capacityItems) == 0 {
 		passengers = 1
 	}
 
 	result, err := h.cb.Execute(func() (interface{}, error) {
 		return h.client.CheckAvailability(ctx, &inventorypb.CheckAvailabilityRequest{
-			OrganizationId: orgID,
-			TripId:         tripID,
-			FromStationId:  fromStation,
-			ToStationId:    toStation,
-			Passengers:     int32(passengers),
+			OrganizationId:  orgID,
+			TripId:          tripID,
+			FromStationId:   fromStation,
+			ToStationId:     toStation,
+			Passengers:      int32(passengers),
+			AllowSeatChange: r.URL.Query().Get("allow_seat_change") == "true",
+			QuotaClaims: quotaClaims(r, QuotaClaimsJSON{
+				Female:    r.URL.Query().Get("female") == "true",
+				Disabled:  r.URL.Query().Get("disabled") == "true",
+				Channel:   r.URL.Query().Get("channel"),
+				PartnerID: r.URL.Query().Get("partner_id"),
+				VIP:       r.URL.Query().Get("vip") == "true",
+			}),
+			CapacityItems: capacityItems,
 		})
 	})
 	if err != nil {
@@ -81,15 +99,114 @@ func (h *InventoryHandler) CheckAvailability(w http.ResponseWriter, r *http.Requ
 		})
 	}
 
+	quotas := make([]map[string]interface{}, 0, len(resp.Quotas))
+	for _, q := range resp.Quotas {
+		quotas = append(quotas, map[string]interface{}{
+			"quota_id":        q.QuotaId,
+			"name":            q.Name,
+			"quota_type":      q.QuotaType,
+			"available_seats": q.AvailableSeats,
+			"eligible":        q.Eligible,
+		})
+	}
+
+	plans := make([]map[string]interface{}, 0, len(resp.SeatChangePlans))
+	for _, p := range resp.SeatChangePlans {
+		legs := make([]map[string]interface{}, 0, len(p.Legs))
+		for _, leg := range p.Legs {
+			legs = append(legs, map[string]interface{}{
+				"seat_id":         leg.SeatId,
+				"seat_number":     leg.SeatNumber,
+				"seat_class":      leg.SeatClass,
+				"from_station_id": leg.FromStationId,
+				"to_station_id":   leg.ToStationId,
+				"segment_indexes": leg.SegmentIndexes,
+				"price_paisa":     leg.PricePaisa,
+			})
+		}
+		plans = append(plans, map[string]interface{}{
+			"passenger_index": p.PassengerIndex,
+			"legs":            legs,
+			"price_paisa":     p.PricePaisa,
+		})
+	}
+
+	capacity := make([]map[string]interface{}, 0, len(resp.Capacity))
+	for _, c := range resp.Capacity {
+		capacity = append(capacity, map[string]interface{}{
+			"capacity_class": c.CapacityClass,
+			"max_count":      c.MaxCount,
+			"available":      c.Available,
+			"price_paisa":    c.PricePaisa,
+		})
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"is_available":    resp.IsAvailable,
-		"available_seats": resp.AvailableSeats,
-		"price_paisa":     resp.PricePaisa,
-		"seats":           seats,
+		"is_available":      resp.IsAvailable,
+		"available_seats":   resp.AvailableSeats,
+		"price_paisa":       resp.PricePaisa,
+		"seats":             seats,
+		"quotas":            quotas,
+		"seat_change_plans": plans,
+		"capacity":          capacity,
 	})
 }
 
+// parseCapacityItems reads "deck:2,standing:1" into capacity items
+func parseCapacityItems(raw string) ([]*inventorypb.CapacityItem, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var items []*inventorypb.CapacityItem
+	for _, part := range strings.Split(raw, ",") {
+		class, qty, ok := strings.Cut(strings.TrimSpace(part), ":")
+		quantity, err := strconv.Atoi(qty)
+		if !ok || class == "" || err != nil || quantity <= 0 {
+			return nil, fmt.Errorf("invalid capacity item %q", part)
+		}
+		items = append(items, &inventorypb.CapacityItem{CapacityClass: class, Quantity: int32(quantity)})
+	}
+	return items, nil
+}
+
+// QuotaClaimsJSON describes what the caller may buy from reserved seat quotas
+type QuotaClaimsJSON struct {
+	Female    bool   `json:"female"`
+	Disabled  bool   `json:"disabled"`
+	Channel   string `json:"channel"` // counter, partner
+	PartnerID string `json:"partner_id"`
+	VIP       bool   `json:"vip"`
+}
+
+// quotaClaims converts caller claims, dropping sales-channel and VIP claims unless
+// the caller is operator staff; counter agents may only claim the counter channel.
+// Ladies/disabled claims are self-declared and checked at boarding.
+func quotaClaims(r *http.Request, c QuotaClaimsJSON) *inventorypb.QuotaClaims {
+	claims := &inventorypb.QuotaClaims{
+		Female:   c.Female,
+		Disabled: c.Disabled,
+	}
+	switch {
+	case isOperatorStaff(r):
+		claims.Channel = c.Channel
+		claims.PartnerId = c.PartnerID
+		claims.Vip = c.VIP
+	case middleware.GetUserRole(r.Context()) == "agent" && c.Channel == "counter":
+		claims.Channel = c.Channel
+	}
+	return claims
+}
+
+// isOperatorStaff reports whether the caller works for the operator
+func isOperatorStaff(r *http.Request) bool {
+	switch middleware.GetUserRole(r.Context()) {
+	case "operator", "admin":
+		return true
+	}
+	return false
+}
+
 // GetSeatMap returns the seat map for a trip
 func (h *InventoryHandler) GetSeatMap(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
@@ -109,10 +226,11 @@ func (h *InventoryHandler) GetSeatMap(w http.ResponseWriter, r *http.Request) {
 
 	result, err := h.cb.Execute(func() (interface{}, error) {
 		return h.client.GetSeatMap(ctx, &inventorypb.GetSeatMapRequest{
-			OrganizationId: orgID,
-			TripId:         tripID,
-			FromStationId:  fromStation,
-			ToStationId:    toStation,
+			OrganizationId:      orgID,
+			TripId:              tripID,
+			FromStationId:       fromStation,
+			ToStationId:         toStation,
+			IncludeBlockDetails: isOperatorStaff(r),
 		})
 	})
 	if err != nil {
@@ -121,31 +239,70 @@ func (h *InventoryHandler) GetSeatMap(w http.ResponseWriter, r *http.Request) {
 	}
 	resp := result.(*inventorypb.GetSeatMapResponse)
 
-	rows := make([]map[string]interface{}, 0)
-	for _, row := range resp.Rows {
-		seats := make([]map[string]interface{}, 0)
+	sections := make([]map[string]interface{}, 0, len(resp.Sections))
+	for _, sec := range resp.Sections {
+		sections = append(sections, map[string]interface{}{
+			"section_id":          sec.SectionId,
+			"name":                sec.Name,
+			"kind":                sec.Kind,
+			"level":               sec.Level,
+			"total_rows":          sec.TotalRows,
+			"total_columns":       sec.TotalColumns,
+			"aisle_after_columns": sec.AisleAfterColumns,
+			"rows":                seatRowsJSON(sec.Rows),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"vehicle_id":    resp.VehicleId,
+		"vehicle_type":  resp.VehicleType,
+		"total_rows":    resp.TotalRows,
+		"total_columns": resp.TotalColumns,
+		"sections":      sections,
+		"rows":          seatRowsJSON(resp.Rows),
+		"legend":        resp.Legend.StatusColors,
+	})
+}
+
+func seatRowsJSON(rows []*inventorypb.SeatRow) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rows))
+	for _, row := range rows {
+		seats := make([]map[string]interface{}, 0, len(row.Seats))
 		for _, s := range row.Seats {
+			segments := make([]map[string]interface{}, 0, len(s.SegmentStatuses))
+			for _, seg := range s.SegmentStatuses {
+				segments = append(segments, map[string]interface{}{
+					"segment_index": seg.SegmentIndex,
+					"status":        seg.Status.String(),
+				})
+			}
 			seats = append(seats, map[string]interface{}{
-				"seat_id":     s.SeatId,
-				"seat_number": s.SeatNumber,
-				"column":      s.Column,
-				"seat_type":   s.SeatType,
-				"seat_class":  s.SeatClass,
-				"status":      s.Status.String(),
-				"price_paisa": s.PricePaisa,
+				"seat_id":          s.SeatId,
+				"seat_number":      s.SeatNumber,
+				"row":              s.Row,
+				"column":           s.Column,
+				"section_id":       s.SectionId,
+				"berth":            s.Berth,
+				"seat_type":        s.SeatType,
+				"seat_class":       s.SeatClass,
+				"status":           s.Status.String(),
+				"price_paisa":      s.PricePaisa,
+				"is_accessible":    s.IsAccessible,
+				"has_power":        s.HasPower,
+				"hold_expires_at":  s.HoldExpiresAt,
+				"segment_statuses": segments,
+				"quota_type":       s.QuotaType,
+				"block_reason":     s.BlockReason,
+				"blocked_until":    s.BlockedUntil,
 			})
 		}
-		rows = append(rows, map[string]interface{}{
+		result = append(result, map[string]interface{}{
 			"row_number": row.RowNumber,
 			"seats":      seats,
 		})
 	}
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"rows":   rows,
-		"legend": resp.Legend.StatusColors,
-	})
+	return result
 }
 
 // HoldSeatsRequest represents the request to hold seats
@@ -155,6 +312,57 @@ type HoldSeatsRequest struct {
 	ToStationID   string   `json:"to_station_id"`
 	SeatIDs       []string `json:"seat_ids"`
 	SessionID     string   `json:"session_id"`
+	// Best-available mode: used when seat_ids is empty
+	SeatCount   int                  `json:"seat_count"`
+	Preferences *SeatPreferencesJSON `json:"preferences,omitempty"`
+	QuotaClaims QuotaClaimsJSON      `json:"quota_claims"`
+	// Split-seat mode: a seat change plan from the availability check, replaces seat_ids
+	SeatLegs []SeatLegJSON `json:"seat_legs,omitempty"`
+	// Deck or standing places, alone or alongside any seat mode
+	CapacityItems []CapacityItemJSON `json:"capacity_items,omitempty"`
+}
+
+// CapacityItemJSON is a quantity of unnumbered places of one class
+type CapacityItemJSON struct {
+	CapacityClass string `json:"capacity_class"` // deck, standing
+	Quantity      int    `json:"quantity"`
+}
+
+// SeatBlockRequest selects seats to block or unblock by ID or seat number
+type SeatBlockRequest struct {
+	SeatIDs        []string   `json:"seat_ids"`
+	SeatNumbers    []string   `json:"seat_numbers"`
+	SegmentIndexes []int32    `json:"segment_indexes"` // Empty = every segment
+	Reason         string     `json:"reason"`
+	UnblockAt      *time.Time `json:"unblock_at,omitempty"` // Block only, RFC3339
+	BlockID        string     `json:"block_id,omitempty"`   // Schedule unblock only
+}
+
+// ExtendHoldRequest asks for more time on a hold; zero uses the organization's extension step
+type ExtendHoldRequest struct {
+	ExtendBySeconds int `json:"extend_by_seconds"`
+}
+
+// HoldPolicyJSON is an organization's hold lifetime and extension limits
+type HoldPolicyJSON struct {
+	MaxHoldLifetimeSeconds int `json:"max_hold_lifetime_seconds"`
+	MaxExtensions          int `json:"max_extensions"`
+	ExtensionSeconds       int `json:"extension_seconds"`
+}
+
+// SeatLegJSON places a passenger in a seat for part of the journey
+type SeatLegJSON struct {
+	PassengerIndex int    `json:"passenger_index"`
+	SeatID         string `json:"seat_id"`
+	FromStationID  string `json:"from_station_id"`
+	ToStationID    string `json:"to_station_id"`
+}
+
+// SeatPreferencesJSON steers best-available seat allocation
+type SeatPreferencesJSON struct {
+	SeatClass       string `json:"seat_class"`
+	Position        string `json:"position"` // window, aisle
+	RequireTogether bool   `json:"require_together"`
 }
 
 // HoldSeats creates a hold on selected seats
@@ -167,6 +375,37 @@ func (h *InventoryHandler) HoldSeats(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
+	if len(req.SeatIDs) == 0 && len(req.SeatLegs) == 0 && req.SeatCount <= 0 && len(req.CapacityItems) == 0 {
+		http.Error(w, `{"error": "seat_ids, seat_legs, seat_count or capacity_items is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	capacityItems := make([]*inventorypb.CapacityItem, 0, len(req.CapacityItems))
+	for _, item := range req.CapacityItems {
+		capacityItems = append(capacityItems, &inventorypb.CapacityItem{
+			CapacityClass: item.CapacityClass,
+			Quantity:      int32(item.Quantity),
+		})
+	}
+
+	seatLegs := make([]*inventorypb.SeatLegSelection, 0, len(req.SeatLegs))
+	for _, leg := range req.SeatLegs {
+		seatLegs = append(seatLegs, &inventorypb.SeatLegSelection{
+			PassengerIndex: int32(leg.PassengerIndex),
+			SeatId:         leg.SeatID,
+			FromStationId:  leg.FromStationID,
+			ToStationId:    leg.ToStationID,
+		})
+	}
+
+	var prefs *inventorypb.SeatPreferences
+	if req.Preferences != nil {
+		prefs = &inventorypb.SeatPreferences{
+			SeatClass:       req.Preferences.SeatClass,
+			Position:        req.Preferences.Position,
+			RequireTogether: req.Preferences.RequireTogether,
+		}
+	}
 
 	// Get user ID from auth header (simplified - in production use JWT claims)
 	userID := middleware.GetUserID(r.Context())
@@ -192,6 +431,11 @@ func (h *InventoryHandler) HoldSeats(w http.ResponseWriter, r *http.Request) {
 			UserId:              userID,
 			SessionId:           req.SessionID,
 			HoldDurationSeconds: 600, // 10 minutes
+			SeatCount:           int32(req.SeatCount),
+			Preferences:         prefs,
+			QuotaClaims:         quotaClaims(r, req.QuotaClaims),
+			SeatLegs:            seatLegs,
+			CapacityItems:       capacityItems,
 		})
 	})
 	if err != nil {
@@ -207,11 +451,20 @@ func (h *InventoryHandler) HoldSeats(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusConflict)
 	}
 
+	heldCapacity := make([]map[string]interface{}, 0, len(resp.HeldCapacity))
+	for _, c := range resp.HeldCapacity {
+		heldCapacity = append(heldCapacity, map[string]interface{}{
+			"capacity_class": c.CapacityClass,
+			"quantity":       c.Quantity,
+		})
+	}
+
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"hold_id":         resp.HoldId,
 		"success":         resp.Success,
 		"held_seat_ids":   resp.HeldSeatIds,
 		"failed_seat_ids": resp.FailedSeatIds,
+		"held_capacity":   heldCapacity,
 		"expires_at":      time.Unix(resp.ExpiresAt, 0).Format(time.RFC3339),
 		"failure_reason":  resp.FailureReason,
 	})
@@ -250,6 +503,693 @@ func (h *InventoryHandler) ReleaseHold(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ExtendHold gives the holder more time, within the organization's hold policy
+func (h *InventoryHandler) ExtendHold(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	var req ExtendHoldRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+	}
+
+	holdID := chi.URLParam(r, "holdId")
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		userID = "anonymous"
+	}
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		orgID = r.URL.Query().Get("org_id")
+	}
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ExtendHold(ctx, &inventorypb.ExtendHoldRequest{
+			OrganizationId:  orgID,
+			HoldId:          holdID,
+			UserId:          userID,
+			ExtendBySeconds: int32(req.ExtendBySeconds),
+		})
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if ok && st.Code() == codes.FailedPrecondition {
+			http.Error(w, `{"error": "hold not found or expired"}`, http.StatusGone)
+			return
+		}
+		http.Error(w, "Failed to extend hold", http.StatusInternalServerError)
+		return
+	}
+	resp := result.(*inventorypb.ExtendHoldResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusConflict)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"hold_id":              holdID,
+		"success":              resp.Success,
+		"expires_at":           time.Unix(resp.ExpiresAt, 0).Format(time.RFC3339),
+		"extensions_remaining": resp.ExtensionsRemaining,
+		"failure_reason":       resp.FailureReason,
+	})
+}
+
+// UpdateHoldPolicy sets an organization's hold lifetime and extension limits
+func (h *InventoryHandler) UpdateHoldPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	orgID := chi.URLParam(r, "orgId")
+	var req HoldPolicyJSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"error": "invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+
+	_, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.SetHoldPolicy(ctx, &inventorypb.SetHoldPolicyRequest{
+			Policy: &inventorypb.HoldPolicy{
+				OrganizationId:         orgID,
+				MaxHoldLifetimeSeconds: int32(req.MaxHoldLifetimeSeconds),
+				MaxExtensions:          int32(req.MaxExtensions),
+				ExtensionSeconds:       int32(req.ExtensionSeconds),
+			},
+		})
+	})
+	if err != nil {
+		http.Error(w, `{"error": "failed to update hold policy"}`, http.StatusInternalServerError)
+		return
+	}
+
+	h.GetHoldPolicy(w, r)
+}
+
+// GetHoldPolicy returns an organization's hold limits, or the defaults when none are set
+func (h *InventoryHandler) GetHoldPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	orgID := chi.URLParam(r, "orgId")
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetHoldPolicy(ctx, &inventorypb.GetHoldPolicyRequest{OrganizationId: orgID})
+	})
+	if err != nil {
+		http.Error(w, `{"error": "failed to get hold policy"}`, http.StatusInternalServerError)
+		return
+	}
+	policy := result.(*inventorypb.GetHoldPolicyResponse).Policy
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"organization_id":           orgID,
+		"max_hold_lifetime_seconds": policy.GetMaxHoldLifetimeSeconds(),
+		"max_extensions":            policy.GetMaxExtensions(),
+		"extension_seconds":         policy.GetExtensionSeconds(),
+	})
+}
+
+// BlockTripSeats takes seats on one trip out of sale
+func (h *InventoryHandler) BlockTripSeats(w http.ResponseWriter, r *http.Request) {
+	h.blockSeats(w, r, chi.URLParam(r, "tripId"), "")
+}
+
+// BlockScheduleSeats takes seats out of sale on every trip of a schedule, including future trips
+func (h *InventoryHandler) BlockScheduleSeats(w http.ResponseWriter, r *http.Request) {
+	h.blockSeats(w, r, "", chi.URLParam(r, "scheduleId"))
+}
+
+func (h *InventoryHandler) blockSeats(w http.ResponseWriter, r *http.Request, tripID, scheduleID string) {
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	var req SeatBlockRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	pbReq := &inventorypb.BlockSeatsRequest{
+		OrganizationId: orgID,
+		TripId:         tripID,
+		ScheduleId:     scheduleID,
+		SeatIds:        req.SeatIDs,
+		SeatNumbers:    req.SeatNumbers,
+		SegmentIndexes: req.SegmentIndexes,
+		Reason:         req.Reason,
+		BlockedBy:      middleware.GetUserID(r.Context()),
+	}
+	if req.UnblockAt != nil {
+		pbReq.UnblockAt = req.UnblockAt.Unix()
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.BlockSeats(ctx, pbReq)
+	})
+	if err != nil {
+		writeSeatBlockError(w, err, "Failed to block seats")
+		return
+	}
+	resp := result.(*inventorypb.BlockSeatsResponse)
+
+	trips := make([]map[string]interface{}, 0, len(resp.Trips))
+	for _, t := range resp.Trips {
+		trips = append(trips, map[string]interface{}{
+			"trip_id":          t.TripId,
+			"blocked_seat_ids": t.BlockedSeatIds,
+			"failed_seat_ids":  t.FailedSeatIds,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"block_id":      resp.BlockId,
+		"blocked_count": resp.BlockedCount,
+		"trips":         trips,
+	})
+}
+
+// UnblockTripSeats returns blocked seats on one trip to sale
+func (h *InventoryHandler) UnblockTripSeats(w http.ResponseWriter, r *http.Request) {
+	h.unblockSeats(w, r, chi.URLParam(r, "tripId"), "")
+}
+
+// UnblockScheduleSeats lifts a schedule-wide block on every trip of the schedule
+func (h *InventoryHandler) UnblockScheduleSeats(w http.ResponseWriter, r *http.Request) {
+	h.unblockSeats(w, r, "", chi.URLParam(r, "scheduleId"))
+}
+
+func (h *InventoryHandler) unblockSeats(w http.ResponseWriter, r *http.Request, tripID, scheduleID string) {
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	var req SeatBlockRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.UnblockSeats(ctx, &inventorypb.UnblockSeatsRequest{
+			OrganizationId: orgID,
+			TripId:         tripID,
+			ScheduleId:     scheduleID,
+			BlockId:        req.BlockID,
+			SeatIds:        req.SeatIDs,
+			SeatNumbers:    req.SeatNumbers,
+			SegmentIndexes: req.SegmentIndexes,
+			UnblockedBy:    middleware.GetUserID(r.Context()),
+		})
+	})
+	if err != nil {
+		writeSeatBlockError(w, err, "Failed to unblock seats")
+		return
+	}
+	resp := result.(*inventorypb.UnblockSeatsResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"unblocked_count": resp.UnblockedCount,
+	})
+}
+
+func writeSeatBlockError(w http.ResponseWriter, err error, fallback string) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusBadRequest)
+	case codes.NotFound:
+		http.Error(w, `{"error": "seat block not found"}`, http.StatusNotFound)
+	default:
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
+
+// GetTripManifest returns the conductor manifest, including where split-seat passengers change seats
+func (h *InventoryHandler) GetTripManifest(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	tripID := chi.URLParam(r, "tripId")
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetTripManifest(ctx, &inventorypb.GetTripManifestRequest{
+			OrganizationId: orgID,
+			TripId:         tripID,
+		})
+	})
+	if err != nil {
+		http.Error(w, "Failed to get manifest", http.StatusInternalServerError)
+		return
+	}
+	resp := result.(*inventorypb.GetTripManifestResponse)
+
+	passengers := make([]map[string]interface{}, 0, len(resp.Passengers))
+	for _, p := range resp.Passengers {
+		legs := make([]map[string]interface{}, 0, len(p.Legs))
+		for _, leg := range p.Legs {
+			legs = append(legs, map[string]interface{}{
+				"seat_id":         leg.SeatId,
+				"seat_number":     leg.SeatNumber,
+				"from_station_id": leg.FromStationId,
+				"to_station_id":   leg.ToStationId,
+				"ticket_id":       leg.TicketId,
+			})
+		}
+		passengers = append(passengers, map[string]interface{}{
+			"booking_id":              p.BookingId,
+			"passenger_name":          p.PassengerName,
+			"passenger_nid":           p.PassengerNid,
+			"from_station_id":         p.FromStationId,
+			"to_station_id":           p.ToStationId,
+			"legs":                    legs,
+			"seat_change_station_ids": p.SeatChangeStationIds,
+			"capacity_class":          p.CapacityClass,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"trip_id":    resp.TripId,
+		"passengers": passengers,
+	})
+}
+
+// GetReaccommodations returns where a vehicle change moved each passenger and who was left unplaced
+func (h *InventoryHandler) GetReaccommodations(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	tripID := chi.URLParam(r, "tripId")
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetReaccommodations(ctx, &inventorypb.GetReaccommodationsRequest{
+			OrganizationId: orgID,
+			TripId:         tripID,
+			UnplacedOnly:   r.URL.Query().Get("unplaced_only") == "true",
+		})
+	})
+	if err != nil {
+		http.Error(w, "Failed to get reaccommodations", http.StatusInternalServerError)
+		return
+	}
+	resp := result.(*inventorypb.GetReaccommodationsResponse)
+
+	entries := make([]map[string]interface{}, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		entries = append(entries, map[string]interface{}{
+			"reference_id":    e.ReferenceId,
+			"reference_type":  e.ReferenceType,
+			"order_id":        e.OrderId,
+			"passenger_index": e.PassengerIndex,
+			"passenger_name":  e.PassengerName,
+			"passenger_nid":   e.PassengerNid,
+			"seat_class":      e.SeatClass,
+			"old_seat_id":     e.OldSeatId,
+			"old_seat_number": e.OldSeatNumber,
+			"new_seat_id":     e.NewSeatId,
+			"new_seat_number": e.NewSeatNumber,
+			"old_ticket_id":   e.OldTicketId,
+			"new_ticket_id":   e.NewTicketId,
+			"status":          e.Status,
+			"old_vehicle_id":  e.OldVehicleId,
+			"new_vehicle_id":  e.NewVehicleId,
+			"created_at":      e.CreatedAt,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"trip_id": resp.TripId,
+		"entries": entries,
+	})
+}
+
+// GetSeatLedger returns every seat status change on a trip, optionally for one seat
+func (h *InventoryHandler) GetSeatLedger(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	tripID := chi.URLParam(r, "tripId")
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetSeatLedger(ctx, &inventorypb.GetSeatLedgerRequest{
+			OrganizationId: orgID,
+			TripId:         tripID,
+			SeatId:         r.URL.Query().Get("seat_id"),
+		})
+	})
+	if err != nil {
+		http.Error(w, "Failed to get seat ledger", http.StatusInternalServerError)
+		return
+	}
+	resp := result.(*inventorypb.GetSeatLedgerResponse)
+
+	entries := make([]map[string]interface{}, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		entries = append(entries, map[string]interface{}{
+			"segment_index": e.SegmentIndex,
+			"seat_id":       e.SeatId,
+			"from_status":   e.FromStatus,
+			"to_status":     e.ToStatus,
+			"actor":         e.Actor,
+			"hold_id":       e.HoldId,
+			"booking_id":    e.BookingId,
+			"reason":        e.Reason,
+			"recorded_at":   e.RecordedAt,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"trip_id": resp.TripId,
+		"entries": entries,
+	})
+}
+
+// ReleaseTrancheJSON holds seats back until a release time: seat_ids or seat_numbers, or a percentage of the trip's seats
+type ReleaseTrancheJSON struct {
+	Name                        string     `json:"name"`
+	Percentage                  int32      `json:"percentage,omitempty"`
+	SeatIDs                     []string   `json:"seat_ids,omitempty"`
+	SeatNumbers                 []string   `json:"seat_numbers,omitempty"`
+	ReleaseAt                   *time.Time `json:"release_at,omitempty"` // RFC3339
+	ReleaseHoursBeforeDeparture int32      `json:"release_hours_before_departure,omitempty"`
+}
+
+// CreateReleaseTranchesRequest lists the tranches to hold back
+type CreateReleaseTranchesRequest struct {
+	Tranches []ReleaseTrancheJSON `json:"tranches"`
+}
+
+// CreateTripReleaseTranches holds seats on one trip back until their release time
+func (h *InventoryHandler) CreateTripReleaseTranches(w http.ResponseWriter, r *http.Request) {
+	h.createReleaseTranches(w, r, chi.URLParam(r, "tripId"), "")
+}
+
+// CreateScheduleReleaseTranches holds seats back on every trip of a schedule, including future trips
+func (h *InventoryHandler) CreateScheduleReleaseTranches(w http.ResponseWriter, r *http.Request) {
+	h.createReleaseTranches(w, r, "", chi.URLParam(r, "scheduleId"))
+}
+
+func (h *InventoryHandler) createReleaseTranches(w http.ResponseWriter, r *http.Request, tripID, scheduleID string) {
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	var req CreateReleaseTranchesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	pbReq := &inventorypb.CreateReleaseTranchesRequest{
+		OrganizationId: orgID,
+		TripId:         tripID,
+		ScheduleId:     scheduleID,
+		CreatedBy:      middleware.GetUserID(r.Context()),
+	}
+	for _, t := range req.Tranches {
+		tranche := &inventorypb.ReleaseTranche{
+			Name:                        t.Name,
+			Percentage:                  t.Percentage,
+			SeatIds:                     t.SeatIDs,
+			SeatNumbers:                 t.SeatNumbers,
+			ReleaseHoursBeforeDeparture: t.ReleaseHoursBeforeDeparture,
+		}
+		if t.ReleaseAt != nil {
+			tranche.ReleaseAt = t.ReleaseAt.Unix()
+		}
+		pbReq.Tranches = append(pbReq.Tranches, tranche)
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.CreateReleaseTranches(ctx, pbReq)
+	})
+	if err != nil {
+		writeReleaseTrancheError(w, err, "Failed to create release tranches")
+		return
+	}
+	resp := result.(*inventorypb.CreateReleaseTranchesResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"tranches": releaseTranchesJSON(resp.Tranches),
+	})
+}
+
+// ListTripReleaseTranches returns a trip's release tranches
+func (h *InventoryHandler) ListTripReleaseTranches(w http.ResponseWriter, r *http.Request) {
+	h.listReleaseTranches(w, r, chi.URLParam(r, "tripId"), "")
+}
+
+// ListScheduleReleaseTranches returns the tranches applied to every trip of a schedule
+func (h *InventoryHandler) ListScheduleReleaseTranches(w http.ResponseWriter, r *http.Request) {
+	h.listReleaseTranches(w, r, "", chi.URLParam(r, "scheduleId"))
+}
+
+func (h *InventoryHandler) listReleaseTranches(w http.ResponseWriter, r *http.Request, tripID, scheduleID string) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ListReleaseTranches(ctx, &inventorypb.ListReleaseTranchesRequest{
+			OrganizationId: orgID,
+			TripId:         tripID,
+			ScheduleId:     scheduleID,
+		})
+	})
+	if err != nil {
+		writeReleaseTrancheError(w, err, "Failed to list release tranches")
+		return
+	}
+	resp := result.(*inventorypb.ListReleaseTranchesResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"tranches": releaseTranchesJSON(resp.Tranches),
+	})
+}
+
+// ReleaseTripTranche puts a trip's tranche on sale now
+func (h *InventoryHandler) ReleaseTripTranche(w http.ResponseWriter, r *http.Request) {
+	h.releaseTranche(w, r, chi.URLParam(r, "tripId"), "")
+}
+
+// ReleaseScheduleTranche puts a schedule's tranche on sale now on every trip of the schedule
+func (h *InventoryHandler) ReleaseScheduleTranche(w http.ResponseWriter, r *http.Request) {
+	h.releaseTranche(w, r, "", chi.URLParam(r, "scheduleId"))
+}
+
+func (h *InventoryHandler) releaseTranche(w http.ResponseWriter, r *http.Request, tripID, scheduleID string) {
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ReleaseTranche(ctx, &inventorypb.ReleaseTrancheRequest{
+			OrganizationId: orgID,
+			TripId:         tripID,
+			ScheduleId:     scheduleID,
+			TrancheId:      chi.URLParam(r, "trancheId"),
+			ReleasedBy:     middleware.GetUserID(r.Context()),
+		})
+	})
+	if err != nil {
+		writeReleaseTrancheError(w, err, "Failed to release tranche")
+		return
+	}
+	resp := result.(*inventorypb.ReleaseTrancheResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"released_count": resp.ReleasedCount,
+	})
+}
+
+func releaseTranchesJSON(tranches []*inventorypb.ReleaseTranche) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(tranches))
+	for _, t := range tranches {
+		out = append(out, map[string]interface{}{
+			"tranche_id":                     t.TrancheId,
+			"name":                           t.Name,
+			"percentage":                     t.Percentage,
+			"seat_ids":                       t.SeatIds,
+			"seat_numbers":                   t.SeatNumbers,
+			"release_at":                     t.ReleaseAt,
+			"release_hours_before_departure": t.ReleaseHoursBeforeDeparture,
+			"status":                         t.Status,
+			"released_at":                    t.ReleasedAt,
+			"trip_id":                        t.TripId,
+			"schedule_id":                    t.ScheduleId,
+		})
+	}
+	return out
+}
+
+func writeReleaseTrancheError(w http.ResponseWriter, err error, fallback string) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusBadRequest)
+	case codes.NotFound:
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusNotFound)
+	default:
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
+
+// AvailabilityCountsRequest asks for cheap seat counts of many trips at once
+type AvailabilityCountsRequest struct {
+	Queries []struct {
+		OrganizationID string `json:"organization_id"`
+		TripID         string `json:"trip_id"`
+		FromStationID  string `json:"from_station_id"`
+		ToStationID    string `json:"to_station_id"`
+	} `json:"queries"`
+}
+
+// GetAvailabilityCounts returns counter-based seat counts for listing pages
+func (h *InventoryHandler) GetAvailabilityCounts(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	var req AvailabilityCountsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"error": "invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+
+	queries := make([]*inventorypb.AvailabilityCountQuery, 0, len(req.Queries))
+	for _, q := range req.Queries {
+		queries = append(queries, &inventorypb.AvailabilityCountQuery{
+			OrganizationId: q.OrganizationID,
+			TripId:         q.TripID,
+			FromStationId:  q.FromStationID,
+			ToStationId:    q.ToStationID,
+		})
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetAvailabilityCounts(ctx, &inventorypb.GetAvailabilityCountsRequest{Queries: queries})
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Failed to get availability counts", http.StatusInternalServerError)
+		return
+	}
+	resp := result.(*inventorypb.GetAvailabilityCountsResponse)
+
+	results := make([]map[string]interface{}, 0, len(resp.Results))
+	for _, res := range resp.Results {
+		classes := make([]map[string]interface{}, 0, len(res.Classes))
+		for _, c := range res.Classes {
+			classes = append(classes, map[string]interface{}{
+				"seat_class": c.SeatClass,
+				"available":  c.Available,
+			})
+		}
+		capacity := make([]map[string]interface{}, 0, len(res.Capacity))
+		for _, c := range res.Capacity {
+			capacity = append(capacity, map[string]interface{}{
+				"capacity_class": c.CapacityClass,
+				"available":      c.Available,
+				"price_paisa":    c.PricePaisa,
+			})
+		}
+		entry := map[string]interface{}{
+			"organization_id": res.OrganizationId,
+			"trip_id":         res.TripId,
+			"from_station_id": res.FromStationId,
+			"to_station_id":   res.ToStationId,
+			"available_seats": res.AvailableSeats,
+			"classes":         classes,
+			"capacity":        capacity,
+		}
+		if res.Error != "" {
+			entry["error"] = res.Error
+		}
+		results = append(results, entry)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"results": results,
+	})
+}
+
+// AvailableSeats looks up counter-based seat counts by trip ID for handlers that list trips.
+// Trips whose count could not be read are left out, so callers keep their own figure.
+func (h *InventoryHandler) AvailableSeats(ctx context.Context, queries []*inventorypb.AvailabilityCountQuery) map[string]int32 {
+	counts := make(map[string]int32, len(queries))
+	if len(queries) == 0 {
+		return counts
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetAvailabilityCounts(ctx, &inventorypb.GetAvailabilityCountsRequest{Queries: queries})
+	})
+	if err != nil {
+		return counts
+	}
+	for _, res := range result.(*inventorypb.GetAvailabilityCountsResponse).Results {
+		if res.Error == "" {
+			counts[res.TripId] = res.AvailableSeats
+		}
+	}
+	return counts
+}
+
 // Close closes the gRPC connection
 func (h *InventoryHandler) Close() error {
 	return h.conn.Close()
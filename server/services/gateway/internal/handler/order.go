@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	inventorypb "github.com/MuhibNayem/Travio/server/api/proto/inventory/v1"
 	orderpb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
 	"github.com/MuhibNayem/Travio/server/services/gateway/internal/middleware"
 	"github.com/go-chi/chi/v5"
@@ -33,27 +34,45 @@ func NewOrderHandler(orderURL string, cb *middleware.CircuitBreaker) (*OrderHand
 	}, nil
 }
 
+// PassengerRequest is one traveller of an order
+type PassengerRequest struct {
+	NID         string `json:"nid"`
+	Name        string `json:"name"`
+	SeatID      string `json:"seat_id"`
+	DateOfBirth string `json:"date_of_birth"`
+	Gender      string `json:"gender"`
+	Age         int    `json:"age"`
+	// Deck or standing passengers: set instead of seat_id
+	CapacityClass string `json:"capacity_class,omitempty"`
+}
+
+// LegRequest is one trip of a round-trip or multi-leg order
+type LegRequest struct {
+	TripID        string             `json:"trip_id"`
+	FromStationID string             `json:"from_station_id"`
+	ToStationID   string             `json:"to_station_id"`
+	HoldID        string             `json:"hold_id,omitempty"`
+	Passengers    []PassengerRequest `json:"passengers"`
+}
+
 // CreateOrderRequest represents the order creation request
 type CreateOrderRequest struct {
-	TripID        string `json:"trip_id"`
-	FromStationID string `json:"from_station_id"`
-	ToStationID   string `json:"to_station_id"`
-	HoldID        string `json:"hold_id"`
-	Passengers    []struct {
-		NID         string `json:"nid"`
-		Name        string `json:"name"`
-		SeatID      string `json:"seat_id"`
-		DateOfBirth string `json:"date_of_birth"`
-		Gender      string `json:"gender"`
-		Age         int    `json:"age"`
-	} `json:"passengers"`
+	TripID        string             `json:"trip_id"`
+	FromStationID string             `json:"from_station_id"`
+	ToStationID   string             `json:"to_station_id"`
+	HoldID        string             `json:"hold_id"`
+	Passengers    []PassengerRequest `json:"passengers"`
+	// Round-trip and multi-leg orders, paid with one charge; replaces the single-trip fields
+	Legs          []LegRequest `json:"legs,omitempty"`
 	PaymentMethod struct {
-		Type  string `json:"type"`
-		Token string `json:"token,omitempty"`
+		Type      string `json:"type"`
+		Token     string `json:"token,omitempty"`
+		AccountID string `json:"account_id,omitempty"` // Corporate account to charge
 	} `json:"payment_method"`
-	ContactEmail   string `json:"contact_email"`
-	ContactPhone   string `json:"contact_phone"`
-	IdempotencyKey string `json:"idempotency_key"`
+	ContactEmail   string          `json:"contact_email"`
+	ContactPhone   string          `json:"contact_phone"`
+	IdempotencyKey string          `json:"idempotency_key"`
+	QuotaClaims    QuotaClaimsJSON `json:"quota_claims"`
 }
 
 // CreateOrder creates a new order
@@ -77,41 +96,11 @@ func (h *OrderHandler) CreateOrder(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userID := middleware.GetUserID(r.Context())
-	orgID := middleware.GetOrgID(r.Context())
-
-	passengers := make([]*orderpb.PassengerRequest, 0, len(req.Passengers))
-	for _, p := range req.Passengers {
-		passengers = append(passengers, &orderpb.PassengerRequest{
-			Nid:         p.NID,
-			Name:        p.Name,
-			SeatId:      p.SeatID,
-			DateOfBirth: p.DateOfBirth,
-			Gender:      p.Gender,
-			Age:         int32(p.Age),
-		})
-	}
-
 	result, err := h.cb.Execute(func() (interface{}, error) {
-		return h.client.CreateOrder(ctx, &orderpb.CreateOrderRequest{
-			OrganizationId: orgID,
-			UserId:         userID,
-			TripId:         req.TripID,
-			FromStationId:  req.FromStationID,
-			ToStationId:    req.ToStationID,
-			HoldId:         req.HoldID,
-			Passengers:     passengers,
-			PaymentMethod: &orderpb.PaymentMethod{
-				Type:  req.PaymentMethod.Type,
-				Token: req.PaymentMethod.Token,
-			},
-			ContactEmail:   req.ContactEmail,
-			ContactPhone:   req.ContactPhone,
-			IdempotencyKey: idempotencyKey,
-		})
+		return h.client.CreateOrder(ctx, createOrderRequestToProto(r, &req, idempotencyKey))
 	})
 	if err != nil {
-		http.Error(w, "Failed to create order", http.StatusInternalServerError)
+		writeSagaError(w, err, "Failed to create order")
 		return
 	}
 	resp := result.(*orderpb.CreateOrderResponse)
@@ -121,6 +110,71 @@ func (h *OrderHandler) CreateOrder(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(orderToJSON(resp.Order))
 }
 
+// createOrderRequestToProto builds the order service request for the caller's order
+func createOrderRequestToProto(r *http.Request, req *CreateOrderRequest, idempotencyKey string) *orderpb.CreateOrderRequest {
+	legs := make([]*orderpb.LegRequest, 0, len(req.Legs))
+	for _, l := range req.Legs {
+		legs = append(legs, &orderpb.LegRequest{
+			TripId:        l.TripID,
+			FromStationId: l.FromStationID,
+			ToStationId:   l.ToStationID,
+			HoldId:        l.HoldID,
+			Passengers:    passengerRequestsToProto(l.Passengers),
+		})
+	}
+
+	return &orderpb.CreateOrderRequest{
+		OrganizationId: middleware.GetOrgID(r.Context()),
+		UserId:         middleware.GetUserID(r.Context()),
+		TripId:         req.TripID,
+		FromStationId:  req.FromStationID,
+		ToStationId:    req.ToStationID,
+		HoldId:         req.HoldID,
+		Passengers:     passengerRequestsToProto(req.Passengers),
+		Legs:           legs,
+		PaymentMethod: &orderpb.PaymentMethod{
+			Type:      req.PaymentMethod.Type,
+			Token:     req.PaymentMethod.Token,
+			AccountId: req.PaymentMethod.AccountID,
+		},
+		ContactEmail:   req.ContactEmail,
+		ContactPhone:   req.ContactPhone,
+		IdempotencyKey: idempotencyKey,
+		QuotaClaims:    orderQuotaClaims(quotaClaims(r, req.QuotaClaims)),
+		// Scored by the fraud check; RealIP has already resolved the client's address
+		IpAddress:         clientIP(r),
+		UserAgent:         r.UserAgent(),
+		DeviceFingerprint: r.Header.Get("X-Device-Fingerprint"),
+	}
+}
+
+// orderQuotaClaims carries vetted quota claims on to the order service
+func orderQuotaClaims(c *inventorypb.QuotaClaims) *orderpb.QuotaClaims {
+	return &orderpb.QuotaClaims{
+		Female:    c.Female,
+		Disabled:  c.Disabled,
+		Channel:   c.Channel,
+		PartnerId: c.PartnerId,
+		Vip:       c.Vip,
+	}
+}
+
+func passengerRequestsToProto(reqs []PassengerRequest) []*orderpb.PassengerRequest {
+	passengers := make([]*orderpb.PassengerRequest, 0, len(reqs))
+	for _, p := range reqs {
+		passengers = append(passengers, &orderpb.PassengerRequest{
+			Nid:           p.NID,
+			Name:          p.Name,
+			SeatId:        p.SeatID,
+			DateOfBirth:   p.DateOfBirth,
+			Gender:        p.Gender,
+			Age:           int32(p.Age),
+			CapacityClass: p.CapacityClass,
+		})
+	}
+	return passengers
+}
+
 // GetOrder retrieves an order by ID
 func (h *OrderHandler) GetOrder(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
@@ -189,18 +243,21 @@ func (h *OrderHandler) CancelOrder(w http.ResponseWriter, r *http.Request) {
 
 	var req struct {
 		Reason string `json:"reason"`
+		// Refund the user accepted from the quote; a lower refund rejects the cancellation
+		ExpectedRefundPaisa int64 `json:"expected_refund_paisa"`
 	}
 	json.NewDecoder(r.Body).Decode(&req)
 
 	result, err := h.cb.Execute(func() (interface{}, error) {
 		return h.client.CancelOrder(ctx, &orderpb.CancelOrderRequest{
-			OrderId: orderID,
-			UserId:  userID,
-			Reason:  req.Reason,
+			OrderId:             orderID,
+			UserId:              userID,
+			Reason:              req.Reason,
+			ExpectedRefundPaisa: req.ExpectedRefundPaisa,
 		})
 	})
 	if err != nil {
-		http.Error(w, "Failed to cancel order", http.StatusInternalServerError)
+		writeRefundError(w, err, "Failed to cancel order")
 		return
 	}
 	resp := result.(*orderpb.CancelOrderResponse)
@@ -211,11 +268,56 @@ func (h *OrderHandler) CancelOrder(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if resp.Refund != nil {
-		response["refund"] = map[string]interface{}{
-			"refund_id":    resp.Refund.RefundId,
-			"amount_paisa": resp.Refund.AmountPaisa,
-			"status":       resp.Refund.Status,
-		}
+		response["refund"] = refundInfoToJSON(resp.Refund)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// CancelPassengers cancels some passengers of an order, by index or by seat; the rest stay booked
+func (h *OrderHandler) CancelPassengers(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	var req struct {
+		PassengerIndexes []int32  `json:"passenger_indexes"`
+		SeatIDs          []string `json:"seat_ids"`
+		Reason           string   `json:"reason"`
+		// Refund the user accepted from the quote; a lower refund rejects the cancellation
+		ExpectedRefundPaisa int64 `json:"expected_refund_paisa"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if len(req.PassengerIndexes) == 0 && len(req.SeatIDs) == 0 {
+		http.Error(w, `{"error": "passenger_indexes or seat_ids is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.CancelPassengers(ctx, &orderpb.CancelPassengersRequest{
+			OrderId:             chi.URLParam(r, "orderId"),
+			UserId:              middleware.GetUserID(r.Context()),
+			PassengerIndexes:    req.PassengerIndexes,
+			SeatIds:             req.SeatIDs,
+			Reason:              req.Reason,
+			ExpectedRefundPaisa: req.ExpectedRefundPaisa,
+		})
+	})
+	if err != nil {
+		writeRefundError(w, err, "Failed to cancel passengers")
+		return
+	}
+	resp := result.(*orderpb.CancelPassengersResponse)
+
+	response := map[string]interface{}{
+		"success": resp.Success,
+		"order":   orderToJSON(resp.Order),
+	}
+	if resp.Refund != nil {
+		response["refund"] = refundInfoToJSON(resp.Refund)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -228,24 +330,15 @@ func orderToJSON(o *orderpb.Order) map[string]interface{} {
 		return nil
 	}
 
-	passengers := make([]map[string]interface{}, 0)
-	for _, p := range o.Passengers {
-		passengers = append(passengers, map[string]interface{}{
-			"nid":         p.Nid,
-			"name":        p.Name,
-			"seat_id":     p.SeatId,
-			"seat_number": p.SeatNumber,
-			"seat_class":  p.SeatClass,
-		})
-	}
-
-	return map[string]interface{}{
+	out := map[string]interface{}{
 		"id":                o.Id,
+		"pnr":               o.Pnr,
 		"trip_id":           o.TripId,
 		"from_station_id":   o.FromStationId,
 		"to_station_id":     o.ToStationId,
 		"status":            o.Status.String(),
-		"passengers":        passengers,
+		"passengers":        passengersToJSON(o.Passengers),
+		"seats":             seatsToJSON(o.Seats),
 		"subtotal_paisa":    o.SubtotalPaisa,
 		"tax_paisa":         o.TaxPaisa,
 		"booking_fee_paisa": o.BookingFeePaisa,
@@ -258,6 +351,105 @@ func orderToJSON(o *orderpb.Order) map[string]interface{} {
 		"created_at":        time.Unix(o.CreatedAt, 0).Format(time.RFC3339),
 		"expires_at":        time.Unix(o.ExpiresAt, 0).Format(time.RFC3339),
 	}
+	if o.RefundBreakdown != nil {
+		out["refund"] = refundBreakdownToJSON(o.RefundBreakdown)
+	}
+	if len(o.PassengerCancellations) > 0 {
+		cancellations := make([]map[string]interface{}, 0, len(o.PassengerCancellations))
+		for _, c := range o.PassengerCancellations {
+			cancellations = append(cancellations, map[string]interface{}{
+				"passenger_indexes": c.PassengerIndexes,
+				"reason":            c.Reason,
+				"refund_id":         c.RefundId,
+				"refund":            refundBreakdownToJSON(c.Refund),
+				"cancelled_at":      time.Unix(c.CancelledAt, 0).Format(time.RFC3339),
+			})
+		}
+		out["passenger_cancellations"] = cancellations
+	}
+	if len(o.Changes) > 0 {
+		changes := make([]map[string]interface{}, 0, len(o.Changes))
+		for _, c := range o.Changes {
+			changes = append(changes, orderChangeToJSON(c))
+		}
+		out["changes"] = changes
+	}
+	if f := o.FraudReview; f != nil {
+		out["fraud_review"] = map[string]interface{}{
+			"risk_score":   f.RiskScore,
+			"risk_level":   f.RiskLevel,
+			"summary":      f.Summary,
+			"risk_factors": f.RiskFactors,
+			"decision":     f.Decision,
+			"reviewer_id":  f.ReviewerId,
+			"note":         f.Note,
+			"flagged_at":   unixToRFC3339(f.FlaggedAt),
+			"decided_at":   unixToRFC3339(f.DecidedAt),
+		}
+	}
+	if o.AgentId != "" {
+		out["agent_id"] = o.AgentId
+		out["shift_id"] = o.ShiftId
+	}
+	if o.AccountId != "" {
+		out["account_id"] = o.AccountId
+	}
+	if len(o.Legs) > 0 {
+		legs := make([]map[string]interface{}, 0, len(o.Legs))
+		for _, l := range o.Legs {
+			legs = append(legs, map[string]interface{}{
+				"trip_id":           l.TripId,
+				"from_station_id":   l.FromStationId,
+				"to_station_id":     l.ToStationId,
+				"booking_id":        l.BookingId,
+				"passengers":        passengersToJSON(l.Passengers),
+				"seats":             seatsToJSON(l.Seats),
+				"subtotal_paisa":    l.SubtotalPaisa,
+				"tax_paisa":         l.TaxPaisa,
+				"booking_fee_paisa": l.BookingFeePaisa,
+				"discount_paisa":    l.DiscountPaisa,
+				"total_paisa":       l.TotalPaisa,
+			})
+		}
+		out["legs"] = legs
+	}
+	return out
+}
+
+func passengersToJSON(ps []*orderpb.Passenger) []map[string]interface{} {
+	passengers := make([]map[string]interface{}, 0)
+	for _, p := range ps {
+		passengers = append(passengers, map[string]interface{}{
+			"nid":            p.Nid,
+			"name":           p.Name,
+			"seat_id":        p.SeatId,
+			"seat_number":    p.SeatNumber,
+			"seat_class":     p.SeatClass,
+			"capacity_class": p.CapacityClass,
+			"cancelled":      p.Cancelled,
+		})
+	}
+
+	return passengers
+}
+
+// seatsToJSON lists booked seats; split-seat journeys list one entry per seat leg, deck and standing tickets have no seat
+func seatsToJSON(ss []*orderpb.BookedSeat) []map[string]interface{} {
+	seats := make([]map[string]interface{}, 0, len(ss))
+	for _, s := range ss {
+		seats = append(seats, map[string]interface{}{
+			"seat_id":         s.SeatId,
+			"seat_number":     s.SeatNumber,
+			"seat_class":      s.SeatClass,
+			"ticket_id":       s.TicketId,
+			"price_paisa":     s.PricePaisa,
+			"passenger_index": s.PassengerIndex,
+			"from_station_id": s.FromStationId,
+			"to_station_id":   s.ToStationId,
+			"capacity_class":  s.CapacityClass,
+		})
+	}
+	return seats
 }
 
 // Close closes the gRPC connection
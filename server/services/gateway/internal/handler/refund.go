@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	orderpb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/gateway/internal/middleware"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetRefundQuote shows what cancelling the order now would refund. Repeated passenger
+// and seat_id query parameters quote cancelling only those passengers.
+func (h *OrderHandler) GetRefundQuote(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	var indexes []int32
+	for _, v := range r.URL.Query()["passenger"] {
+		idx, err := strconv.Atoi(v)
+		if err != nil || idx < 0 {
+			http.Error(w, `{"error": "Invalid passenger index"}`, http.StatusBadRequest)
+			return
+		}
+		indexes = append(indexes, int32(idx))
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetRefundQuote(ctx, &orderpb.GetRefundQuoteRequest{
+			OrderId:          chi.URLParam(r, "orderId"),
+			UserId:           middleware.GetUserID(r.Context()),
+			PassengerIndexes: indexes,
+			SeatIds:          r.URL.Query()["seat_id"],
+		})
+	})
+	if err != nil {
+		writeRefundError(w, err, "Failed to quote refund")
+		return
+	}
+	resp := result.(*orderpb.GetRefundQuoteResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"quote":       refundBreakdownToJSON(resp.Quote),
+		"total_paisa": resp.TotalPaisa,
+		"currency":    resp.Currency,
+	})
+}
+
+// RefundPolicyRequest creates or replaces the operator's policy for a route and vehicle class
+type RefundPolicyRequest struct {
+	RouteID      string `json:"route_id,omitempty"`
+	VehicleClass string `json:"vehicle_class,omitempty"`
+	Name         string `json:"name"`
+	Tiers        []struct {
+		MinHoursBeforeDeparture int32 `json:"min_hours_before_departure"`
+		RefundPercent           int32 `json:"refund_percent"`
+	} `json:"tiers"`
+	RefundBookingFee    bool  `json:"refund_booking_fee"`
+	ChangeFeePaisa      int64 `json:"change_fee_paisa"`      // Per passenger, on date and seat changes
+	ChangeCutoffHours   int32 `json:"change_cutoff_hours"`   // No changes this close to departure
+	TransferFeePaisa    int64 `json:"transfer_fee_paisa"`    // Per passenger, on ticket transfers
+	TransferCutoffHours int32 `json:"transfer_cutoff_hours"` // No transfers this close to departure
+}
+
+// SetRefundPolicy creates or replaces a refund policy of the caller's organization
+func (h *OrderHandler) SetRefundPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	var req RefundPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	policy := &orderpb.RefundPolicy{
+		OrganizationId:      orgID,
+		RouteId:             req.RouteID,
+		VehicleClass:        req.VehicleClass,
+		Name:                req.Name,
+		RefundBookingFee:    req.RefundBookingFee,
+		ChangeFeePaisa:      req.ChangeFeePaisa,
+		ChangeCutoffHours:   req.ChangeCutoffHours,
+		TransferFeePaisa:    req.TransferFeePaisa,
+		TransferCutoffHours: req.TransferCutoffHours,
+	}
+	for _, t := range req.Tiers {
+		policy.Tiers = append(policy.Tiers, &orderpb.RefundTier{
+			MinHoursBeforeDeparture: t.MinHoursBeforeDeparture,
+			RefundPercent:           t.RefundPercent,
+		})
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.SetRefundPolicy(ctx, policy)
+	})
+	if err != nil {
+		writeRefundError(w, err, "Failed to save refund policy")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(refundPolicyToJSON(result.(*orderpb.RefundPolicy)))
+}
+
+// ListRefundPolicies lists the refund policies of the caller's organization
+func (h *OrderHandler) ListRefundPolicies(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ListRefundPolicies(ctx, &orderpb.ListRefundPoliciesRequest{OrganizationId: orgID})
+	})
+	if err != nil {
+		writeRefundError(w, err, "Failed to list refund policies")
+		return
+	}
+	resp := result.(*orderpb.ListRefundPoliciesResponse)
+
+	policies := make([]map[string]interface{}, 0, len(resp.Policies))
+	for _, p := range resp.Policies {
+		policies = append(policies, refundPolicyToJSON(p))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"policies": policies,
+	})
+}
+
+// DeleteRefundPolicy removes one of the caller's organization's refund policies
+func (h *OrderHandler) DeleteRefundPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	orgID := middleware.GetOrgID(r.Context())
+	if orgID == "" {
+		http.Error(w, `{"error": "organization_id is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	_, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.DeleteRefundPolicy(ctx, &orderpb.DeleteRefundPolicyRequest{
+			OrganizationId: orgID,
+			PolicyId:       chi.URLParam(r, "policyId"),
+		})
+	})
+	if err != nil {
+		writeRefundError(w, err, "Failed to delete refund policy")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func refundInfoToJSON(info *orderpb.RefundInfo) map[string]interface{} {
+	return map[string]interface{}{
+		"refund_id":    info.RefundId,
+		"amount_paisa": info.AmountPaisa,
+		"status":       info.Status,
+		"breakdown":    refundBreakdownToJSON(info.Breakdown),
+	}
+}
+
+func refundBreakdownToJSON(b *orderpb.RefundBreakdown) map[string]interface{} {
+	if b == nil {
+		return nil
+	}
+	out := map[string]interface{}{
+		"policy_id":                b.PolicyId,
+		"policy_name":              b.PolicyName,
+		"departure_time":           unixToRFC3339(b.DepartureTime),
+		"hours_before_departure":   b.HoursBeforeDeparture,
+		"refund_percent":           b.RefundPercent,
+		"fare_paisa":               b.FarePaisa,
+		"fare_refund_paisa":        b.FareRefundPaisa,
+		"tax_paisa":                b.TaxPaisa,
+		"tax_refund_paisa":         b.TaxRefundPaisa,
+		"booking_fee_paisa":        b.BookingFeePaisa,
+		"booking_fee_refund_paisa": b.BookingFeeRefundPaisa,
+		"refund_paisa":             b.RefundPaisa,
+		"retained_paisa":           b.RetainedPaisa,
+		"quoted_at":                unixToRFC3339(b.QuotedAt),
+	}
+	if len(b.Legs) > 0 {
+		legs := make([]map[string]interface{}, 0, len(b.Legs))
+		for _, leg := range b.Legs {
+			legs = append(legs, refundBreakdownToJSON(leg))
+		}
+		out["legs"] = legs
+	}
+	return out
+}
+
+func refundPolicyToJSON(p *orderpb.RefundPolicy) map[string]interface{} {
+	tiers := make([]map[string]interface{}, 0, len(p.Tiers))
+	for _, t := range p.Tiers {
+		tiers = append(tiers, map[string]interface{}{
+			"min_hours_before_departure": t.MinHoursBeforeDeparture,
+			"refund_percent":             t.RefundPercent,
+		})
+	}
+	return map[string]interface{}{
+		"id":                    p.Id,
+		"route_id":              p.RouteId,
+		"vehicle_class":         p.VehicleClass,
+		"name":                  p.Name,
+		"tiers":                 tiers,
+		"refund_booking_fee":    p.RefundBookingFee,
+		"change_fee_paisa":      p.ChangeFeePaisa,
+		"change_cutoff_hours":   p.ChangeCutoffHours,
+		"transfer_fee_paisa":    p.TransferFeePaisa,
+		"transfer_cutoff_hours": p.TransferCutoffHours,
+		"created_at":            unixToRFC3339(p.CreatedAt),
+		"updated_at":            unixToRFC3339(p.UpdatedAt),
+	}
+}
+
+func writeRefundError(w http.ResponseWriter, err error, fallback string) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusBadRequest)
+	case codes.NotFound:
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusNotFound)
+	case codes.FailedPrecondition, codes.AlreadyExists:
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusConflict)
+	default:
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	orderpb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/gateway/internal/middleware"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListSagas lists order sagas for operations staff.
+// Query: status (comma separated, e.g. failed,compensating), organization_id,
+// min_age (e.g. 30m), page_size, page_token.
+func (h *OrderHandler) ListSagas(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	q := r.URL.Query()
+	req := &orderpb.ListSagasRequest{
+		OrganizationId: q.Get("organization_id"),
+		PageToken:      q.Get("page_token"),
+	}
+	if v := q.Get("status"); v != "" {
+		for _, name := range strings.Split(v, ",") {
+			st, ok := orderpb.SagaStatus_value["SAGA_STATUS_"+strings.ToUpper(strings.TrimSpace(name))]
+			if !ok {
+				http.Error(w, fmt.Sprintf(`{"error": "unknown status %q"}`, name), http.StatusBadRequest)
+				return
+			}
+			req.Statuses = append(req.Statuses, orderpb.SagaStatus(st))
+		}
+	}
+	if v := q.Get("min_age"); v != "" {
+		age, err := time.ParseDuration(v)
+		if err != nil {
+			http.Error(w, `{"error": "min_age must be a duration such as 30m"}`, http.StatusBadRequest)
+			return
+		}
+		req.MinAgeSeconds = int64(age.Seconds())
+	}
+	if v := q.Get("page_size"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, `{"error": "invalid page_size"}`, http.StatusBadRequest)
+			return
+		}
+		req.PageSize = int32(size)
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ListSagas(ctx, req)
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to list sagas")
+		return
+	}
+	resp := result.(*orderpb.ListSagasResponse)
+
+	sagas := make([]map[string]interface{}, 0, len(resp.Sagas))
+	for _, s := range resp.Sagas {
+		sagas = append(sagas, sagaToJSON(s))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"sagas":     sagas,
+		"next_page": resp.NextPageToken,
+		"total":     resp.TotalCount,
+	})
+}
+
+// GetSaga returns a saga's steps, references and admin audit trail
+func (h *OrderHandler) GetSaga(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetSaga(ctx, &orderpb.GetSagaRequest{SagaId: chi.URLParam(r, "sagaId")})
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to get saga")
+		return
+	}
+	resp := result.(*orderpb.GetSagaResponse)
+
+	audit := make([]map[string]interface{}, 0, len(resp.Audit))
+	for _, a := range resp.Audit {
+		audit = append(audit, map[string]interface{}{
+			"action":     a.Action,
+			"actor_id":   a.ActorId,
+			"details":    a.Details,
+			"created_at": time.Unix(a.CreatedAt, 0).Format(time.RFC3339),
+		})
+	}
+
+	out := sagaToJSON(resp.Saga)
+	out["audit"] = audit
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(out)
+}
+
+// sagaActionRequest is the body of the saga admin actions
+type sagaActionRequest struct {
+	FromStep string `json:"from_step,omitempty"` // Retry only
+	Reason   string `json:"reason"`
+}
+
+// RetrySaga forces a saga to run again, from from_step or its first step not in effect
+func (h *OrderHandler) RetrySaga(w http.ResponseWriter, r *http.Request) {
+	h.sagaAction(w, r, func(ctx context.Context, sagaID, actorID string, body sagaActionRequest) (*orderpb.SagaActionResponse, error) {
+		return h.client.RetrySaga(ctx, &orderpb.RetrySagaRequest{
+			SagaId:   sagaID,
+			FromStep: body.FromStep,
+			ActorId:  actorID,
+			Reason:   body.Reason,
+		})
+	})
+}
+
+// CompensateSaga forces a saga to undo every step still in effect
+func (h *OrderHandler) CompensateSaga(w http.ResponseWriter, r *http.Request) {
+	h.sagaAction(w, r, func(ctx context.Context, sagaID, actorID string, body sagaActionRequest) (*orderpb.SagaActionResponse, error) {
+		return h.client.CompensateSaga(ctx, &orderpb.CompensateSagaRequest{
+			SagaId:  sagaID,
+			ActorId: actorID,
+			Reason:  body.Reason,
+		})
+	})
+}
+
+// ResolveSaga marks a saga as fixed by hand
+func (h *OrderHandler) ResolveSaga(w http.ResponseWriter, r *http.Request) {
+	h.sagaAction(w, r, func(ctx context.Context, sagaID, actorID string, body sagaActionRequest) (*orderpb.SagaActionResponse, error) {
+		return h.client.ResolveSaga(ctx, &orderpb.ResolveSagaRequest{
+			SagaId:  sagaID,
+			ActorId: actorID,
+			Reason:  body.Reason,
+		})
+	})
+}
+
+func (h *OrderHandler) sagaAction(w http.ResponseWriter, r *http.Request, call func(ctx context.Context, sagaID, actorID string, body sagaActionRequest) (*orderpb.SagaActionResponse, error)) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	var body sagaActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	sagaID := chi.URLParam(r, "sagaId")
+	actorID := middleware.GetUserID(r.Context())
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return call(ctx, sagaID, actorID, body)
+	})
+	if err != nil {
+		writeSagaError(w, err, "Saga action failed")
+		return
+	}
+	resp := result.(*orderpb.SagaActionResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(sagaToJSON(resp.Saga))
+}
+
+func sagaToJSON(s *orderpb.SagaState) map[string]interface{} {
+	if s == nil {
+		return map[string]interface{}{}
+	}
+
+	steps := make([]map[string]interface{}, 0, len(s.Steps))
+	for _, step := range s.Steps {
+		steps = append(steps, map[string]interface{}{
+			"name":         step.Name,
+			"status":       strings.ToLower(strings.TrimPrefix(step.Status.String(), "STEP_STATUS_")),
+			"error":        step.Error,
+			"started_at":   unixToRFC3339(step.StartedAt),
+			"completed_at": unixToRFC3339(step.CompletedAt),
+			"compensated":  step.Compensated,
+		})
+	}
+
+	return map[string]interface{}{
+		"saga_id":            s.SagaId,
+		"name":               s.Name,
+		"status":             strings.ToLower(strings.TrimPrefix(s.Status.String(), "SAGA_STATUS_")),
+		"organization_id":    s.OrganizationId,
+		"current_step":       s.CurrentStep,
+		"current_step_index": s.CurrentStepIndex,
+		"failure_reason":     s.FailureReason,
+		"references":         s.References,
+		"steps":              steps,
+		"owner":              s.Owner,
+		"lease_until":        unixToRFC3339(s.LeaseUntil),
+		"started_at":         unixToRFC3339(s.StartedAt),
+		"completed_at":       unixToRFC3339(s.CompletedAt),
+		"updated_at":         unixToRFC3339(s.UpdatedAt),
+	}
+}
+
+// unixToRFC3339 formats a unix timestamp, leaving unset ones empty
+func unixToRFC3339(ts int64) string {
+	if ts == 0 {
+		return ""
+	}
+	return time.Unix(ts, 0).Format(time.RFC3339)
+}
+
+func writeSagaError(w http.ResponseWriter, err error, fallback string) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusBadRequest)
+	case codes.PermissionDenied:
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusForbidden)
+	case codes.NotFound:
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusNotFound)
+	case codes.FailedPrecondition, codes.Aborted:
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, st.Message()), http.StatusConflict)
+	default:
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
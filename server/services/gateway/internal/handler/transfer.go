@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	orderpb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/gateway/internal/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// TransferPassengerRequest hands a passenger's ticket to another traveller
+type TransferPassengerRequest struct {
+	NID          string `json:"nid"`
+	Name         string `json:"name"`
+	DateOfBirth  string `json:"date_of_birth"`           // Checked with the NID service
+	PaymentToken string `json:"payment_token,omitempty"` // Required when the transfer has a fee
+	// Fee the user accepted from the quote; a higher fee rejects the transfer
+	ExpectedFeePaisa *int64 `json:"expected_fee_paisa,omitempty"`
+}
+
+// TransferPassenger moves the passenger at the index to the new traveller, reissuing their tickets
+func (h *OrderHandler) TransferPassenger(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 15*time.Second)
+	defer cancel()
+
+	index, err := strconv.Atoi(chi.URLParam(r, "index"))
+	if err != nil {
+		http.Error(w, `{"error": "invalid passenger index"}`, http.StatusBadRequest)
+		return
+	}
+	var req TransferPassengerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	transferReq := &orderpb.TransferPassengerRequest{
+		OrderId:        chi.URLParam(r, "orderId"),
+		UserId:         middleware.GetUserID(r.Context()),
+		PassengerIndex: int32(index),
+		NewNid:         req.NID,
+		NewName:        req.Name,
+		NewDateOfBirth: req.DateOfBirth,
+		PaymentToken:   req.PaymentToken,
+	}
+	if req.ExpectedFeePaisa != nil {
+		transferReq.ExpectedFeePaisa = *req.ExpectedFeePaisa
+		transferReq.CheckExpectedFee = true
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.TransferPassenger(ctx, transferReq)
+	})
+	if err != nil {
+		writeRefundError(w, err, "Failed to transfer ticket")
+		return
+	}
+	resp := result.(*orderpb.TransferPassengerResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":  resp.Success,
+		"order":    orderToJSON(resp.Order),
+		"transfer": transferToJSON(resp.Transfer),
+	})
+}
+
+// GetTransferQuote shows what transferring the passenger at the index would cost now
+func (h *OrderHandler) GetTransferQuote(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	index, err := strconv.Atoi(chi.URLParam(r, "index"))
+	if err != nil {
+		http.Error(w, `{"error": "invalid passenger index"}`, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.GetTransferQuote(ctx, &orderpb.GetTransferQuoteRequest{
+			OrderId:        chi.URLParam(r, "orderId"),
+			UserId:         middleware.GetUserID(r.Context()),
+			PassengerIndex: int32(index),
+		})
+	})
+	if err != nil {
+		writeRefundError(w, err, "Failed to quote transfer")
+		return
+	}
+	q := result.(*orderpb.TransferQuote)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"quote": map[string]interface{}{
+			"policy_id":              q.PolicyId,
+			"policy_name":            q.PolicyName,
+			"departure_time":         unixToRFC3339(q.DepartureTime),
+			"hours_before_departure": q.HoursBeforeDeparture,
+			"fee_paisa":              q.FeePaisa,
+			"currency":               q.Currency,
+			"quoted_at":              unixToRFC3339(q.QuotedAt),
+		},
+	})
+}
+
+// ListTransfers lists ticket transfers, newest first.
+// Query: organization_id, order_id, user_id, nid (either passenger), limit, offset.
+func (h *OrderHandler) ListTransfers(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	q := r.URL.Query()
+	req := &orderpb.ListTransfersRequest{
+		OrganizationId: q.Get("organization_id"),
+		OrderId:        q.Get("order_id"),
+		UserId:         q.Get("user_id"),
+		Nid:            q.Get("nid"),
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, `{"error": "invalid limit"}`, http.StatusBadRequest)
+			return
+		}
+		req.Limit = int32(limit)
+	}
+	if v := q.Get("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, `{"error": "invalid offset"}`, http.StatusBadRequest)
+			return
+		}
+		req.Offset = int32(offset)
+	}
+
+	result, err := h.cb.Execute(func() (interface{}, error) {
+		return h.client.ListTransfers(ctx, req)
+	})
+	if err != nil {
+		writeSagaError(w, err, "Failed to list transfers")
+		return
+	}
+	resp := result.(*orderpb.ListTransfersResponse)
+
+	transfers := make([]map[string]interface{}, 0, len(resp.Transfers))
+	for _, t := range resp.Transfers {
+		transfers = append(transfers, transferToJSON(t))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"transfers": transfers,
+		"total":     resp.Total,
+	})
+}
+
+func transferToJSON(t *orderpb.PassengerTransfer) map[string]interface{} {
+	if t == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"id":              t.Id,
+		"order_id":        t.OrderId,
+		"organization_id": t.OrganizationId,
+		"user_id":         t.UserId,
+		"trip_id":         t.TripId,
+		"passenger_index": t.PassengerIndex,
+		"old_nid":         t.OldNid,
+		"old_name":        t.OldName,
+		"new_nid":         t.NewNid,
+		"new_name":        t.NewName,
+		"fee_paisa":       t.FeePaisa,
+		"payment_id":      t.PaymentId,
+		"transferred_at":  unixToRFC3339(t.TransferredAt),
+	}
+}
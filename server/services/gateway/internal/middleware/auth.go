@@ -24,79 +24,95 @@ type JWTConfig struct {
 	SkipPaths []string // Paths that don't require auth
 }
 
-// JWTAuth creates a JWT authentication middleware
+// JWTAuth creates a JWT authentication middleware. Skipped paths are served without a
+// token, but a valid token sent to them is still read, so public routes shared with
+// staff (seat maps, holds) and staff routes beneath a public prefix see the caller's role.
 func JWTAuth(config JWTConfig) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			logger.Info("JWT Auth Middleware Hit", "path", r.URL.Path)
+			tokenString := bearerToken(r)
+
 			// Check if path should skip auth
 			for _, path := range config.SkipPaths {
 				if strings.HasPrefix(r.URL.Path, path) {
+					if tokenString != "" {
+						if claims, err := parseClaims(tokenString, config.Secret); err == nil {
+							r = r.WithContext(withClaims(r.Context(), claims))
+						}
+					}
 					next.ServeHTTP(w, r)
 					return
 				}
 			}
 
-			// Get Token from Header or Cookie
-			var tokenString string
-			authHeader := r.Header.Get("Authorization")
-			if authHeader != "" {
-				parts := strings.Split(authHeader, " ")
-				if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
-					tokenString = parts[1]
-				}
-			}
-
-			if tokenString == "" {
-				cookie, err := r.Cookie("access_token")
-				if err == nil {
-					tokenString = cookie.Value
-				}
-			}
-
 			if tokenString == "" {
 				http.Error(w, `{"error": "missing or invalid authorization token"}`, http.StatusUnauthorized)
 				return
 			}
 
-			// Parse and validate token
-			token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-				// Validate signing method
-				if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-					return nil, jwt.ErrSignatureInvalid
-				}
-				return []byte(config.Secret), nil
-			})
-
-			if err != nil || !token.Valid {
+			claims, err := parseClaims(tokenString, config.Secret)
+			if err != nil {
 				logger.Debug("JWT validation failed", "error", err)
 				http.Error(w, `{"error": "invalid or expired token"}`, http.StatusUnauthorized)
 				return
 			}
 
-			// Extract claims
-			claims, ok := token.Claims.(jwt.MapClaims)
-			if !ok {
-				logger.Error("Invalid token claims structure")
-				http.Error(w, `{"error": "invalid token claims"}`, http.StatusUnauthorized)
-				return
-			}
+			next.ServeHTTP(w, r.WithContext(withClaims(r.Context(), claims)))
+		})
+	}
+}
 
-			// Add claims to context
-			ctx := r.Context()
-			if userID, ok := claims["sub"].(string); ok {
-				ctx = context.WithValue(ctx, UserIDKey, userID)
-			}
-			if orgID, ok := claims["oid"].(string); ok {
-				ctx = context.WithValue(ctx, OrgIDKey, orgID)
-			}
-			if role, ok := claims["role"].(string); ok {
-				ctx = context.WithValue(ctx, UserRoleKey, role)
-			}
+// bearerToken reads the token from the Authorization header or the access_token cookie
+func bearerToken(r *http.Request) string {
+	if authHeader := r.Header.Get("Authorization"); authHeader != "" {
+		parts := strings.Split(authHeader, " ")
+		if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
+			return parts[1]
+		}
+	}
+	if cookie, err := r.Cookie("access_token"); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
 
-			next.ServeHTTP(w, r.WithContext(ctx))
-		})
+// parseClaims validates the token and returns its claims
+func parseClaims(tokenString, secret string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		// Validate signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(secret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		logger.Error("Invalid token claims structure")
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return claims, nil
+}
+
+// withClaims adds the caller's user, organization and role to the context
+func withClaims(ctx context.Context, claims jwt.MapClaims) context.Context {
+	if userID, ok := claims["sub"].(string); ok {
+		ctx = context.WithValue(ctx, UserIDKey, userID)
+	}
+	if orgID, ok := claims["oid"].(string); ok {
+		ctx = context.WithValue(ctx, OrgIDKey, orgID)
+	}
+	if role, ok := claims["role"].(string); ok {
+		ctx = context.WithValue(ctx, UserRoleKey, role)
 	}
+	return ctx
 }
 
 // GetUserID extracts user ID from context
@@ -12,17 +12,29 @@ import (
 // RateLimiter provides Redis-backed rate limiting
 type RateLimiter struct {
 	client     *redis.Client
+	keyPrefix  string
 	maxReqs    int
 	windowSecs int
 }
 
 // NewRateLimiter creates a new rate limiter
 func NewRateLimiter(redisURL string, maxReqs, windowSecs int) *RateLimiter {
+	return newRateLimiter(redisURL, "rate", maxReqs, windowSecs)
+}
+
+// NewNamedRateLimiter creates a rate limiter counting requests apart from the global one,
+// for routes that need a stricter limit
+func NewNamedRateLimiter(redisURL, name string, maxReqs, windowSecs int) *RateLimiter {
+	return newRateLimiter(redisURL, "rate:"+name, maxReqs, windowSecs)
+}
+
+func newRateLimiter(redisURL, keyPrefix string, maxReqs, windowSecs int) *RateLimiter {
 	client := redis.NewClient(&redis.Options{
 		Addr: redisURL,
 	})
 	return &RateLimiter{
 		client:     client,
+		keyPrefix:  keyPrefix,
 		maxReqs:    maxReqs,
 		windowSecs: windowSecs,
 	}
@@ -32,7 +44,7 @@ func NewRateLimiter(redisURL string, maxReqs, windowSecs int) *RateLimiter {
 func (rl *RateLimiter) Middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Use IP as key; in production add user ID for authenticated requests
-		key := fmt.Sprintf("rate:%s", r.RemoteAddr)
+		key := fmt.Sprintf("%s:%s", rl.keyPrefix, r.RemoteAddr)
 
 		ctx := context.Background()
 
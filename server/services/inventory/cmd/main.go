@@ -20,6 +20,7 @@ import (
 	"github.com/MuhibNayem/Travio/server/services/inventory/internal/handler"
 	"github.com/MuhibNayem/Travio/server/services/inventory/internal/repository"
 	"github.com/MuhibNayem/Travio/server/services/inventory/internal/service"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/worker"
 	"google.golang.org/grpc"
 )
 
@@ -107,6 +108,22 @@ func main() {
 	inventoryService := service.NewInventoryService(scyllaRepo, holdRepo, redisRepo, kafkaProducer)
 	grpcHandler := handler.NewGrpcHandler(inventoryService)
 
+	// Background Workers
+	waitlistPromoter := worker.NewWaitlistPromoter(inventoryService, 30*time.Second)
+	go waitlistPromoter.Start(context.Background())
+
+	quotaReleaser := worker.NewQuotaReleaser(inventoryService, time.Minute)
+	go quotaReleaser.Start(context.Background())
+
+	holdSweeper := worker.NewHoldSweeper(inventoryService, 30*time.Second)
+	go holdSweeper.Start(context.Background())
+
+	seatUnblocker := worker.NewSeatUnblocker(inventoryService, time.Minute)
+	go seatUnblocker.Start(context.Background())
+
+	trancheReleaser := worker.NewTrancheReleaser(inventoryService, time.Minute)
+	go trancheReleaser.Start(context.Background())
+
 	// Event Consumer
 	// Group ID usually "inventory-service"
 	consumer, err := consumer.New(cfg.KafkaBrokers, "inventory-service", inventoryService, fleetClient)
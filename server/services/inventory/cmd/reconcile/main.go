@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/database/scylladb"
+	"github.com/MuhibNayem/Travio/server/pkg/kafka"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/config"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/clients"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/repository"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+// reconcile compares seat inventory in Scylla with the Redis holds and seat locks,
+// the bookings and the order service, and reports or repairs what disagrees.
+// Exits 1 when discrepancies remain unrepaired, so it can run from cron.
+func main() {
+	orgID := flag.String("org", "", "organization ID (with -trip)")
+	tripID := flag.String("trip", "", "trip ID; empty reconciles every trip")
+	repair := flag.Bool("repair", false, "repair what can be repaired instead of only reporting")
+	skipOrders := flag.Bool("skip-orders", false, "do not ask the order service about bookings")
+	asJSON := flag.Bool("json", false, "print the reports as JSON")
+	flag.Parse()
+
+	if *tripID != "" && *orgID == "" {
+		log.Fatal("-org is required with -trip")
+	}
+
+	logger.Init("inventory-reconcile")
+	cfg := config.Load()
+
+	scyllaSession, err := scylladb.NewSession(scylladb.Config{
+		Hosts:          cfg.ScyllaDB.Hosts,
+		Keyspace:       cfg.ScyllaDB.Keyspace,
+		Consistency:    cfg.ScyllaDB.Consistency,
+		Timeout:        cfg.ScyllaDB.Timeout,
+		ConnectTimeout: 10 * time.Second,
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to ScyllaDB: %v", err)
+	}
+	defer scyllaSession.Close()
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	defer redisClient.Close()
+
+	// Repairs announce freed seats like the service does
+	var kafkaProducer *kafka.Producer
+	if *repair {
+		kafkaProducer, err = kafka.NewProducer(cfg.KafkaBrokers)
+		if err != nil {
+			log.Printf("Kafka unavailable, repairs will not publish events: %v", err)
+		} else {
+			defer kafkaProducer.Close()
+		}
+	}
+
+	inventoryService := service.NewInventoryService(
+		repository.NewScyllaRepository(scyllaSession),
+		repository.NewHoldRepository(redisClient),
+		repository.NewRedisRepository(redisClient),
+		kafkaProducer,
+	)
+
+	var orders service.OrderLookup
+	if !*skipOrders {
+		orderClient, err := clients.NewOrderClient(cfg.OrderURL)
+		if err != nil {
+			log.Fatalf("Failed to create order client: %v", err)
+		}
+		orders = orderClient
+	}
+
+	ctx := context.Background()
+	var reports []*domain.ReconciliationReport
+	if *tripID != "" {
+		report, err := inventoryService.ReconcileTrip(ctx, *orgID, *tripID, orders, *repair)
+		if err != nil {
+			log.Fatalf("Failed to reconcile trip %s: %v", *tripID, err)
+		}
+		reports = append(reports, report)
+	} else {
+		reports, err = inventoryService.ReconcileInventory(ctx, orders, *repair)
+		if err != nil {
+			log.Fatalf("Failed to reconcile inventory: %v", err)
+		}
+	}
+
+	outstanding := 0
+	for _, report := range reports {
+		outstanding += len(report.Discrepancies) - report.Repaired
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(reports); err != nil {
+			log.Fatalf("Failed to encode reports: %v", err)
+		}
+	} else {
+		printReports(reports)
+	}
+
+	if outstanding > 0 {
+		os.Exit(1)
+	}
+}
+
+func printReports(reports []*domain.ReconciliationReport) {
+	found, repaired := 0, 0
+	for _, report := range reports {
+		found += len(report.Discrepancies)
+		repaired += report.Repaired
+		if len(report.Discrepancies) == 0 {
+			continue
+		}
+
+		fmt.Printf("Trip %s (org %s): %d discrepancies, %d repaired\n",
+			report.TripID, report.OrganizationID, len(report.Discrepancies), report.Repaired)
+		for _, d := range report.Discrepancies {
+			state := "reported"
+			if d.Repaired {
+				state = "repaired"
+			}
+			fmt.Printf("  [%s] %s segment=%d seat=%s hold=%s booking=%s order=%s: %s\n",
+				state, d.Kind, d.SegmentIndex, d.SeatID, d.HoldID, d.BookingID, d.OrderID, d.Detail)
+		}
+	}
+	fmt.Printf("Checked %d trips: %d discrepancies, %d repaired\n", len(reports), found, repaired)
+}
@@ -14,6 +14,7 @@ type Config struct {
 	Redis        RedisConfig
 	KafkaBrokers []string
 	FleetURL     string
+	OrderURL     string
 }
 
 type ScyllaDBConfig struct {
@@ -52,6 +53,11 @@ func Load() *Config {
 		fleetURL = env
 	}
 
+	orderURL := "localhost:9084"
+	if env := os.Getenv("ORDER_URL"); env != "" {
+		orderURL = env
+	}
+
 	redisAddr := "localhost:6379"
 	if env := os.Getenv("INVENTORY_REDIS_URL"); env != "" {
 		redisAddr = env
@@ -80,5 +86,6 @@ func Load() *Config {
 		},
 		KafkaBrokers: kafkaBrokers,
 		FleetURL:     fleetURL,
+		OrderURL:     orderURL,
 	}
 }
@@ -0,0 +1,41 @@
+package clients
+
+import (
+	"context"
+	"strings"
+
+	orderpb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// OrderClient implements client for Order Service
+type OrderClient struct {
+	client orderpb.OrderServiceClient
+}
+
+func NewOrderClient(addr string) (*OrderClient, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &OrderClient{client: orderpb.NewOrderServiceClient(conn)}, nil
+}
+
+// GetOrderStatus returns the order's status in lower case, e.g. "confirmed" or "cancelled"
+func (c *OrderClient) GetOrderStatus(ctx context.Context, orderID, userID string) (string, error) {
+	resp, err := c.client.GetOrderStatus(ctx, &orderpb.GetOrderStatusRequest{
+		OrderId: orderID,
+		UserId:  userID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", domain.ErrOrderNotFound
+		}
+		return "", err
+	}
+	return strings.ToLower(strings.TrimPrefix(resp.Status.String(), "ORDER_STATUS_")), nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/MuhibNayem/Travio/server/pkg/kafka"
 	"github.com/MuhibNayem/Travio/server/pkg/logger"
 	"github.com/MuhibNayem/Travio/server/services/inventory/internal/clients"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
 	"github.com/MuhibNayem/Travio/server/services/inventory/internal/service"
 )
 
@@ -34,6 +35,7 @@ func New(brokers []string, groupID string, inventorySvc *service.InventoryServic
 	}
 
 	consumer.RegisterHandler(kafka.EventTripCreated, c.handleTripCreated)
+	consumer.RegisterHandler(kafka.EventTripUpdated, c.handleTripUpdated)
 
 	return c, nil
 }
@@ -49,12 +51,24 @@ func (c *EventConsumer) Stop() error {
 type TripEventDTO struct {
 	ID             string           `json:"trip_id"`
 	OrganizationID string           `json:"organization_id"`
+	ScheduleID     string           `json:"schedule_id"`
 	VehicleID      string           `json:"vehicle_id"`
 	ServiceDate    string           `json:"service_date"`
 	DepartureTime  int64            `json:"departure_time"`
 	RouteID        string           `json:"route_id"`
 	Pricing        TripPricingDTO   `json:"pricing"`
 	Segments       []TripSegmentDTO `json:"segments"`
+	Quotas         []TripQuotaDTO   `json:"quotas"`
+}
+
+type TripQuotaDTO struct {
+	QuotaID              string   `json:"quota_id"`
+	Name                 string   `json:"name"`
+	QuotaType            string   `json:"quota_type"`
+	SeatNumbers          []string `json:"seat_numbers"` // Resolved to seat IDs against the vehicle layout
+	SegmentIndexes       []int    `json:"segment_indexes"`
+	PartnerIDs           []string `json:"partner_ids"`
+	ReleaseMinutesBefore int      `json:"release_minutes_before"`
 }
 
 type TripPricingDTO struct {
@@ -104,6 +118,30 @@ func (c *EventConsumer) handleTripCreated(ctx context.Context, event *kafka.Even
 		})
 	}
 
+	// Map Quotas: the trip refers to seats by number, inventory keys them by seat ID
+	seatIDsByNumber := make(map[string]string, len(seatConfig.Seats))
+	for _, seat := range seatConfig.Seats {
+		seatIDsByNumber[seat.SeatNumber] = seat.SeatID
+	}
+	var quotas []service.QuotaDef
+	for _, q := range trip.Quotas {
+		var seatIDs []string
+		for _, num := range q.SeatNumbers {
+			if id, ok := seatIDsByNumber[num]; ok {
+				seatIDs = append(seatIDs, id)
+			}
+		}
+		quotas = append(quotas, service.QuotaDef{
+			QuotaID:        q.QuotaID,
+			Name:           q.Name,
+			QuotaType:      q.QuotaType,
+			SeatIDs:        seatIDs,
+			SegmentIndexes: q.SegmentIndexes,
+			PartnerIDs:     q.PartnerIDs,
+			ReleaseBefore:  time.Duration(q.ReleaseMinutesBefore) * time.Minute,
+		})
+	}
+
 	// Call Inventory Service
 	req := &service.InitializeTripRequest{
 		TripID:         trip.ID,
@@ -111,6 +149,8 @@ func (c *EventConsumer) handleTripCreated(ctx context.Context, event *kafka.Even
 		VehicleID:      trip.VehicleID,
 		Segments:       segments,
 		SeatConfig:     seatConfig,
+		Quotas:         quotas,
+		ScheduleID:     trip.ScheduleID,
 	}
 
 	// Idempotency check handled by Service/Repo (usually `InitializeTrip` fails if exists or is safe)
@@ -126,10 +166,74 @@ func (c *EventConsumer) handleTripCreated(ctx context.Context, event *kafka.Even
 	return nil
 }
 
+// handleTripUpdated re-accommodates the trip's passengers when the operator swaps its vehicle
+func (c *EventConsumer) handleTripUpdated(ctx context.Context, event *kafka.Event) error {
+	var trip TripEventDTO
+	payloadBytes, err := json.Marshal(event.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload for decoding: %w", err)
+	}
+	if err := json.Unmarshal(payloadBytes, &trip); err != nil {
+		return fmt.Errorf("failed to unmarshal trip event: %w", err)
+	}
+
+	// Trips without inventory, or still on the same vehicle, have nothing to move
+	currentVehicleID, err := c.inventorySvc.TripVehicleID(ctx, trip.OrganizationID, trip.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load trip vehicle: %w", err)
+	}
+	if currentVehicleID == "" || trip.VehicleID == "" || currentVehicleID == trip.VehicleID {
+		return nil
+	}
+
+	logger.Info("Trip vehicle changed, re-accommodating passengers", "trip_id", trip.ID,
+		"old_vehicle_id", currentVehicleID, "new_vehicle_id", trip.VehicleID)
+
+	asset, err := c.fleetClient.GetAsset(ctx, trip.VehicleID, trip.OrganizationID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch asset %s: %w", trip.VehicleID, err)
+	}
+
+	result, err := c.inventorySvc.ReaccommodateTrip(ctx, &service.ReaccommodateRequest{
+		OrganizationID: trip.OrganizationID,
+		TripID:         trip.ID,
+		VehicleID:      trip.VehicleID,
+		SeatConfig:     mapAssetToSeatConfig(asset, trip.Pricing),
+		ScheduleID:     trip.ScheduleID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to re-accommodate trip: %w", err)
+	}
+
+	logger.Info("Re-accommodated passengers via event", "trip_id", trip.ID,
+		"moved", len(result.Moved), "unplaced", len(result.Unplaced))
+	return nil
+}
+
 func mapAssetToSeatConfig(asset *fleetpb.Asset, pricing TripPricingDTO) service.SeatConfig {
 	var seats []service.SeatDef
+	var sections []domain.LayoutSection
+	vehicleType := ""
 	totalSeats := 0
 
+	// Unnumbered places are sold by count alongside the seats
+	var capacity []service.CapacityDef
+	addCapacity := func(class string, count int32, price int64) {
+		if count <= 0 {
+			return
+		}
+		if p, ok := pricing.ClassPrices[class]; ok {
+			price = p
+		}
+		for i := range capacity {
+			if capacity[i].CapacityClass == class {
+				capacity[i].MaxCount += int(count)
+				return
+			}
+		}
+		capacity = append(capacity, service.CapacityDef{CapacityClass: class, MaxCount: int(count), PricePaisa: price})
+	}
+
 	// Helper to calculate price
 	getPrice := func(seatClass, seatCategory string) int64 {
 		price := pricing.BasePricePaisa
@@ -144,63 +248,139 @@ func mapAssetToSeatConfig(asset *fleetpb.Asset, pricing TripPricingDTO) service.
 
 	if asset.Config.GetBus() != nil {
 		bus := asset.Config.GetBus()
-		totalSeats = int(bus.Rows * bus.SeatsPerRow) // Approximation
-		for r := 1; r <= int(bus.Rows); r++ {
-			char := string(rune('A' + r - 1))
-			for c := 1; c <= int(bus.SeatsPerRow); c++ {
-				seatNum := fmt.Sprintf("%s%d", char, c)
-				seatType := "window"
-				if c > 1 && c < int(bus.SeatsPerRow) {
-					seatType = "aisle"
-				}
+		vehicleType = "bus"
+		perRow := int(bus.SeatsPerRow)
+		aisleAfter := int(bus.AisleAfterSeat)
+		if aisleAfter <= 0 || aisleAfter >= perRow {
+			aisleAfter = perRow / 2
+		}
 
-				seatClass := "economy" // default
-				for _, cat := range bus.Categories {
-					// Naive mapping: if cat.Name matches? No, logic in Catalog was simpler.
-					// We'll stick to default unless we traverse explicit seat map if available.
-					// Catalog loop: "seatClass = strings.ToLower(cat.Name)" was looping inside?
-					// Catalog logic was: loop categories -> set seatClass.
-					// This means LAST category wins? Or did it have seat ranges?
-					// Step 262 line 870: loops categories, sets seatClass.
-					// It doesn't seem to check WHICH seats. It just overwrites.
-					// This implies the asset config structure in the earlier file view was maybe incomplete or I missed the Seat Range check.
-					// However, for "smooth" port, I copy the exact behavior.
-					seatClass = strings.ToLower(cat.Name)
-				}
+		// Categories list their seats by number or seat ID; seats no category lists
+		// stay in the default class
+		categories := make(map[string]*fleetpb.SeatCategory)
+		for _, cat := range bus.Categories {
+			for _, id := range cat.SeatIds {
+				categories[id] = cat
+			}
+		}
 
-				seats = append(seats, service.SeatDef{
-					SeatID:     fmt.Sprintf("%s-%s", asset.Id, seatNum),
-					SeatNumber: seatNum,
-					Row:        r,
-					Column:     c,
-					SeatType:   seatType,
-					SeatClass:  seatClass,
-					PricePaisa: getPrice(seatClass, ""),
-				})
+		// Double-decker sleepers repeat the configured rows on the lower and upper deck
+		decks := []struct{ id, name, prefix, berth string }{{"main", "Main Deck", "", ""}}
+		if bus.HasSleeper && bus.Decks >= 2 {
+			decks = []struct{ id, name, prefix, berth string }{
+				{"L", "Lower Deck", "L", "LB"},
+				{"U", "Upper Deck", "U", "UB"},
+			}
+		}
+
+		for level, deck := range decks {
+			sections = append(sections, domain.LayoutSection{
+				SectionID:         deck.id,
+				Name:              deck.name,
+				Kind:              domain.SectionKindDeck,
+				Level:             level + 1,
+				Rows:              int(bus.Rows),
+				Columns:           perRow,
+				AisleAfterColumns: []int{aisleAfter},
+			})
+			for r := 1; r <= int(bus.Rows); r++ {
+				char := string(rune('A' + r - 1))
+				for c := 1; c <= perRow; c++ {
+					seatNum := fmt.Sprintf("%s%s%d", deck.prefix, char, c)
+					seatID := fmt.Sprintf("%s-%s", asset.Id, seatNum)
+					seatClass := "economy" // default
+					price := getPrice(seatClass, "")
+					cat, ok := categories[seatNum]
+					if !ok {
+						cat, ok = categories[seatID]
+					}
+					if ok {
+						seatClass = strings.ToLower(cat.Name)
+						price = getPrice(seatClass, cat.Name)
+						// The fleet's category price applies unless the trip prices the seat
+						_, classPriced := pricing.ClassPrices[seatClass]
+						_, categoryPriced := pricing.SeatCategoryPrices[cat.Name]
+						if !classPriced && !categoryPriced && cat.PricePaisa > 0 {
+							price = int64(cat.PricePaisa)
+						}
+					}
+					seats = append(seats, service.SeatDef{
+						SeatID:     seatID,
+						SeatNumber: seatNum,
+						Row:        r,
+						Column:     c,
+						SeatType:   seatTypeForColumn(c, perRow, aisleAfter),
+						SeatClass:  seatClass,
+						PricePaisa: price,
+						SectionID:  deck.id,
+						Berth:      deck.berth,
+					})
+					totalSeats++
+				}
 			}
 		}
 	} else if asset.Config.GetTrain() != nil {
 		train := asset.Config.GetTrain()
+		vehicleType = "train"
 		for _, coach := range train.Coaches {
+			perRow := int(coach.SeatsPerRow)
+			berths := coachBerthPattern(coach)
+			aisleAfter := perRow / 2
+			if coach.HasBerths && coach.BerthConfig.GetHasSideBerths() && perRow > 2 {
+				// Side berths run along the far side of the corridor
+				aisleAfter = perRow - 2
+			}
+
+			sections = append(sections, domain.LayoutSection{
+				SectionID:         coach.Id,
+				Name:              coach.Name,
+				Kind:              domain.SectionKindCoach,
+				Level:             1,
+				Rows:              int(coach.Rows),
+				Columns:           perRow,
+				AisleAfterColumns: []int{aisleAfter},
+			})
+
 			for r := 1; r <= int(coach.Rows); r++ {
-				for s := 1; s <= int(coach.SeatsPerRow); s++ {
+				for s := 1; s <= perRow; s++ {
 					seatNum := fmt.Sprintf("%s-%d-%d", coach.Id, r, s)
 					seatClass := strings.ToLower(coach.Name)
-					seats = append(seats, service.SeatDef{
+					seat := service.SeatDef{
 						SeatID:     fmt.Sprintf("%s-%s", asset.Id, seatNum),
 						SeatNumber: seatNum,
 						Row:        r,
 						Column:     s,
 						SeatClass:  seatClass,
 						PricePaisa: getPrice(seatClass, ""),
-					})
+						SectionID:  coach.Id,
+					}
+					if len(berths) > 0 {
+						seat.SeatType = "berth"
+						seat.Berth = berths[(s-1)%len(berths)]
+					} else {
+						seat.SeatType = seatTypeForColumn(s, perRow, aisleAfter)
+					}
+					seats = append(seats, seat)
 					totalSeats++
 				}
 			}
+			addCapacity(domain.CapacityClassStanding, coach.StandingCapacity, pricing.BasePricePaisa)
 		}
 	} else if asset.Config.GetLaunch() != nil {
 		launch := asset.Config.GetLaunch()
-		for _, deck := range launch.Decks {
+		vehicleType = "launch"
+		for i, deck := range launch.Decks {
+			addCapacity(domain.CapacityClassDeck, deck.DeckCapacity, int64(deck.DeckPricePaisa))
+			if deck.Rows > 0 && deck.Cols > 0 {
+				sections = append(sections, domain.LayoutSection{
+					SectionID: deck.Id,
+					Name:      deck.Name,
+					Kind:      domain.SectionKindDeck,
+					Level:     i + 1,
+					Rows:      int(deck.Rows),
+					Columns:   int(deck.Cols),
+				})
+			}
 			for r := 1; r <= int(deck.Rows); r++ {
 				for c := 1; c <= int(deck.Cols); c++ {
 					seatNum := fmt.Sprintf("%s-%d-%d", deck.Id, r, c)
@@ -212,11 +392,28 @@ func mapAssetToSeatConfig(asset *fleetpb.Asset, pricing TripPricingDTO) service.
 						Column:     c,
 						SeatClass:  seatClass,
 						PricePaisa: int64(deck.SeatPricePaisa),
+						SectionID:  deck.Id,
 					})
 					totalSeats++
 				}
 			}
-			for _, cabin := range deck.Cabins {
+
+			if len(deck.Cabins) == 0 {
+				continue
+			}
+
+			// Cabins line both sides of a corridor, two per row
+			cabinSection := deck.Id + "-cabins"
+			sections = append(sections, domain.LayoutSection{
+				SectionID:         cabinSection,
+				Name:              deck.Name + " Cabins",
+				Kind:              domain.SectionKindCabin,
+				Level:             i + 1,
+				Rows:              (len(deck.Cabins) + 1) / 2,
+				Columns:           2,
+				AisleAfterColumns: []int{1},
+			})
+			for j, cabin := range deck.Cabins {
 				seatNum := cabin.Name
 				seatClass := "cabin"
 				if cabin.IsSuite {
@@ -225,8 +422,12 @@ func mapAssetToSeatConfig(asset *fleetpb.Asset, pricing TripPricingDTO) service.
 				seats = append(seats, service.SeatDef{
 					SeatID:     fmt.Sprintf("%s-%s", asset.Id, cabin.Id),
 					SeatNumber: seatNum,
+					Row:        j/2 + 1,
+					Column:     j%2 + 1,
+					SeatType:   "cabin",
 					SeatClass:  seatClass,
 					PricePaisa: int64(cabin.PricePaisa),
+					SectionID:  cabinSection,
 				})
 				totalSeats++
 			}
@@ -234,7 +435,53 @@ func mapAssetToSeatConfig(asset *fleetpb.Asset, pricing TripPricingDTO) service.
 	}
 
 	return service.SeatConfig{
-		TotalSeats: totalSeats,
-		Seats:      seats,
+		TotalSeats:  totalSeats,
+		Seats:       seats,
+		VehicleType: vehicleType,
+		Sections:    sections,
+		Capacity:    capacity,
+	}
+}
+
+// seatTypeForColumn classifies a seat by its position relative to the windows and the aisle
+func seatTypeForColumn(col, perRow, aisleAfter int) string {
+	switch {
+	case col == 1 || col == perRow:
+		return "window"
+	case col == aisleAfter || col == aisleAfter+1:
+		return "aisle"
+	default:
+		return "middle"
+	}
+}
+
+// coachBerthPattern returns the berth labels repeated across a sleeper coach's row, or nil for chair coaches
+func coachBerthPattern(coach *fleetpb.TrainCoach) []string {
+	if !coach.HasBerths {
+		return nil
+	}
+
+	var main []string
+	switch coach.BerthConfig.GetType() {
+	case fleetpb.BerthType_BERTH_TYPE_THREE_TIER:
+		main = []string{"LB", "MB", "UB"}
+	case fleetpb.BerthType_BERTH_TYPE_TWO_TIER:
+		main = []string{"LB", "UB"}
+	default:
+		return nil
+	}
+
+	perRow := int(coach.SeatsPerRow)
+	if !coach.BerthConfig.GetHasSideBerths() || perRow <= 2 {
+		return repeatBerths(main, perRow)
+	}
+	return append(repeatBerths(main, perRow-2), "SL", "SU")
+}
+
+func repeatBerths(pattern []string, n int) []string {
+	berths := make([]string, n)
+	for i := range berths {
+		berths[i] = pattern[i%len(pattern)]
 	}
+	return berths
 }
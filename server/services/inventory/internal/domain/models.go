@@ -17,6 +17,12 @@ type Segment struct {
 	ArrivalTime    time.Time `json:"arrival_time"`
 }
 
+// TripRef identifies a trip within an organization
+type TripRef struct {
+	OrganizationID string `json:"organization_id"`
+	TripID         string `json:"trip_id"`
+}
+
 // SeatInventory represents the availability of a specific seat on a specific segment
 // Stored in ScyllaDB with partition key = (trip_id, segment_index)
 type SeatInventory struct {
@@ -27,30 +33,122 @@ type SeatInventory struct {
 	SeatNumber     string    `json:"seat_number"`
 	SeatClass      string    `json:"seat_class"` // economy, business, ac
 	SeatType       string    `json:"seat_type"`  // window, aisle, middle
-	Status         string    `json:"status"`     // available, held, booked, blocked
+	Status         string    `json:"status"`     // available, held, booked, blocked, unreleased
 	HoldID         string    `json:"hold_id,omitempty"`
 	HoldUserID     string    `json:"hold_user_id,omitempty"`
 	HoldExpiry     time.Time `json:"hold_expiry,omitempty"`
 	BookingID      string    `json:"booking_id,omitempty"`
 	PricePaisa     int64     `json:"price_paisa"`
+	Row            int       `json:"row,omitempty"`
+	Column         int       `json:"column,omitempty"`
+	SectionID      string    `json:"section_id,omitempty"` // deck, coach or cabin block
+	Berth          string    `json:"berth,omitempty"`      // LB, MB, UB, SL, SU
+	IsAccessible   bool      `json:"is_accessible,omitempty"`
+	HasPower       bool      `json:"has_power,omitempty"`
+	TrancheID      string    `json:"tranche_id,omitempty"` // Release tranche of an unreleased seat
+	BlockReason    string    `json:"block_reason,omitempty"`
+	BlockedBy      string    `json:"blocked_by,omitempty"`
+	BlockedUntil   time.Time `json:"blocked_until,omitempty"` // Zero = until unblocked by hand
 	UpdatedAt      time.Time `json:"updated_at"`
 }
 
+// SeatLayout describes the physical arrangement of a vehicle's seats
+// Stored once per trip so the seat map can be drawn on a fixed grid
+type SeatLayout struct {
+	VehicleID   string          `json:"vehicle_id"`
+	VehicleType string          `json:"vehicle_type"` // bus, train, launch
+	Sections    []LayoutSection `json:"sections"`
+}
+
+// LayoutSection is one drawable area: a bus deck, a train coach or a launch deck/cabin block
+type LayoutSection struct {
+	SectionID         string `json:"section_id"`
+	Name              string `json:"name"`
+	Kind              string `json:"kind"`  // deck, coach, cabin
+	Level             int    `json:"level"` // 1 = lower deck
+	Rows              int    `json:"rows"`
+	Columns           int    `json:"columns"`
+	AisleAfterColumns []int  `json:"aisle_after_columns,omitempty"`
+}
+
+// Layout section kinds
+const (
+	SectionKindDeck  = "deck"
+	SectionKindCoach = "coach"
+	SectionKindCabin = "cabin"
+)
+
 // SeatHold represents a temporary reservation across multiple segments
 type SeatHold struct {
-	HoldID         string    `json:"hold_id"`
-	OrganizationID string    `json:"organization_id"`
-	TripID         string    `json:"trip_id"`
-	UserID         string    `json:"user_id"`
-	SessionID      string    `json:"session_id"`
-	FromStationID  string    `json:"from_station_id"`
-	ToStationID    string    `json:"to_station_id"`
-	SeatIDs        []string  `json:"seat_ids"`
-	SegmentRange   []int     `json:"segment_range"` // e.g., [0,1,2] for A-D via B,C
-	Status         string    `json:"status"`        // active, expired, converted, released
-	ExpiresAt      time.Time `json:"expires_at"`
-	CreatedAt      time.Time `json:"created_at"`
-	IPAddress      string    `json:"ip_address"`
+	HoldID         string         `json:"hold_id"`
+	OrganizationID string         `json:"organization_id"`
+	TripID         string         `json:"trip_id"`
+	UserID         string         `json:"user_id"`
+	SessionID      string         `json:"session_id"`
+	FromStationID  string         `json:"from_station_id"`
+	ToStationID    string         `json:"to_station_id"`
+	SeatIDs        []string       `json:"seat_ids"`
+	SegmentRange   []int          `json:"segment_range"`      // e.g., [0,1,2] for A-D via B,C
+	Legs           []SeatLeg      `json:"legs,omitempty"`     // Split-seat holds only; SeatIDs then lists each distinct seat
+	Capacity       []CapacityItem `json:"capacity,omitempty"` // Unnumbered places held over the whole SegmentRange
+	Status         string         `json:"status"`             // active, expired, converted, released
+	ExpiresAt      time.Time      `json:"expires_at"`
+	ExtensionCount int            `json:"extension_count,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	IPAddress      string         `json:"ip_address"`
+}
+
+// SeatLeg places one passenger in one seat for part of a journey
+// A split-seat journey A->D might be seat 3A for A->C and seat 7B for C->D
+type SeatLeg struct {
+	PassengerIndex int    `json:"passenger_index"`
+	SeatID         string `json:"seat_id"`
+	FromStationID  string `json:"from_station_id"`
+	ToStationID    string `json:"to_station_id"`
+	SegmentRange   []int  `json:"segment_range"`
+}
+
+// SeatGroup is a set of seats held or booked over the same segments
+// Seat state changes are applied one group at a time
+type SeatGroup struct {
+	SegmentRange []int
+	SeatIDs      []string
+}
+
+// SeatGroups returns the hold's seats grouped by the segments they cover
+// Capacity-only holds have no seat groups.
+func (h *SeatHold) SeatGroups() []SeatGroup {
+	if len(h.Legs) == 0 {
+		if len(h.SeatIDs) == 0 {
+			return nil
+		}
+		return []SeatGroup{{SegmentRange: h.SegmentRange, SeatIDs: h.SeatIDs}}
+	}
+
+	var groups []SeatGroup
+	for _, leg := range h.Legs {
+		groups = addToSeatGroup(groups, leg.SegmentRange, leg.SeatID)
+	}
+	return groups
+}
+
+// PassengerCount returns the number of passengers the hold carries, seated or not
+func (h *SeatHold) PassengerCount() int {
+	return h.SeatedPassengerCount() + CapacityQuantity(h.Capacity)
+}
+
+// SeatedPassengerCount returns the number of passengers the hold seats
+func (h *SeatHold) SeatedPassengerCount() int {
+	if len(h.Legs) == 0 {
+		return len(h.SeatIDs)
+	}
+	count := 0
+	for _, leg := range h.Legs {
+		if leg.PassengerIndex+1 > count {
+			count = leg.PassengerIndex + 1
+		}
+	}
+	return count
 }
 
 // Booking represents confirmed seat reservations
@@ -71,22 +169,78 @@ type Booking struct {
 }
 
 // BookedSeat represents a single booked seat with passenger info
+// Split-seat bookings hold one BookedSeat per leg, each with its own ticket
 type BookedSeat struct {
-	SeatID        string `json:"seat_id"`
-	SeatNumber    string `json:"seat_number"`
-	SeatClass     string `json:"seat_class"`
-	TicketID      string `json:"ticket_id"`
-	PassengerNID  string `json:"passenger_nid"`
-	PassengerName string `json:"passenger_name"`
-	PricePaisa    int64  `json:"price_paisa"`
+	SeatID         string `json:"seat_id"`
+	SeatNumber     string `json:"seat_number"`
+	SeatClass      string `json:"seat_class"`
+	TicketID       string `json:"ticket_id"`
+	PassengerNID   string `json:"passenger_nid"`
+	PassengerName  string `json:"passenger_name"`
+	PricePaisa     int64  `json:"price_paisa"`
+	PassengerIndex int    `json:"passenger_index"`
+	FromStationID  string `json:"from_station_id,omitempty"` // Split-seat legs only
+	ToStationID    string `json:"to_station_id,omitempty"`
+	SegmentRange   []int  `json:"segment_range,omitempty"`
+	CapacityClass  string `json:"capacity_class,omitempty"` // Capacity tickets only; SeatID is then empty
+}
+
+// SeatGroups returns the booking's seats grouped by the segments they cover
+func (b *Booking) SeatGroups() []SeatGroup {
+	var groups []SeatGroup
+	for _, seat := range b.Seats {
+		if seat.SeatID == "" {
+			continue
+		}
+		segmentRange := seat.SegmentRange
+		if len(segmentRange) == 0 {
+			segmentRange = b.SegmentRange
+		}
+		groups = addToSeatGroup(groups, segmentRange, seat.SeatID)
+	}
+	return groups
+}
+
+// CapacityItems returns the unnumbered places the booking holds, by class
+func (b *Booking) CapacityItems() []CapacityItem {
+	var items []CapacityItem
+	for _, seat := range b.Seats {
+		if seat.CapacityClass != "" {
+			items = addCapacity(items, seat.CapacityClass, 1)
+		}
+	}
+	return items
+}
+
+func addToSeatGroup(groups []SeatGroup, segmentRange []int, seatID string) []SeatGroup {
+	for i := range groups {
+		if sameSegments(groups[i].SegmentRange, segmentRange) {
+			groups[i].SeatIDs = append(groups[i].SeatIDs, seatID)
+			return groups
+		}
+	}
+	return append(groups, SeatGroup{SegmentRange: segmentRange, SeatIDs: []string{seatID}})
+}
+
+func sameSegments(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 // Status constants
 const (
-	SeatStatusAvailable = "available"
-	SeatStatusHeld      = "held"
-	SeatStatusBooked    = "booked"
-	SeatStatusBlocked   = "blocked"
+	SeatStatusAvailable  = "available"
+	SeatStatusHeld       = "held"
+	SeatStatusBooked     = "booked"
+	SeatStatusBlocked    = "blocked"
+	SeatStatusUnreleased = "unreleased" // Held back for a release tranche
 
 	HoldStatusActive    = "active"
 	HoldStatusExpired   = "expired"
@@ -101,6 +255,7 @@ const (
 	WaitlistStatusNotified  = "notified"
 	WaitlistStatusExpired   = "expired"
 	WaitlistStatusConverted = "converted"
+	WaitlistStatusDeclined  = "declined"
 )
 
 // WaitlistEntry represents a user waiting for seats
@@ -110,8 +265,385 @@ type WaitlistEntry struct {
 	UserID         string    `json:"user_id"`
 	SeatClass      string    `json:"seat_class"`
 	RequestedSeats int       `json:"requested_seats"`
+	FromStationID  string    `json:"from_station_id"`
+	ToStationID    string    `json:"to_station_id"`
 	CreatedAt      time.Time `json:"created_at"`
-	Status         string    `json:"status"` // pending, notified, expired, converted
+	Status         string    `json:"status"` // pending, notified, expired, converted, declined
+
+	// Offer state, set while the entry is notified
+	HoldID         string    `json:"hold_id,omitempty"`
+	OfferExpiresAt time.Time `json:"offer_expires_at,omitempty"`
+}
+
+// SameEntry reports whether both values are the same waitlist entry. Entries are keyed by
+// when they joined and by whom, like the waitlist table.
+func (e WaitlistEntry) SameEntry(other WaitlistEntry) bool {
+	return e.UserID == other.UserID && e.CreatedAt.Equal(other.CreatedAt)
+}
+
+// SeatQuota reserves seats on a trip for an eligible group of passengers or a sales channel
+// Quota seats are only sold to eligible callers until the release cutoff before departure
+type SeatQuota struct {
+	OrganizationID string        `json:"organization_id"`
+	TripID         string        `json:"trip_id"`
+	QuotaID        string        `json:"quota_id"`
+	Name           string        `json:"name"`
+	QuotaType      string        `json:"quota_type"` // ladies, disabled, counter, partner, vip
+	SeatIDs        []string      `json:"seat_ids"`
+	SegmentIndexes []int         `json:"segment_indexes,omitempty"` // Empty = every segment
+	PartnerIDs     []string      `json:"partner_ids,omitempty"`     // Partner quotas: allowed resellers
+	ReleaseBefore  time.Duration `json:"release_before"`            // 0 = never released automatically
+	Released       bool          `json:"released"`
+	ReleasedAt     time.Time     `json:"released_at,omitempty"`
+	CreatedAt      time.Time     `json:"created_at"`
+}
+
+// QuotaClaims describes what the caller is entitled to when holding or searching seats
+type QuotaClaims struct {
+	Female    bool   `json:"female"`
+	Disabled  bool   `json:"disabled"`
+	Channel   string `json:"channel"` // web, app, counter, partner
+	PartnerID string `json:"partner_id"`
+	VIP       bool   `json:"vip"`
+}
+
+// Quota types and sales channels
+const (
+	QuotaTypeLadies   = "ladies"
+	QuotaTypeDisabled = "disabled"
+	QuotaTypeCounter  = "counter"
+	QuotaTypePartner  = "partner"
+	QuotaTypeVIP      = "vip"
+
+	ChannelCounter = "counter"
+	ChannelPartner = "partner"
+)
+
+// IsEligible reports whether a caller with the given claims may buy seats from this quota
+func (q *SeatQuota) IsEligible(claims QuotaClaims) bool {
+	switch q.QuotaType {
+	case QuotaTypeLadies:
+		return claims.Female
+	case QuotaTypeDisabled:
+		return claims.Disabled
+	case QuotaTypeCounter:
+		return claims.Channel == ChannelCounter
+	case QuotaTypePartner:
+		if claims.Channel != ChannelPartner {
+			return false
+		}
+		if len(q.PartnerIDs) == 0 {
+			return true
+		}
+		for _, id := range q.PartnerIDs {
+			if id == claims.PartnerID {
+				return true
+			}
+		}
+		return false
+	case QuotaTypeVIP:
+		return claims.VIP
+	default:
+		return false
+	}
+}
+
+// CoversSegment reports whether the quota applies to the given segment
+func (q *SeatQuota) CoversSegment(segmentIndex int) bool {
+	if len(q.SegmentIndexes) == 0 {
+		return true
+	}
+	for _, idx := range q.SegmentIndexes {
+		if idx == segmentIndex {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActive reports whether the quota still restricts the segment at the given time
+func (q *SeatQuota) IsActive(seg Segment, now time.Time) bool {
+	if q.Released || !q.CoversSegment(seg.SegmentIndex) {
+		return false
+	}
+	if q.ReleaseBefore <= 0 {
+		return true
+	}
+	return now.Before(seg.DepartureTime.Add(-q.ReleaseBefore))
+}
+
+// SeatBlock takes seats out of sale for staff, broken seats or VIPs
+// Schedule blocks are stored and applied to every trip generated from the schedule
+// Seats are matched by ID or by number; numbers still match after a vehicle swap
+type SeatBlock struct {
+	OrganizationID string    `json:"organization_id"`
+	TripID         string    `json:"trip_id,omitempty"`     // Single-trip blocks
+	ScheduleID     string    `json:"schedule_id,omitempty"` // Schedule blocks, stored
+	BlockID        string    `json:"block_id,omitempty"`
+	SeatIDs        []string  `json:"seat_ids,omitempty"`
+	SeatNumbers    []string  `json:"seat_numbers,omitempty"`
+	SegmentIndexes []int     `json:"segment_indexes,omitempty"` // Empty = every segment
+	Reason         string    `json:"reason"`
+	BlockedBy      string    `json:"blocked_by"`
+	UnblockAt      time.Time `json:"unblock_at,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// IsActive reports whether the block still applies to trips at the given time
+func (b *SeatBlock) IsActive(now time.Time) bool {
+	return b.UnblockAt.IsZero() || now.Before(b.UnblockAt)
+}
+
+// ReleaseTranche holds seats back from sale until a release time, for staged pre-sale launches.
+// Seats are named by ID or number, or taken as a percentage of the trip's seats.
+// Schedule tranches are stored and applied to every trip generated from the schedule,
+// so their release time is usually given relative to departure.
+// A tranche with neither time stays held back until released by hand, e.g. for counter sales.
+type ReleaseTranche struct {
+	OrganizationID         string        `json:"organization_id"`
+	TripID                 string        `json:"trip_id,omitempty"`
+	ScheduleID             string        `json:"schedule_id,omitempty"` // Template, or the schedule a trip tranche came from
+	TrancheID              string        `json:"tranche_id"`
+	Name                   string        `json:"name"`
+	Percentage             int           `json:"percentage,omitempty"`
+	SeatIDs                []string      `json:"seat_ids,omitempty"` // Trip tranches: the seats held back
+	SeatNumbers            []string      `json:"seat_numbers,omitempty"`
+	ReleaseAt              time.Time     `json:"release_at,omitempty"`
+	ReleaseBeforeDeparture time.Duration `json:"release_before_departure,omitempty"` // Used when ReleaseAt is zero
+	Status                 string        `json:"status"`                             // pending, released
+	ReleasedAt             time.Time     `json:"released_at,omitempty"`
+	CreatedBy              string        `json:"created_by"`
+	CreatedAt              time.Time     `json:"created_at"`
+}
+
+// Release tranche statuses
+const (
+	TrancheStatusPending  = "pending"
+	TrancheStatusReleased = "released"
+)
+
+// Timed reports whether the tranche releases itself
+func (t *ReleaseTranche) Timed() bool {
+	return !t.ReleaseAt.IsZero() || t.ReleaseBeforeDeparture > 0
+}
+
+// HoldPolicy limits how far an organization's holds may be extended
+type HoldPolicy struct {
+	OrganizationID  string        `json:"organization_id"`
+	MaxHoldLifetime time.Duration `json:"max_hold_lifetime"` // From hold creation, across all extensions
+	MaxExtensions   int           `json:"max_extensions"`
+	ExtensionStep   time.Duration `json:"extension_step"` // Used when the caller does not ask for a duration
+	UpdatedAt       time.Time     `json:"updated_at"`
+}
+
+// Hold policy defaults for organizations that have not configured one
+const (
+	DefaultMaxHoldLifetime = 30 * time.Minute
+	DefaultMaxExtensions   = 2
+	DefaultExtensionStep   = 5 * time.Minute
+)
+
+// DefaultHoldPolicy returns the policy applied when an organization has none stored
+func DefaultHoldPolicy(orgID string) *HoldPolicy {
+	return &HoldPolicy{
+		OrganizationID:  orgID,
+		MaxHoldLifetime: DefaultMaxHoldLifetime,
+		MaxExtensions:   DefaultMaxExtensions,
+		ExtensionStep:   DefaultExtensionStep,
+	}
+}
+
+// AvailabilityCount is the number of free seats of one class on one segment, kept in
+// availability_counters so listings need not scan seat_inventory. Eventually consistent.
+type AvailabilityCount struct {
+	SegmentIndex int    `json:"segment_index"`
+	SeatClass    string `json:"seat_class"`
+	Available    int    `json:"available"`
+}
+
+// CapacityInventory counts unnumbered places of one class on one segment:
+// deck passengers on a launch or standing tickets on a train.
+// Places are sold by quantity, so there is no per-seat row to lock.
+type CapacityInventory struct {
+	OrganizationID string    `json:"organization_id"`
+	TripID         string    `json:"trip_id"`
+	SegmentIndex   int       `json:"segment_index"`
+	CapacityClass  string    `json:"capacity_class"`
+	MaxCount       int       `json:"max_count"`
+	HeldCount      int       `json:"held_count"`
+	BookedCount    int       `json:"booked_count"`
+	PricePaisa     int64     `json:"price_paisa"` // Whole journey, like seat prices
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Available returns the places neither held nor booked
+func (c *CapacityInventory) Available() int {
+	if free := c.MaxCount - c.HeldCount - c.BookedCount; free > 0 {
+		return free
+	}
+	return 0
+}
+
+// Capacity classes derived from vehicle configuration
+const (
+	CapacityClassDeck     = "deck"     // Launch deck passengers
+	CapacityClassStanding = "standing" // Standing train tickets
+)
+
+// CapacityItem is a quantity of unnumbered places of one class, one passenger each
+type CapacityItem struct {
+	CapacityClass string `json:"capacity_class"`
+	Quantity      int    `json:"quantity"`
+}
+
+// CapacityHold records the places one hold took from one class
+// Its status moves exactly once out of held, which decides who adjusts the counts
+type CapacityHold struct {
+	HoldID        string    `json:"hold_id"`
+	CapacityClass string    `json:"capacity_class"`
+	Quantity      int       `json:"quantity"`
+	SegmentRange  []int     `json:"segment_range"`
+	Status        string    `json:"status"` // held, booked, released, expired
+	HoldExpiry    time.Time `json:"hold_expiry"`
+}
+
+// Capacity hold statuses
+const (
+	CapacityHoldHeld     = "held"
+	CapacityHoldBooked   = "booked"
+	CapacityHoldReleased = "released"
+	CapacityHoldExpired  = "expired"
+)
+
+// CapacityQuantity returns the total number of places across items
+func CapacityQuantity(items []CapacityItem) int {
+	total := 0
+	for _, item := range items {
+		total += item.Quantity
+	}
+	return total
+}
+
+// MergeCapacityItems folds repeated classes together and drops empty quantities
+func MergeCapacityItems(items []CapacityItem) []CapacityItem {
+	var merged []CapacityItem
+	for _, item := range items {
+		if item.Quantity > 0 {
+			merged = addCapacity(merged, item.CapacityClass, item.Quantity)
+		}
+	}
+	return merged
+}
+
+func addCapacity(items []CapacityItem, class string, quantity int) []CapacityItem {
+	for i := range items {
+		if items[i].CapacityClass == class {
+			items[i].Quantity += quantity
+			return items
+		}
+	}
+	return append(items, CapacityItem{CapacityClass: class, Quantity: quantity})
+}
+
+// Reaccommodation records where one passenger went when the trip changed vehicle.
+// Passengers who could not be placed keep a record with status unplaced until refunded or moved.
+type Reaccommodation struct {
+	OrganizationID string    `json:"organization_id"`
+	TripID         string    `json:"trip_id"`
+	ReferenceID    string    `json:"reference_id"`   // Booking or hold ID
+	ReferenceType  string    `json:"reference_type"` // booking, hold
+	OrderID        string    `json:"order_id,omitempty"`
+	UserID         string    `json:"user_id"`
+	PassengerIndex int       `json:"passenger_index"`
+	PassengerName  string    `json:"passenger_name,omitempty"`
+	PassengerNID   string    `json:"passenger_nid,omitempty"`
+	SeatClass      string    `json:"seat_class"` // Capacity class for deck or standing passengers
+	OldSeatID      string    `json:"old_seat_id,omitempty"`
+	OldSeatNumber  string    `json:"old_seat_number,omitempty"`
+	NewSeatID      string    `json:"new_seat_id,omitempty"`
+	NewSeatNumber  string    `json:"new_seat_number,omitempty"`
+	OldTicketID    string    `json:"old_ticket_id,omitempty"`
+	NewTicketID    string    `json:"new_ticket_id,omitempty"`
+	Status         string    `json:"status"` // moved, unplaced
+	OldVehicleID   string    `json:"old_vehicle_id"`
+	NewVehicleID   string    `json:"new_vehicle_id"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// Re-accommodation reference types and outcomes
+const (
+	ReaccommodationBooking  = "booking"
+	ReaccommodationHold     = "hold"
+	ReaccommodationMoved    = "moved"
+	ReaccommodationUnplaced = "unplaced"
+)
+
+// SeatTransition is one entry of the append-only seat ledger: a seat moving from
+// one status to another on one segment, who moved it and for which hold or booking.
+type SeatTransition struct {
+	OrganizationID string    `json:"organization_id"`
+	TripID         string    `json:"trip_id"`
+	SegmentIndex   int       `json:"segment_index"`
+	SeatID         string    `json:"seat_id"`
+	FromStatus     string    `json:"from_status"`
+	ToStatus       string    `json:"to_status"`
+	Actor          string    `json:"actor"` // User ID, or one of the Actor* background actors
+	HoldID         string    `json:"hold_id,omitempty"`
+	BookingID      string    `json:"booking_id,omitempty"`
+	Reason         string    `json:"reason,omitempty"`
+	RecordedAt     time.Time `json:"recorded_at"`
+}
+
+// Ledger actors for seat changes no user asked for
+const (
+	ActorSystem          = "system"
+	ActorHoldSweeper     = "hold-sweeper"
+	ActorSeatUnblocker   = "seat-unblocker"
+	ActorReaccommodation = "reaccommodation"
+	ActorReconciler      = "reconciler"
+	ActorTrancheReleaser = "tranche-releaser"
+)
+
+// SeatLock is the short-lived Redis lock taken on one seat and segment while a hold is placed
+type SeatLock struct {
+	Key          string
+	SegmentIndex int
+	SeatID       string
+	TTL          time.Duration // -1 when the lock never expires
+}
+
+// Discrepancy is one disagreement the reconciler found between the seat rows,
+// the Redis holds and locks, the bookings and the order service
+type Discrepancy struct {
+	Kind         string `json:"kind"`
+	SegmentIndex int    `json:"segment_index,omitempty"`
+	SeatID       string `json:"seat_id,omitempty"`
+	HoldID       string `json:"hold_id,omitempty"`
+	BookingID    string `json:"booking_id,omitempty"`
+	OrderID      string `json:"order_id,omitempty"`
+	Detail       string `json:"detail"`
+	Repaired     bool   `json:"repaired"`
+}
+
+// Discrepancy kinds
+const (
+	DiscrepancyOrphanedHold     = "orphaned_hold"      // Seat held for a hold Redis no longer has active
+	DiscrepancyHoldWithoutSeats = "hold_without_seats" // Active Redis hold whose seats are not held for it
+	DiscrepancyStaleSeatLock    = "stale_seat_lock"    // Seat lock that never expires
+	DiscrepancyDoubleBooked     = "double_booked"      // Seat and segment claimed by two confirmed bookings
+	DiscrepancyUnbookedSeat     = "unbooked_seat"      // Confirmed booking whose seat row is not booked for it
+	DiscrepancyOrphanedBooking  = "orphaned_booking"   // Seat booked for a missing or cancelled booking
+	DiscrepancyBookingNoOrder   = "booking_without_order"
+	DiscrepancyClosedOrder      = "closed_order" // Confirmed booking whose order failed, expired or was cancelled
+)
+
+// ReconciliationReport lists what the reconciler found on one trip
+type ReconciliationReport struct {
+	OrganizationID string        `json:"organization_id"`
+	TripID         string        `json:"trip_id"`
+	Discrepancies  []Discrepancy `json:"discrepancies"`
+	Repaired       int           `json:"repaired"`
+	CheckedAt      time.Time     `json:"checked_at"`
 }
 
 // SegmentRange calculates which segment indices are covered for a journey
@@ -147,6 +679,28 @@ var ErrInvalidStationRange = &DomainError{Message: "invalid station range"}
 var ErrSeatNotAvailable = &DomainError{Message: "seat not available"}
 var ErrHoldExpired = &DomainError{Message: "hold has expired"}
 var ErrHoldNotFound = &DomainError{Message: "hold not found"}
+var ErrBookingNotFound = &DomainError{Message: "booking not found"}
+var ErrWaitlistEntryNotFound = &DomainError{Message: "waitlist entry not found"}
+var ErrNoWaitlistOffer = &DomainError{Message: "no outstanding waitlist offer"}
+var ErrInvalidSeatCount = &DomainError{Message: "seat count must be positive"}
+var ErrInsufficientSeats = &DomainError{Message: "not enough seats available"}
+var ErrNoAdjacentSeats = &DomainError{Message: "no adjacent seats available for group"}
+var ErrSeatReservedForQuota = &DomainError{Message: "seat reserved for a quota the caller is not eligible for"}
+var ErrInvalidSeatLegs = &DomainError{Message: "seat legs must cover the journey without gaps or overlaps"}
+var ErrTooManySeatChanges = &DomainError{Message: "too many seat changes for one passenger"}
+var ErrBlockTargetRequired = &DomainError{Message: "trip_id or schedule_id is required"}
+var ErrNoSeatsSelected = &DomainError{Message: "seat_ids or seat_numbers is required"}
+var ErrSeatBlockNotFound = &DomainError{Message: "seat block not found"}
+var ErrHoldExtensionLimit = &DomainError{Message: "hold extension limit reached"}
+var ErrHoldLifetimeExceeded = &DomainError{Message: "hold has reached its maximum lifetime"}
+var ErrInsufficientCapacity = &DomainError{Message: "not enough capacity available"}
+var ErrInvalidCapacityItem = &DomainError{Message: "capacity items need a class and a positive quantity"}
+var ErrCapacityContention = &DomainError{Message: "capacity contention - please retry"}
+var ErrTripInventoryNotFound = &DomainError{Message: "trip inventory not found"}
+var ErrOrderNotFound = &DomainError{Message: "order not found"}
+var ErrInvalidTranche = &DomainError{Message: "a tranche needs seat_ids, seat_numbers or a percentage from 1 to 100"}
+var ErrTranchesOverAllocated = &DomainError{Message: "tranche percentages add up to more than 100"}
+var ErrTrancheNotFound = &DomainError{Message: "release tranche not found"}
 
 type DomainError struct {
 	Message string
@@ -12,6 +12,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// maxAvailabilityCountQueries bounds one GetAvailabilityCounts call, about two listing pages
+const maxAvailabilityCountQueries = 200
+
 type GrpcHandler struct {
 	pb.UnimplementedInventoryServiceServer
 	inventoryService *service.InventoryService
@@ -22,7 +25,7 @@ func NewGrpcHandler(inventoryService *service.InventoryService) *GrpcHandler {
 }
 
 func (h *GrpcHandler) CheckAvailability(ctx context.Context, req *pb.CheckAvailabilityRequest) (*pb.CheckAvailabilityResponse, error) {
-	result, err := h.inventoryService.CheckAvailability(ctx, req.OrganizationId, req.TripId, req.FromStationId, req.ToStationId, int(req.Passengers), req.SeatClass)
+	result, err := h.inventoryService.CheckAvailability(ctx, req.OrganizationId, req.TripId, req.FromStationId, req.ToStationId, int(req.Passengers), req.SeatClass, quotaClaimsFromProto(req.QuotaClaims), req.AllowSeatChange, capacityItemsFromProto(req.CapacityItems))
 	if err != nil {
 		if err == domain.ErrInvalidStationRange {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -41,21 +44,133 @@ func (h *GrpcHandler) CheckAvailability(ctx context.Context, req *pb.CheckAvaila
 		})
 	}
 
+	var quotas []*pb.QuotaAvailability
+	for _, q := range result.Quotas {
+		quotas = append(quotas, &pb.QuotaAvailability{
+			QuotaId:        q.QuotaID,
+			Name:           q.Name,
+			QuotaType:      q.QuotaType,
+			AvailableSeats: int32(q.AvailableCount),
+			Eligible:       q.Eligible,
+		})
+	}
+
+	var plans []*pb.SeatChangePlan
+	for _, plan := range result.SeatChangePlans {
+		var legs []*pb.SeatLeg
+		for _, leg := range plan.Legs {
+			segmentIndexes := make([]int32, 0, len(leg.SegmentRange))
+			for _, idx := range leg.SegmentRange {
+				segmentIndexes = append(segmentIndexes, int32(idx))
+			}
+			legs = append(legs, &pb.SeatLeg{
+				SeatId:         leg.SeatID,
+				SeatNumber:     leg.SeatNumber,
+				SeatClass:      leg.SeatClass,
+				FromStationId:  leg.FromStationID,
+				ToStationId:    leg.ToStationID,
+				SegmentIndexes: segmentIndexes,
+				PricePaisa:     leg.PricePaisa,
+			})
+		}
+		plans = append(plans, &pb.SeatChangePlan{
+			PassengerIndex: int32(plan.PassengerIndex),
+			Legs:           legs,
+			PricePaisa:     plan.PricePaisa,
+		})
+	}
+
+	var capacity []*pb.CapacityAvailability
+	for _, c := range result.Capacity {
+		capacity = append(capacity, &pb.CapacityAvailability{
+			CapacityClass: c.CapacityClass,
+			MaxCount:      int32(c.MaxCount),
+			Available:     int32(c.Available),
+			PricePaisa:    c.PricePaisa,
+		})
+	}
+
 	return &pb.CheckAvailabilityResponse{
-		IsAvailable:    result.IsAvailable,
-		AvailableSeats: int32(result.AvailableCount),
-		Seats:          seats,
-		PricePaisa:     result.TotalPricePaisa,
-		CheckedAt:      result.CheckedAt.Unix(),
+		IsAvailable:     result.IsAvailable,
+		AvailableSeats:  int32(result.AvailableCount),
+		Seats:           seats,
+		PricePaisa:      result.TotalPricePaisa,
+		CheckedAt:       result.CheckedAt.Unix(),
+		Quotas:          quotas,
+		SeatChangePlans: plans,
+		Capacity:        capacity,
 	}, nil
 }
 
+func (h *GrpcHandler) GetAvailabilityCounts(ctx context.Context, req *pb.GetAvailabilityCountsRequest) (*pb.GetAvailabilityCountsResponse, error) {
+	if len(req.Queries) > maxAvailabilityCountQueries {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d queries per call", maxAvailabilityCountQueries)
+	}
+
+	queries := make([]service.AvailabilityQuery, 0, len(req.Queries))
+	for _, q := range req.Queries {
+		queries = append(queries, service.AvailabilityQuery{
+			OrganizationID: q.OrganizationId,
+			TripID:         q.TripId,
+			FromStationID:  q.FromStationId,
+			ToStationID:    q.ToStationId,
+		})
+	}
+
+	summaries := h.inventoryService.GetAvailabilityCounts(ctx, queries)
+
+	results := make([]*pb.AvailabilityCountResult, 0, len(summaries))
+	for _, summary := range summaries {
+		result := &pb.AvailabilityCountResult{
+			OrganizationId: summary.OrganizationID,
+			TripId:         summary.TripID,
+			FromStationId:  summary.FromStationID,
+			ToStationId:    summary.ToStationID,
+			AvailableSeats: int32(summary.AvailableSeats),
+		}
+		if summary.Err != nil {
+			result.Error = summary.Err.Error()
+		}
+		for _, c := range summary.Classes {
+			result.Classes = append(result.Classes, &pb.ClassAvailabilityCount{
+				SeatClass: c.SeatClass,
+				Available: int32(c.Available),
+			})
+		}
+		for _, c := range summary.Capacity {
+			result.Capacity = append(result.Capacity, &pb.CapacityAvailability{
+				CapacityClass: c.CapacityClass,
+				MaxCount:      int32(c.MaxCount),
+				Available:     int32(c.Available),
+				PricePaisa:    c.PricePaisa,
+			})
+		}
+		results = append(results, result)
+	}
+
+	return &pb.GetAvailabilityCountsResponse{Results: results}, nil
+}
+
 func (h *GrpcHandler) HoldSeats(ctx context.Context, req *pb.HoldSeatsRequest) (*pb.HoldSeatsResponse, error) {
 	holdDuration := time.Duration(req.HoldDurationSeconds) * time.Second
 	if holdDuration == 0 {
 		holdDuration = 10 * time.Minute
 	}
 
+	if len(req.SeatIds) == 0 && len(req.SeatLegs) == 0 && req.SeatCount <= 0 && len(req.CapacityItems) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "seat_ids, seat_legs, seat_count or capacity_items is required")
+	}
+
+	var legs []domain.SeatLeg
+	for _, leg := range req.SeatLegs {
+		legs = append(legs, domain.SeatLeg{
+			PassengerIndex: int(leg.PassengerIndex),
+			SeatID:         leg.SeatId,
+			FromStationID:  leg.FromStationId,
+			ToStationID:    leg.ToStationId,
+		})
+	}
+
 	result, err := h.inventoryService.HoldSeats(ctx, &service.HoldRequest{
 		OrganizationID: req.OrganizationId,
 		TripID:         req.TripId,
@@ -65,6 +180,15 @@ func (h *GrpcHandler) HoldSeats(ctx context.Context, req *pb.HoldSeatsRequest) (
 		UserID:         req.UserId,
 		SessionID:      req.SessionId,
 		HoldDuration:   holdDuration,
+		SeatCount:      int(req.SeatCount),
+		Preferences: service.SeatPreferences{
+			SeatClass:       req.GetPreferences().GetSeatClass(),
+			Position:        req.GetPreferences().GetPosition(),
+			RequireTogether: req.GetPreferences().GetRequireTogether(),
+		},
+		QuotaClaims: quotaClaimsFromProto(req.QuotaClaims),
+		Legs:        legs,
+		Capacity:    capacityItemsFromProto(req.CapacityItems),
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "hold failed")
@@ -77,6 +201,7 @@ func (h *GrpcHandler) HoldSeats(ctx context.Context, req *pb.HoldSeatsRequest) (
 		FailedSeatIds: result.FailedSeatIDs,
 		ExpiresAt:     result.ExpiresAt.Unix(),
 		FailureReason: result.FailureReason,
+		HeldCapacity:  capacityItemsToProto(result.HeldCapacity),
 	}, nil
 }
 
@@ -88,12 +213,133 @@ func (h *GrpcHandler) ReleaseSeats(ctx context.Context, req *pb.ReleaseSeatsRequ
 	return &pb.ReleaseSeatsResponse{Success: true}, nil
 }
 
+func (h *GrpcHandler) ExtendHold(ctx context.Context, req *pb.ExtendHoldRequest) (*pb.ExtendHoldResponse, error) {
+	extendBy := time.Duration(req.ExtendBySeconds) * time.Second
+	extend := h.inventoryService.ExtendHold
+	if req.ForReview {
+		extend = h.inventoryService.ExtendHoldForReview
+	}
+	result, err := extend(ctx, req.OrganizationId, req.HoldId, req.UserId, extendBy)
+	if err != nil {
+		if err == domain.ErrHoldExpired || err == domain.ErrHoldNotFound {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		logger.Error("Failed to extend hold", "error", err, "hold_id", req.HoldId)
+		return nil, status.Error(codes.Internal, "hold extension failed")
+	}
+
+	return &pb.ExtendHoldResponse{
+		Success:             result.Success,
+		ExpiresAt:           result.ExpiresAt.Unix(),
+		ExtensionsRemaining: int32(result.ExtensionsRemaining),
+		FailureReason:       result.FailureReason,
+	}, nil
+}
+
+func (h *GrpcHandler) BlockSeats(ctx context.Context, req *pb.BlockSeatsRequest) (*pb.BlockSeatsResponse, error) {
+	block := &domain.SeatBlock{
+		OrganizationID: req.OrganizationId,
+		TripID:         req.TripId,
+		ScheduleID:     req.ScheduleId,
+		SeatIDs:        req.SeatIds,
+		SeatNumbers:    req.SeatNumbers,
+		SegmentIndexes: int32sToInts(req.SegmentIndexes),
+		Reason:         req.Reason,
+		BlockedBy:      req.BlockedBy,
+	}
+	if req.UnblockAt > 0 {
+		block.UnblockAt = time.Unix(req.UnblockAt, 0)
+	}
+
+	result, err := h.inventoryService.BlockSeats(ctx, block)
+	if err != nil {
+		if err == domain.ErrNoSeatsSelected || err == domain.ErrBlockTargetRequired {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		logger.Error("Failed to block seats", "error", err, "trip_id", req.TripId, "schedule_id", req.ScheduleId)
+		return nil, status.Error(codes.Internal, "failed to block seats")
+	}
+
+	var trips []*pb.TripSeatBlockResult
+	for _, t := range result.Trips {
+		trips = append(trips, &pb.TripSeatBlockResult{
+			TripId:         t.TripID,
+			BlockedSeatIds: t.BlockedSeatIDs,
+			FailedSeatIds:  t.FailedSeatIDs,
+		})
+	}
+	return &pb.BlockSeatsResponse{
+		BlockedCount: int32(result.BlockedCount),
+		Trips:        trips,
+		BlockId:      result.BlockID,
+	}, nil
+}
+
+func (h *GrpcHandler) UnblockSeats(ctx context.Context, req *pb.UnblockSeatsRequest) (*pb.UnblockSeatsResponse, error) {
+	unblocked, err := h.inventoryService.UnblockSeats(ctx, &domain.SeatBlock{
+		OrganizationID: req.OrganizationId,
+		TripID:         req.TripId,
+		ScheduleID:     req.ScheduleId,
+		BlockID:        req.BlockId,
+		SeatIDs:        req.SeatIds,
+		SeatNumbers:    req.SeatNumbers,
+		SegmentIndexes: int32sToInts(req.SegmentIndexes),
+		BlockedBy:      req.UnblockedBy,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrNoSeatsSelected, domain.ErrBlockTargetRequired:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrSeatBlockNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		logger.Error("Failed to unblock seats", "error", err, "trip_id", req.TripId, "schedule_id", req.ScheduleId)
+		return nil, status.Error(codes.Internal, "failed to unblock seats")
+	}
+	return &pb.UnblockSeatsResponse{UnblockedCount: int32(unblocked)}, nil
+}
+
+func (h *GrpcHandler) SetHoldPolicy(ctx context.Context, req *pb.SetHoldPolicyRequest) (*pb.SetHoldPolicyResponse, error) {
+	if req.Policy == nil || req.Policy.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "policy.organization_id is required")
+	}
+
+	_, err := h.inventoryService.SetHoldPolicy(ctx, &domain.HoldPolicy{
+		OrganizationID:  req.Policy.OrganizationId,
+		MaxHoldLifetime: time.Duration(req.Policy.MaxHoldLifetimeSeconds) * time.Second,
+		MaxExtensions:   int(req.Policy.MaxExtensions),
+		ExtensionStep:   time.Duration(req.Policy.ExtensionSeconds) * time.Second,
+	})
+	if err != nil {
+		logger.Error("Failed to save hold policy", "error", err, "organization_id", req.Policy.OrganizationId)
+		return nil, status.Error(codes.Internal, "failed to save hold policy")
+	}
+	return &pb.SetHoldPolicyResponse{Success: true}, nil
+}
+
+func (h *GrpcHandler) GetHoldPolicy(ctx context.Context, req *pb.GetHoldPolicyRequest) (*pb.GetHoldPolicyResponse, error) {
+	policy, err := h.inventoryService.GetHoldPolicy(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get hold policy")
+	}
+
+	return &pb.GetHoldPolicyResponse{
+		Policy: &pb.HoldPolicy{
+			OrganizationId:         policy.OrganizationID,
+			MaxHoldLifetimeSeconds: int32(policy.MaxHoldLifetime / time.Second),
+			MaxExtensions:          int32(policy.MaxExtensions),
+			ExtensionSeconds:       int32(policy.ExtensionStep / time.Second),
+		},
+	}, nil
+}
+
 func (h *GrpcHandler) ConfirmBooking(ctx context.Context, req *pb.ConfirmBookingRequest) (*pb.ConfirmBookingResponse, error) {
 	var passengers []service.PassengerInfo
 	for _, p := range req.Passengers {
 		passengers = append(passengers, service.PassengerInfo{
-			NID:  p.PassengerNid,
-			Name: p.PassengerName,
+			SeatID: p.SeatId,
+			NID:    p.PassengerNid,
+			Name:   p.PassengerName,
 		})
 	}
 
@@ -108,9 +354,15 @@ func (h *GrpcHandler) ConfirmBooking(ctx context.Context, req *pb.ConfirmBooking
 	var confirmed []*pb.ConfirmedSeat
 	for _, s := range result.ConfirmedSeats {
 		confirmed = append(confirmed, &pb.ConfirmedSeat{
-			SeatId:     s.SeatID,
-			SeatNumber: s.SeatNumber,
-			TicketId:   s.TicketID,
+			SeatId:         s.SeatID,
+			SeatNumber:     s.SeatNumber,
+			TicketId:       s.TicketID,
+			PassengerIndex: int32(s.PassengerIndex),
+			FromStationId:  s.FromStationID,
+			ToStationId:    s.ToStationID,
+			SeatClass:      s.SeatClass,
+			PricePaisa:     s.PricePaisa,
+			CapacityClass:  s.CapacityClass,
 		})
 	}
 
@@ -122,36 +374,126 @@ func (h *GrpcHandler) ConfirmBooking(ctx context.Context, req *pb.ConfirmBooking
 	}, nil
 }
 
+func (h *GrpcHandler) CancelBooking(ctx context.Context, req *pb.CancelBookingRequest) (*pb.CancelBookingResponse, error) {
+	var released int
+	var err error
+	if len(req.PassengerIndexes) > 0 {
+		indexes := make([]int, 0, len(req.PassengerIndexes))
+		for _, idx := range req.PassengerIndexes {
+			indexes = append(indexes, int(idx))
+		}
+		released, err = h.inventoryService.CancelBookingPassengers(ctx, req.OrganizationId, req.BookingId, req.OrderId, indexes)
+	} else {
+		released, err = h.inventoryService.CancelBooking(ctx, req.OrganizationId, req.BookingId, req.OrderId)
+	}
+	if err != nil {
+		if err == domain.ErrBookingNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		logger.Error("Failed to cancel booking", "error", err, "booking_id", req.BookingId)
+		return nil, status.Error(codes.Internal, "booking cancellation failed")
+	}
+
+	return &pb.CancelBookingResponse{
+		Success:       true,
+		ReleasedCount: int32(released),
+	}, nil
+}
+
+func (h *GrpcHandler) TransferPassenger(ctx context.Context, req *pb.TransferPassengerRequest) (*pb.TransferPassengerResponse, error) {
+	if req.PassengerNid == "" || req.PassengerName == "" {
+		return nil, status.Error(codes.InvalidArgument, "passenger_nid and passenger_name are required")
+	}
+	updated, err := h.inventoryService.TransferPassenger(ctx, req.OrganizationId, req.BookingId, req.OrderId,
+		int(req.PassengerIndex), req.PassengerNid, req.PassengerName)
+	if err != nil {
+		if err == domain.ErrBookingNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		logger.Error("Failed to transfer passenger", "error", err, "booking_id", req.BookingId)
+		return nil, status.Error(codes.Internal, "passenger transfer failed")
+	}
+	return &pb.TransferPassengerResponse{SeatsUpdated: int32(updated)}, nil
+}
+
 func (h *GrpcHandler) GetSeatMap(ctx context.Context, req *pb.GetSeatMapRequest) (*pb.GetSeatMapResponse, error) {
-	result, err := h.inventoryService.GetSeatMap(ctx, req.OrganizationId, req.TripId, req.FromStationId, req.ToStationId)
+	result, err := h.inventoryService.GetSeatMap(ctx, req.OrganizationId, req.TripId, req.FromStationId, req.ToStationId, req.IncludeBlockDetails)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "seat map retrieval failed")
 	}
 
-	var rows []*pb.SeatRow
-	for _, r := range result.Rows {
+	var sections []*pb.SeatSection
+	for _, sec := range result.Sections {
+		aisles := make([]int32, 0, len(sec.AisleAfterColumns))
+		for _, c := range sec.AisleAfterColumns {
+			aisles = append(aisles, int32(c))
+		}
+		sections = append(sections, &pb.SeatSection{
+			SectionId:         sec.SectionID,
+			Name:              sec.Name,
+			Kind:              sec.Kind,
+			Level:             int32(sec.Level),
+			TotalRows:         int32(sec.TotalRows),
+			TotalColumns:      int32(sec.TotalColumns),
+			AisleAfterColumns: aisles,
+			Rows:              seatRowsToProto(sec.Rows),
+		})
+	}
+
+	return &pb.GetSeatMapResponse{
+		VehicleId:    result.VehicleID,
+		VehicleType:  result.VehicleType,
+		TotalRows:    int32(result.TotalRows),
+		TotalColumns: int32(result.TotalColumns),
+		Rows:         seatRowsToProto(result.Rows),
+		Legend:       &pb.SeatMapLegend{StatusColors: result.Legend},
+		Sections:     sections,
+	}, nil
+}
+
+func seatRowsToProto(rows []service.SeatRow) []*pb.SeatRow {
+	var result []*pb.SeatRow
+	for _, r := range rows {
 		var seats []*pb.SeatCell
 		for _, s := range r.Seats {
-			seats = append(seats, &pb.SeatCell{
-				SeatId:     s.SeatID,
-				SeatNumber: s.SeatNumber,
-				Column:     int32(s.Column),
-				SeatType:   s.SeatType,
-				SeatClass:  s.SeatClass,
-				Status:     stringToProtoSeatStatus(s.Status),
-				PricePaisa: s.PricePaisa,
-			})
+			cell := &pb.SeatCell{
+				SeatId:       s.SeatID,
+				SeatNumber:   s.SeatNumber,
+				Row:          int32(s.Row),
+				Column:       int32(s.Column),
+				SectionId:    s.SectionID,
+				Berth:        s.Berth,
+				SeatType:     s.SeatType,
+				SeatClass:    s.SeatClass,
+				PricePaisa:   s.PricePaisa,
+				IsAccessible: s.IsAccessible,
+				HasPower:     s.HasPower,
+				QuotaType:    s.QuotaType,
+				BlockReason:  s.BlockReason,
+			}
+			if s.SeatID != "" {
+				cell.Status = stringToProtoSeatStatus(s.Status)
+			}
+			if !s.HoldExpiry.IsZero() {
+				cell.HoldExpiresAt = s.HoldExpiry.Unix()
+			}
+			if !s.BlockedUntil.IsZero() {
+				cell.BlockedUntil = s.BlockedUntil.Unix()
+			}
+			for _, seg := range s.SegmentStatuses {
+				cell.SegmentStatuses = append(cell.SegmentStatuses, &pb.SegmentSeatStatus{
+					SegmentIndex: int32(seg.SegmentIndex),
+					Status:       stringToProtoSeatStatus(seg.Status),
+				})
+			}
+			seats = append(seats, cell)
 		}
-		rows = append(rows, &pb.SeatRow{
+		result = append(result, &pb.SeatRow{
 			RowNumber: int32(r.RowNumber),
 			Seats:     seats,
 		})
 	}
-
-	return &pb.GetSeatMapResponse{
-		Rows:   rows,
-		Legend: &pb.SeatMapLegend{StatusColors: result.Legend},
-	}, nil
+	return result
 }
 
 func (h *GrpcHandler) InitializeTripInventory(ctx context.Context, req *pb.InitializeTripInventoryRequest) (*pb.InitializeTripInventoryResponse, error) {
@@ -171,26 +513,78 @@ func (h *GrpcHandler) InitializeTripInventory(ctx context.Context, req *pb.Initi
 	if req.SeatConfig != nil {
 		for _, s := range req.SeatConfig.Seats {
 			seats = append(seats, service.SeatDef{
-				SeatID:     s.SeatId,
-				SeatNumber: s.SeatNumber,
-				Row:        int(s.Row),
-				Column:     int(s.Column),
-				SeatType:   s.SeatType,
-				SeatClass:  s.SeatClass,
-				PricePaisa: s.PricePaisa,
+				SeatID:       s.SeatId,
+				SeatNumber:   s.SeatNumber,
+				Row:          int(s.Row),
+				Column:       int(s.Column),
+				SeatType:     s.SeatType,
+				SeatClass:    s.SeatClass,
+				PricePaisa:   s.PricePaisa,
+				SectionID:    s.SectionId,
+				Berth:        s.Berth,
+				IsAccessible: s.IsAccessible,
+				HasPower:     s.HasPower,
+			})
+		}
+	}
+
+	var vehicleType string
+	var sections []domain.LayoutSection
+	if layout := req.GetSeatConfig().GetLayout(); layout != nil {
+		vehicleType = layout.VehicleType
+		for _, sec := range layout.Sections {
+			aisles := make([]int, 0, len(sec.AisleAfterColumns))
+			for _, c := range sec.AisleAfterColumns {
+				aisles = append(aisles, int(c))
+			}
+			sections = append(sections, domain.LayoutSection{
+				SectionID:         sec.SectionId,
+				Name:              sec.Name,
+				Kind:              sec.Kind,
+				Level:             int(sec.Level),
+				Rows:              int(sec.Rows),
+				Columns:           int(sec.Columns),
+				AisleAfterColumns: aisles,
 			})
 		}
 	}
 
+	var quotas []service.QuotaDef
+	for _, q := range req.Quotas {
+		quotas = append(quotas, service.QuotaDef{
+			QuotaID:        q.QuotaId,
+			Name:           q.Name,
+			QuotaType:      q.QuotaType,
+			SeatIDs:        q.SeatIds,
+			SegmentIndexes: int32sToInts(q.SegmentIndexes),
+			PartnerIDs:     q.PartnerIds,
+			ReleaseBefore:  time.Duration(q.ReleaseMinutesBeforeDeparture) * time.Minute,
+		})
+	}
+
+	var capacity []service.CapacityDef
+	for _, c := range req.Capacity {
+		capacity = append(capacity, service.CapacityDef{
+			CapacityClass: c.CapacityClass,
+			MaxCount:      int(c.MaxCount),
+			PricePaisa:    c.PricePaisa,
+		})
+	}
+
 	res, err := h.inventoryService.InitializeTripInventory(ctx, &service.InitializeTripRequest{
 		TripID:         req.TripId,
 		OrganizationID: req.OrganizationId,
 		VehicleID:      req.VehicleId,
 		Segments:       segments,
 		SeatConfig: service.SeatConfig{
-			TotalSeats: int(req.SeatConfig.TotalSeats),
-			Seats:      seats,
+			TotalSeats:  int(req.GetSeatConfig().GetTotalSeats()),
+			Seats:       seats,
+			VehicleType: vehicleType,
+			Sections:    sections,
+			Capacity:    capacity,
 		},
+		Quotas:     quotas,
+		ScheduleID: req.ScheduleId,
 	})
 
 	if err != nil {
@@ -205,6 +599,46 @@ func (h *GrpcHandler) InitializeTripInventory(ctx context.Context, req *pb.Initi
 	}, nil
 }
 
+func quotaClaimsFromProto(c *pb.QuotaClaims) domain.QuotaClaims {
+	return domain.QuotaClaims{
+		Female:    c.GetFemale(),
+		Disabled:  c.GetDisabled(),
+		Channel:   c.GetChannel(),
+		PartnerID: c.GetPartnerId(),
+		VIP:       c.GetVip(),
+	}
+}
+
+func capacityItemsFromProto(items []*pb.CapacityItem) []domain.CapacityItem {
+	var result []domain.CapacityItem
+	for _, item := range items {
+		result = append(result, domain.CapacityItem{
+			CapacityClass: item.CapacityClass,
+			Quantity:      int(item.Quantity),
+		})
+	}
+	return result
+}
+
+func capacityItemsToProto(items []domain.CapacityItem) []*pb.CapacityItem {
+	var result []*pb.CapacityItem
+	for _, item := range items {
+		result = append(result, &pb.CapacityItem{
+			CapacityClass: item.CapacityClass,
+			Quantity:      int32(item.Quantity),
+		})
+	}
+	return result
+}
+
+func int32sToInts(values []int32) []int {
+	ints := make([]int, 0, len(values))
+	for _, v := range values {
+		ints = append(ints, int(v))
+	}
+	return ints
+}
+
 func stringToProtoSeatStatus(s string) pb.SeatStatus {
 	switch s {
 	case domain.SeatStatusAvailable:
@@ -227,8 +661,13 @@ func (h *GrpcHandler) JoinWaitlist(ctx context.Context, req *pb.JoinWaitlistRequ
 		UserID:         req.UserId,
 		SeatClass:      req.SeatClass,
 		RequestedSeats: int(req.RequestedSeats),
+		FromStation:    req.FromStationId,
+		ToStation:      req.ToStationId,
 	})
 	if err != nil {
+		if err == domain.ErrInvalidStationRange {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to join waitlist")
 	}
 
@@ -247,17 +686,228 @@ func (h *GrpcHandler) GetUserWaitlist(ctx context.Context, req *pb.GetUserWaitli
 
 	var pbEntries []*pb.WaitlistEntry
 	for _, e := range entries {
-		pbEntries = append(pbEntries, &pb.WaitlistEntry{
+		entry := &pb.WaitlistEntry{
 			TripId:         e.TripID,
 			OrganizationId: e.OrganizationID,
 			SeatClass:      e.SeatClass,
 			RequestedSeats: int32(e.RequestedSeats),
 			Status:         e.Status,
 			CreatedAt:      e.CreatedAt.Format(time.RFC3339),
-		})
+			FromStationId:  e.FromStationID,
+			ToStationId:    e.ToStationID,
+			Position:       int32(e.Position),
+		}
+		if e.Status == domain.WaitlistStatusNotified {
+			entry.HoldId = e.HoldID
+			entry.OfferExpiresAt = e.OfferExpiresAt.Unix()
+		}
+		pbEntries = append(pbEntries, entry)
 	}
 
 	return &pb.GetUserWaitlistResponse{
 		Entries: pbEntries,
 	}, nil
 }
+
+func (h *GrpcHandler) RespondWaitlistOffer(ctx context.Context, req *pb.RespondWaitlistOfferRequest) (*pb.RespondWaitlistOfferResponse, error) {
+	result, err := h.inventoryService.RespondWaitlistOffer(ctx, req.OrganizationId, req.TripId, req.UserId, req.Accept)
+	if err != nil {
+		if err == domain.ErrNoWaitlistOffer {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to respond to waitlist offer")
+	}
+
+	resp := &pb.RespondWaitlistOfferResponse{
+		Success: result.Success,
+		HoldId:  result.HoldID,
+		Message: result.Message,
+	}
+	if !result.ExpiresAt.IsZero() {
+		resp.ExpiresAt = result.ExpiresAt.Unix()
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) GetTripManifest(ctx context.Context, req *pb.GetTripManifestRequest) (*pb.GetTripManifestResponse, error) {
+	manifest, err := h.inventoryService.GetTripManifest(ctx, req.OrganizationId, req.TripId)
+	if err != nil {
+		logger.Error("Failed to build trip manifest", "error", err, "trip_id", req.TripId)
+		return nil, status.Error(codes.Internal, "failed to get manifest")
+	}
+
+	var passengers []*pb.ManifestPassenger
+	for _, p := range manifest {
+		var legs []*pb.ManifestLeg
+		for _, leg := range p.Legs {
+			legs = append(legs, &pb.ManifestLeg{
+				SeatId:        leg.SeatID,
+				SeatNumber:    leg.SeatNumber,
+				FromStationId: leg.FromStationID,
+				ToStationId:   leg.ToStationID,
+				TicketId:      leg.TicketID,
+			})
+		}
+		passengers = append(passengers, &pb.ManifestPassenger{
+			BookingId:            p.BookingID,
+			PassengerName:        p.PassengerName,
+			PassengerNid:         p.PassengerNID,
+			FromStationId:        p.FromStationID,
+			ToStationId:          p.ToStationID,
+			Legs:                 legs,
+			SeatChangeStationIds: p.SeatChangeStationIDs,
+			CapacityClass:        p.CapacityClass,
+		})
+	}
+
+	return &pb.GetTripManifestResponse{
+		TripId:     req.TripId,
+		Passengers: passengers,
+	}, nil
+}
+
+func (h *GrpcHandler) GetReaccommodations(ctx context.Context, req *pb.GetReaccommodationsRequest) (*pb.GetReaccommodationsResponse, error) {
+	entries, err := h.inventoryService.GetReaccommodations(ctx, req.OrganizationId, req.TripId, req.UnplacedOnly)
+	if err != nil {
+		logger.Error("Failed to list re-accommodations", "error", err, "trip_id", req.TripId)
+		return nil, status.Error(codes.Internal, "failed to get re-accommodations")
+	}
+
+	var out []*pb.Reaccommodation
+	for _, e := range entries {
+		out = append(out, &pb.Reaccommodation{
+			ReferenceId:    e.ReferenceID,
+			ReferenceType:  e.ReferenceType,
+			OrderId:        e.OrderID,
+			PassengerIndex: int32(e.PassengerIndex),
+			PassengerName:  e.PassengerName,
+			PassengerNid:   e.PassengerNID,
+			SeatClass:      e.SeatClass,
+			OldSeatId:      e.OldSeatID,
+			OldSeatNumber:  e.OldSeatNumber,
+			NewSeatId:      e.NewSeatID,
+			NewSeatNumber:  e.NewSeatNumber,
+			OldTicketId:    e.OldTicketID,
+			NewTicketId:    e.NewTicketID,
+			Status:         e.Status,
+			OldVehicleId:   e.OldVehicleID,
+			NewVehicleId:   e.NewVehicleID,
+			CreatedAt:      e.CreatedAt.Unix(),
+		})
+	}
+
+	return &pb.GetReaccommodationsResponse{
+		TripId:  req.TripId,
+		Entries: out,
+	}, nil
+}
+
+func (h *GrpcHandler) GetSeatLedger(ctx context.Context, req *pb.GetSeatLedgerRequest) (*pb.GetSeatLedgerResponse, error) {
+	entries, err := h.inventoryService.GetSeatLedger(ctx, req.OrganizationId, req.TripId, req.SeatId)
+	if err != nil {
+		logger.Error("Failed to list seat ledger", "error", err, "trip_id", req.TripId)
+		return nil, status.Error(codes.Internal, "failed to get seat ledger")
+	}
+
+	var out []*pb.SeatTransition
+	for _, e := range entries {
+		out = append(out, &pb.SeatTransition{
+			SegmentIndex: int32(e.SegmentIndex),
+			SeatId:       e.SeatID,
+			FromStatus:   e.FromStatus,
+			ToStatus:     e.ToStatus,
+			Actor:        e.Actor,
+			HoldId:       e.HoldID,
+			BookingId:    e.BookingID,
+			Reason:       e.Reason,
+			RecordedAt:   e.RecordedAt.Unix(),
+		})
+	}
+
+	return &pb.GetSeatLedgerResponse{
+		TripId:  req.TripId,
+		Entries: out,
+	}, nil
+}
+
+func (h *GrpcHandler) CreateReleaseTranches(ctx context.Context, req *pb.CreateReleaseTranchesRequest) (*pb.CreateReleaseTranchesResponse, error) {
+	tranches := make([]domain.ReleaseTranche, 0, len(req.Tranches))
+	for _, t := range req.Tranches {
+		tranche := domain.ReleaseTranche{
+			Name:                   t.Name,
+			Percentage:             int(t.Percentage),
+			SeatIDs:                t.SeatIds,
+			SeatNumbers:            t.SeatNumbers,
+			ReleaseBeforeDeparture: time.Duration(t.ReleaseHoursBeforeDeparture) * time.Hour,
+		}
+		if t.ReleaseAt > 0 {
+			tranche.ReleaseAt = time.Unix(t.ReleaseAt, 0)
+		}
+		tranches = append(tranches, tranche)
+	}
+
+	created, err := h.inventoryService.CreateReleaseTranches(ctx, req.OrganizationId, req.TripId, req.ScheduleId, tranches, req.CreatedBy)
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidTranche, domain.ErrTranchesOverAllocated, domain.ErrBlockTargetRequired:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrTripInventoryNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		logger.Error("Failed to create release tranches", "error", err, "trip_id", req.TripId, "schedule_id", req.ScheduleId)
+		return nil, status.Error(codes.Internal, "failed to create release tranches")
+	}
+	return &pb.CreateReleaseTranchesResponse{Tranches: releaseTranchesToProto(created)}, nil
+}
+
+func (h *GrpcHandler) ListReleaseTranches(ctx context.Context, req *pb.ListReleaseTranchesRequest) (*pb.ListReleaseTranchesResponse, error) {
+	tranches, err := h.inventoryService.ListReleaseTranches(ctx, req.OrganizationId, req.TripId, req.ScheduleId)
+	if err != nil {
+		if err == domain.ErrBlockTargetRequired {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		logger.Error("Failed to list release tranches", "error", err, "trip_id", req.TripId, "schedule_id", req.ScheduleId)
+		return nil, status.Error(codes.Internal, "failed to list release tranches")
+	}
+	return &pb.ListReleaseTranchesResponse{Tranches: releaseTranchesToProto(tranches)}, nil
+}
+
+func (h *GrpcHandler) ReleaseTranche(ctx context.Context, req *pb.ReleaseTrancheRequest) (*pb.ReleaseTrancheResponse, error) {
+	released, err := h.inventoryService.ReleaseTranche(ctx, req.OrganizationId, req.TripId, req.ScheduleId, req.TrancheId, req.ReleasedBy)
+	if err != nil {
+		switch err {
+		case domain.ErrBlockTargetRequired:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrTrancheNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		logger.Error("Failed to release tranche", "error", err, "trip_id", req.TripId, "tranche_id", req.TrancheId)
+		return nil, status.Error(codes.Internal, "failed to release tranche")
+	}
+	return &pb.ReleaseTrancheResponse{ReleasedCount: int32(released)}, nil
+}
+
+func releaseTranchesToProto(tranches []domain.ReleaseTranche) []*pb.ReleaseTranche {
+	out := make([]*pb.ReleaseTranche, 0, len(tranches))
+	for _, t := range tranches {
+		pt := &pb.ReleaseTranche{
+			TrancheId:                   t.TrancheID,
+			Name:                        t.Name,
+			Percentage:                  int32(t.Percentage),
+			SeatIds:                     t.SeatIDs,
+			SeatNumbers:                 t.SeatNumbers,
+			ReleaseHoursBeforeDeparture: int32(t.ReleaseBeforeDeparture.Hours()),
+			Status:                      t.Status,
+			TripId:                      t.TripID,
+			ScheduleId:                  t.ScheduleID,
+		}
+		if !t.ReleaseAt.IsZero() {
+			pt.ReleaseAt = t.ReleaseAt.Unix()
+		}
+		if !t.ReleasedAt.IsZero() {
+			pt.ReleasedAt = t.ReleasedAt.Unix()
+		}
+		out = append(out, pt)
+	}
+	return out
+}
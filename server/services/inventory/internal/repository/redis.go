@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
@@ -43,6 +45,40 @@ func (r *RedisRepository) ReleaseSeatLock(ctx context.Context, orgID, tripID, se
 	return r.client.Eval(ctx, script, []string{key}, userID).Err()
 }
 
+// ListSeatLocks returns the seat locks held on a trip with their remaining TTL.
+// A lock without a TTL (-1) will never expire on its own.
+func (r *RedisRepository) ListSeatLocks(ctx context.Context, orgID, tripID string) ([]domain.SeatLock, error) {
+	prefix := fmt.Sprintf("inventory:lock:%s:%s:", orgID, tripID)
+
+	var locks []domain.SeatLock
+	iter := r.client.Scan(ctx, 0, prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+		segment, seatID, ok := strings.Cut(strings.TrimPrefix(key, prefix), ":")
+		if !ok {
+			continue
+		}
+		segmentIndex, err := strconv.Atoi(segment)
+		if err != nil {
+			continue
+		}
+		ttl, err := r.client.TTL(ctx, key).Result()
+		if err != nil {
+			return nil, err
+		}
+		locks = append(locks, domain.SeatLock{Key: key, SegmentIndex: segmentIndex, SeatID: seatID, TTL: ttl})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return locks, nil
+}
+
+// DeleteSeatLock removes a seat lock by key, whoever holds it
+func (r *RedisRepository) DeleteSeatLock(ctx context.Context, key string) error {
+	return r.client.Del(ctx, key).Err()
+}
+
 // CacheSeatMap caches the entire seat availability for a trip (short TTL)
 // Maps: segment_index -> []SeatInventory
 func (r *RedisRepository) CacheSeatMap(ctx context.Context, orgID, tripID string, seats []domain.SeatInventory, ttl time.Duration) error {
@@ -77,3 +113,134 @@ func (r *RedisRepository) InvalidateSeatMap(ctx context.Context, orgID, tripID s
 	key := fmt.Sprintf("inventory:cache:seatmap:%s:%s", orgID, tripID)
 	return r.client.Del(ctx, key).Err()
 }
+
+// AcquireWaitlistLock serializes waitlist processing for a trip across instances
+func (r *RedisRepository) AcquireWaitlistLock(ctx context.Context, orgID, tripID, owner string, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf("inventory:lock:waitlist:%s:%s", orgID, tripID)
+	return r.client.SetNX(ctx, key, owner, ttl).Result()
+}
+
+// ReleaseWaitlistLock releases the waitlist lock only if it belongs to the owner
+func (r *RedisRepository) ReleaseWaitlistLock(ctx context.Context, orgID, tripID, owner string) error {
+	script := `
+		if redis.call("get", KEYS[1]) == ARGV[1] then
+			return redis.call("del", KEYS[1])
+		else
+			return 0
+		end
+	`
+	key := fmt.Sprintf("inventory:lock:waitlist:%s:%s", orgID, tripID)
+	return r.client.Eval(ctx, script, []string{key}, owner).Err()
+}
+
+// AcquireHoldSweepLock serializes hold-expiry sweeps of a trip across instances
+func (r *RedisRepository) AcquireHoldSweepLock(ctx context.Context, orgID, tripID, owner string, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf("inventory:lock:holdsweep:%s:%s", orgID, tripID)
+	return r.client.SetNX(ctx, key, owner, ttl).Result()
+}
+
+// ReleaseHoldSweepLock releases the hold sweep lock only if it belongs to the owner
+func (r *RedisRepository) ReleaseHoldSweepLock(ctx context.Context, orgID, tripID, owner string) error {
+	script := `
+		if redis.call("get", KEYS[1]) == ARGV[1] then
+			return redis.call("del", KEYS[1])
+		else
+			return 0
+		end
+	`
+	key := fmt.Sprintf("inventory:lock:holdsweep:%s:%s", orgID, tripID)
+	return r.client.Eval(ctx, script, []string{key}, owner).Err()
+}
+
+// TrackWaitlistTrip records that a trip has an active waitlist so background workers can find it
+func (r *RedisRepository) TrackWaitlistTrip(ctx context.Context, orgID, tripID string) error {
+	return r.client.SAdd(ctx, "inventory:waitlist:trips", orgID+":"+tripID).Err()
+}
+
+// UntrackWaitlistTrip removes a trip whose waitlist has been drained
+func (r *RedisRepository) UntrackWaitlistTrip(ctx context.Context, orgID, tripID string) error {
+	return r.client.SRem(ctx, "inventory:waitlist:trips", orgID+":"+tripID).Err()
+}
+
+// ListWaitlistTrips returns the trips with an active waitlist
+func (r *RedisRepository) ListWaitlistTrips(ctx context.Context) ([]domain.TripRef, error) {
+	return r.listTrackedTrips(ctx, "inventory:waitlist:trips")
+}
+
+// TrackQuotaTrip records that a trip has quotas awaiting automatic release
+func (r *RedisRepository) TrackQuotaTrip(ctx context.Context, orgID, tripID string) error {
+	return r.client.SAdd(ctx, "inventory:quota:trips", orgID+":"+tripID).Err()
+}
+
+// UntrackQuotaTrip removes a trip whose quotas have all been released
+func (r *RedisRepository) UntrackQuotaTrip(ctx context.Context, orgID, tripID string) error {
+	return r.client.SRem(ctx, "inventory:quota:trips", orgID+":"+tripID).Err()
+}
+
+// ListQuotaTrips returns the trips with quotas awaiting automatic release
+func (r *RedisRepository) ListQuotaTrips(ctx context.Context) ([]domain.TripRef, error) {
+	return r.listTrackedTrips(ctx, "inventory:quota:trips")
+}
+
+// TrackHoldTrip records that a trip has seat holds the expiry sweeper must watch
+func (r *RedisRepository) TrackHoldTrip(ctx context.Context, orgID, tripID string) error {
+	return r.client.SAdd(ctx, "inventory:hold:trips", orgID+":"+tripID).Err()
+}
+
+// UntrackHoldTrip removes a trip with no seats left in held state
+func (r *RedisRepository) UntrackHoldTrip(ctx context.Context, orgID, tripID string) error {
+	return r.client.SRem(ctx, "inventory:hold:trips", orgID+":"+tripID).Err()
+}
+
+// ListHoldTrips returns the trips with seat holds that may need sweeping
+func (r *RedisRepository) ListHoldTrips(ctx context.Context) ([]domain.TripRef, error) {
+	return r.listTrackedTrips(ctx, "inventory:hold:trips")
+}
+
+// TrackBlockTrip records that a trip has seat blocks with an auto-unblock time
+func (r *RedisRepository) TrackBlockTrip(ctx context.Context, orgID, tripID string) error {
+	return r.client.SAdd(ctx, "inventory:block:trips", orgID+":"+tripID).Err()
+}
+
+// UntrackBlockTrip removes a trip with no timed seat blocks left
+func (r *RedisRepository) UntrackBlockTrip(ctx context.Context, orgID, tripID string) error {
+	return r.client.SRem(ctx, "inventory:block:trips", orgID+":"+tripID).Err()
+}
+
+// ListBlockTrips returns the trips with seat blocks awaiting automatic unblock
+func (r *RedisRepository) ListBlockTrips(ctx context.Context) ([]domain.TripRef, error) {
+	return r.listTrackedTrips(ctx, "inventory:block:trips")
+}
+
+// TrackTrancheTrip records that a trip has release tranches awaiting their release time
+func (r *RedisRepository) TrackTrancheTrip(ctx context.Context, orgID, tripID string) error {
+	return r.client.SAdd(ctx, "inventory:tranche:trips", orgID+":"+tripID).Err()
+}
+
+// UntrackTrancheTrip removes a trip with no timed release tranches left
+func (r *RedisRepository) UntrackTrancheTrip(ctx context.Context, orgID, tripID string) error {
+	return r.client.SRem(ctx, "inventory:tranche:trips", orgID+":"+tripID).Err()
+}
+
+// ListTrancheTrips returns the trips with release tranches awaiting their release time
+func (r *RedisRepository) ListTrancheTrips(ctx context.Context) ([]domain.TripRef, error) {
+	return r.listTrackedTrips(ctx, "inventory:tranche:trips")
+}
+
+// listTrackedTrips decodes a set of "org:trip" members
+func (r *RedisRepository) listTrackedTrips(ctx context.Context, key string) ([]domain.TripRef, error) {
+	members, err := r.client.SMembers(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	trips := make([]domain.TripRef, 0, len(members))
+	for _, m := range members {
+		orgID, tripID, ok := strings.Cut(m, ":")
+		if !ok {
+			continue
+		}
+		trips = append(trips, domain.TripRef{OrganizationID: orgID, TripID: tripID})
+	}
+	return trips, nil
+}
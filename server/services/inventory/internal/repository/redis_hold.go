@@ -11,6 +11,10 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// HoldRecordRetention keeps hold records readable past their expiry so the
+// expiry sweeper can still mark them expired
+const HoldRecordRetention = 30 * time.Minute
+
 // HoldRepository manages seat holds in Redis for fast TTL-based expiration
 type HoldRepository struct {
 	client *redis.Client
@@ -29,7 +33,7 @@ func (r *HoldRepository) CreateHold(ctx context.Context, orgID string, hold *dom
 
 	// Key by hold_id
 	holdKey := fmt.Sprintf("hold:%s:%s", orgID, hold.HoldID)
-	ttl := time.Until(hold.ExpiresAt)
+	ttl := time.Until(hold.ExpiresAt) + HoldRecordRetention
 
 	pipe := r.client.Pipeline()
 
@@ -82,11 +86,80 @@ func (r *HoldRepository) UpdateHoldStatus(ctx context.Context, orgID, holdID, st
 	}
 
 	hold.Status = status
-	data, _ := json.Marshal(hold)
+	return r.saveHold(ctx, orgID, hold)
+}
+
+// ExtendHold records a new expiry for an active hold and counts the extension
+func (r *HoldRepository) ExtendHold(ctx context.Context, orgID, holdID string, expiresAt time.Time) (*domain.SeatHold, error) {
+	hold, err := r.GetHold(ctx, orgID, holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	hold.ExpiresAt = expiresAt
+	hold.ExtensionCount++
+	if err := r.saveHold(ctx, orgID, hold); err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// SetHoldExpiry moves a hold's expiry without counting it as an extension
+func (r *HoldRepository) SetHoldExpiry(ctx context.Context, orgID, holdID string, expiresAt time.Time) (*domain.SeatHold, error) {
+	hold, err := r.GetHold(ctx, orgID, holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	hold.ExpiresAt = expiresAt
+	if err := r.saveHold(ctx, orgID, hold); err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
 
+// ReplaceHoldSeats moves a hold onto other seats, keeping its expiry and status
+func (r *HoldRepository) ReplaceHoldSeats(ctx context.Context, orgID, holdID string, seatIDs []string, legs []domain.SeatLeg) error {
+	hold, err := r.GetHold(ctx, orgID, holdID)
+	if err != nil {
+		return err
+	}
+
+	hold.SeatIDs = seatIDs
+	hold.Legs = legs
+	return r.saveHold(ctx, orgID, hold)
+}
+
+// MarkHoldExpired flags a lapsed hold record as expired.
+// Holds that were already converted or released keep their status.
+func (r *HoldRepository) MarkHoldExpired(ctx context.Context, orgID, holdID string) error {
 	key := fmt.Sprintf("hold:%s:%s", orgID, holdID)
-	ttl := time.Until(hold.ExpiresAt)
-	if ttl < 0 {
+	data, err := r.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil // Record already gone, nothing to mark
+		}
+		return err
+	}
+
+	var hold domain.SeatHold
+	if err := json.Unmarshal(data, &hold); err != nil {
+		return err
+	}
+	if hold.Status != domain.HoldStatusActive {
+		return nil
+	}
+
+	hold.Status = domain.HoldStatusExpired
+	return r.saveHold(ctx, orgID, &hold)
+}
+
+func (r *HoldRepository) saveHold(ctx context.Context, orgID string, hold *domain.SeatHold) error {
+	data, _ := json.Marshal(hold)
+
+	key := fmt.Sprintf("hold:%s:%s", orgID, hold.HoldID)
+	ttl := time.Until(hold.ExpiresAt) + HoldRecordRetention
+	if ttl < time.Minute {
 		ttl = time.Minute // Grace period for cleanup
 	}
 
@@ -129,6 +202,33 @@ func (r *HoldRepository) GetUserHolds(ctx context.Context, orgID, userID string)
 	return holds, nil
 }
 
+// ListTripHolds returns the hold records indexed under a trip, whatever their status.
+// Records past their retention are gone and not returned.
+func (r *HoldRepository) ListTripHolds(ctx context.Context, orgID, tripID string) ([]*domain.SeatHold, error) {
+	key := fmt.Sprintf("trip_holds:%s:%s", orgID, tripID)
+	holdIDs, err := r.client.SMembers(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var holds []*domain.SeatHold
+	for _, holdID := range holdIDs {
+		data, err := r.client.Get(ctx, fmt.Sprintf("hold:%s:%s", orgID, holdID)).Bytes()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+			return nil, err
+		}
+		var hold domain.SeatHold
+		if err := json.Unmarshal(data, &hold); err != nil {
+			continue
+		}
+		holds = append(holds, &hold)
+	}
+	return holds, nil
+}
+
 // CountUserActiveHolds returns the number of active holds for a user
 func (r *HoldRepository) CountUserActiveHolds(ctx context.Context, orgID, userID string) (int, error) {
 	holds, err := r.GetUserHolds(ctx, orgID, userID)
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
@@ -63,6 +64,10 @@ func (r *ScyllaRepository) InitSchema(ctx context.Context, keyspace string) erro
 			requested_seats int,
 			created_at timestamp,
 			status text,
+			from_station_id text,
+			to_station_id text,
+			hold_id text,
+			offer_expires_at timestamp,
 			PRIMARY KEY ((organization_id, trip_id), created_at, user_id)
 		) WITH CLUSTERING ORDER BY (created_at ASC)
 		  AND compaction = {'class': 'TimeWindowCompactionStrategy', 'compaction_window_unit': 'DAYS', 'compaction_window_size': 1}`,
@@ -75,6 +80,172 @@ func (r *ScyllaRepository) InitSchema(ctx context.Context, keyspace string) erro
 			  AND created_at IS NOT NULL 
 			  AND user_id IS NOT NULL
 			PRIMARY KEY ((user_id), organization_id, trip_id, created_at)`,
+
+		`CREATE TABLE IF NOT EXISTS bookings (
+			booking_id text PRIMARY KEY,
+			organization_id text,
+			order_id text,
+			trip_id text,
+			user_id text,
+			from_station_id text,
+			to_station_id text,
+			segment_range list<int>,
+			seats list<frozen<map<text, text>>>,
+			total_paisa bigint,
+			status text,
+			created_at timestamp,
+			updated_at timestamp
+		)`,
+		`CREATE INDEX IF NOT EXISTS bookings_by_trip ON bookings (trip_id)`,
+		`CREATE INDEX IF NOT EXISTS bookings_by_order ON bookings (order_id)`,
+
+		// 004_seat_geometry.cql
+		`CREATE TABLE IF NOT EXISTS trip_layouts (
+			organization_id text,
+			trip_id text,
+			vehicle_id text,
+			vehicle_type text,
+			layout text,
+			created_at timestamp,
+			PRIMARY KEY ((organization_id, trip_id))
+		)`,
+
+		// 005_seat_quotas.cql
+		`CREATE TABLE IF NOT EXISTS trip_quotas (
+			organization_id text,
+			trip_id text,
+			quota_id text,
+			name text,
+			quota_type text,
+			seat_ids list<text>,
+			segment_indexes list<int>,
+			partner_ids list<text>,
+			release_before_minutes int,
+			released boolean,
+			released_at timestamp,
+			created_at timestamp,
+			PRIMARY KEY ((organization_id, trip_id), quota_id)
+		)`,
+
+		// 006_hold_policies.cql
+		`CREATE TABLE IF NOT EXISTS hold_policies (
+			organization_id text,
+			max_hold_lifetime_seconds int,
+			max_extensions int,
+			extension_seconds int,
+			updated_at timestamp,
+			PRIMARY KEY (organization_id)
+		)`,
+
+		// 007_seat_blocks.cql
+		`CREATE TABLE IF NOT EXISTS schedule_trips (
+			organization_id text,
+			schedule_id text,
+			trip_id text,
+			created_at timestamp,
+			PRIMARY KEY ((organization_id, schedule_id), trip_id)
+		)`,
+		`CREATE TABLE IF NOT EXISTS schedule_seat_blocks (
+			organization_id text,
+			schedule_id text,
+			block_id text,
+			seat_ids list<text>,
+			seat_numbers list<text>,
+			segment_indexes list<int>,
+			reason text,
+			blocked_by text,
+			unblock_at timestamp,
+			created_at timestamp,
+			PRIMARY KEY ((organization_id, schedule_id), block_id)
+		)`,
+
+		// 008_capacity_inventory.cql
+		`CREATE TABLE IF NOT EXISTS capacity_inventory (
+			organization_id text,
+			trip_id text,
+			segment_index int,
+			capacity_class text,
+			max_count int,
+			held_count int,
+			booked_count int,
+			price_paisa bigint,
+			updated_at timestamp,
+			PRIMARY KEY ((organization_id, trip_id, segment_index), capacity_class)
+		)`,
+		`CREATE TABLE IF NOT EXISTS capacity_holds (
+			organization_id text,
+			trip_id text,
+			hold_id text,
+			capacity_class text,
+			quantity int,
+			segment_indexes list<int>,
+			status text,
+			hold_expiry timestamp,
+			booking_id text,
+			updated_at timestamp,
+			PRIMARY KEY ((organization_id, trip_id), hold_id, capacity_class)
+		)`,
+
+		// 009_reaccommodations.cql
+		`CREATE TABLE IF NOT EXISTS trip_reaccommodations (
+			organization_id text,
+			trip_id text,
+			reference_id text,
+			old_seat_id text,
+			passenger_index int,
+			reference_type text,
+			order_id text,
+			user_id text,
+			passenger_name text,
+			passenger_nid text,
+			seat_class text,
+			old_seat_number text,
+			new_seat_id text,
+			new_seat_number text,
+			old_ticket_id text,
+			new_ticket_id text,
+			status text,
+			old_vehicle_id text,
+			new_vehicle_id text,
+			created_at timestamp,
+			PRIMARY KEY ((organization_id, trip_id), reference_id, old_seat_id, passenger_index)
+		)`,
+
+		// 010_seat_ledger.cql
+		`CREATE TABLE IF NOT EXISTS seat_ledger (
+			organization_id text,
+			trip_id text,
+			entry_id timeuuid,
+			segment_index int,
+			seat_id text,
+			from_status text,
+			to_status text,
+			actor text,
+			hold_id text,
+			booking_id text,
+			reason text,
+			PRIMARY KEY ((organization_id, trip_id), entry_id, segment_index, seat_id)
+		) WITH CLUSTERING ORDER BY (entry_id ASC, segment_index ASC, seat_id ASC)`,
+
+		// 011_release_tranches.cql
+		`CREATE TABLE IF NOT EXISTS release_tranches (
+			organization_id text,
+			scope_id text,
+			tranche_id text,
+			scope_type text,
+			schedule_id text,
+			name text,
+			percentage int,
+			seat_ids list<text>,
+			seat_numbers list<text>,
+			release_at timestamp,
+			release_before_departure_minutes int,
+			status text,
+			released_at timestamp,
+			created_by text,
+			created_at timestamp,
+			PRIMARY KEY ((organization_id, scope_id), tranche_id)
+		)`,
 	}
 
 	for _, query := range queries {
@@ -82,9 +253,46 @@ func (r *ScyllaRepository) InitSchema(ctx context.Context, keyspace string) erro
 			return fmt.Errorf("schema init failed: %w query: %s", err, query)
 		}
 	}
+
+	// Column additions for tables created by earlier versions.
+	// Scylla has no ADD COLUMN IF NOT EXISTS, so "already exists" errors are expected.
+	alterations := []string{
+		// 003_waitlist_offers.cql
+		`ALTER TABLE waitlist ADD from_station_id text`,
+		`ALTER TABLE waitlist ADD to_station_id text`,
+		`ALTER TABLE waitlist ADD hold_id text`,
+		`ALTER TABLE waitlist ADD offer_expires_at timestamp`,
+
+		// 004_seat_geometry.cql
+		`ALTER TABLE seat_inventory ADD row_number int`,
+		`ALTER TABLE seat_inventory ADD column_number int`,
+		`ALTER TABLE seat_inventory ADD section_id text`,
+		`ALTER TABLE seat_inventory ADD berth text`,
+		`ALTER TABLE seat_inventory ADD is_accessible boolean`,
+		`ALTER TABLE seat_inventory ADD has_power boolean`,
+
+		// 007_seat_blocks.cql
+		`ALTER TABLE seat_inventory ADD block_reason text`,
+		`ALTER TABLE seat_inventory ADD blocked_by text`,
+		`ALTER TABLE seat_inventory ADD block_until timestamp`,
+
+		// 011_release_tranches.cql
+		`ALTER TABLE seat_inventory ADD tranche_id text`,
+	}
+
+	for _, query := range alterations {
+		if err := r.session.Query(query).WithContext(ctx).Exec(); err != nil && !isColumnExistsError(err) {
+			return fmt.Errorf("schema alter failed: %w query: %s", err, query)
+		}
+	}
 	return nil
 }
 
+func isColumnExistsError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "conflicts with an existing column") || strings.Contains(msg, "already exists")
+}
+
 // InitializeTrip creates all segment-seat records for a new trip
 func (r *ScyllaRepository) InitializeTrip(ctx context.Context, orgID, tripID string, segments []domain.Segment, seats []domain.SeatInventory) error {
 	batch := r.session.NewBatch(gocql.LoggedBatch)
@@ -100,10 +308,11 @@ func (r *ScyllaRepository) InitializeTrip(ctx context.Context, orgID, tripID str
 	for _, seg := range segments {
 		for _, seat := range seats {
 			batch.Query(`INSERT INTO seat_inventory (organization_id, trip_id, segment_index, seat_id, seat_number, seat_class, 
-						 seat_type, status, price_paisa, updated_at) 
-						 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+						 seat_type, status, price_paisa, row_number, column_number, section_id, berth, is_accessible, has_power, updated_at) 
+						 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				orgID, tripID, seg.SegmentIndex, seat.SeatID, seat.SeatNumber, seat.SeatClass,
-				seat.SeatType, domain.SeatStatusAvailable, seat.PricePaisa, time.Now())
+				seat.SeatType, domain.SeatStatusAvailable, seat.PricePaisa, seat.Row, seat.Column, seat.SectionID,
+				seat.Berth, seat.IsAccessible, seat.HasPower, time.Now())
 		}
 	}
 
@@ -115,7 +324,9 @@ func (r *ScyllaRepository) GetSeatAvailability(ctx context.Context, orgID, tripI
 	// Build query for multiple segments
 	// Using IN clause for segment indices (efficient in Scylla with partition key)
 	query := `SELECT trip_id, segment_index, seat_id, seat_number, seat_class, seat_type, 
-			  status, hold_id, hold_user_id, hold_expiry, booking_id, price_paisa, updated_at 
+			  status, hold_id, hold_user_id, hold_expiry, booking_id, price_paisa,
+			  row_number, column_number, section_id, berth, is_accessible, has_power,
+			  block_reason, blocked_by, block_until, tranche_id, updated_at
 			  FROM seat_inventory 
 			  WHERE organization_id = ? AND trip_id = ? AND segment_index IN ?`
 
@@ -127,7 +338,9 @@ func (r *ScyllaRepository) GetSeatAvailability(ctx context.Context, orgID, tripI
 	for iter.Scan(
 		&seat.TripID, &seat.SegmentIndex, &seat.SeatID, &seat.SeatNumber, &seat.SeatClass,
 		&seat.SeatType, &seat.Status, &seat.HoldID, &seat.HoldUserID, &seat.HoldExpiry,
-		&seat.BookingID, &seat.PricePaisa, &seat.UpdatedAt,
+		&seat.BookingID, &seat.PricePaisa,
+		&seat.Row, &seat.Column, &seat.SectionID, &seat.Berth, &seat.IsAccessible, &seat.HasPower,
+		&seat.BlockReason, &seat.BlockedBy, &seat.BlockedUntil, &seat.TrancheID, &seat.UpdatedAt,
 	) {
 		seat.OrganizationID = orgID
 		seats = append(seats, seat)
@@ -181,16 +394,19 @@ func (r *ScyllaRepository) CheckSeatsAvailableForSegments(ctx context.Context, o
 	return len(unavailable) == 0, unavailable, nil
 }
 
-// ReleaseExpiredHolds clears expired holds for specific seats and segments
-func (r *ScyllaRepository) ReleaseExpiredHolds(ctx context.Context, orgID, tripID string, seatIDs []string, segmentIndices []int) error {
+// ReleaseExpiredHolds clears expired holds for specific seats and segments.
+// Each segment is a partition released in its own conditional batch: all of the seats or,
+// if any was re-held, extended or booked meanwhile, none. Returns the segments released.
+func (r *ScyllaRepository) ReleaseExpiredHolds(ctx context.Context, orgID, tripID string, seatIDs []string, segmentIndices []int) ([]int, error) {
 	if len(seatIDs) == 0 || len(segmentIndices) == 0 {
-		return nil
+		return nil, nil
 	}
 
-	batch := r.session.NewBatch(gocql.LoggedBatch)
 	now := time.Now()
+	var released []int
 
 	for _, segIdx := range segmentIndices {
+		batch := r.session.NewBatch(gocql.LoggedBatch)
 		for _, seatID := range seatIDs {
 			batch.Query(`UPDATE seat_inventory
 						 SET status = ?, hold_id = '', hold_user_id = '', hold_expiry = ?, updated_at = ?
@@ -200,9 +416,17 @@ func (r *ScyllaRepository) ReleaseExpiredHolds(ctx context.Context, orgID, tripI
 				orgID, tripID, segIdx, seatID,
 				domain.SeatStatusHeld, now)
 		}
+
+		applied, _, err := r.session.ExecuteBatchCAS(batch, make(map[string]interface{}))
+		if err != nil {
+			return released, err
+		}
+		if applied {
+			released = append(released, segIdx)
+		}
 	}
 
-	return r.session.ExecuteBatch(batch)
+	return released, nil
 }
 
 // HoldSeats marks seats as held across all required segments atomically
@@ -260,6 +484,34 @@ func (r *ScyllaRepository) ReleaseHold(ctx context.Context, orgID, tripID, holdI
 	return r.session.ExecuteBatch(batch)
 }
 
+// ExtendHold moves the expiry of seats still held under holdID.
+// Fails with ErrHoldExpired when any segment has lapsed or changed hands.
+func (r *ScyllaRepository) ExtendHold(ctx context.Context, orgID, tripID, holdID string, segmentIndices []int, seatIDs []string, expiry time.Time) error {
+	now := time.Now()
+	for _, segIdx := range segmentIndices {
+		batch := r.session.NewBatch(gocql.LoggedBatch)
+		for _, seatID := range seatIDs {
+			batch.Query(`UPDATE seat_inventory
+						 SET hold_expiry = ?, updated_at = ?
+						 WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND seat_id = ?
+						 IF hold_id = ? AND status = ? AND hold_expiry > ?`,
+				expiry, now,
+				orgID, tripID, segIdx, seatID,
+				holdID, domain.SeatStatusHeld, now)
+		}
+
+		applied, _, err := r.session.ExecuteBatchCAS(batch, make(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		if !applied {
+			return domain.ErrHoldExpired
+		}
+	}
+
+	return nil
+}
+
 // ConfirmBooking converts held seats to booked status
 func (r *ScyllaRepository) ConfirmBooking(ctx context.Context, orgID, tripID, holdID, bookingID string, segmentIndices []int, seatIDs []string) error {
 	// Group by Segment (Partition)
@@ -376,21 +628,44 @@ func (r *ScyllaRepository) CountAvailableSeats(ctx context.Context, orgID, tripI
 
 // AddToWaitlist adds a user to the waitlist
 func (r *ScyllaRepository) AddToWaitlist(ctx context.Context, entry domain.WaitlistEntry) error {
-	query := `INSERT INTO waitlist (organization_id, trip_id, user_id, seat_class, requested_seats, created_at, status)
-	          VALUES (?, ?, ?, ?, ?, ?, ?)`
-	return r.session.Query(query, entry.OrganizationID, entry.TripID, entry.UserID, entry.SeatClass, entry.RequestedSeats, entry.CreatedAt, entry.Status).WithContext(ctx).Exec()
+	query := `INSERT INTO waitlist (organization_id, trip_id, user_id, seat_class, requested_seats, from_station_id, to_station_id, created_at, status)
+	          VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	return r.session.Query(query, entry.OrganizationID, entry.TripID, entry.UserID, entry.SeatClass, entry.RequestedSeats,
+		entry.FromStationID, entry.ToStationID, entry.CreatedAt, entry.Status).WithContext(ctx).Exec()
 }
 
 // GetNextWaitlistEntries returns pending waitlist entries ordered by FIFO
 func (r *ScyllaRepository) GetNextWaitlistEntries(ctx context.Context, orgID, tripID string, limit int) ([]domain.WaitlistEntry, error) {
-	query := `SELECT organization_id, trip_id, user_id, seat_class, requested_seats, created_at, status 
-	          FROM waitlist WHERE organization_id = ? AND trip_id = ? ORDER BY created_at ASC LIMIT ?`
+	entries, err := r.GetTripWaitlist(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []domain.WaitlistEntry
+	for _, entry := range entries {
+		if entry.Status != domain.WaitlistStatusPending {
+			continue
+		}
+		pending = append(pending, entry)
+		if len(pending) == limit {
+			break
+		}
+	}
+	return pending, nil
+}
 
-	iter := r.session.Query(query, orgID, tripID, limit).WithContext(ctx).Iter()
+// GetTripWaitlist returns every waitlist entry of a trip in FIFO order, regardless of status
+func (r *ScyllaRepository) GetTripWaitlist(ctx context.Context, orgID, tripID string) ([]domain.WaitlistEntry, error) {
+	query := `SELECT organization_id, trip_id, user_id, seat_class, requested_seats, from_station_id, to_station_id,
+	          created_at, status, hold_id, offer_expires_at
+	          FROM waitlist WHERE organization_id = ? AND trip_id = ? ORDER BY created_at ASC`
+
+	iter := r.session.Query(query, orgID, tripID).WithContext(ctx).Iter()
 
 	var entries []domain.WaitlistEntry
 	var entry domain.WaitlistEntry
-	for iter.Scan(&entry.OrganizationID, &entry.TripID, &entry.UserID, &entry.SeatClass, &entry.RequestedSeats, &entry.CreatedAt, &entry.Status) {
+	for iter.Scan(&entry.OrganizationID, &entry.TripID, &entry.UserID, &entry.SeatClass, &entry.RequestedSeats,
+		&entry.FromStationID, &entry.ToStationID, &entry.CreatedAt, &entry.Status, &entry.HoldID, &entry.OfferExpiresAt) {
 		entries = append(entries, entry)
 	}
 
@@ -424,3 +699,11 @@ func (r *ScyllaRepository) UpdateWaitlistStatus(ctx context.Context, orgID, trip
 	query := `UPDATE waitlist SET status = ? WHERE organization_id = ? AND trip_id = ? AND created_at = ? AND user_id = ?`
 	return r.session.Query(query, status, orgID, tripID, createdAt, userID).WithContext(ctx).Exec()
 }
+
+// UpdateWaitlistOffer stores the status and offer (hold) state of a waitlist entry
+func (r *ScyllaRepository) UpdateWaitlistOffer(ctx context.Context, entry domain.WaitlistEntry) error {
+	query := `UPDATE waitlist SET status = ?, hold_id = ?, offer_expires_at = ?
+	          WHERE organization_id = ? AND trip_id = ? AND created_at = ? AND user_id = ?`
+	return r.session.Query(query, entry.Status, entry.HoldID, entry.OfferExpiresAt,
+		entry.OrganizationID, entry.TripID, entry.CreatedAt, entry.UserID).WithContext(ctx).Exec()
+}
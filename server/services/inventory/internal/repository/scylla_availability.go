@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/gocql/gocql"
+)
+
+// AddAvailabilityCounts moves the availability counters by each entry's Available, which may be negative
+func (r *ScyllaRepository) AddAvailabilityCounts(ctx context.Context, orgID, tripID string, deltas []domain.AvailabilityCount) error {
+	batch := r.session.NewBatch(gocql.CounterBatch)
+	for _, d := range deltas {
+		if d.Available == 0 {
+			continue
+		}
+		batch.Query(`UPDATE availability_counters SET available_count = available_count + ?
+					 WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND seat_class = ?`,
+			int64(d.Available), orgID, tripID, d.SegmentIndex, d.SeatClass)
+	}
+	if batch.Size() == 0 {
+		return nil
+	}
+	return r.session.ExecuteBatch(batch.WithContext(ctx))
+}
+
+// GetAvailabilityCounts returns the availability counters of the given segments
+func (r *ScyllaRepository) GetAvailabilityCounts(ctx context.Context, orgID, tripID string, segmentIndices []int) ([]domain.AvailabilityCount, error) {
+	query := `SELECT segment_index, seat_class, available_count FROM availability_counters
+			  WHERE organization_id = ? AND trip_id = ? AND segment_index IN ?`
+
+	iter := r.session.Query(query, orgID, tripID, segmentIndices).WithContext(ctx).Iter()
+
+	var counts []domain.AvailabilityCount
+	var c domain.AvailabilityCount
+	var available int64
+	for iter.Scan(&c.SegmentIndex, &c.SeatClass, &available) {
+		c.Available = int(available)
+		counts = append(counts, c)
+		c = domain.AvailabilityCount{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// GetSeatClasses returns the class of each seat, read from one segment's partition
+func (r *ScyllaRepository) GetSeatClasses(ctx context.Context, orgID, tripID string, segmentIndex int, seatIDs []string) (map[string]string, error) {
+	query := `SELECT seat_id, seat_class FROM seat_inventory
+			  WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND seat_id IN ?`
+
+	iter := r.session.Query(query, orgID, tripID, segmentIndex, seatIDs).WithContext(ctx).Iter()
+
+	classes := make(map[string]string, len(seatIDs))
+	var seatID, seatClass string
+	for iter.Scan(&seatID, &seatClass) {
+		classes[seatID] = seatClass
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return classes, nil
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/gocql/gocql"
+)
+
+// BlockSeat takes one seat out of sale on one segment.
+// Returns false without error when the seat is not available (held, booked or already blocked).
+func (r *ScyllaRepository) BlockSeat(ctx context.Context, orgID, tripID string, segmentIndex int, seatID, reason, blockedBy string, until time.Time) (bool, error) {
+	query := `UPDATE seat_inventory
+			  SET status = ?, block_reason = ?, blocked_by = ?, block_until = ?, updated_at = ?
+			  WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND seat_id = ?
+			  IF status = ?`
+	return r.session.Query(query,
+		domain.SeatStatusBlocked, reason, blockedBy, until, time.Now(),
+		orgID, tripID, segmentIndex, seatID,
+		domain.SeatStatusAvailable).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+}
+
+// UnblockSeat returns a blocked seat to sale on one segment.
+// Returns false without error when the seat was not blocked.
+func (r *ScyllaRepository) UnblockSeat(ctx context.Context, orgID, tripID string, segmentIndex int, seatID string) (bool, error) {
+	query := `UPDATE seat_inventory
+			  SET status = ?, block_reason = '', blocked_by = '', block_until = ?, updated_at = ?
+			  WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND seat_id = ?
+			  IF status = ?`
+	return r.session.Query(query,
+		domain.SeatStatusAvailable, time.Time{}, time.Now(),
+		orgID, tripID, segmentIndex, seatID,
+		domain.SeatStatusBlocked).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+}
+
+// ReleaseExpiredBlock unblocks a seat whose auto-unblock time has passed.
+// The block_until condition leaves seats re-blocked with a new time alone.
+func (r *ScyllaRepository) ReleaseExpiredBlock(ctx context.Context, orgID, tripID string, segmentIndex int, seatID string, until time.Time) (bool, error) {
+	query := `UPDATE seat_inventory
+			  SET status = ?, block_reason = '', blocked_by = '', block_until = ?, updated_at = ?
+			  WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND seat_id = ?
+			  IF status = ? AND block_until = ?`
+	return r.session.Query(query,
+		domain.SeatStatusAvailable, time.Time{}, time.Now(),
+		orgID, tripID, segmentIndex, seatID,
+		domain.SeatStatusBlocked, until).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+}
+
+// SaveScheduleTrip records that a trip was generated from a schedule
+func (r *ScyllaRepository) SaveScheduleTrip(ctx context.Context, orgID, scheduleID, tripID string) error {
+	query := `INSERT INTO schedule_trips (organization_id, schedule_id, trip_id, created_at) VALUES (?, ?, ?, ?)`
+	return r.session.Query(query, orgID, scheduleID, tripID, time.Now()).WithContext(ctx).Exec()
+}
+
+// ListScheduleTrips returns the IDs of trips generated from a schedule
+func (r *ScyllaRepository) ListScheduleTrips(ctx context.Context, orgID, scheduleID string) ([]string, error) {
+	iter := r.session.Query(`SELECT trip_id FROM schedule_trips WHERE organization_id = ? AND schedule_id = ?`,
+		orgID, scheduleID).WithContext(ctx).Iter()
+
+	var tripIDs []string
+	var tripID string
+	for iter.Scan(&tripID) {
+		tripIDs = append(tripIDs, tripID)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return tripIDs, nil
+}
+
+// SaveScheduleBlock stores a schedule-wide seat block
+func (r *ScyllaRepository) SaveScheduleBlock(ctx context.Context, block *domain.SeatBlock) error {
+	query := `INSERT INTO schedule_seat_blocks (organization_id, schedule_id, block_id, seat_ids, seat_numbers,
+			  segment_indexes, reason, blocked_by, unblock_at, created_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	return r.session.Query(query, block.OrganizationID, block.ScheduleID, block.BlockID, block.SeatIDs, block.SeatNumbers,
+		block.SegmentIndexes, block.Reason, block.BlockedBy, block.UnblockAt, block.CreatedAt).WithContext(ctx).Exec()
+}
+
+// ListScheduleBlocks returns the seat blocks configured for a schedule
+func (r *ScyllaRepository) ListScheduleBlocks(ctx context.Context, orgID, scheduleID string) ([]domain.SeatBlock, error) {
+	query := `SELECT block_id, seat_ids, seat_numbers, segment_indexes, reason, blocked_by, unblock_at, created_at
+			  FROM schedule_seat_blocks WHERE organization_id = ? AND schedule_id = ?`
+
+	iter := r.session.Query(query, orgID, scheduleID).WithContext(ctx).Iter()
+
+	var blocks []domain.SeatBlock
+	var b domain.SeatBlock
+	for iter.Scan(&b.BlockID, &b.SeatIDs, &b.SeatNumbers, &b.SegmentIndexes, &b.Reason, &b.BlockedBy, &b.UnblockAt, &b.CreatedAt) {
+		b.OrganizationID = orgID
+		b.ScheduleID = scheduleID
+		blocks = append(blocks, b)
+		b = domain.SeatBlock{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// GetScheduleBlock returns one schedule-wide seat block
+func (r *ScyllaRepository) GetScheduleBlock(ctx context.Context, orgID, scheduleID, blockID string) (*domain.SeatBlock, error) {
+	b := domain.SeatBlock{OrganizationID: orgID, ScheduleID: scheduleID, BlockID: blockID}
+	err := r.session.Query(`SELECT seat_ids, seat_numbers, segment_indexes, reason, blocked_by, unblock_at, created_at
+							FROM schedule_seat_blocks WHERE organization_id = ? AND schedule_id = ? AND block_id = ?`,
+		orgID, scheduleID, blockID).WithContext(ctx).
+		Scan(&b.SeatIDs, &b.SeatNumbers, &b.SegmentIndexes, &b.Reason, &b.BlockedBy, &b.UnblockAt, &b.CreatedAt)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, domain.ErrSeatBlockNotFound
+		}
+		return nil, err
+	}
+	return &b, nil
+}
+
+// DeleteScheduleBlock removes a schedule-wide seat block
+func (r *ScyllaRepository) DeleteScheduleBlock(ctx context.Context, orgID, scheduleID, blockID string) error {
+	query := `DELETE FROM schedule_seat_blocks WHERE organization_id = ? AND schedule_id = ? AND block_id = ?`
+	return r.session.Query(query, orgID, scheduleID, blockID).WithContext(ctx).Exec()
+}
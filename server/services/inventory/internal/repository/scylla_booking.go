@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/gocql/gocql"
+)
+
+// CreateBooking persists a confirmed booking
+func (r *ScyllaRepository) CreateBooking(ctx context.Context, booking *domain.Booking) error {
+	query := `INSERT INTO bookings (booking_id, organization_id, order_id, trip_id, user_id, from_station_id, to_station_id,
+	          segment_range, seats, total_paisa, status, created_at, updated_at)
+	          VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	return r.session.Query(query,
+		booking.BookingID, booking.OrganizationID, booking.OrderID, booking.TripID, booking.UserID,
+		booking.FromStationID, booking.ToStationID, booking.SegmentRange, encodeBookedSeats(booking.Seats),
+		booking.TotalPaisa, booking.Status, booking.CreatedAt, booking.UpdatedAt,
+	).WithContext(ctx).Exec()
+}
+
+// GetBooking retrieves a booking by ID
+func (r *ScyllaRepository) GetBooking(ctx context.Context, bookingID string) (*domain.Booking, error) {
+	query := `SELECT booking_id, organization_id, order_id, trip_id, user_id, from_station_id, to_station_id,
+	          segment_range, seats, total_paisa, status, created_at, updated_at
+	          FROM bookings WHERE booking_id = ?`
+
+	var booking domain.Booking
+	var seats []map[string]string
+	err := r.session.Query(query, bookingID).WithContext(ctx).Scan(
+		&booking.BookingID, &booking.OrganizationID, &booking.OrderID, &booking.TripID, &booking.UserID,
+		&booking.FromStationID, &booking.ToStationID, &booking.SegmentRange, &seats,
+		&booking.TotalPaisa, &booking.Status, &booking.CreatedAt, &booking.UpdatedAt,
+	)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, domain.ErrBookingNotFound
+		}
+		return nil, err
+	}
+
+	booking.Seats = decodeBookedSeats(seats)
+	return &booking, nil
+}
+
+// UpdateBookingStatus changes the status of a booking
+func (r *ScyllaRepository) UpdateBookingStatus(ctx context.Context, bookingID, status string) error {
+	query := `UPDATE bookings SET status = ?, updated_at = ? WHERE booking_id = ?`
+	return r.session.Query(query, status, time.Now(), bookingID).WithContext(ctx).Exec()
+}
+
+func encodeBookedSeats(seats []domain.BookedSeat) []map[string]string {
+	encoded := make([]map[string]string, 0, len(seats))
+	for _, seat := range seats {
+		encoded = append(encoded, map[string]string{
+			"seat_id":         seat.SeatID,
+			"seat_number":     seat.SeatNumber,
+			"seat_class":      seat.SeatClass,
+			"ticket_id":       seat.TicketID,
+			"passenger_nid":   seat.PassengerNID,
+			"passenger_name":  seat.PassengerName,
+			"price_paisa":     strconv.FormatInt(seat.PricePaisa, 10),
+			"passenger_index": strconv.Itoa(seat.PassengerIndex),
+			"from_station_id": seat.FromStationID,
+			"to_station_id":   seat.ToStationID,
+			"segment_range":   encodeSegmentRange(seat.SegmentRange),
+			"capacity_class":  seat.CapacityClass,
+		})
+	}
+	return encoded
+}
+
+func decodeBookedSeats(encoded []map[string]string) []domain.BookedSeat {
+	seats := make([]domain.BookedSeat, 0, len(encoded))
+	for _, m := range encoded {
+		price, _ := strconv.ParseInt(m["price_paisa"], 10, 64)
+		passengerIndex, _ := strconv.Atoi(m["passenger_index"])
+		seats = append(seats, domain.BookedSeat{
+			SeatID:         m["seat_id"],
+			SeatNumber:     m["seat_number"],
+			SeatClass:      m["seat_class"],
+			TicketID:       m["ticket_id"],
+			PassengerNID:   m["passenger_nid"],
+			PassengerName:  m["passenger_name"],
+			PricePaisa:     price,
+			PassengerIndex: passengerIndex,
+			FromStationID:  m["from_station_id"],
+			ToStationID:    m["to_station_id"],
+			SegmentRange:   decodeSegmentRange(m["segment_range"]),
+			CapacityClass:  m["capacity_class"],
+		})
+	}
+	return seats
+}
+
+// Seat leg segments are stored as a comma separated list inside the frozen seat map
+func encodeSegmentRange(segmentRange []int) string {
+	parts := make([]string, 0, len(segmentRange))
+	for _, idx := range segmentRange {
+		parts = append(parts, strconv.Itoa(idx))
+	}
+	return strings.Join(parts, ",")
+}
+
+func decodeSegmentRange(encoded string) []int {
+	if encoded == "" {
+		return nil
+	}
+	var segmentRange []int
+	for _, part := range strings.Split(encoded, ",") {
+		if idx, err := strconv.Atoi(part); err == nil {
+			segmentRange = append(segmentRange, idx)
+		}
+	}
+	return segmentRange
+}
+
+// ListTripBookings returns every booking made on a trip
+func (r *ScyllaRepository) ListTripBookings(ctx context.Context, orgID, tripID string) ([]domain.Booking, error) {
+	query := `SELECT booking_id, organization_id, order_id, trip_id, user_id, from_station_id, to_station_id,
+	          segment_range, seats, total_paisa, status, created_at, updated_at
+	          FROM bookings WHERE trip_id = ?`
+
+	iter := r.session.Query(query, tripID).WithContext(ctx).Iter()
+
+	var bookings []domain.Booking
+	var booking domain.Booking
+	var seats []map[string]string
+	for iter.Scan(
+		&booking.BookingID, &booking.OrganizationID, &booking.OrderID, &booking.TripID, &booking.UserID,
+		&booking.FromStationID, &booking.ToStationID, &booking.SegmentRange, &seats,
+		&booking.TotalPaisa, &booking.Status, &booking.CreatedAt, &booking.UpdatedAt,
+	) {
+		if booking.OrganizationID == orgID {
+			booking.Seats = decodeBookedSeats(seats)
+			bookings = append(bookings, booking)
+		}
+		booking = domain.Booking{}
+		seats = nil
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/gocql/gocql"
+)
+
+// maxCapacityCASAttempts bounds the compare-and-set retries on a contended capacity row
+const maxCapacityCASAttempts = 5
+
+// InitializeCapacity creates the capacity counters of a new trip, one row per segment and class
+func (r *ScyllaRepository) InitializeCapacity(ctx context.Context, orgID, tripID string, segments []domain.Segment, classes []domain.CapacityInventory) error {
+	batch := r.session.NewBatch(gocql.LoggedBatch)
+	for _, seg := range segments {
+		for _, class := range classes {
+			batch.Query(`INSERT INTO capacity_inventory (organization_id, trip_id, segment_index, capacity_class,
+						 max_count, held_count, booked_count, price_paisa, updated_at)
+						 VALUES (?, ?, ?, ?, ?, 0, 0, ?, ?)`,
+				orgID, tripID, seg.SegmentIndex, class.CapacityClass, class.MaxCount, class.PricePaisa, time.Now())
+		}
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+// GetCapacity returns the capacity counters of the given segments
+func (r *ScyllaRepository) GetCapacity(ctx context.Context, orgID, tripID string, segmentIndices []int) ([]domain.CapacityInventory, error) {
+	query := `SELECT segment_index, capacity_class, max_count, held_count, booked_count, price_paisa, updated_at
+			  FROM capacity_inventory
+			  WHERE organization_id = ? AND trip_id = ? AND segment_index IN ?`
+
+	iter := r.session.Query(query, orgID, tripID, segmentIndices).WithContext(ctx).Iter()
+
+	var rows []domain.CapacityInventory
+	var c domain.CapacityInventory
+	for iter.Scan(&c.SegmentIndex, &c.CapacityClass, &c.MaxCount, &c.HeldCount, &c.BookedCount, &c.PricePaisa, &c.UpdatedAt) {
+		c.OrganizationID = orgID
+		c.TripID = tripID
+		rows = append(rows, c)
+		c = domain.CapacityInventory{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AdjustCapacity moves the held and booked counts of one class on one segment.
+// Both counts are compared and set together, so concurrent holds never oversell.
+// Returns false without error when the change would exceed the class maximum
+// or the trip does not sell the class.
+func (r *ScyllaRepository) AdjustCapacity(ctx context.Context, orgID, tripID string, segmentIndex int, capacityClass string, heldDelta, bookedDelta int) (bool, error) {
+	var maxCount, held, booked int
+	err := r.session.Query(`SELECT max_count, held_count, booked_count FROM capacity_inventory
+							WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND capacity_class = ?`,
+		orgID, tripID, segmentIndex, capacityClass).WithContext(ctx).Scan(&maxCount, &held, &booked)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for attempt := 0; attempt < maxCapacityCASAttempts; attempt++ {
+		newHeld := max(held+heldDelta, 0)
+		newBooked := max(booked+bookedDelta, 0)
+		if heldDelta+bookedDelta > 0 && newHeld+newBooked > maxCount {
+			return false, nil
+		}
+
+		current := make(map[string]interface{})
+		applied, err := r.session.Query(`UPDATE capacity_inventory
+										 SET held_count = ?, booked_count = ?, updated_at = ?
+										 WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND capacity_class = ?
+										 IF held_count = ? AND booked_count = ?`,
+			newHeld, newBooked, time.Now(),
+			orgID, tripID, segmentIndex, capacityClass,
+			held, booked).WithContext(ctx).MapScanCAS(current)
+		if err != nil {
+			return false, err
+		}
+		if applied {
+			return true, nil
+		}
+
+		// Lost the race: retry against the counts that won
+		held, _ = current["held_count"].(int)
+		booked, _ = current["booked_count"].(int)
+	}
+
+	return false, domain.ErrCapacityContention
+}
+
+// SaveCapacityHold records the places a hold took from one class
+func (r *ScyllaRepository) SaveCapacityHold(ctx context.Context, orgID, tripID string, hold domain.CapacityHold) error {
+	query := `INSERT INTO capacity_holds (organization_id, trip_id, hold_id, capacity_class, quantity, segment_indexes,
+			  status, hold_expiry, booking_id, updated_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, '', ?)`
+	return r.session.Query(query, orgID, tripID, hold.HoldID, hold.CapacityClass, hold.Quantity, hold.SegmentRange,
+		hold.Status, hold.HoldExpiry, time.Now()).WithContext(ctx).Exec()
+}
+
+// ListCapacityHolds returns every capacity hold recorded on a trip
+func (r *ScyllaRepository) ListCapacityHolds(ctx context.Context, orgID, tripID string) ([]domain.CapacityHold, error) {
+	query := `SELECT hold_id, capacity_class, quantity, segment_indexes, status, hold_expiry
+			  FROM capacity_holds WHERE organization_id = ? AND trip_id = ?`
+
+	iter := r.session.Query(query, orgID, tripID).WithContext(ctx).Iter()
+
+	var holds []domain.CapacityHold
+	var h domain.CapacityHold
+	for iter.Scan(&h.HoldID, &h.CapacityClass, &h.Quantity, &h.SegmentRange, &h.Status, &h.HoldExpiry) {
+		holds = append(holds, h)
+		h = domain.CapacityHold{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return holds, nil
+}
+
+// TransitionCapacityHold moves a capacity hold out of fromStatus.
+// Returns false without error when the hold is no longer in fromStatus;
+// only the caller that wins the transition may adjust the counts.
+func (r *ScyllaRepository) TransitionCapacityHold(ctx context.Context, orgID, tripID, holdID, capacityClass, fromStatus, toStatus, bookingID string) (bool, error) {
+	query := `UPDATE capacity_holds SET status = ?, booking_id = ?, updated_at = ?
+			  WHERE organization_id = ? AND trip_id = ? AND hold_id = ? AND capacity_class = ?
+			  IF status = ?`
+	return r.session.Query(query, toStatus, bookingID, time.Now(),
+		orgID, tripID, holdID, capacityClass,
+		fromStatus).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+}
+
+// ExtendCapacityHold moves the expiry of a live capacity hold
+func (r *ScyllaRepository) ExtendCapacityHold(ctx context.Context, orgID, tripID, holdID, capacityClass string, expiry time.Time) error {
+	now := time.Now()
+	applied, err := r.session.Query(`UPDATE capacity_holds SET hold_expiry = ?, updated_at = ?
+									 WHERE organization_id = ? AND trip_id = ? AND hold_id = ? AND capacity_class = ?
+									 IF status = ? AND hold_expiry > ?`,
+		expiry, now,
+		orgID, tripID, holdID, capacityClass,
+		domain.CapacityHoldHeld, now).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return domain.ErrHoldExpired
+	}
+	return nil
+}
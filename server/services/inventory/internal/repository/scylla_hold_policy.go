@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/gocql/gocql"
+)
+
+// SaveHoldPolicy stores an organization's hold extension limits
+func (r *ScyllaRepository) SaveHoldPolicy(ctx context.Context, policy *domain.HoldPolicy) error {
+	query := `INSERT INTO hold_policies (organization_id, max_hold_lifetime_seconds, max_extensions, extension_seconds, updated_at)
+			  VALUES (?, ?, ?, ?, ?)`
+	return r.session.Query(query, policy.OrganizationID, int(policy.MaxHoldLifetime/time.Second),
+		policy.MaxExtensions, int(policy.ExtensionStep/time.Second), policy.UpdatedAt).WithContext(ctx).Exec()
+}
+
+// GetHoldPolicy returns the organization's stored policy, or nil when none is configured
+func (r *ScyllaRepository) GetHoldPolicy(ctx context.Context, orgID string) (*domain.HoldPolicy, error) {
+	var lifetimeSeconds, extensionSeconds int
+	policy := domain.HoldPolicy{OrganizationID: orgID}
+	err := r.session.Query(`SELECT max_hold_lifetime_seconds, max_extensions, extension_seconds, updated_at
+							FROM hold_policies WHERE organization_id = ?`, orgID).
+		WithContext(ctx).Scan(&lifetimeSeconds, &policy.MaxExtensions, &extensionSeconds, &policy.UpdatedAt)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	policy.MaxHoldLifetime = time.Duration(lifetimeSeconds) * time.Second
+	policy.ExtensionStep = time.Duration(extensionSeconds) * time.Second
+	return &policy, nil
+}
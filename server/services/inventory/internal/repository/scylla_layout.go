@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/gocql/gocql"
+)
+
+// SaveTripLayout stores the vehicle layout captured when a trip is initialized
+func (r *ScyllaRepository) SaveTripLayout(ctx context.Context, orgID, tripID string, layout *domain.SeatLayout) error {
+	data, err := json.Marshal(layout)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO trip_layouts (organization_id, trip_id, vehicle_id, vehicle_type, layout, created_at)
+	          VALUES (?, ?, ?, ?, ?, ?)`
+	return r.session.Query(query, orgID, tripID, layout.VehicleID, layout.VehicleType, string(data), time.Now()).
+		WithContext(ctx).Exec()
+}
+
+// GetTripLayout returns the stored layout, or nil for trips initialized before layouts were recorded
+func (r *ScyllaRepository) GetTripLayout(ctx context.Context, orgID, tripID string) (*domain.SeatLayout, error) {
+	var data string
+	err := r.session.Query(`SELECT layout FROM trip_layouts WHERE organization_id = ? AND trip_id = ?`, orgID, tripID).
+		WithContext(ctx).Scan(&data)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var layout domain.SeatLayout
+	if err := json.Unmarshal([]byte(data), &layout); err != nil {
+		return nil, err
+	}
+	return &layout, nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/gocql/gocql"
+)
+
+// AppendLedger records one seat status change. Every seat and segment of the change
+// shares an entry ID, so the ledger reads back in the order changes were made.
+func (r *ScyllaRepository) AppendLedger(ctx context.Context, orgID, tripID string, transitions []domain.SeatTransition) error {
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	entryID := gocql.TimeUUID()
+	batch := r.session.NewBatch(gocql.UnloggedBatch)
+	for _, t := range transitions {
+		batch.Query(`INSERT INTO seat_ledger (organization_id, trip_id, entry_id, segment_index, seat_id,
+					 from_status, to_status, actor, hold_id, booking_id, reason)
+					 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			orgID, tripID, entryID, t.SegmentIndex, t.SeatID,
+			t.FromStatus, t.ToStatus, t.Actor, t.HoldID, t.BookingID, t.Reason)
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+// ListLedger returns a trip's seat changes, oldest first
+func (r *ScyllaRepository) ListLedger(ctx context.Context, orgID, tripID string) ([]domain.SeatTransition, error) {
+	query := `SELECT entry_id, segment_index, seat_id, from_status, to_status, actor, hold_id, booking_id, reason
+			  FROM seat_ledger WHERE organization_id = ? AND trip_id = ?`
+
+	iter := r.session.Query(query, orgID, tripID).WithContext(ctx).Iter()
+
+	var entries []domain.SeatTransition
+	var t domain.SeatTransition
+	var entryID gocql.UUID
+	for iter.Scan(&entryID, &t.SegmentIndex, &t.SeatID, &t.FromStatus, &t.ToStatus, &t.Actor, &t.HoldID, &t.BookingID, &t.Reason) {
+		t.OrganizationID = orgID
+		t.TripID = tripID
+		t.RecordedAt = entryID.Time()
+		entries = append(entries, t)
+		t = domain.SeatTransition{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ListTrips returns every trip with inventory
+func (r *ScyllaRepository) ListTrips(ctx context.Context) ([]domain.TripRef, error) {
+	iter := r.session.Query(`SELECT DISTINCT organization_id, trip_id FROM segments`).WithContext(ctx).Iter()
+
+	var trips []domain.TripRef
+	var trip domain.TripRef
+	for iter.Scan(&trip.OrganizationID, &trip.TripID) {
+		trips = append(trips, trip)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return trips, nil
+}
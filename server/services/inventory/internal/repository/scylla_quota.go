@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/gocql/gocql"
+)
+
+// SaveQuotas stores the quota buckets configured for a trip
+func (r *ScyllaRepository) SaveQuotas(ctx context.Context, quotas []domain.SeatQuota) error {
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch)
+	for _, q := range quotas {
+		batch.Query(`INSERT INTO trip_quotas (organization_id, trip_id, quota_id, name, quota_type, seat_ids,
+					 segment_indexes, partner_ids, release_before_minutes, released, created_at)
+					 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			q.OrganizationID, q.TripID, q.QuotaID, q.Name, q.QuotaType, q.SeatIDs,
+			q.SegmentIndexes, q.PartnerIDs, int(q.ReleaseBefore/time.Minute), false, q.CreatedAt)
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+// GetTripQuotas returns all quota buckets for a trip
+func (r *ScyllaRepository) GetTripQuotas(ctx context.Context, orgID, tripID string) ([]domain.SeatQuota, error) {
+	query := `SELECT quota_id, name, quota_type, seat_ids, segment_indexes, partner_ids,
+			  release_before_minutes, released, released_at, created_at
+			  FROM trip_quotas WHERE organization_id = ? AND trip_id = ?`
+
+	iter := r.session.Query(query, orgID, tripID).WithContext(ctx).Iter()
+
+	var quotas []domain.SeatQuota
+	var q domain.SeatQuota
+	var releaseMinutes int
+	for iter.Scan(&q.QuotaID, &q.Name, &q.QuotaType, &q.SeatIDs, &q.SegmentIndexes, &q.PartnerIDs,
+		&releaseMinutes, &q.Released, &q.ReleasedAt, &q.CreatedAt) {
+		q.OrganizationID = orgID
+		q.TripID = tripID
+		q.ReleaseBefore = time.Duration(releaseMinutes) * time.Minute
+		quotas = append(quotas, q)
+		q = domain.SeatQuota{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return quotas, nil
+}
+
+// MarkQuotaReleased returns a quota's seats to the general pool
+func (r *ScyllaRepository) MarkQuotaReleased(ctx context.Context, orgID, tripID, quotaID string) error {
+	query := `UPDATE trip_quotas SET released = true, released_at = ? WHERE organization_id = ? AND trip_id = ? AND quota_id = ?`
+	return r.session.Query(query, time.Now(), orgID, tripID, quotaID).WithContext(ctx).Exec()
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/gocql/gocql"
+)
+
+// ReplaceTripSeats swaps a trip's seat rows for those of a new vehicle layout.
+// New rows are written with their final status, so moved passengers never show as available.
+func (r *ScyllaRepository) ReplaceTripSeats(ctx context.Context, orgID, tripID string, segmentIndices []int, oldSeatIDs []string, rows []domain.SeatInventory) error {
+	batch := r.session.NewBatch(gocql.LoggedBatch)
+	now := time.Now()
+
+	for _, seat := range rows {
+		batch.Query(`INSERT INTO seat_inventory (organization_id, trip_id, segment_index, seat_id, seat_number, seat_class,
+					 seat_type, status, hold_id, hold_user_id, hold_expiry, booking_id, price_paisa,
+					 row_number, column_number, section_id, berth, is_accessible, has_power,
+					 block_reason, blocked_by, block_until, updated_at)
+					 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			orgID, tripID, seat.SegmentIndex, seat.SeatID, seat.SeatNumber, seat.SeatClass,
+			seat.SeatType, seat.Status, seat.HoldID, seat.HoldUserID, seat.HoldExpiry, seat.BookingID, seat.PricePaisa,
+			seat.Row, seat.Column, seat.SectionID, seat.Berth, seat.IsAccessible, seat.HasPower,
+			seat.BlockReason, seat.BlockedBy, seat.BlockedUntil, now)
+	}
+
+	for _, segIdx := range segmentIndices {
+		for _, seatID := range oldSeatIDs {
+			batch.Query(`DELETE FROM seat_inventory WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND seat_id = ?`,
+				orgID, tripID, segIdx, seatID)
+		}
+	}
+
+	return r.session.ExecuteBatch(batch)
+}
+
+// ResizeCapacity sets the maximum and price of one class on one segment, keeping its counts.
+// A class the trip did not sell before starts with nothing held or booked.
+func (r *ScyllaRepository) ResizeCapacity(ctx context.Context, orgID, tripID string, segmentIndex int, capacityClass string, maxCount int, pricePaisa int64) error {
+	query := `UPDATE capacity_inventory SET max_count = ?, price_paisa = ?, updated_at = ?
+			  WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND capacity_class = ?`
+	return r.session.Query(query, maxCount, pricePaisa, time.Now(),
+		orgID, tripID, segmentIndex, capacityClass).WithContext(ctx).Exec()
+}
+
+// UpdateQuotaSeats points a quota at the seats of a new vehicle layout
+func (r *ScyllaRepository) UpdateQuotaSeats(ctx context.Context, orgID, tripID, quotaID string, seatIDs []string) error {
+	query := `UPDATE trip_quotas SET seat_ids = ? WHERE organization_id = ? AND trip_id = ? AND quota_id = ?`
+	return r.session.Query(query, seatIDs, orgID, tripID, quotaID).WithContext(ctx).Exec()
+}
+
+// UpdateBookingSeats rewrites the seats of a booking after its passengers were moved
+func (r *ScyllaRepository) UpdateBookingSeats(ctx context.Context, bookingID string, seats []domain.BookedSeat) error {
+	query := `UPDATE bookings SET seats = ?, updated_at = ? WHERE booking_id = ?`
+	return r.session.Query(query, encodeBookedSeats(seats), time.Now(), bookingID).WithContext(ctx).Exec()
+}
+
+// SaveReaccommodations records where each passenger of a vehicle change went
+func (r *ScyllaRepository) SaveReaccommodations(ctx context.Context, entries []domain.Reaccommodation) error {
+	batch := r.session.NewBatch(gocql.LoggedBatch)
+	for _, e := range entries {
+		batch.Query(`INSERT INTO trip_reaccommodations (organization_id, trip_id, reference_id, old_seat_id, passenger_index,
+					 reference_type, order_id, user_id, passenger_name, passenger_nid, seat_class, old_seat_number,
+					 new_seat_id, new_seat_number, old_ticket_id, new_ticket_id, status, old_vehicle_id, new_vehicle_id, created_at)
+					 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			e.OrganizationID, e.TripID, e.ReferenceID, e.OldSeatID, e.PassengerIndex,
+			e.ReferenceType, e.OrderID, e.UserID, e.PassengerName, e.PassengerNID, e.SeatClass, e.OldSeatNumber,
+			e.NewSeatID, e.NewSeatNumber, e.OldTicketID, e.NewTicketID, e.Status, e.OldVehicleID, e.NewVehicleID, e.CreatedAt)
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+// ListReaccommodations returns every re-accommodation recorded on a trip
+func (r *ScyllaRepository) ListReaccommodations(ctx context.Context, orgID, tripID string) ([]domain.Reaccommodation, error) {
+	query := `SELECT reference_id, old_seat_id, passenger_index, reference_type, order_id, user_id, passenger_name,
+			  passenger_nid, seat_class, old_seat_number, new_seat_id, new_seat_number, old_ticket_id, new_ticket_id,
+			  status, old_vehicle_id, new_vehicle_id, created_at
+			  FROM trip_reaccommodations WHERE organization_id = ? AND trip_id = ?`
+
+	iter := r.session.Query(query, orgID, tripID).WithContext(ctx).Iter()
+
+	var entries []domain.Reaccommodation
+	var e domain.Reaccommodation
+	for iter.Scan(&e.ReferenceID, &e.OldSeatID, &e.PassengerIndex, &e.ReferenceType, &e.OrderID, &e.UserID, &e.PassengerName,
+		&e.PassengerNID, &e.SeatClass, &e.OldSeatNumber, &e.NewSeatID, &e.NewSeatNumber, &e.OldTicketID, &e.NewTicketID,
+		&e.Status, &e.OldVehicleID, &e.NewVehicleID, &e.CreatedAt) {
+		e.OrganizationID = orgID
+		e.TripID = tripID
+		entries = append(entries, e)
+		e = domain.Reaccommodation{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
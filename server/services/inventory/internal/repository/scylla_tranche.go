@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/gocql/gocql"
+)
+
+// Release tranches are keyed by the trip they apply to, or by the schedule they are a template for
+const (
+	trancheScopeTrip     = "trip"
+	trancheScopeSchedule = "schedule"
+)
+
+// trancheScope returns the partition a tranche is stored under
+func trancheScope(t *domain.ReleaseTranche) (string, string) {
+	if t.TripID != "" {
+		return t.TripID, trancheScopeTrip
+	}
+	return t.ScheduleID, trancheScopeSchedule
+}
+
+// SaveTranche stores a trip's release tranche or a schedule's tranche template
+func (r *ScyllaRepository) SaveTranche(ctx context.Context, t *domain.ReleaseTranche) error {
+	scopeID, scopeType := trancheScope(t)
+	query := `INSERT INTO release_tranches (organization_id, scope_id, tranche_id, scope_type, schedule_id, name,
+			  percentage, seat_ids, seat_numbers, release_at, release_before_departure_minutes, status, released_at,
+			  created_by, created_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	return r.session.Query(query, t.OrganizationID, scopeID, t.TrancheID, scopeType, t.ScheduleID, t.Name,
+		t.Percentage, t.SeatIDs, t.SeatNumbers, t.ReleaseAt, int(t.ReleaseBeforeDeparture.Minutes()), t.Status, t.ReleasedAt,
+		t.CreatedBy, t.CreatedAt).WithContext(ctx).Exec()
+}
+
+// ListTranches returns the release tranches of a trip, or the templates of a schedule
+func (r *ScyllaRepository) ListTranches(ctx context.Context, orgID, scopeID string) ([]domain.ReleaseTranche, error) {
+	query := `SELECT tranche_id, scope_type, schedule_id, name, percentage, seat_ids, seat_numbers, release_at,
+			  release_before_departure_minutes, status, released_at, created_by, created_at
+			  FROM release_tranches WHERE organization_id = ? AND scope_id = ?`
+
+	iter := r.session.Query(query, orgID, scopeID).WithContext(ctx).Iter()
+
+	var tranches []domain.ReleaseTranche
+	var t domain.ReleaseTranche
+	var scopeType string
+	var beforeMinutes int
+	for iter.Scan(&t.TrancheID, &scopeType, &t.ScheduleID, &t.Name, &t.Percentage, &t.SeatIDs, &t.SeatNumbers, &t.ReleaseAt,
+		&beforeMinutes, &t.Status, &t.ReleasedAt, &t.CreatedBy, &t.CreatedAt) {
+		t.OrganizationID = orgID
+		if scopeType == trancheScopeTrip {
+			t.TripID = scopeID
+		}
+		t.ReleaseBeforeDeparture = time.Duration(beforeMinutes) * time.Minute
+		tranches = append(tranches, t)
+		t = domain.ReleaseTranche{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return tranches, nil
+}
+
+// GetTranche returns one release tranche of a trip or schedule
+func (r *ScyllaRepository) GetTranche(ctx context.Context, orgID, scopeID, trancheID string) (*domain.ReleaseTranche, error) {
+	t := domain.ReleaseTranche{OrganizationID: orgID, TrancheID: trancheID}
+	var scopeType string
+	var beforeMinutes int
+	err := r.session.Query(`SELECT scope_type, schedule_id, name, percentage, seat_ids, seat_numbers, release_at,
+							release_before_departure_minutes, status, released_at, created_by, created_at
+							FROM release_tranches WHERE organization_id = ? AND scope_id = ? AND tranche_id = ?`,
+		orgID, scopeID, trancheID).WithContext(ctx).
+		Scan(&scopeType, &t.ScheduleID, &t.Name, &t.Percentage, &t.SeatIDs, &t.SeatNumbers, &t.ReleaseAt,
+			&beforeMinutes, &t.Status, &t.ReleasedAt, &t.CreatedBy, &t.CreatedAt)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, domain.ErrTrancheNotFound
+		}
+		return nil, err
+	}
+	if scopeType == trancheScopeTrip {
+		t.TripID = scopeID
+	}
+	t.ReleaseBeforeDeparture = time.Duration(beforeMinutes) * time.Minute
+	return &t, nil
+}
+
+// DeleteTranche removes a release tranche or schedule template
+func (r *ScyllaRepository) DeleteTranche(ctx context.Context, orgID, scopeID, trancheID string) error {
+	query := `DELETE FROM release_tranches WHERE organization_id = ? AND scope_id = ? AND tranche_id = ?`
+	return r.session.Query(query, orgID, scopeID, trancheID).WithContext(ctx).Exec()
+}
+
+// MarkTrancheReleased flips a trip's tranche to released.
+// Returns false without error when another caller released it first.
+func (r *ScyllaRepository) MarkTrancheReleased(ctx context.Context, orgID, tripID, trancheID string, at time.Time) (bool, error) {
+	query := `UPDATE release_tranches SET status = ?, released_at = ?
+			  WHERE organization_id = ? AND scope_id = ? AND tranche_id = ?
+			  IF status = ?`
+	return r.session.Query(query,
+		domain.TrancheStatusReleased, at,
+		orgID, tripID, trancheID,
+		domain.TrancheStatusPending).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+}
+
+// HoldBackSeat takes an available seat out of sale on one segment until its tranche is released.
+// Returns false without error when the seat is not available.
+func (r *ScyllaRepository) HoldBackSeat(ctx context.Context, orgID, tripID string, segmentIndex int, seatID, trancheID string) (bool, error) {
+	query := `UPDATE seat_inventory
+			  SET status = ?, tranche_id = ?, updated_at = ?
+			  WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND seat_id = ?
+			  IF status = ?`
+	return r.session.Query(query,
+		domain.SeatStatusUnreleased, trancheID, time.Now(),
+		orgID, tripID, segmentIndex, seatID,
+		domain.SeatStatusAvailable).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+}
+
+// ReleaseTrancheSeat puts a seat held back for a tranche on sale on one segment.
+// Returns false without error when the seat is not held back for that tranche.
+func (r *ScyllaRepository) ReleaseTrancheSeat(ctx context.Context, orgID, tripID string, segmentIndex int, seatID, trancheID string) (bool, error) {
+	query := `UPDATE seat_inventory
+			  SET status = ?, tranche_id = '', updated_at = ?
+			  WHERE organization_id = ? AND trip_id = ? AND segment_index = ? AND seat_id = ?
+			  IF status = ? AND tranche_id = ?`
+	return r.session.Query(query,
+		domain.SeatStatusAvailable, time.Now(),
+		orgID, tripID, segmentIndex, seatID,
+		domain.SeatStatusUnreleased, trancheID).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+}
@@ -0,0 +1,324 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+)
+
+// MaxAllocationAttempts bounds re-allocation when chosen seats are taken between selection and hold
+const MaxAllocationAttempts = 3
+
+// SeatPreferences steers best-available allocation
+type SeatPreferences struct {
+	SeatClass       string
+	Position        string // window, aisle; empty = no preference
+	RequireTogether bool   // Fail rather than split the group
+}
+
+// SeatAllocator picks seats for a "give me N seats" hold.
+// Candidates are seats free on every segment of the journey (one record per seat).
+type SeatAllocator interface {
+	Allocate(candidates []domain.SeatInventory, layout *domain.SeatLayout, count int, prefs SeatPreferences) ([]string, error)
+}
+
+// BestAvailableAllocator seats a group in one row where possible, then across adjacent rows,
+// preferring front rows, the requested window/aisle position and blocks that strand no single seat.
+type BestAvailableAllocator struct{}
+
+func NewBestAvailableAllocator() *BestAvailableAllocator {
+	return &BestAvailableAllocator{}
+}
+
+// Scoring weights (lower score wins)
+const (
+	strandPenalty     = 100 // Leaves a single unsellable seat next to the block
+	positionPenalty   = 150 // Block has no seat in the preferred position; an explicit ask outweighs stranding
+	aisleSplitPenalty = 20  // Group sits on both sides of an aisle
+	extraRowPenalty   = 200 // Each additional row the group spans
+)
+
+type seatRowGroup struct {
+	sectionID string
+	row       int
+	seats     []domain.SeatInventory // sorted by column
+}
+
+func (a *BestAvailableAllocator) Allocate(candidates []domain.SeatInventory, layout *domain.SeatLayout, count int, prefs SeatPreferences) ([]string, error) {
+	if count <= 0 {
+		return nil, domain.ErrInvalidSeatCount
+	}
+
+	var pool []domain.SeatInventory
+	for _, seat := range candidates {
+		if prefs.SeatClass == "" || seat.SeatClass == prefs.SeatClass {
+			pool = append(pool, seat)
+		}
+	}
+	if len(pool) < count {
+		return nil, domain.ErrInsufficientSeats
+	}
+
+	aisles := aisleColumns(layout)
+	rows := groupSeatRows(pool)
+
+	if block := a.bestRowBlock(rows, aisles, count, prefs); block != nil {
+		return seatIDsOf(block), nil
+	}
+	if block := a.bestMultiRowBlock(rows, count, prefs); block != nil {
+		return seatIDsOf(block), nil
+	}
+	if prefs.RequireTogether {
+		return nil, domain.ErrNoAdjacentSeats
+	}
+
+	// Scattered fallback: preferred position first, then front to back
+	sort.SliceStable(pool, func(i, j int) bool {
+		mi, mj := matchesPosition(pool[i], prefs), matchesPosition(pool[j], prefs)
+		if mi != mj {
+			return mi
+		}
+		return seatLess(pool[i], pool[j])
+	})
+	return seatIDsOf(pool[:count]), nil
+}
+
+// bestRowBlock finds the best run of count adjacent seats within a single row
+func (a *BestAvailableAllocator) bestRowBlock(rows []seatRowGroup, aisles map[string]map[int]bool, count int, prefs SeatPreferences) []domain.SeatInventory {
+	var best []domain.SeatInventory
+	bestScore := -1
+
+	for _, row := range rows {
+		for _, run := range contiguousRuns(row.seats) {
+			for start := 0; start+count <= len(run); start++ {
+				block := run[start : start+count]
+				score := row.row
+
+				// Avoid leaving a single stranded seat on either side of the block
+				if start == 1 {
+					score += strandPenalty
+				}
+				if len(run)-start-count == 1 {
+					score += strandPenalty
+				}
+				if count > 1 && crossesAisle(block, aisles[row.sectionID]) {
+					score += aisleSplitPenalty
+				}
+				if !blockMatchesPosition(block, prefs) {
+					score += positionPenalty
+				}
+
+				if bestScore < 0 || score < bestScore {
+					best, bestScore = block, score
+				}
+			}
+		}
+	}
+	return best
+}
+
+// bestMultiRowBlock seats the group across consecutive rows of one section, stacking
+// seats under each other as closely as possible
+func (a *BestAvailableAllocator) bestMultiRowBlock(rows []seatRowGroup, count int, prefs SeatPreferences) []domain.SeatInventory {
+	var best []domain.SeatInventory
+	bestScore := -1
+
+	for i := range rows {
+		var block []domain.SeatInventory
+		anchor := 0 // column the previous row's seats started at
+		score := rows[i].row
+
+		for j := i; j < len(rows) && len(block) < count; j++ {
+			if rows[j].sectionID != rows[i].sectionID || rows[j].row != rows[i].row+(j-i) {
+				break
+			}
+			if j > i {
+				score += extraRowPenalty
+			}
+
+			run := longestRunNear(contiguousRuns(rows[j].seats), anchor)
+			take := count - len(block)
+			if take > len(run) {
+				take = len(run)
+			}
+			start := closestStart(run, take, anchor)
+			block = append(block, run[start:start+take]...)
+			anchor = run[start].Column
+		}
+
+		if len(block) < count {
+			continue
+		}
+		if !blockMatchesPosition(block, prefs) {
+			score += positionPenalty
+		}
+		if bestScore < 0 || score < bestScore {
+			best, bestScore = block, score
+		}
+	}
+	return best
+}
+
+// --- Allocation Helpers ---
+
+// availableInventory returns one record per seat that is free on every segment in segmentRange
+func availableInventory(seats []domain.SeatInventory, segmentRange []int) []domain.SeatInventory {
+	freeSegments := make(map[string]int)
+	details := make(map[string]domain.SeatInventory)
+
+	now := time.Now()
+	for _, seat := range seatsInSegments(seats, segmentRange) {
+		if seat.Status == domain.SeatStatusAvailable ||
+			(seat.Status == domain.SeatStatusHeld && now.After(seat.HoldExpiry)) {
+			freeSegments[seat.SeatID]++
+			details[seat.SeatID] = seat
+		}
+	}
+
+	var available []domain.SeatInventory
+	for seatID, count := range freeSegments {
+		if count == len(segmentRange) {
+			available = append(available, details[seatID])
+		}
+	}
+	sort.Slice(available, func(i, j int) bool { return seatLess(available[i], available[j]) })
+	return available
+}
+
+func groupSeatRows(seats []domain.SeatInventory) []seatRowGroup {
+	type rowKey struct {
+		sectionID string
+		row       int
+	}
+	index := make(map[rowKey]int)
+	var rows []seatRowGroup
+	for _, seat := range seats {
+		if seat.Row <= 0 || seat.Column <= 0 {
+			continue // No geometry, only usable by the scattered fallback
+		}
+		key := rowKey{seat.SectionID, seat.Row}
+		i, ok := index[key]
+		if !ok {
+			i = len(rows)
+			index[key] = i
+			rows = append(rows, seatRowGroup{sectionID: seat.SectionID, row: seat.Row})
+		}
+		rows[i].seats = append(rows[i].seats, seat)
+	}
+
+	for i := range rows {
+		sort.Slice(rows[i].seats, func(a, b int) bool { return rows[i].seats[a].Column < rows[i].seats[b].Column })
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].sectionID != rows[j].sectionID {
+			return rows[i].sectionID < rows[j].sectionID
+		}
+		return rows[i].row < rows[j].row
+	})
+	return rows
+}
+
+// contiguousRuns splits a column-sorted row into runs of side-by-side seats
+func contiguousRuns(seats []domain.SeatInventory) [][]domain.SeatInventory {
+	var runs [][]domain.SeatInventory
+	start := 0
+	for i := 1; i <= len(seats); i++ {
+		if i == len(seats) || seats[i].Column != seats[i-1].Column+1 {
+			runs = append(runs, seats[start:i])
+			start = i
+		}
+	}
+	return runs
+}
+
+// longestRunNear returns the longest run, breaking ties by distance to the anchor column
+func longestRunNear(runs [][]domain.SeatInventory, anchor int) []domain.SeatInventory {
+	var best []domain.SeatInventory
+	for _, run := range runs {
+		if len(run) > len(best) ||
+			(len(run) == len(best) && abs(run[0].Column-anchor) < abs(best[0].Column-anchor)) {
+			best = run
+		}
+	}
+	return best
+}
+
+// closestStart picks where to take n seats from run so they line up with the anchor column
+func closestStart(run []domain.SeatInventory, n, anchor int) int {
+	if anchor == 0 {
+		return 0
+	}
+	best := 0
+	for start := 0; start+n <= len(run); start++ {
+		if abs(run[start].Column-anchor) < abs(run[best].Column-anchor) {
+			best = start
+		}
+	}
+	return best
+}
+
+func aisleColumns(layout *domain.SeatLayout) map[string]map[int]bool {
+	aisles := make(map[string]map[int]bool)
+	if layout == nil {
+		return aisles
+	}
+	for _, section := range layout.Sections {
+		cols := make(map[int]bool, len(section.AisleAfterColumns))
+		for _, c := range section.AisleAfterColumns {
+			cols[c] = true
+		}
+		aisles[section.SectionID] = cols
+	}
+	return aisles
+}
+
+func crossesAisle(block []domain.SeatInventory, aisleAfter map[int]bool) bool {
+	for _, seat := range block[:len(block)-1] {
+		if aisleAfter[seat.Column] {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesPosition(seat domain.SeatInventory, prefs SeatPreferences) bool {
+	return prefs.Position == "" || seat.SeatType == prefs.Position
+}
+
+func blockMatchesPosition(block []domain.SeatInventory, prefs SeatPreferences) bool {
+	for _, seat := range block {
+		if matchesPosition(seat, prefs) {
+			return true
+		}
+	}
+	return false
+}
+
+func seatLess(a, b domain.SeatInventory) bool {
+	if a.SectionID != b.SectionID {
+		return a.SectionID < b.SectionID
+	}
+	if a.Row != b.Row {
+		return a.Row < b.Row
+	}
+	if a.Column != b.Column {
+		return a.Column < b.Column
+	}
+	return a.SeatNumber < b.SeatNumber
+}
+
+func seatIDsOf(seats []domain.SeatInventory) []string {
+	ids := make([]string, 0, len(seats))
+	for _, seat := range seats {
+		ids = append(ids, seat.SeatID)
+	}
+	return ids
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
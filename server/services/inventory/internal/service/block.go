@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/kafka"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/google/uuid"
+)
+
+// SeatBlockResult reports which seats were blocked on each trip
+type SeatBlockResult struct {
+	BlockID      string // Schedule blocks only
+	BlockedCount int
+	Trips        []TripBlockResult
+}
+
+// TripBlockResult lists the seats blocked on one trip and those that could not be
+type TripBlockResult struct {
+	TripID         string
+	BlockedSeatIDs []string
+	FailedSeatIDs  []string // Held, booked or already blocked on a requested segment
+}
+
+// BlockSeats takes seats out of sale on one trip, or on every trip of a schedule.
+// A schedule block is stored so trips generated later are blocked as well.
+func (s *InventoryService) BlockSeats(ctx context.Context, block *domain.SeatBlock) (*SeatBlockResult, error) {
+	if len(block.SeatIDs) == 0 && len(block.SeatNumbers) == 0 {
+		return nil, domain.ErrNoSeatsSelected
+	}
+	block.CreatedAt = time.Now()
+
+	var tripIDs []string
+	result := &SeatBlockResult{}
+	switch {
+	case block.ScheduleID != "":
+		block.BlockID = uuid.New().String()
+		if err := s.scyllaRepo.SaveScheduleBlock(ctx, block); err != nil {
+			return nil, err
+		}
+		result.BlockID = block.BlockID
+
+		ids, err := s.scyllaRepo.ListScheduleTrips(ctx, block.OrganizationID, block.ScheduleID)
+		if err != nil {
+			return nil, err
+		}
+		tripIDs = ids
+	case block.TripID != "":
+		tripIDs = []string{block.TripID}
+	default:
+		return nil, domain.ErrBlockTargetRequired
+	}
+
+	for _, tripID := range tripIDs {
+		trip, err := s.blockTripSeats(ctx, block.OrganizationID, tripID, block)
+		if err != nil {
+			if block.ScheduleID == "" {
+				return nil, err
+			}
+			logger.Warn("Failed to apply schedule seat block", "trip_id", tripID, "block_id", block.BlockID, "error", err)
+			continue
+		}
+		result.BlockedCount += len(trip.BlockedSeatIDs)
+		result.Trips = append(result.Trips, *trip)
+	}
+
+	logger.Info("Blocked seats", "trip_id", block.TripID, "schedule_id", block.ScheduleID,
+		"blocked", result.BlockedCount, "blocked_by", block.BlockedBy, "reason", block.Reason)
+	return result, nil
+}
+
+// UnblockSeats returns blocked seats to sale. Trip unblocks select seats by ID or number;
+// schedule unblocks lift a stored block on every trip of the schedule.
+// block.BlockedBy names who is unblocking, for the seat ledger.
+// Returns the number of seats unblocked.
+func (s *InventoryService) UnblockSeats(ctx context.Context, block *domain.SeatBlock) (int, error) {
+	switch {
+	case block.ScheduleID != "":
+		stored, err := s.scyllaRepo.GetScheduleBlock(ctx, block.OrganizationID, block.ScheduleID, block.BlockID)
+		if err != nil {
+			return 0, err
+		}
+		if err := s.scyllaRepo.DeleteScheduleBlock(ctx, block.OrganizationID, block.ScheduleID, block.BlockID); err != nil {
+			return 0, err
+		}
+
+		tripIDs, err := s.scyllaRepo.ListScheduleTrips(ctx, block.OrganizationID, block.ScheduleID)
+		if err != nil {
+			return 0, err
+		}
+		total := 0
+		for _, tripID := range tripIDs {
+			n, err := s.unblockTripSeats(ctx, block.OrganizationID, tripID, stored, block.BlockedBy)
+			if err != nil {
+				logger.Warn("Failed to lift schedule seat block", "trip_id", tripID, "block_id", block.BlockID, "error", err)
+				continue
+			}
+			total += n
+		}
+		return total, nil
+	case block.TripID != "":
+		if len(block.SeatIDs) == 0 && len(block.SeatNumbers) == 0 {
+			return 0, domain.ErrNoSeatsSelected
+		}
+		return s.unblockTripSeats(ctx, block.OrganizationID, block.TripID, block, block.BlockedBy)
+	default:
+		return 0, domain.ErrBlockTargetRequired
+	}
+}
+
+// applyScheduleBlocks blocks seats on a newly generated trip for every active schedule block
+func (s *InventoryService) applyScheduleBlocks(ctx context.Context, orgID, scheduleID, tripID string) error {
+	if err := s.scyllaRepo.SaveScheduleTrip(ctx, orgID, scheduleID, tripID); err != nil {
+		return err
+	}
+
+	blocks, err := s.scyllaRepo.ListScheduleBlocks(ctx, orgID, scheduleID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for i := range blocks {
+		if !blocks[i].IsActive(now) {
+			continue
+		}
+		if _, err := s.blockTripSeats(ctx, orgID, tripID, &blocks[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockTripSeats blocks the selected seats on every requested segment of one trip.
+// Each seat is blocked on all segments or none: a seat that is not available on one
+// segment is rolled back and reported as failed, while the other seats are still blocked.
+func (s *InventoryService) blockTripSeats(ctx context.Context, orgID, tripID string, block *domain.SeatBlock) (*TripBlockResult, error) {
+	segmentIndexes, seatIDs, err := s.selectBlockSeats(ctx, orgID, tripID, block)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TripBlockResult{TripID: tripID}
+	for _, seatID := range seatIDs {
+		var done []int
+		for _, segIdx := range segmentIndexes {
+			applied, err := s.scyllaRepo.BlockSeat(ctx, orgID, tripID, segIdx, seatID, block.Reason, block.BlockedBy, block.UnblockAt)
+			if err != nil {
+				logger.Warn("Failed to block seat", "trip_id", tripID, "seat_id", seatID, "segment", segIdx, "error", err)
+			}
+			if err != nil || !applied {
+				break
+			}
+			done = append(done, segIdx)
+		}
+		if len(done) == len(segmentIndexes) {
+			result.BlockedSeatIDs = append(result.BlockedSeatIDs, seatID)
+			continue
+		}
+		for _, segIdx := range done {
+			if _, err := s.scyllaRepo.UnblockSeat(ctx, orgID, tripID, segIdx, seatID); err != nil {
+				logger.Warn("Failed to roll back partial seat block", "trip_id", tripID, "seat_id", seatID, "error", err)
+			}
+		}
+		result.FailedSeatIDs = append(result.FailedSeatIDs, seatID)
+	}
+
+	// Requested IDs that do not exist on the trip cannot be blocked either
+	found := make(map[string]bool, len(seatIDs))
+	for _, seatID := range seatIDs {
+		found[seatID] = true
+	}
+	for _, seatID := range block.SeatIDs {
+		if !found[seatID] {
+			result.FailedSeatIDs = append(result.FailedSeatIDs, seatID)
+		}
+	}
+
+	if len(result.BlockedSeatIDs) > 0 {
+		blocked := make(map[int][]string, len(segmentIndexes))
+		for _, segIdx := range segmentIndexes {
+			blocked[segIdx] = result.BlockedSeatIDs
+		}
+		s.moveAvailability(ctx, orgID, tripID, blocked, -1)
+		s.recordTransitions(ctx, orgID, tripID, blocked, domain.SeatStatusAvailable, domain.SeatStatusBlocked,
+			ledgerEntry{Actor: block.BlockedBy, Reason: block.Reason})
+		s.redisRepo.InvalidateSeatMap(ctx, orgID, tripID)
+		s.publishSeatEvent(ctx, kafka.EventSeatsBlocked, tripID, result.BlockedSeatIDs, "BLOCKED")
+		if !block.UnblockAt.IsZero() {
+			if err := s.redisRepo.TrackBlockTrip(ctx, orgID, tripID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// unblockTripSeats returns the selected blocked seats on one trip to sale, recording actor in the seat ledger
+func (s *InventoryService) unblockTripSeats(ctx context.Context, orgID, tripID string, block *domain.SeatBlock, actor string) (int, error) {
+	segmentIndexes, seatIDs, err := s.selectBlockSeats(ctx, orgID, tripID, block)
+	if err != nil {
+		return 0, err
+	}
+
+	var unblocked []string
+	returned := make(map[int][]string)
+	defer func() {
+		s.moveAvailability(ctx, orgID, tripID, returned, 1)
+		s.recordTransitions(ctx, orgID, tripID, returned, domain.SeatStatusBlocked, domain.SeatStatusAvailable,
+			ledgerEntry{Actor: actor, Reason: "unblocked"})
+	}()
+	for _, seatID := range seatIDs {
+		released := false
+		for _, segIdx := range segmentIndexes {
+			applied, err := s.scyllaRepo.UnblockSeat(ctx, orgID, tripID, segIdx, seatID)
+			if err != nil {
+				return len(unblocked), err
+			}
+			if applied {
+				returned[segIdx] = append(returned[segIdx], seatID)
+			}
+			released = released || applied
+		}
+		if released {
+			unblocked = append(unblocked, seatID)
+		}
+	}
+
+	if len(unblocked) > 0 {
+		s.redisRepo.InvalidateSeatMap(ctx, orgID, tripID)
+		s.publishSeatEvent(ctx, kafka.EventSeatsReleased, tripID, unblocked, "AVAILABLE")
+		s.triggerWaitlist(orgID, tripID)
+	}
+	return len(unblocked), nil
+}
+
+// selectBlockSeats resolves a block to the trip's segments and the IDs of the seats it covers
+func (s *InventoryService) selectBlockSeats(ctx context.Context, orgID, tripID string, block *domain.SeatBlock) ([]int, []string, error) {
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	requested := make(map[int]bool, len(block.SegmentIndexes))
+	for _, idx := range block.SegmentIndexes {
+		requested[idx] = true
+	}
+	var segmentIndexes []int
+	for _, seg := range segments {
+		if len(requested) == 0 || requested[seg.SegmentIndex] {
+			segmentIndexes = append(segmentIndexes, seg.SegmentIndex)
+		}
+	}
+	if len(segmentIndexes) == 0 {
+		return nil, nil, nil
+	}
+
+	seats, err := s.scyllaRepo.GetSeatAvailability(ctx, orgID, tripID, segmentIndexes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byID := make(map[string]bool, len(block.SeatIDs))
+	for _, id := range block.SeatIDs {
+		byID[id] = true
+	}
+	byNumber := make(map[string]bool, len(block.SeatNumbers))
+	for _, num := range block.SeatNumbers {
+		byNumber[num] = true
+	}
+
+	matched := make(map[string]bool)
+	var seatIDs []string
+	for _, seat := range seats {
+		if matched[seat.SeatID] || !(byID[seat.SeatID] || byNumber[seat.SeatNumber]) {
+			continue
+		}
+		matched[seat.SeatID] = true
+		seatIDs = append(seatIDs, seat.SeatID)
+	}
+	sort.Strings(seatIDs)
+	return segmentIndexes, seatIDs, nil
+}
+
+// ProcessExpiredBlocks returns seats to sale once their auto-unblock time passes
+func (s *InventoryService) ProcessExpiredBlocks(ctx context.Context) error {
+	trips, err := s.redisRepo.ListBlockTrips(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, trip := range trips {
+		if err := s.releaseExpiredBlocks(ctx, trip.OrganizationID, trip.TripID); err != nil {
+			logger.Warn("Failed to release expired seat blocks", "trip_id", trip.TripID, "error", err)
+		}
+	}
+	return nil
+}
+
+// releaseExpiredBlocks unblocks seats on one trip whose block has run out.
+// The trip stops being checked once no timed block remains on it.
+func (s *InventoryService) releaseExpiredBlocks(ctx context.Context, orgID, tripID string) error {
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		return err
+	}
+	segmentIndexes := make([]int, 0, len(segments))
+	for _, seg := range segments {
+		segmentIndexes = append(segmentIndexes, seg.SegmentIndex)
+	}
+
+	seats, err := s.scyllaRepo.GetSeatAvailability(ctx, orgID, tripID, segmentIndexes)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	pending := false
+	released := make(map[string]bool)
+	returned := make(map[int][]string)
+	defer func() {
+		s.moveAvailability(ctx, orgID, tripID, returned, 1)
+		s.recordTransitions(ctx, orgID, tripID, returned, domain.SeatStatusBlocked, domain.SeatStatusAvailable,
+			ledgerEntry{Actor: domain.ActorSeatUnblocker, Reason: "block expired"})
+	}()
+	var seatIDs []string
+	for _, seat := range seats {
+		if seat.Status != domain.SeatStatusBlocked || seat.BlockedUntil.IsZero() {
+			continue
+		}
+		if now.Before(seat.BlockedUntil) {
+			pending = true
+			continue
+		}
+
+		applied, err := s.scyllaRepo.ReleaseExpiredBlock(ctx, orgID, tripID, seat.SegmentIndex, seat.SeatID, seat.BlockedUntil)
+		if err != nil {
+			return err
+		}
+		if applied {
+			returned[seat.SegmentIndex] = append(returned[seat.SegmentIndex], seat.SeatID)
+		}
+		if applied && !released[seat.SeatID] {
+			released[seat.SeatID] = true
+			seatIDs = append(seatIDs, seat.SeatID)
+		}
+	}
+
+	if len(seatIDs) > 0 {
+		s.redisRepo.InvalidateSeatMap(ctx, orgID, tripID)
+		s.publishSeatEvent(ctx, kafka.EventSeatsReleased, tripID, seatIDs, "AVAILABLE")
+		s.triggerWaitlist(orgID, tripID)
+		logger.Info("Released expired seat blocks", "trip_id", tripID, "seats", len(seatIDs))
+	}
+	if !pending {
+		return s.redisRepo.UntrackBlockTrip(ctx, orgID, tripID)
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/kafka"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+)
+
+// CapacityAvailability is how many unnumbered places of one class are free for a journey
+type CapacityAvailability struct {
+	CapacityClass string
+	MaxCount      int
+	Available     int // Free on every segment of the journey
+	PricePaisa    int64
+}
+
+// CapacityDef sells unnumbered places of one class by count on every segment
+type CapacityDef struct {
+	CapacityClass string
+	MaxCount      int
+	PricePaisa    int64
+}
+
+// configureCapacity creates the per-segment counters for a trip's capacity classes
+func (s *InventoryService) configureCapacity(ctx context.Context, orgID, tripID string, segments []domain.Segment, defs []CapacityDef) error {
+	var classes []domain.CapacityInventory
+	for _, def := range defs {
+		if def.CapacityClass == "" || def.MaxCount <= 0 {
+			continue
+		}
+		classes = append(classes, domain.CapacityInventory{
+			CapacityClass: def.CapacityClass,
+			MaxCount:      def.MaxCount,
+			PricePaisa:    def.PricePaisa,
+		})
+	}
+	if len(classes) == 0 {
+		return nil
+	}
+
+	if err := s.scyllaRepo.InitializeCapacity(ctx, orgID, tripID, segments, classes); err != nil {
+		return err
+	}
+	s.publishCapacityEvent(ctx, orgID, tripID)
+	return nil
+}
+
+// capacityAvailability reports, per class, the places free on every segment of the range.
+// A place is only sellable for a journey when no segment the journey crosses is full.
+func (s *InventoryService) capacityAvailability(ctx context.Context, orgID, tripID string, segmentRange []int) ([]CapacityAvailability, error) {
+	rows, err := s.scyllaRepo.GetCapacity(ctx, orgID, tripID, segmentRange)
+	if err != nil {
+		return nil, err
+	}
+
+	byClass := make(map[string]*CapacityAvailability)
+	segmentsSeen := make(map[string]int)
+	for _, row := range rows {
+		avail, ok := byClass[row.CapacityClass]
+		if !ok {
+			avail = &CapacityAvailability{
+				CapacityClass: row.CapacityClass,
+				MaxCount:      row.MaxCount,
+				Available:     row.Available(),
+				PricePaisa:    row.PricePaisa,
+			}
+			byClass[row.CapacityClass] = avail
+		}
+		avail.MaxCount = min(avail.MaxCount, row.MaxCount)
+		avail.Available = min(avail.Available, row.Available())
+		segmentsSeen[row.CapacityClass]++
+	}
+
+	result := make([]CapacityAvailability, 0, len(byClass))
+	for class, avail := range byClass {
+		// A class missing on one of the segments cannot carry the journey
+		if segmentsSeen[class] < len(segmentRange) {
+			avail.Available = 0
+		}
+		result = append(result, *avail)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CapacityClass < result[j].CapacityClass })
+	return result, nil
+}
+
+// capacityCovers reports whether every requested item fits in the free places
+func capacityCovers(availability []CapacityAvailability, items []domain.CapacityItem) bool {
+	free := make(map[string]int, len(availability))
+	for _, avail := range availability {
+		free[avail.CapacityClass] = avail.Available
+	}
+	for _, item := range items {
+		if free[item.CapacityClass] < item.Quantity {
+			return false
+		}
+	}
+	return true
+}
+
+// validateCapacityItems rejects nameless or negative items and merges repeated classes
+func validateCapacityItems(items []domain.CapacityItem) ([]domain.CapacityItem, error) {
+	for _, item := range items {
+		if item.CapacityClass == "" || item.Quantity < 0 {
+			return nil, domain.ErrInvalidCapacityItem
+		}
+	}
+	return domain.MergeCapacityItems(items), nil
+}
+
+// holdCapacity takes the requested places on every segment of the range.
+// The hold records are written before the counts move, so the sweeper knows about every place taken.
+// Nothing stays taken when any class or segment runs short.
+func (s *InventoryService) holdCapacity(ctx context.Context, orgID, tripID, holdID string, items []domain.CapacityItem, segmentRange []int, expiresAt time.Time) error {
+	type taken struct {
+		class    string
+		quantity int
+		segIdx   int
+	}
+	var done []taken
+	undo := func() {
+		for _, t := range done {
+			if _, err := s.scyllaRepo.AdjustCapacity(ctx, orgID, tripID, t.segIdx, t.class, -t.quantity, 0); err != nil {
+				logger.Warn("Failed to roll back capacity", "trip_id", tripID, "class", t.class, "segment", t.segIdx, "error", err)
+			}
+		}
+		for _, item := range items {
+			s.scyllaRepo.TransitionCapacityHold(ctx, orgID, tripID, holdID, item.CapacityClass,
+				domain.CapacityHoldHeld, domain.CapacityHoldReleased, "")
+		}
+	}
+
+	for _, item := range items {
+		record := domain.CapacityHold{
+			HoldID:        holdID,
+			CapacityClass: item.CapacityClass,
+			Quantity:      item.Quantity,
+			SegmentRange:  segmentRange,
+			Status:        domain.CapacityHoldHeld,
+			HoldExpiry:    expiresAt,
+		}
+		if err := s.scyllaRepo.SaveCapacityHold(ctx, orgID, tripID, record); err != nil {
+			undo()
+			return err
+		}
+	}
+
+	for _, item := range items {
+		for _, segIdx := range segmentRange {
+			ok, err := s.scyllaRepo.AdjustCapacity(ctx, orgID, tripID, segIdx, item.CapacityClass, item.Quantity, 0)
+			if err != nil {
+				undo()
+				return err
+			}
+			if !ok {
+				undo()
+				return domain.ErrInsufficientCapacity
+			}
+			done = append(done, taken{item.CapacityClass, item.Quantity, segIdx})
+		}
+	}
+	return nil
+}
+
+// releaseCapacity returns a hold's places to the pool and closes its records with toStatus.
+// Records already confirmed, released or expired are left alone.
+func (s *InventoryService) releaseCapacity(ctx context.Context, orgID, tripID, holdID string, items []domain.CapacityItem, toStatus string) error {
+	records, err := s.capacityHoldRecords(ctx, orgID, tripID, holdID, items)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		won, err := s.scyllaRepo.TransitionCapacityHold(ctx, orgID, tripID, holdID, record.CapacityClass,
+			domain.CapacityHoldHeld, toStatus, "")
+		if err != nil {
+			return err
+		}
+		if !won {
+			continue
+		}
+		for _, segIdx := range record.SegmentRange {
+			if _, err := s.scyllaRepo.AdjustCapacity(ctx, orgID, tripID, segIdx, record.CapacityClass, -record.Quantity, 0); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// confirmCapacity moves a hold's places from held to booked
+func (s *InventoryService) confirmCapacity(ctx context.Context, orgID, tripID, holdID, bookingID string, items []domain.CapacityItem) error {
+	records, err := s.capacityHoldRecords(ctx, orgID, tripID, holdID, items)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		won, err := s.scyllaRepo.TransitionCapacityHold(ctx, orgID, tripID, holdID, record.CapacityClass,
+			domain.CapacityHoldHeld, domain.CapacityHoldBooked, bookingID)
+		if err != nil {
+			return err
+		}
+		if !won {
+			// The sweeper got there first
+			return domain.ErrHoldExpired
+		}
+		for _, segIdx := range record.SegmentRange {
+			if _, err := s.scyllaRepo.AdjustCapacity(ctx, orgID, tripID, segIdx, record.CapacityClass, -record.Quantity, record.Quantity); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// cancelCapacity returns booked places to the pool
+func (s *InventoryService) cancelCapacity(ctx context.Context, orgID, tripID string, items []domain.CapacityItem, segmentRange []int) error {
+	for _, item := range items {
+		for _, segIdx := range segmentRange {
+			if _, err := s.scyllaRepo.AdjustCapacity(ctx, orgID, tripID, segIdx, item.CapacityClass, 0, -item.Quantity); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// extendCapacity moves the expiry of a hold's capacity records
+func (s *InventoryService) extendCapacity(ctx context.Context, orgID, tripID, holdID string, items []domain.CapacityItem, expiresAt time.Time) error {
+	for _, item := range items {
+		if err := s.scyllaRepo.ExtendCapacityHold(ctx, orgID, tripID, holdID, item.CapacityClass, expiresAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// capacityHoldRecords returns the stored records of one hold's classes
+func (s *InventoryService) capacityHoldRecords(ctx context.Context, orgID, tripID, holdID string, items []domain.CapacityItem) ([]domain.CapacityHold, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	all, err := s.scyllaRepo.ListCapacityHolds(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(items))
+	for _, item := range items {
+		wanted[item.CapacityClass] = true
+	}
+	var records []domain.CapacityHold
+	for _, record := range all {
+		if record.HoldID == holdID && wanted[record.CapacityClass] {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// sweepCapacityHolds returns the places of lapsed capacity holds on a trip.
+// Reports the lapsed hold IDs and whether any capacity hold is still live.
+func (s *InventoryService) sweepCapacityHolds(ctx context.Context, orgID, tripID string) ([]string, bool, error) {
+	records, err := s.scyllaRepo.ListCapacityHolds(ctx, orgID, tripID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var expired []string
+	seen := make(map[string]bool)
+	pending := false
+	now := time.Now()
+	for _, record := range records {
+		if record.Status != domain.CapacityHoldHeld {
+			continue
+		}
+		if now.Before(record.HoldExpiry) {
+			pending = true
+			continue
+		}
+
+		item := []domain.CapacityItem{{CapacityClass: record.CapacityClass, Quantity: record.Quantity}}
+		if err := s.releaseCapacity(ctx, orgID, tripID, record.HoldID, item, domain.CapacityHoldExpired); err != nil {
+			return nil, pending, err
+		}
+		if !seen[record.HoldID] {
+			seen[record.HoldID] = true
+			expired = append(expired, record.HoldID)
+		}
+	}
+
+	if len(expired) > 0 {
+		s.publishCapacityEvent(ctx, orgID, tripID)
+	}
+	return expired, pending, nil
+}
+
+// publishCapacityEvent announces the trip's remaining places per class over the whole route
+func (s *InventoryService) publishCapacityEvent(ctx context.Context, orgID, tripID string) {
+	if s.kafkaProducer == nil {
+		return
+	}
+
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		logger.Warn("Failed to load segments for capacity event", "trip_id", tripID, "error", err)
+		return
+	}
+	segmentIndexes := make([]int, 0, len(segments))
+	for _, seg := range segments {
+		segmentIndexes = append(segmentIndexes, seg.SegmentIndex)
+	}
+
+	availability, err := s.capacityAvailability(ctx, orgID, tripID, segmentIndexes)
+	if err != nil {
+		logger.Warn("Failed to load capacity for capacity event", "trip_id", tripID, "error", err)
+		return
+	}
+
+	capacity := make([]map[string]interface{}, 0, len(availability))
+	for _, avail := range availability {
+		capacity = append(capacity, map[string]interface{}{
+			"capacity_class": avail.CapacityClass,
+			"max_count":      avail.MaxCount,
+			"available":      avail.Available,
+			"price_paisa":    avail.PricePaisa,
+		})
+	}
+
+	s.publishEvent(ctx, kafka.EventCapacityUpdated, tripID, map[string]interface{}{
+		"trip_id":         tripID,
+		"organization_id": orgID,
+		"capacity":        capacity,
+		"updated_at":      time.Now(),
+	})
+}
@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/kafka"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+)
+
+// AvailabilityQuery asks how many seats one trip has free between two stations
+type AvailabilityQuery struct {
+	OrganizationID string
+	TripID         string
+	FromStationID  string // Empty with ToStationID: the whole route
+	ToStationID    string
+}
+
+// ClassAvailability is the number of free seats of one class for a journey
+type ClassAvailability struct {
+	SeatClass string
+	Available int
+}
+
+// AvailabilitySummary answers one AvailabilityQuery from the availability counters.
+// Each class counts the fewest seats free on any segment of the journey, which can
+// overstate multi-segment journeys; CheckAvailability gives the exact seats.
+type AvailabilitySummary struct {
+	AvailabilityQuery
+	AvailableSeats int
+	Classes        []ClassAvailability
+	Capacity       []CapacityAvailability
+	Err            error // Unknown trip or stations; the other queries still answer
+}
+
+// GetAvailabilityCounts answers many availability queries without scanning seat_inventory
+func (s *InventoryService) GetAvailabilityCounts(ctx context.Context, queries []AvailabilityQuery) []AvailabilitySummary {
+	segmentsByTrip := make(map[string][]domain.Segment)
+	results := make([]AvailabilitySummary, 0, len(queries))
+	for _, q := range queries {
+		summary := AvailabilitySummary{AvailabilityQuery: q}
+
+		key := q.OrganizationID + ":" + q.TripID
+		segments, ok := segmentsByTrip[key]
+		if !ok {
+			var err error
+			segments, err = s.scyllaRepo.GetSegments(ctx, q.OrganizationID, q.TripID)
+			if err != nil {
+				summary.Err = err
+				results = append(results, summary)
+				continue
+			}
+			segmentsByTrip[key] = segments
+		}
+
+		segmentRange, err := journeySegments(segments, q.FromStationID, q.ToStationID)
+		if err != nil {
+			summary.Err = err
+			results = append(results, summary)
+			continue
+		}
+
+		counts, err := s.scyllaRepo.GetAvailabilityCounts(ctx, q.OrganizationID, q.TripID, segmentRange)
+		if err != nil {
+			summary.Err = err
+			results = append(results, summary)
+			continue
+		}
+		summary.Classes, summary.AvailableSeats = summarizeAvailability(counts, segmentRange)
+
+		summary.Capacity, err = s.capacityAvailability(ctx, q.OrganizationID, q.TripID, segmentRange)
+		if err != nil {
+			summary.Err = err
+		}
+		results = append(results, summary)
+	}
+	return results
+}
+
+// journeySegments returns the segments between two stations, or the whole route when both are empty
+func journeySegments(segments []domain.Segment, fromStation, toStation string) ([]int, error) {
+	if len(segments) == 0 {
+		return nil, domain.ErrTripInventoryNotFound
+	}
+	if fromStation == "" && toStation == "" {
+		indexes := make([]int, 0, len(segments))
+		for _, seg := range segments {
+			indexes = append(indexes, seg.SegmentIndex)
+		}
+		return indexes, nil
+	}
+	return domain.CalculateSegmentRange(extractStationOrder(segments), fromStation, toStation)
+}
+
+// summarizeAvailability takes, per class, the fewest free seats on any segment of the range.
+// A class missing from a segment has no free seats there.
+func summarizeAvailability(counts []domain.AvailabilityCount, segmentRange []int) ([]ClassAvailability, int) {
+	bySegment := make(map[string]map[int]int)
+	for _, c := range counts {
+		if bySegment[c.SeatClass] == nil {
+			bySegment[c.SeatClass] = make(map[int]int)
+		}
+		bySegment[c.SeatClass][c.SegmentIndex] = c.Available
+	}
+
+	classes := make([]ClassAvailability, 0, len(bySegment))
+	total := 0
+	for class, segs := range bySegment {
+		free := -1
+		for _, segIdx := range segmentRange {
+			n := max(segs[segIdx], 0)
+			if free < 0 || n < free {
+				free = n
+			}
+		}
+		free = max(free, 0)
+		classes = append(classes, ClassAvailability{SeatClass: class, Available: free})
+		total += free
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i].SeatClass < classes[j].SeatClass })
+	return classes, total
+}
+
+// seatGroupSegments lists a hold's or booking's seats by segment
+func seatGroupSegments(groups []domain.SeatGroup) map[int][]string {
+	bySegment := make(map[int][]string)
+	for _, group := range groups {
+		for _, segIdx := range group.SegmentRange {
+			bySegment[segIdx] = append(bySegment[segIdx], group.SeatIDs...)
+		}
+	}
+	return bySegment
+}
+
+// moveAvailability moves the availability counters for seats leaving sale (delta -1: held,
+// blocked) or returning to it (delta +1: released, cancelled, unblocked). Confirming a hold
+// leaves them alone, since held seats are already off sale.
+// The counters only feed listings, so a failed update is logged rather than failing the
+// seat change; the hold sweeper reconciles them from the seat rows.
+func (s *InventoryService) moveAvailability(ctx context.Context, orgID, tripID string, bySegment map[int][]string, delta int) {
+	if len(bySegment) == 0 {
+		return
+	}
+
+	// Seat classes are the same on every segment, so one partition answers for all
+	firstSeg := -1
+	seen := make(map[string]bool)
+	var seatIDs []string
+	for segIdx, ids := range bySegment {
+		if firstSeg < 0 || segIdx < firstSeg {
+			firstSeg = segIdx
+		}
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				seatIDs = append(seatIDs, id)
+			}
+		}
+	}
+	if len(seatIDs) == 0 {
+		return
+	}
+
+	classes, err := s.scyllaRepo.GetSeatClasses(ctx, orgID, tripID, firstSeg, seatIDs)
+	if err != nil {
+		logger.Warn("Failed to load seat classes for availability counters", "trip_id", tripID, "error", err)
+		return
+	}
+
+	type counterKey struct {
+		segIdx int
+		class  string
+	}
+	sums := make(map[counterKey]int)
+	for segIdx, ids := range bySegment {
+		for _, id := range ids {
+			if class, ok := classes[id]; ok {
+				sums[counterKey{segIdx, class}] += delta
+			}
+		}
+	}
+	deltas := make([]domain.AvailabilityCount, 0, len(sums))
+	for key, n := range sums {
+		deltas = append(deltas, domain.AvailabilityCount{SegmentIndex: key.segIdx, SeatClass: key.class, Available: n})
+	}
+
+	if err := s.scyllaRepo.AddAvailabilityCounts(ctx, orgID, tripID, deltas); err != nil {
+		logger.Warn("Failed to update availability counters", "trip_id", tripID, "error", err)
+		return
+	}
+	s.publishAvailabilityEvent(ctx, orgID, tripID)
+}
+
+// reconcileAvailability sets the availability counters to what the seat rows show.
+// Seats held past their expiry count as free, as CheckAvailability treats them.
+// Counters are not idempotent, so drift from lost or repeated updates is corrected here.
+func (s *InventoryService) reconcileAvailability(ctx context.Context, orgID, tripID string, seats []domain.SeatInventory, segmentIndexes []int) error {
+	current, err := s.scyllaRepo.GetAvailabilityCounts(ctx, orgID, tripID, segmentIndexes)
+	if err != nil {
+		return err
+	}
+
+	type counterKey struct {
+		segIdx int
+		class  string
+	}
+	want := make(map[counterKey]int)
+	now := time.Now()
+	for _, seat := range seats {
+		key := counterKey{seat.SegmentIndex, seat.SeatClass}
+		free := seat.Status == domain.SeatStatusAvailable ||
+			(seat.Status == domain.SeatStatusHeld && !now.Before(seat.HoldExpiry))
+		if free {
+			want[key]++
+		} else if _, ok := want[key]; !ok {
+			want[key] = 0
+		}
+	}
+
+	var deltas []domain.AvailabilityCount
+	for _, c := range current {
+		key := counterKey{c.SegmentIndex, c.SeatClass}
+		if diff := want[key] - c.Available; diff != 0 {
+			deltas = append(deltas, domain.AvailabilityCount{SegmentIndex: c.SegmentIndex, SeatClass: c.SeatClass, Available: diff})
+		}
+		delete(want, key)
+	}
+	for key, n := range want {
+		if n != 0 {
+			deltas = append(deltas, domain.AvailabilityCount{SegmentIndex: key.segIdx, SeatClass: key.class, Available: n})
+		}
+	}
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	if err := s.scyllaRepo.AddAvailabilityCounts(ctx, orgID, tripID, deltas); err != nil {
+		return err
+	}
+	s.publishAvailabilityEvent(ctx, orgID, tripID)
+	return nil
+}
+
+// publishAvailabilityEvent announces the trip's free seats per class over the whole route
+func (s *InventoryService) publishAvailabilityEvent(ctx context.Context, orgID, tripID string) {
+	if s.kafkaProducer == nil {
+		return
+	}
+
+	summary := s.GetAvailabilityCounts(ctx, []AvailabilityQuery{{OrganizationID: orgID, TripID: tripID}})[0]
+	if summary.Err != nil {
+		logger.Warn("Failed to load availability for availability event", "trip_id", tripID, "error", summary.Err)
+		return
+	}
+
+	classes := make([]map[string]interface{}, 0, len(summary.Classes))
+	for _, class := range summary.Classes {
+		classes = append(classes, map[string]interface{}{
+			"seat_class": class.SeatClass,
+			"available":  class.Available,
+		})
+	}
+
+	s.publishEvent(ctx, kafka.EventAvailabilityUpdated, tripID, map[string]interface{}{
+		"trip_id":         tripID,
+		"organization_id": orgID,
+		"available_seats": summary.AvailableSeats,
+		"classes":         classes,
+		"updated_at":      time.Now(),
+	})
+}
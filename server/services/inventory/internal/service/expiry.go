@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/kafka"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/google/uuid"
+)
+
+// holdSweepLockTTL bounds how long one instance may sweep a trip before another can
+const holdSweepLockTTL = 30 * time.Second
+
+// SweepExpiredHolds releases lapsed holds on every trip with seats in held state
+func (s *InventoryService) SweepExpiredHolds(ctx context.Context) error {
+	trips, err := s.redisRepo.ListHoldTrips(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, trip := range trips {
+		if err := s.sweepTripHolds(ctx, trip.OrganizationID, trip.TripID); err != nil {
+			logger.Warn("Failed to sweep expired holds", "trip_id", trip.TripID, "error", err)
+		}
+	}
+	return nil
+}
+
+// sweepTripHolds finds seats whose hold expired without being confirmed or released,
+// returns them to the pool, marks the hold records expired and publishes release events.
+// Lapsed capacity holds give their places back the same way, and the availability
+// counters are reconciled with the scanned seats.
+// The trip stops being swept once nothing on it is held. Every instance runs the sweeper,
+// so a trip is swept by one of them at a time.
+func (s *InventoryService) sweepTripHolds(ctx context.Context, orgID, tripID string) error {
+	owner := uuid.New().String()
+	acquired, err := s.redisRepo.AcquireHoldSweepLock(ctx, orgID, tripID, owner, holdSweepLockTTL)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		return nil // Another instance is sweeping this trip
+	}
+	defer s.redisRepo.ReleaseHoldSweepLock(context.Background(), orgID, tripID, owner)
+
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		return err
+	}
+	segmentIndexes := make([]int, 0, len(segments))
+	for _, seg := range segments {
+		segmentIndexes = append(segmentIndexes, seg.SegmentIndex)
+	}
+
+	seats, err := s.scyllaRepo.GetSeatAvailability(ctx, orgID, tripID, segmentIndexes)
+	if err != nil {
+		return err
+	}
+
+	// hold ID -> segment -> seats; split-seat holds cover different segments per seat
+	expired := make(map[string]map[int][]string)
+	pending := false
+	now := time.Now()
+	for _, seat := range seats {
+		if seat.Status != domain.SeatStatusHeld {
+			continue
+		}
+		if now.Before(seat.HoldExpiry) {
+			pending = true
+			continue
+		}
+		if expired[seat.HoldID] == nil {
+			expired[seat.HoldID] = make(map[int][]string)
+		}
+		expired[seat.HoldID][seat.SegmentIndex] = append(expired[seat.HoldID][seat.SegmentIndex], seat.SeatID)
+	}
+
+	// Holds extended, confirmed or re-held since the scan are left as they are
+	releasedAny := false
+	raced := false
+	markedExpired := make(map[string]bool)
+	for holdID, bySegment := range expired {
+		var seatIDs []string
+		seen := make(map[string]bool)
+		released := make(map[int][]string)
+		for segIdx, segSeats := range bySegment {
+			// Conditional on the hold still being lapsed, so a concurrent re-hold is never undone
+			applied, err := s.scyllaRepo.ReleaseExpiredHolds(ctx, orgID, tripID, segSeats, []int{segIdx})
+			if err != nil {
+				return err
+			}
+			if len(applied) == 0 {
+				raced = true
+				continue
+			}
+			released[segIdx] = segSeats
+			for _, seatID := range segSeats {
+				if !seen[seatID] {
+					seen[seatID] = true
+					seatIDs = append(seatIDs, seatID)
+				}
+			}
+		}
+		if len(released) == 0 {
+			continue
+		}
+		releasedAny = true
+
+		s.recordTransitions(ctx, orgID, tripID, released, domain.SeatStatusHeld, domain.SeatStatusAvailable,
+			ledgerEntry{Actor: domain.ActorHoldSweeper, HoldID: holdID, Reason: "hold expired"})
+
+		if holdID != "" && len(released) == len(bySegment) {
+			if err := s.holdRepo.MarkHoldExpired(ctx, orgID, holdID); err != nil {
+				logger.Warn("Failed to mark hold expired", "hold_id", holdID, "error", err)
+			}
+			markedExpired[holdID] = true
+		}
+
+		s.publishSeatEvent(ctx, kafka.EventSeatsReleased, tripID, seatIDs, "AVAILABLE")
+		logger.Info("Released expired hold", "trip_id", tripID, "hold_id", holdID, "seats", len(seatIDs))
+	}
+
+	// The scan is at hand, so correct any drift in the availability counters. A release that
+	// lost a race makes the scan stale; the next sweep reconciles instead.
+	if !raced {
+		if err := s.reconcileAvailability(ctx, orgID, tripID, seats, segmentIndexes); err != nil {
+			logger.Warn("Failed to reconcile availability counters", "trip_id", tripID, "error", err)
+		}
+	}
+
+	capacityExpired, capacityPending, err := s.sweepCapacityHolds(ctx, orgID, tripID)
+	if err != nil {
+		return err
+	}
+	for _, holdID := range capacityExpired {
+		if markedExpired[holdID] {
+			continue
+		}
+		if err := s.holdRepo.MarkHoldExpired(ctx, orgID, holdID); err != nil {
+			logger.Warn("Failed to mark hold expired", "hold_id", holdID, "error", err)
+		}
+	}
+
+	if releasedAny {
+		s.redisRepo.InvalidateSeatMap(ctx, orgID, tripID)
+		s.triggerWaitlist(orgID, tripID)
+	}
+	if !pending && !capacityPending && !raced {
+		return s.redisRepo.UntrackHoldTrip(ctx, orgID, tripID)
+	}
+	return nil
+}
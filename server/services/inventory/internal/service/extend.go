@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+)
+
+// MaxReviewHold bounds how long a hold is kept for a fraud review
+const MaxReviewHold = 24 * time.Hour
+
+// ExtendResult reports the outcome of a hold extension
+type ExtendResult struct {
+	Success             bool
+	ExpiresAt           time.Time
+	ExtensionsRemaining int
+	FailureReason       string
+}
+
+// ExtendHold pushes an active hold's expiry out by extendBy, or by the organization's
+// extension step when extendBy is zero. The new expiry is capped at the policy's maximum
+// hold lifetime; holds at the lifetime or extension limit are refused.
+func (s *InventoryService) ExtendHold(ctx context.Context, orgID, holdID, userID string, extendBy time.Duration) (*ExtendResult, error) {
+	hold, err := s.holdRepo.GetHold(ctx, orgID, holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify ownership
+	if hold.UserID != userID || hold.Status != domain.HoldStatusActive {
+		return nil, domain.ErrHoldNotFound
+	}
+
+	policy, err := s.GetHoldPolicy(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := policy.MaxExtensions - hold.ExtensionCount
+	if remaining <= 0 {
+		return &ExtendResult{
+			ExpiresAt:     hold.ExpiresAt,
+			FailureReason: domain.ErrHoldExtensionLimit.Error(),
+		}, nil
+	}
+
+	if extendBy <= 0 {
+		extendBy = policy.ExtensionStep
+	}
+	expiresAt := hold.ExpiresAt.Add(extendBy)
+	if deadline := hold.CreatedAt.Add(policy.MaxHoldLifetime); expiresAt.After(deadline) {
+		expiresAt = deadline
+	}
+	if !expiresAt.After(hold.ExpiresAt) {
+		return &ExtendResult{
+			ExpiresAt:           hold.ExpiresAt,
+			ExtensionsRemaining: remaining,
+			FailureReason:       domain.ErrHoldLifetimeExceeded.Error(),
+		}, nil
+	}
+
+	if err := s.moveHoldExpiry(ctx, orgID, hold, expiresAt); err != nil {
+		return nil, err
+	}
+	hold, err = s.holdRepo.ExtendHold(ctx, orgID, holdID, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateSeatMapAsync(orgID, hold.TripID)
+
+	logger.Info("Hold extended", "hold_id", holdID, "expires_at", expiresAt, "extensions", hold.ExtensionCount)
+
+	return &ExtendResult{
+		Success:             true,
+		ExpiresAt:           expiresAt,
+		ExtensionsRemaining: policy.MaxExtensions - hold.ExtensionCount,
+	}, nil
+}
+
+// ExtendHoldForReview keeps an active hold while its booking waits for a fraud analyst.
+// The hold runs until extendBy from now, at most MaxReviewHold. The customer is not the
+// one keeping the seats, so the hold policy's lifetime cap and extension count do not apply.
+func (s *InventoryService) ExtendHoldForReview(ctx context.Context, orgID, holdID, userID string, extendBy time.Duration) (*ExtendResult, error) {
+	hold, err := s.holdRepo.GetHold(ctx, orgID, holdID)
+	if err != nil {
+		return nil, err
+	}
+	if hold.UserID != userID || hold.Status != domain.HoldStatusActive {
+		return nil, domain.ErrHoldNotFound
+	}
+
+	if extendBy <= 0 || extendBy > MaxReviewHold {
+		extendBy = MaxReviewHold
+	}
+	expiresAt := time.Now().Add(extendBy)
+	if !expiresAt.After(hold.ExpiresAt) {
+		return &ExtendResult{Success: true, ExpiresAt: hold.ExpiresAt}, nil // Already held long enough
+	}
+
+	if err := s.moveHoldExpiry(ctx, orgID, hold, expiresAt); err != nil {
+		return nil, err
+	}
+	if _, err := s.holdRepo.SetHoldExpiry(ctx, orgID, holdID, expiresAt); err != nil {
+		return nil, err
+	}
+	s.invalidateSeatMapAsync(orgID, hold.TripID)
+
+	logger.Info("Hold kept for fraud review", "hold_id", holdID, "expires_at", expiresAt)
+	return &ExtendResult{Success: true, ExpiresAt: expiresAt}, nil
+}
+
+// moveHoldExpiry moves the expiry of a hold's seats and capacity. Scylla goes first: the
+// seat rows decide whether the hold is still alive.
+func (s *InventoryService) moveHoldExpiry(ctx context.Context, orgID string, hold *domain.SeatHold, expiresAt time.Time) error {
+	for _, group := range hold.SeatGroups() {
+		if err := s.scyllaRepo.ExtendHold(ctx, orgID, hold.TripID, hold.HoldID, group.SegmentRange, group.SeatIDs, expiresAt); err != nil {
+			return err
+		}
+	}
+	return s.extendCapacity(ctx, orgID, hold.TripID, hold.HoldID, hold.Capacity, expiresAt)
+}
+
+func (s *InventoryService) invalidateSeatMapAsync(orgID, tripID string) {
+	go func() {
+		bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		s.redisRepo.InvalidateSeatMap(bgCtx, orgID, tripID)
+	}()
+}
+
+// SetHoldPolicy stores an organization's hold limits; zero fields fall back to the defaults
+func (s *InventoryService) SetHoldPolicy(ctx context.Context, policy *domain.HoldPolicy) (*domain.HoldPolicy, error) {
+	defaults := domain.DefaultHoldPolicy(policy.OrganizationID)
+	if policy.MaxHoldLifetime <= 0 {
+		policy.MaxHoldLifetime = defaults.MaxHoldLifetime
+	}
+	if policy.MaxExtensions < 0 {
+		policy.MaxExtensions = 0
+	}
+	if policy.ExtensionStep <= 0 {
+		policy.ExtensionStep = defaults.ExtensionStep
+	}
+	policy.UpdatedAt = time.Now()
+
+	if err := s.scyllaRepo.SaveHoldPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// GetHoldPolicy returns the organization's hold limits, or the defaults when none are stored
+func (s *InventoryService) GetHoldPolicy(ctx context.Context, orgID string) (*domain.HoldPolicy, error) {
+	policy, err := s.scyllaRepo.GetHoldPolicy(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return domain.DefaultHoldPolicy(orgID), nil
+	}
+	return policy, nil
+}
@@ -21,6 +21,7 @@ type InventoryService struct {
 	holdRepo      *repository.HoldRepository
 	redisRepo     *repository.RedisRepository
 	kafkaProducer *kafka.Producer
+	allocator     SeatAllocator
 }
 
 func NewInventoryService(scyllaRepo *repository.ScyllaRepository, holdRepo *repository.HoldRepository, redisRepo *repository.RedisRepository, kafkaProducer *kafka.Producer) *InventoryService {
@@ -29,11 +30,20 @@ func NewInventoryService(scyllaRepo *repository.ScyllaRepository, holdRepo *repo
 		holdRepo:      holdRepo,
 		redisRepo:     redisRepo,
 		kafkaProducer: kafkaProducer,
+		allocator:     NewBestAvailableAllocator(),
 	}
 }
 
+// SetSeatAllocator replaces the strategy used for best-available holds
+func (s *InventoryService) SetSeatAllocator(allocator SeatAllocator) {
+	s.allocator = allocator
+}
+
 // CheckAvailability returns seat availability for a journey
-func (s *InventoryService) CheckAvailability(ctx context.Context, orgID, tripID, fromStation, toStation string, passengers int, seatClass string) (*AvailabilityResult, error) {
+// Quota seats count only when the caller is eligible for the quota.
+// With allowSeatChange, passengers no single seat can carry end to end get seat change plans.
+// Capacity items ask for deck or standing places on top of the seated passengers.
+func (s *InventoryService) CheckAvailability(ctx context.Context, orgID, tripID, fromStation, toStation string, passengers int, seatClass string, claims domain.QuotaClaims, allowSeatChange bool, capacityItems []domain.CapacityItem) (*AvailabilityResult, error) {
 	// Get segments for this trip
 	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
 	if err != nil {
@@ -56,6 +66,41 @@ func (s *InventoryService) CheckAvailability(ctx context.Context, orgID, tripID,
 	// Filter and aggregate availability
 	availableSeats := filterAvailableSeats(seats, segmentRange, seatClass)
 
+	// Hide quota seats the caller is not eligible for
+	restrictions, quotas, err := s.quotaRestrictions(ctx, orgID, tripID, segments, segmentRange)
+	if err != nil {
+		return nil, err
+	}
+	quotaSummary := summarizeQuotas(quotas, restrictions, availableSeats, claims)
+	sellable := availableSeats[:0]
+	for _, seat := range availableSeats {
+		if seatAllowed(restrictions[seat.SeatID], claims) {
+			sellable = append(sellable, seat)
+		}
+	}
+	availableSeats = sellable
+
+	// Split-seat journeys: cover the journey with seat changes when whole-journey seats run short
+	var plans []SeatChangePlan
+	if allowSeatChange && len(availableSeats) < passengers {
+		now := time.Now()
+		bySegment := make(map[int]map[string][]*domain.SeatQuota, len(segmentRange))
+		for _, idx := range segmentRange {
+			bySegment[idx] = activeQuotasBySeat(quotas, segments, []int{idx}, now)
+		}
+		plans = planSeatChanges(seats, segments, segmentRange, passengers, func(seat domain.SeatInventory) bool {
+			return (seatClass == "" || seat.SeatClass == seatClass) &&
+				seatAllowed(bySegment[seat.SegmentIndex][seat.SeatID], claims)
+		})
+	}
+
+	// Deck and standing places are counted, not seated
+	capacity, err := s.capacityAvailability(ctx, orgID, tripID, segmentRange)
+	if err != nil {
+		return nil, err
+	}
+	capacityItems = domain.MergeCapacityItems(capacityItems)
+
 	// Calculate pricing
 	var totalPrice int64
 	if len(availableSeats) > 0 && passengers > 0 {
@@ -63,13 +108,23 @@ func (s *InventoryService) CheckAvailability(ctx context.Context, orgID, tripID,
 			totalPrice += availableSeats[i].PricePaisa
 		}
 	}
+	for _, item := range capacityItems {
+		for _, avail := range capacity {
+			if avail.CapacityClass == item.CapacityClass {
+				totalPrice += avail.PricePaisa * int64(item.Quantity)
+			}
+		}
+	}
 
 	return &AvailabilityResult{
-		IsAvailable:     len(availableSeats) >= passengers,
+		IsAvailable:     len(availableSeats) >= passengers && capacityCovers(capacity, capacityItems),
 		AvailableCount:  len(availableSeats),
 		Seats:           availableSeats,
 		TotalPricePaisa: totalPrice,
 		SegmentRange:    segmentRange,
+		Quotas:          quotaSummary,
+		SeatChangePlans: plans,
+		Capacity:        capacity,
 		CheckedAt:       time.Now(),
 	}, nil
 }
@@ -100,55 +155,193 @@ func (s *InventoryService) HoldSeats(ctx context.Context, req *HoldRequest) (*Ho
 		return nil, err
 	}
 
+	restrictions, quotas, err := s.quotaRestrictions(ctx, req.OrganizationID, req.TripID, segments, segmentRange)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deck and standing places ride along with any seat mode; fail fast when they have run out
+	capacity, err := validateCapacityItems(req.Capacity)
+	if err != nil {
+		return &HoldResult{Success: false, FailureReason: err.Error()}, nil
+	}
+	if len(capacity) > 0 {
+		availability, err := s.capacityAvailability(ctx, req.OrganizationID, req.TripID, segmentRange)
+		if err != nil {
+			return nil, err
+		}
+		if !capacityCovers(availability, capacity) {
+			return &HoldResult{Success: false, FailureReason: domain.ErrInsufficientCapacity.Error()}, nil
+		}
+	}
+	capacityReq := *req
+	capacityReq.Capacity = capacity
+	req = &capacityReq
+
+	// Split-seat mode: each passenger's legs must cover the journey end to end
+	if len(req.Legs) > 0 {
+		legs, err := resolveSeatLegs(req.Legs, stationOrder, segmentRange)
+		if err != nil {
+			if _, ok := err.(*domain.DomainError); ok {
+				return &HoldResult{Success: false, FailureReason: err.Error()}, nil
+			}
+			return nil, err
+		}
+		if blocked := seatLegsBlockedByQuota(legs, quotas, segments, req.QuotaClaims); len(blocked) > 0 {
+			return &HoldResult{
+				Success:       false,
+				FailedSeatIDs: blocked,
+				FailureReason: domain.ErrSeatReservedForQuota.Error(),
+			}, nil
+		}
+
+		legReq := *req
+		legReq.Legs = legs
+		legReq.SeatIDs = distinctLegSeats(legs)
+		return s.createHold(ctx, &legReq, segmentRange)
+	}
+
+	if len(req.SeatIDs) > 0 {
+		if blocked := seatsBlockedByQuota(req.SeatIDs, restrictions, req.QuotaClaims); len(blocked) > 0 {
+			return &HoldResult{
+				Success:       false,
+				FailedSeatIDs: blocked,
+				FailureReason: domain.ErrSeatReservedForQuota.Error(),
+			}, nil
+		}
+		return s.createHold(ctx, req, segmentRange)
+	}
+	if req.SeatCount <= 0 {
+		// Capacity-only holds: deck passengers or standing tickets without seats
+		if len(req.Capacity) > 0 {
+			return s.createHold(ctx, req, segmentRange)
+		}
+		return nil, domain.ErrInvalidSeatCount
+	}
+
+	// Best-available mode: pick seats, then hold them. Seats can be taken between
+	// selection and hold, so re-allocate against fresh availability a few times.
+	var result *HoldResult
+	for attempt := 0; attempt < MaxAllocationAttempts; attempt++ {
+		seatIDs, err := s.allocateSeats(ctx, req, segmentRange, restrictions)
+		if err != nil {
+			if _, ok := err.(*domain.DomainError); ok {
+				return &HoldResult{Success: false, FailureReason: err.Error()}, nil
+			}
+			return nil, err
+		}
+
+		attemptReq := *req
+		attemptReq.SeatIDs = seatIDs
+		result, err = s.createHold(ctx, &attemptReq, segmentRange)
+		if err != nil || result.Success {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// allocateSeats runs the allocator over seats that are free on every segment of the journey
+// and not reserved for a quota the caller is ineligible for
+func (s *InventoryService) allocateSeats(ctx context.Context, req *HoldRequest, segmentRange []int, restrictions map[string][]*domain.SeatQuota) ([]string, error) {
+	seats, err := s.scyllaRepo.GetSeatAvailability(ctx, req.OrganizationID, req.TripID, segmentRange)
+	if err != nil {
+		return nil, err
+	}
+
+	layout, err := s.scyllaRepo.GetTripLayout(ctx, req.OrganizationID, req.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []domain.SeatInventory
+	for _, seat := range availableInventory(seats, segmentRange) {
+		if seatAllowed(restrictions[seat.SeatID], req.QuotaClaims) {
+			candidates = append(candidates, seat)
+		}
+	}
+
+	return s.allocator.Allocate(candidates, layout, req.SeatCount, req.Preferences)
+}
+
+// createHold locks, verifies and holds the requested seats across the segment range.
+// Split-seat requests hold each leg's seat only on the leg's segments.
+// Capacity places are taken on every segment once the seats are held.
+// Callers are responsible for policy checks such as the per-user hold limit.
+func (s *InventoryService) createHold(ctx context.Context, req *HoldRequest, segmentRange []int) (*HoldResult, error) {
+	hold := &domain.SeatHold{
+		OrganizationID: req.OrganizationID,
+		TripID:         req.TripID,
+		UserID:         req.UserID,
+		SessionID:      req.SessionID,
+		FromStationID:  req.FromStation,
+		ToStationID:    req.ToStation,
+		SeatIDs:        req.SeatIDs,
+		SegmentRange:   segmentRange,
+		Legs:           req.Legs,
+		Capacity:       req.Capacity,
+		Status:         domain.HoldStatusActive,
+		IPAddress:      req.IPAddress,
+	}
+	groups := hold.SeatGroups()
+
 	// Optimistic Pre-Lock with Redis
 	// Purpose: Fail fast if another user is processing the same seat, protecting DB from heavy LWTs
-	lockedSeats := make([]string, 0, len(req.SeatIDs))
+	type seatLock struct {
+		seatID string
+		segIdx int
+	}
+	lockedSeats := make([]seatLock, 0, len(req.SeatIDs)*len(segmentRange))
 	// We use a clean-up function to release locks
 	defer func() {
 		go func() {
 			bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 			defer cancel()
-			for _, seatID := range lockedSeats {
-				for _, segIdx := range segmentRange {
-					s.redisRepo.ReleaseSeatLock(bgCtx, req.OrganizationID, req.TripID, seatID, segIdx, req.UserID)
-				}
+			for _, lock := range lockedSeats {
+				s.redisRepo.ReleaseSeatLock(bgCtx, req.OrganizationID, req.TripID, lock.seatID, lock.segIdx, req.UserID)
 			}
 		}()
 	}()
 
-	for _, seatID := range req.SeatIDs {
-		// Acquire lock for ALL segments involved
-		for _, segIdx := range segmentRange {
-			acquired, err := s.redisRepo.AcquireSeatLock(ctx, req.OrganizationID, req.TripID, seatID, segIdx, req.UserID, 10*time.Second)
-			if err != nil {
-				return nil, err
-			}
-			if !acquired {
-				return &HoldResult{
-					Success:       false,
-					FailureReason: "seat query contention - please retry",
-				}, nil
+	for _, group := range groups {
+		for _, seatID := range group.SeatIDs {
+			// Acquire lock for ALL segments involved
+			for _, segIdx := range group.SegmentRange {
+				acquired, err := s.redisRepo.AcquireSeatLock(ctx, req.OrganizationID, req.TripID, seatID, segIdx, req.UserID, 10*time.Second)
+				if err != nil {
+					return nil, err
+				}
+				if !acquired {
+					return &HoldResult{
+						Success:       false,
+						FailureReason: "seat query contention - please retry",
+					}, nil
+				}
+				// Track successfully locked seats for deferred release
+				lockedSeats = append(lockedSeats, seatLock{seatID, segIdx})
 			}
 		}
-		// Track successfully locked seats for deferred release
-		lockedSeats = append(lockedSeats, seatID)
-	}
-
-	if err := s.scyllaRepo.ReleaseExpiredHolds(ctx, req.OrganizationID, req.TripID, req.SeatIDs, segmentRange); err != nil {
-		return nil, err
 	}
 
 	// Check availability (Scylla)
-	available, unavailableReasons, err := s.scyllaRepo.CheckSeatsAvailableForSegments(ctx, req.OrganizationID, req.TripID, req.SeatIDs, segmentRange)
-	if err != nil {
-		return nil, err
-	}
+	var failedSeats []string
+	for _, group := range groups {
+		if _, err := s.scyllaRepo.ReleaseExpiredHolds(ctx, req.OrganizationID, req.TripID, group.SeatIDs, group.SegmentRange); err != nil {
+			return nil, err
+		}
 
-	if !available {
-		var failedSeats []string
-		for seatID := range unavailableReasons {
-			failedSeats = append(failedSeats, seatID)
+		available, unavailableReasons, err := s.scyllaRepo.CheckSeatsAvailableForSegments(ctx, req.OrganizationID, req.TripID, group.SeatIDs, group.SegmentRange)
+		if err != nil {
+			return nil, err
+		}
+		if !available {
+			for seatID := range unavailableReasons {
+				failedSeats = append(failedSeats, seatID)
+			}
 		}
+	}
+
+	if len(failedSeats) > 0 {
 		return &HoldResult{
 			Success:       false,
 			FailedSeatIDs: failedSeats,
@@ -164,33 +357,44 @@ func (s *InventoryService) HoldSeats(ctx context.Context, req *HoldRequest) (*Ho
 	}
 	expiresAt := time.Now().Add(holdDuration)
 
-	// Update ScyllaDB (mark as held)
-	if err := s.scyllaRepo.HoldSeats(ctx, req.OrganizationID, holdID, req.TripID, req.UserID, req.SeatIDs, segmentRange, expiresAt); err != nil {
+	// Register the trip with the expiry sweeper before any seat is marked held
+	if err := s.redisRepo.TrackHoldTrip(ctx, req.OrganizationID, req.TripID); err != nil {
 		return nil, err
 	}
 
-	// Store hold metadata in Redis
-	hold := &domain.SeatHold{
-		HoldID:         holdID,
-		OrganizationID: req.OrganizationID,
-		TripID:         req.TripID,
-		UserID:         req.UserID,
-		SessionID:      req.SessionID,
-		FromStationID:  req.FromStation,
-		ToStationID:    req.ToStation,
-		SeatIDs:        req.SeatIDs,
-		SegmentRange:   segmentRange,
-		Status:         domain.HoldStatusActive,
-		ExpiresAt:      expiresAt,
-		CreatedAt:      time.Now(),
-		IPAddress:      req.IPAddress,
+	// Update ScyllaDB (mark as held), undoing earlier groups if a later one fails
+	for i, group := range groups {
+		if err := s.scyllaRepo.HoldSeats(ctx, req.OrganizationID, holdID, req.TripID, req.UserID, group.SeatIDs, group.SegmentRange, expiresAt); err != nil {
+			s.releaseSeatGroups(ctx, req.OrganizationID, req.TripID, holdID, groups[:i])
+			return nil, err
+		}
 	}
 
+	// Take the unnumbered places, giving the seats back if they have run out
+	if len(req.Capacity) > 0 {
+		if err := s.holdCapacity(ctx, req.OrganizationID, req.TripID, holdID, req.Capacity, segmentRange, expiresAt); err != nil {
+			s.releaseSeatGroups(ctx, req.OrganizationID, req.TripID, holdID, groups)
+			if _, ok := err.(*domain.DomainError); ok {
+				return &HoldResult{Success: false, FailureReason: err.Error()}, nil
+			}
+			return nil, err
+		}
+	}
+
+	// Store hold metadata in Redis
+	hold.HoldID = holdID
+	hold.ExpiresAt = expiresAt
+	hold.CreatedAt = time.Now()
+
 	if err := s.holdRepo.CreateHold(ctx, req.OrganizationID, hold); err != nil {
 		// Rollback ScyllaDB hold
-		_ = s.scyllaRepo.ReleaseHold(ctx, req.OrganizationID, req.TripID, holdID, segmentRange, req.SeatIDs)
+		s.releaseSeatGroups(ctx, req.OrganizationID, req.TripID, holdID, groups)
+		s.releaseCapacity(ctx, req.OrganizationID, req.TripID, holdID, req.Capacity, domain.CapacityHoldReleased)
 		return nil, err
 	}
+	s.moveAvailability(ctx, req.OrganizationID, req.TripID, seatGroupSegments(groups), -1)
+	s.recordTransitions(ctx, req.OrganizationID, req.TripID, seatGroupSegments(groups),
+		domain.SeatStatusAvailable, domain.SeatStatusHeld, ledgerEntry{Actor: req.UserID, HoldID: holdID})
 
 	// Invalidate Cache after successful hold
 	// We delete the whole trip cache to force refresh on next read
@@ -201,16 +405,33 @@ func (s *InventoryService) HoldSeats(ctx context.Context, req *HoldRequest) (*Ho
 	}()
 
 	// Publish Event
-	s.publishSeatEvent(ctx, kafka.EventSeatsHeld, req.TripID, req.SeatIDs, "HELD")
+	if len(req.SeatIDs) > 0 {
+		s.publishSeatEvent(ctx, kafka.EventSeatsHeld, req.TripID, req.SeatIDs, "HELD")
+	}
+	if len(req.Capacity) > 0 {
+		s.publishCapacityEvent(ctx, req.OrganizationID, req.TripID)
+	}
 
 	return &HoldResult{
-		Success:     true,
-		HoldID:      holdID,
-		HeldSeatIDs: req.SeatIDs,
-		ExpiresAt:   expiresAt,
+		Success:      true,
+		HoldID:       holdID,
+		HeldSeatIDs:  req.SeatIDs,
+		Legs:         req.Legs,
+		HeldCapacity: req.Capacity,
+		ExpiresAt:    expiresAt,
 	}, nil
 }
 
+// releaseSeatGroups returns held seats to the pool, one segment group at a time
+func (s *InventoryService) releaseSeatGroups(ctx context.Context, orgID, tripID, holdID string, groups []domain.SeatGroup) error {
+	for _, group := range groups {
+		if err := s.scyllaRepo.ReleaseHold(ctx, orgID, tripID, holdID, group.SegmentRange, group.SeatIDs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ReleaseSeats releases a hold
 func (s *InventoryService) ReleaseSeats(ctx context.Context, orgID, holdID, userID string) error {
 	hold, err := s.holdRepo.GetHold(ctx, orgID, holdID)
@@ -224,7 +445,15 @@ func (s *InventoryService) ReleaseSeats(ctx context.Context, orgID, holdID, user
 	}
 
 	// Release in ScyllaDB
-	if err := s.scyllaRepo.ReleaseHold(ctx, orgID, hold.TripID, holdID, hold.SegmentRange, hold.SeatIDs); err != nil {
+	if err := s.releaseSeatGroups(ctx, orgID, hold.TripID, holdID, hold.SeatGroups()); err != nil {
+		return err
+	}
+	if hold.Status == domain.HoldStatusActive {
+		s.moveAvailability(ctx, orgID, hold.TripID, seatGroupSegments(hold.SeatGroups()), 1)
+		s.recordTransitions(ctx, orgID, hold.TripID, seatGroupSegments(hold.SeatGroups()),
+			domain.SeatStatusHeld, domain.SeatStatusAvailable, ledgerEntry{Actor: userID, HoldID: holdID, Reason: "released"})
+	}
+	if err := s.releaseCapacity(ctx, orgID, hold.TripID, holdID, hold.Capacity, domain.CapacityHoldReleased); err != nil {
 		return err
 	}
 
@@ -234,7 +463,15 @@ func (s *InventoryService) ReleaseSeats(ctx context.Context, orgID, holdID, user
 	}
 
 	// Publish Event
-	s.publishSeatEvent(ctx, kafka.EventSeatsReleased, hold.TripID, hold.SeatIDs, "AVAILABLE")
+	if len(hold.SeatIDs) > 0 {
+		s.publishSeatEvent(ctx, kafka.EventSeatsReleased, hold.TripID, hold.SeatIDs, "AVAILABLE")
+	}
+	if len(hold.Capacity) > 0 {
+		s.publishCapacityEvent(ctx, orgID, hold.TripID)
+	}
+
+	// Freed seats may satisfy someone on the waitlist
+	s.triggerWaitlist(orgID, hold.TripID)
 
 	return nil
 }
@@ -261,7 +498,7 @@ func (s *InventoryService) ConfirmBooking(ctx context.Context, orgID, holdID, or
 	}
 
 	// Validate passenger count matches seats
-	if len(passengers) != len(hold.SeatIDs) {
+	if len(passengers) != hold.PassengerCount() {
 		return &BookingResult{
 			Success:       false,
 			FailureReason: "passenger count does not match seat count",
@@ -270,31 +507,185 @@ func (s *InventoryService) ConfirmBooking(ctx context.Context, orgID, holdID, or
 
 	bookingID := uuid.New().String()
 
-	// Confirm in ScyllaDB
-	if err := s.scyllaRepo.ConfirmBooking(ctx, orgID, hold.TripID, holdID, bookingID, hold.SegmentRange, hold.SeatIDs); err != nil {
+	// Seat details (number, class, price) are identical on every segment, so one is enough
+	seatRows, err := s.scyllaRepo.GetSeatAvailability(ctx, orgID, hold.TripID, hold.SegmentRange[:1])
+	if err != nil {
 		return nil, err
 	}
+	seatDetails := make(map[string]domain.SeatInventory, len(seatRows))
+	for _, seat := range seatRows {
+		seatDetails[seat.SeatID] = seat
+	}
+
+	// Confirm in ScyllaDB
+	for _, group := range hold.SeatGroups() {
+		if err := s.scyllaRepo.ConfirmBooking(ctx, orgID, hold.TripID, holdID, bookingID, group.SegmentRange, group.SeatIDs); err != nil {
+			return nil, err
+		}
+	}
+	s.recordTransitions(ctx, orgID, hold.TripID, seatGroupSegments(hold.SeatGroups()),
+		domain.SeatStatusHeld, domain.SeatStatusBooked, ledgerEntry{Actor: userID, HoldID: holdID, BookingID: bookingID})
+	var capacityPrices map[string]int64
+	if len(hold.Capacity) > 0 {
+		if err := s.confirmCapacity(ctx, orgID, hold.TripID, holdID, bookingID, hold.Capacity); err != nil {
+			return nil, err
+		}
+		availability, err := s.capacityAvailability(ctx, orgID, hold.TripID, hold.SegmentRange[:1])
+		if err != nil {
+			return nil, err
+		}
+		capacityPrices = make(map[string]int64, len(availability))
+		for _, avail := range availability {
+			capacityPrices[avail.CapacityClass] = avail.PricePaisa
+		}
+	}
 
 	// Update hold status
 	if err := s.holdRepo.UpdateHoldStatus(ctx, orgID, holdID, domain.HoldStatusConverted); err != nil {
 		// Non-fatal, booking is already confirmed
 	}
 
-	// Build confirmed seats response
+	// Build confirmed seats response: one ticket per seat, or per leg on split-seat holds
+	passengerBySeat := make(map[string]PassengerInfo, len(passengers))
+	for _, p := range passengers {
+		if p.SeatID != "" {
+			passengerBySeat[p.SeatID] = p
+		}
+	}
+
+	legs := hold.Legs
+	if len(legs) == 0 {
+		for i, seatID := range hold.SeatIDs {
+			legs = append(legs, domain.SeatLeg{PassengerIndex: i, SeatID: seatID})
+		}
+	}
+
+	// A passenger is matched by a seat from their first leg, falling back to request order
+	passengerByIndex := make(map[int]PassengerInfo)
+	for _, leg := range legs {
+		if _, ok := passengerByIndex[leg.PassengerIndex]; ok {
+			continue
+		}
+		passenger, ok := passengerBySeat[leg.SeatID]
+		if !ok && leg.PassengerIndex < len(passengers) {
+			passenger = passengers[leg.PassengerIndex]
+		}
+		passengerByIndex[leg.PassengerIndex] = passenger
+	}
+
+	journeyStart := hold.SegmentRange[0]
 	var confirmedSeats []ConfirmedSeatInfo
-	for i, seatID := range hold.SeatIDs {
+	var bookedSeats []domain.BookedSeat
+	var totalPaisa int64
+	for _, leg := range legs {
+		passenger := passengerByIndex[leg.PassengerIndex]
+		detail := seatDetails[leg.SeatID]
 		ticketID := uuid.New().String()
+
+		price := detail.PricePaisa
+		if len(leg.SegmentRange) > 0 {
+			from := leg.SegmentRange[0] - journeyStart
+			price = prorate(detail.PricePaisa, from, from+len(leg.SegmentRange), len(hold.SegmentRange))
+		}
+
 		confirmedSeats = append(confirmedSeats, ConfirmedSeatInfo{
-			SeatID:   seatID,
-			TicketID: ticketID,
+			SeatID:         leg.SeatID,
+			SeatNumber:     detail.SeatNumber,
+			SeatClass:      detail.SeatClass,
+			TicketID:       ticketID,
+			PassengerName:  passenger.Name,
+			PassengerIndex: leg.PassengerIndex,
+			FromStationID:  leg.FromStationID,
+			ToStationID:    leg.ToStationID,
+			PricePaisa:     price,
 		})
-		if i < len(passengers) {
-			confirmedSeats[i].PassengerName = passengers[i].Name
+		bookedSeats = append(bookedSeats, domain.BookedSeat{
+			SeatID:         leg.SeatID,
+			SeatNumber:     detail.SeatNumber,
+			SeatClass:      detail.SeatClass,
+			TicketID:       ticketID,
+			PassengerNID:   passenger.NID,
+			PassengerName:  passenger.Name,
+			PricePaisa:     price,
+			PassengerIndex: leg.PassengerIndex,
+			FromStationID:  leg.FromStationID,
+			ToStationID:    leg.ToStationID,
+			SegmentRange:   leg.SegmentRange,
+		})
+		totalPaisa += price
+	}
+
+	// Capacity places carry no seat: their passengers are the ones sent without a seat ID,
+	// or, when every passenger comes without one, those after the seated passengers
+	var unseated []PassengerInfo
+	for _, p := range passengers {
+		if p.SeatID == "" {
+			unseated = append(unseated, p)
+		}
+	}
+	if len(unseated) != domain.CapacityQuantity(hold.Capacity) {
+		unseated = passengers[min(hold.SeatedPassengerCount(), len(passengers)):]
+	}
+
+	passengerIndex := hold.SeatedPassengerCount()
+	for _, item := range hold.Capacity {
+		for n := 0; n < item.Quantity; n++ {
+			var passenger PassengerInfo
+			if k := passengerIndex - hold.SeatedPassengerCount(); k < len(unseated) {
+				passenger = unseated[k]
+			}
+			ticketID := uuid.New().String()
+			price := capacityPrices[item.CapacityClass]
+
+			confirmedSeats = append(confirmedSeats, ConfirmedSeatInfo{
+				SeatClass:      item.CapacityClass,
+				CapacityClass:  item.CapacityClass,
+				TicketID:       ticketID,
+				PassengerName:  passenger.Name,
+				PassengerIndex: passengerIndex,
+				PricePaisa:     price,
+			})
+			bookedSeats = append(bookedSeats, domain.BookedSeat{
+				SeatClass:      item.CapacityClass,
+				CapacityClass:  item.CapacityClass,
+				TicketID:       ticketID,
+				PassengerNID:   passenger.NID,
+				PassengerName:  passenger.Name,
+				PricePaisa:     price,
+				PassengerIndex: passengerIndex,
+			})
+			totalPaisa += price
+			passengerIndex++
 		}
 	}
 
+	now := time.Now()
+	booking := &domain.Booking{
+		BookingID:      bookingID,
+		OrderID:        orderID,
+		OrganizationID: orgID,
+		TripID:         hold.TripID,
+		UserID:         userID,
+		FromStationID:  hold.FromStationID,
+		ToStationID:    hold.ToStationID,
+		Seats:          bookedSeats,
+		SegmentRange:   hold.SegmentRange,
+		TotalPaisa:     totalPaisa,
+		Status:         domain.BookingStatusConfirmed,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := s.scyllaRepo.CreateBooking(ctx, booking); err != nil {
+		return nil, err
+	}
+
 	// Publish Event
-	s.publishSeatEvent(ctx, kafka.EventSeatsBooked, hold.TripID, hold.SeatIDs, "BOOKED")
+	if len(hold.SeatIDs) > 0 {
+		s.publishSeatEvent(ctx, kafka.EventSeatsBooked, hold.TripID, hold.SeatIDs, "BOOKED")
+	}
+	if len(hold.Capacity) > 0 {
+		s.publishCapacityEvent(ctx, orgID, hold.TripID)
+	}
 
 	return &BookingResult{
 		Success:        true,
@@ -303,46 +694,176 @@ func (s *InventoryService) ConfirmBooking(ctx context.Context, orgID, holdID, or
 	}, nil
 }
 
-// GetSeatMap returns the seat layout with availability status
-func (s *InventoryService) GetSeatMap(ctx context.Context, orgID, tripID, fromStation, toStation string) (*SeatMapResult, error) {
-	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+// CancelBooking returns the seats of a confirmed booking to the pool
+func (s *InventoryService) CancelBooking(ctx context.Context, orgID, bookingID, orderID string) (int, error) {
+	return s.cancelBooking(ctx, orgID, bookingID, orderID, ledgerEntry{Actor: domain.ActorSystem, Reason: "booking cancelled"})
+}
+
+// cancelBooking cancels a booking, recording entry in the seat ledger
+func (s *InventoryService) cancelBooking(ctx context.Context, orgID, bookingID, orderID string, entry ledgerEntry) (int, error) {
+	booking, err := s.scyllaRepo.GetBooking(ctx, bookingID)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	stationOrder := extractStationOrder(segments)
-	segmentRange, err := domain.CalculateSegmentRange(stationOrder, fromStation, toStation)
+	// Verify ownership
+	if (orgID != "" && booking.OrganizationID != orgID) || (orderID != "" && booking.OrderID != orderID) {
+		return 0, domain.ErrBookingNotFound
+	}
+
+	// Idempotent: a cancelled booking has nothing left to release
+	if booking.Status == domain.BookingStatusCancelled {
+		return 0, nil
+	}
+
+	seatIDs, capacity, err := s.releaseBookedSeats(ctx, booking, booking.Seats, entry)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+
+	if err := s.scyllaRepo.UpdateBookingStatus(ctx, bookingID, domain.BookingStatusCancelled); err != nil {
+		return 0, err
 	}
 
-	// Try Cache First (Read-Through)
-	// We cache the ENTIRE trip inventory to allow in-memory filtering for any segment range
-	seats, err := s.redisRepo.GetCachedSeatMap(ctx, orgID, tripID)
-	if err != nil || len(seats) == 0 {
-		// Cache Miss: Fetch ALL segments to warm cache for everyone
-		allSegmentIndices := make([]int, len(segments))
-		for i := range segments {
-			allSegmentIndices[i] = segments[i].SegmentIndex
+	s.announceReleasedSeats(ctx, booking, seatIDs, capacity)
+	return len(seatIDs) + domain.CapacityQuantity(capacity), nil
+}
+
+// CancelBookingPassengers cancels some passengers of a booking, releasing every seat leg and
+// deck or standing place they hold while the rest of the booking stays confirmed.
+// Passengers no longer on the booking are skipped, so a retry releases nothing twice.
+// Cancelling every passenger cancels the booking.
+func (s *InventoryService) CancelBookingPassengers(ctx context.Context, orgID, bookingID, orderID string, passengerIndexes []int) (int, error) {
+	booking, err := s.scyllaRepo.GetBooking(ctx, bookingID)
+	if err != nil {
+		return 0, err
+	}
+	if (orgID != "" && booking.OrganizationID != orgID) || (orderID != "" && booking.OrderID != orderID) {
+		return 0, domain.ErrBookingNotFound
+	}
+	if booking.Status == domain.BookingStatusCancelled {
+		return 0, nil
+	}
+
+	drop := make(map[int]bool, len(passengerIndexes))
+	for _, idx := range passengerIndexes {
+		drop[idx] = true
+	}
+	var keep, cancelled []domain.BookedSeat
+	for _, seat := range booking.Seats {
+		if drop[seat.PassengerIndex] {
+			cancelled = append(cancelled, seat)
+		} else {
+			keep = append(keep, seat)
 		}
+	}
+	if len(cancelled) == 0 {
+		return 0, nil
+	}
+	entry := ledgerEntry{Actor: domain.ActorSystem, Reason: "passengers cancelled"}
+	if len(keep) == 0 {
+		return s.cancelBooking(ctx, orgID, bookingID, orderID, entry)
+	}
 
-		seats, err = s.scyllaRepo.GetSeatAvailability(ctx, orgID, tripID, allSegmentIndices)
-		if err != nil {
-			return nil, err
+	seatIDs, capacity, err := s.releaseBookedSeats(ctx, booking, cancelled, entry)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.scyllaRepo.UpdateBookingSeats(ctx, bookingID, keep); err != nil {
+		return 0, err
+	}
+
+	s.announceReleasedSeats(ctx, booking, seatIDs, capacity)
+	return len(seatIDs) + domain.CapacityQuantity(capacity), nil
+}
+
+// TransferPassenger hands a passenger's seats on a booking to a new holder after a
+// ticket transfer; the seats stay booked. Transferring to the current holder changes
+// nothing, so a retry is harmless. Returns the number of seats rewritten.
+func (s *InventoryService) TransferPassenger(ctx context.Context, orgID, bookingID, orderID string, passengerIndex int, nid, name string) (int, error) {
+	booking, err := s.scyllaRepo.GetBooking(ctx, bookingID)
+	if err != nil {
+		return 0, err
+	}
+	if (orgID != "" && booking.OrganizationID != orgID) || (orderID != "" && booking.OrderID != orderID) {
+		return 0, domain.ErrBookingNotFound
+	}
+	if booking.Status == domain.BookingStatusCancelled {
+		return 0, domain.ErrBookingNotFound
+	}
+
+	updated := 0
+	seats := make([]domain.BookedSeat, len(booking.Seats))
+	copy(seats, booking.Seats)
+	for i := range seats {
+		if seats[i].PassengerIndex != passengerIndex {
+			continue
 		}
+		if seats[i].PassengerNID == nid && seats[i].PassengerName == name {
+			continue
+		}
+		seats[i].PassengerNID = nid
+		seats[i].PassengerName = name
+		updated++
+	}
+	if updated == 0 {
+		return 0, nil
+	}
 
-		// Update Cache asynchronously
-		go func() {
-			bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer cancel()
-			s.redisRepo.CacheSeatMap(bgCtx, orgID, tripID, seats, 5*time.Second) // Short TTL for near-realtime
-		}()
+	if err := s.scyllaRepo.UpdateBookingSeats(ctx, bookingID, seats); err != nil {
+		return 0, err
 	}
+	return updated, nil
+}
 
-	// Aggregate availability across segments
-	seatMap := aggregateSeatMap(seats, segmentRange)
+// releaseBookedSeats frees some of a booking's seats across the segments each covers,
+// and its deck or standing places. Returns the seat IDs and places freed.
+func (s *InventoryService) releaseBookedSeats(ctx context.Context, booking *domain.Booking, seats []domain.BookedSeat, entry ledgerEntry) ([]string, []domain.CapacityItem, error) {
+	part := *booking
+	part.Seats = seats
 
-	return seatMap, nil
+	seatIDs := make([]string, 0, len(seats))
+	seen := make(map[string]bool, len(seats))
+	for _, seat := range seats {
+		if seat.SeatID != "" && !seen[seat.SeatID] {
+			seen[seat.SeatID] = true
+			seatIDs = append(seatIDs, seat.SeatID)
+		}
+	}
+
+	groups := part.SeatGroups()
+	for _, group := range groups {
+		if err := s.scyllaRepo.CancelBooking(ctx, booking.OrganizationID, booking.TripID, booking.BookingID, group.SegmentRange, group.SeatIDs); err != nil {
+			return nil, nil, err
+		}
+	}
+	s.moveAvailability(ctx, booking.OrganizationID, booking.TripID, seatGroupSegments(groups), 1)
+	entry.BookingID = booking.BookingID
+	s.recordTransitions(ctx, booking.OrganizationID, booking.TripID, seatGroupSegments(groups),
+		domain.SeatStatusBooked, domain.SeatStatusAvailable, entry)
+	capacity := part.CapacityItems()
+	if err := s.cancelCapacity(ctx, booking.OrganizationID, booking.TripID, capacity, booking.SegmentRange); err != nil {
+		return nil, nil, err
+	}
+	return seatIDs, capacity, nil
+}
+
+// announceReleasedSeats refreshes the seat map and tells the waitlist and listeners about freed seats
+func (s *InventoryService) announceReleasedSeats(ctx context.Context, booking *domain.Booking, seatIDs []string, capacity []domain.CapacityItem) {
+	go func() {
+		bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		s.redisRepo.InvalidateSeatMap(bgCtx, booking.OrganizationID, booking.TripID)
+	}()
+
+	if len(seatIDs) > 0 {
+		s.publishSeatEvent(ctx, kafka.EventSeatsReleased, booking.TripID, seatIDs, "AVAILABLE")
+		s.triggerWaitlist(booking.OrganizationID, booking.TripID)
+	}
+	if len(capacity) > 0 {
+		s.publishCapacityEvent(ctx, booking.OrganizationID, booking.TripID)
+	}
 }
 
 // InitializeTripInventory initializes inventory for a new trip
@@ -364,11 +885,17 @@ func (s *InventoryService) InitializeTripInventory(ctx context.Context, req *Ini
 	var seats []domain.SeatInventory
 	for _, seatDef := range req.SeatConfig.Seats {
 		seats = append(seats, domain.SeatInventory{
-			SeatID:     seatDef.SeatID,
-			SeatNumber: seatDef.SeatNumber,
-			SeatClass:  seatDef.SeatClass,
-			SeatType:   seatDef.SeatType,
-			PricePaisa: seatDef.PricePaisa,
+			SeatID:       seatDef.SeatID,
+			SeatNumber:   seatDef.SeatNumber,
+			SeatClass:    seatDef.SeatClass,
+			SeatType:     seatDef.SeatType,
+			PricePaisa:   seatDef.PricePaisa,
+			Row:          seatDef.Row,
+			Column:       seatDef.Column,
+			SectionID:    seatDef.SectionID,
+			Berth:        seatDef.Berth,
+			IsAccessible: seatDef.IsAccessible,
+			HasPower:     seatDef.HasPower,
 		})
 	}
 
@@ -377,6 +904,57 @@ func (s *InventoryService) InitializeTripInventory(ctx context.Context, req *Ini
 		return nil, err
 	}
 
+	// 3. Seed the availability counters before any seat is blocked
+	segmentIndexes := make([]int, 0, len(segments))
+	rows := make([]domain.SeatInventory, 0, len(seats)*len(segments))
+	for _, seg := range segments {
+		segmentIndexes = append(segmentIndexes, seg.SegmentIndex)
+		for _, seat := range seats {
+			seat.SegmentIndex = seg.SegmentIndex
+			seat.Status = domain.SeatStatusAvailable
+			rows = append(rows, seat)
+		}
+	}
+	if err := s.reconcileAvailability(ctx, req.OrganizationID, req.TripID, rows, segmentIndexes); err != nil {
+		return nil, err
+	}
+
+	// 4. Record the vehicle layout so the seat map can be drawn on its real grid
+	if len(req.SeatConfig.Sections) > 0 {
+		layout := &domain.SeatLayout{
+			VehicleID:   req.VehicleID,
+			VehicleType: req.SeatConfig.VehicleType,
+			Sections:    req.SeatConfig.Sections,
+		}
+		if err := s.scyllaRepo.SaveTripLayout(ctx, req.OrganizationID, req.TripID, layout); err != nil {
+			return nil, err
+		}
+	}
+
+	// 5. Reserve quota buckets (ladies, disabled, counter, partner, VIP)
+	if err := s.configureQuotas(ctx, req.OrganizationID, req.TripID, req.Quotas); err != nil {
+		return nil, err
+	}
+
+	// 6. Apply the schedule's standing seat blocks to the new trip
+	if req.ScheduleID != "" {
+		if err := s.applyScheduleBlocks(ctx, req.OrganizationID, req.ScheduleID, req.TripID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 7. Hold back the schedule's release tranches until their release time
+	if req.ScheduleID != "" {
+		if err := s.applyScheduleTranches(ctx, req.OrganizationID, req.ScheduleID, req.TripID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 8. Count deck passengers and standing tickets per segment
+	if err := s.configureCapacity(ctx, req.OrganizationID, req.TripID, segments, req.SeatConfig.Capacity); err != nil {
+		return nil, err
+	}
+
 	return &InitializeTripResult{
 		Success:         true,
 		SegmentsCreated: len(segments),
@@ -392,6 +970,9 @@ type AvailabilityResult struct {
 	Seats           []SeatInfo
 	TotalPricePaisa int64
 	SegmentRange    []int
+	Quotas          []QuotaAvailability
+	SeatChangePlans []SeatChangePlan // Split-seat offers, one per passenger
+	Capacity        []CapacityAvailability
 	CheckedAt       time.Time
 }
 
@@ -415,6 +996,11 @@ type HoldRequest struct {
 	SessionID      string
 	IPAddress      string
 	HoldDuration   time.Duration
+	SeatCount      int             // Best-available mode when SeatIDs is empty
+	Preferences    SeatPreferences // Best-available mode only
+	QuotaClaims    domain.QuotaClaims
+	Legs           []domain.SeatLeg      // Split-seat mode: replaces SeatIDs
+	Capacity       []domain.CapacityItem // Deck or standing places, with or without seats
 }
 
 type HoldResult struct {
@@ -422,13 +1008,16 @@ type HoldResult struct {
 	HoldID        string
 	HeldSeatIDs   []string
 	FailedSeatIDs []string
+	Legs          []domain.SeatLeg
+	HeldCapacity  []domain.CapacityItem
 	ExpiresAt     time.Time
 	FailureReason string
 }
 
 type PassengerInfo struct {
-	NID  string
-	Name string
+	SeatID string
+	NID    string
+	Name   string
 }
 
 type BookingResult struct {
@@ -439,31 +1028,16 @@ type BookingResult struct {
 }
 
 type ConfirmedSeatInfo struct {
-	SeatID        string
-	SeatNumber    string
-	TicketID      string
-	PassengerName string
-}
-
-type SeatMapResult struct {
-	TripID string
-	Rows   []SeatRow
-	Legend map[string]string
-}
-
-type SeatRow struct {
-	RowNumber int
-	Seats     []SeatCell
-}
-
-type SeatCell struct {
-	SeatID     string
-	SeatNumber string
-	Column     int
-	SeatType   string
-	SeatClass  string
-	Status     string
-	PricePaisa int64
+	SeatID         string
+	SeatNumber     string
+	SeatClass      string
+	TicketID       string
+	PassengerName  string
+	PassengerIndex int
+	FromStationID  string // Split-seat legs only
+	ToStationID    string
+	PricePaisa     int64
+	CapacityClass  string // Capacity tickets only; SeatID is then empty
 }
 
 type InitializeTripRequest struct {
@@ -472,6 +1046,8 @@ type InitializeTripRequest struct {
 	VehicleID      string
 	Segments       []SegmentDef
 	SeatConfig     SeatConfig
+	Quotas         []QuotaDef
+	ScheduleID     string // Trips generated from a schedule inherit its seat blocks
 }
 
 type SegmentDef struct {
@@ -483,18 +1059,25 @@ type SegmentDef struct {
 }
 
 type SeatConfig struct {
-	TotalSeats int
-	Seats      []SeatDef
+	TotalSeats  int
+	Seats       []SeatDef
+	VehicleType string
+	Sections    []domain.LayoutSection
+	Capacity    []CapacityDef // Unnumbered places: deck passengers, standing tickets
 }
 
 type SeatDef struct {
-	SeatID     string
-	SeatNumber string
-	Row        int
-	Column     int
-	SeatType   string
-	SeatClass  string
-	PricePaisa int64
+	SeatID       string
+	SeatNumber   string
+	Row          int
+	Column       int
+	SeatType     string
+	SeatClass    string
+	PricePaisa   int64
+	SectionID    string
+	Berth        string
+	IsAccessible bool
+	HasPower     bool
 }
 
 type InitializeTripResult struct {
@@ -557,100 +1140,8 @@ func filterAvailableSeats(seats []domain.SeatInventory, segmentRange []int, seat
 	return available
 }
 
-func aggregateSeatMap(seats []domain.SeatInventory, segmentRange []int) *SeatMapResult {
-	// Similar logic to filterAvailableSeats but builds a seat map structure
-	seatSegmentAvail := make(map[string]int)
-	seatDetails := make(map[string]domain.SeatInventory)
-
-	now := time.Now()
-	for _, seat := range seats {
-		isAvail := seat.Status == domain.SeatStatusAvailable ||
-			(seat.Status == domain.SeatStatusHeld && now.After(seat.HoldExpiry))
-
-		if isAvail {
-			seatSegmentAvail[seat.SeatID]++
-		}
-		seatDetails[seat.SeatID] = seat
-	}
-
-	requiredSegments := len(segmentRange)
-
-	var cells []SeatCell
-	for seatID, detail := range seatDetails {
-		status := detail.Status
-		if seatSegmentAvail[seatID] == requiredSegments {
-			status = domain.SeatStatusAvailable
-		}
-
-		cells = append(cells, SeatCell{
-			SeatID:     seatID,
-			SeatNumber: detail.SeatNumber,
-			SeatType:   detail.SeatType,
-			SeatClass:  detail.SeatClass,
-			Status:     status,
-			PricePaisa: detail.PricePaisa,
-		})
-	}
-
-	return &SeatMapResult{
-		Rows: []SeatRow{{Seats: cells}}, // Simplified - in production, group by row
-		Legend: map[string]string{
-			"available": "#00FF00",
-			"held":      "#FFFF00",
-			"booked":    "#FF0000",
-			"blocked":   "#808080",
-		},
-	}
-}
-
-// JoinWaitlist adds a user to the waitlist
-func (s *InventoryService) JoinWaitlist(ctx context.Context, req *WaitlistRequest) (*WaitlistResult, error) {
-	entry := domain.WaitlistEntry{
-		OrganizationID: req.OrganizationID,
-		TripID:         req.TripID,
-		UserID:         req.UserID,
-		SeatClass:      req.SeatClass,
-		RequestedSeats: req.RequestedSeats,
-		CreatedAt:      time.Now(),
-		Status:         domain.WaitlistStatusPending,
-	}
-
-	if err := s.scyllaRepo.AddToWaitlist(ctx, entry); err != nil {
-		return nil, err
-	}
-
-	return &WaitlistResult{
-		Success:  true,
-		Message:  "added to waitlist",
-		Position: 0, // Placeholder
-	}, nil
-}
-
-// GetUserWaitlist returns user's waitlist
-func (s *InventoryService) GetUserWaitlist(ctx context.Context, userID string) ([]domain.WaitlistEntry, error) {
-	return s.scyllaRepo.GetUserWaitlist(ctx, userID)
-}
-
-type WaitlistRequest struct {
-	OrganizationID string
-	TripID         string
-	UserID         string
-	SeatClass      string
-	RequestedSeats int
-}
-
-type WaitlistResult struct {
-	Success  bool
-	Message  string
-	Position int
-}
-
 // publishSeatEvent publishes a seat status change event to Kafka
 func (s *InventoryService) publishSeatEvent(ctx context.Context, eventType, tripID string, seatIDs []string, status string) {
-	if s.kafkaProducer == nil {
-		return
-	}
-
 	payload := map[string]interface{}{
 		"trip_id":    tripID,
 		"seat_ids":   seatIDs,
@@ -658,6 +1149,15 @@ func (s *InventoryService) publishSeatEvent(ctx context.Context, eventType, trip
 		"updated_at": time.Now(),
 	}
 
+	s.publishEvent(ctx, eventType, tripID, payload)
+}
+
+// publishEvent publishes an inventory event keyed by trip to Kafka
+func (s *InventoryService) publishEvent(ctx context.Context, eventType, tripID string, payload map[string]interface{}) {
+	if s.kafkaProducer == nil {
+		return
+	}
+
 	event := &kafka.Event{
 		ID:          uuid.New().String(),
 		Type:        eventType,
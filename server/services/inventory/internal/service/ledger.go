@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+)
+
+// ledgerEntry says who changed seats and for which hold or booking
+type ledgerEntry struct {
+	Actor     string
+	HoldID    string
+	BookingID string
+	Reason    string
+}
+
+// recordTransitions appends one seat status change to the seat ledger.
+// Like the availability counters, the ledger is written after the seats change,
+// and a failed write is logged rather than undoing the change.
+func (s *InventoryService) recordTransitions(ctx context.Context, orgID, tripID string, bySegment map[int][]string, fromStatus, toStatus string, entry ledgerEntry) {
+	segIdxs := make([]int, 0, len(bySegment))
+	for segIdx := range bySegment {
+		segIdxs = append(segIdxs, segIdx)
+	}
+	sort.Ints(segIdxs)
+
+	var transitions []domain.SeatTransition
+	for _, segIdx := range segIdxs {
+		for _, seatID := range bySegment[segIdx] {
+			transitions = append(transitions, domain.SeatTransition{
+				SegmentIndex: segIdx,
+				SeatID:       seatID,
+				FromStatus:   fromStatus,
+				ToStatus:     toStatus,
+				Actor:        entry.Actor,
+				HoldID:       entry.HoldID,
+				BookingID:    entry.BookingID,
+				Reason:       entry.Reason,
+			})
+		}
+	}
+	s.appendLedger(ctx, orgID, tripID, transitions)
+}
+
+// appendLedger writes prepared transitions, defaulting the actor to the system
+func (s *InventoryService) appendLedger(ctx context.Context, orgID, tripID string, transitions []domain.SeatTransition) {
+	if len(transitions) == 0 {
+		return
+	}
+	for i := range transitions {
+		if transitions[i].Actor == "" {
+			transitions[i].Actor = domain.ActorSystem
+		}
+	}
+	if err := s.scyllaRepo.AppendLedger(ctx, orgID, tripID, transitions); err != nil {
+		logger.Warn("Failed to append seat ledger", "trip_id", tripID, "entries", len(transitions), "error", err)
+	}
+}
+
+// GetSeatLedger returns a trip's seat status changes, oldest first, optionally for one seat
+func (s *InventoryService) GetSeatLedger(ctx context.Context, orgID, tripID, seatID string) ([]domain.SeatTransition, error) {
+	entries, err := s.scyllaRepo.ListLedger(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if seatID == "" {
+		return entries, nil
+	}
+
+	filtered := entries[:0]
+	for _, e := range entries {
+		if e.SeatID == seatID {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered, nil
+}
+
+// seatRowTransitions records where the rows of a replaced vehicle layout start out.
+// Rows written as available are not recorded; the others arrive held, booked or blocked.
+func seatRowTransitions(rows []domain.SeatInventory, reason string) []domain.SeatTransition {
+	var transitions []domain.SeatTransition
+	for _, row := range rows {
+		if row.Status == domain.SeatStatusAvailable {
+			continue
+		}
+		transitions = append(transitions, domain.SeatTransition{
+			SegmentIndex: row.SegmentIndex,
+			SeatID:       row.SeatID,
+			FromStatus:   domain.SeatStatusAvailable,
+			ToStatus:     row.Status,
+			Actor:        domain.ActorReaccommodation,
+			HoldID:       row.HoldID,
+			BookingID:    row.BookingID,
+			Reason:       reason,
+		})
+	}
+	return transitions
+}
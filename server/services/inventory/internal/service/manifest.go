@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+)
+
+// ManifestPassenger is one confirmed passenger on the conductor manifest
+type ManifestPassenger struct {
+	BookingID            string
+	PassengerName        string
+	PassengerNID         string
+	FromStationID        string
+	ToStationID          string
+	Legs                 []ManifestLeg // In travel order
+	SeatChangeStationIDs []string      // Stations where the passenger moves to the next leg's seat
+	CapacityClass        string        // Deck or standing passengers, who have no seat
+}
+
+// ManifestLeg is the seat a passenger occupies between two stations
+type ManifestLeg struct {
+	SeatID        string
+	SeatNumber    string
+	FromStationID string
+	ToStationID   string
+	TicketID      string
+}
+
+// GetTripManifest lists confirmed passengers by boarding station with the seat held on each leg
+func (s *InventoryService) GetTripManifest(ctx context.Context, orgID, tripID string) ([]ManifestPassenger, error) {
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+	stopOrder := make(map[string]int)
+	for i, station := range extractStationOrder(segments) {
+		stopOrder[station] = i
+	}
+
+	bookings, err := s.scyllaRepo.ListTripBookings(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest []ManifestPassenger
+	for _, booking := range bookings {
+		if booking.Status == domain.BookingStatusCancelled {
+			continue
+		}
+
+		// Split-seat legs share a passenger index; every other seat is its own passenger
+		index := make(map[string]int)
+		var passengers []ManifestPassenger
+		for i, seat := range booking.Seats {
+			key := fmt.Sprintf("seat:%d", i)
+			from, to := booking.FromStationID, booking.ToStationID
+			if len(seat.SegmentRange) > 0 {
+				key = fmt.Sprintf("passenger:%d", seat.PassengerIndex)
+				from, to = seat.FromStationID, seat.ToStationID
+			}
+
+			n, ok := index[key]
+			if !ok {
+				n = len(passengers)
+				index[key] = n
+				passengers = append(passengers, ManifestPassenger{
+					BookingID:     booking.BookingID,
+					PassengerName: seat.PassengerName,
+					PassengerNID:  seat.PassengerNID,
+					FromStationID: booking.FromStationID,
+					ToStationID:   booking.ToStationID,
+					CapacityClass: seat.CapacityClass,
+				})
+			}
+			passengers[n].Legs = append(passengers[n].Legs, ManifestLeg{
+				SeatID:        seat.SeatID,
+				SeatNumber:    seat.SeatNumber,
+				FromStationID: from,
+				ToStationID:   to,
+				TicketID:      seat.TicketID,
+			})
+		}
+
+		for _, passenger := range passengers {
+			legs := passenger.Legs
+			sort.SliceStable(legs, func(i, j int) bool { return stopOrder[legs[i].FromStationID] < stopOrder[legs[j].FromStationID] })
+			for _, leg := range legs[1:] {
+				passenger.SeatChangeStationIDs = append(passenger.SeatChangeStationIDs, leg.FromStationID)
+			}
+			manifest = append(manifest, passenger)
+		}
+	}
+
+	sort.SliceStable(manifest, func(i, j int) bool {
+		return stopOrder[manifest[i].FromStationID] < stopOrder[manifest[j].FromStationID]
+	})
+	return manifest, nil
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/kafka"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+)
+
+// QuotaDef configures a quota bucket when trip inventory is initialized
+type QuotaDef struct {
+	QuotaID        string
+	Name           string
+	QuotaType      string
+	SeatIDs        []string
+	SegmentIndexes []int
+	PartnerIDs     []string
+	ReleaseBefore  time.Duration
+}
+
+// QuotaAvailability reports free seats in one quota bucket for a journey
+type QuotaAvailability struct {
+	QuotaID        string
+	Name           string
+	QuotaType      string
+	AvailableCount int
+	Eligible       bool
+}
+
+// configureQuotas stores a trip's quota buckets and schedules their automatic release
+func (s *InventoryService) configureQuotas(ctx context.Context, orgID, tripID string, defs []QuotaDef) error {
+	if len(defs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	autoRelease := false
+	quotas := make([]domain.SeatQuota, 0, len(defs))
+	for _, def := range defs {
+		quotas = append(quotas, domain.SeatQuota{
+			OrganizationID: orgID,
+			TripID:         tripID,
+			QuotaID:        def.QuotaID,
+			Name:           def.Name,
+			QuotaType:      def.QuotaType,
+			SeatIDs:        def.SeatIDs,
+			SegmentIndexes: def.SegmentIndexes,
+			PartnerIDs:     def.PartnerIDs,
+			ReleaseBefore:  def.ReleaseBefore,
+			CreatedAt:      now,
+		})
+		if def.ReleaseBefore > 0 {
+			autoRelease = true
+		}
+	}
+
+	if err := s.scyllaRepo.SaveQuotas(ctx, quotas); err != nil {
+		return err
+	}
+	if autoRelease {
+		return s.redisRepo.TrackQuotaTrip(ctx, orgID, tripID)
+	}
+	return nil
+}
+
+// quotaRestrictions returns the quotas that currently restrict each seat on the journey
+func (s *InventoryService) quotaRestrictions(ctx context.Context, orgID, tripID string, segments []domain.Segment, segmentRange []int) (map[string][]*domain.SeatQuota, []domain.SeatQuota, error) {
+	quotas, err := s.scyllaRepo.GetTripQuotas(ctx, orgID, tripID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return activeQuotasBySeat(quotas, segments, segmentRange, time.Now()), quotas, nil
+}
+
+// ProcessQuotaReleases releases quotas whose cutoff has passed on every trip with pending releases
+func (s *InventoryService) ProcessQuotaReleases(ctx context.Context) error {
+	trips, err := s.redisRepo.ListQuotaTrips(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, trip := range trips {
+		if err := s.releaseDueQuotas(ctx, trip.OrganizationID, trip.TripID); err != nil {
+			logger.Warn("Failed to release trip quotas", "trip_id", trip.TripID, "error", err)
+		}
+	}
+	return nil
+}
+
+// releaseDueQuotas returns unsold quota seats to the general pool once the cutoff before
+// departure has passed on every segment the quota covers
+func (s *InventoryService) releaseDueQuotas(ctx context.Context, orgID, tripID string) error {
+	quotas, err := s.scyllaRepo.GetTripQuotas(ctx, orgID, tripID)
+	if err != nil {
+		return err
+	}
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	pending := false
+	released := false
+	for i := range quotas {
+		quota := &quotas[i]
+		if quota.Released || quota.ReleaseBefore <= 0 {
+			continue
+		}
+
+		due := true
+		for _, seg := range segments {
+			if quota.IsActive(seg, now) {
+				due = false
+				break
+			}
+		}
+		if !due {
+			pending = true
+			continue
+		}
+
+		if err := s.scyllaRepo.MarkQuotaReleased(ctx, orgID, tripID, quota.QuotaID); err != nil {
+			return err
+		}
+		released = true
+
+		s.publishEvent(ctx, kafka.EventQuotaReleased, tripID, map[string]interface{}{
+			"trip_id":     tripID,
+			"quota_id":    quota.QuotaID,
+			"quota_type":  quota.QuotaType,
+			"seat_ids":    quota.SeatIDs,
+			"released_at": now,
+		})
+		logger.Info("Released seat quota to general pool", "trip_id", tripID, "quota_id", quota.QuotaID, "seats", len(quota.SeatIDs))
+	}
+
+	if released {
+		s.redisRepo.InvalidateSeatMap(ctx, orgID, tripID)
+		s.triggerWaitlist(orgID, tripID)
+	}
+	if !pending {
+		return s.redisRepo.UntrackQuotaTrip(ctx, orgID, tripID)
+	}
+	return nil
+}
+
+// --- Quota Helpers ---
+
+// activeQuotasBySeat maps seat ID to the quotas restricting it on any segment of the journey
+func activeQuotasBySeat(quotas []domain.SeatQuota, segments []domain.Segment, segmentRange []int, now time.Time) map[string][]*domain.SeatQuota {
+	inRange := make(map[int]bool, len(segmentRange))
+	for _, idx := range segmentRange {
+		inRange[idx] = true
+	}
+
+	restrictions := make(map[string][]*domain.SeatQuota)
+	for i := range quotas {
+		quota := &quotas[i]
+
+		active := false
+		for _, seg := range segments {
+			if inRange[seg.SegmentIndex] && quota.IsActive(seg, now) {
+				active = true
+				break
+			}
+		}
+		if !active {
+			continue
+		}
+
+		for _, seatID := range quota.SeatIDs {
+			restrictions[seatID] = append(restrictions[seatID], quota)
+		}
+	}
+	return restrictions
+}
+
+// seatAllowed reports whether the caller is eligible for every quota restricting a seat
+func seatAllowed(restrictions []*domain.SeatQuota, claims domain.QuotaClaims) bool {
+	for _, quota := range restrictions {
+		if !quota.IsEligible(claims) {
+			return false
+		}
+	}
+	return true
+}
+
+// seatsBlockedByQuota returns the requested seats the caller may not hold
+func seatsBlockedByQuota(seatIDs []string, restrictions map[string][]*domain.SeatQuota, claims domain.QuotaClaims) []string {
+	var blocked []string
+	for _, seatID := range seatIDs {
+		if !seatAllowed(restrictions[seatID], claims) {
+			blocked = append(blocked, seatID)
+		}
+	}
+	return blocked
+}
+
+// summarizeQuotas counts available seats per active quota for the journey
+func summarizeQuotas(quotas []domain.SeatQuota, restrictions map[string][]*domain.SeatQuota, available []SeatInfo, claims domain.QuotaClaims) []QuotaAvailability {
+	counts := make(map[string]int)
+	for _, seat := range available {
+		for _, quota := range restrictions[seat.SeatID] {
+			counts[quota.QuotaID]++
+		}
+	}
+
+	active := make(map[string]bool)
+	for _, seatQuotas := range restrictions {
+		for _, quota := range seatQuotas {
+			active[quota.QuotaID] = true
+		}
+	}
+
+	var summary []QuotaAvailability
+	for i := range quotas {
+		quota := &quotas[i]
+		if !active[quota.QuotaID] {
+			continue
+		}
+		summary = append(summary, QuotaAvailability{
+			QuotaID:        quota.QuotaID,
+			Name:           quota.Name,
+			QuotaType:      quota.QuotaType,
+			AvailableCount: counts[quota.QuotaID],
+			Eligible:       quota.IsEligible(claims),
+		})
+	}
+	return summary
+}
@@ -0,0 +1,869 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/kafka"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/google/uuid"
+)
+
+// ReaccommodateRequest moves a trip's passengers onto the layout of a new vehicle
+type ReaccommodateRequest struct {
+	OrganizationID string
+	TripID         string
+	VehicleID      string
+	SeatConfig     SeatConfig
+	ScheduleID     string // Schedule seat blocks are applied again to the new seats
+}
+
+// ReaccommodationResult reports where passengers went and who could not be placed
+type ReaccommodationResult struct {
+	OldVehicleID string
+	NewVehicleID string
+	Moved        []domain.Reaccommodation
+	Unplaced     []domain.Reaccommodation
+}
+
+// seatMove is one old seat of a booking or hold, the segments it is taken on and its new seat
+type seatMove struct {
+	old      domain.SeatInventory
+	segments []int
+	newSeat  *SeatDef
+}
+
+// seatParty is a booking or hold whose seats should stay together on the new vehicle
+type seatParty struct {
+	kind    string // domain.ReaccommodationBooking or domain.ReaccommodationHold
+	id      string
+	created time.Time
+	moves   []*seatMove
+	hold    *domain.SeatHold
+}
+
+// carriedBlock is a blocked old seat, carried to the seat with the same number
+type carriedBlock struct {
+	reason    string
+	blockedBy string
+	until     time.Time
+}
+
+// TripVehicleID returns the vehicle a trip's inventory was built from, or "" when unknown
+func (s *InventoryService) TripVehicleID(ctx context.Context, orgID, tripID string) (string, error) {
+	layout, err := s.scyllaRepo.GetTripLayout(ctx, orgID, tripID)
+	if err != nil || layout == nil {
+		return "", err
+	}
+	return layout.VehicleID, nil
+}
+
+// ReaccommodateTrip rebuilds a trip's seats from a new vehicle and moves its passengers onto them.
+// Confirmed bookings are placed first, then active holds, each keeping its seat class and,
+// where possible, its seat numbers or a block of neighbouring seats. Booked passengers who do
+// not fit are flagged unplaced for a refund or another trip; a hold that does not fit whole is released.
+// Moved booking passengers get new ticket IDs, and every affected booking is announced for reissue.
+func (s *InventoryService) ReaccommodateTrip(ctx context.Context, req *ReaccommodateRequest) (*ReaccommodationResult, error) {
+	orgID, tripID := req.OrganizationID, req.TripID
+	oldVehicleID, err := s.TripVehicleID(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+	result := &ReaccommodationResult{OldVehicleID: oldVehicleID, NewVehicleID: req.VehicleID}
+	if oldVehicleID == req.VehicleID {
+		return result, nil
+	}
+
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+	segmentIndexes := make([]int, 0, len(segments))
+	for _, seg := range segments {
+		segmentIndexes = append(segmentIndexes, seg.SegmentIndex)
+	}
+
+	oldSeats, err := s.scyllaRepo.GetSeatAvailability(ctx, orgID, tripID, segmentIndexes)
+	if err != nil {
+		return nil, err
+	}
+	bookings, err := s.scyllaRepo.ListTripBookings(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+	bookingByID := make(map[string]*domain.Booking, len(bookings))
+	for i := range bookings {
+		if bookings[i].Status == domain.BookingStatusConfirmed {
+			bookingByID[bookings[i].BookingID] = &bookings[i]
+		}
+	}
+
+	parties, oldSeatIDs, blocks := s.collectSeatParties(ctx, orgID, oldSeats, bookingByID)
+
+	// Capacity classes the new vehicle no longer offers cannot carry their passengers
+	dropped, err := s.resizeTripCapacity(ctx, orgID, tripID, segmentIndexes, req.SeatConfig.Capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dropped) > 0 {
+		extra, err := s.capacityHoldParties(ctx, orgID, tripID, parties, dropped)
+		if err != nil {
+			return nil, err
+		}
+		parties = append(parties, extra...)
+	}
+
+	planner := newSeatPlanner(req.SeatConfig.Seats)
+	for _, party := range parties {
+		if party.hold != nil && holdsDroppedCapacity(party.hold, dropped) {
+			continue
+		}
+		planner.placeParty(party)
+	}
+
+	// Write the new seat rows with their final status and drop the old ones
+	rows := buildReaccommodatedSeats(req.SeatConfig.Seats, segmentIndexes, parties, blocks)
+	newIDs := make(map[string]bool, len(req.SeatConfig.Seats))
+	for _, seat := range req.SeatConfig.Seats {
+		newIDs[seat.SeatID] = true
+	}
+	var staleIDs []string
+	for _, id := range oldSeatIDs {
+		if !newIDs[id] {
+			staleIDs = append(staleIDs, id)
+		}
+	}
+	if err := s.scyllaRepo.ReplaceTripSeats(ctx, orgID, tripID, segmentIndexes, staleIDs, rows); err != nil {
+		return nil, err
+	}
+	if err := s.reconcileAvailability(ctx, orgID, tripID, rows, segmentIndexes); err != nil {
+		logger.Warn("Failed to reconcile availability counters after vehicle change", "trip_id", tripID, "error", err)
+	}
+	s.appendLedger(ctx, orgID, tripID, seatRowTransitions(rows, "vehicle changed to "+req.VehicleID))
+
+	if err := s.remapQuotaSeats(ctx, orgID, tripID, oldSeats, req.SeatConfig.Seats); err != nil {
+		return nil, err
+	}
+
+	layout := &domain.SeatLayout{
+		VehicleID:   req.VehicleID,
+		VehicleType: req.SeatConfig.VehicleType,
+		Sections:    req.SeatConfig.Sections,
+	}
+	if err := s.scyllaRepo.SaveTripLayout(ctx, orgID, tripID, layout); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	base := domain.Reaccommodation{
+		OrganizationID: orgID,
+		TripID:         tripID,
+		OldVehicleID:   oldVehicleID,
+		NewVehicleID:   req.VehicleID,
+		CreatedAt:      now,
+	}
+
+	var entries []domain.Reaccommodation
+	changedBookings := make(map[string][]domain.Reaccommodation)
+	movesByBooking := make(map[string]*seatParty)
+	for _, party := range parties {
+		if party.kind == domain.ReaccommodationBooking {
+			movesByBooking[party.id] = party
+		}
+	}
+
+	// Bookings: rewrite their seats and reissue tickets of moved passengers
+	for _, booking := range bookingByID {
+		party := movesByBooking[booking.BookingID]
+		bookingEntries, changed := reaccommodateBooking(booking, party, dropped, base)
+		if !changed {
+			continue
+		}
+		if err := s.scyllaRepo.UpdateBookingSeats(ctx, booking.BookingID, booking.Seats); err != nil {
+			return nil, err
+		}
+		entries = append(entries, bookingEntries...)
+		changedBookings[booking.BookingID] = bookingEntries
+	}
+
+	// Holds: move them whole, or release them
+	for _, party := range parties {
+		if party.kind != domain.ReaccommodationHold {
+			continue
+		}
+		holdEntries, err := s.reaccommodateHold(ctx, party, dropped, base)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, holdEntries...)
+	}
+
+	if len(entries) > 0 {
+		if err := s.scyllaRepo.SaveReaccommodations(ctx, entries); err != nil {
+			return nil, err
+		}
+	}
+	for _, entry := range entries {
+		if entry.Status == domain.ReaccommodationMoved {
+			result.Moved = append(result.Moved, entry)
+		} else {
+			result.Unplaced = append(result.Unplaced, entry)
+		}
+	}
+
+	if req.ScheduleID != "" {
+		if err := s.applyScheduleBlocks(ctx, orgID, req.ScheduleID, tripID); err != nil {
+			logger.Warn("Failed to reapply schedule blocks after vehicle change", "trip_id", tripID, "error", err)
+		}
+	}
+	if err := s.reapplyTripTranches(ctx, orgID, tripID); err != nil {
+		logger.Warn("Failed to reapply release tranches after vehicle change", "trip_id", tripID, "error", err)
+	}
+
+	go func() {
+		bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		s.redisRepo.InvalidateSeatMap(bgCtx, orgID, tripID)
+	}()
+
+	for bookingID, bookingEntries := range changedBookings {
+		s.publishReaccommodationEvent(ctx, bookingByID[bookingID], bookingEntries, oldVehicleID, req.VehicleID)
+	}
+	if len(req.SeatConfig.Capacity) > 0 || len(dropped) > 0 {
+		s.publishCapacityEvent(ctx, orgID, tripID)
+	}
+
+	// A larger vehicle may have room for the waitlist
+	s.triggerWaitlist(orgID, tripID)
+
+	logger.Info("Re-accommodated trip on new vehicle", "trip_id", tripID,
+		"old_vehicle_id", oldVehicleID, "new_vehicle_id", req.VehicleID,
+		"moved", len(result.Moved), "unplaced", len(result.Unplaced))
+	return result, nil
+}
+
+// GetReaccommodations lists the recorded moves of a trip, oldest first
+func (s *InventoryService) GetReaccommodations(ctx context.Context, orgID, tripID string, unplacedOnly bool) ([]domain.Reaccommodation, error) {
+	all, err := s.scyllaRepo.ListReaccommodations(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []domain.Reaccommodation
+	for _, entry := range all {
+		if unplacedOnly && entry.Status != domain.ReaccommodationUnplaced {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].CreatedAt.Equal(entries[j].CreatedAt) {
+			return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+		}
+		if entries[i].ReferenceID != entries[j].ReferenceID {
+			return entries[i].ReferenceID < entries[j].ReferenceID
+		}
+		return entries[i].PassengerIndex < entries[j].PassengerIndex
+	})
+	return entries, nil
+}
+
+// collectSeatParties groups the old seats by the booking or active hold that occupies them.
+// Bookings come first, oldest first, then holds. Blocked seats are returned by seat number and segment.
+func (s *InventoryService) collectSeatParties(ctx context.Context, orgID string, oldSeats []domain.SeatInventory, bookings map[string]*domain.Booking) ([]*seatParty, []string, map[string]map[int]carriedBlock) {
+	partyByKey := make(map[string]*seatParty)
+	moveByKey := make(map[string]*seatMove)
+	blocks := make(map[string]map[int]carriedBlock)
+	seen := make(map[string]bool)
+	var oldSeatIDs []string
+	var parties []*seatParty
+
+	now := time.Now()
+	for _, seat := range oldSeats {
+		if !seen[seat.SeatID] {
+			seen[seat.SeatID] = true
+			oldSeatIDs = append(oldSeatIDs, seat.SeatID)
+		}
+
+		var kind, id string
+		switch {
+		case seat.Status == domain.SeatStatusBooked && bookings[seat.BookingID] != nil:
+			kind, id = domain.ReaccommodationBooking, seat.BookingID
+		case seat.Status == domain.SeatStatusHeld && now.Before(seat.HoldExpiry):
+			kind, id = domain.ReaccommodationHold, seat.HoldID
+		case seat.Status == domain.SeatStatusBlocked:
+			if blocks[seat.SeatNumber] == nil {
+				blocks[seat.SeatNumber] = make(map[int]carriedBlock)
+			}
+			blocks[seat.SeatNumber][seat.SegmentIndex] = carriedBlock{seat.BlockReason, seat.BlockedBy, seat.BlockedUntil}
+			continue
+		default:
+			continue
+		}
+
+		partyKey := kind + ":" + id
+		party, ok := partyByKey[partyKey]
+		if !ok {
+			party = &seatParty{kind: kind, id: id}
+			if kind == domain.ReaccommodationBooking {
+				party.created = bookings[id].CreatedAt
+			} else {
+				hold, err := s.holdRepo.GetHold(ctx, orgID, id)
+				if err != nil || hold.Status != domain.HoldStatusActive {
+					// The hold record is gone or closed; its seats are not carried over
+					partyByKey[partyKey] = &seatParty{kind: kind, id: id}
+					continue
+				}
+				party.hold = hold
+				party.created = hold.CreatedAt
+			}
+			partyByKey[partyKey] = party
+			parties = append(parties, party)
+		}
+		if kind == domain.ReaccommodationHold && party.hold == nil {
+			continue
+		}
+
+		moveKey := partyKey + ":" + seat.SeatID
+		move, ok := moveByKey[moveKey]
+		if !ok {
+			move = &seatMove{old: seat}
+			moveByKey[moveKey] = move
+			party.moves = append(party.moves, move)
+		}
+		move.segments = append(move.segments, seat.SegmentIndex)
+	}
+
+	sort.SliceStable(parties, func(i, j int) bool {
+		if parties[i].kind != parties[j].kind {
+			return parties[i].kind == domain.ReaccommodationBooking
+		}
+		return parties[i].created.Before(parties[j].created)
+	})
+	for _, party := range parties {
+		sort.SliceStable(party.moves, func(i, j int) bool {
+			a, b := party.moves[i].old, party.moves[j].old
+			if a.SectionID != b.SectionID {
+				return a.SectionID < b.SectionID
+			}
+			if a.Row != b.Row {
+				return a.Row < b.Row
+			}
+			return a.Column < b.Column
+		})
+	}
+	return parties, oldSeatIDs, blocks
+}
+
+// remapQuotaSeats moves quota seats to the new seats with the same number.
+// Numbers the new vehicle does not have drop out of the quota.
+func (s *InventoryService) remapQuotaSeats(ctx context.Context, orgID, tripID string, oldSeats []domain.SeatInventory, newSeats []SeatDef) error {
+	quotas, err := s.scyllaRepo.GetTripQuotas(ctx, orgID, tripID)
+	if err != nil || len(quotas) == 0 {
+		return err
+	}
+
+	numberByOldID := make(map[string]string, len(oldSeats))
+	for _, seat := range oldSeats {
+		numberByOldID[seat.SeatID] = seat.SeatNumber
+	}
+	newIDByNumber := make(map[string]string, len(newSeats))
+	for _, seat := range newSeats {
+		newIDByNumber[seat.SeatNumber] = seat.SeatID
+	}
+
+	for _, quota := range quotas {
+		var seatIDs []string
+		for _, oldID := range quota.SeatIDs {
+			if newID, ok := newIDByNumber[numberByOldID[oldID]]; ok {
+				seatIDs = append(seatIDs, newID)
+			}
+		}
+		if err := s.scyllaRepo.UpdateQuotaSeats(ctx, orgID, tripID, quota.QuotaID, seatIDs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resizeTripCapacity applies the new vehicle's deck and standing places, keeping what is
+// already held and booked. Returns the classes the new vehicle no longer offers.
+func (s *InventoryService) resizeTripCapacity(ctx context.Context, orgID, tripID string, segmentIndexes []int, defs []CapacityDef) (map[string]bool, error) {
+	current, err := s.scyllaRepo.GetCapacity(ctx, orgID, tripID, segmentIndexes)
+	if err != nil {
+		return nil, err
+	}
+
+	offered := make(map[string]bool, len(defs))
+	for _, def := range defs {
+		if def.CapacityClass == "" || def.MaxCount <= 0 {
+			continue
+		}
+		offered[def.CapacityClass] = true
+		for _, segIdx := range segmentIndexes {
+			if err := s.scyllaRepo.ResizeCapacity(ctx, orgID, tripID, segIdx, def.CapacityClass, def.MaxCount, def.PricePaisa); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	dropped := make(map[string]bool)
+	for _, row := range current {
+		if offered[row.CapacityClass] {
+			continue
+		}
+		dropped[row.CapacityClass] = true
+		if err := s.scyllaRepo.ResizeCapacity(ctx, orgID, tripID, row.SegmentIndex, row.CapacityClass, 0, row.PricePaisa); err != nil {
+			return nil, err
+		}
+	}
+	return dropped, nil
+}
+
+func holdsDroppedCapacity(hold *domain.SeatHold, dropped map[string]bool) bool {
+	for _, item := range hold.Capacity {
+		if dropped[item.CapacityClass] {
+			return true
+		}
+	}
+	return false
+}
+
+// seatPlanner assigns new seats to parties, tracking which segments of each new seat are taken
+type seatPlanner struct {
+	byClass  map[string][]*SeatDef // In layout order: section, row, column
+	byNumber map[string]*SeatDef
+	taken    map[string]map[int]bool
+}
+
+func newSeatPlanner(seats []SeatDef) *seatPlanner {
+	p := &seatPlanner{
+		byClass:  make(map[string][]*SeatDef),
+		byNumber: make(map[string]*SeatDef, len(seats)),
+		taken:    make(map[string]map[int]bool),
+	}
+	for i := range seats {
+		seat := &seats[i]
+		p.byClass[seat.SeatClass] = append(p.byClass[seat.SeatClass], seat)
+		p.byNumber[seat.SeatNumber] = seat
+	}
+	for _, class := range p.byClass {
+		sort.SliceStable(class, func(i, j int) bool {
+			if class[i].SectionID != class[j].SectionID {
+				return class[i].SectionID < class[j].SectionID
+			}
+			if class[i].Row != class[j].Row {
+				return class[i].Row < class[j].Row
+			}
+			return class[i].Column < class[j].Column
+		})
+	}
+	return p
+}
+
+func (p *seatPlanner) free(seat *SeatDef, segments []int) bool {
+	for _, segIdx := range segments {
+		if p.taken[seat.SeatID][segIdx] {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *seatPlanner) take(move *seatMove, seat *SeatDef) {
+	if p.taken[seat.SeatID] == nil {
+		p.taken[seat.SeatID] = make(map[int]bool)
+	}
+	for _, segIdx := range move.segments {
+		p.taken[seat.SeatID][segIdx] = true
+	}
+	move.newSeat = seat
+}
+
+func (p *seatPlanner) give(move *seatMove) {
+	if move.newSeat == nil {
+		return
+	}
+	for _, segIdx := range move.segments {
+		delete(p.taken[move.newSeat.SeatID], segIdx)
+	}
+	move.newSeat = nil
+}
+
+// placeParty seats one booking or hold, class by class.
+// A hold that cannot be seated whole gives back what it took.
+func (p *seatPlanner) placeParty(party *seatParty) {
+	var classes []string
+	byClass := make(map[string][]*seatMove)
+	for _, move := range party.moves {
+		class := move.old.SeatClass
+		if _, ok := byClass[class]; !ok {
+			classes = append(classes, class)
+		}
+		byClass[class] = append(byClass[class], move)
+	}
+	for _, class := range classes {
+		p.placeGroup(byClass[class])
+	}
+
+	if party.kind != domain.ReaccommodationHold {
+		return
+	}
+	for _, move := range party.moves {
+		if move.newSeat == nil {
+			for _, m := range party.moves {
+				p.give(m)
+			}
+			return
+		}
+	}
+}
+
+// placeGroup seats moves of one class: on their old seat numbers when the new vehicle has them
+// free in the same class, else on the tightest run of free neighbouring seats nearest their old row,
+// else one by one wherever seats of the class are left
+func (p *seatPlanner) placeGroup(moves []*seatMove) {
+	class := moves[0].old.SeatClass
+
+	sameNumbers := make([]*SeatDef, len(moves))
+	for i, move := range moves {
+		seat := p.byNumber[move.old.SeatNumber]
+		if seat == nil || seat.SeatClass != class || !p.free(seat, move.segments) {
+			sameNumbers = nil
+			break
+		}
+		sameNumbers[i] = seat
+	}
+	if sameNumbers != nil {
+		for i, move := range moves {
+			p.take(move, sameNumbers[i])
+		}
+		return
+	}
+
+	candidates := p.byClass[class]
+	n := len(moves)
+	best, bestScore := -1, 0
+	for i := 0; i+n <= len(candidates); i++ {
+		window := candidates[i : i+n]
+		if window[0].SectionID != window[n-1].SectionID {
+			continue
+		}
+		fits := true
+		for k, move := range moves {
+			if !p.free(window[k], move.segments) {
+				fits = false
+				break
+			}
+		}
+		if !fits {
+			continue
+		}
+		score := (window[n-1].Row-window[0].Row)*1000 + abs(window[0].Row-moves[0].old.Row)
+		if best < 0 || score < bestScore {
+			best, bestScore = i, score
+		}
+	}
+	if best >= 0 {
+		for k, move := range moves {
+			p.take(move, candidates[best+k])
+		}
+		return
+	}
+
+	if n > 1 {
+		for _, move := range moves {
+			p.placeGroup([]*seatMove{move})
+		}
+	}
+}
+
+// buildReaccommodatedSeats lays out the new vehicle's seat rows for every segment.
+// Seats given to a booking or hold carry its status; free seats with the number of a
+// blocked old seat stay blocked.
+func buildReaccommodatedSeats(seats []SeatDef, segmentIndexes []int, parties []*seatParty, blocks map[string]map[int]carriedBlock) []domain.SeatInventory {
+	type occupant struct {
+		party *seatParty
+		move  *seatMove
+	}
+	occupied := make(map[string]map[int]occupant)
+	for _, party := range parties {
+		for _, move := range party.moves {
+			if move.newSeat == nil {
+				continue
+			}
+			if occupied[move.newSeat.SeatID] == nil {
+				occupied[move.newSeat.SeatID] = make(map[int]occupant)
+			}
+			for _, segIdx := range move.segments {
+				occupied[move.newSeat.SeatID][segIdx] = occupant{party, move}
+			}
+		}
+	}
+
+	rows := make([]domain.SeatInventory, 0, len(seats)*len(segmentIndexes))
+	for _, segIdx := range segmentIndexes {
+		for _, def := range seats {
+			row := domain.SeatInventory{
+				SegmentIndex: segIdx,
+				SeatID:       def.SeatID,
+				SeatNumber:   def.SeatNumber,
+				SeatClass:    def.SeatClass,
+				SeatType:     def.SeatType,
+				Status:       domain.SeatStatusAvailable,
+				PricePaisa:   def.PricePaisa,
+				Row:          def.Row,
+				Column:       def.Column,
+				SectionID:    def.SectionID,
+				Berth:        def.Berth,
+				IsAccessible: def.IsAccessible,
+				HasPower:     def.HasPower,
+			}
+			if occ, ok := occupied[def.SeatID][segIdx]; ok {
+				if occ.party.kind == domain.ReaccommodationBooking {
+					row.Status = domain.SeatStatusBooked
+					row.BookingID = occ.party.id
+				} else {
+					row.Status = domain.SeatStatusHeld
+					row.HoldID = occ.party.id
+					row.HoldUserID = occ.move.old.HoldUserID
+					row.HoldExpiry = occ.move.old.HoldExpiry
+				}
+			} else if block, ok := blocks[def.SeatNumber][segIdx]; ok {
+				row.Status = domain.SeatStatusBlocked
+				row.BlockReason = block.reason
+				row.BlockedBy = block.blockedBy
+				row.BlockedUntil = block.until
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// reaccommodateBooking moves a booking's seats to their new seats and reissues their tickets.
+// Passengers left without a seat, or whose capacity class is gone, are flagged unplaced.
+// Reports whether the booking changed.
+func reaccommodateBooking(booking *domain.Booking, party *seatParty, dropped map[string]bool, base domain.Reaccommodation) ([]domain.Reaccommodation, bool) {
+	moveBySeat := make(map[string]*seatMove)
+	if party != nil {
+		for _, move := range party.moves {
+			moveBySeat[move.old.SeatID] = move
+		}
+	}
+
+	var entries []domain.Reaccommodation
+	for i := range booking.Seats {
+		seat := &booking.Seats[i]
+		entry := base
+		entry.ReferenceID = booking.BookingID
+		entry.ReferenceType = domain.ReaccommodationBooking
+		entry.OrderID = booking.OrderID
+		entry.UserID = booking.UserID
+		entry.PassengerIndex = seat.PassengerIndex
+		entry.PassengerName = seat.PassengerName
+		entry.PassengerNID = seat.PassengerNID
+		entry.SeatClass = seat.SeatClass
+		entry.OldSeatID = seat.SeatID
+		entry.OldSeatNumber = seat.SeatNumber
+		entry.OldTicketID = seat.TicketID
+
+		if seat.SeatID == "" {
+			if seat.CapacityClass == "" || !dropped[seat.CapacityClass] {
+				continue
+			}
+			entry.Status = domain.ReaccommodationUnplaced
+			entries = append(entries, entry)
+			continue
+		}
+
+		move, ok := moveBySeat[seat.SeatID]
+		if !ok {
+			continue
+		}
+		if move.newSeat == nil {
+			entry.Status = domain.ReaccommodationUnplaced
+			seat.SeatID = ""
+			seat.SeatNumber = ""
+			entries = append(entries, entry)
+			continue
+		}
+
+		seat.SeatID = move.newSeat.SeatID
+		seat.SeatNumber = move.newSeat.SeatNumber
+		seat.TicketID = uuid.New().String()
+		entry.Status = domain.ReaccommodationMoved
+		entry.NewSeatID = seat.SeatID
+		entry.NewSeatNumber = seat.SeatNumber
+		entry.NewTicketID = seat.TicketID
+		entries = append(entries, entry)
+	}
+	return entries, len(entries) > 0
+}
+
+// reaccommodateHold points a placed hold at its new seats, or releases a hold that did not fit.
+// A hold is placed only when every seat moved and none of its capacity classes was dropped.
+func (s *InventoryService) reaccommodateHold(ctx context.Context, party *seatParty, dropped map[string]bool, base domain.Reaccommodation) ([]domain.Reaccommodation, error) {
+	hold := party.hold
+	if hold == nil {
+		return nil, nil
+	}
+
+	newSeatByOld := make(map[string]*SeatDef, len(party.moves))
+	oldByID := make(map[string]domain.SeatInventory, len(party.moves))
+	for _, move := range party.moves {
+		oldByID[move.old.SeatID] = move.old
+		if move.newSeat != nil {
+			newSeatByOld[move.old.SeatID] = move.newSeat
+		}
+	}
+	placed := !holdsDroppedCapacity(hold, dropped)
+	for _, seatID := range hold.SeatIDs {
+		if newSeatByOld[seatID] == nil {
+			placed = false
+		}
+	}
+	if placed && len(hold.SeatIDs) == 0 {
+		return nil, nil // Capacity-only hold in classes the new vehicle still offers
+	}
+
+	status := domain.ReaccommodationMoved
+	if !placed {
+		status = domain.ReaccommodationUnplaced
+	}
+	newEntry := func(passengerIndex int, seatClass string) domain.Reaccommodation {
+		entry := base
+		entry.ReferenceID = hold.HoldID
+		entry.ReferenceType = domain.ReaccommodationHold
+		entry.UserID = hold.UserID
+		entry.PassengerIndex = passengerIndex
+		entry.SeatClass = seatClass
+		entry.Status = status
+		return entry
+	}
+
+	// One entry per passenger seat: legs on split-seat holds, else the seat list
+	legs := hold.Legs
+	if len(legs) == 0 {
+		for i, seatID := range hold.SeatIDs {
+			legs = append(legs, domain.SeatLeg{PassengerIndex: i, SeatID: seatID})
+		}
+	}
+	var entries []domain.Reaccommodation
+	for _, leg := range legs {
+		old := oldByID[leg.SeatID]
+		entry := newEntry(leg.PassengerIndex, old.SeatClass)
+		entry.OldSeatID = leg.SeatID
+		entry.OldSeatNumber = old.SeatNumber
+		if seat := newSeatByOld[leg.SeatID]; placed && seat != nil {
+			entry.NewSeatID = seat.SeatID
+			entry.NewSeatNumber = seat.SeatNumber
+		}
+		entries = append(entries, entry)
+	}
+
+	if !placed {
+		passengerIndex := hold.SeatedPassengerCount()
+		for _, item := range hold.Capacity {
+			for n := 0; n < item.Quantity; n++ {
+				entries = append(entries, newEntry(passengerIndex, item.CapacityClass))
+				passengerIndex++
+			}
+		}
+		if err := s.releaseCapacity(ctx, base.OrganizationID, base.TripID, hold.HoldID, hold.Capacity, domain.CapacityHoldReleased); err != nil {
+			return nil, err
+		}
+		if err := s.holdRepo.UpdateHoldStatus(ctx, base.OrganizationID, hold.HoldID, domain.HoldStatusReleased); err != nil {
+			return nil, err
+		}
+		return entries, nil
+	}
+
+	seatIDs := make([]string, 0, len(hold.SeatIDs))
+	for _, seatID := range hold.SeatIDs {
+		seatIDs = append(seatIDs, newSeatByOld[seatID].SeatID)
+	}
+	var newLegs []domain.SeatLeg
+	for _, leg := range hold.Legs {
+		leg.SeatID = newSeatByOld[leg.SeatID].SeatID
+		newLegs = append(newLegs, leg)
+	}
+	if err := s.holdRepo.ReplaceHoldSeats(ctx, base.OrganizationID, hold.HoldID, seatIDs, newLegs); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// capacityHoldParties returns the active capacity-only holds that lost their class,
+// so they are released and flagged with the seated holds
+func (s *InventoryService) capacityHoldParties(ctx context.Context, orgID, tripID string, parties []*seatParty, dropped map[string]bool) ([]*seatParty, error) {
+	records, err := s.scyllaRepo.ListCapacityHolds(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(parties))
+	for _, party := range parties {
+		if party.kind == domain.ReaccommodationHold {
+			known[party.id] = true
+		}
+	}
+
+	var extra []*seatParty
+	now := time.Now()
+	for _, record := range records {
+		if known[record.HoldID] || !dropped[record.CapacityClass] ||
+			record.Status != domain.CapacityHoldHeld || !now.Before(record.HoldExpiry) {
+			continue
+		}
+		known[record.HoldID] = true
+		hold, err := s.holdRepo.GetHold(ctx, orgID, record.HoldID)
+		if err != nil || hold.Status != domain.HoldStatusActive {
+			continue
+		}
+		extra = append(extra, &seatParty{kind: domain.ReaccommodationHold, id: hold.HoldID, created: hold.CreatedAt, hold: hold})
+	}
+	return extra, nil
+}
+
+// publishReaccommodationEvent announces one booking's moved and unplaced passengers,
+// so the order can be updated, tickets reissued and passengers notified
+func (s *InventoryService) publishReaccommodationEvent(ctx context.Context, booking *domain.Booking, entries []domain.Reaccommodation, oldVehicleID, newVehicleID string) {
+	moved := make([]map[string]interface{}, 0, len(entries))
+	unplaced := make([]map[string]interface{}, 0)
+	for _, e := range entries {
+		passenger := map[string]interface{}{
+			"passenger_index": e.PassengerIndex,
+			"passenger_name":  e.PassengerName,
+			"passenger_nid":   e.PassengerNID,
+			"seat_class":      e.SeatClass,
+			"old_seat_id":     e.OldSeatID,
+			"old_seat_number": e.OldSeatNumber,
+			"old_ticket_id":   e.OldTicketID,
+		}
+		if e.Status == domain.ReaccommodationMoved {
+			passenger["new_seat_id"] = e.NewSeatID
+			passenger["new_seat_number"] = e.NewSeatNumber
+			passenger["new_ticket_id"] = e.NewTicketID
+			moved = append(moved, passenger)
+		} else {
+			unplaced = append(unplaced, passenger)
+		}
+	}
+
+	s.publishEvent(ctx, kafka.EventPassengersReaccommodated, booking.TripID, map[string]interface{}{
+		"trip_id":         booking.TripID,
+		"organization_id": booking.OrganizationID,
+		"booking_id":      booking.BookingID,
+		"order_id":        booking.OrderID,
+		"user_id":         booking.UserID,
+		"old_vehicle_id":  oldVehicleID,
+		"new_vehicle_id":  newVehicleID,
+		"moved":           moved,
+		"unplaced":        unplaced,
+		"updated_at":      time.Now(),
+	})
+}
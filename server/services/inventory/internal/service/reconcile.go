@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+)
+
+// reconcileGrace leaves recent changes alone: a hold or booking is written to Scylla
+// a moment before Redis or the bookings table, and lapsed holds wait for the sweeper
+const reconcileGrace = 5 * time.Minute
+
+// OrderLookup reports the status of the order behind a booking, e.g. "confirmed" or "cancelled".
+// Unknown orders return domain.ErrOrderNotFound.
+type OrderLookup interface {
+	GetOrderStatus(ctx context.Context, orderID, userID string) (string, error)
+}
+
+// Order statuses after which the order no longer needs its seats
+var closedOrderStatuses = map[string]bool{
+	"failed":    true,
+	"cancelled": true,
+	"expired":   true,
+	"refunded":  true,
+}
+
+// ReconcileInventory reconciles every trip with inventory; see ReconcileTrip.
+// A trip that cannot be checked is logged and skipped.
+func (s *InventoryService) ReconcileInventory(ctx context.Context, orders OrderLookup, repair bool) ([]*domain.ReconciliationReport, error) {
+	trips, err := s.scyllaRepo.ListTrips(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []*domain.ReconciliationReport
+	for _, trip := range trips {
+		report, err := s.ReconcileTrip(ctx, trip.OrganizationID, trip.TripID, orders, repair)
+		if err != nil {
+			logger.Warn("Failed to reconcile trip inventory", "trip_id", trip.TripID, "error", err)
+			continue
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// seatKey names one seat on one segment
+type seatKey struct {
+	segIdx int
+	seatID string
+}
+
+// seatRelease groups freed seats by what they were freed from, for the seat ledger
+type seatRelease struct {
+	fromStatus string
+	holdID     string
+	bookingID  string
+	kind       string
+}
+
+// ReconcileTrip compares a trip's seat rows with the Redis holds and seat locks, its bookings
+// and, when orders is set, the order service.
+// With repair it fixes what it safely can: seats held for dead holds or booked for dead
+// bookings are freed, stale seat locks deleted, holds that lost their seats released and
+// bookings of closed orders cancelled. Double bookings, seats missing from their booking
+// and bookings without an order are only reported; someone has to decide who keeps the seat.
+func (s *InventoryService) ReconcileTrip(ctx context.Context, orgID, tripID string, orders OrderLookup, repair bool) (*domain.ReconciliationReport, error) {
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		return nil, domain.ErrTripInventoryNotFound
+	}
+	segmentIndexes := make([]int, 0, len(segments))
+	for _, seg := range segments {
+		segmentIndexes = append(segmentIndexes, seg.SegmentIndex)
+	}
+
+	seats, err := s.scyllaRepo.GetSeatAvailability(ctx, orgID, tripID, segmentIndexes)
+	if err != nil {
+		return nil, err
+	}
+	holds, err := s.holdRepo.ListTripHolds(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+	bookings, err := s.scyllaRepo.ListTripBookings(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+	locks, err := s.redisRepo.ListSeatLocks(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.ReconciliationReport{OrganizationID: orgID, TripID: tripID, CheckedAt: time.Now()}
+	add := func(d domain.Discrepancy) {
+		if d.Repaired {
+			report.Repaired++
+		}
+		report.Discrepancies = append(report.Discrepancies, d)
+	}
+
+	holdByID := make(map[string]*domain.SeatHold, len(holds))
+	for _, hold := range holds {
+		holdByID[hold.HoldID] = hold
+	}
+	bookingByID := make(map[string]*domain.Booking, len(bookings))
+	for i := range bookings {
+		bookingByID[bookings[i].BookingID] = &bookings[i]
+	}
+
+	now := report.CheckedAt
+	rows := make(map[seatKey]*domain.SeatInventory, len(seats))
+	heldFor := make(map[string]map[seatKey]bool)
+	freed := make(map[seatRelease]map[int][]string)
+	free := func(r seatRelease, seat *domain.SeatInventory) bool {
+		var err error
+		if r.fromStatus == domain.SeatStatusHeld {
+			err = s.scyllaRepo.ReleaseHold(ctx, orgID, tripID, r.holdID, []int{seat.SegmentIndex}, []string{seat.SeatID})
+		} else {
+			err = s.scyllaRepo.CancelBooking(ctx, orgID, tripID, r.bookingID, []int{seat.SegmentIndex}, []string{seat.SeatID})
+		}
+		if err != nil {
+			logger.Warn("Failed to free seat", "trip_id", tripID, "seat_id", seat.SeatID, "segment", seat.SegmentIndex, "error", err)
+			return false
+		}
+		if freed[r] == nil {
+			freed[r] = make(map[int][]string)
+		}
+		freed[r][seat.SegmentIndex] = append(freed[r][seat.SegmentIndex], seat.SeatID)
+		return true
+	}
+
+	// Seat rows held or booked for something that no longer exists
+	for i := range seats {
+		seat := &seats[i]
+		key := seatKey{seat.SegmentIndex, seat.SeatID}
+		rows[key] = seat
+		recent := now.Sub(seat.UpdatedAt) < reconcileGrace
+
+		switch seat.Status {
+		case domain.SeatStatusHeld:
+			if heldFor[seat.HoldID] == nil {
+				heldFor[seat.HoldID] = make(map[seatKey]bool)
+			}
+			heldFor[seat.HoldID][key] = true
+			if recent {
+				continue
+			}
+
+			var detail string
+			hold := holdByID[seat.HoldID]
+			switch {
+			case now.Sub(seat.HoldExpiry) > reconcileGrace:
+				detail = fmt.Sprintf("hold lapsed at %s and was never swept", seat.HoldExpiry.Format(time.RFC3339))
+			case !now.Before(seat.HoldExpiry):
+				continue // Lapsed moments ago; the sweeper will get to it
+			case hold == nil:
+				detail = "hold record is missing from Redis"
+			case hold.Status != domain.HoldStatusActive:
+				detail = "hold is " + hold.Status
+			default:
+				continue
+			}
+			d := domain.Discrepancy{
+				Kind:         domain.DiscrepancyOrphanedHold,
+				SegmentIndex: seat.SegmentIndex,
+				SeatID:       seat.SeatID,
+				HoldID:       seat.HoldID,
+				Detail:       detail,
+			}
+			if repair {
+				d.Repaired = free(seatRelease{domain.SeatStatusHeld, seat.HoldID, "", d.Kind}, seat)
+			}
+			add(d)
+
+		case domain.SeatStatusBooked:
+			booking := bookingByID[seat.BookingID]
+			if recent || (booking != nil && booking.Status != domain.BookingStatusCancelled) {
+				continue
+			}
+			detail := "booking is missing"
+			if booking != nil {
+				detail = "booking is cancelled"
+			}
+			d := domain.Discrepancy{
+				Kind:         domain.DiscrepancyOrphanedBooking,
+				SegmentIndex: seat.SegmentIndex,
+				SeatID:       seat.SeatID,
+				BookingID:    seat.BookingID,
+				Detail:       detail,
+			}
+			if repair {
+				d.Repaired = free(seatRelease{domain.SeatStatusBooked, "", seat.BookingID, d.Kind}, seat)
+			}
+			add(d)
+		}
+	}
+
+	// Active holds whose seats someone else has
+	for _, hold := range holds {
+		if hold.Status != domain.HoldStatusActive || !now.Before(hold.ExpiresAt) || now.Sub(hold.CreatedAt) < reconcileGrace {
+			continue
+		}
+		missing, total := 0, 0
+		for _, group := range hold.SeatGroups() {
+			for _, segIdx := range group.SegmentRange {
+				for _, seatID := range group.SeatIDs {
+					total++
+					if !heldFor[hold.HoldID][seatKey{segIdx, seatID}] {
+						missing++
+					}
+				}
+			}
+		}
+		if missing == 0 {
+			continue
+		}
+		d := domain.Discrepancy{
+			Kind:   domain.DiscrepancyHoldWithoutSeats,
+			HoldID: hold.HoldID,
+			Detail: fmt.Sprintf("%d of %d held seat segments are not held for the hold", missing, total),
+		}
+		if repair {
+			d.Repaired = s.releaseBrokenHold(ctx, orgID, hold, heldFor[hold.HoldID])
+		}
+		add(d)
+	}
+
+	// Seat locks should only live for the moment a hold is being placed
+	for _, lock := range locks {
+		if lock.TTL != -1 {
+			continue
+		}
+		d := domain.Discrepancy{
+			Kind:         domain.DiscrepancyStaleSeatLock,
+			SegmentIndex: lock.SegmentIndex,
+			SeatID:       lock.SeatID,
+			Detail:       "seat lock has no expiry",
+		}
+		if repair {
+			if err := s.redisRepo.DeleteSeatLock(ctx, lock.Key); err != nil {
+				logger.Warn("Failed to delete stale seat lock", "key", lock.Key, "error", err)
+			} else {
+				d.Repaired = true
+			}
+		}
+		add(d)
+	}
+
+	// Every seat of a live booking should be booked for it, and for it alone
+	claims := make(map[seatKey][]string)
+	var claimed []seatKey
+	for _, booking := range bookings {
+		if booking.Status == domain.BookingStatusCancelled {
+			continue
+		}
+		for _, group := range booking.SeatGroups() {
+			for _, segIdx := range group.SegmentRange {
+				for _, seatID := range group.SeatIDs {
+					key := seatKey{segIdx, seatID}
+					if len(claims[key]) == 0 {
+						claimed = append(claimed, key)
+					}
+					claims[key] = append(claims[key], booking.BookingID)
+				}
+			}
+		}
+	}
+	for _, key := range claimed {
+		owners := claims[key]
+		if len(owners) > 1 {
+			add(domain.Discrepancy{
+				Kind:         domain.DiscrepancyDoubleBooked,
+				SegmentIndex: key.segIdx,
+				SeatID:       key.seatID,
+				Detail:       fmt.Sprintf("seat is claimed by bookings %v", owners),
+			})
+			continue
+		}
+
+		row := rows[key]
+		var detail string
+		switch {
+		case row == nil:
+			detail = "seat row is missing"
+		case row.Status != domain.SeatStatusBooked:
+			detail = "seat row is " + row.Status
+		case row.BookingID != owners[0]:
+			detail = "seat row is booked for " + row.BookingID
+		default:
+			continue
+		}
+		add(domain.Discrepancy{
+			Kind:         domain.DiscrepancyUnbookedSeat,
+			SegmentIndex: key.segIdx,
+			SeatID:       key.seatID,
+			BookingID:    owners[0],
+			Detail:       detail,
+		})
+	}
+
+	// Live bookings need a live order
+	if orders != nil {
+		for _, booking := range bookings {
+			if booking.Status == domain.BookingStatusCancelled || now.Sub(booking.CreatedAt) < reconcileGrace {
+				continue
+			}
+			d := domain.Discrepancy{BookingID: booking.BookingID, OrderID: booking.OrderID}
+			if booking.OrderID == "" {
+				d.Kind = domain.DiscrepancyBookingNoOrder
+				d.Detail = "booking has no order ID"
+				add(d)
+				continue
+			}
+
+			orderStatus, err := orders.GetOrderStatus(ctx, booking.OrderID, booking.UserID)
+			if err == domain.ErrOrderNotFound {
+				d.Kind = domain.DiscrepancyBookingNoOrder
+				d.Detail = "order service does not know the order"
+				add(d)
+				continue
+			}
+			if err != nil {
+				logger.Warn("Failed to look up order of booking", "booking_id", booking.BookingID, "order_id", booking.OrderID, "error", err)
+				continue
+			}
+			if !closedOrderStatuses[orderStatus] {
+				continue
+			}
+
+			d.Kind = domain.DiscrepancyClosedOrder
+			d.Detail = "order is " + orderStatus
+			if repair {
+				entry := ledgerEntry{Actor: domain.ActorReconciler, Reason: d.Kind}
+				if _, err := s.cancelBooking(ctx, orgID, booking.BookingID, booking.OrderID, entry); err != nil {
+					logger.Warn("Failed to cancel booking of closed order", "booking_id", booking.BookingID, "error", err)
+				} else {
+					d.Repaired = true
+				}
+			}
+			add(d)
+		}
+	}
+
+	if len(freed) > 0 {
+		for release, bySegment := range freed {
+			s.recordTransitions(ctx, orgID, tripID, bySegment, release.fromStatus, domain.SeatStatusAvailable,
+				ledgerEntry{Actor: domain.ActorReconciler, HoldID: release.holdID, BookingID: release.bookingID, Reason: release.kind})
+		}
+		s.afterReconcileRepair(ctx, orgID, tripID, segmentIndexes)
+	}
+	return report, nil
+}
+
+// releaseBrokenHold releases an active hold that lost some of its seats, freeing the seats it
+// still has and its capacity places. The hold cannot be confirmed any more.
+func (s *InventoryService) releaseBrokenHold(ctx context.Context, orgID string, hold *domain.SeatHold, stillHeld map[seatKey]bool) bool {
+	if err := s.releaseSeatGroups(ctx, orgID, hold.TripID, hold.HoldID, hold.SeatGroups()); err != nil {
+		logger.Warn("Failed to release seats of broken hold", "hold_id", hold.HoldID, "error", err)
+		return false
+	}
+	if err := s.releaseCapacity(ctx, orgID, hold.TripID, hold.HoldID, hold.Capacity, domain.CapacityHoldReleased); err != nil {
+		logger.Warn("Failed to release capacity of broken hold", "hold_id", hold.HoldID, "error", err)
+		return false
+	}
+	if err := s.holdRepo.UpdateHoldStatus(ctx, orgID, hold.HoldID, domain.HoldStatusReleased); err != nil {
+		logger.Warn("Failed to mark broken hold released", "hold_id", hold.HoldID, "error", err)
+		return false
+	}
+
+	bySegment := make(map[int][]string)
+	for key := range stillHeld {
+		bySegment[key.segIdx] = append(bySegment[key.segIdx], key.seatID)
+	}
+	s.recordTransitions(ctx, orgID, hold.TripID, bySegment, domain.SeatStatusHeld, domain.SeatStatusAvailable,
+		ledgerEntry{Actor: domain.ActorReconciler, HoldID: hold.HoldID, Reason: domain.DiscrepancyHoldWithoutSeats})
+	s.afterReconcileRepair(ctx, orgID, hold.TripID, hold.SegmentRange)
+	return true
+}
+
+// afterReconcileRepair brings the counters, the seat map cache and the waitlist up to date
+// once the reconciler has freed seats
+func (s *InventoryService) afterReconcileRepair(ctx context.Context, orgID, tripID string, segmentIndexes []int) {
+	seats, err := s.scyllaRepo.GetSeatAvailability(ctx, orgID, tripID, segmentIndexes)
+	if err != nil {
+		logger.Warn("Failed to reload seats after reconciliation", "trip_id", tripID, "error", err)
+	} else if err := s.reconcileAvailability(ctx, orgID, tripID, seats, segmentIndexes); err != nil {
+		logger.Warn("Failed to reconcile availability counters", "trip_id", tripID, "error", err)
+	}
+	s.redisRepo.InvalidateSeatMap(ctx, orgID, tripID)
+	s.triggerWaitlist(orgID, tripID)
+}
@@ -0,0 +1,438 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+)
+
+// SeatTypeGap marks grid positions that do not hold a bookable seat
+const SeatTypeGap = "none"
+
+const defaultSectionID = "main"
+
+// GetSeatMap returns the seat layout with availability status.
+// Block reasons and auto-unblock times are only returned when includeBlockDetails is set (staff callers).
+// Other callers do not see seats held back for a release tranche; they are drawn as gaps.
+func (s *InventoryService) GetSeatMap(ctx context.Context, orgID, tripID, fromStation, toStation string, includeBlockDetails bool) (*SeatMapResult, error) {
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	stationOrder := extractStationOrder(segments)
+	segmentRange, err := domain.CalculateSegmentRange(stationOrder, fromStation, toStation)
+	if err != nil {
+		return nil, err
+	}
+
+	// Try Cache First (Read-Through)
+	// We cache the ENTIRE trip inventory to allow in-memory filtering for any segment range
+	seats, err := s.redisRepo.GetCachedSeatMap(ctx, orgID, tripID)
+	if err != nil || len(seats) == 0 {
+		// Cache Miss: Fetch ALL segments to warm cache for everyone
+		allSegmentIndices := make([]int, len(segments))
+		for i := range segments {
+			allSegmentIndices[i] = segments[i].SegmentIndex
+		}
+
+		seats, err = s.scyllaRepo.GetSeatAvailability(ctx, orgID, tripID, allSegmentIndices)
+		if err != nil {
+			return nil, err
+		}
+
+		// Update Cache asynchronously
+		go func() {
+			bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			s.redisRepo.CacheSeatMap(bgCtx, orgID, tripID, seats, 5*time.Second) // Short TTL for near-realtime
+		}()
+	}
+
+	// Layout is optional: trips initialized before layouts were recorded derive it from seat positions
+	layout, err := s.scyllaRepo.GetTripLayout(ctx, orgID, tripID)
+	if err != nil {
+		logger.Warn("Failed to load trip layout, deriving from seats", "trip_id", tripID, "error", err)
+		layout = nil
+	}
+
+	if !includeBlockDetails {
+		seats = withoutUnreleasedSeats(seats, segmentRange)
+	}
+
+	// Aggregate availability across segments
+	seatMap := aggregateSeatMap(seats, segmentRange, layout)
+	seatMap.TripID = tripID
+
+	// Mark seats still reserved for a quota so clients can badge them
+	restrictions, _, err := s.quotaRestrictions(ctx, orgID, tripID, segments, segmentRange)
+	if err != nil {
+		return nil, err
+	}
+	if len(restrictions) > 0 {
+		for i := range seatMap.Sections {
+			annotateQuotas(seatMap.Sections[i].Rows, restrictions)
+		}
+		annotateQuotas(seatMap.Rows, restrictions)
+	}
+
+	if !includeBlockDetails {
+		for i := range seatMap.Sections {
+			redactBlockDetails(seatMap.Sections[i].Rows)
+		}
+		redactBlockDetails(seatMap.Rows)
+	}
+
+	return seatMap, nil
+}
+
+// --- Seat Map Types ---
+
+type SeatMapResult struct {
+	TripID       string
+	VehicleID    string
+	VehicleType  string
+	TotalRows    int
+	TotalColumns int
+	Sections     []SeatSection
+	Rows         []SeatRow // All seats, flattened across sections (no gap cells)
+	Legend       map[string]string
+}
+
+type SeatSection struct {
+	SectionID         string
+	Name              string
+	Kind              string
+	Level             int
+	TotalRows         int
+	TotalColumns      int
+	AisleAfterColumns []int
+	Rows              []SeatRow
+}
+
+type SeatRow struct {
+	RowNumber int
+	Seats     []SeatCell
+}
+
+type SeatCell struct {
+	SeatID          string
+	SeatNumber      string
+	Row             int
+	Column          int
+	SectionID       string
+	Berth           string
+	SeatType        string
+	SeatClass       string
+	Status          string
+	PricePaisa      int64
+	IsAccessible    bool
+	HasPower        bool
+	HoldExpiry      time.Time
+	SegmentStatuses []SegmentSeatStatus
+	QuotaType       string // Set while the seat is reserved for a quota
+	BlockReason     string // Staff only
+	BlockedUntil    time.Time
+}
+
+type SegmentSeatStatus struct {
+	SegmentIndex int
+	Status       string
+}
+
+// --- Seat Map Helpers ---
+
+func aggregateSeatMap(seats []domain.SeatInventory, segmentRange []int, layout *domain.SeatLayout) *SeatMapResult {
+	inRange := make(map[int]bool, len(segmentRange))
+	for _, idx := range segmentRange {
+		inRange[idx] = true
+	}
+
+	// Collect the per-segment status of every seat within the requested journey
+	now := time.Now()
+	seatDetails := make(map[string]domain.SeatInventory)
+	seatSegments := make(map[string]map[int]domain.SeatInventory)
+	for _, seat := range seats {
+		if !inRange[seat.SegmentIndex] {
+			continue
+		}
+		if seat.Status == domain.SeatStatusHeld && now.After(seat.HoldExpiry) {
+			seat.Status = domain.SeatStatusAvailable
+		}
+		if seatSegments[seat.SeatID] == nil {
+			seatSegments[seat.SeatID] = make(map[int]domain.SeatInventory)
+		}
+		seatSegments[seat.SeatID][seat.SegmentIndex] = seat
+		seatDetails[seat.SeatID] = seat
+	}
+
+	cells := make([]SeatCell, 0, len(seatDetails))
+	for seatID, detail := range seatDetails {
+		cell := SeatCell{
+			SeatID:       seatID,
+			SeatNumber:   detail.SeatNumber,
+			Row:          detail.Row,
+			Column:       detail.Column,
+			SectionID:    detail.SectionID,
+			Berth:        detail.Berth,
+			SeatType:     detail.SeatType,
+			SeatClass:    detail.SeatClass,
+			PricePaisa:   detail.PricePaisa,
+			IsAccessible: detail.IsAccessible,
+			HasPower:     detail.HasPower,
+		}
+		if cell.SectionID == "" {
+			cell.SectionID = defaultSectionID
+		}
+
+		var statuses []string
+		for _, idx := range segmentRange {
+			segSeat, ok := seatSegments[seatID][idx]
+			segStatus := domain.SeatStatusBlocked // Missing segment record means the seat cannot be sold
+			if ok {
+				segStatus = segSeat.Status
+				if segStatus == domain.SeatStatusHeld && segSeat.HoldExpiry.After(cell.HoldExpiry) {
+					cell.HoldExpiry = segSeat.HoldExpiry
+				}
+				if segStatus == domain.SeatStatusBlocked && cell.BlockReason == "" {
+					cell.BlockReason = segSeat.BlockReason
+					cell.BlockedUntil = segSeat.BlockedUntil
+				}
+			}
+			statuses = append(statuses, segStatus)
+			cell.SegmentStatuses = append(cell.SegmentStatuses, SegmentSeatStatus{
+				SegmentIndex: idx,
+				Status:       segStatus,
+			})
+		}
+		cell.Status = journeySeatStatus(statuses)
+
+		cells = append(cells, cell)
+	}
+
+	if layout == nil {
+		layout = deriveLayout(cells)
+	}
+
+	result := &SeatMapResult{
+		VehicleID:   layout.VehicleID,
+		VehicleType: layout.VehicleType,
+		Legend: map[string]string{
+			"available": "#00FF00",
+			"held":      "#FFFF00",
+			"booked":    "#FF0000",
+			"blocked":   "#808080",
+		},
+	}
+	for _, cell := range cells {
+		if cell.Status == domain.SeatStatusUnreleased {
+			result.Legend["unreleased"] = "#ADD8E6"
+			break
+		}
+	}
+
+	bySection := make(map[string][]SeatCell)
+	for _, cell := range cells {
+		bySection[cell.SectionID] = append(bySection[cell.SectionID], cell)
+	}
+
+	addSection := func(def domain.LayoutSection) {
+		section := buildSeatSection(def, bySection[def.SectionID])
+		delete(bySection, def.SectionID)
+
+		result.Sections = append(result.Sections, section)
+		result.Rows = append(result.Rows, seatRowsWithoutGaps(section.Rows)...)
+		result.TotalRows += section.TotalRows
+		if section.TotalColumns > result.TotalColumns {
+			result.TotalColumns = section.TotalColumns
+		}
+	}
+
+	for _, def := range layout.Sections {
+		addSection(def)
+	}
+
+	// Seats whose section is missing from the stored layout are still returned
+	for _, def := range deriveLayout(flattenSections(bySection)).Sections {
+		addSection(def)
+	}
+
+	return result
+}
+
+// journeySeatStatus collapses per-segment statuses into the status for the whole journey.
+// A seat is only available if every segment is; otherwise the most restrictive status wins.
+func journeySeatStatus(statuses []string) string {
+	rank := map[string]int{
+		domain.SeatStatusAvailable:  0,
+		domain.SeatStatusHeld:       1,
+		domain.SeatStatusBlocked:    2,
+		domain.SeatStatusUnreleased: 3,
+		domain.SeatStatusBooked:     4,
+	}
+
+	result := domain.SeatStatusAvailable
+	for _, st := range statuses {
+		if rank[st] > rank[result] {
+			result = st
+		}
+	}
+	return result
+}
+
+// buildSeatSection lays out a section's seats on its row/column grid, filling empty positions with gap cells
+func buildSeatSection(def domain.LayoutSection, cells []SeatCell) SeatSection {
+	section := SeatSection{
+		SectionID:         def.SectionID,
+		Name:              def.Name,
+		Kind:              def.Kind,
+		Level:             def.Level,
+		TotalRows:         def.Rows,
+		TotalColumns:      def.Columns,
+		AisleAfterColumns: def.AisleAfterColumns,
+	}
+
+	// Seats without a grid position (e.g. legacy trips, cabins) are appended after the grid
+	grid := make(map[int]map[int]SeatCell)
+	var unplaced []SeatCell
+	for _, cell := range cells {
+		if cell.Row <= 0 || cell.Column <= 0 {
+			unplaced = append(unplaced, cell)
+			continue
+		}
+		if grid[cell.Row] == nil {
+			grid[cell.Row] = make(map[int]SeatCell)
+		}
+		grid[cell.Row][cell.Column] = cell
+		if cell.Row > section.TotalRows {
+			section.TotalRows = cell.Row
+		}
+		if cell.Column > section.TotalColumns {
+			section.TotalColumns = cell.Column
+		}
+	}
+
+	for r := 1; r <= section.TotalRows; r++ {
+		row := SeatRow{RowNumber: r}
+		for c := 1; c <= section.TotalColumns; c++ {
+			cell, ok := grid[r][c]
+			if !ok {
+				cell = SeatCell{Row: r, Column: c, SectionID: def.SectionID, SeatType: SeatTypeGap}
+			}
+			row.Seats = append(row.Seats, cell)
+		}
+		section.Rows = append(section.Rows, row)
+	}
+
+	if len(unplaced) > 0 {
+		sort.Slice(unplaced, func(i, j int) bool { return unplaced[i].SeatNumber < unplaced[j].SeatNumber })
+		section.TotalRows++
+		row := SeatRow{RowNumber: section.TotalRows}
+		for i, cell := range unplaced {
+			cell.Row = section.TotalRows
+			cell.Column = i + 1
+			row.Seats = append(row.Seats, cell)
+		}
+		if len(unplaced) > section.TotalColumns {
+			section.TotalColumns = len(unplaced)
+		}
+		section.Rows = append(section.Rows, row)
+	}
+
+	return section
+}
+
+// deriveLayout builds one section per distinct section ID when no layout was stored for the trip
+func deriveLayout(cells []SeatCell) *domain.SeatLayout {
+	layout := &domain.SeatLayout{}
+	seen := make(map[string]bool)
+	for _, cell := range cells {
+		if seen[cell.SectionID] {
+			continue
+		}
+		seen[cell.SectionID] = true
+		layout.Sections = append(layout.Sections, domain.LayoutSection{
+			SectionID: cell.SectionID,
+			Name:      cell.SectionID,
+			Kind:      domain.SectionKindDeck,
+			Level:     1,
+		})
+	}
+	sort.Slice(layout.Sections, func(i, j int) bool {
+		return layout.Sections[i].SectionID < layout.Sections[j].SectionID
+	})
+	return layout
+}
+
+func flattenSections(bySection map[string][]SeatCell) []SeatCell {
+	var cells []SeatCell
+	for _, sectionCells := range bySection {
+		cells = append(cells, sectionCells...)
+	}
+	return cells
+}
+
+func seatRowsWithoutGaps(rows []SeatRow) []SeatRow {
+	var result []SeatRow
+	for _, row := range rows {
+		var seats []SeatCell
+		for _, cell := range row.Seats {
+			if cell.SeatID != "" {
+				seats = append(seats, cell)
+			}
+		}
+		if len(seats) > 0 {
+			result = append(result, SeatRow{RowNumber: row.RowNumber, Seats: seats})
+		}
+	}
+	return result
+}
+
+func annotateQuotas(rows []SeatRow, restrictions map[string][]*domain.SeatQuota) {
+	for r := range rows {
+		for c := range rows[r].Seats {
+			if quotas := restrictions[rows[r].Seats[c].SeatID]; len(quotas) > 0 {
+				rows[r].Seats[c].QuotaType = quotas[0].QuotaType
+			}
+		}
+	}
+}
+
+// withoutUnreleasedSeats drops every row of the seats held back for a release tranche on the journey.
+// The rows come from the shared cache, so a new slice is returned.
+func withoutUnreleasedSeats(seats []domain.SeatInventory, segmentRange []int) []domain.SeatInventory {
+	inRange := make(map[int]bool, len(segmentRange))
+	for _, idx := range segmentRange {
+		inRange[idx] = true
+	}
+	hidden := make(map[string]bool)
+	for _, seat := range seats {
+		if seat.Status == domain.SeatStatusUnreleased && inRange[seat.SegmentIndex] {
+			hidden[seat.SeatID] = true
+		}
+	}
+	if len(hidden) == 0 {
+		return seats
+	}
+
+	visible := make([]domain.SeatInventory, 0, len(seats))
+	for _, seat := range seats {
+		if !hidden[seat.SeatID] {
+			visible = append(visible, seat)
+		}
+	}
+	return visible
+}
+
+// redactBlockDetails hides why and until when seats are blocked from non-staff callers
+func redactBlockDetails(rows []SeatRow) {
+	for r := range rows {
+		for c := range rows[r].Seats {
+			rows[r].Seats[c].BlockReason = ""
+			rows[r].Seats[c].BlockedUntil = time.Time{}
+		}
+	}
+}
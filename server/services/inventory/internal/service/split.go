@@ -0,0 +1,198 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+)
+
+// MaxSeatChanges bounds how often one passenger is asked to move seats on a journey
+const MaxSeatChanges = 2
+
+// SeatChangePlan seats one passenger for the whole journey across one or more seats
+type SeatChangePlan struct {
+	PassengerIndex int
+	Legs           []PlannedLeg
+	PricePaisa     int64
+}
+
+// PlannedLeg is one seat of a seat change plan
+type PlannedLeg struct {
+	SeatID        string
+	SeatNumber    string
+	SeatClass     string
+	FromStationID string
+	ToStationID   string
+	SegmentRange  []int
+	PricePaisa    int64
+}
+
+// planSeatChanges covers every passenger's journey with as few seats as possible.
+// From where the previous leg ended it takes the seat that stays free the longest, which
+// minimizes seat changes for one passenger; seats given to earlier passengers are skipped.
+// Returns nil when any passenger cannot be seated within MaxSeatChanges.
+func planSeatChanges(seats []domain.SeatInventory, segments []domain.Segment, segmentRange []int, passengers int, sellable func(seat domain.SeatInventory) bool) []SeatChangePlan {
+	if passengers <= 0 || len(segmentRange) == 0 {
+		return nil
+	}
+
+	free := make(map[string]map[int]bool)
+	details := make(map[string]domain.SeatInventory)
+	now := time.Now()
+	for _, seat := range seatsInSegments(seats, segmentRange) {
+		isFree := seat.Status == domain.SeatStatusAvailable ||
+			(seat.Status == domain.SeatStatusHeld && now.After(seat.HoldExpiry))
+		if !isFree || !sellable(seat) {
+			continue
+		}
+		if free[seat.SeatID] == nil {
+			free[seat.SeatID] = make(map[int]bool)
+		}
+		free[seat.SeatID][seat.SegmentIndex] = true
+		details[seat.SeatID] = seat
+	}
+
+	candidates := make([]domain.SeatInventory, 0, len(details))
+	for _, seat := range details {
+		candidates = append(candidates, seat)
+	}
+	sort.Slice(candidates, func(i, j int) bool { return seatLess(candidates[i], candidates[j]) })
+
+	segmentsByIndex := make(map[int]domain.Segment, len(segments))
+	for _, seg := range segments {
+		segmentsByIndex[seg.SegmentIndex] = seg
+	}
+
+	plans := make([]SeatChangePlan, 0, passengers)
+	for p := 0; p < passengers; p++ {
+		plan := SeatChangePlan{PassengerIndex: p}
+		for pos := 0; pos < len(segmentRange); {
+			if len(plan.Legs) > MaxSeatChanges {
+				return nil
+			}
+
+			var best domain.SeatInventory
+			bestRun := 0
+			for _, seat := range candidates {
+				run := 0
+				for pos+run < len(segmentRange) && free[seat.SeatID][segmentRange[pos+run]] {
+					run++
+				}
+				if run > bestRun {
+					best, bestRun = seat, run
+				}
+			}
+			if bestRun == 0 {
+				return nil
+			}
+
+			legRange := append([]int(nil), segmentRange[pos:pos+bestRun]...)
+			for _, idx := range legRange {
+				delete(free[best.SeatID], idx)
+			}
+			price := prorate(best.PricePaisa, pos, pos+bestRun, len(segmentRange))
+			plan.Legs = append(plan.Legs, PlannedLeg{
+				SeatID:        best.SeatID,
+				SeatNumber:    best.SeatNumber,
+				SeatClass:     best.SeatClass,
+				FromStationID: segmentsByIndex[legRange[0]].FromStationID,
+				ToStationID:   segmentsByIndex[legRange[len(legRange)-1]].ToStationID,
+				SegmentRange:  legRange,
+				PricePaisa:    price,
+			})
+			plan.PricePaisa += price
+			pos += bestRun
+		}
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// resolveSeatLegs fills in each leg's segments and checks that every passenger's legs
+// cover the journey in order, without gaps, overlaps or a seat used twice on one segment.
+// Legs are returned by passenger, in travel order.
+func resolveSeatLegs(legs []domain.SeatLeg, stationOrder []string, segmentRange []int) ([]domain.SeatLeg, error) {
+	byPassenger := make(map[int][]domain.SeatLeg)
+	for _, leg := range legs {
+		if leg.SeatID == "" || leg.PassengerIndex < 0 {
+			return nil, domain.ErrInvalidSeatLegs
+		}
+		legRange, err := domain.CalculateSegmentRange(stationOrder, leg.FromStationID, leg.ToStationID)
+		if err != nil {
+			return nil, err
+		}
+		leg.SegmentRange = legRange
+		byPassenger[leg.PassengerIndex] = append(byPassenger[leg.PassengerIndex], leg)
+	}
+
+	resolved := make([]domain.SeatLeg, 0, len(legs))
+	taken := make(map[string]bool)
+	for p := 0; p < len(byPassenger); p++ {
+		passengerLegs, ok := byPassenger[p]
+		if !ok {
+			return nil, domain.ErrInvalidSeatLegs
+		}
+		if len(passengerLegs)-1 > MaxSeatChanges {
+			return nil, domain.ErrTooManySeatChanges
+		}
+		sort.Slice(passengerLegs, func(i, j int) bool {
+			return passengerLegs[i].SegmentRange[0] < passengerLegs[j].SegmentRange[0]
+		})
+
+		next := segmentRange[0]
+		for _, leg := range passengerLegs {
+			if leg.SegmentRange[0] != next {
+				return nil, domain.ErrInvalidSeatLegs
+			}
+			for _, idx := range leg.SegmentRange {
+				key := fmt.Sprintf("%s:%d", leg.SeatID, idx)
+				if taken[key] {
+					return nil, domain.ErrInvalidSeatLegs
+				}
+				taken[key] = true
+			}
+			next = leg.SegmentRange[len(leg.SegmentRange)-1] + 1
+			resolved = append(resolved, leg)
+		}
+		if next != segmentRange[len(segmentRange)-1]+1 {
+			return nil, domain.ErrInvalidSeatLegs
+		}
+	}
+	return resolved, nil
+}
+
+// seatLegsBlockedByQuota returns the leg seats the caller may not hold on the leg's segments
+func seatLegsBlockedByQuota(legs []domain.SeatLeg, quotas []domain.SeatQuota, segments []domain.Segment, claims domain.QuotaClaims) []string {
+	now := time.Now()
+	var blocked []string
+	for _, leg := range legs {
+		restrictions := activeQuotasBySeat(quotas, segments, leg.SegmentRange, now)
+		if !seatAllowed(restrictions[leg.SeatID], claims) {
+			blocked = append(blocked, leg.SeatID)
+		}
+	}
+	return blocked
+}
+
+// distinctLegSeats lists each seat used by any leg once, in leg order
+func distinctLegSeats(legs []domain.SeatLeg) []string {
+	seen := make(map[string]bool, len(legs))
+	var seatIDs []string
+	for _, leg := range legs {
+		if !seen[leg.SeatID] {
+			seen[leg.SeatID] = true
+			seatIDs = append(seatIDs, leg.SeatID)
+		}
+	}
+	return seatIDs
+}
+
+// prorate splits a full-journey fare by segments so leg fares always add up to the fare
+func prorate(pricePaisa int64, from, to, total int) int64 {
+	if total <= 0 {
+		return pricePaisa
+	}
+	return pricePaisa*int64(to)/int64(total) - pricePaisa*int64(from)/int64(total)
+}
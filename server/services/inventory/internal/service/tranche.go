@@ -0,0 +1,452 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/kafka"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/google/uuid"
+)
+
+// CreateReleaseTranches holds seats back from sale on one trip, or on every trip of a schedule,
+// until each tranche's release time. Schedule tranches are stored so trips generated later
+// hold back the same share of seats. Percentages are of the trip's seats and may not add up to more than 100.
+// Returns the trip's tranches with the seats held back, or the stored schedule templates.
+func (s *InventoryService) CreateReleaseTranches(ctx context.Context, orgID, tripID, scheduleID string, tranches []domain.ReleaseTranche, createdBy string) ([]domain.ReleaseTranche, error) {
+	if len(tranches) == 0 {
+		return nil, domain.ErrInvalidTranche
+	}
+	total := 0
+	for _, t := range tranches {
+		explicit := len(t.SeatIDs) > 0 || len(t.SeatNumbers) > 0
+		if t.Percentage < 0 || t.Percentage > 100 || explicit == (t.Percentage > 0) {
+			return nil, domain.ErrInvalidTranche
+		}
+		total += t.Percentage
+	}
+	if total > 100 {
+		return nil, domain.ErrTranchesOverAllocated
+	}
+
+	now := time.Now()
+	for i := range tranches {
+		tranches[i].OrganizationID = orgID
+		tranches[i].TrancheID = uuid.New().String()
+		tranches[i].Status = domain.TrancheStatusPending
+		tranches[i].CreatedBy = createdBy
+		tranches[i].CreatedAt = now
+	}
+
+	switch {
+	case scheduleID != "":
+		for i := range tranches {
+			tranches[i].ScheduleID = scheduleID
+			if err := s.scyllaRepo.SaveTranche(ctx, &tranches[i]); err != nil {
+				return nil, err
+			}
+		}
+
+		tripIDs, err := s.scyllaRepo.ListScheduleTrips(ctx, orgID, scheduleID)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range tripIDs {
+			if _, err := s.applyTripTranches(ctx, orgID, id, tranches); err != nil {
+				logger.Warn("Failed to apply schedule release tranches", "trip_id", id, "schedule_id", scheduleID, "error", err)
+			}
+		}
+		logger.Info("Created schedule release tranches", "schedule_id", scheduleID, "tranches", len(tranches), "created_by", createdBy)
+		return tranches, nil
+	case tripID != "":
+		applied, err := s.applyTripTranches(ctx, orgID, tripID, tranches)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("Created release tranches", "trip_id", tripID, "tranches", len(applied), "created_by", createdBy)
+		return applied, nil
+	default:
+		return nil, domain.ErrBlockTargetRequired
+	}
+}
+
+// ListReleaseTranches returns a trip's release tranches or a schedule's tranche templates
+func (s *InventoryService) ListReleaseTranches(ctx context.Context, orgID, tripID, scheduleID string) ([]domain.ReleaseTranche, error) {
+	scopeID := tripID
+	if scheduleID != "" {
+		scopeID = scheduleID
+	}
+	if scopeID == "" {
+		return nil, domain.ErrBlockTargetRequired
+	}
+
+	tranches, err := s.scyllaRepo.ListTranches(ctx, orgID, scopeID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(tranches, func(i, j int) bool { return tranches[i].CreatedAt.Before(tranches[j].CreatedAt) })
+	return tranches, nil
+}
+
+// ReleaseTranche puts a tranche's seats on sale now, ahead of its release time.
+// Releasing a schedule template drops it and releases it on every trip of the schedule.
+// Returns the number of seats released.
+func (s *InventoryService) ReleaseTranche(ctx context.Context, orgID, tripID, scheduleID, trancheID, releasedBy string) (int, error) {
+	switch {
+	case scheduleID != "":
+		if _, err := s.scyllaRepo.GetTranche(ctx, orgID, scheduleID, trancheID); err != nil {
+			return 0, err
+		}
+		if err := s.scyllaRepo.DeleteTranche(ctx, orgID, scheduleID, trancheID); err != nil {
+			return 0, err
+		}
+
+		tripIDs, err := s.scyllaRepo.ListScheduleTrips(ctx, orgID, scheduleID)
+		if err != nil {
+			return 0, err
+		}
+		total := 0
+		for _, id := range tripIDs {
+			n, err := s.releaseTripTranche(ctx, orgID, id, trancheID, releasedBy)
+			if err != nil {
+				if err != domain.ErrTrancheNotFound {
+					logger.Warn("Failed to release schedule tranche", "trip_id", id, "tranche_id", trancheID, "error", err)
+				}
+				continue
+			}
+			total += n
+		}
+		return total, nil
+	case tripID != "":
+		return s.releaseTripTranche(ctx, orgID, tripID, trancheID, releasedBy)
+	default:
+		return 0, domain.ErrBlockTargetRequired
+	}
+}
+
+// applyScheduleTranches holds back seats on a newly generated trip for every schedule tranche
+func (s *InventoryService) applyScheduleTranches(ctx context.Context, orgID, scheduleID, tripID string) error {
+	templates, err := s.scyllaRepo.ListTranches(ctx, orgID, scheduleID)
+	if err != nil {
+		return err
+	}
+	if len(templates) == 0 {
+		return nil
+	}
+	_, err = s.applyTripTranches(ctx, orgID, tripID, templates)
+	return err
+}
+
+// reapplyTripTranches holds back the seats of a trip's pending tranches again by seat number,
+// after a vehicle change has rewritten the trip's seats
+func (s *InventoryService) reapplyTripTranches(ctx context.Context, orgID, tripID string) error {
+	tranches, err := s.scyllaRepo.ListTranches(ctx, orgID, tripID)
+	if err != nil {
+		return err
+	}
+
+	var pending []domain.ReleaseTranche
+	for _, t := range tranches {
+		if t.Status != domain.TrancheStatusPending {
+			continue
+		}
+		t.Percentage = 0
+		t.SeatIDs = nil
+		pending = append(pending, t)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	_, err = s.applyTripTranches(ctx, orgID, tripID, pending)
+	return err
+}
+
+// applyTripTranches holds back each tranche's seats on every segment of one trip and stores
+// the tranche with the seats it took. Only seats available end to end are taken: named seats
+// that are sold, held or blocked are skipped, and percentages are spread evenly over the cabin
+// from the seats no earlier tranche took. A release time before departure is fixed against
+// the trip's first departure.
+func (s *InventoryService) applyTripTranches(ctx context.Context, orgID, tripID string, tranches []domain.ReleaseTranche) ([]domain.ReleaseTranche, error) {
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		return nil, domain.ErrTripInventoryNotFound
+	}
+	segmentIndexes := make([]int, 0, len(segments))
+	departure := segments[0].DepartureTime
+	for _, seg := range segments {
+		segmentIndexes = append(segmentIndexes, seg.SegmentIndex)
+		if seg.DepartureTime.Before(departure) {
+			departure = seg.DepartureTime
+		}
+	}
+
+	seats, err := s.scyllaRepo.GetSeatAvailability(ctx, orgID, tripID, segmentIndexes)
+	if err != nil {
+		return nil, err
+	}
+
+	// A seat is a candidate when it is available on every segment
+	bySeat := make(map[string]domain.SeatInventory)
+	freeSegments := make(map[string]int)
+	for _, seat := range seats {
+		bySeat[seat.SeatID] = seat
+		if seat.Status == domain.SeatStatusAvailable {
+			freeSegments[seat.SeatID]++
+		}
+	}
+	var candidates []domain.SeatInventory
+	for seatID, seat := range bySeat {
+		if freeSegments[seatID] == len(segmentIndexes) {
+			candidates = append(candidates, seat)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.SectionID != b.SectionID {
+			return a.SectionID < b.SectionID
+		}
+		if a.Row != b.Row {
+			return a.Row < b.Row
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.SeatNumber < b.SeatNumber
+	})
+
+	taken := make(map[string]bool)
+	applied := make([]domain.ReleaseTranche, 0, len(tranches))
+	timed := false
+	for _, tmpl := range tranches {
+		t := tmpl
+		t.TripID = tripID
+		t.Status = domain.TrancheStatusPending
+		if t.ReleaseAt.IsZero() && t.ReleaseBeforeDeparture > 0 {
+			t.ReleaseAt = departure.Add(-t.ReleaseBeforeDeparture)
+		}
+
+		var picked []domain.SeatInventory
+		if t.Percentage > 0 {
+			picked = spreadSeats(candidates, taken, len(bySeat)*t.Percentage/100)
+		} else {
+			picked = namedSeats(candidates, taken, t.SeatIDs, t.SeatNumbers)
+		}
+
+		t.SeatIDs, t.SeatNumbers = nil, nil
+		heldBack := make(map[int][]string, len(segmentIndexes))
+		for _, seat := range picked {
+			if !s.holdBackTrancheSeat(ctx, orgID, tripID, segmentIndexes, seat.SeatID, t.TrancheID) {
+				continue
+			}
+			taken[seat.SeatID] = true
+			t.SeatIDs = append(t.SeatIDs, seat.SeatID)
+			t.SeatNumbers = append(t.SeatNumbers, seat.SeatNumber)
+			for _, segIdx := range segmentIndexes {
+				heldBack[segIdx] = append(heldBack[segIdx], seat.SeatID)
+			}
+		}
+
+		if err := s.scyllaRepo.SaveTranche(ctx, &t); err != nil {
+			return applied, err
+		}
+		s.moveAvailability(ctx, orgID, tripID, heldBack, -1)
+		s.recordTransitions(ctx, orgID, tripID, heldBack, domain.SeatStatusAvailable, domain.SeatStatusUnreleased,
+			ledgerEntry{Actor: t.CreatedBy, Reason: "release tranche " + t.Name})
+		timed = timed || t.Timed()
+		applied = append(applied, t)
+	}
+
+	s.redisRepo.InvalidateSeatMap(ctx, orgID, tripID)
+	if timed {
+		if err := s.redisRepo.TrackTrancheTrip(ctx, orgID, tripID); err != nil {
+			return applied, err
+		}
+	}
+	return applied, nil
+}
+
+// holdBackTrancheSeat holds one seat back on every segment, or on none if any segment is no longer available
+func (s *InventoryService) holdBackTrancheSeat(ctx context.Context, orgID, tripID string, segmentIndexes []int, seatID, trancheID string) bool {
+	var done []int
+	for _, segIdx := range segmentIndexes {
+		applied, err := s.scyllaRepo.HoldBackSeat(ctx, orgID, tripID, segIdx, seatID, trancheID)
+		if err != nil {
+			logger.Warn("Failed to hold back tranche seat", "trip_id", tripID, "seat_id", seatID, "segment", segIdx, "error", err)
+		}
+		if err != nil || !applied {
+			break
+		}
+		done = append(done, segIdx)
+	}
+	if len(done) == len(segmentIndexes) {
+		return true
+	}
+	for _, segIdx := range done {
+		if _, err := s.scyllaRepo.ReleaseTrancheSeat(ctx, orgID, tripID, segIdx, seatID, trancheID); err != nil {
+			logger.Warn("Failed to roll back partial tranche hold-back", "trip_id", tripID, "seat_id", seatID, "error", err)
+		}
+	}
+	return false
+}
+
+// spreadSeats picks n of the seats not yet taken, evenly spaced so a tranche is not one block of the cabin
+func spreadSeats(candidates []domain.SeatInventory, taken map[string]bool, n int) []domain.SeatInventory {
+	var free []domain.SeatInventory
+	for _, seat := range candidates {
+		if !taken[seat.SeatID] {
+			free = append(free, seat)
+		}
+	}
+	if n >= len(free) {
+		return free
+	}
+
+	picked := make([]domain.SeatInventory, 0, n)
+	for i := 0; i < n; i++ {
+		picked = append(picked, free[i*len(free)/n])
+	}
+	return picked
+}
+
+// namedSeats picks the seats not yet taken that match the given IDs or numbers
+func namedSeats(candidates []domain.SeatInventory, taken map[string]bool, seatIDs, seatNumbers []string) []domain.SeatInventory {
+	byID := make(map[string]bool, len(seatIDs))
+	for _, id := range seatIDs {
+		byID[id] = true
+	}
+	byNumber := make(map[string]bool, len(seatNumbers))
+	for _, num := range seatNumbers {
+		byNumber[num] = true
+	}
+
+	var picked []domain.SeatInventory
+	for _, seat := range candidates {
+		if !taken[seat.SeatID] && (byID[seat.SeatID] || byNumber[seat.SeatNumber]) {
+			picked = append(picked, seat)
+		}
+	}
+	return picked
+}
+
+// releaseTripTranche puts one tranche's seats on sale on one trip and announces the release,
+// so the queue service can open a waiting room for the newly released seats
+func (s *InventoryService) releaseTripTranche(ctx context.Context, orgID, tripID, trancheID, actor string) (int, error) {
+	t, err := s.scyllaRepo.GetTranche(ctx, orgID, tripID, trancheID)
+	if err != nil {
+		return 0, err
+	}
+	if t.Status == domain.TrancheStatusReleased {
+		return 0, nil
+	}
+
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		return 0, err
+	}
+
+	var released []string
+	returned := make(map[int][]string)
+	defer func() {
+		s.moveAvailability(ctx, orgID, tripID, returned, 1)
+		s.recordTransitions(ctx, orgID, tripID, returned, domain.SeatStatusUnreleased, domain.SeatStatusAvailable,
+			ledgerEntry{Actor: actor, Reason: "release tranche " + t.Name + " released"})
+	}()
+	for _, seatID := range t.SeatIDs {
+		freed := false
+		for _, seg := range segments {
+			applied, err := s.scyllaRepo.ReleaseTrancheSeat(ctx, orgID, tripID, seg.SegmentIndex, seatID, trancheID)
+			if err != nil {
+				return len(released), err
+			}
+			if applied {
+				returned[seg.SegmentIndex] = append(returned[seg.SegmentIndex], seatID)
+			}
+			freed = freed || applied
+		}
+		if freed {
+			released = append(released, seatID)
+		}
+	}
+
+	// Only the caller that flips the tranche announces it
+	now := time.Now()
+	won, err := s.scyllaRepo.MarkTrancheReleased(ctx, orgID, tripID, trancheID, now)
+	if err != nil {
+		return len(released), err
+	}
+
+	if len(released) > 0 {
+		s.redisRepo.InvalidateSeatMap(ctx, orgID, tripID)
+		s.publishSeatEvent(ctx, kafka.EventSeatsReleased, tripID, released, "AVAILABLE")
+		s.triggerWaitlist(orgID, tripID)
+	}
+	if won {
+		var departure time.Time
+		for _, seg := range segments {
+			if departure.IsZero() || seg.DepartureTime.Before(departure) {
+				departure = seg.DepartureTime
+			}
+		}
+		s.publishEvent(ctx, kafka.EventTrancheReleased, tripID, map[string]interface{}{
+			"trip_id":         tripID,
+			"organization_id": orgID,
+			"schedule_id":     t.ScheduleID,
+			"tranche_id":      trancheID,
+			"name":            t.Name,
+			"seats_released":  len(released),
+			"released_at":     now,
+			"departure_time":  departure,
+		})
+		logger.Info("Released seat tranche", "trip_id", tripID, "tranche_id", trancheID, "seats", len(released), "released_by", actor)
+	}
+	return len(released), nil
+}
+
+// ProcessDueTranches releases tranches whose release time has passed
+func (s *InventoryService) ProcessDueTranches(ctx context.Context) error {
+	trips, err := s.redisRepo.ListTrancheTrips(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, trip := range trips {
+		if err := s.releaseDueTranches(ctx, trip.OrganizationID, trip.TripID); err != nil {
+			logger.Warn("Failed to release due tranches", "trip_id", trip.TripID, "error", err)
+		}
+	}
+	return nil
+}
+
+// releaseDueTranches releases one trip's due tranches.
+// The trip stops being checked once no timed tranche is pending on it.
+func (s *InventoryService) releaseDueTranches(ctx context.Context, orgID, tripID string) error {
+	tranches, err := s.scyllaRepo.ListTranches(ctx, orgID, tripID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	pending := false
+	for _, t := range tranches {
+		if t.Status != domain.TrancheStatusPending || t.ReleaseAt.IsZero() {
+			continue
+		}
+		if now.Before(t.ReleaseAt) {
+			pending = true
+			continue
+		}
+		if _, err := s.releaseTripTranche(ctx, orgID, tripID, t.TrancheID, domain.ActorTrancheReleaser); err != nil {
+			return err
+		}
+	}
+
+	if !pending {
+		return s.redisRepo.UntrackTrancheTrip(ctx, orgID, tripID)
+	}
+	return nil
+}
@@ -0,0 +1,408 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/kafka"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	// WaitlistOfferDuration is how long a promoted waitlist entry may keep its seats before they pass to the next user
+	WaitlistOfferDuration = 15 * time.Minute
+	waitlistLockTTL       = 30 * time.Second
+)
+
+// JoinWaitlist adds a user to the waitlist
+func (s *InventoryService) JoinWaitlist(ctx context.Context, req *WaitlistRequest) (*WaitlistResult, error) {
+	segments, err := s.scyllaRepo.GetSegments(ctx, req.OrganizationID, req.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Default to the full trip when no journey is given
+	stationOrder := extractStationOrder(segments)
+	fromStation, toStation := req.FromStation, req.ToStation
+	if fromStation == "" && len(stationOrder) > 0 {
+		fromStation = stationOrder[0]
+	}
+	if toStation == "" && len(stationOrder) > 0 {
+		toStation = stationOrder[len(stationOrder)-1]
+	}
+	if _, err := domain.CalculateSegmentRange(stationOrder, fromStation, toStation); err != nil {
+		return nil, err
+	}
+
+	requestedSeats := req.RequestedSeats
+	if requestedSeats <= 0 {
+		requestedSeats = 1
+	}
+
+	entry := domain.WaitlistEntry{
+		OrganizationID: req.OrganizationID,
+		TripID:         req.TripID,
+		UserID:         req.UserID,
+		SeatClass:      req.SeatClass,
+		RequestedSeats: requestedSeats,
+		FromStationID:  fromStation,
+		ToStationID:    toStation,
+		CreatedAt:      time.Now().Truncate(time.Millisecond), // Scylla keeps milliseconds
+		Status:         domain.WaitlistStatusPending,
+	}
+
+	if err := s.scyllaRepo.AddToWaitlist(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := s.redisRepo.TrackWaitlistTrip(ctx, req.OrganizationID, req.TripID); err != nil {
+		logger.Warn("Failed to track waitlist trip", "trip_id", req.TripID, "error", err)
+	}
+
+	entries, err := s.scyllaRepo.GetTripWaitlist(ctx, req.OrganizationID, req.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Seats may already be free (e.g. a hold lapsed while the user was deciding)
+	s.triggerWaitlist(req.OrganizationID, req.TripID)
+
+	return &WaitlistResult{
+		Success:  true,
+		Message:  "added to waitlist",
+		Position: waitlistPosition(entries, entry),
+	}, nil
+}
+
+// GetUserWaitlist returns user's waitlist with live queue position and offer state
+func (s *InventoryService) GetUserWaitlist(ctx context.Context, userID string) ([]UserWaitlistEntry, error) {
+	entries, err := s.scyllaRepo.GetUserWaitlist(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The by-user view only carries the queue key; read the trip queue for position and offer details
+	tripQueues := make(map[domain.TripRef][]domain.WaitlistEntry)
+	result := make([]UserWaitlistEntry, 0, len(entries))
+	for _, e := range entries {
+		ref := domain.TripRef{OrganizationID: e.OrganizationID, TripID: e.TripID}
+		queue, ok := tripQueues[ref]
+		if !ok {
+			queue, err = s.scyllaRepo.GetTripWaitlist(ctx, e.OrganizationID, e.TripID)
+			if err != nil {
+				return nil, err
+			}
+			tripQueues[ref] = queue
+		}
+
+		item := UserWaitlistEntry{WaitlistEntry: e}
+		for _, q := range queue {
+			if q.SameEntry(e) {
+				item.WaitlistEntry = q
+				break
+			}
+		}
+		if item.Status == domain.WaitlistStatusPending {
+			item.Position = waitlistPosition(queue, e)
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// RespondWaitlistOffer accepts or declines the seats offered to a waitlisted user
+func (s *InventoryService) RespondWaitlistOffer(ctx context.Context, orgID, tripID, userID string, accept bool) (*WaitlistOfferResult, error) {
+	entries, err := s.scyllaRepo.GetTripWaitlist(ctx, orgID, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry *domain.WaitlistEntry
+	for i := range entries {
+		if entries[i].UserID == userID && entries[i].Status == domain.WaitlistStatusNotified {
+			entry = &entries[i]
+			break
+		}
+	}
+	if entry == nil {
+		return nil, domain.ErrNoWaitlistOffer
+	}
+
+	if time.Now().After(entry.OfferExpiresAt) {
+		entry.Status = domain.WaitlistStatusExpired
+		if err := s.scyllaRepo.UpdateWaitlistOffer(ctx, *entry); err != nil {
+			return nil, err
+		}
+		s.triggerWaitlist(orgID, tripID)
+		return &WaitlistOfferResult{Success: false, Message: "offer has expired"}, nil
+	}
+
+	if accept {
+		// The hold already belongs to the user; checkout proceeds with it as usual
+		entry.Status = domain.WaitlistStatusConverted
+		if err := s.scyllaRepo.UpdateWaitlistOffer(ctx, *entry); err != nil {
+			return nil, err
+		}
+		return &WaitlistOfferResult{
+			Success:   true,
+			HoldID:    entry.HoldID,
+			ExpiresAt: entry.OfferExpiresAt,
+			Message:   "offer accepted",
+		}, nil
+	}
+
+	entry.Status = domain.WaitlistStatusDeclined
+	if err := s.scyllaRepo.UpdateWaitlistOffer(ctx, *entry); err != nil {
+		return nil, err
+	}
+
+	// Releasing the hold hands the seats to the next entry
+	if err := s.ReleaseSeats(ctx, orgID, entry.HoldID, userID); err != nil && err != domain.ErrHoldNotFound && err != domain.ErrHoldExpired {
+		return nil, err
+	}
+
+	return &WaitlistOfferResult{Success: true, Message: "offer declined"}, nil
+}
+
+// ProcessActiveWaitlists runs promotion for every trip with an active waitlist
+func (s *InventoryService) ProcessActiveWaitlists(ctx context.Context) {
+	trips, err := s.redisRepo.ListWaitlistTrips(ctx)
+	if err != nil {
+		logger.Error("Failed to list waitlist trips", "error", err)
+		return
+	}
+
+	for _, trip := range trips {
+		if err := s.ProcessWaitlist(ctx, trip.OrganizationID, trip.TripID); err != nil {
+			logger.Error("Waitlist processing failed", "trip_id", trip.TripID, "error", err)
+		}
+	}
+}
+
+// ProcessWaitlist expires lapsed offers and offers free seats to pending entries in FIFO order.
+// An entry that cannot be satisfied does not block smaller requests behind it.
+func (s *InventoryService) ProcessWaitlist(ctx context.Context, orgID, tripID string) error {
+	owner := uuid.New().String()
+	acquired, err := s.redisRepo.AcquireWaitlistLock(ctx, orgID, tripID, owner, waitlistLockTTL)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		return nil // Another instance is processing this trip
+	}
+	defer s.redisRepo.ReleaseWaitlistLock(context.Background(), orgID, tripID, owner)
+
+	entries, err := s.scyllaRepo.GetTripWaitlist(ctx, orgID, tripID)
+	if err != nil {
+		return err
+	}
+
+	segments, err := s.scyllaRepo.GetSegments(ctx, orgID, tripID)
+	if err != nil {
+		return err
+	}
+	stationOrder := extractStationOrder(segments)
+	allSegments := make([]int, len(segments))
+	for i := range segments {
+		allSegments[i] = segments[i].SegmentIndex
+	}
+
+	seats, err := s.scyllaRepo.GetSeatAvailability(ctx, orgID, tripID, allSegments)
+	if err != nil {
+		return err
+	}
+
+	quotas, err := s.scyllaRepo.GetTripQuotas(ctx, orgID, tripID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	offered := make(map[string]bool) // Seats handed out during this pass
+	active := false
+
+	for i := range entries {
+		entry := &entries[i]
+
+		switch entry.Status {
+		case domain.WaitlistStatusNotified:
+			if now.Before(entry.OfferExpiresAt) {
+				active = true
+				continue
+			}
+			// Lapsed holds read as available, so the seats fall through to the entries below
+			entry.Status = domain.WaitlistStatusExpired
+			if err := s.scyllaRepo.UpdateWaitlistOffer(ctx, *entry); err != nil {
+				return err
+			}
+			continue
+		case domain.WaitlistStatusPending:
+			active = true
+		default:
+			continue
+		}
+
+		segmentRange, err := domain.CalculateSegmentRange(stationOrder, entry.FromStationID, entry.ToStationID)
+		if err != nil {
+			continue
+		}
+
+		// Waitlist offers only come from the general pool, never from reserved quotas
+		restrictions := activeQuotasBySeat(quotas, segments, segmentRange, now)
+		var candidates []SeatInfo
+		for _, seat := range filterAvailableSeats(seatsInSegments(seats, segmentRange), segmentRange, entry.SeatClass) {
+			if len(restrictions[seat.SeatID]) == 0 {
+				candidates = append(candidates, seat)
+			}
+		}
+		seatIDs := pickWaitlistSeats(candidates, offered, entry.RequestedSeats)
+		if seatIDs == nil {
+			continue
+		}
+
+		if err := s.offerSeats(ctx, entry, segmentRange, seatIDs); err != nil {
+			logger.Warn("Failed to offer seats to waitlisted user", "trip_id", tripID, "user_id", entry.UserID, "error", err)
+			continue
+		}
+		for _, seatID := range seatIDs {
+			offered[seatID] = true
+		}
+	}
+
+	if !active {
+		return s.redisRepo.UntrackWaitlistTrip(ctx, orgID, tripID)
+	}
+	return nil
+}
+
+// offerSeats holds seats on behalf of a waitlisted user and notifies them
+func (s *InventoryService) offerSeats(ctx context.Context, entry *domain.WaitlistEntry, segmentRange []int, seatIDs []string) error {
+	result, err := s.createHold(ctx, &HoldRequest{
+		OrganizationID: entry.OrganizationID,
+		TripID:         entry.TripID,
+		FromStation:    entry.FromStationID,
+		ToStation:      entry.ToStationID,
+		SeatIDs:        seatIDs,
+		UserID:         entry.UserID,
+		SessionID:      "waitlist",
+		HoldDuration:   WaitlistOfferDuration,
+	}, segmentRange)
+	if err != nil {
+		return err
+	}
+	if !result.Success {
+		return &domain.DomainError{Message: result.FailureReason}
+	}
+
+	entry.Status = domain.WaitlistStatusNotified
+	entry.HoldID = result.HoldID
+	entry.OfferExpiresAt = result.ExpiresAt
+	if err := s.scyllaRepo.UpdateWaitlistOffer(ctx, *entry); err != nil {
+		_ = s.ReleaseSeats(ctx, entry.OrganizationID, result.HoldID, entry.UserID)
+		return err
+	}
+
+	s.publishEvent(ctx, kafka.EventWaitlistOffered, entry.TripID, map[string]interface{}{
+		"trip_id":         entry.TripID,
+		"organization_id": entry.OrganizationID,
+		"user_id":         entry.UserID,
+		"hold_id":         result.HoldID,
+		"seat_ids":        seatIDs,
+		"expires_at":      result.ExpiresAt,
+	})
+
+	return nil
+}
+
+// triggerWaitlist processes the trip's waitlist in the background
+func (s *InventoryService) triggerWaitlist(orgID, tripID string) {
+	go func() {
+		bgCtx, cancel := context.WithTimeout(context.Background(), waitlistLockTTL)
+		defer cancel()
+		if err := s.ProcessWaitlist(bgCtx, orgID, tripID); err != nil {
+			logger.Error("Waitlist processing failed", "trip_id", tripID, "error", err)
+		}
+	}()
+}
+
+// waitlistPosition returns the 1-based position of the pending entry, or 0 if it is no
+// longer pending. A user can wait for several segments or classes of one trip, so the
+// entry is matched by its key rather than by user.
+func waitlistPosition(entries []domain.WaitlistEntry, entry domain.WaitlistEntry) int {
+	position := 0
+	for _, e := range entries {
+		if e.Status != domain.WaitlistStatusPending {
+			continue
+		}
+		position++
+		if e.SameEntry(entry) {
+			return position
+		}
+	}
+	return 0
+}
+
+// seatsInSegments keeps only seat rows belonging to the given segments
+func seatsInSegments(seats []domain.SeatInventory, segmentRange []int) []domain.SeatInventory {
+	wanted := make(map[int]bool, len(segmentRange))
+	for _, idx := range segmentRange {
+		wanted[idx] = true
+	}
+
+	var filtered []domain.SeatInventory
+	for _, seat := range seats {
+		if wanted[seat.SegmentIndex] {
+			filtered = append(filtered, seat)
+		}
+	}
+	return filtered
+}
+
+// pickWaitlistSeats returns count seats not yet offered in this pass, or nil if not enough are free
+func pickWaitlistSeats(candidates []SeatInfo, offered map[string]bool, count int) []string {
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].SeatNumber < candidates[j].SeatNumber })
+
+	var seatIDs []string
+	for _, seat := range candidates {
+		if offered[seat.SeatID] {
+			continue
+		}
+		seatIDs = append(seatIDs, seat.SeatID)
+		if len(seatIDs) == count {
+			return seatIDs
+		}
+	}
+	return nil
+}
+
+type WaitlistRequest struct {
+	OrganizationID string
+	TripID         string
+	UserID         string
+	SeatClass      string
+	RequestedSeats int
+	FromStation    string
+	ToStation      string
+}
+
+type WaitlistResult struct {
+	Success  bool
+	Message  string
+	Position int
+}
+
+type UserWaitlistEntry struct {
+	domain.WaitlistEntry
+	Position int
+}
+
+type WaitlistOfferResult struct {
+	Success   bool
+	HoldID    string
+	ExpiresAt time.Time
+	Message   string
+}
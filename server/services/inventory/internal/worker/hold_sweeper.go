@@ -0,0 +1,41 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/service"
+)
+
+// HoldSweeper releases seat holds that expired without being confirmed or released
+type HoldSweeper struct {
+	inventorySvc *service.InventoryService
+	interval     time.Duration
+}
+
+func NewHoldSweeper(inventorySvc *service.InventoryService, interval time.Duration) *HoldSweeper {
+	return &HoldSweeper{
+		inventorySvc: inventorySvc,
+		interval:     interval,
+	}
+}
+
+func (w *HoldSweeper) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.Info("Starting Hold Sweeper", "interval", w.interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Stopping Hold Sweeper")
+			return
+		case <-ticker.C:
+			if err := w.inventorySvc.SweepExpiredHolds(ctx); err != nil {
+				logger.Error("Hold sweep failed", "error", err)
+			}
+		}
+	}
+}
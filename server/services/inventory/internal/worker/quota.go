@@ -0,0 +1,41 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/service"
+)
+
+// QuotaReleaser returns unsold quota seats to the general pool once their cutoff before departure passes
+type QuotaReleaser struct {
+	inventorySvc *service.InventoryService
+	interval     time.Duration
+}
+
+func NewQuotaReleaser(inventorySvc *service.InventoryService, interval time.Duration) *QuotaReleaser {
+	return &QuotaReleaser{
+		inventorySvc: inventorySvc,
+		interval:     interval,
+	}
+}
+
+func (w *QuotaReleaser) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.Info("Starting Quota Releaser", "interval", w.interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Stopping Quota Releaser")
+			return
+		case <-ticker.C:
+			if err := w.inventorySvc.ProcessQuotaReleases(ctx); err != nil {
+				logger.Error("Quota release pass failed", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/service"
+)
+
+// SeatUnblocker returns operator-blocked seats to sale once their auto-unblock time passes
+type SeatUnblocker struct {
+	inventorySvc *service.InventoryService
+	interval     time.Duration
+}
+
+func NewSeatUnblocker(inventorySvc *service.InventoryService, interval time.Duration) *SeatUnblocker {
+	return &SeatUnblocker{
+		inventorySvc: inventorySvc,
+		interval:     interval,
+	}
+}
+
+func (w *SeatUnblocker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.Info("Starting Seat Unblocker", "interval", w.interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Stopping Seat Unblocker")
+			return
+		case <-ticker.C:
+			if err := w.inventorySvc.ProcessExpiredBlocks(ctx); err != nil {
+				logger.Error("Seat unblock pass failed", "error", err)
+			}
+		}
+	}
+}
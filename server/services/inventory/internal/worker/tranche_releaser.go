@@ -0,0 +1,41 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/service"
+)
+
+// TrancheReleaser puts release tranches on sale once their release time arrives
+type TrancheReleaser struct {
+	inventorySvc *service.InventoryService
+	interval     time.Duration
+}
+
+func NewTrancheReleaser(inventorySvc *service.InventoryService, interval time.Duration) *TrancheReleaser {
+	return &TrancheReleaser{
+		inventorySvc: inventorySvc,
+		interval:     interval,
+	}
+}
+
+func (w *TrancheReleaser) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.Info("Starting Tranche Releaser", "interval", w.interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Stopping Tranche Releaser")
+			return
+		case <-ticker.C:
+			if err := w.inventorySvc.ProcessDueTranches(ctx); err != nil {
+				logger.Error("Tranche release pass failed", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/inventory/internal/service"
+)
+
+// WaitlistPromoter periodically offers freed seats to waitlisted users and expires lapsed offers.
+// ReleaseSeats and CancelBooking trigger promotion immediately; this loop catches holds that simply expire.
+type WaitlistPromoter struct {
+	inventorySvc *service.InventoryService
+	interval     time.Duration
+}
+
+func NewWaitlistPromoter(inventorySvc *service.InventoryService, interval time.Duration) *WaitlistPromoter {
+	return &WaitlistPromoter{
+		inventorySvc: inventorySvc,
+		interval:     interval,
+	}
+}
+
+func (w *WaitlistPromoter) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.Info("Starting Waitlist Promoter", "interval", w.interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Stopping Waitlist Promoter")
+			return
+		case <-ticker.C:
+			w.inventorySvc.ProcessActiveWaitlists(ctx)
+		}
+	}
+}
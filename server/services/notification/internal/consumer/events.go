@@ -3,6 +3,7 @@ package consumer
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	"github.com/MuhibNayem/Travio/server/pkg/kafka"
 	"github.com/MuhibNayem/Travio/server/pkg/logger"
@@ -37,6 +38,7 @@ func NewEventConsumer(brokers []string, notificationSvc *service.NotificationSer
 	consumer.RegisterHandler(kafka.EventOrderConfirmed, c.handleOrderConfirmed)
 	consumer.RegisterHandler(kafka.EventOrderCancelled, c.handleOrderCancelled)
 	consumer.RegisterHandler(kafka.EventTicketGenerated, c.handleTicketGenerated)
+	consumer.RegisterHandler(kafka.EventOrderReaccommodated, c.handleOrderReaccommodated)
 
 	return c, nil
 }
@@ -44,6 +46,7 @@ func NewEventConsumer(brokers []string, notificationSvc *service.NotificationSer
 // OrderConfirmedPayload from order service
 type OrderConfirmedPayload struct {
 	OrderID      string `json:"order_id"`
+	PNR          string `json:"pnr"`
 	UserID       string `json:"user_id"`
 	TripID       string `json:"trip_id"`
 	BookingID    string `json:"booking_id"`
@@ -63,10 +66,11 @@ func (c *EventConsumer) handleOrderConfirmed(ctx context.Context, event *kafka.E
 	// Send email notification
 	err := c.notificationService.SendEmail(ctx, &service.EmailRequest{
 		To:       payload.ContactEmail,
-		Subject:  "Order Confirmed - Booking #" + payload.BookingID,
+		Subject:  "Order Confirmed - Booking #" + bookingRef(payload.PNR, payload.BookingID),
 		Template: "order_confirmed",
 		Data: map[string]interface{}{
 			"order_id":   payload.OrderID,
+			"pnr":        payload.PNR,
 			"booking_id": payload.BookingID,
 			"total":      float64(payload.TotalPaisa) / 100,
 			"logo_url":   "https://travio.com/assets/logo.png", // CDN URL for production
@@ -81,7 +85,7 @@ func (c *EventConsumer) handleOrderConfirmed(ctx context.Context, event *kafka.E
 	if payload.ContactPhone != "" {
 		c.notificationService.SendSMS(ctx, &service.SMSRequest{
 			To:      payload.ContactPhone,
-			Message: "Your booking #" + payload.BookingID + " is confirmed!",
+			Message: "Your booking #" + bookingRef(payload.PNR, payload.BookingID) + " is confirmed!",
 		})
 	}
 
@@ -91,6 +95,7 @@ func (c *EventConsumer) handleOrderConfirmed(ctx context.Context, event *kafka.E
 // OrderCancelledPayload from order service
 type OrderCancelledPayload struct {
 	OrderID      string `json:"order_id"`
+	PNR          string `json:"pnr"`
 	BookingID    string `json:"booking_id"`
 	RefundAmount int64  `json:"refund_amount"`
 	Reason       string `json:"reason"`
@@ -114,10 +119,11 @@ func (c *EventConsumer) handleOrderCancelled(ctx context.Context, event *kafka.E
 	if email != "" {
 		c.notificationService.SendEmail(ctx, &service.EmailRequest{
 			To:       email,
-			Subject:  "Order Cancelled - #" + payload.OrderID,
+			Subject:  "Order Cancelled - #" + bookingRef(payload.PNR, payload.OrderID),
 			Template: "order_cancelled",
 			Data: map[string]interface{}{
 				"order_id":      payload.OrderID,
+				"pnr":           payload.PNR,
 				"refund_amount": float64(payload.RefundAmount) / 100,
 				"reason":        payload.Reason,
 				"logo_url":      "https://travio.com/assets/logo.png",
@@ -128,7 +134,7 @@ func (c *EventConsumer) handleOrderCancelled(ctx context.Context, event *kafka.E
 	if phone != "" {
 		c.notificationService.SendSMS(ctx, &service.SMSRequest{
 			To:      phone,
-			Message: "Order #" + payload.OrderID + " cancelled. Refund: " + formatMoney(payload.RefundAmount),
+			Message: "Order #" + bookingRef(payload.PNR, payload.OrderID) + " cancelled. Refund: " + formatMoney(payload.RefundAmount),
 		})
 	}
 
@@ -170,6 +176,67 @@ func (c *EventConsumer) handleTicketGenerated(ctx context.Context, event *kafka.
 	return nil
 }
 
+// SeatReassignment is one passenger's seat move after a vehicle change
+type SeatReassignment struct {
+	PassengerName string `json:"passenger_name"`
+	OldSeatNumber string `json:"old_seat_number"`
+	NewSeatNumber string `json:"new_seat_number"`
+}
+
+// OrderReaccommodatedPayload from order service
+type OrderReaccommodatedPayload struct {
+	OrderID      string             `json:"order_id"`
+	BookingID    string             `json:"booking_id"`
+	Moved        []SeatReassignment `json:"moved"`
+	Unplaced     []SeatReassignment `json:"unplaced"`
+	ContactEmail string             `json:"contact_email"`
+	ContactPhone string             `json:"contact_phone"`
+}
+
+// handleOrderReaccommodated tells passengers their seats changed with the vehicle
+func (c *EventConsumer) handleOrderReaccommodated(ctx context.Context, event *kafka.Event) error {
+	logger.Info("sending seat change notification", "event_id", event.ID)
+
+	payloadBytes, _ := json.Marshal(event.Payload)
+	var payload OrderReaccommodatedPayload
+	json.Unmarshal(payloadBytes, &payload)
+
+	if payload.ContactEmail != "" {
+		c.notificationService.SendEmail(ctx, &service.EmailRequest{
+			To:       payload.ContactEmail,
+			Subject:  "Seat Change - Booking #" + payload.BookingID,
+			Template: "seats_changed",
+			Data: map[string]interface{}{
+				"order_id":   payload.OrderID,
+				"booking_id": payload.BookingID,
+				"moved":      payload.Moved,
+				"unplaced":   payload.Unplaced,
+				"logo_url":   "https://travio.com/assets/logo.png",
+			},
+		})
+	}
+
+	if payload.ContactPhone != "" {
+		var seats []string
+		for _, m := range payload.Moved {
+			seats = append(seats, m.OldSeatNumber+"→"+m.NewSeatNumber)
+		}
+		message := "Booking #" + payload.BookingID + ": vehicle changed."
+		if len(seats) > 0 {
+			message += " New seats: " + strings.Join(seats, ", ") + "."
+		}
+		if len(payload.Unplaced) > 0 {
+			message += " Some passengers could not be seated; we will contact you."
+		}
+		c.notificationService.SendSMS(ctx, &service.SMSRequest{
+			To:      payload.ContactPhone,
+			Message: message,
+		})
+	}
+
+	return nil
+}
+
 // Start begins consuming events
 func (c *EventConsumer) Start() error {
 	return c.consumer.Start()
@@ -180,6 +247,15 @@ func (c *EventConsumer) Stop() error {
 	return c.consumer.Stop()
 }
 
+// bookingRef is the reference customers know an order by: its PNR, or the fallback for
+// orders placed before PNRs existed
+func bookingRef(pnr, fallback string) string {
+	if pnr != "" {
+		return pnr
+	}
+	return fallback
+}
+
 func formatMoney(paisa int64) string {
 	return "BDT " + string(rune(paisa/100))
 }
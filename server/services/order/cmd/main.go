@@ -10,6 +10,7 @@ import (
 	"github.com/MuhibNayem/Travio/server/pkg/server"
 	"github.com/MuhibNayem/Travio/server/services/order/config"
 	"github.com/MuhibNayem/Travio/server/services/order/internal/clients"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/consumer"
 	"github.com/MuhibNayem/Travio/server/services/order/internal/handler"
 	"github.com/MuhibNayem/Travio/server/services/order/internal/messaging"
 	"github.com/MuhibNayem/Travio/server/services/order/internal/middleware"
@@ -93,6 +94,15 @@ func main() {
 		PaymentService:      paymentClient,
 		SubscriptionService: subscriptionClient,
 		NotificationSvc:     notificationClient,
+		CreditLedger:        repository.NewAccountRepository(db),
+		Passengers:          repository.NewOrderRepository(db),
+	}
+
+	// Bookings are scored for fraud before payment; without the fraud service they go through unscored
+	if fraudClient, err := clients.NewFraudClient(cfg.Services.FraudAddr); err != nil {
+		logger.Error("Failed to connect to fraud service", "error", err)
+	} else {
+		sagaDeps.FraudService = fraudClient
 	}
 
 	// Repository and service
@@ -107,9 +117,29 @@ func main() {
 	if dlq != nil {
 		dlqProducer = dlq
 	}
-	orderService := service.NewOrderService(db, gormDB, dlqProducer, orderRepo, sagaDeps, catalogClient, pricingClient, inventoryClient)
+	orderService := service.NewOrderService(db, gormDB, dlqProducer, orderRepo, sagaDeps, catalogClient, pricingClient, inventoryClient,
+		repository.NewTicketLimitChecker(redisClient))
 	grpcHandler := handler.NewGrpcHandler(orderService)
 
+	// Resume or compensate sagas interrupted by a restart
+	go orderService.StartSagaRecovery(context.Background())
+
+	// Expire pending orders abandoned before they were paid
+	go orderService.StartExpirySweeper(context.Background())
+
+	// Invoice corporate accounts once each billing period is over
+	go orderService.StartInvoiceScheduler(context.Background())
+
+	// Inventory events: seat changes on confirmed orders
+	inventoryConsumer, err := consumer.NewInventoryEventConsumer([]string{"localhost:9092"}, orderService)
+	if err != nil {
+		logger.Error("Failed to create inventory event consumer", "error", err)
+	} else if err := inventoryConsumer.Start(); err != nil {
+		logger.Error("Failed to start inventory event consumer", "error", err)
+	} else {
+		defer inventoryConsumer.Stop()
+	}
+
 	// Idempotency Middleware
 	idempotency := middleware.NewIdempotencyMiddleware(redisClient)
 
@@ -31,6 +31,7 @@ type ServicesConfig struct {
 	SubscriptionAddr string
 	CatalogAddr      string
 	PricingAddr      string
+	FraudAddr        string
 }
 
 func Load() *Config {
@@ -58,6 +59,7 @@ func Load() *Config {
 			SubscriptionAddr: getEnv("SUBSCRIPTION_URL", "localhost:50060"),
 			CatalogAddr:      getEnv("CATALOG_URL", "localhost:9082"),
 			PricingAddr:      getEnv("PRICING_URL", "localhost:9095"),
+			FraudAddr:        getEnv("FRAUD_URL", "localhost:50090"),
 		},
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	catalogpb "github.com/MuhibNayem/Travio/server/api/proto/catalog/v1"
+	fraudpb "github.com/MuhibNayem/Travio/server/api/proto/fraud/v1"
 	inventorypb "github.com/MuhibNayem/Travio/server/api/proto/inventory/v1"
 	nidpb "github.com/MuhibNayem/Travio/server/api/proto/nid/v1"
 	paymentpb "github.com/MuhibNayem/Travio/server/api/proto/payment/v1"
@@ -13,7 +14,9 @@ import (
 	"github.com/MuhibNayem/Travio/server/pkg/logger"
 	"github.com/MuhibNayem/Travio/server/services/order/internal/saga"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 // NIDClient implements saga.NIDVerifier via gRPC
@@ -55,13 +58,33 @@ func NewInventoryClient(addr string) (*InventoryClient, error) {
 	return &InventoryClient{client: inventorypb.NewInventoryServiceClient(conn)}, nil
 }
 
-func (c *InventoryClient) HoldSeats(ctx context.Context, orgID, tripID string, seatIDs []string, userID string) (string, error) {
+func (c *InventoryClient) HoldSeats(ctx context.Context, orgID, tripID string, seatIDs []string, capacity []saga.CapacityItem, userID string, claims *saga.QuotaClaims) (string, error) {
+	var items []*inventorypb.CapacityItem
+	for _, item := range capacity {
+		items = append(items, &inventorypb.CapacityItem{
+			CapacityClass: item.CapacityClass,
+			Quantity:      int32(item.Quantity),
+		})
+	}
+	var quotaClaims *inventorypb.QuotaClaims
+	if claims != nil {
+		quotaClaims = &inventorypb.QuotaClaims{
+			Female:    claims.Female,
+			Disabled:  claims.Disabled,
+			Channel:   claims.Channel,
+			PartnerId: claims.PartnerID,
+			Vip:       claims.VIP,
+		}
+	}
+
 	resp, err := c.client.HoldSeats(ctx, &inventorypb.HoldSeatsRequest{
 		OrganizationId:      orgID,
 		TripId:              tripID,
 		SeatIds:             seatIDs,
 		UserId:              userID,
 		HoldDurationSeconds: 600,
+		CapacityItems:       items,
+		QuotaClaims:         quotaClaims,
 	})
 	if err != nil {
 		return "", err
@@ -81,7 +104,41 @@ func (c *InventoryClient) ReleaseSeats(ctx context.Context, orgID, holdID, userI
 	return err
 }
 
-func (c *InventoryClient) ConfirmBooking(ctx context.Context, orgID, holdID, orderID, userID string, passengers []saga.PassengerInfo) (string, error) {
+func (c *InventoryClient) ExtendHold(ctx context.Context, orgID, holdID, userID string, extendBy time.Duration) (time.Time, error) {
+	resp, err := c.client.ExtendHold(ctx, &inventorypb.ExtendHoldRequest{
+		OrganizationId:  orgID,
+		HoldId:          holdID,
+		UserId:          userID,
+		ExtendBySeconds: int32(extendBy / time.Second),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !resp.Success {
+		return time.Unix(resp.ExpiresAt, 0), &saga.SagaError{Message: resp.FailureReason}
+	}
+	return time.Unix(resp.ExpiresAt, 0), nil
+}
+
+// ExtendHoldForReview keeps a hold for a booking under fraud review, outside the hold policy
+func (c *InventoryClient) ExtendHoldForReview(ctx context.Context, orgID, holdID, userID string, extendBy time.Duration) (time.Time, error) {
+	resp, err := c.client.ExtendHold(ctx, &inventorypb.ExtendHoldRequest{
+		OrganizationId:  orgID,
+		HoldId:          holdID,
+		UserId:          userID,
+		ExtendBySeconds: int32(extendBy / time.Second),
+		ForReview:       true,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !resp.Success {
+		return time.Unix(resp.ExpiresAt, 0), &saga.SagaError{Message: resp.FailureReason}
+	}
+	return time.Unix(resp.ExpiresAt, 0), nil
+}
+
+func (c *InventoryClient) ConfirmBooking(ctx context.Context, orgID, holdID, orderID, userID string, passengers []saga.PassengerInfo) (string, []saga.ConfirmedSeat, error) {
 	var pbPassengers []*inventorypb.PassengerSeat
 	for _, p := range passengers {
 		pbPassengers = append(pbPassengers, &inventorypb.PassengerSeat{
@@ -99,17 +156,60 @@ func (c *InventoryClient) ConfirmBooking(ctx context.Context, orgID, holdID, ord
 		Passengers:     pbPassengers,
 	})
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	if !resp.Success {
-		return "", &saga.SagaError{Message: resp.FailureReason}
+		return "", nil, &saga.SagaError{Message: resp.FailureReason}
+	}
+
+	seats := make([]saga.ConfirmedSeat, 0, len(resp.ConfirmedSeats))
+	for _, s := range resp.ConfirmedSeats {
+		seats = append(seats, saga.ConfirmedSeat{
+			SeatID:         s.SeatId,
+			SeatNumber:     s.SeatNumber,
+			SeatClass:      s.SeatClass,
+			TicketID:       s.TicketId,
+			PricePaisa:     s.PricePaisa,
+			PassengerIndex: int(s.PassengerIndex),
+			FromStationID:  s.FromStationId,
+			ToStationID:    s.ToStationId,
+			CapacityClass:  s.CapacityClass,
+		})
 	}
-	return resp.BookingId, nil
+	return resp.BookingId, seats, nil
 }
 
 func (c *InventoryClient) CancelBooking(ctx context.Context, bookingID, orderID string) error {
-	// Inventory service would need a CancelBooking RPC - for now use release
-	return nil
+	_, err := c.client.CancelBooking(ctx, &inventorypb.CancelBookingRequest{
+		BookingId: bookingID,
+		OrderId:   orderID,
+	})
+	return err
+}
+
+func (c *InventoryClient) CancelBookingPassengers(ctx context.Context, bookingID, orderID string, passengerIndexes []int) error {
+	indexes := make([]int32, 0, len(passengerIndexes))
+	for _, idx := range passengerIndexes {
+		indexes = append(indexes, int32(idx))
+	}
+	_, err := c.client.CancelBooking(ctx, &inventorypb.CancelBookingRequest{
+		BookingId:        bookingID,
+		OrderId:          orderID,
+		PassengerIndexes: indexes,
+	})
+	return err
+}
+
+// TransferPassenger hands a passenger's booked seats to a new traveller
+func (c *InventoryClient) TransferPassenger(ctx context.Context, bookingID, orderID string, passengerIndex int, nid, name string) error {
+	_, err := c.client.TransferPassenger(ctx, &inventorypb.TransferPassengerRequest{
+		BookingId:      bookingID,
+		OrderId:        orderID,
+		PassengerIndex: int32(passengerIndex),
+		PassengerNid:   nid,
+		PassengerName:  name,
+	})
+	return err
 }
 
 func (c *InventoryClient) GetSeatMap(ctx context.Context, orgID, tripID, fromStationID, toStationID string) (*inventorypb.GetSeatMapResponse, error) {
@@ -121,6 +221,20 @@ func (c *InventoryClient) GetSeatMap(ctx context.Context, orgID, tripID, fromSta
 	})
 }
 
+// GetCapacity returns the deck and standing places free for a journey
+func (c *InventoryClient) GetCapacity(ctx context.Context, orgID, tripID, fromStationID, toStationID string) ([]*inventorypb.CapacityAvailability, error) {
+	resp, err := c.client.CheckAvailability(ctx, &inventorypb.CheckAvailabilityRequest{
+		OrganizationId: orgID,
+		TripId:         tripID,
+		FromStationId:  fromStationID,
+		ToStationId:    toStationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Capacity, nil
+}
+
 // PaymentClient implements saga.PaymentClient via gRPC
 type PaymentClient struct {
 	client paymentpb.PaymentServiceClient
@@ -169,6 +283,70 @@ func (c *PaymentClient) Refund(ctx context.Context, paymentID string, amountPais
 	return resp.RefundId, nil
 }
 
+// Cancel cancels the order's pending payment and returns the payment's resulting status.
+// An order that never reached the payment service has nothing to cancel.
+func (c *PaymentClient) Cancel(ctx context.Context, orderID, reason string) (string, error) {
+	resp, err := c.client.CancelPayment(ctx, &paymentpb.CancelPaymentRequest{
+		OrderId: orderID,
+		Reason:  reason,
+	})
+	if status.Code(err) == codes.NotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return resp.Status, nil
+}
+
+// FraudClient implements saga.FraudClient via gRPC
+type FraudClient struct {
+	client fraudpb.FraudServiceClient
+}
+
+func NewFraudClient(addr string) (*FraudClient, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &FraudClient{client: fraudpb.NewFraudServiceClient(conn)}, nil
+}
+
+func (c *FraudClient) AnalyzeBooking(ctx context.Context, req *saga.FraudCheckRequest) (*saga.FraudAssessment, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.client.AnalyzeBooking(ctx, &fraudpb.AnalyzeBookingRequest{
+		OrderId:           req.OrderID,
+		OrganizationId:    req.OrgID,
+		UserId:            req.UserID,
+		TripId:            req.TripID,
+		PassengerNids:     req.PassengerNIDs,
+		PassengerNames:    req.PassengerNames,
+		PassengerCount:    int32(len(req.PassengerNIDs)),
+		BookingTimestamp:  time.Now().Unix(),
+		IpAddress:         req.IPAddress,
+		UserAgent:         req.UserAgent,
+		PaymentMethod:     req.PaymentMethod,
+		TotalAmountPaisa:  req.AmountPaisa,
+		DeviceFingerprint: req.DeviceFingerprint,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assessment := &saga.FraudAssessment{
+		RiskScore:   int(resp.RiskScore),
+		RiskLevel:   resp.RiskLevel,
+		ShouldBlock: resp.ShouldBlock,
+		Summary:     resp.Summary,
+	}
+	for _, f := range resp.RiskFactors {
+		assessment.RiskFactors = append(assessment.RiskFactors, f.Description)
+	}
+	return assessment, nil
+}
+
 // SubscriptionClient implements saga.SubscriptionClient via gRPC
 type SubscriptionClient struct {
 	client subscriptionpb.SubscriptionServiceClient
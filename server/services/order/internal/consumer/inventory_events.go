@@ -0,0 +1,92 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/MuhibNayem/Travio/server/pkg/kafka"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/service"
+)
+
+// InventoryEventConsumer applies inventory changes that affect confirmed orders
+type InventoryEventConsumer struct {
+	consumer     *kafka.Consumer
+	orderService *service.OrderService
+}
+
+// NewInventoryEventConsumer creates a new consumer for inventory events
+func NewInventoryEventConsumer(brokers []string, orderSvc *service.OrderService) (*InventoryEventConsumer, error) {
+	consumer, err := kafka.NewConsumer(brokers, "order-service", []string{kafka.TopicInventory})
+	if err != nil {
+		return nil, err
+	}
+
+	c := &InventoryEventConsumer{
+		consumer:     consumer,
+		orderService: orderSvc,
+	}
+
+	consumer.RegisterHandler(kafka.EventPassengersReaccommodated, c.handlePassengersReaccommodated)
+
+	return c, nil
+}
+
+// PassengersReaccommodatedPayload matches the event structure from inventory service
+type PassengersReaccommodatedPayload struct {
+	TripID         string                    `json:"trip_id"`
+	OrganizationID string                    `json:"organization_id"`
+	BookingID      string                    `json:"booking_id"`
+	OrderID        string                    `json:"order_id"`
+	UserID         string                    `json:"user_id"`
+	OldVehicleID   string                    `json:"old_vehicle_id"`
+	NewVehicleID   string                    `json:"new_vehicle_id"`
+	Moved          []domain.SeatReassignment `json:"moved"`
+	Unplaced       []domain.SeatReassignment `json:"unplaced"`
+}
+
+// handlePassengersReaccommodated updates an order whose passengers moved to a new vehicle
+func (c *InventoryEventConsumer) handlePassengersReaccommodated(ctx context.Context, event *kafka.Event) error {
+	payloadBytes, err := json.Marshal(event.Payload)
+	if err != nil {
+		logger.Error("failed to marshal payload", "error", err)
+		return err
+	}
+
+	var payload PassengersReaccommodatedPayload
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		logger.Error("failed to unmarshal PassengersReaccommodated payload", "error", err)
+		return err
+	}
+	if payload.OrderID == "" {
+		return nil // Bookings made outside the order flow
+	}
+
+	logger.Info("received PassengersReaccommodated event",
+		"event_id", event.ID,
+		"order_id", payload.OrderID,
+		"moved", len(payload.Moved),
+		"unplaced", len(payload.Unplaced),
+	)
+
+	return c.orderService.ReaccommodateOrder(ctx, &service.ReaccommodateOrderRequest{
+		OrderID:      payload.OrderID,
+		UserID:       payload.UserID,
+		BookingID:    payload.BookingID,
+		OldVehicleID: payload.OldVehicleID,
+		NewVehicleID: payload.NewVehicleID,
+		Moved:        payload.Moved,
+		Unplaced:     payload.Unplaced,
+	})
+}
+
+// Start begins consuming events
+func (c *InventoryEventConsumer) Start() error {
+	return c.consumer.Start()
+}
+
+// Stop stops the consumer
+func (c *InventoryEventConsumer) Stop() error {
+	return c.consumer.Stop()
+}
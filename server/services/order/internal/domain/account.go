@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PaymentMethodAccount charges an order to a corporate account's credit, settled by invoice
+const PaymentMethodAccount = "account"
+
+// Account kinds
+const (
+	AccountKindCorporate = "corporate"
+	AccountKindGroup     = "group" // Tour groups
+)
+
+// Account statuses
+const (
+	AccountStatusActive    = "active"
+	AccountStatusSuspended = "suspended" // Keeps its invoices but takes no new orders
+)
+
+// Invoice statuses
+const (
+	InvoiceStatusIssued = "issued"
+	InvoiceStatusPaid   = "paid"
+)
+
+// DefaultPaymentTermsDays is how long an account has to pay an invoice unless configured
+const DefaultPaymentTermsDays = 30
+
+var (
+	ErrAccountNotFound      = errors.New("account not found")
+	ErrInvalidAccount       = errors.New("invalid account")
+	ErrAccountSuspended     = errors.New("account is suspended")
+	ErrNotAccountBooker     = errors.New("user may not book on this account")
+	ErrTravellerNotApproved = errors.New("passenger is not an approved traveller of the account")
+	ErrCreditLimitExceeded  = errors.New("order exceeds the account's available credit")
+	ErrInvoiceNotFound      = errors.New("invoice not found")
+	ErrInvoiceAlreadyPaid   = errors.New("invoice is already paid")
+)
+
+// CorporateAccount lets a company or tour group book on credit and pay by monthly invoice.
+// Orders on the account are charged against its credit limit when confirmed; the credit
+// is given back as invoices are paid and orders are refunded.
+type CorporateAccount struct {
+	ID               string `json:"id"`
+	OrganizationID   string `json:"organization_id"`
+	Name             string `json:"name"`
+	Kind             string `json:"kind"`
+	Status           string `json:"status"`
+	BillingEmail     string `json:"billing_email"`
+	Currency         string `json:"currency"`
+	CreditLimitPaisa int64  `json:"credit_limit_paisa"`
+	OutstandingPaisa int64  `json:"outstanding_paisa"` // Charged and not yet paid, invoiced or not
+	PaymentTermsDays int    `json:"payment_terms_days"`
+	// Users who may book on the account
+	BookerIDs []string `json:"booker_ids"`
+	// Passengers the account pays for; an empty list approves anyone
+	Travellers []ApprovedTraveller `json:"travellers,omitempty"`
+	// Tickets an order on the account may hold per trip, in place of the anti-scalp
+	// limits; zero keeps the standard limits
+	MaxGroupSize int       `json:"max_group_size"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ApprovedTraveller is a passenger an account pays for
+type ApprovedTraveller struct {
+	NID  string `json:"nid"`
+	Name string `json:"name"`
+}
+
+// Validate checks the account can be saved
+func (a *CorporateAccount) Validate() error {
+	if a.OrganizationID == "" || a.Name == "" {
+		return fmt.Errorf("%w: organization and name are required", ErrInvalidAccount)
+	}
+	if a.Kind != AccountKindCorporate && a.Kind != AccountKindGroup {
+		return fmt.Errorf("%w: kind must be corporate or group", ErrInvalidAccount)
+	}
+	if a.Status != AccountStatusActive && a.Status != AccountStatusSuspended {
+		return fmt.Errorf("%w: status must be active or suspended", ErrInvalidAccount)
+	}
+	if a.CreditLimitPaisa < 0 || a.MaxGroupSize < 0 || a.PaymentTermsDays < 0 {
+		return fmt.Errorf("%w: credit limit, group size and payment terms cannot be negative", ErrInvalidAccount)
+	}
+	for _, t := range a.Travellers {
+		if t.NID == "" {
+			return fmt.Errorf("%w: travellers need an NID", ErrInvalidAccount)
+		}
+	}
+	return nil
+}
+
+// AvailableCreditPaisa is what the account can still be charged
+func (a *CorporateAccount) AvailableCreditPaisa() int64 {
+	return a.CreditLimitPaisa - a.OutstandingPaisa
+}
+
+// CanBook reports whether the user may book on the account
+func (a *CorporateAccount) CanBook(userID string) bool {
+	for _, id := range a.BookerIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Approves reports whether the account pays for the passenger
+func (a *CorporateAccount) Approves(nid string) bool {
+	if len(a.Travellers) == 0 {
+		return true
+	}
+	for _, t := range a.Travellers {
+		if t.NID == nid {
+			return true
+		}
+	}
+	return false
+}
+
+// AccountCharge is an entry of an account's ledger: an order charged to it, or a refund
+// given back, until it is billed on an invoice
+type AccountCharge struct {
+	ID          string    `json:"id"`
+	AccountID   string    `json:"account_id"`
+	OrderID     string    `json:"order_id"`
+	AmountPaisa int64     `json:"amount_paisa"` // Negative for refunds
+	Description string    `json:"description"`
+	InvoiceID   string    `json:"invoice_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// AccountInvoice consolidates an account's charges and refunds over a billing period
+type AccountInvoice struct {
+	ID             string          `json:"id"`
+	AccountID      string          `json:"account_id"`
+	OrganizationID string          `json:"organization_id"`
+	PeriodStart    time.Time       `json:"period_start"`
+	PeriodEnd      time.Time       `json:"period_end"` // Exclusive
+	TotalPaisa     int64           `json:"total_paisa"`
+	Currency       string          `json:"currency"`
+	Status         string          `json:"status"`
+	IssuedAt       time.Time       `json:"issued_at"`
+	DueAt          time.Time       `json:"due_at"`
+	PaidAt         time.Time       `json:"paid_at,omitempty"`
+	Lines          []AccountCharge `json:"lines,omitempty"`
+}
+
+// BillingPeriodEnd is the end of the last whole billing period before t: invoices cover
+// calendar months and are issued once the month is over
+func BillingPeriodEnd(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
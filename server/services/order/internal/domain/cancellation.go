@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidPassengers = errors.New("passengers are not on the order or are already cancelled")
+
+// PassengerCancellation records passengers cancelled out of an order that stays confirmed
+type PassengerCancellation struct {
+	SagaID           string           `json:"saga_id"`
+	PassengerIndexes []int            `json:"passenger_indexes"`
+	Seats            []BookedSeat     `json:"seats"`
+	Reason           string           `json:"reason"`
+	RefundID         string           `json:"refund_id,omitempty"`
+	Refund           *RefundBreakdown `json:"refund,omitempty"`
+	CancelledAt      time.Time        `json:"cancelled_at"`
+}
+
+// ActivePassengers returns the indexes of the passengers not cancelled
+func (o *Order) ActivePassengers() []int {
+	active := make([]int, 0, len(o.Passengers))
+	for i, p := range o.Passengers {
+		if !p.Cancelled {
+			active = append(active, i)
+		}
+	}
+	return active
+}
+
+// PassengerIndexesForSeats returns the passengers holding any of the seats
+func (o *Order) PassengerIndexesForSeats(seatIDs []string) []int {
+	wanted := make(map[string]bool, len(seatIDs))
+	for _, id := range seatIDs {
+		wanted[id] = true
+	}
+	seen := make(map[int]bool)
+	var indexes []int
+	for _, seat := range o.Seats {
+		if wanted[seat.SeatID] && !seen[seat.PassengerIndex] {
+			seen[seat.PassengerIndex] = true
+			indexes = append(indexes, seat.PassengerIndex)
+		}
+	}
+	for i, p := range o.Passengers {
+		if wanted[p.SeatID] && !seen[i] {
+			seen[i] = true
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// PassengersPart returns the share of the order owed for some of its active passengers:
+// their seats, their share of the fare, tax and discount in proportion to their seat prices
+// (or headcount when seats carry no price), and their booking fees. Quoting a refund on
+// the part prorates the refund to those passengers.
+func (o *Order) PassengersPart(indexes []int) (*Order, error) {
+	active := o.ActivePassengers()
+	if len(indexes) == 0 || len(active) == 0 {
+		return nil, ErrInvalidPassengers
+	}
+	chosen := make(map[int]bool, len(indexes))
+	for _, idx := range indexes {
+		if idx < 0 || idx >= len(o.Passengers) || o.Passengers[idx].Cancelled {
+			return nil, ErrInvalidPassengers
+		}
+		chosen[idx] = true
+	}
+
+	part := &Order{
+		ID:             o.ID,
+		OrganizationID: o.OrganizationID,
+		UserID:         o.UserID,
+		TripID:         o.TripID,
+		RouteID:        o.RouteID,
+		FromStationID:  o.FromStationID,
+		ToStationID:    o.ToStationID,
+		Currency:       o.Currency,
+		PaymentID:      o.PaymentID,
+		BookingID:      o.BookingID,
+		Status:         o.Status,
+	}
+	for i, p := range o.Passengers {
+		if chosen[i] {
+			part.Passengers = append(part.Passengers, p)
+		}
+	}
+
+	var chosenPrice, allPrice int64
+	for _, seat := range o.Seats {
+		allPrice += seat.PricePaisa
+		if chosen[seat.PassengerIndex] {
+			chosenPrice += seat.PricePaisa
+			part.Seats = append(part.Seats, seat)
+		}
+	}
+	num, den := int64(len(chosen)), int64(len(active))
+	if allPrice > 0 {
+		num, den = chosenPrice, allPrice
+	}
+
+	part.SubtotalPaisa = o.SubtotalPaisa * num / den
+	part.TaxPaisa = o.TaxPaisa * num / den
+	part.DiscountPaisa = o.DiscountPaisa * num / den
+	part.BookingFeePaisa = o.BookingFeePaisa * int64(len(chosen)) / int64(len(active))
+	part.TotalPaisa = part.SubtotalPaisa + part.TaxPaisa + part.BookingFeePaisa - part.DiscountPaisa
+	if part.TotalPaisa < 0 {
+		part.TotalPaisa = 0
+	}
+	if part.TotalPaisa > o.TotalPaisa {
+		part.TotalPaisa = o.TotalPaisa
+	}
+	return part, nil
+}
+
+// RemovePassengers cancels the passengers of part out of the order: they are marked
+// cancelled, their seats leave the order and their share comes off its totals
+func (o *Order) RemovePassengers(part *Order, cancellation PassengerCancellation) {
+	removed := make(map[int]bool, len(cancellation.PassengerIndexes))
+	for _, idx := range cancellation.PassengerIndexes {
+		removed[idx] = true
+		if idx >= 0 && idx < len(o.Passengers) {
+			o.Passengers[idx].Cancelled = true
+		}
+	}
+
+	seats := o.Seats[:0]
+	for _, seat := range o.Seats {
+		if !removed[seat.PassengerIndex] {
+			seats = append(seats, seat)
+		}
+	}
+	o.Seats = seats
+
+	o.SubtotalPaisa -= part.SubtotalPaisa
+	o.TaxPaisa -= part.TaxPaisa
+	o.BookingFeePaisa -= part.BookingFeePaisa
+	o.DiscountPaisa -= part.DiscountPaisa
+	o.TotalPaisa -= part.TotalPaisa
+	if o.TotalPaisa < 0 {
+		o.TotalPaisa = 0
+	}
+
+	cancellation.Seats = part.Seats
+	o.PassengerCancellations = append(o.PassengerCancellations, cancellation)
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidChange = errors.New("a change must move the order to another trip of its route or to other seats")
+	ErrChangeClosed  = errors.New("changes are closed for this trip")
+)
+
+// ChangeQuote prices a date or seat change: what the new seats cost against what the
+// order costs now, plus the operator's change fee. A positive amount due is collected
+// from the user; a negative one is refunded.
+type ChangeQuote struct {
+	PolicyID             string    `json:"policy_id,omitempty"` // Empty for the default policy
+	PolicyName           string    `json:"policy_name"`
+	DepartureTime        time.Time `json:"departure_time"` // Of the trip booked now
+	HoursBeforeDeparture float64   `json:"hours_before_departure"`
+	NewTripID            string    `json:"new_trip_id"`
+
+	OldTotalPaisa       int64     `json:"old_total_paisa"`
+	NewTotalPaisa       int64     `json:"new_total_paisa"`
+	FareDifferencePaisa int64     `json:"fare_difference_paisa"`
+	ChangeFeePaisa      int64     `json:"change_fee_paisa"`
+	AmountDuePaisa      int64     `json:"amount_due_paisa"`
+	QuotedAt            time.Time `json:"quoted_at"`
+}
+
+// OrderChange records a date or seat change of a confirmed order: the trip, booking and
+// seats it replaced and how the difference was settled
+type OrderChange struct {
+	SagaID       string       `json:"saga_id"`
+	OldTripID    string       `json:"old_trip_id"`
+	OldBookingID string       `json:"old_booking_id"`
+	OldSeats     []BookedSeat `json:"old_seats"`
+	NewTripID    string       `json:"new_trip_id"`
+	NewBookingID string       `json:"new_booking_id"`
+	Quote        *ChangeQuote `json:"quote"`
+	PaymentID    string       `json:"payment_id,omitempty"` // Charge collecting the amount due
+	RefundID     string       `json:"refund_id,omitempty"`  // Refund of a cheaper change
+	RefundFailed bool         `json:"refund_failed,omitempty"`
+	ChangedAt    time.Time    `json:"changed_at"`
+}
+
+// QuoteChange prices moving the order onto the new leg at now under the policy. The fee
+// is charged per passenger moved; changes close ChangeCutoffHours before the booked trip
+// departs.
+func (p *RefundPolicy) QuoteChange(order *Order, leg *OrderLeg, departure, now time.Time) (*ChangeQuote, error) {
+	hours := departure.Sub(now).Hours()
+	if hours < 0 || hours < float64(p.ChangeCutoffHours) {
+		return nil, ErrChangeClosed
+	}
+
+	q := &ChangeQuote{
+		PolicyID:             p.ID,
+		PolicyName:           p.Name,
+		DepartureTime:        departure,
+		HoursBeforeDeparture: hours,
+		NewTripID:            leg.TripID,
+		OldTotalPaisa:        order.TotalPaisa,
+		NewTotalPaisa:        leg.TotalPaisa,
+		FareDifferencePaisa:  leg.TotalPaisa - order.TotalPaisa,
+		ChangeFeePaisa:       p.ChangeFeePaisa * int64(len(leg.Passengers)),
+		QuotedAt:             now,
+	}
+	q.AmountDuePaisa = q.FareDifferencePaisa + q.ChangeFeePaisa
+	return q, nil
+}
+
+// ApplyChange moves the order onto the new leg: its trip, hold, booking, passengers, seats
+// and totals become the leg's. Passengers cancelled earlier are left off the new booking;
+// their cancellations stay on the order.
+func (o *Order) ApplyChange(leg *OrderLeg, change OrderChange) {
+	change.OldTripID, change.OldBookingID, change.OldSeats = o.TripID, o.BookingID, o.Seats
+	change.NewTripID, change.NewBookingID = leg.TripID, leg.BookingID
+
+	o.TripID = leg.TripID
+	o.RouteID = leg.RouteID
+	o.HoldID = leg.HoldID
+	o.BookingID = leg.BookingID
+	o.Passengers = leg.Passengers
+	o.Seats = leg.Seats
+
+	o.SubtotalPaisa = leg.SubtotalPaisa
+	o.TaxPaisa = leg.TaxPaisa
+	o.BookingFeePaisa = leg.BookingFeePaisa
+	o.DiscountPaisa = leg.DiscountPaisa
+	o.TotalPaisa = leg.TotalPaisa
+
+	o.Changes = append(o.Changes, change)
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Fraud review decisions
+const (
+	FraudDecisionApproved = "approved"
+	FraudDecisionRejected = "rejected"
+)
+
+var ErrNotUnderReview = errors.New("order is not awaiting fraud review")
+
+// FraudReview is the fraud check's assessment of a booking it held for manual review,
+// and the analyst's decision on it
+type FraudReview struct {
+	RiskScore   int       `json:"risk_score"`
+	RiskLevel   string    `json:"risk_level"`
+	Summary     string    `json:"summary"`
+	RiskFactors []string  `json:"risk_factors,omitempty"`
+	Decision    string    `json:"decision,omitempty"` // Empty while pending
+	ReviewerID  string    `json:"reviewer_id,omitempty"`
+	Note        string    `json:"note,omitempty"`
+	FlaggedAt   time.Time `json:"flagged_at"`
+	DecidedAt   time.Time `json:"decided_at,omitempty"`
+}
+
+// Decided reports whether an analyst has approved or rejected the booking
+func (r *FraudReview) Decided() bool {
+	return r.Decision != ""
+}
@@ -7,6 +7,7 @@ import (
 // Order represents a booking order
 type Order struct {
 	ID             string `json:"id"`
+	PNR            string `json:"pnr"` // Short booking reference printed on tickets
 	OrganizationID string `json:"organization_id"`
 	UserID         string `json:"user_id"`
 	TripID         string `json:"trip_id"`
@@ -35,10 +36,32 @@ type Order struct {
 	HoldID    string       `json:"hold_id"`
 	Seats     []BookedSeat `json:"seats"`
 
+	// Round-trip and multi-leg orders: every leg, the first included. The order's trip,
+	// stations, hold, booking, passengers and seats above mirror the first leg; its totals
+	// cover all legs. Single-trip orders have no legs.
+	Legs []OrderLeg `json:"legs,omitempty"`
+
 	// Status
 	Status OrderStatus `json:"status"`
 	SagaID string      `json:"saga_id"`
 
+	// Refund computed under the refund policy when the order was cancelled
+	Refund *RefundBreakdown `json:"refund,omitempty"`
+	// Passengers cancelled out of the order while the rest stayed confirmed
+	PassengerCancellations []PassengerCancellation `json:"passenger_cancellations,omitempty"`
+	// Date and seat changes made to the confirmed order, oldest first
+	Changes []OrderChange `json:"changes,omitempty"`
+	// Set when the fraud check held the booking for manual review
+	FraudReview *FraudReview `json:"fraud_review,omitempty"`
+
+	// Counter sales: the agent who sold the order and the shift it was sold in
+	AgentID string `json:"agent_id,omitempty"`
+	ShiftID string `json:"shift_id,omitempty"`
+	// Orders charged to a corporate account's credit
+	AccountID string `json:"account_id,omitempty"`
+	// IP the order was placed from; its tickets count against the per-IP limit
+	ClientIP string `json:"-"`
+
 	// Contact
 	ContactEmail string `json:"contact_email"`
 	ContactPhone string `json:"contact_phone"`
@@ -62,6 +85,7 @@ const (
 	OrderStatusExpired       OrderStatus = "expired"
 	OrderStatusRefundPending OrderStatus = "refund_pending"
 	OrderStatusRefunded      OrderStatus = "refunded"
+	OrderStatusReviewPending OrderStatus = "review_pending" // Held by the fraud check for an analyst
 )
 
 // OrderPassenger is a passenger on an order (distinct from anti-scalp Passenger)
@@ -74,14 +98,132 @@ type OrderPassenger struct {
 	Gender      string `json:"gender"`
 	Age         int    `json:"age"`
 	NIDVerified bool   `json:"nid_verified"`
+	// Deck or standing passengers carry a capacity class instead of a seat
+	CapacityClass string `json:"capacity_class,omitempty"`
+	// Cancelled passengers stay on the order so passenger indexes keep their meaning
+	Cancelled bool `json:"cancelled,omitempty"`
 }
 
+// BookedSeat is one ticketed seat; split-seat journeys have one per leg
 type BookedSeat struct {
-	SeatID     string `json:"seat_id"`
-	SeatNumber string `json:"seat_number"`
-	SeatClass  string `json:"seat_class"`
-	TicketID   string `json:"ticket_id"`
-	PricePaisa int64  `json:"price_paisa"`
+	SeatID         string `json:"seat_id"`
+	SeatNumber     string `json:"seat_number"`
+	SeatClass      string `json:"seat_class"`
+	TicketID       string `json:"ticket_id"`
+	PricePaisa     int64  `json:"price_paisa"`
+	PassengerIndex int    `json:"passenger_index"`
+	FromStationID  string `json:"from_station_id,omitempty"`
+	ToStationID    string `json:"to_station_id,omitempty"`
+	CapacityClass  string `json:"capacity_class,omitempty"` // Capacity tickets only; SeatID is then empty
+}
+
+// OrderLeg is one trip of a multi-leg order with its own hold, booking, segment range
+// and passengers. All legs are paid with one charge and confirmed together.
+type OrderLeg struct {
+	TripID        string           `json:"trip_id"`
+	RouteID       string           `json:"route_id"`
+	FromStationID string           `json:"from_station_id"`
+	ToStationID   string           `json:"to_station_id"`
+	HoldID        string           `json:"hold_id"`
+	BookingID     string           `json:"booking_id"`
+	Passengers    []OrderPassenger `json:"passengers"`
+	Seats         []BookedSeat     `json:"seats"`
+
+	SubtotalPaisa   int64 `json:"subtotal_paisa"`
+	TaxPaisa        int64 `json:"tax_paisa"`
+	BookingFeePaisa int64 `json:"booking_fee_paisa"`
+	DiscountPaisa   int64 `json:"discount_paisa"`
+	TotalPaisa      int64 `json:"total_paisa"`
+}
+
+// IsMultiLeg reports whether the order books more than one trip
+func (o *Order) IsMultiLeg() bool {
+	return len(o.Legs) > 1
+}
+
+// SetLegs makes the order cover the legs: the first leg's trip, stations, hold, booking,
+// passengers and seats become the order's, and the totals add up every leg
+func (o *Order) SetLegs(legs []OrderLeg) {
+	if len(legs) == 0 {
+		return
+	}
+	first := legs[0]
+	o.TripID, o.RouteID = first.TripID, first.RouteID
+	o.FromStationID, o.ToStationID = first.FromStationID, first.ToStationID
+	o.HoldID, o.BookingID = first.HoldID, first.BookingID
+	o.Passengers, o.Seats = first.Passengers, first.Seats
+
+	o.SubtotalPaisa, o.TaxPaisa, o.BookingFeePaisa, o.DiscountPaisa, o.TotalPaisa = 0, 0, 0, 0, 0
+	for _, leg := range legs {
+		o.SubtotalPaisa += leg.SubtotalPaisa
+		o.TaxPaisa += leg.TaxPaisa
+		o.BookingFeePaisa += leg.BookingFeePaisa
+		o.DiscountPaisa += leg.DiscountPaisa
+		o.TotalPaisa += leg.TotalPaisa
+	}
+
+	o.Legs = nil
+	if len(legs) > 1 {
+		o.Legs = legs
+	}
+}
+
+// LegOrder returns one leg as an order of its own, e.g. to quote its refund
+func (o *Order) LegOrder(i int) *Order {
+	leg := o.Legs[i]
+	return &Order{
+		ID:              o.ID,
+		OrganizationID:  o.OrganizationID,
+		UserID:          o.UserID,
+		TripID:          leg.TripID,
+		RouteID:         leg.RouteID,
+		FromStationID:   leg.FromStationID,
+		ToStationID:     leg.ToStationID,
+		HoldID:          leg.HoldID,
+		BookingID:       leg.BookingID,
+		Passengers:      leg.Passengers,
+		Seats:           leg.Seats,
+		SubtotalPaisa:   leg.SubtotalPaisa,
+		TaxPaisa:        leg.TaxPaisa,
+		BookingFeePaisa: leg.BookingFeePaisa,
+		DiscountPaisa:   leg.DiscountPaisa,
+		TotalPaisa:      leg.TotalPaisa,
+		Currency:        o.Currency,
+		PaymentID:       o.PaymentID,
+		Status:          o.Status,
+	}
+}
+
+// BookingIDs returns the inventory bookings of every leg
+func (o *Order) BookingIDs() []string {
+	if !o.IsMultiLeg() {
+		if o.BookingID == "" {
+			return nil
+		}
+		return []string{o.BookingID}
+	}
+	ids := make([]string, 0, len(o.Legs))
+	for _, leg := range o.Legs {
+		if leg.BookingID != "" {
+			ids = append(ids, leg.BookingID)
+		}
+	}
+	return ids
+}
+
+// SeatReassignment is one passenger seat changed when the trip's vehicle was swapped.
+// Unplaced passengers have no new seat and wait for a refund or another trip.
+type SeatReassignment struct {
+	PassengerIndex int    `json:"passenger_index"`
+	PassengerName  string `json:"passenger_name"`
+	PassengerNID   string `json:"passenger_nid"`
+	SeatClass      string `json:"seat_class"`
+	OldSeatID      string `json:"old_seat_id"`
+	OldSeatNumber  string `json:"old_seat_number"`
+	OldTicketID    string `json:"old_ticket_id"`
+	NewSeatID      string `json:"new_seat_id,omitempty"`
+	NewSeatNumber  string `json:"new_seat_number,omitempty"`
+	NewTicketID    string `json:"new_ticket_id,omitempty"`
 }
 
 // PaymentStatus constants
@@ -91,6 +233,10 @@ const (
 	PaymentStatusCaptured   = "captured"
 	PaymentStatusFailed     = "failed"
 	PaymentStatusRefunded   = "refunded"
+	// Abandoned unpaid; the payment can no longer be completed
+	PaymentStatusCancelled = "cancelled"
+	// Cancelled under a policy that kept part of the payment
+	PaymentStatusPartiallyRefunded = "partially_refunded"
 )
 
 // CalculateTotals calculates order totals
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// PNRLength is the number of characters in a booking reference
+const PNRLength = 6
+
+// pnrAlphabet leaves out characters easily misread over the phone or on paper:
+// 0/O, 1/I/L
+const pnrAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+var (
+	ErrInvalidPNR     = errors.New("invalid PNR")
+	ErrContactMissing = errors.New("the phone number or email the order was booked with is required")
+)
+
+// NewPNR generates a random booking reference. Uniqueness is checked by the caller.
+func NewPNR() (string, error) {
+	max := big.NewInt(int64(len(pnrAlphabet)))
+	var b strings.Builder
+	for i := 0; i < PNRLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(pnrAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// NormalizePNR upper-cases a PNR as typed by a customer and checks its shape
+func NormalizePNR(pnr string) (string, error) {
+	pnr = strings.ToUpper(strings.TrimSpace(pnr))
+	if len(pnr) != PNRLength {
+		return "", ErrInvalidPNR
+	}
+	for _, c := range pnr {
+		if !strings.ContainsRune(pnrAlphabet, c) {
+			return "", ErrInvalidPNR
+		}
+	}
+	return pnr, nil
+}
+
+// MatchesContact reports whether contact is the email or phone number the order was
+// booked with. Emails compare case-insensitively; phones by their last ten digits, so
+// "+880 1712-345678" matches "01712345678".
+func (o *Order) MatchesContact(contact string) bool {
+	contact = strings.TrimSpace(contact)
+	if contact == "" {
+		return false
+	}
+	if strings.Contains(contact, "@") {
+		return o.ContactEmail != "" && strings.EqualFold(contact, strings.TrimSpace(o.ContactEmail))
+	}
+	given, booked := phoneDigits(contact), phoneDigits(o.ContactPhone)
+	return len(given) >= 10 && given == booked
+}
+
+// phoneDigits keeps the last ten digits of a phone number
+func phoneDigits(phone string) string {
+	var b strings.Builder
+	for _, c := range phone {
+		if c >= '0' && c <= '9' {
+			b.WriteRune(c)
+		}
+	}
+	digits := b.String()
+	if len(digits) > 10 {
+		digits = digits[len(digits)-10:]
+	}
+	return digits
+}
@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var (
+	ErrInvalidRefundPolicy  = errors.New("invalid refund policy")
+	ErrRefundPolicyNotFound = errors.New("refund policy not found")
+)
+
+// RefundPolicy decides how much of an order is refunded when it is cancelled.
+// A policy covers an organization, optionally narrowed to one route and/or vehicle class;
+// the most specific policy matching an order's trip applies.
+type RefundPolicy struct {
+	ID             string       `json:"id"`
+	OrganizationID string       `json:"organization_id"`
+	RouteID        string       `json:"route_id,omitempty"`      // Empty: any route
+	VehicleClass   string       `json:"vehicle_class,omitempty"` // Empty: any class
+	Name           string       `json:"name"`
+	Tiers          []RefundTier `json:"tiers"`
+	// RefundBookingFee returns the booking fee along with the fare; it is kept otherwise
+	RefundBookingFee bool `json:"refund_booking_fee"`
+	// Date and seat changes: a fee per passenger, and no changes within
+	// ChangeCutoffHours of departure
+	ChangeFeePaisa    int64 `json:"change_fee_paisa"`
+	ChangeCutoffHours int   `json:"change_cutoff_hours"`
+	// Ticket transfers to another passenger: a fee per transfer, and no transfers
+	// within TransferCutoffHours of departure
+	TransferFeePaisa    int64     `json:"transfer_fee_paisa"`
+	TransferCutoffHours int       `json:"transfer_cutoff_hours"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// RefundTier refunds a share of the fare and tax when the order is cancelled at least
+// MinHoursBeforeDeparture before the trip leaves. Nothing is refunded after departure.
+type RefundTier struct {
+	MinHoursBeforeDeparture int `json:"min_hours_before_departure"`
+	RefundPercent           int `json:"refund_percent"` // 0-100
+}
+
+// DefaultRefundPolicy applies when an organization has no policy: a full refund,
+// booking fee included, up to departure
+var DefaultRefundPolicy = RefundPolicy{
+	Name:             "default",
+	Tiers:            []RefundTier{{MinHoursBeforeDeparture: 0, RefundPercent: 100}},
+	RefundBookingFee: true,
+}
+
+// Validate checks the tiers, change and transfer terms and sorts the tiers, latest cut-off first
+func (p *RefundPolicy) Validate() error {
+	if p.OrganizationID == "" || len(p.Tiers) == 0 || p.ChangeFeePaisa < 0 || p.ChangeCutoffHours < 0 ||
+		p.TransferFeePaisa < 0 || p.TransferCutoffHours < 0 {
+		return ErrInvalidRefundPolicy
+	}
+	seen := make(map[int]bool, len(p.Tiers))
+	for _, t := range p.Tiers {
+		if t.MinHoursBeforeDeparture < 0 || t.RefundPercent < 0 || t.RefundPercent > 100 || seen[t.MinHoursBeforeDeparture] {
+			return ErrInvalidRefundPolicy
+		}
+		seen[t.MinHoursBeforeDeparture] = true
+	}
+	sort.Slice(p.Tiers, func(i, j int) bool {
+		return p.Tiers[i].MinHoursBeforeDeparture > p.Tiers[j].MinHoursBeforeDeparture
+	})
+	return nil
+}
+
+// Specificity ranks policies for the same organization: route and class beat route,
+// route beats class, and class beats organization-wide
+func (p *RefundPolicy) Specificity() int {
+	n := 0
+	if p.RouteID != "" {
+		n += 2
+	}
+	if p.VehicleClass != "" {
+		n++
+	}
+	return n
+}
+
+// Matches reports whether the policy covers a trip on the route with the vehicle class
+func (p *RefundPolicy) Matches(routeID, vehicleClass string) bool {
+	return (p.RouteID == "" || p.RouteID == routeID) && (p.VehicleClass == "" || p.VehicleClass == vehicleClass)
+}
+
+// RefundBreakdown is how a cancellation refund was computed. It is quoted before the
+// user confirms and kept on the order once the cancellation goes through.
+type RefundBreakdown struct {
+	PolicyID             string    `json:"policy_id,omitempty"` // Empty for the default policy
+	PolicyName           string    `json:"policy_name"`
+	DepartureTime        time.Time `json:"departure_time"`
+	HoursBeforeDeparture float64   `json:"hours_before_departure"` // Negative after departure
+	RefundPercent        int       `json:"refund_percent"`
+
+	FarePaisa             int64     `json:"fare_paisa"` // Subtotal less discount
+	FareRefundPaisa       int64     `json:"fare_refund_paisa"`
+	TaxPaisa              int64     `json:"tax_paisa"`
+	TaxRefundPaisa        int64     `json:"tax_refund_paisa"`
+	BookingFeePaisa       int64     `json:"booking_fee_paisa"`
+	BookingFeeRefundPaisa int64     `json:"booking_fee_refund_paisa"`
+	RefundPaisa           int64     `json:"refund_paisa"`
+	RetainedPaisa         int64     `json:"retained_paisa"`
+	QuotedAt              time.Time `json:"quoted_at"`
+
+	// Multi-leg orders: each leg quoted under its own trip's policy
+	Legs []RefundBreakdown `json:"legs,omitempty"`
+}
+
+// CombineRefunds adds up the quotes of a multi-leg order's legs. The policy, departure
+// and percentage shown are the first leg's.
+func CombineRefunds(legs []*RefundBreakdown) *RefundBreakdown {
+	if len(legs) == 0 {
+		return nil
+	}
+	first := *legs[0]
+	b := &RefundBreakdown{
+		PolicyID:             first.PolicyID,
+		PolicyName:           first.PolicyName,
+		DepartureTime:        first.DepartureTime,
+		HoursBeforeDeparture: first.HoursBeforeDeparture,
+		RefundPercent:        first.RefundPercent,
+		QuotedAt:             first.QuotedAt,
+	}
+	for _, leg := range legs {
+		b.FarePaisa += leg.FarePaisa
+		b.FareRefundPaisa += leg.FareRefundPaisa
+		b.TaxPaisa += leg.TaxPaisa
+		b.TaxRefundPaisa += leg.TaxRefundPaisa
+		b.BookingFeePaisa += leg.BookingFeePaisa
+		b.BookingFeeRefundPaisa += leg.BookingFeeRefundPaisa
+		b.RefundPaisa += leg.RefundPaisa
+		b.RetainedPaisa += leg.RetainedPaisa
+		b.Legs = append(b.Legs, *leg)
+	}
+	return b
+}
+
+// Quote computes the refund of cancelling the order at now under the policy
+func (p *RefundPolicy) Quote(order *Order, departure, now time.Time) *RefundBreakdown {
+	hours := departure.Sub(now).Hours()
+
+	percent := 0
+	if hours >= 0 {
+		for _, t := range p.Tiers { // Latest cut-off first
+			if hours >= float64(t.MinHoursBeforeDeparture) {
+				percent = t.RefundPercent
+				break
+			}
+		}
+	}
+
+	fare := order.SubtotalPaisa - order.DiscountPaisa
+	if fare < 0 {
+		fare = 0
+	}
+	b := &RefundBreakdown{
+		PolicyID:             p.ID,
+		PolicyName:           p.Name,
+		DepartureTime:        departure,
+		HoursBeforeDeparture: hours,
+		RefundPercent:        percent,
+		FarePaisa:            fare,
+		FareRefundPaisa:      fare * int64(percent) / 100,
+		TaxPaisa:             order.TaxPaisa,
+		TaxRefundPaisa:       order.TaxPaisa * int64(percent) / 100,
+		BookingFeePaisa:      order.BookingFeePaisa,
+		QuotedAt:             now,
+	}
+	if p.RefundBookingFee && percent > 0 {
+		b.BookingFeeRefundPaisa = order.BookingFeePaisa
+	}
+
+	b.RefundPaisa = b.FareRefundPaisa + b.TaxRefundPaisa + b.BookingFeeRefundPaisa
+	if b.RefundPaisa > order.TotalPaisa {
+		b.RefundPaisa = order.TotalPaisa
+	}
+	b.RetainedPaisa = order.TotalPaisa - b.RefundPaisa
+	return b
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// PaymentMethodCash is paid at a counter to an agent and never reaches the payment gateway
+const PaymentMethodCash = "cash"
+
+// Shift statuses
+const (
+	ShiftStatusOpen   = "open"
+	ShiftStatusClosed = "closed"
+)
+
+var (
+	ErrShiftNotFound     = errors.New("shift not found")
+	ErrNoOpenShift       = errors.New("agent has no open shift")
+	ErrShiftAlreadyOpen  = errors.New("agent already has an open shift")
+	ErrCashNotAllowed    = errors.New("cash is only taken by counter agents")
+	ErrInvalidCashAmount = errors.New("cash amounts cannot be negative")
+)
+
+// AgentShift is a counter agent's session at the till. Cash orders the agent sells are
+// added to it as they are confirmed, and cash refunds the agent pays out are taken off.
+type AgentShift struct {
+	ID                string    `json:"id"`
+	OrganizationID    string    `json:"organization_id"`
+	AgentID           string    `json:"agent_id"`
+	Status            string    `json:"status"`
+	OpeningFloatPaisa int64     `json:"opening_float_paisa"` // Cash in the drawer when the shift opened
+	CashSalesPaisa    int64     `json:"cash_sales_paisa"`    // Running total of confirmed cash orders
+	CashRefundsPaisa  int64     `json:"cash_refunds_paisa"`  // Running total of cash paid back
+	Currency          string    `json:"currency"`
+	OpenedAt          time.Time `json:"opened_at"`
+
+	// Set when the shift is closed
+	CountedCashPaisa  int64     `json:"counted_cash_paisa"`
+	ExpectedCashPaisa int64     `json:"expected_cash_paisa"`
+	VariancePaisa     int64     `json:"variance_paisa"` // Counted less expected; negative is a shortfall
+	Note              string    `json:"note,omitempty"`
+	ClosedAt          time.Time `json:"closed_at,omitempty"`
+}
+
+// IsOpen reports whether the shift still takes cash
+func (s *AgentShift) IsOpen() bool {
+	return s.Status == ShiftStatusOpen
+}
+
+// ShiftOrders is what the orders sold in a shift add up to
+type ShiftOrders struct {
+	ConfirmedOrders    int   `json:"confirmed_orders"`
+	ConfirmedCashPaisa int64 `json:"confirmed_cash_paisa"` // Totals of the cash orders that were confirmed
+	PendingOrders      int   `json:"pending_orders"`       // Still booking or awaiting fraud review
+}
+
+// ShiftReport reconciles a shift's cash against the orders confirmed in it
+type ShiftReport struct {
+	Shift  *AgentShift `json:"shift"`
+	Orders ShiftOrders `json:"orders"`
+	// Confirmed cash orders less the shift's running sales total; non-zero when a
+	// confirmation was not added to the running total
+	UnrecordedSalesPaisa int64 `json:"unrecorded_sales_paisa"`
+}
+
+// Reconcile reports on the shift. The cash expected in the drawer of an open shift is
+// the float plus the confirmed cash orders, less the cash refunds paid out; a closed
+// shift keeps the figures it was closed with.
+func (s *AgentShift) Reconcile(orders ShiftOrders) *ShiftReport {
+	if s.IsOpen() {
+		s.ExpectedCashPaisa = s.OpeningFloatPaisa + orders.ConfirmedCashPaisa - s.CashRefundsPaisa
+	}
+	return &ShiftReport{
+		Shift:                s,
+		Orders:               orders,
+		UnrecordedSalesPaisa: orders.ConfirmedCashPaisa - s.CashSalesPaisa,
+	}
+}
+
+// Close closes the shift with the cash counted in its drawer and returns its closing report
+func (s *AgentShift) Close(orders ShiftOrders, countedCashPaisa int64, note string) *ShiftReport {
+	report := s.Reconcile(orders)
+	s.Status = ShiftStatusClosed
+	s.CountedCashPaisa = countedCashPaisa
+	s.VariancePaisa = countedCashPaisa - s.ExpectedCashPaisa
+	s.Note = note
+	s.ClosedAt = time.Now()
+	return report
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidTransfer        = errors.New("a transfer must hand an active passenger's ticket to a different traveller")
+	ErrTransferClosed         = errors.New("transfers are closed for this trip")
+	ErrTransferNIDNotVerified = errors.New("the new passenger's NID could not be verified")
+)
+
+// TransferQuote prices handing one passenger's ticket to another traveller under the
+// operator's policy
+type TransferQuote struct {
+	PolicyID             string    `json:"policy_id,omitempty"` // Empty for the default policy
+	PolicyName           string    `json:"policy_name"`
+	DepartureTime        time.Time `json:"departure_time"`
+	HoursBeforeDeparture float64   `json:"hours_before_departure"`
+	FeePaisa             int64     `json:"fee_paisa"`
+	QuotedAt             time.Time `json:"quoted_at"`
+}
+
+// PassengerTransfer records a ticket handed from one passenger to another. Transfers are
+// kept apart from the order so the NIDs that repeatedly pass tickets on can be found.
+type PassengerTransfer struct {
+	ID             string    `json:"id"`
+	OrderID        string    `json:"order_id"`
+	OrganizationID string    `json:"organization_id"`
+	UserID         string    `json:"user_id"`
+	TripID         string    `json:"trip_id"`
+	PassengerIndex int       `json:"passenger_index"`
+	OldNID         string    `json:"old_nid"`
+	OldName        string    `json:"old_name"`
+	NewNID         string    `json:"new_nid"`
+	NewName        string    `json:"new_name"`
+	FeePaisa       int64     `json:"fee_paisa"`
+	PaymentID      string    `json:"payment_id,omitempty"` // Charge collecting the fee
+	SagaID         string    `json:"saga_id"`
+	TransferredAt  time.Time `json:"transferred_at"`
+}
+
+// TransferFilter narrows the transfer history; empty fields match everything
+type TransferFilter struct {
+	OrganizationID string
+	OrderID        string
+	UserID         string
+	NID            string // Either side of the transfer
+	Limit          int
+	Offset         int
+}
+
+// QuoteTransfer prices a transfer at now under the policy. The fee is charged per
+// passenger transferred; transfers close TransferCutoffHours before the trip departs.
+func (p *RefundPolicy) QuoteTransfer(departure, now time.Time) (*TransferQuote, error) {
+	hours := departure.Sub(now).Hours()
+	if hours < 0 || hours < float64(p.TransferCutoffHours) {
+		return nil, ErrTransferClosed
+	}
+	return &TransferQuote{
+		PolicyID:             p.ID,
+		PolicyName:           p.Name,
+		DepartureTime:        departure,
+		HoursBeforeDeparture: hours,
+		FeePaisa:             p.TransferFeePaisa,
+		QuotedAt:             now,
+	}, nil
+}
+
+// TransferablePassenger checks that a passenger's ticket can go to the new traveller:
+// the passenger is active and nobody on the order already travels on the new NID
+func (o *Order) TransferablePassenger(index int, newNID string) error {
+	if index < 0 || index >= len(o.Passengers) || o.Passengers[index].Cancelled {
+		return ErrInvalidPassengers
+	}
+	for i, p := range o.Passengers {
+		if !p.Cancelled && p.NID == newNID {
+			if i == index {
+				return ErrInvalidTransfer
+			}
+			return ErrDuplicateNID
+		}
+	}
+	return nil
+}
+
+// SeatNumbers returns the seat numbers a passenger holds, one per leg of a split seat
+func (o *Order) SeatNumbers(index int) []string {
+	var numbers []string
+	for _, seat := range o.Seats {
+		if seat.PassengerIndex == index && seat.SeatNumber != "" {
+			numbers = append(numbers, seat.SeatNumber)
+		}
+	}
+	if len(numbers) == 0 && index >= 0 && index < len(o.Passengers) && o.Passengers[index].SeatNumber != "" {
+		numbers = append(numbers, o.Passengers[index].SeatNumber)
+	}
+	return numbers
+}
+
+// ApplyTransfer puts the new traveller in the passenger's place, keeping the seat and
+// fare. The transfer's old holder is filled in from the order.
+func (o *Order) ApplyTransfer(transfer *PassengerTransfer) {
+	p := &o.Passengers[transfer.PassengerIndex]
+	transfer.OldNID, transfer.OldName = p.NID, p.Name
+	p.NID = transfer.NewNID
+	p.Name = transfer.NewName
+	p.NIDVerified = true
+}
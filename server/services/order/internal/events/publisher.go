@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/MuhibNayem/Travio/server/pkg/kafka"
 	"github.com/MuhibNayem/Travio/server/pkg/outbox"
@@ -41,6 +42,7 @@ type OrderCreatedPayload struct {
 // OrderConfirmedPayload is the event payload for order confirmed
 type OrderConfirmedPayload struct {
 	OrderID        string `json:"order_id"`
+	PNR            string `json:"pnr"`
 	UserID         string `json:"user_id"`
 	OrganizationID string `json:"organization_id"`
 	TripID         string `json:"trip_id"`
@@ -54,6 +56,7 @@ type OrderConfirmedPayload struct {
 // OrderCancelledPayload is the event payload for order cancelled
 type OrderCancelledPayload struct {
 	OrderID        string `json:"order_id"`
+	PNR            string `json:"pnr"`
 	UserID         string `json:"user_id"`
 	OrganizationID string `json:"organization_id"`
 	BookingID      string `json:"booking_id"`
@@ -71,6 +74,127 @@ type OrderFailedPayload struct {
 	SagaState string `json:"saga_state"`
 }
 
+// OrderReaccommodatedPayload is the event payload for passengers moved to a new vehicle
+type OrderReaccommodatedPayload struct {
+	OrderID        string                    `json:"order_id"`
+	UserID         string                    `json:"user_id"`
+	OrganizationID string                    `json:"organization_id"`
+	TripID         string                    `json:"trip_id"`
+	BookingID      string                    `json:"booking_id"`
+	OldVehicleID   string                    `json:"old_vehicle_id"`
+	NewVehicleID   string                    `json:"new_vehicle_id"`
+	Moved          []domain.SeatReassignment `json:"moved"`
+	Unplaced       []domain.SeatReassignment `json:"unplaced"`
+	ContactEmail   string                    `json:"contact_email"`
+	ContactPhone   string                    `json:"contact_phone"`
+}
+
+// OrderPassengersCancelledPayload is the event payload for passengers cancelled out of a confirmed order
+type OrderPassengersCancelledPayload struct {
+	OrderID        string                  `json:"order_id"`
+	UserID         string                  `json:"user_id"`
+	OrganizationID string                  `json:"organization_id"`
+	TripID         string                  `json:"trip_id"`
+	BookingID      string                  `json:"booking_id"`
+	PaymentID      string                  `json:"payment_id"`
+	Passengers     []CancelledPassenger    `json:"passengers"`
+	RefundID       string                  `json:"refund_id"`
+	RefundAmount   int64                   `json:"refund_amount"`
+	Refund         *domain.RefundBreakdown `json:"refund,omitempty"`
+	Reason         string                  `json:"reason"`
+	TotalPaisa     int64                   `json:"total_paisa"` // What the order now costs
+}
+
+// CancelledPassenger is one passenger cancelled out of an order, with the seats they gave up
+type CancelledPassenger struct {
+	PassengerIndex int      `json:"passenger_index"`
+	PassengerNID   string   `json:"passenger_nid"`
+	PassengerName  string   `json:"passenger_name"`
+	SeatNumbers    []string `json:"seat_numbers,omitempty"`
+}
+
+// OrderChangedPayload is the event payload for a confirmed order moved to new seats or another trip
+type OrderChangedPayload struct {
+	OrderID        string `json:"order_id"`
+	UserID         string `json:"user_id"`
+	OrganizationID string `json:"organization_id"`
+	OldTripID      string `json:"old_trip_id"`
+	OldBookingID   string `json:"old_booking_id"`
+	TripID         string `json:"trip_id"`
+	BookingID      string `json:"booking_id"`
+	PaymentID      string `json:"payment_id,omitempty"` // Charge collecting the amount due
+	RefundID       string `json:"refund_id,omitempty"`
+	AmountDuePaisa int64  `json:"amount_due_paisa"`
+	ChangeFeePaisa int64  `json:"change_fee_paisa"`
+	TotalPaisa     int64  `json:"total_paisa"` // What the order now costs
+	ContactEmail   string `json:"contact_email"`
+	ContactPhone   string `json:"contact_phone"`
+}
+
+// OrderExpiredPayload is the event payload for a pending order abandoned before it was paid
+type OrderExpiredPayload struct {
+	OrderID        string    `json:"order_id"`
+	UserID         string    `json:"user_id"`
+	OrganizationID string    `json:"organization_id"`
+	TripID         string    `json:"trip_id"`
+	PaymentID      string    `json:"payment_id,omitempty"` // Cancelled pending payment
+	TotalPaisa     int64     `json:"total_paisa"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	ContactEmail   string    `json:"contact_email"`
+	ContactPhone   string    `json:"contact_phone"`
+}
+
+// OrderReviewPendingPayload is the event payload for a booking the fraud check held for
+// an analyst
+type OrderReviewPendingPayload struct {
+	OrderID        string    `json:"order_id"`
+	UserID         string    `json:"user_id"`
+	OrganizationID string    `json:"organization_id"`
+	TripID         string    `json:"trip_id"`
+	TotalPaisa     int64     `json:"total_paisa"`
+	RiskScore      int       `json:"risk_score"`
+	RiskLevel      string    `json:"risk_level"`
+	Summary        string    `json:"summary"`
+	FlaggedAt      time.Time `json:"flagged_at"`
+}
+
+// AccountInvoiceIssuedPayload is the event payload for a corporate account's invoice
+type AccountInvoiceIssuedPayload struct {
+	InvoiceID      string    `json:"invoice_id"`
+	AccountID      string    `json:"account_id"`
+	OrganizationID string    `json:"organization_id"`
+	AccountName    string    `json:"account_name"`
+	BillingEmail   string    `json:"billing_email"`
+	PeriodStart    time.Time `json:"period_start"`
+	PeriodEnd      time.Time `json:"period_end"`
+	TotalPaisa     int64     `json:"total_paisa"`
+	Currency       string    `json:"currency"`
+	LineCount      int       `json:"line_count"`
+	DueAt          time.Time `json:"due_at"`
+}
+
+// OrderPassengerTransferredPayload is the event payload for a passenger's ticket handed
+// to a new traveller; the old holder's tickets are cancelled and new ones issued
+type OrderPassengerTransferredPayload struct {
+	OrderID        string    `json:"order_id"`
+	UserID         string    `json:"user_id"`
+	OrganizationID string    `json:"organization_id"`
+	TripID         string    `json:"trip_id"`
+	BookingID      string    `json:"booking_id"`
+	TransferID     string    `json:"transfer_id"`
+	PassengerIndex int       `json:"passenger_index"`
+	OldNID         string    `json:"old_nid"`
+	OldName        string    `json:"old_name"`
+	NewNID         string    `json:"new_nid"`
+	NewName        string    `json:"new_name"`
+	SeatNumbers    []string  `json:"seat_numbers,omitempty"`
+	FeePaisa       int64     `json:"fee_paisa"`
+	PaymentID      string    `json:"payment_id,omitempty"`
+	ContactEmail   string    `json:"contact_email"`
+	ContactPhone   string    `json:"contact_phone"`
+	TransferredAt  time.Time `json:"transferred_at"`
+}
+
 // PublishOrderCreated publishes order created event within a transaction
 func (p *Publisher) PublishOrderCreated(ctx context.Context, tx *sql.Tx, order *domain.Order) error {
 	payload := OrderCreatedPayload{
@@ -95,6 +219,7 @@ func (p *Publisher) PublishOrderCreated(ctx context.Context, tx *sql.Tx, order *
 func (p *Publisher) PublishOrderConfirmed(ctx context.Context, tx *sql.Tx, order *domain.Order) error {
 	payload := OrderConfirmedPayload{
 		OrderID:        order.ID,
+		PNR:            order.PNR,
 		UserID:         order.UserID,
 		OrganizationID: order.OrganizationID,
 		TripID:         order.TripID,
@@ -111,6 +236,7 @@ func (p *Publisher) PublishOrderConfirmed(ctx context.Context, tx *sql.Tx, order
 func (p *Publisher) PublishOrderCancelled(ctx context.Context, tx *sql.Tx, order *domain.Order, refundID string, refundAmount int64, reason string) error {
 	payload := OrderCancelledPayload{
 		OrderID:        order.ID,
+		PNR:            order.PNR,
 		UserID:         order.UserID,
 		OrganizationID: order.OrganizationID,
 		BookingID:      order.BookingID,
@@ -132,3 +258,160 @@ func (p *Publisher) PublishOrderFailed(ctx context.Context, tx *sql.Tx, order *d
 	}
 	return p.outbox.Publish(ctx, tx, kafka.TopicOrders, kafka.EventOrderFailed, order.ID, payload)
 }
+
+// PublishOrderReaccommodated publishes the seat changes of an order within a transaction
+func (p *Publisher) PublishOrderReaccommodated(ctx context.Context, tx *sql.Tx, order *domain.Order, oldVehicleID, newVehicleID string, moved, unplaced []domain.SeatReassignment) error {
+	payload := OrderReaccommodatedPayload{
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		OrganizationID: order.OrganizationID,
+		TripID:         order.TripID,
+		BookingID:      order.BookingID,
+		OldVehicleID:   oldVehicleID,
+		NewVehicleID:   newVehicleID,
+		Moved:          moved,
+		Unplaced:       unplaced,
+		ContactEmail:   order.ContactEmail,
+		ContactPhone:   order.ContactPhone,
+	}
+	return p.outbox.Publish(ctx, tx, kafka.TopicOrders, kafka.EventOrderReaccommodated, order.ID, payload)
+}
+
+// PublishOrderPassengersCancelled publishes a partial cancellation of an order within a transaction
+func (p *Publisher) PublishOrderPassengersCancelled(ctx context.Context, tx *sql.Tx, order *domain.Order, cancellation *domain.PassengerCancellation) error {
+	passengers := make([]CancelledPassenger, 0, len(cancellation.PassengerIndexes))
+	for _, idx := range cancellation.PassengerIndexes {
+		cp := CancelledPassenger{PassengerIndex: idx}
+		if idx >= 0 && idx < len(order.Passengers) {
+			cp.PassengerNID = order.Passengers[idx].NID
+			cp.PassengerName = order.Passengers[idx].Name
+		}
+		for _, seat := range cancellation.Seats {
+			if seat.PassengerIndex == idx && seat.SeatNumber != "" {
+				cp.SeatNumbers = append(cp.SeatNumbers, seat.SeatNumber)
+			}
+		}
+		passengers = append(passengers, cp)
+	}
+
+	var amount int64
+	if cancellation.Refund != nil {
+		amount = cancellation.Refund.RefundPaisa
+	}
+	payload := OrderPassengersCancelledPayload{
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		OrganizationID: order.OrganizationID,
+		TripID:         order.TripID,
+		BookingID:      order.BookingID,
+		PaymentID:      order.PaymentID,
+		Passengers:     passengers,
+		RefundID:       cancellation.RefundID,
+		RefundAmount:   amount,
+		Refund:         cancellation.Refund,
+		Reason:         cancellation.Reason,
+		TotalPaisa:     order.TotalPaisa,
+	}
+	return p.outbox.Publish(ctx, tx, kafka.TopicOrders, kafka.EventOrderPassengersCancelled, order.ID, payload)
+}
+
+// PublishOrderChanged publishes a date or seat change of an order within a transaction
+func (p *Publisher) PublishOrderChanged(ctx context.Context, tx *sql.Tx, order *domain.Order, change *domain.OrderChange) error {
+	payload := OrderChangedPayload{
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		OrganizationID: order.OrganizationID,
+		OldTripID:      change.OldTripID,
+		OldBookingID:   change.OldBookingID,
+		TripID:         order.TripID,
+		BookingID:      order.BookingID,
+		PaymentID:      change.PaymentID,
+		RefundID:       change.RefundID,
+		TotalPaisa:     order.TotalPaisa,
+		ContactEmail:   order.ContactEmail,
+		ContactPhone:   order.ContactPhone,
+	}
+	if change.Quote != nil {
+		payload.AmountDuePaisa = change.Quote.AmountDuePaisa
+		payload.ChangeFeePaisa = change.Quote.ChangeFeePaisa
+	}
+	return p.outbox.Publish(ctx, tx, kafka.TopicOrders, kafka.EventOrderChanged, order.ID, payload)
+}
+
+// PublishOrderExpired publishes the expiry of an abandoned order within a transaction
+func (p *Publisher) PublishOrderExpired(ctx context.Context, tx *sql.Tx, order *domain.Order, paymentID string) error {
+	payload := OrderExpiredPayload{
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		OrganizationID: order.OrganizationID,
+		TripID:         order.TripID,
+		PaymentID:      paymentID,
+		TotalPaisa:     order.TotalPaisa,
+		ExpiresAt:      order.ExpiresAt,
+		ContactEmail:   order.ContactEmail,
+		ContactPhone:   order.ContactPhone,
+	}
+	return p.outbox.Publish(ctx, tx, kafka.TopicOrders, kafka.EventOrderExpired, order.ID, payload)
+}
+
+// PublishOrderReviewPending publishes a booking held for fraud review within a transaction
+func (p *Publisher) PublishOrderReviewPending(ctx context.Context, tx *sql.Tx, order *domain.Order) error {
+	payload := OrderReviewPendingPayload{
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		OrganizationID: order.OrganizationID,
+		TripID:         order.TripID,
+		TotalPaisa:     order.TotalPaisa,
+	}
+	if review := order.FraudReview; review != nil {
+		payload.RiskScore = review.RiskScore
+		payload.RiskLevel = review.RiskLevel
+		payload.Summary = review.Summary
+		payload.FlaggedAt = review.FlaggedAt
+	}
+	return p.outbox.Publish(ctx, tx, kafka.TopicOrders, kafka.EventOrderReviewPending, order.ID, payload)
+}
+
+// PublishAccountInvoiceIssued publishes a corporate account's invoice within a transaction,
+// so it can be sent to the account's billing email
+func (p *Publisher) PublishAccountInvoiceIssued(ctx context.Context, tx *sql.Tx, account *domain.CorporateAccount, invoice *domain.AccountInvoice) error {
+	payload := AccountInvoiceIssuedPayload{
+		InvoiceID:      invoice.ID,
+		AccountID:      account.ID,
+		OrganizationID: account.OrganizationID,
+		AccountName:    account.Name,
+		BillingEmail:   account.BillingEmail,
+		PeriodStart:    invoice.PeriodStart,
+		PeriodEnd:      invoice.PeriodEnd,
+		TotalPaisa:     invoice.TotalPaisa,
+		Currency:       invoice.Currency,
+		LineCount:      len(invoice.Lines),
+		DueAt:          invoice.DueAt,
+	}
+	return p.outbox.Publish(ctx, tx, kafka.TopicOrders, kafka.EventAccountInvoiceIssued, invoice.ID, payload)
+}
+
+// PublishOrderPassengerTransferred publishes a ticket transfer within a transaction, so
+// fulfillment reissues the passenger's tickets to the new traveller
+func (p *Publisher) PublishOrderPassengerTransferred(ctx context.Context, tx *sql.Tx, order *domain.Order, transfer *domain.PassengerTransfer) error {
+	payload := OrderPassengerTransferredPayload{
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		OrganizationID: order.OrganizationID,
+		TripID:         order.TripID,
+		BookingID:      order.BookingID,
+		TransferID:     transfer.ID,
+		PassengerIndex: transfer.PassengerIndex,
+		OldNID:         transfer.OldNID,
+		OldName:        transfer.OldName,
+		NewNID:         transfer.NewNID,
+		NewName:        transfer.NewName,
+		SeatNumbers:    order.SeatNumbers(transfer.PassengerIndex),
+		FeePaisa:       transfer.FeePaisa,
+		PaymentID:      transfer.PaymentID,
+		ContactEmail:   order.ContactEmail,
+		ContactPhone:   order.ContactPhone,
+		TransferredAt:  transfer.TransferredAt,
+	}
+	return p.outbox.Publish(ctx, tx, kafka.TopicOrders, kafka.EventPassengerTransferred, order.ID, payload)
+}
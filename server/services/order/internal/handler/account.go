@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GrpcHandler) SaveCorporateAccount(ctx context.Context, req *pb.SaveCorporateAccountRequest) (*pb.CorporateAccount, error) {
+	if req.Account == nil {
+		return nil, status.Error(codes.InvalidArgument, "account is required")
+	}
+
+	account := accountFromProto(req.Account)
+	if err := h.orderService.SaveAccount(ctx, account, req.ActorId); err != nil {
+		return nil, accountError(err)
+	}
+	return accountToProto(account), nil
+}
+
+func (h *GrpcHandler) GetCorporateAccount(ctx context.Context, req *pb.GetCorporateAccountRequest) (*pb.CorporateAccount, error) {
+	account, err := h.orderService.GetAccount(ctx, req.OrganizationId, req.AccountId)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return accountToProto(account), nil
+}
+
+func (h *GrpcHandler) ListCorporateAccounts(ctx context.Context, req *pb.ListCorporateAccountsRequest) (*pb.ListCorporateAccountsResponse, error) {
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+
+	accounts, err := h.orderService.ListAccounts(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	resp := &pb.ListCorporateAccountsResponse{}
+	for _, a := range accounts {
+		resp.Accounts = append(resp.Accounts, accountToProto(a))
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) ListAccountInvoices(ctx context.Context, req *pb.ListAccountInvoicesRequest) (*pb.ListAccountInvoicesResponse, error) {
+	invoices, err := h.orderService.ListInvoices(ctx, req.OrganizationId, req.AccountId)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	resp := &pb.ListAccountInvoicesResponse{}
+	for _, inv := range invoices {
+		resp.Invoices = append(resp.Invoices, invoiceToProto(inv))
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) GetAccountInvoice(ctx context.Context, req *pb.GetAccountInvoiceRequest) (*pb.AccountInvoice, error) {
+	invoice, err := h.orderService.GetInvoice(ctx, req.OrganizationId, req.AccountId, req.InvoiceId)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return invoiceToProto(invoice), nil
+}
+
+func (h *GrpcHandler) MarkInvoicePaid(ctx context.Context, req *pb.MarkInvoicePaidRequest) (*pb.AccountInvoice, error) {
+	invoice, err := h.orderService.MarkInvoicePaid(ctx, req.OrganizationId, req.AccountId, req.InvoiceId, req.ActorId)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return invoiceToProto(invoice), nil
+}
+
+// accountError maps corporate account and invoice errors to gRPC status codes
+func accountError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrAccountNotFound), errors.Is(err, domain.ErrInvoiceNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidAccount):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrNotAccountBooker), errors.Is(err, domain.ErrTravellerNotApproved):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrAccountSuspended), errors.Is(err, domain.ErrCreditLimitExceeded),
+		errors.Is(err, domain.ErrInvoiceAlreadyPaid):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// createOrderError maps the errors of placing an order to gRPC status codes
+func createOrderError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrMaxTicketsExceeded):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrAccountNotFound), errors.Is(err, domain.ErrNotAccountBooker),
+		errors.Is(err, domain.ErrTravellerNotApproved), errors.Is(err, domain.ErrAccountSuspended),
+		errors.Is(err, domain.ErrCreditLimitExceeded):
+		return accountError(err)
+	default:
+		return shiftError(err)
+	}
+}
+
+func accountFromProto(a *pb.CorporateAccount) *domain.CorporateAccount {
+	account := &domain.CorporateAccount{
+		ID:               a.Id,
+		OrganizationID:   a.OrganizationId,
+		Name:             a.Name,
+		Kind:             a.Kind,
+		Status:           a.Status,
+		BillingEmail:     a.BillingEmail,
+		Currency:         a.Currency,
+		CreditLimitPaisa: a.CreditLimitPaisa,
+		PaymentTermsDays: int(a.PaymentTermsDays),
+		BookerIDs:        a.BookerIds,
+		MaxGroupSize:     int(a.MaxGroupSize),
+	}
+	for _, t := range a.Travellers {
+		account.Travellers = append(account.Travellers, domain.ApprovedTraveller{NID: t.Nid, Name: t.Name})
+	}
+	return account
+}
+
+func accountToProto(a *domain.CorporateAccount) *pb.CorporateAccount {
+	out := &pb.CorporateAccount{
+		Id:               a.ID,
+		OrganizationId:   a.OrganizationID,
+		Name:             a.Name,
+		Kind:             a.Kind,
+		Status:           a.Status,
+		BillingEmail:     a.BillingEmail,
+		Currency:         a.Currency,
+		CreditLimitPaisa: a.CreditLimitPaisa,
+		OutstandingPaisa: a.OutstandingPaisa,
+		PaymentTermsDays: int32(a.PaymentTermsDays),
+		BookerIds:        a.BookerIDs,
+		MaxGroupSize:     int32(a.MaxGroupSize),
+		CreatedAt:        unixOrZero(a.CreatedAt),
+		UpdatedAt:        unixOrZero(a.UpdatedAt),
+	}
+	for _, t := range a.Travellers {
+		out.Travellers = append(out.Travellers, &pb.ApprovedTraveller{Nid: t.NID, Name: t.Name})
+	}
+	return out
+}
+
+func invoiceToProto(inv *domain.AccountInvoice) *pb.AccountInvoice {
+	out := &pb.AccountInvoice{
+		Id:             inv.ID,
+		AccountId:      inv.AccountID,
+		OrganizationId: inv.OrganizationID,
+		PeriodStart:    unixOrZero(inv.PeriodStart),
+		PeriodEnd:      unixOrZero(inv.PeriodEnd),
+		TotalPaisa:     inv.TotalPaisa,
+		Currency:       inv.Currency,
+		Status:         inv.Status,
+		IssuedAt:       unixOrZero(inv.IssuedAt),
+		DueAt:          unixOrZero(inv.DueAt),
+		PaidAt:         unixOrZero(inv.PaidAt),
+	}
+	for _, l := range inv.Lines {
+		out.Lines = append(out.Lines, &pb.AccountCharge{
+			Id:          l.ID,
+			OrderId:     l.OrderID,
+			AmountPaisa: l.AmountPaisa,
+			Description: l.Description,
+			CreatedAt:   unixOrZero(l.CreatedAt),
+		})
+	}
+	return out
+}
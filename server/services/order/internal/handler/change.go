@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/service"
+)
+
+func (h *GrpcHandler) ChangeOrder(ctx context.Context, req *pb.ChangeOrderRequest) (*pb.ChangeOrderResponse, error) {
+	order, change, err := h.orderService.ChangeOrder(ctx, changeRequest(req))
+	if err != nil {
+		return nil, refundError(err)
+	}
+	return &pb.ChangeOrderResponse{
+		Success: true,
+		Order:   orderToProto(order),
+		Change:  orderChangeToProto(change, order.Currency),
+	}, nil
+}
+
+func (h *GrpcHandler) GetChangeQuote(ctx context.Context, req *pb.ChangeOrderRequest) (*pb.ChangeQuote, error) {
+	order, quote, err := h.orderService.QuoteChange(ctx, changeRequest(req))
+	if err != nil {
+		return nil, refundError(err)
+	}
+	return changeQuoteToProto(quote, order.Currency), nil
+}
+
+func changeRequest(req *pb.ChangeOrderRequest) *service.ChangeOrderRequest {
+	r := &service.ChangeOrderRequest{
+		OrderID:      req.OrderId,
+		UserID:       req.UserId,
+		TripID:       req.TripId,
+		SeatIDs:      req.SeatIds,
+		HoldID:       req.HoldId,
+		PaymentToken: req.PaymentToken,
+	}
+	if req.CheckExpectedAmount {
+		expected := req.ExpectedAmountDuePaisa
+		r.ExpectedAmountDue = &expected
+	}
+	return r
+}
+
+func changeQuoteToProto(q *domain.ChangeQuote, currency string) *pb.ChangeQuote {
+	if q == nil {
+		return nil
+	}
+	return &pb.ChangeQuote{
+		PolicyId:             q.PolicyID,
+		PolicyName:           q.PolicyName,
+		DepartureTime:        q.DepartureTime.Unix(),
+		HoursBeforeDeparture: q.HoursBeforeDeparture,
+		NewTripId:            q.NewTripID,
+		OldTotalPaisa:        q.OldTotalPaisa,
+		NewTotalPaisa:        q.NewTotalPaisa,
+		FareDifferencePaisa:  q.FareDifferencePaisa,
+		ChangeFeePaisa:       q.ChangeFeePaisa,
+		AmountDuePaisa:       q.AmountDuePaisa,
+		QuotedAt:             q.QuotedAt.Unix(),
+		Currency:             currency,
+	}
+}
+
+func orderChangeToProto(c *domain.OrderChange, currency string) *pb.OrderChange {
+	if c == nil {
+		return nil
+	}
+	return &pb.OrderChange{
+		OldTripId:    c.OldTripID,
+		OldBookingId: c.OldBookingID,
+		OldSeats:     bookedSeatsToProto(c.OldSeats),
+		NewTripId:    c.NewTripID,
+		NewBookingId: c.NewBookingID,
+		Quote:        changeQuoteToProto(c.Quote, currency),
+		PaymentId:    c.PaymentID,
+		RefundId:     c.RefundID,
+		RefundFailed: c.RefundFailed,
+		ChangedAt:    c.ChangedAt.Unix(),
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/saga"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GrpcHandler) ListFraudReviews(ctx context.Context, req *pb.ListFraudReviewsRequest) (*pb.ListOrdersResponse, error) {
+	orders, total, nextToken, err := h.orderService.ListFraudReviews(ctx, req.OrganizationId, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbOrders := make([]*pb.Order, 0, len(orders))
+	for _, o := range orders {
+		pbOrders = append(pbOrders, orderToProto(o))
+	}
+
+	return &pb.ListOrdersResponse{
+		Orders:        pbOrders,
+		NextPageToken: nextToken,
+		TotalCount:    int32(total),
+	}, nil
+}
+
+func (h *GrpcHandler) ReviewOrder(ctx context.Context, req *pb.ReviewOrderRequest) (*pb.Order, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id is required")
+	}
+	if req.ReviewerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "reviewer_id is required")
+	}
+
+	order, err := h.orderService.ReviewOrder(ctx, &service.ReviewOrderRequest{
+		OrderID:    req.OrderId,
+		Approve:    req.Approve,
+		ReviewerID: req.ReviewerId,
+		Note:       req.Note,
+	})
+	if err != nil {
+		return nil, reviewError(err)
+	}
+	return orderToProto(order), nil
+}
+
+// reviewError maps fraud review errors to gRPC status codes
+func reviewError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrOrderNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrNotUnderReview), errors.Is(err, saga.ErrSagaNotSuspended):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return sagaError(err)
+	}
+}
+
+func fraudReviewToProto(r *domain.FraudReview) *pb.FraudReview {
+	if r == nil {
+		return nil
+	}
+	return &pb.FraudReview{
+		RiskScore:   int32(r.RiskScore),
+		RiskLevel:   r.RiskLevel,
+		Summary:     r.Summary,
+		RiskFactors: r.RiskFactors,
+		Decision:    r.Decision,
+		ReviewerId:  r.ReviewerID,
+		Note:        r.Note,
+		FlaggedAt:   unixOrZero(r.FlaggedAt),
+		DecidedAt:   unixOrZero(r.DecidedAt),
+	}
+}
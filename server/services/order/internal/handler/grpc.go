@@ -2,9 +2,11 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
 	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/saga"
 	"github.com/MuhibNayem/Travio/server/services/order/internal/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,15 +22,14 @@ func NewGrpcHandler(orderService *service.OrderService) *GrpcHandler {
 }
 
 func (h *GrpcHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error) {
-	var passengers []service.PassengerRequest
-	for _, p := range req.Passengers {
-		passengers = append(passengers, service.PassengerRequest{
-			NID:         p.Nid,
-			Name:        p.Name,
-			SeatID:      p.SeatId,
-			DateOfBirth: p.DateOfBirth,
-			Gender:      p.Gender,
-			Age:         int(p.Age),
+	var legs []service.LegRequest
+	for _, l := range req.Legs {
+		legs = append(legs, service.LegRequest{
+			TripID:      l.TripId,
+			FromStation: l.FromStationId,
+			ToStation:   l.ToStationId,
+			HoldID:      l.HoldId,
+			Passengers:  passengerRequests(l.Passengers),
 		})
 	}
 
@@ -39,16 +40,24 @@ func (h *GrpcHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderReques
 		FromStation:    req.FromStationId,
 		ToStation:      req.ToStationId,
 		HoldID:         req.HoldId,
-		Passengers:     passengers,
+		Passengers:     passengerRequests(req.Passengers),
 		PaymentToken:   req.PaymentMethod.Token,
 		PaymentMethod:  req.PaymentMethod.Type,
 		Email:          req.ContactEmail,
 		Phone:          req.ContactPhone,
 		CouponCode:     req.CouponCode,
 		IdempotencyKey: req.IdempotencyKey,
+		Legs:           legs,
+
+		IPAddress:         req.IpAddress,
+		UserAgent:         req.UserAgent,
+		DeviceFingerprint: req.DeviceFingerprint,
+		AgentID:           req.AgentId,
+		AccountID:         req.PaymentMethod.AccountId,
+		QuotaClaims:       quotaClaims(req.QuotaClaims),
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, createOrderError(err)
 	}
 
 	return &pb.CreateOrderResponse{
@@ -83,11 +92,14 @@ func (h *GrpcHandler) ListOrders(ctx context.Context, req *pb.ListOrdersRequest)
 }
 
 func (h *GrpcHandler) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
-	order, refund, err := h.orderService.CancelOrder(ctx, req.OrderId, req.UserId, req.Reason)
+	order, refund, err := h.orderService.CancelOrder(ctx, req.OrderId, req.UserId, req.Reason, req.ExpectedRefundPaisa)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, refundError(err)
 	}
+	return cancelOrderResponse(order, refund), nil
+}
 
+func cancelOrderResponse(order *domain.Order, refund *service.RefundInfo) *pb.CancelOrderResponse {
 	resp := &pb.CancelOrderResponse{
 		Success: true,
 		Order:   orderToProto(order),
@@ -98,10 +110,11 @@ func (h *GrpcHandler) CancelOrder(ctx context.Context, req *pb.CancelOrderReques
 			RefundId:    refund.RefundID,
 			AmountPaisa: refund.AmountPaisa,
 			Status:      refund.Status,
+			Breakdown:   refundBreakdownToProto(refund.Breakdown),
 		}
 	}
 
-	return resp, nil
+	return resp
 }
 
 func (h *GrpcHandler) GetOrderStatus(ctx context.Context, req *pb.GetOrderStatusRequest) (*pb.OrderStatusResponse, error) {
@@ -127,36 +140,79 @@ func (h *GrpcHandler) RetryOrder(ctx context.Context, req *pb.RetryOrderRequest)
 }
 
 // Converters
+func passengerRequests(ps []*pb.PassengerRequest) []service.PassengerRequest {
+	var passengers []service.PassengerRequest
+	for _, p := range ps {
+		passengers = append(passengers, service.PassengerRequest{
+			NID:           p.Nid,
+			Name:          p.Name,
+			SeatID:        p.SeatId,
+			DateOfBirth:   p.DateOfBirth,
+			Gender:        p.Gender,
+			Age:           int(p.Age),
+			CapacityClass: p.CapacityClass,
+		})
+	}
+	return passengers
+}
+
+func quotaClaims(c *pb.QuotaClaims) *saga.QuotaClaims {
+	if c == nil {
+		return nil
+	}
+	return &saga.QuotaClaims{
+		Female:    c.Female,
+		Disabled:  c.Disabled,
+		Channel:   c.Channel,
+		PartnerID: c.PartnerId,
+		VIP:       c.Vip,
+	}
+}
+
 func orderToProto(o *domain.Order) *pb.Order {
 	if o == nil {
 		return nil
 	}
 
-	var passengers []*pb.Passenger
-	for _, p := range o.Passengers {
-		passengers = append(passengers, &pb.Passenger{
-			Nid:         p.NID,
-			Name:        p.Name,
-			SeatId:      p.SeatID,
-			SeatNumber:  p.SeatNumber,
-			SeatClass:   p.SeatClass,
-			Gender:      p.Gender,
-			Age:         int32(p.Age),
-			NidVerified: p.NIDVerified,
+	var legs []*pb.OrderLeg
+	for _, l := range o.Legs {
+		legs = append(legs, &pb.OrderLeg{
+			TripId:          l.TripID,
+			FromStationId:   l.FromStationID,
+			ToStationId:     l.ToStationID,
+			HoldId:          l.HoldID,
+			BookingId:       l.BookingID,
+			Passengers:      passengersToProto(l.Passengers),
+			Seats:           bookedSeatsToProto(l.Seats),
+			SubtotalPaisa:   l.SubtotalPaisa,
+			TaxPaisa:        l.TaxPaisa,
+			BookingFeePaisa: l.BookingFeePaisa,
+			DiscountPaisa:   l.DiscountPaisa,
+			TotalPaisa:      l.TotalPaisa,
 		})
 	}
 
-	var seats []*pb.BookedSeat
-	for _, s := range o.Seats {
-		seats = append(seats, &pb.BookedSeat{
-			SeatId:     s.SeatID,
-			SeatNumber: s.SeatNumber,
-			SeatClass:  s.SeatClass,
-			TicketId:   s.TicketID,
-			PricePaisa: s.PricePaisa,
+	var cancellations []*pb.PassengerCancellation
+	for _, c := range o.PassengerCancellations {
+		indexes := make([]int32, 0, len(c.PassengerIndexes))
+		for _, idx := range c.PassengerIndexes {
+			indexes = append(indexes, int32(idx))
+		}
+		cancellations = append(cancellations, &pb.PassengerCancellation{
+			PassengerIndexes: indexes,
+			Seats:            bookedSeatsToProto(c.Seats),
+			Reason:           c.Reason,
+			RefundId:         c.RefundID,
+			Refund:           refundBreakdownToProto(c.Refund),
+			CancelledAt:      c.CancelledAt.Unix(),
 		})
 	}
 
+	var changes []*pb.OrderChange
+	for i := range o.Changes {
+		changes = append(changes, orderChangeToProto(&o.Changes[i], o.Currency))
+	}
+
 	return &pb.Order{
 		Id:              o.ID,
 		OrganizationId:  o.OrganizationID,
@@ -164,7 +220,7 @@ func orderToProto(o *domain.Order) *pb.Order {
 		TripId:          o.TripID,
 		FromStationId:   o.FromStationID,
 		ToStationId:     o.ToStationID,
-		Passengers:      passengers,
+		Passengers:      passengersToProto(o.Passengers),
 		SubtotalPaisa:   o.SubtotalPaisa,
 		TaxPaisa:        o.TaxPaisa,
 		BookingFeePaisa: o.BookingFeePaisa,
@@ -174,20 +230,148 @@ func orderToProto(o *domain.Order) *pb.Order {
 		PaymentId:       o.PaymentID,
 		PaymentStatus:   mapPaymentStatus(o.PaymentStatus),
 		BookingId:       o.BookingID,
-		Seats:           seats,
+		Seats:           bookedSeatsToProto(o.Seats),
 		Status:          mapOrderStatus(o.Status),
 		ContactEmail:    o.ContactEmail,
 		ContactPhone:    o.ContactPhone,
+		RefundBreakdown: refundBreakdownToProto(o.Refund),
 		CreatedAt:       o.CreatedAt.Unix(),
 		UpdatedAt:       o.UpdatedAt.Unix(),
 		ExpiresAt:       o.ExpiresAt.Unix(),
+
+		PassengerCancellations: cancellations,
+		Legs:                   legs,
+		Changes:                changes,
+		FraudReview:            fraudReviewToProto(o.FraudReview),
+		AgentId:                o.AgentID,
+		ShiftId:                o.ShiftID,
+		AccountId:              o.AccountID,
+		Pnr:                    o.PNR,
+	}
+}
+
+func passengersToProto(ps []domain.OrderPassenger) []*pb.Passenger {
+	var passengers []*pb.Passenger
+	for _, p := range ps {
+		passengers = append(passengers, &pb.Passenger{
+			Nid:           p.NID,
+			Name:          p.Name,
+			SeatId:        p.SeatID,
+			SeatNumber:    p.SeatNumber,
+			SeatClass:     p.SeatClass,
+			Gender:        p.Gender,
+			Age:           int32(p.Age),
+			NidVerified:   p.NIDVerified,
+			CapacityClass: p.CapacityClass,
+			Cancelled:     p.Cancelled,
+		})
+	}
+	return passengers
+}
+
+func bookedSeatsToProto(seats []domain.BookedSeat) []*pb.BookedSeat {
+	var out []*pb.BookedSeat
+	for _, s := range seats {
+		out = append(out, &pb.BookedSeat{
+			SeatId:         s.SeatID,
+			SeatNumber:     s.SeatNumber,
+			SeatClass:      s.SeatClass,
+			TicketId:       s.TicketID,
+			PricePaisa:     s.PricePaisa,
+			PassengerIndex: int32(s.PassengerIndex),
+			FromStationId:  s.FromStationID,
+			ToStationId:    s.ToStationID,
+			CapacityClass:  s.CapacityClass,
+		})
 	}
+	return out
 }
 
-func sagaToProto(s interface{}) *pb.SagaState {
-	// Simplified - would need proper saga state conversion
+func sagaToProto(s *saga.Saga) *pb.SagaState {
+	if s == nil {
+		return nil
+	}
+
+	steps := make([]*pb.SagaStep, 0, len(s.Steps))
+	currentStep := ""
+	for i, step := range s.Steps {
+		if i == s.CurrentStep {
+			currentStep = step.Name
+		}
+		steps = append(steps, &pb.SagaStep{
+			Name:        step.Name,
+			Status:      mapStepStatus(step.Status),
+			Error:       step.Error,
+			StartedAt:   unixOrZero(step.StartedAt),
+			CompletedAt: unixOrZero(step.CompletedAt),
+			Compensated: step.Compensated,
+		})
+	}
+
 	return &pb.SagaState{
-		Status: pb.SagaStatus_SAGA_STATUS_RUNNING,
+		SagaId:           s.ID,
+		Name:             s.Name,
+		Status:           mapSagaStatus(s.Status),
+		CurrentStep:      currentStep,
+		CurrentStepIndex: int32(s.CurrentStep),
+		Steps:            steps,
+		FailureReason:    s.FailureReason,
+		OrganizationId:   s.Context.GetString("org_id"),
+		References:       s.References(),
+		Owner:            s.Owner,
+		StartedAt:        unixOrZero(s.StartedAt),
+		CompletedAt:      unixOrZero(s.CompletedAt),
+		LeaseUntil:       unixOrZero(s.LeaseUntil),
+		UpdatedAt:        unixOrZero(s.UpdatedAt),
+	}
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func mapSagaStatus(s saga.Status) pb.SagaStatus {
+	switch s {
+	case saga.StatusPending:
+		return pb.SagaStatus_SAGA_STATUS_PENDING
+	case saga.StatusRunning:
+		return pb.SagaStatus_SAGA_STATUS_RUNNING
+	case saga.StatusCompleted:
+		return pb.SagaStatus_SAGA_STATUS_COMPLETED
+	case saga.StatusCompensating:
+		return pb.SagaStatus_SAGA_STATUS_COMPENSATING
+	case saga.StatusCompensated:
+		return pb.SagaStatus_SAGA_STATUS_COMPENSATED
+	case saga.StatusFailed:
+		return pb.SagaStatus_SAGA_STATUS_FAILED
+	case saga.StatusResolved:
+		return pb.SagaStatus_SAGA_STATUS_RESOLVED
+	case saga.StatusSuspended:
+		return pb.SagaStatus_SAGA_STATUS_SUSPENDED
+	default:
+		return pb.SagaStatus_SAGA_STATUS_UNSPECIFIED
+	}
+}
+
+func mapStepStatus(s saga.Status) pb.StepStatus {
+	switch s {
+	case saga.StatusPending:
+		return pb.StepStatus_STEP_STATUS_PENDING
+	case saga.StatusRunning:
+		return pb.StepStatus_STEP_STATUS_RUNNING
+	case saga.StatusCompleted:
+		return pb.StepStatus_STEP_STATUS_COMPLETED
+	case saga.StatusFailed:
+		return pb.StepStatus_STEP_STATUS_FAILED
+	case saga.StatusCompensated:
+		return pb.StepStatus_STEP_STATUS_COMPENSATED
+	case saga.StatusInterrupted:
+		return pb.StepStatus_STEP_STATUS_INTERRUPTED
+	default:
+		return pb.StepStatus_STEP_STATUS_UNSPECIFIED
 	}
 }
 
@@ -201,8 +385,14 @@ func mapOrderStatus(s domain.OrderStatus) pb.OrderStatus {
 		return pb.OrderStatus_ORDER_STATUS_FAILED
 	case domain.OrderStatusCancelled:
 		return pb.OrderStatus_ORDER_STATUS_CANCELLED
+	case domain.OrderStatusExpired:
+		return pb.OrderStatus_ORDER_STATUS_EXPIRED
+	case domain.OrderStatusRefundPending:
+		return pb.OrderStatus_ORDER_STATUS_REFUND_PENDING
 	case domain.OrderStatusRefunded:
 		return pb.OrderStatus_ORDER_STATUS_REFUNDED
+	case domain.OrderStatusReviewPending:
+		return pb.OrderStatus_ORDER_STATUS_REVIEW_PENDING
 	default:
 		return pb.OrderStatus_ORDER_STATUS_UNSPECIFIED
 	}
@@ -220,6 +410,8 @@ func mapPaymentStatus(s string) pb.PaymentStatus {
 		return pb.PaymentStatus_PAYMENT_STATUS_FAILED
 	case domain.PaymentStatusRefunded:
 		return pb.PaymentStatus_PAYMENT_STATUS_REFUNDED
+	case domain.PaymentStatusPartiallyRefunded:
+		return pb.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED
 	default:
 		return pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
 	}
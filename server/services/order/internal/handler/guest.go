@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+)
+
+func (h *GrpcHandler) GetOrderByPNR(ctx context.Context, req *pb.GetOrderByPNRRequest) (*pb.Order, error) {
+	order, err := h.orderService.GetOrderByPNR(ctx, req.Pnr, req.Contact)
+	if err != nil {
+		return nil, refundError(err)
+	}
+	return orderToProto(order), nil
+}
+
+func (h *GrpcHandler) GetRefundQuoteByPNR(ctx context.Context, req *pb.GetOrderByPNRRequest) (*pb.GetRefundQuoteResponse, error) {
+	order, quote, err := h.orderService.QuoteRefundByPNR(ctx, req.Pnr, req.Contact)
+	if err != nil {
+		return nil, refundError(err)
+	}
+	return &pb.GetRefundQuoteResponse{
+		Quote:      refundBreakdownToProto(quote),
+		TotalPaisa: order.TotalPaisa,
+		Currency:   order.Currency,
+	}, nil
+}
+
+func (h *GrpcHandler) CancelOrderByPNR(ctx context.Context, req *pb.CancelOrderByPNRRequest) (*pb.CancelOrderResponse, error) {
+	order, refund, err := h.orderService.CancelOrderByPNR(ctx, req.Pnr, req.Contact, req.Reason, req.ExpectedRefundPaisa)
+	if err != nil {
+		return nil, refundError(err)
+	}
+	return cancelOrderResponse(order, refund), nil
+}
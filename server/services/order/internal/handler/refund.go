@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GrpcHandler) GetRefundQuote(ctx context.Context, req *pb.GetRefundQuoteRequest) (*pb.GetRefundQuoteResponse, error) {
+	var order *domain.Order
+	var quote *domain.RefundBreakdown
+	var err error
+	if len(req.PassengerIndexes) > 0 || len(req.SeatIds) > 0 {
+		order, quote, err = h.orderService.QuotePassengerRefund(ctx, req.OrderId, req.UserId, toInts(req.PassengerIndexes), req.SeatIds)
+	} else {
+		order, quote, err = h.orderService.QuoteRefund(ctx, req.OrderId, req.UserId)
+	}
+	if err != nil {
+		return nil, refundError(err)
+	}
+	return &pb.GetRefundQuoteResponse{
+		Quote:      refundBreakdownToProto(quote),
+		TotalPaisa: order.TotalPaisa,
+		Currency:   order.Currency,
+	}, nil
+}
+
+func (h *GrpcHandler) CancelPassengers(ctx context.Context, req *pb.CancelPassengersRequest) (*pb.CancelPassengersResponse, error) {
+	if len(req.PassengerIndexes) == 0 && len(req.SeatIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "passenger_indexes or seat_ids is required")
+	}
+	order, refund, err := h.orderService.CancelPassengers(ctx, &service.CancelPassengersRequest{
+		OrderID:          req.OrderId,
+		UserID:           req.UserId,
+		PassengerIndexes: toInts(req.PassengerIndexes),
+		SeatIDs:          req.SeatIds,
+		Reason:           req.Reason,
+		ExpectedRefund:   req.ExpectedRefundPaisa,
+	})
+	if err != nil {
+		return nil, refundError(err)
+	}
+
+	resp := &pb.CancelPassengersResponse{
+		Success: true,
+		Order:   orderToProto(order),
+	}
+	if refund != nil {
+		resp.Refund = &pb.RefundInfo{
+			RefundId:    refund.RefundID,
+			AmountPaisa: refund.AmountPaisa,
+			Status:      refund.Status,
+			Breakdown:   refundBreakdownToProto(refund.Breakdown),
+		}
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) SetRefundPolicy(ctx context.Context, req *pb.RefundPolicy) (*pb.RefundPolicy, error) {
+	policy := &domain.RefundPolicy{
+		OrganizationID:      req.OrganizationId,
+		RouteID:             req.RouteId,
+		VehicleClass:        req.VehicleClass,
+		Name:                req.Name,
+		RefundBookingFee:    req.RefundBookingFee,
+		ChangeFeePaisa:      req.ChangeFeePaisa,
+		ChangeCutoffHours:   int(req.ChangeCutoffHours),
+		TransferFeePaisa:    req.TransferFeePaisa,
+		TransferCutoffHours: int(req.TransferCutoffHours),
+	}
+	for _, t := range req.Tiers {
+		policy.Tiers = append(policy.Tiers, domain.RefundTier{
+			MinHoursBeforeDeparture: int(t.MinHoursBeforeDeparture),
+			RefundPercent:           int(t.RefundPercent),
+		})
+	}
+
+	if err := h.orderService.SaveRefundPolicy(ctx, policy); err != nil {
+		return nil, refundError(err)
+	}
+	return refundPolicyToProto(policy), nil
+}
+
+func (h *GrpcHandler) ListRefundPolicies(ctx context.Context, req *pb.ListRefundPoliciesRequest) (*pb.ListRefundPoliciesResponse, error) {
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+	policies, err := h.orderService.ListRefundPolicies(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.ListRefundPoliciesResponse{}
+	for _, p := range policies {
+		resp.Policies = append(resp.Policies, refundPolicyToProto(p))
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) DeleteRefundPolicy(ctx context.Context, req *pb.DeleteRefundPolicyRequest) (*pb.DeleteRefundPolicyResponse, error) {
+	if err := h.orderService.DeleteRefundPolicy(ctx, req.OrganizationId, req.PolicyId); err != nil {
+		return nil, refundError(err)
+	}
+	return &pb.DeleteRefundPolicyResponse{Success: true}, nil
+}
+
+// refundError maps cancellation, change and refund policy errors to gRPC status codes
+func refundError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrOrderNotFound), errors.Is(err, domain.ErrRefundPolicyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidRefundPolicy), errors.Is(err, domain.ErrInvalidPassengers),
+		errors.Is(err, domain.ErrInvalidChange), errors.Is(err, service.ErrChangePaymentRequired),
+		errors.Is(err, domain.ErrInvalidPNR), errors.Is(err, domain.ErrContactMissing):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrOrderNotCancellable), errors.Is(err, service.ErrRefundQuoteChanged),
+		errors.Is(err, service.ErrOrderNotChangeable), errors.Is(err, service.ErrChangeQuoteChanged),
+		errors.Is(err, domain.ErrChangeClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func refundBreakdownToProto(b *domain.RefundBreakdown) *pb.RefundBreakdown {
+	if b == nil {
+		return nil
+	}
+	var legs []*pb.RefundBreakdown
+	for i := range b.Legs {
+		legs = append(legs, refundBreakdownToProto(&b.Legs[i]))
+	}
+	return &pb.RefundBreakdown{
+		PolicyId:              b.PolicyID,
+		PolicyName:            b.PolicyName,
+		DepartureTime:         b.DepartureTime.Unix(),
+		HoursBeforeDeparture:  b.HoursBeforeDeparture,
+		RefundPercent:         int32(b.RefundPercent),
+		FarePaisa:             b.FarePaisa,
+		FareRefundPaisa:       b.FareRefundPaisa,
+		TaxPaisa:              b.TaxPaisa,
+		TaxRefundPaisa:        b.TaxRefundPaisa,
+		BookingFeePaisa:       b.BookingFeePaisa,
+		BookingFeeRefundPaisa: b.BookingFeeRefundPaisa,
+		RefundPaisa:           b.RefundPaisa,
+		RetainedPaisa:         b.RetainedPaisa,
+		QuotedAt:              b.QuotedAt.Unix(),
+		Legs:                  legs,
+	}
+}
+
+func refundPolicyToProto(p *domain.RefundPolicy) *pb.RefundPolicy {
+	tiers := make([]*pb.RefundTier, 0, len(p.Tiers))
+	for _, t := range p.Tiers {
+		tiers = append(tiers, &pb.RefundTier{
+			MinHoursBeforeDeparture: int32(t.MinHoursBeforeDeparture),
+			RefundPercent:           int32(t.RefundPercent),
+		})
+	}
+	return &pb.RefundPolicy{
+		Id:                  p.ID,
+		OrganizationId:      p.OrganizationID,
+		RouteId:             p.RouteID,
+		VehicleClass:        p.VehicleClass,
+		Name:                p.Name,
+		Tiers:               tiers,
+		RefundBookingFee:    p.RefundBookingFee,
+		ChangeFeePaisa:      p.ChangeFeePaisa,
+		ChangeCutoffHours:   int32(p.ChangeCutoffHours),
+		TransferFeePaisa:    p.TransferFeePaisa,
+		TransferCutoffHours: int32(p.TransferCutoffHours),
+		CreatedAt:           p.CreatedAt.Unix(),
+		UpdatedAt:           p.UpdatedAt.Unix(),
+	}
+}
+
+func toInts(values []int32) []int {
+	out := make([]int, 0, len(values))
+	for _, v := range values {
+		out = append(out, int(v))
+	}
+	return out
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/saga"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GrpcHandler) ListSagas(ctx context.Context, req *pb.ListSagasRequest) (*pb.ListSagasResponse, error) {
+	filter := saga.SagaFilter{
+		OrganizationID: req.OrganizationId,
+		MinAge:         time.Duration(req.MinAgeSeconds) * time.Second,
+	}
+	for _, st := range req.Statuses {
+		s, ok := sagaStatusFromProto(st)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown saga status %s", st)
+		}
+		filter.Statuses = append(filter.Statuses, s)
+	}
+
+	sagas, total, nextToken, err := h.orderService.ListSagas(ctx, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, sagaError(err)
+	}
+
+	pbSagas := make([]*pb.SagaState, 0, len(sagas))
+	for _, s := range sagas {
+		pbSagas = append(pbSagas, sagaToProto(s))
+	}
+
+	return &pb.ListSagasResponse{
+		Sagas:         pbSagas,
+		NextPageToken: nextToken,
+		TotalCount:    int32(total),
+	}, nil
+}
+
+func (h *GrpcHandler) GetSaga(ctx context.Context, req *pb.GetSagaRequest) (*pb.GetSagaResponse, error) {
+	if req.SagaId == "" {
+		return nil, status.Error(codes.InvalidArgument, "saga_id is required")
+	}
+
+	sagaInst, audit, err := h.orderService.GetSagaDetails(ctx, req.SagaId)
+	if err != nil {
+		return nil, sagaError(err)
+	}
+
+	entries := make([]*pb.SagaAuditEntry, 0, len(audit))
+	for _, a := range audit {
+		details := make(map[string]string, len(a.Changes))
+		for k, v := range a.Changes {
+			details[k] = fmt.Sprint(v)
+		}
+		entries = append(entries, &pb.SagaAuditEntry{
+			Action:    a.Action,
+			ActorId:   a.ActorID,
+			Details:   details,
+			CreatedAt: a.CreatedAt.Unix(),
+		})
+	}
+
+	return &pb.GetSagaResponse{
+		Saga:  sagaToProto(sagaInst),
+		Audit: entries,
+	}, nil
+}
+
+func (h *GrpcHandler) RetrySaga(ctx context.Context, req *pb.RetrySagaRequest) (*pb.SagaActionResponse, error) {
+	if err := validateSagaAction(req.SagaId, req.ActorId); err != nil {
+		return nil, err
+	}
+
+	sagaInst, err := h.orderService.RetrySaga(ctx, req.SagaId, req.FromStep, req.ActorId, req.Reason)
+	if err != nil {
+		return nil, sagaError(err)
+	}
+	return &pb.SagaActionResponse{Saga: sagaToProto(sagaInst)}, nil
+}
+
+func (h *GrpcHandler) CompensateSaga(ctx context.Context, req *pb.CompensateSagaRequest) (*pb.SagaActionResponse, error) {
+	if err := validateSagaAction(req.SagaId, req.ActorId); err != nil {
+		return nil, err
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	sagaInst, err := h.orderService.CompensateSaga(ctx, req.SagaId, req.ActorId, req.Reason)
+	if err != nil {
+		return nil, sagaError(err)
+	}
+	return &pb.SagaActionResponse{Saga: sagaToProto(sagaInst)}, nil
+}
+
+func (h *GrpcHandler) ResolveSaga(ctx context.Context, req *pb.ResolveSagaRequest) (*pb.SagaActionResponse, error) {
+	if err := validateSagaAction(req.SagaId, req.ActorId); err != nil {
+		return nil, err
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	sagaInst, err := h.orderService.ResolveSaga(ctx, req.SagaId, req.ActorId, req.Reason)
+	if err != nil {
+		return nil, sagaError(err)
+	}
+	return &pb.SagaActionResponse{Saga: sagaToProto(sagaInst)}, nil
+}
+
+func validateSagaAction(sagaID, actorID string) error {
+	if sagaID == "" {
+		return status.Error(codes.InvalidArgument, "saga_id is required")
+	}
+	if actorID == "" {
+		return status.Error(codes.InvalidArgument, "actor_id is required")
+	}
+	return nil
+}
+
+// sagaError maps saga admin errors to gRPC status codes
+func sagaError(err error) error {
+	switch {
+	case errors.Is(err, saga.ErrSagaNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, saga.ErrStepNotFound):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, saga.ErrSagaLeased):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, saga.ErrSagaActionNotAllowed),
+		errors.Is(err, saga.ErrStepsNotInEffect),
+		errors.Is(err, saga.ErrSagaNotRecoverable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, saga.ErrNoSagaStore):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func sagaStatusFromProto(s pb.SagaStatus) (saga.Status, bool) {
+	switch s {
+	case pb.SagaStatus_SAGA_STATUS_PENDING:
+		return saga.StatusPending, true
+	case pb.SagaStatus_SAGA_STATUS_RUNNING:
+		return saga.StatusRunning, true
+	case pb.SagaStatus_SAGA_STATUS_COMPLETED:
+		return saga.StatusCompleted, true
+	case pb.SagaStatus_SAGA_STATUS_COMPENSATING:
+		return saga.StatusCompensating, true
+	case pb.SagaStatus_SAGA_STATUS_COMPENSATED:
+		return saga.StatusCompensated, true
+	case pb.SagaStatus_SAGA_STATUS_FAILED:
+		return saga.StatusFailed, true
+	case pb.SagaStatus_SAGA_STATUS_RESOLVED:
+		return saga.StatusResolved, true
+	case pb.SagaStatus_SAGA_STATUS_SUSPENDED:
+		return saga.StatusSuspended, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GrpcHandler) OpenShift(ctx context.Context, req *pb.OpenShiftRequest) (*pb.AgentShift, error) {
+	if req.OrganizationId == "" || req.AgentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id and agent_id are required")
+	}
+
+	shift, err := h.orderService.OpenShift(ctx, req.OrganizationId, req.AgentId, req.OpeningFloatPaisa)
+	if err != nil {
+		return nil, shiftError(err)
+	}
+	return shiftToProto(shift), nil
+}
+
+func (h *GrpcHandler) GetCurrentShift(ctx context.Context, req *pb.GetCurrentShiftRequest) (*pb.ShiftReport, error) {
+	report, err := h.orderService.GetCurrentShift(ctx, req.OrganizationId, req.AgentId)
+	if err != nil {
+		return nil, shiftError(err)
+	}
+	return shiftReportToProto(report), nil
+}
+
+func (h *GrpcHandler) GetShiftReport(ctx context.Context, req *pb.GetShiftReportRequest) (*pb.ShiftReport, error) {
+	if req.ShiftId == "" {
+		return nil, status.Error(codes.InvalidArgument, "shift_id is required")
+	}
+
+	report, err := h.orderService.GetShiftReport(ctx, req.OrganizationId, req.ShiftId)
+	if err != nil {
+		return nil, shiftError(err)
+	}
+	return shiftReportToProto(report), nil
+}
+
+func (h *GrpcHandler) CloseShift(ctx context.Context, req *pb.CloseShiftRequest) (*pb.ShiftReport, error) {
+	report, err := h.orderService.CloseShift(ctx, &service.CloseShiftRequest{
+		OrgID:            req.OrganizationId,
+		AgentID:          req.AgentId,
+		CountedCashPaisa: req.CountedCashPaisa,
+		Note:             req.Note,
+	})
+	if err != nil {
+		return nil, shiftError(err)
+	}
+	return shiftReportToProto(report), nil
+}
+
+// shiftError maps counter sale and shift errors to gRPC status codes
+func shiftError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrShiftNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidCashAmount):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrCashNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrNoOpenShift), errors.Is(err, domain.ErrShiftAlreadyOpen):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func shiftToProto(s *domain.AgentShift) *pb.AgentShift {
+	return &pb.AgentShift{
+		Id:                s.ID,
+		OrganizationId:    s.OrganizationID,
+		AgentId:           s.AgentID,
+		Status:            s.Status,
+		OpeningFloatPaisa: s.OpeningFloatPaisa,
+		CashSalesPaisa:    s.CashSalesPaisa,
+		CashRefundsPaisa:  s.CashRefundsPaisa,
+		Currency:          s.Currency,
+		OpenedAt:          unixOrZero(s.OpenedAt),
+		CountedCashPaisa:  s.CountedCashPaisa,
+		ExpectedCashPaisa: s.ExpectedCashPaisa,
+		VariancePaisa:     s.VariancePaisa,
+		Note:              s.Note,
+		ClosedAt:          unixOrZero(s.ClosedAt),
+	}
+}
+
+func shiftReportToProto(r *domain.ShiftReport) *pb.ShiftReport {
+	return &pb.ShiftReport{
+		Shift:                shiftToProto(r.Shift),
+		ConfirmedOrders:      int32(r.Orders.ConfirmedOrders),
+		ConfirmedCashPaisa:   r.Orders.ConfirmedCashPaisa,
+		PendingOrders:        int32(r.Orders.PendingOrders),
+		UnrecordedSalesPaisa: r.UnrecordedSalesPaisa,
+	}
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/MuhibNayem/Travio/server/api/proto/order/v1"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GrpcHandler) TransferPassenger(ctx context.Context, req *pb.TransferPassengerRequest) (*pb.TransferPassengerResponse, error) {
+	r := &service.TransferPassengerRequest{
+		OrderID:        req.OrderId,
+		UserID:         req.UserId,
+		PassengerIndex: int(req.PassengerIndex),
+		NewNID:         req.NewNid,
+		NewName:        req.NewName,
+		NewDateOfBirth: req.NewDateOfBirth,
+		PaymentToken:   req.PaymentToken,
+	}
+	if req.CheckExpectedFee {
+		expected := req.ExpectedFeePaisa
+		r.ExpectedFee = &expected
+	}
+	order, transfer, err := h.orderService.TransferPassenger(ctx, r)
+	if err != nil {
+		return nil, transferError(err)
+	}
+	return &pb.TransferPassengerResponse{
+		Success:  true,
+		Order:    orderToProto(order),
+		Transfer: transferToProto(transfer),
+	}, nil
+}
+
+func (h *GrpcHandler) GetTransferQuote(ctx context.Context, req *pb.GetTransferQuoteRequest) (*pb.TransferQuote, error) {
+	order, quote, err := h.orderService.QuoteTransfer(ctx, req.OrderId, req.UserId, int(req.PassengerIndex))
+	if err != nil {
+		return nil, transferError(err)
+	}
+	return &pb.TransferQuote{
+		PolicyId:             quote.PolicyID,
+		PolicyName:           quote.PolicyName,
+		DepartureTime:        quote.DepartureTime.Unix(),
+		HoursBeforeDeparture: quote.HoursBeforeDeparture,
+		FeePaisa:             quote.FeePaisa,
+		QuotedAt:             quote.QuotedAt.Unix(),
+		Currency:             order.Currency,
+	}, nil
+}
+
+func (h *GrpcHandler) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	transfers, total, err := h.orderService.ListTransfers(ctx, domain.TransferFilter{
+		OrganizationID: req.OrganizationId,
+		OrderID:        req.OrderId,
+		UserID:         req.UserId,
+		NID:            req.Nid,
+		Limit:          int(req.Limit),
+		Offset:         int(req.Offset),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.ListTransfersResponse{Total: int32(total)}
+	for _, t := range transfers {
+		resp.Transfers = append(resp.Transfers, transferToProto(t))
+	}
+	return resp, nil
+}
+
+func transferToProto(t *domain.PassengerTransfer) *pb.PassengerTransfer {
+	if t == nil {
+		return nil
+	}
+	return &pb.PassengerTransfer{
+		Id:             t.ID,
+		OrderId:        t.OrderID,
+		OrganizationId: t.OrganizationID,
+		UserId:         t.UserID,
+		TripId:         t.TripID,
+		PassengerIndex: int32(t.PassengerIndex),
+		OldNid:         t.OldNID,
+		OldName:        t.OldName,
+		NewNid:         t.NewNID,
+		NewName:        t.NewName,
+		FeePaisa:       t.FeePaisa,
+		PaymentId:      t.PaymentID,
+		TransferredAt:  t.TransferredAt.Unix(),
+	}
+}
+
+func transferError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidTransfer), errors.Is(err, domain.ErrInvalidNID),
+		errors.Is(err, service.ErrTransferPaymentRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDuplicateNID):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrOrderNotTransferable), errors.Is(err, service.ErrTransferQuoteChanged),
+		errors.Is(err, domain.ErrTransferClosed), errors.Is(err, domain.ErrTransferNIDNotVerified):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return refundError(err)
+	}
+}
@@ -41,6 +41,25 @@ type DLQMessage struct {
 	Timestamp     time.Time   `json:"timestamp"`
 }
 
+// SagaFailure is the payload of a saga whose compensation failed. It names what the saga
+// touched (order, payment, booking, ...) and where each step stands, so staff can finish
+// the compensation by hand or through the saga admin API.
+type SagaFailure struct {
+	SagaName       string            `json:"saga_name"`
+	OrganizationID string            `json:"organization_id,omitempty"`
+	StepIndex      int               `json:"step_index"`
+	FailureReason  string            `json:"saga_failure_reason,omitempty"`
+	References     map[string]string `json:"references"`
+	Steps          []SagaStepState   `json:"steps"`
+}
+
+type SagaStepState struct {
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	Error       string `json:"error,omitempty"`
+	Compensated bool   `json:"compensated"`
+}
+
 func (p *KafkaDLQProducer) PublishError(sagaID, stepName, failureReason string, payload interface{}) error {
 	msg := DLQMessage{
 		SagaID:        sagaID,
@@ -0,0 +1,402 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/google/uuid"
+)
+
+type AccountRepository struct {
+	DB *sql.DB
+}
+
+func NewAccountRepository(db *sql.DB) *AccountRepository {
+	return &AccountRepository{DB: db}
+}
+
+const accountColumns = `id, organization_id, name, kind, status, billing_email, currency, credit_limit_paisa, outstanding_paisa,
+	payment_terms_days, booker_ids, travellers, max_group_size, created_at, updated_at`
+
+const invoiceColumns = `id, account_id, organization_id, period_start, period_end, total_paisa, currency, status, issued_at, due_at, paid_at`
+
+// Save creates the account, or updates its settings when it has an ID. The outstanding
+// balance is left to charges, refunds and payments.
+func (r *AccountRepository) Save(ctx context.Context, account *domain.CorporateAccount) error {
+	now := time.Now()
+	bookersJSON, _ := json.Marshal(account.BookerIDs)
+	travellersJSON, _ := json.Marshal(account.Travellers)
+
+	if account.ID == "" {
+		account.ID = uuid.New().String()
+		account.CreatedAt = now
+		account.UpdatedAt = now
+		query := `INSERT INTO corporate_accounts (
+			id, organization_id, name, kind, status, billing_email, currency, credit_limit_paisa,
+			payment_terms_days, booker_ids, travellers, max_group_size, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $13)`
+		_, err := r.DB.ExecContext(ctx, query,
+			account.ID, account.OrganizationID, account.Name, account.Kind, account.Status, account.BillingEmail, account.Currency,
+			account.CreditLimitPaisa, account.PaymentTermsDays, bookersJSON, travellersJSON, account.MaxGroupSize, now,
+		)
+		return err
+	}
+
+	account.UpdatedAt = now
+	query := `UPDATE corporate_accounts SET
+		name = $1, kind = $2, status = $3, billing_email = $4, credit_limit_paisa = $5,
+		payment_terms_days = $6, booker_ids = $7, travellers = $8, max_group_size = $9, updated_at = $10
+		WHERE id = $11 AND organization_id = $12
+		RETURNING currency, outstanding_paisa, created_at`
+	err := r.DB.QueryRowContext(ctx, query,
+		account.Name, account.Kind, account.Status, account.BillingEmail, account.CreditLimitPaisa,
+		account.PaymentTermsDays, bookersJSON, travellersJSON, account.MaxGroupSize, now,
+		account.ID, account.OrganizationID,
+	).Scan(&account.Currency, &account.OutstandingPaisa, &account.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrAccountNotFound
+	}
+	return err
+}
+
+// Get loads one of an organization's accounts
+func (r *AccountRepository) Get(ctx context.Context, orgID, id string) (*domain.CorporateAccount, error) {
+	row := r.DB.QueryRowContext(ctx, `SELECT `+accountColumns+` FROM corporate_accounts WHERE id = $1 AND organization_id = $2`, id, orgID)
+	account, err := scanAccount(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrAccountNotFound
+	}
+	return account, err
+}
+
+// getByID loads an account whatever its organization
+func (r *AccountRepository) getByID(ctx context.Context, id string) (*domain.CorporateAccount, error) {
+	row := r.DB.QueryRowContext(ctx, `SELECT `+accountColumns+` FROM corporate_accounts WHERE id = $1`, id)
+	account, err := scanAccount(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrAccountNotFound
+	}
+	return account, err
+}
+
+// List returns an organization's accounts by name
+func (r *AccountRepository) List(ctx context.Context, orgID string) ([]*domain.CorporateAccount, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT `+accountColumns+` FROM corporate_accounts WHERE organization_id = $1 ORDER BY name`, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []*domain.CorporateAccount
+	for rows.Next() {
+		account, err := scanAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, rows.Err()
+}
+
+func scanAccount(row interface{ Scan(...interface{}) error }) (*domain.CorporateAccount, error) {
+	var a domain.CorporateAccount
+	var bookersJSON, travellersJSON []byte
+	if err := row.Scan(&a.ID, &a.OrganizationID, &a.Name, &a.Kind, &a.Status, &a.BillingEmail, &a.Currency,
+		&a.CreditLimitPaisa, &a.OutstandingPaisa, &a.PaymentTermsDays, &bookersJSON, &travellersJSON,
+		&a.MaxGroupSize, &a.CreatedAt, &a.UpdatedAt); err != nil {
+		return nil, err
+	}
+	json.Unmarshal(bookersJSON, &a.BookerIDs)
+	json.Unmarshal(travellersJSON, &a.Travellers)
+	return &a, nil
+}
+
+// ReserveCredit charges an order to an account, if the account is active and the charge
+// fits within its available credit. An order already charged is not charged again.
+func (r *AccountRepository) ReserveCredit(ctx context.Context, accountID, orderID string, amountPaisa int64) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `INSERT INTO account_charges (id, account_id, order_id, reference, amount_paisa, description, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (reference) DO NOTHING`,
+		uuid.New().String(), accountID, orderID, chargeReference(orderID), amountPaisa, "Order "+orderID, time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil
+	}
+
+	res, err = tx.ExecContext(ctx, `UPDATE corporate_accounts SET outstanding_paisa = outstanding_paisa + $1, updated_at = $2
+		WHERE id = $3 AND status = $4 AND outstanding_paisa + $1 <= credit_limit_paisa`,
+		amountPaisa, time.Now(), accountID, domain.AccountStatusActive,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		account, err := r.getByID(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if account.Status != domain.AccountStatusActive {
+			return domain.ErrAccountSuspended
+		}
+		return domain.ErrCreditLimitExceeded
+	}
+	return tx.Commit()
+}
+
+// ReleaseCredit drops an order's charge and gives its credit back, unless the charge has
+// been invoiced already. Releasing an order that was not charged does nothing.
+func (r *AccountRepository) ReleaseCredit(ctx context.Context, accountID, orderID string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var amount int64
+	err = tx.QueryRowContext(ctx, `DELETE FROM account_charges WHERE reference = $1 AND account_id = $2 AND invoice_id IS NULL
+		RETURNING amount_paisa`, chargeReference(orderID), accountID,
+	).Scan(&amount)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE corporate_accounts SET outstanding_paisa = outstanding_paisa - $1, updated_at = $2 WHERE id = $3`,
+		amount, time.Now(), accountID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ListAccountsToInvoice returns the accounts with charges or refunds made before the end
+// of a billing period that are not on an invoice yet
+func (r *AccountRepository) ListAccountsToInvoice(ctx context.Context, periodEnd time.Time) ([]*domain.CorporateAccount, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT DISTINCT account_id FROM account_charges WHERE invoice_id IS NULL AND created_at < $1`, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*domain.CorporateAccount, 0, len(ids))
+	for _, id := range ids {
+		account, err := r.getByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+// ListInvoices returns an account's invoices, newest first, without their lines
+func (r *AccountRepository) ListInvoices(ctx context.Context, orgID, accountID string) ([]*domain.AccountInvoice, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT `+invoiceColumns+` FROM account_invoices
+		WHERE account_id = $1 AND organization_id = $2 ORDER BY period_end DESC`, accountID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invoices []*domain.AccountInvoice
+	for rows.Next() {
+		invoice, err := scanInvoice(rows)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, invoice)
+	}
+	return invoices, rows.Err()
+}
+
+// GetInvoice loads one of an organization's invoices with its lines
+func (r *AccountRepository) GetInvoice(ctx context.Context, orgID, id string) (*domain.AccountInvoice, error) {
+	row := r.DB.QueryRowContext(ctx, `SELECT `+invoiceColumns+` FROM account_invoices WHERE id = $1 AND organization_id = $2`, id, orgID)
+	invoice, err := scanInvoice(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrInvoiceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, `SELECT id, account_id, order_id, amount_paisa, description, created_at
+		FROM account_charges WHERE invoice_id = $1 ORDER BY created_at`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		line := domain.AccountCharge{InvoiceID: id}
+		if err := rows.Scan(&line.ID, &line.AccountID, &line.OrderID, &line.AmountPaisa, &line.Description, &line.CreatedAt); err != nil {
+			return nil, err
+		}
+		invoice.Lines = append(invoice.Lines, line)
+	}
+	return invoice, rows.Err()
+}
+
+// MarkInvoicePaid records an invoice's payment and gives its total back to the account's credit
+func (r *AccountRepository) MarkInvoicePaid(ctx context.Context, orgID, id string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var accountID string
+	var total int64
+	err = tx.QueryRowContext(ctx, `UPDATE account_invoices SET status = $1, paid_at = $2
+		WHERE id = $3 AND organization_id = $4 AND status = $5
+		RETURNING account_id, total_paisa`,
+		domain.InvoiceStatusPaid, time.Now(), id, orgID, domain.InvoiceStatusIssued,
+	).Scan(&accountID, &total)
+	if errors.Is(err, sql.ErrNoRows) {
+		if _, getErr := r.GetInvoice(ctx, orgID, id); getErr != nil {
+			return getErr
+		}
+		return domain.ErrInvoiceAlreadyPaid
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE corporate_accounts SET outstanding_paisa = outstanding_paisa - $1, updated_at = $2 WHERE id = $3`,
+		total, time.Now(), accountID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func scanInvoice(row interface{ Scan(...interface{}) error }) (*domain.AccountInvoice, error) {
+	var inv domain.AccountInvoice
+	var paidAt sql.NullTime
+	if err := row.Scan(&inv.ID, &inv.AccountID, &inv.OrganizationID, &inv.PeriodStart, &inv.PeriodEnd, &inv.TotalPaisa,
+		&inv.Currency, &inv.Status, &inv.IssuedAt, &inv.DueAt, &paidAt); err != nil {
+		return nil, err
+	}
+	if paidAt.Valid {
+		inv.PaidAt = paidAt.Time
+	}
+	return &inv, nil
+}
+
+// chargeReference identifies an order's charge in the ledger
+func chargeReference(orderID string) string {
+	return "order:" + orderID
+}
+
+// IssueInvoiceTx bills an account's charges and refunds made before the end of a billing
+// period on one invoice, within a transaction. It returns nil when the period is already
+// invoiced or there is nothing to bill.
+func (r *TxOrderRepository) IssueInvoiceTx(ctx context.Context, account *domain.CorporateAccount, periodEnd time.Time) (*domain.AccountInvoice, error) {
+	now := time.Now()
+	invoice := &domain.AccountInvoice{
+		ID:             uuid.New().String(),
+		AccountID:      account.ID,
+		OrganizationID: account.OrganizationID,
+		PeriodStart:    account.CreatedAt,
+		PeriodEnd:      periodEnd,
+		Currency:       account.Currency,
+		Status:         domain.InvoiceStatusIssued,
+		IssuedAt:       now,
+		DueAt:          now.AddDate(0, 0, account.PaymentTermsDays),
+	}
+
+	// The period starts where the account's last invoice ended
+	var lastEnd sql.NullTime
+	if err := r.tx.QueryRowContext(ctx, `SELECT MAX(period_end) FROM account_invoices WHERE account_id = $1`, account.ID).Scan(&lastEnd); err != nil {
+		return nil, err
+	}
+	if lastEnd.Valid {
+		invoice.PeriodStart = lastEnd.Time
+	}
+
+	res, err := r.tx.ExecContext(ctx, `INSERT INTO account_invoices (`+invoiceColumns+`)
+		VALUES ($1, $2, $3, $4, $5, 0, $6, $7, $8, $9, NULL)
+		ON CONFLICT (account_id, period_end) DO NOTHING`,
+		invoice.ID, invoice.AccountID, invoice.OrganizationID, invoice.PeriodStart, invoice.PeriodEnd,
+		invoice.Currency, invoice.Status, invoice.IssuedAt, invoice.DueAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.tx.QueryContext(ctx, `UPDATE account_charges SET invoice_id = $1
+		WHERE account_id = $2 AND invoice_id IS NULL AND created_at < $3
+		RETURNING id, order_id, amount_paisa, description, created_at`,
+		invoice.ID, account.ID, periodEnd,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		line := domain.AccountCharge{AccountID: account.ID, InvoiceID: invoice.ID}
+		if err := rows.Scan(&line.ID, &line.OrderID, &line.AmountPaisa, &line.Description, &line.CreatedAt); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		invoice.Lines = append(invoice.Lines, line)
+		invoice.TotalPaisa += line.AmountPaisa
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(invoice.Lines) == 0 {
+		return nil, nil
+	}
+
+	if _, err := r.tx.ExecContext(ctx, `UPDATE account_invoices SET total_paisa = $1 WHERE id = $2`, invoice.TotalPaisa, invoice.ID); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// AddAccountRefundTx gives a refund of an account's order back to its credit within a
+// transaction, to be billed on its next invoice. The reference makes a refund recorded
+// twice count once.
+func (r *TxOrderRepository) AddAccountRefundTx(ctx context.Context, accountID, orderID, reference string, amountPaisa int64) error {
+	res, err := r.tx.ExecContext(ctx, `INSERT INTO account_charges (id, account_id, order_id, reference, amount_paisa, description, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (reference) DO NOTHING`,
+		uuid.New().String(), accountID, orderID, "refund:"+reference, -amountPaisa, "Refund of order "+orderID, time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil
+	}
+
+	_, err = r.tx.ExecContext(ctx, `UPDATE corporate_accounts SET outstanding_paisa = outstanding_paisa - $1, updated_at = $2 WHERE id = $3`,
+		amountPaisa, time.Now(), accountID)
+	return err
+}
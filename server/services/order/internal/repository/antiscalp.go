@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -86,7 +87,7 @@ func (c *TicketLimitChecker) CheckAndIncrement(ctx context.Context, tripID, user
 	// 24 hour TTL for trip-specific limits
 	ttl := 24 * 3600
 
-	result, err := script.Run(ctx, c.client,
+	_, err := script.Run(ctx, c.client,
 		[]string{userKey, ipKey, nidKey, hourlyKey},
 		quantity,
 		limits.MaxTicketsPerUser,
@@ -97,14 +98,12 @@ func (c *TicketLimitChecker) CheckAndIncrement(ctx context.Context, tripID, user
 	).Result()
 
 	if err != nil {
-		return fmt.Errorf("limit check failed: %w", err)
-	}
-
-	// Parse result
-	if resultMap, ok := result.(map[interface{}]interface{}); ok {
-		if errType, hasErr := resultMap["err"]; hasErr {
-			return fmt.Errorf("%w: %s", ErrLimitExceeded, errType)
+		// The script reports a limit as an error reply naming it
+		var replyErr redis.Error
+		if errors.As(err, &replyErr) && strings.HasSuffix(replyErr.Error(), "_limit") {
+			return fmt.Errorf("%w: %s", ErrLimitExceeded, replyErr.Error())
 		}
+		return fmt.Errorf("limit check failed: %w", err)
 	}
 
 	return nil
@@ -141,19 +140,66 @@ func (c *TicketLimitChecker) GetCurrentCounts(ctx context.Context, tripID, userI
 	return counts, nil
 }
 
-// ReleaseTickets decrements counters (for order cancellation)
+// ReleaseTickets decrements counters (for order cancellation or expiry).
+// Counters of an empty IP or NID are left alone, and no counter drops below zero.
 func (c *TicketLimitChecker) ReleaseTickets(ctx context.Context, tripID, userID, ipAddress, nid string, quantity int) error {
-	userKey := fmt.Sprintf("ticketlimit:%s:user:%s", tripID, userID)
-	ipKey := fmt.Sprintf("ticketlimit:%s:ip:%s", tripID, ipAddress)
-	nidKey := fmt.Sprintf("ticketlimit:%s:nid:%s", tripID, nid)
+	keys := []string{fmt.Sprintf("ticketlimit:%s:user:%s", tripID, userID)}
+	if ipAddress != "" {
+		keys = append(keys, fmt.Sprintf("ticketlimit:%s:ip:%s", tripID, ipAddress))
+	}
+	if nid != "" {
+		keys = append(keys, fmt.Sprintf("ticketlimit:%s:nid:%s", tripID, nid))
+	}
 
-	pipe := c.client.Pipeline()
-	pipe.DecrBy(ctx, userKey, int64(quantity))
-	pipe.DecrBy(ctx, ipKey, int64(quantity))
-	pipe.DecrBy(ctx, nidKey, int64(quantity))
-	_, err := pipe.Exec(ctx)
+	script := redis.NewScript(`
+		local quantity = tonumber(ARGV[1])
+		for _, key in ipairs(KEYS) do
+			local count = tonumber(redis.call('GET', key) or 0)
+			if count > 0 then
+				redis.call('DECRBY', key, math.min(count, quantity))
+			end
+		end
+		return 1
+	`)
 
-	return err
+	return script.Run(ctx, c.client, keys, quantity).Err()
+}
+
+// MoveNIDTicket moves one ticket of a trip from one NID's counter to another's, for a
+// ticket transfer. The move fails with ErrLimitExceeded if the new NID already holds
+// maxPerNID tickets on the trip; the user's and IP counters are not touched.
+func (c *TicketLimitChecker) MoveNIDTicket(ctx context.Context, tripID, fromNID, toNID string, maxPerNID int) error {
+	fromKey := fmt.Sprintf("ticketlimit:%s:nid:%s", tripID, fromNID)
+	toKey := fmt.Sprintf("ticketlimit:%s:nid:%s", tripID, toNID)
+
+	script := redis.NewScript(`
+		local maxNID = tonumber(ARGV[1])
+		local ttl = tonumber(ARGV[2])
+
+		local toCount = tonumber(redis.call('GET', KEYS[2]) or 0)
+		if toCount + 1 > maxNID then
+			return {err = "nid_limit"}
+		end
+
+		redis.call('INCR', KEYS[2])
+		redis.call('EXPIRE', KEYS[2], ttl)
+
+		local fromCount = tonumber(redis.call('GET', KEYS[1]) or 0)
+		if fromCount > 0 then
+			redis.call('DECR', KEYS[1])
+		end
+		return 1
+	`)
+
+	err := script.Run(ctx, c.client, []string{fromKey, toKey}, maxPerNID, 24*3600).Err()
+	if err != nil {
+		var replyErr redis.Error
+		if errors.As(err, &replyErr) && strings.HasSuffix(replyErr.Error(), "_limit") {
+			return fmt.Errorf("%w: %s", ErrLimitExceeded, replyErr.Error())
+		}
+		return fmt.Errorf("limit check failed: %w", err)
+	}
+	return nil
 }
 
 // --- NID Deduplication ---
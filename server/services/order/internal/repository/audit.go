@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditLog records an action staff took on an entity, e.g. forcing a saga to retry
+type AuditLog struct {
+	ID         string
+	EntityType string
+	EntityID   string
+	Action     string
+	ActorID    string
+	Changes    map[string]interface{}
+	CreatedAt  time.Time
+}
+
+type AuditRepository struct {
+	DB *sql.DB
+}
+
+func NewAuditRepository(db *sql.DB) *AuditRepository {
+	return &AuditRepository{DB: db}
+}
+
+func (r *AuditRepository) Log(ctx context.Context, log AuditLog) error {
+	log.ID = uuid.New().String()
+	log.CreatedAt = time.Now()
+
+	changesJSON, _ := json.Marshal(log.Changes)
+
+	query := `INSERT INTO audit_logs (id, entity_type, entity_id, action, actor_id, changes, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.DB.ExecContext(ctx, query,
+		log.ID, log.EntityType, log.EntityID, log.Action, log.ActorID, changesJSON, log.CreatedAt)
+	return err
+}
+
+// ListByEntity returns the audit trail of one entity, oldest first
+func (r *AuditRepository) ListByEntity(ctx context.Context, entityType, entityID string) ([]AuditLog, error) {
+	query := `SELECT id, entity_type, entity_id, action, actor_id, changes, created_at
+			  FROM audit_logs WHERE entity_type = $1 AND entity_id = $2 ORDER BY created_at`
+
+	rows, err := r.DB.QueryContext(ctx, query, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var logs []AuditLog
+	for rows.Next() {
+		var log AuditLog
+		var changesJSON []byte
+		if err := rows.Scan(&log.ID, &log.EntityType, &log.EntityID, &log.Action, &log.ActorID, &changesJSON, &log.CreatedAt); err != nil {
+			return nil, err
+		}
+		if len(changesJSON) > 0 {
+			json.Unmarshal(changesJSON, &log.Changes)
+		}
+		logs = append(logs, log)
+	}
+	return logs, rows.Err()
+}
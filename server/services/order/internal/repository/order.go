@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
@@ -36,35 +37,69 @@ func (r *OrderRepository) Create(ctx context.Context, order *domain.Order) error
 		id, organization_id, user_id, trip_id, route_id, from_station_id, to_station_id,
 		passengers, subtotal_paisa, tax_paisa, booking_fee_paisa, discount_paisa, total_paisa, currency,
 		payment_id, payment_status, payment_method, booking_id, hold_id, seats,
-		status, saga_id, contact_email, contact_phone, created_at, updated_at, expires_at, idempotency_key
-	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28)`
+		status, saga_id, contact_email, contact_phone, created_at, updated_at, expires_at, idempotency_key,
+		legs, agent_id, shift_id, account_id, pnr, client_ip
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34)`
 
 	_, err := r.DB.ExecContext(ctx, query,
 		order.ID, order.OrganizationID, order.UserID, order.TripID, order.RouteID, order.FromStationID, order.ToStationID,
 		passengersJSON, order.SubtotalPaisa, order.TaxPaisa, order.BookingFeePaisa, order.DiscountPaisa, order.TotalPaisa, order.Currency,
 		order.PaymentID, order.PaymentStatus, order.PaymentMethod, order.BookingID, order.HoldID, seatsJSON,
 		order.Status, order.SagaID, order.ContactEmail, order.ContactPhone, order.CreatedAt, order.UpdatedAt, order.ExpiresAt, order.IdempotencyKey,
+		legsJSON(order.Legs), order.AgentID, order.ShiftID, order.AccountID, order.PNR, order.ClientIP,
 	)
 
 	return err
 }
 
 func (r *OrderRepository) GetByID(ctx context.Context, id, userID string) (*domain.Order, error) {
+	return r.get(ctx, "id = $1 AND user_id = $2", id, userID)
+}
+
+// GetByIDForStaff loads an order whoever placed it, for staff such as fraud analysts.
+// Callers must check the staff member's access themselves.
+func (r *OrderRepository) GetByIDForStaff(ctx context.Context, id string) (*domain.Order, error) {
+	return r.get(ctx, "id = $1", id)
+}
+
+// PassengerNIDs returns the NIDs of an order's passengers, one list per leg in order,
+// for booking sagas rebuilt from a store that does not keep them
+func (r *OrderRepository) PassengerNIDs(ctx context.Context, orderID string) ([][]string, error) {
+	order, err := r.GetByIDForStaff(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	legs := order.Legs
+	if !order.IsMultiLeg() {
+		legs = []domain.OrderLeg{{Passengers: order.Passengers}}
+	}
+	nids := make([][]string, len(legs))
+	for i, leg := range legs {
+		for _, p := range leg.Passengers {
+			nids[i] = append(nids[i], p.NID)
+		}
+	}
+	return nids, nil
+}
+
+func (r *OrderRepository) get(ctx context.Context, where string, args ...interface{}) (*domain.Order, error) {
 	query := `SELECT 
 		id, organization_id, user_id, trip_id, route_id, from_station_id, to_station_id,
 		passengers, subtotal_paisa, tax_paisa, booking_fee_paisa, discount_paisa, total_paisa, currency,
 		payment_id, payment_status, payment_method, booking_id, hold_id, seats,
-		status, saga_id, contact_email, contact_phone, created_at, updated_at, expires_at, idempotency_key
-		FROM orders WHERE id = $1 AND user_id = $2`
+		status, saga_id, contact_email, contact_phone, created_at, updated_at, expires_at, idempotency_key,
+		refund_breakdown, passenger_cancellations, legs, changes, fraud_review, agent_id, shift_id, account_id, pnr, client_ip
+		FROM orders WHERE ` + where
 
 	var order domain.Order
-	var passengersJSON, seatsJSON []byte
+	var passengersJSON, seatsJSON, refundJSON, cancellationsJSON, legsData, changesData, reviewData []byte
 
-	err := r.DB.QueryRowContext(ctx, query, id, userID).Scan(
+	err := r.DB.QueryRowContext(ctx, query, args...).Scan(
 		&order.ID, &order.OrganizationID, &order.UserID, &order.TripID, &order.RouteID, &order.FromStationID, &order.ToStationID,
 		&passengersJSON, &order.SubtotalPaisa, &order.TaxPaisa, &order.BookingFeePaisa, &order.DiscountPaisa, &order.TotalPaisa, &order.Currency,
 		&order.PaymentID, &order.PaymentStatus, &order.PaymentMethod, &order.BookingID, &order.HoldID, &seatsJSON,
 		&order.Status, &order.SagaID, &order.ContactEmail, &order.ContactPhone, &order.CreatedAt, &order.UpdatedAt, &order.ExpiresAt, &order.IdempotencyKey,
+		&refundJSON, &cancellationsJSON, &legsData, &changesData, &reviewData, &order.AgentID, &order.ShiftID, &order.AccountID, &order.PNR, &order.ClientIP,
 	)
 
 	if err != nil {
@@ -76,10 +111,38 @@ func (r *OrderRepository) GetByID(ctx context.Context, id, userID string) (*doma
 
 	json.Unmarshal(passengersJSON, &order.Passengers)
 	json.Unmarshal(seatsJSON, &order.Seats)
+	if len(refundJSON) > 0 {
+		json.Unmarshal(refundJSON, &order.Refund)
+	}
+	if len(cancellationsJSON) > 0 {
+		json.Unmarshal(cancellationsJSON, &order.PassengerCancellations)
+	}
+	if len(legsData) > 0 {
+		json.Unmarshal(legsData, &order.Legs)
+	}
+	if len(changesData) > 0 {
+		json.Unmarshal(changesData, &order.Changes)
+	}
+	if len(reviewData) > 0 {
+		json.Unmarshal(reviewData, &order.FraudReview)
+	}
 
 	return &order, nil
 }
 
+// GetByPNR loads an order by its booking reference, whoever placed it. Callers must
+// check the customer's contact details themselves.
+func (r *OrderRepository) GetByPNR(ctx context.Context, pnr string) (*domain.Order, error) {
+	return r.get(ctx, "pnr = $1", pnr)
+}
+
+// PNRExists reports whether an order already uses the booking reference
+func (r *OrderRepository) PNRExists(ctx context.Context, pnr string) (bool, error) {
+	var exists bool
+	err := r.DB.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM orders WHERE pnr = $1)`, pnr).Scan(&exists)
+	return exists, err
+}
+
 func (r *OrderRepository) GetByIdempotencyKey(ctx context.Context, key string) (*domain.Order, error) {
 	query := `SELECT id FROM orders WHERE idempotency_key = $1`
 
@@ -101,15 +164,24 @@ func (r *OrderRepository) Update(ctx context.Context, order *domain.Order) error
 
 	passengersJSON, _ := json.Marshal(order.Passengers)
 	seatsJSON, _ := json.Marshal(order.Seats)
+	refundData := refundJSON(order.Refund)
+	cancellationsJSON, _ := json.Marshal(order.PassengerCancellations)
+	changesJSON, _ := json.Marshal(order.Changes)
 
 	query := `UPDATE orders SET
 		passengers = $1, payment_id = $2, payment_status = $3, booking_id = $4, seats = $5,
-		status = $6, saga_id = $7, updated_at = $8
+		status = $6, saga_id = $7, updated_at = $8, refund_breakdown = $10,
+		subtotal_paisa = $11, tax_paisa = $12, booking_fee_paisa = $13, discount_paisa = $14, total_paisa = $15,
+		passenger_cancellations = $16, legs = $17,
+		trip_id = $18, route_id = $19, hold_id = $20, changes = $21, fraud_review = $22
 		WHERE id = $9`
 
 	_, err := r.DB.ExecContext(ctx, query,
 		passengersJSON, order.PaymentID, order.PaymentStatus, order.BookingID, seatsJSON,
-		order.Status, order.SagaID, order.UpdatedAt, order.ID,
+		order.Status, order.SagaID, order.UpdatedAt, order.ID, refundData,
+		order.SubtotalPaisa, order.TaxPaisa, order.BookingFeePaisa, order.DiscountPaisa, order.TotalPaisa,
+		cancellationsJSON, legsJSON(order.Legs),
+		order.TripID, order.RouteID, order.HoldID, changesJSON, fraudReviewJSON(order.FraudReview),
 	)
 
 	return err
@@ -141,7 +213,8 @@ func (r *OrderRepository) ListByUser(ctx context.Context, userID string, status
 		id, organization_id, user_id, trip_id, route_id, from_station_id, to_station_id,
 		passengers, subtotal_paisa, tax_paisa, booking_fee_paisa, discount_paisa, total_paisa, currency,
 		payment_id, payment_status, payment_method, booking_id, hold_id, seats,
-		status, saga_id, contact_email, contact_phone, created_at, updated_at, expires_at
+		status, saga_id, contact_email, contact_phone, created_at, updated_at, expires_at, refund_breakdown,
+		passenger_cancellations, legs, changes, fraud_review, agent_id, shift_id, account_id, pnr, client_ip
 		FROM orders ` + whereClause + ` ORDER BY created_at DESC LIMIT $` +
 		string(rune('0'+len(args)+1)) + ` OFFSET $` + string(rune('0'+len(args)+2))
 
@@ -156,21 +229,145 @@ func (r *OrderRepository) ListByUser(ctx context.Context, userID string, status
 	var orders []*domain.Order
 	for rows.Next() {
 		var o domain.Order
-		var passengersJSON, seatsJSON []byte
+		var passengersJSON, seatsJSON, refundJSON, cancellationsJSON, legsData, changesData, reviewData []byte
 
 		if err := rows.Scan(
 			&o.ID, &o.OrganizationID, &o.UserID, &o.TripID, &o.RouteID, &o.FromStationID, &o.ToStationID,
 			&passengersJSON, &o.SubtotalPaisa, &o.TaxPaisa, &o.BookingFeePaisa, &o.DiscountPaisa, &o.TotalPaisa, &o.Currency,
 			&o.PaymentID, &o.PaymentStatus, &o.PaymentMethod, &o.BookingID, &o.HoldID, &seatsJSON,
-			&o.Status, &o.SagaID, &o.ContactEmail, &o.ContactPhone, &o.CreatedAt, &o.UpdatedAt, &o.ExpiresAt,
+			&o.Status, &o.SagaID, &o.ContactEmail, &o.ContactPhone, &o.CreatedAt, &o.UpdatedAt, &o.ExpiresAt, &refundJSON,
+			&cancellationsJSON, &legsData, &changesData, &reviewData, &o.AgentID, &o.ShiftID, &o.AccountID, &o.PNR, &o.ClientIP,
 		); err != nil {
 			return nil, 0, err
 		}
 
 		json.Unmarshal(passengersJSON, &o.Passengers)
 		json.Unmarshal(seatsJSON, &o.Seats)
+		if len(refundJSON) > 0 {
+			json.Unmarshal(refundJSON, &o.Refund)
+		}
+		if len(cancellationsJSON) > 0 {
+			json.Unmarshal(cancellationsJSON, &o.PassengerCancellations)
+		}
+		if len(legsData) > 0 {
+			json.Unmarshal(legsData, &o.Legs)
+		}
+		if len(changesData) > 0 {
+			json.Unmarshal(changesData, &o.Changes)
+		}
+		if len(reviewData) > 0 {
+			json.Unmarshal(reviewData, &o.FraudReview)
+		}
 		orders = append(orders, &o)
 	}
 
 	return orders, total, nil
 }
+
+// ListExpiredPending returns up to limit pending orders whose expiry passed before now, oldest first
+func (r *OrderRepository) ListExpiredPending(ctx context.Context, now time.Time, limit int) ([]*domain.Order, error) {
+	query := `SELECT id, user_id FROM orders
+		WHERE status = $1 AND expires_at < $2
+		ORDER BY expires_at LIMIT $3`
+
+	rows, err := r.DB.QueryContext(ctx, query, domain.OrderStatusPending, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	type ref struct{ id, userID string }
+	var refs []ref
+	for rows.Next() {
+		var f ref
+		if err := rows.Scan(&f.id, &f.userID); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		refs = append(refs, f)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	orders := make([]*domain.Order, 0, len(refs))
+	for _, f := range refs {
+		order, err := r.GetByID(ctx, f.id, f.userID)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+// ListByStatus returns an organization's orders in a status, oldest first, with the total
+// count. An empty organization lists every organization's orders.
+func (r *OrderRepository) ListByStatus(ctx context.Context, orgID string, status domain.OrderStatus, limit, offset int) ([]*domain.Order, int, error) {
+	whereClause := "WHERE status = $1"
+	args := []interface{}{status}
+	if orgID != "" {
+		whereClause += " AND organization_id = $2"
+		args = append(args, orgID)
+	}
+
+	var total int
+	if err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM orders "+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf("SELECT id FROM orders %s ORDER BY created_at LIMIT $%d OFFSET $%d", whereClause, len(args)+1, len(args)+2)
+	rows, err := r.DB.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, 0, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	orders := make([]*domain.Order, 0, len(ids))
+	for _, id := range ids {
+		order, err := r.GetByIDForStaff(ctx, id)
+		if err != nil {
+			return nil, 0, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, total, nil
+}
+
+// refundJSON stores NULL for orders without a refund
+func refundJSON(refund *domain.RefundBreakdown) []byte {
+	if refund == nil {
+		return nil
+	}
+	b, _ := json.Marshal(refund)
+	return b
+}
+
+// legsJSON stores NULL for single-trip orders
+func legsJSON(legs []domain.OrderLeg) []byte {
+	if len(legs) == 0 {
+		return nil
+	}
+	b, _ := json.Marshal(legs)
+	return b
+}
+
+// fraudReviewJSON stores NULL for orders the fraud check never held
+func fraudReviewJSON(review *domain.FraudReview) []byte {
+	if review == nil {
+		return nil
+	}
+	b, _ := json.Marshal(review)
+	return b
+}
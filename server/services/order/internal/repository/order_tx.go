@@ -38,14 +38,16 @@ func (r *TxOrderRepository) CreateTx(ctx context.Context, order *domain.Order) e
 		id, organization_id, user_id, trip_id, route_id, from_station_id, to_station_id,
 		passengers, subtotal_paisa, tax_paisa, booking_fee_paisa, discount_paisa, total_paisa, currency,
 		payment_id, payment_status, payment_method, booking_id, hold_id, seats,
-		status, saga_id, contact_email, contact_phone, created_at, updated_at, expires_at, idempotency_key
-	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28)`
+		status, saga_id, contact_email, contact_phone, created_at, updated_at, expires_at, idempotency_key,
+		legs, agent_id, shift_id, account_id, pnr, client_ip
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34)`
 
 	_, err := r.tx.ExecContext(ctx, query,
 		order.ID, order.OrganizationID, order.UserID, order.TripID, order.RouteID, order.FromStationID, order.ToStationID,
 		passengersJSON, order.SubtotalPaisa, order.TaxPaisa, order.BookingFeePaisa, order.DiscountPaisa, order.TotalPaisa, order.Currency,
 		order.PaymentID, order.PaymentStatus, order.PaymentMethod, order.BookingID, order.HoldID, seatsJSON,
 		order.Status, order.SagaID, order.ContactEmail, order.ContactPhone, order.CreatedAt, order.UpdatedAt, order.ExpiresAt, order.IdempotencyKey,
+		legsJSON(order.Legs), order.AgentID, order.ShiftID, order.AccountID, order.PNR, order.ClientIP,
 	)
 
 	return err
@@ -57,15 +59,24 @@ func (r *TxOrderRepository) UpdateTx(ctx context.Context, order *domain.Order) e
 
 	passengersJSON, _ := json.Marshal(order.Passengers)
 	seatsJSON, _ := json.Marshal(order.Seats)
+	refundData := refundJSON(order.Refund)
+	cancellationsJSON, _ := json.Marshal(order.PassengerCancellations)
+	changesJSON, _ := json.Marshal(order.Changes)
 
 	query := `UPDATE orders SET
 		passengers = $1, payment_id = $2, payment_status = $3, booking_id = $4, seats = $5,
-		status = $6, saga_id = $7, updated_at = $8
+		status = $6, saga_id = $7, updated_at = $8, refund_breakdown = $10,
+		subtotal_paisa = $11, tax_paisa = $12, booking_fee_paisa = $13, discount_paisa = $14, total_paisa = $15,
+		passenger_cancellations = $16, legs = $17,
+		trip_id = $18, route_id = $19, hold_id = $20, changes = $21, fraud_review = $22
 		WHERE id = $9`
 
 	_, err := r.tx.ExecContext(ctx, query,
 		passengersJSON, order.PaymentID, order.PaymentStatus, order.BookingID, seatsJSON,
-		order.Status, order.SagaID, order.UpdatedAt, order.ID,
+		order.Status, order.SagaID, order.UpdatedAt, order.ID, refundData,
+		order.SubtotalPaisa, order.TaxPaisa, order.BookingFeePaisa, order.DiscountPaisa, order.TotalPaisa,
+		cancellationsJSON, legsJSON(order.Legs),
+		order.TripID, order.RouteID, order.HoldID, changesJSON, fraudReviewJSON(order.FraudReview),
 	)
 
 	return err
@@ -78,6 +89,31 @@ func (r *TxOrderRepository) UpdateStatusTx(ctx context.Context, id string, statu
 	return err
 }
 
+// ExpireTx expires an order that is still pending within a transaction. It reports false
+// when the order left pending meanwhile, e.g. because its booking went through.
+func (r *TxOrderRepository) ExpireTx(ctx context.Context, id, paymentStatus string) (bool, error) {
+	query := `UPDATE orders SET status = $1, payment_status = $2, updated_at = $3 WHERE id = $4 AND status = $5`
+	res, err := r.tx.ExecContext(ctx, query, domain.OrderStatusExpired, paymentStatus, time.Now(), id, domain.OrderStatusPending)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+// ReviewTx records a fraud analyst's decision and moves the order from one status to
+// another within a transaction. It reports false when the order was not in the from
+// status, e.g. because another analyst decided first.
+func (r *TxOrderRepository) ReviewTx(ctx context.Context, id string, from, to domain.OrderStatus, review *domain.FraudReview) (bool, error) {
+	query := `UPDATE orders SET status = $1, fraud_review = $2, updated_at = $3 WHERE id = $4 AND status = $5`
+	res, err := r.tx.ExecContext(ctx, query, to, fraudReviewJSON(review), time.Now(), id, from)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
 // Tx returns the underlying transaction
 func (r *TxOrderRepository) Tx() *sql.Tx {
 	return r.tx
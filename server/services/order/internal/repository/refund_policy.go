@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/google/uuid"
+)
+
+type RefundPolicyRepository struct {
+	DB *sql.DB
+}
+
+func NewRefundPolicyRepository(db *sql.DB) *RefundPolicyRepository {
+	return &RefundPolicyRepository{DB: db}
+}
+
+// Upsert saves a policy, replacing the organization's policy for the same route and vehicle class
+func (r *RefundPolicyRepository) Upsert(ctx context.Context, policy *domain.RefundPolicy) error {
+	now := time.Now()
+	tiersJSON, _ := json.Marshal(policy.Tiers)
+
+	query := `INSERT INTO refund_policies (
+		id, organization_id, route_id, vehicle_class, name, tiers, refund_booking_fee, created_at, updated_at,
+		change_fee_paisa, change_cutoff_hours, transfer_fee_paisa, transfer_cutoff_hours
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8, $9, $10, $11, $12)
+	ON CONFLICT (organization_id, route_id, vehicle_class) DO UPDATE SET
+		name = EXCLUDED.name, tiers = EXCLUDED.tiers, refund_booking_fee = EXCLUDED.refund_booking_fee, updated_at = EXCLUDED.updated_at,
+		change_fee_paisa = EXCLUDED.change_fee_paisa, change_cutoff_hours = EXCLUDED.change_cutoff_hours,
+		transfer_fee_paisa = EXCLUDED.transfer_fee_paisa, transfer_cutoff_hours = EXCLUDED.transfer_cutoff_hours
+	RETURNING id, created_at, updated_at`
+
+	return r.DB.QueryRowContext(ctx, query,
+		uuid.New().String(), policy.OrganizationID, policy.RouteID, policy.VehicleClass, policy.Name, tiersJSON, policy.RefundBookingFee, now,
+		policy.ChangeFeePaisa, policy.ChangeCutoffHours, policy.TransferFeePaisa, policy.TransferCutoffHours,
+	).Scan(&policy.ID, &policy.CreatedAt, &policy.UpdatedAt)
+}
+
+// ListByOrg returns an organization's policies
+func (r *RefundPolicyRepository) ListByOrg(ctx context.Context, orgID string) ([]*domain.RefundPolicy, error) {
+	query := `SELECT id, organization_id, route_id, vehicle_class, name, tiers, refund_booking_fee, created_at, updated_at,
+		change_fee_paisa, change_cutoff_hours, transfer_fee_paisa, transfer_cutoff_hours
+		FROM refund_policies WHERE organization_id = $1 ORDER BY route_id, vehicle_class`
+
+	rows, err := r.DB.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []*domain.RefundPolicy
+	for rows.Next() {
+		var p domain.RefundPolicy
+		var tiersJSON []byte
+		if err := rows.Scan(&p.ID, &p.OrganizationID, &p.RouteID, &p.VehicleClass, &p.Name, &tiersJSON,
+			&p.RefundBookingFee, &p.CreatedAt, &p.UpdatedAt, &p.ChangeFeePaisa, &p.ChangeCutoffHours,
+			&p.TransferFeePaisa, &p.TransferCutoffHours); err != nil {
+			return nil, err
+		}
+		json.Unmarshal(tiersJSON, &p.Tiers)
+		policies = append(policies, &p)
+	}
+	return policies, rows.Err()
+}
+
+// Delete removes one of an organization's policies
+func (r *RefundPolicyRepository) Delete(ctx context.Context, orgID, id string) error {
+	res, err := r.DB.ExecContext(ctx, `DELETE FROM refund_policies WHERE id = $1 AND organization_id = $2`, id, orgID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.ErrRefundPolicyNotFound
+	}
+	return nil
+}
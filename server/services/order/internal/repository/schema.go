@@ -63,6 +63,150 @@ func (r *OrderRepository) InitSchema(ctx context.Context) error {
 		// 003_add_route_id
 		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS route_id UUID`,
 		`CREATE INDEX IF NOT EXISTS idx_orders_route_id ON orders(route_id)`,
+
+		// 004_add_audit_logs
+		`CREATE TABLE IF NOT EXISTS audit_logs (
+			id UUID PRIMARY KEY,
+			entity_type VARCHAR(50) NOT NULL,
+			entity_id VARCHAR(255) NOT NULL,
+			action VARCHAR(50) NOT NULL,
+			actor_id VARCHAR(255) NOT NULL,
+			changes JSONB,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_audit_logs_entity ON audit_logs(entity_type, entity_id)`,
+
+		// 005_add_refund_policies
+		`CREATE TABLE IF NOT EXISTS refund_policies (
+			id UUID PRIMARY KEY,
+			organization_id UUID NOT NULL,
+			route_id VARCHAR(255) NOT NULL DEFAULT '',
+			vehicle_class VARCHAR(50) NOT NULL DEFAULT '',
+			name VARCHAR(255) NOT NULL,
+			tiers JSONB NOT NULL,
+			refund_booking_fee BOOLEAN NOT NULL DEFAULT FALSE,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			UNIQUE (organization_id, route_id, vehicle_class)
+		)`,
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS refund_breakdown JSONB`,
+
+		// 006_add_passenger_cancellations
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS passenger_cancellations JSONB`,
+
+		// 007_add_order_legs
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS legs JSONB`,
+
+		// 008_add_order_changes
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS changes JSONB`,
+		`ALTER TABLE refund_policies ADD COLUMN IF NOT EXISTS change_fee_paisa BIGINT NOT NULL DEFAULT 0`,
+		`ALTER TABLE refund_policies ADD COLUMN IF NOT EXISTS change_cutoff_hours INT NOT NULL DEFAULT 0`,
+
+		// 009_add_order_expiry_index
+		`CREATE INDEX IF NOT EXISTS idx_orders_pending_expires_at ON orders(expires_at) WHERE status = 'pending'`,
+
+		// 010_add_fraud_review
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS fraud_review JSONB`,
+		`CREATE INDEX IF NOT EXISTS idx_orders_review_pending ON orders(organization_id, created_at) WHERE status = 'review_pending'`,
+
+		// 011_add_agent_shifts
+		`CREATE TABLE IF NOT EXISTS agent_shifts (
+			id UUID PRIMARY KEY,
+			organization_id UUID NOT NULL,
+			agent_id VARCHAR(255) NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			opening_float_paisa BIGINT NOT NULL DEFAULT 0,
+			cash_sales_paisa BIGINT NOT NULL DEFAULT 0,
+			cash_refunds_paisa BIGINT NOT NULL DEFAULT 0,
+			currency VARCHAR(10) NOT NULL,
+			counted_cash_paisa BIGINT NOT NULL DEFAULT 0,
+			expected_cash_paisa BIGINT NOT NULL DEFAULT 0,
+			variance_paisa BIGINT NOT NULL DEFAULT 0,
+			note TEXT NOT NULL DEFAULT '',
+			opened_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			closed_at TIMESTAMP WITH TIME ZONE
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_agent_shifts_open ON agent_shifts(organization_id, agent_id) WHERE status = 'open'`,
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS agent_id VARCHAR(255) NOT NULL DEFAULT ''`,
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS shift_id VARCHAR(255) NOT NULL DEFAULT ''`,
+		`CREATE INDEX IF NOT EXISTS idx_orders_shift_id ON orders(shift_id) WHERE shift_id <> ''`,
+
+		// 012_add_corporate_accounts
+		`CREATE TABLE IF NOT EXISTS corporate_accounts (
+			id UUID PRIMARY KEY,
+			organization_id UUID NOT NULL,
+			name VARCHAR(255) NOT NULL,
+			kind VARCHAR(20) NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			billing_email VARCHAR(255) NOT NULL DEFAULT '',
+			currency VARCHAR(10) NOT NULL,
+			credit_limit_paisa BIGINT NOT NULL DEFAULT 0,
+			outstanding_paisa BIGINT NOT NULL DEFAULT 0,
+			payment_terms_days INT NOT NULL DEFAULT 30,
+			booker_ids JSONB NOT NULL DEFAULT '[]',
+			travellers JSONB NOT NULL DEFAULT '[]',
+			max_group_size INT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_corporate_accounts_org ON corporate_accounts(organization_id)`,
+		`CREATE TABLE IF NOT EXISTS account_invoices (
+			id UUID PRIMARY KEY,
+			account_id UUID NOT NULL,
+			organization_id UUID NOT NULL,
+			period_start TIMESTAMP WITH TIME ZONE NOT NULL,
+			period_end TIMESTAMP WITH TIME ZONE NOT NULL,
+			total_paisa BIGINT NOT NULL,
+			currency VARCHAR(10) NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			issued_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			paid_at TIMESTAMP WITH TIME ZONE,
+			UNIQUE (account_id, period_end)
+		)`,
+		`CREATE TABLE IF NOT EXISTS account_charges (
+			id UUID PRIMARY KEY,
+			account_id UUID NOT NULL,
+			order_id UUID NOT NULL,
+			reference VARCHAR(255) NOT NULL UNIQUE,
+			amount_paisa BIGINT NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			invoice_id UUID,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_account_charges_uninvoiced ON account_charges(account_id, created_at) WHERE invoice_id IS NULL`,
+		`CREATE INDEX IF NOT EXISTS idx_account_charges_invoice ON account_charges(invoice_id)`,
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS account_id VARCHAR(255) NOT NULL DEFAULT ''`,
+
+		// 013_add_passenger_transfers
+		`ALTER TABLE refund_policies ADD COLUMN IF NOT EXISTS transfer_fee_paisa BIGINT NOT NULL DEFAULT 0`,
+		`ALTER TABLE refund_policies ADD COLUMN IF NOT EXISTS transfer_cutoff_hours INT NOT NULL DEFAULT 0`,
+		`CREATE TABLE IF NOT EXISTS passenger_transfers (
+			id UUID PRIMARY KEY,
+			order_id UUID NOT NULL,
+			organization_id UUID NOT NULL,
+			user_id VARCHAR(255) NOT NULL,
+			trip_id VARCHAR(255) NOT NULL,
+			passenger_index INT NOT NULL,
+			old_nid VARCHAR(50) NOT NULL,
+			old_name VARCHAR(255) NOT NULL,
+			new_nid VARCHAR(50) NOT NULL,
+			new_name VARCHAR(255) NOT NULL,
+			fee_paisa BIGINT NOT NULL DEFAULT 0,
+			payment_id VARCHAR(255) NOT NULL DEFAULT '',
+			saga_id VARCHAR(255) NOT NULL UNIQUE,
+			transferred_at TIMESTAMP WITH TIME ZONE NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_passenger_transfers_order ON passenger_transfers(order_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_passenger_transfers_user ON passenger_transfers(user_id, transferred_at)`,
+		`CREATE INDEX IF NOT EXISTS idx_passenger_transfers_old_nid ON passenger_transfers(old_nid, transferred_at)`,
+		`CREATE INDEX IF NOT EXISTS idx_passenger_transfers_new_nid ON passenger_transfers(new_nid, transferred_at)`,
+
+		// 014_add_order_pnr
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS pnr VARCHAR(10) NOT NULL DEFAULT ''`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_pnr ON orders(pnr) WHERE pnr <> ''`,
+		// 015_add_order_client_ip
+		`ALTER TABLE orders ADD COLUMN IF NOT EXISTS client_ip VARCHAR(64) NOT NULL DEFAULT ''`,
 	}
 
 	for _, query := range queries {
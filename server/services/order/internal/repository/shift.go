@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/google/uuid"
+)
+
+type ShiftRepository struct {
+	DB *sql.DB
+}
+
+func NewShiftRepository(db *sql.DB) *ShiftRepository {
+	return &ShiftRepository{DB: db}
+}
+
+const shiftColumns = `id, organization_id, agent_id, status, opening_float_paisa, cash_sales_paisa, cash_refunds_paisa,
+	currency, counted_cash_paisa, expected_cash_paisa, variance_paisa, note, opened_at, closed_at`
+
+// Open starts a shift for its agent, unless the agent already has one open
+func (r *ShiftRepository) Open(ctx context.Context, shift *domain.AgentShift) error {
+	shift.ID = uuid.New().String()
+	shift.Status = domain.ShiftStatusOpen
+	shift.OpenedAt = time.Now()
+
+	query := `INSERT INTO agent_shifts (
+		id, organization_id, agent_id, status, opening_float_paisa, currency, opened_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT (organization_id, agent_id) WHERE status = 'open' DO NOTHING`
+
+	res, err := r.DB.ExecContext(ctx, query,
+		shift.ID, shift.OrganizationID, shift.AgentID, shift.Status, shift.OpeningFloatPaisa, shift.Currency, shift.OpenedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.ErrShiftAlreadyOpen
+	}
+	return nil
+}
+
+// Get loads one of an organization's shifts
+func (r *ShiftRepository) Get(ctx context.Context, orgID, id string) (*domain.AgentShift, error) {
+	shift, err := r.get(ctx, "id = $1 AND organization_id = $2", id, orgID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrShiftNotFound
+	}
+	return shift, err
+}
+
+// GetOpen loads the agent's open shift
+func (r *ShiftRepository) GetOpen(ctx context.Context, orgID, agentID string) (*domain.AgentShift, error) {
+	shift, err := r.get(ctx, "organization_id = $1 AND agent_id = $2 AND status = $3", orgID, agentID, domain.ShiftStatusOpen)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNoOpenShift
+	}
+	return shift, err
+}
+
+func (r *ShiftRepository) get(ctx context.Context, where string, args ...interface{}) (*domain.AgentShift, error) {
+	var s domain.AgentShift
+	var closedAt sql.NullTime
+	err := r.DB.QueryRowContext(ctx, `SELECT `+shiftColumns+` FROM agent_shifts WHERE `+where, args...).Scan(
+		&s.ID, &s.OrganizationID, &s.AgentID, &s.Status, &s.OpeningFloatPaisa, &s.CashSalesPaisa, &s.CashRefundsPaisa,
+		&s.Currency, &s.CountedCashPaisa, &s.ExpectedCashPaisa, &s.VariancePaisa, &s.Note, &s.OpenedAt, &closedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if closedAt.Valid {
+		s.ClosedAt = closedAt.Time
+	}
+	return &s, nil
+}
+
+// Close records the counted cash and reconciliation of an open shift. It reports false
+// when the shift was closed meanwhile.
+func (r *ShiftRepository) Close(ctx context.Context, shift *domain.AgentShift) (bool, error) {
+	query := `UPDATE agent_shifts SET
+		status = $1, counted_cash_paisa = $2, expected_cash_paisa = $3, variance_paisa = $4, note = $5, closed_at = $6
+		WHERE id = $7 AND status = $8`
+
+	res, err := r.DB.ExecContext(ctx, query,
+		domain.ShiftStatusClosed, shift.CountedCashPaisa, shift.ExpectedCashPaisa, shift.VariancePaisa, shift.Note, shift.ClosedAt,
+		shift.ID, domain.ShiftStatusOpen,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+// SumOrders adds up the cash orders sold in a shift. An order counts as confirmed once
+// its cash was taken, whatever happened to it afterwards; refunds are tracked on the shift.
+func (r *ShiftRepository) SumOrders(ctx context.Context, shiftID string) (domain.ShiftOrders, error) {
+	query := `SELECT
+		COUNT(*) FILTER (WHERE payment_status IN ($2, $3, $4)),
+		COALESCE(SUM(total_paisa) FILTER (WHERE payment_status IN ($2, $3, $4)), 0),
+		COUNT(*) FILTER (WHERE status IN ($5, $6))
+		FROM orders WHERE shift_id = $1 AND payment_method = $7`
+
+	var sum domain.ShiftOrders
+	err := r.DB.QueryRowContext(ctx, query, shiftID,
+		domain.PaymentStatusCaptured, domain.PaymentStatusPartiallyRefunded, domain.PaymentStatusRefunded,
+		domain.OrderStatusPending, domain.OrderStatusReviewPending, domain.PaymentMethodCash,
+	).Scan(&sum.ConfirmedOrders, &sum.ConfirmedCashPaisa, &sum.PendingOrders)
+	return sum, err
+}
+
+// AddShiftCashSaleTx adds a confirmed cash order to the running total of the shift it
+// was sold in, within a transaction
+func (r *TxOrderRepository) AddShiftCashSaleTx(ctx context.Context, shiftID string, amountPaisa int64) error {
+	query := `UPDATE agent_shifts SET cash_sales_paisa = cash_sales_paisa + $1 WHERE id = $2`
+	_, err := r.tx.ExecContext(ctx, query, amountPaisa, shiftID)
+	return err
+}
+
+// AddShiftCashRefundTx takes a cash refund paid out by an agent off their open shift
+// within a transaction. It reports false when the agent has no open shift.
+func (r *TxOrderRepository) AddShiftCashRefundTx(ctx context.Context, orgID, agentID string, amountPaisa int64) (bool, error) {
+	query := `UPDATE agent_shifts SET cash_refunds_paisa = cash_refunds_paisa + $1
+		WHERE organization_id = $2 AND agent_id = $3 AND status = $4`
+	res, err := r.tx.ExecContext(ctx, query, amountPaisa, orgID, agentID, domain.ShiftStatusOpen)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/google/uuid"
+)
+
+// TransferRepository reads the history of ticket transfers
+type TransferRepository struct {
+	DB *sql.DB
+}
+
+func NewTransferRepository(db *sql.DB) *TransferRepository {
+	return &TransferRepository{DB: db}
+}
+
+const transferColumns = `id, order_id, organization_id, user_id, trip_id, passenger_index, old_nid, old_name,
+	new_nid, new_name, fee_paisa, payment_id, saga_id, transferred_at`
+
+// List returns the transfers matching the filter, newest first, with the total count
+func (r *TransferRepository) List(ctx context.Context, filter domain.TransferFilter) ([]*domain.PassengerTransfer, int, error) {
+	var conds []string
+	var args []interface{}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.OrganizationID != "" {
+		add("organization_id = $%d", filter.OrganizationID)
+	}
+	if filter.OrderID != "" {
+		add("order_id = $%d", filter.OrderID)
+	}
+	if filter.UserID != "" {
+		add("user_id = $%d", filter.UserID)
+	}
+	if filter.NID != "" {
+		args = append(args, filter.NID)
+		conds = append(conds, fmt.Sprintf("(old_nid = $%d OR new_nid = $%d)", len(args), len(args)))
+	}
+	whereClause := ""
+	if len(conds) > 0 {
+		whereClause = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	var total int
+	if err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM passenger_transfers "+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM passenger_transfers %s ORDER BY transferred_at DESC LIMIT $%d OFFSET $%d",
+		transferColumns, whereClause, len(args)+1, len(args)+2)
+	rows, err := r.DB.QueryContext(ctx, query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var transfers []*domain.PassengerTransfer
+	for rows.Next() {
+		var t domain.PassengerTransfer
+		if err := rows.Scan(&t.ID, &t.OrderID, &t.OrganizationID, &t.UserID, &t.TripID, &t.PassengerIndex, &t.OldNID, &t.OldName,
+			&t.NewNID, &t.NewName, &t.FeePaisa, &t.PaymentID, &t.SagaID, &t.TransferredAt); err != nil {
+			return nil, 0, err
+		}
+		transfers = append(transfers, &t)
+	}
+	return transfers, total, rows.Err()
+}
+
+// AddTransferTx records a transfer. Returns false, recording nothing, when the transfer's
+// saga was already recorded.
+func (r *TxOrderRepository) AddTransferTx(ctx context.Context, t *domain.PassengerTransfer) (bool, error) {
+	if t.ID == "" {
+		t.ID = uuid.New().String()
+	}
+	if t.TransferredAt.IsZero() {
+		t.TransferredAt = time.Now()
+	}
+	res, err := r.tx.ExecContext(ctx, `INSERT INTO passenger_transfers (`+transferColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) ON CONFLICT (saga_id) DO NOTHING`,
+		t.ID, t.OrderID, t.OrganizationID, t.UserID, t.TripID, t.PassengerIndex, t.OldNID, t.OldName,
+		t.NewNID, t.NewName, t.FeePaisa, t.PaymentID, t.SagaID, t.TransferredAt,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
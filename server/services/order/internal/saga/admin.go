@@ -0,0 +1,258 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrNoSagaStore          = errors.New("saga persistence is not configured")
+	ErrSagaActionNotAllowed = errors.New("action is not allowed in the saga's current status")
+	ErrStepNotFound         = errors.New("saga has no such step")
+	ErrStepsNotInEffect     = errors.New("steps before the retried step must have completed")
+)
+
+// SagaFilter selects persisted sagas for operations staff
+type SagaFilter struct {
+	Statuses       []Status
+	OrganizationID string
+	MinAge         time.Duration // Only sagas started at least this long ago
+	Limit          int
+	Offset         int
+}
+
+// ListSagas returns the persisted sagas matching the filter, oldest first, and how many match in total
+func (o *Orchestrator) ListSagas(ctx context.Context, filter SagaFilter) ([]*Saga, int64, error) {
+	if o.db == nil {
+		return nil, 0, ErrNoSagaStore
+	}
+
+	query := o.db.WithContext(ctx).Model(&SagaInstance{})
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, st := range filter.Statuses {
+			statuses = append(statuses, string(st))
+		}
+		query = query.Where("status IN ?", statuses)
+	}
+	if filter.OrganizationID != "" {
+		query = query.Where("organization_id = ?", filter.OrganizationID)
+	}
+	if filter.MinAge > 0 {
+		query = query.Where("started_at <= ?", time.Now().Add(-filter.MinAge))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	var instances []SagaInstance
+	if err := query.Order("started_at ASC").Limit(limit).Offset(filter.Offset).Find(&instances).Error; err != nil {
+		return nil, 0, err
+	}
+
+	sagas := make([]*Saga, 0, len(instances))
+	for i := range instances {
+		saga, err := decode(&instances[i])
+		if err != nil {
+			return nil, 0, err
+		}
+		sagas = append(sagas, saga)
+	}
+	return sagas, total, nil
+}
+
+// InspectSaga returns a saga's persisted state, including its lease
+func (o *Orchestrator) InspectSaga(ctx context.Context, sagaID string) (*Saga, error) {
+	if o.db == nil {
+		return nil, ErrNoSagaStore
+	}
+	var instance SagaInstance
+	if err := o.db.WithContext(ctx).Where("id = ?", sagaID).First(&instance).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSagaNotFound
+		}
+		return nil, err
+	}
+	return decode(&instance)
+}
+
+// ForceRetry runs a saga again from the named step, or from its first step that has not
+// completed when step is empty. Steps before it must still be in effect. Works on failed
+// and compensated sagas and on sagas stuck with a lapsed lease; the saga runs in the
+// background and the returned copy shows it reset.
+func (o *Orchestrator) ForceRetry(ctx context.Context, sagaID, step string) (*Saga, error) {
+	saga, err := o.claimForAdmin(ctx, sagaID, true, func(s Status) bool {
+		return s != StatusCompleted && s != StatusResolved
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	from := firstNotInEffect(saga)
+	if step != "" {
+		from = -1
+		for i, st := range saga.Steps {
+			if st.Name == step {
+				from = i
+				break
+			}
+		}
+		if from < 0 {
+			o.release(saga.ID)
+			return nil, ErrStepNotFound
+		}
+		for _, st := range saga.Steps[:from] {
+			if st.Status != StatusCompleted {
+				o.release(saga.ID)
+				return nil, ErrStepsNotInEffect
+			}
+		}
+	}
+
+	o.resetFrom(saga, from)
+	o.persist(saga)
+	view, err := o.copyOf(saga)
+	if err != nil {
+		o.release(saga.ID)
+		return nil, err
+	}
+
+	o.runDetached(saga, func(ctx context.Context) error {
+		return o.run(ctx, saga, from)
+	})
+	return view, nil
+}
+
+// ForceCompensate undoes every step of a saga that is still in effect. Works on stuck
+// sagas and on failed ones, retrying the compensations that failed before. A step
+// caught mid-run is treated as interrupted and compensated too.
+func (o *Orchestrator) ForceCompensate(ctx context.Context, sagaID, reason string) (*Saga, error) {
+	saga, err := o.claimForAdmin(ctx, sagaID, true, func(s Status) bool {
+		return !s.Finished() || s == StatusFailed
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	saga.mu.Lock()
+	for _, step := range saga.Steps {
+		if step.Status == StatusRunning {
+			step.Status = StatusInterrupted
+			step.Error = "interrupted; compensated by operator"
+			step.CompletedAt = time.Now()
+		}
+	}
+	if saga.FailureReason == "" {
+		saga.FailureReason = "compensated by operator: " + reason
+	}
+	last := len(saga.Steps) - 1
+	saga.mu.Unlock()
+
+	o.mu.Lock()
+	o.sagas[saga.ID] = saga
+	o.mu.Unlock()
+
+	view, err := o.copyOf(saga)
+	if err != nil {
+		o.release(saga.ID)
+		return nil, err
+	}
+
+	o.runDetached(saga, func(ctx context.Context) error {
+		return o.compensate(ctx, saga, last)
+	})
+	return view, nil
+}
+
+// Resolve closes a failed or stuck saga that staff fixed by hand. Nothing is run and
+// recovery leaves the saga alone from then on.
+func (o *Orchestrator) Resolve(ctx context.Context, sagaID, note string) (*Saga, error) {
+	saga, err := o.claimForAdmin(ctx, sagaID, false, func(s Status) bool {
+		return !s.Finished() || s == StatusFailed
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	saga.Context.Set("resolution", note)
+	saga.mu.Lock()
+	saga.Status = StatusResolved
+	saga.CompletedAt = time.Now()
+	saga.mu.Unlock()
+	o.notify(saga, nil, "saga_resolved")
+	o.persist(saga)
+	o.forget(saga)
+
+	return o.copyOf(saga)
+}
+
+// claimForAdmin loads a saga, checks the action is allowed in its status and takes the
+// lease on it. Fails with ErrSagaLeased while a live instance is running the saga.
+// Actions that run steps need the saga's definition to be registered.
+func (o *Orchestrator) claimForAdmin(ctx context.Context, sagaID string, runsSteps bool, allowed func(Status) bool) (*Saga, error) {
+	if o.db == nil {
+		return nil, ErrNoSagaStore
+	}
+
+	saga, err := o.load(ctx, sagaID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed(saga.Status) {
+		return nil, ErrSagaActionNotAllowed
+	}
+	if runsSteps {
+		for _, step := range saga.Steps {
+			if step.ExecuteFn == nil {
+				return nil, ErrSagaNotRecoverable
+			}
+		}
+	}
+
+	if !o.claim(ctx, sagaID, string(saga.Status)) {
+		return nil, ErrSagaLeased
+	}
+	// Reload, the saga may have moved on between the first read and the claim
+	saga, err = o.load(ctx, sagaID)
+	if err != nil {
+		o.release(sagaID)
+		return nil, err
+	}
+	return saga, nil
+}
+
+// copyOf returns a snapshot of a saga that is safe to read while the saga keeps running
+func (o *Orchestrator) copyOf(saga *Saga) (*Saga, error) {
+	instance, err := o.snapshot(saga)
+	if err != nil {
+		return nil, err
+	}
+	instance.Owner = o.owner
+	instance.LeaseUntil = time.Now().Add(LeaseDuration)
+	if !Status(instance.Status).leased() {
+		instance.Owner = ""
+		instance.LeaseUntil = time.Time{}
+	}
+	return decode(instance)
+}
+
+// runDetached runs fn on a claimed saga in the background, holding its lease, and hands
+// the outcome to the saga's definition
+func (o *Orchestrator) runDetached(saga *Saga, fn func(ctx context.Context) error) {
+	go func() {
+		ctx := context.Background()
+		err := o.withLease(saga, func() error {
+			return fn(ctx)
+		})
+		o.finish(ctx, saga, err)
+	}()
+}
@@ -3,20 +3,70 @@ package saga
 import (
 	"context"
 	"fmt"
+	"time"
+)
+
+// PaymentHoldExtension is how much extra time a hold gets once a payment session opens,
+// so users finishing payment in a wallet app do not lose their seats
+const PaymentHoldExtension = 10 * time.Minute
+
+// PaymentMethodCash is collected by a counter agent before the booking is made, so cash
+// bookings skip the payment gateway and have no gateway payment to refund
+const PaymentMethodCash = "cash"
+
+// Saga names, used to rebuild persisted sagas
+const (
+	BookingSagaName               = "booking"
+	CancellationSagaName          = "cancellation"
+	PassengerCancellationSagaName = "passenger_cancellation"
+	ChangeSagaName                = "order_change"
+	TransferSagaName              = "passenger_transfer"
 )
 
 // BookingSaga defines the saga steps for creating a ticket booking
-// Steps: CheckEntitlement -> ValidateNID -> HoldSeats -> ProcessPayment -> ConfirmBooking -> RecordUsage -> SendNotification
-func NewBookingSaga(deps *BookingDependencies, req *BookingRequest) *Saga {
-	o := NewOrchestrator(nil, nil) // Persistence and DLQ handled by execution context
+// Steps: CheckEntitlement -> ValidateNID -> HoldSeats -> FraudCheck -> ProcessPayment -> ConfirmBooking -> RecordUsage -> SendNotification
+// A booking the fraud check sends to manual review suspends the saga before payment.
+// The request is kept in the saga context so the saga can be rebuilt after a restart,
+// without the payment token and the passengers' NIDs and dates of birth (see persisted).
+func NewBookingSaga(o *Orchestrator, deps *BookingDependencies, req *BookingRequest) *Saga {
+	saga := o.CreateSaga(BookingSagaName, bookingSteps(deps, req))
+
+	// Pre-populate context with request data
+	saga.Context.Set("booking_request", req.persisted())
+	saga.Context.Set("order_id", req.OrderID)
+	saga.Context.Set("user_id", req.UserID)
+	saga.Context.Set("trip_id", req.TripID)
+	saga.Context.Set("hold_id", req.HoldID)
+	saga.Context.Set("org_id", req.OrgID)
+	saga.Context.Set("total_paisa", req.TotalPaisa)
+
+	return saga
+}
+
+// BookingSagaSteps rebuilds a persisted booking saga's steps from its context
+func BookingSagaSteps(deps *BookingDependencies) func(sagaCtx *SagaContext) ([]*Step, error) {
+	return func(sagaCtx *SagaContext) ([]*Step, error) {
+		var req BookingRequest
+		if !sagaCtx.Decode("booking_request", &req) {
+			return nil, fmt.Errorf("booking request missing from saga context")
+		}
+		if err := deps.restorePassengerNIDs(&req); err != nil {
+			return nil, fmt.Errorf("restore passengers of order %s: %w", req.OrderID, err)
+		}
+		req.restored = true
+		return bookingSteps(deps, &req), nil
+	}
+}
 
-	saga := o.CreateSaga("booking", []*Step{
+func bookingSteps(deps *BookingDependencies, req *BookingRequest) []*Step {
+	return []*Step{
 		{
 			Name: "check_entitlement",
 			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
 				return deps.checkEntitlement(ctx, sagaCtx, req)
 			},
 			// No compensation - this is a gate, not a mutation
+			Retryable: true,
 		},
 		{
 			Name: "validate_nid",
@@ -24,6 +74,7 @@ func NewBookingSaga(deps *BookingDependencies, req *BookingRequest) *Saga {
 				return deps.validateNID(ctx, sagaCtx, req)
 			},
 			// No compensation needed - validation is idempotent
+			Retryable: true,
 		},
 		{
 			Name: "hold_seats",
@@ -33,6 +84,16 @@ func NewBookingSaga(deps *BookingDependencies, req *BookingRequest) *Saga {
 			CompensateFn: func(ctx context.Context, sagaCtx *SagaContext) error {
 				return deps.releaseSeats(ctx, sagaCtx)
 			},
+			// Adopting the frontend's holds can be repeated; placing new ones cannot
+			Retryable: req.allLegsHeld(),
+		},
+		{
+			Name: "fraud_check",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				return deps.checkFraud(ctx, sagaCtx, req)
+			},
+			// No compensation - this is a gate; a repeated run reuses the first assessment
+			Retryable: true,
 		},
 		{
 			Name: "process_payment",
@@ -57,11 +118,13 @@ func NewBookingSaga(deps *BookingDependencies, req *BookingRequest) *Saga {
 			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
 				// Best effort - log error but don't fail saga
 				// In real world, push to DLQ or retry queue
-				if err := deps.SubscriptionService.RecordUsage(ctx, req.OrgID, "ticket_sale", int64(len(req.Passengers)), req.OrderID); err != nil {
+				if err := deps.SubscriptionService.RecordUsage(ctx, req.OrgID, "ticket_sale", int64(req.PassengerCount()), req.OrderID); err != nil {
 					fmt.Printf("Failed to record usage for order %s: %v\n", req.OrderID, err)
 				}
 				return nil
 			},
+			// Usage is keyed by order ID, so recording it twice counts once
+			Retryable: true,
 		},
 		{
 			Name: "send_notification",
@@ -69,17 +132,9 @@ func NewBookingSaga(deps *BookingDependencies, req *BookingRequest) *Saga {
 				return deps.sendNotification(ctx, sagaCtx, req)
 			},
 			// No compensation - notification failures shouldn't rollback booking
+			Retryable: true,
 		},
-	})
-
-	// Pre-populate context with request data
-	saga.Context.Set("order_id", req.OrderID)
-	saga.Context.Set("user_id", req.UserID)
-	saga.Context.Set("trip_id", req.TripID)
-	saga.Context.Set("hold_id", req.HoldID)
-	saga.Context.Set("org_id", req.OrgID)
-
-	return saga
+	}
 }
 
 // BookingDependencies contains service clients needed for the saga
@@ -89,6 +144,9 @@ type BookingDependencies struct {
 	PaymentService      PaymentClient
 	SubscriptionService SubscriptionClient
 	NotificationSvc     NotificationClient
+	FraudService        FraudClient     // Optional; bookings are not scored without it
+	CreditLedger        CreditLedger    // Optional; needed for account bookings
+	Passengers          PassengerLookup // Restores the NIDs of rebuilt booking sagas
 }
 
 // BookingRequest contains the booking order details
@@ -103,9 +161,119 @@ type BookingRequest struct {
 	Passengers    []PassengerInfo
 	PaymentToken  string
 	PaymentMethod string
+	AccountID     string // Account bookings only
 	TotalPaisa    int64
 	Email         string
 	Phone         string
+	// Client the booking was made from, for fraud scoring
+	IPAddress         string
+	UserAgent         string
+	DeviceFingerprint string
+	// Multi-leg bookings: every leg, the first included, paid with one charge.
+	// The trip, hold, stations and passengers above are the first leg's.
+	Legs []BookingLeg
+	// Reserved seat quotas the saga's own holds may draw on
+	QuotaClaims *QuotaClaims
+
+	restored bool // Rebuilt from the saga store, which keeps no dates of birth
+}
+
+// persisted is the request as kept in the saga store. The store is readable through the
+// saga admin API and the DLQ, so the payment token and the passengers' NIDs and dates of
+// birth stay in memory; the order already records the NIDs.
+func (r *BookingRequest) persisted() *BookingRequest {
+	p := *r
+	p.PaymentToken = ""
+	p.Passengers = redactPassengers(r.Passengers)
+	p.Legs = nil
+	for _, leg := range r.Legs {
+		leg.Passengers = redactPassengers(leg.Passengers)
+		p.Legs = append(p.Legs, leg)
+	}
+	return &p
+}
+
+func redactPassengers(passengers []PassengerInfo) []PassengerInfo {
+	redacted := make([]PassengerInfo, len(passengers))
+	for i, p := range passengers {
+		p.NID = ""
+		p.DateOfBirth = ""
+		redacted[i] = p
+	}
+	return redacted
+}
+
+// PassengerLookup reads back the NIDs of an order's passengers, one list per leg in order
+type PassengerLookup interface {
+	PassengerNIDs(ctx context.Context, orderID string) ([][]string, error)
+}
+
+// restorePassengerNIDs fills the NIDs the persisted request leaves out back in from the order
+func (d *BookingDependencies) restorePassengerNIDs(req *BookingRequest) error {
+	if d.Passengers == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nids, err := d.Passengers.PassengerNIDs(ctx, req.OrderID)
+	if err != nil {
+		return err
+	}
+	legs := req.AllLegs()
+	if len(nids) != len(legs) {
+		return fmt.Errorf("order has %d legs, booking has %d", len(nids), len(legs))
+	}
+	for i, leg := range legs {
+		if len(nids[i]) != len(leg.Passengers) {
+			return fmt.Errorf("order has %d passengers on leg %d, booking has %d", len(nids[i]), i+1, len(leg.Passengers))
+		}
+		for j := range leg.Passengers {
+			leg.Passengers[j].NID = nids[i][j]
+		}
+	}
+	if len(req.Legs) > 0 {
+		req.Passengers = req.Legs[0].Passengers
+	}
+	return nil
+}
+
+// BookingLeg is one trip of a multi-leg booking, with its own hold and passengers
+type BookingLeg struct {
+	TripID      string
+	HoldID      string
+	FromStation string
+	ToStation   string
+	Passengers  []PassengerInfo
+}
+
+// AllLegs returns the legs to book; a single-trip request is one leg
+func (r *BookingRequest) AllLegs() []BookingLeg {
+	if len(r.Legs) > 0 {
+		return r.Legs
+	}
+	return []BookingLeg{{
+		TripID:      r.TripID,
+		HoldID:      r.HoldID,
+		FromStation: r.FromStation,
+		ToStation:   r.ToStation,
+		Passengers:  r.Passengers,
+	}}
+}
+
+// PassengerCount counts passengers over all legs, one ticket sale each
+func (r *BookingRequest) PassengerCount() int {
+	n := 0
+	for _, leg := range r.AllLegs() {
+		n += len(leg.Passengers)
+	}
+	return n
+}
+
+// LegBooking is one leg confirmed by inventory
+type LegBooking struct {
+	BookingID string
+	Seats     []ConfirmedSeat
 }
 
 type PassengerInfo struct {
@@ -114,6 +282,37 @@ type PassengerInfo struct {
 	SeatID      string
 	DateOfBirth string
 	Gender      string
+	// Deck or standing passengers carry a capacity class instead of a seat
+	CapacityClass string
+}
+
+// CapacityItem is a quantity of unnumbered places of one class
+type CapacityItem struct {
+	CapacityClass string
+	Quantity      int
+}
+
+// QuotaClaims describes what the buyer is entitled to buy from reserved seat quotas
+type QuotaClaims struct {
+	Female    bool
+	Disabled  bool
+	Channel   string
+	PartnerID string
+	VIP       bool
+}
+
+// ConfirmedSeat is one ticketed seat returned by inventory on confirmation.
+// Split-seat journeys return one per leg with the leg's stations.
+type ConfirmedSeat struct {
+	SeatID         string
+	SeatNumber     string
+	SeatClass      string
+	TicketID       string
+	PricePaisa     int64
+	PassengerIndex int
+	FromStationID  string
+	ToStationID    string
+	CapacityClass  string // Capacity tickets only; SeatID is then empty
 }
 
 // --- Service Interfaces ---
@@ -123,16 +322,23 @@ type NIDVerifier interface {
 }
 
 type InventoryClient interface {
-	HoldSeats(ctx context.Context, orgID, tripID string, seatIDs []string, userID string) (string, error)
+	HoldSeats(ctx context.Context, orgID, tripID string, seatIDs []string, capacity []CapacityItem, userID string, claims *QuotaClaims) (string, error)
 	ReleaseSeats(ctx context.Context, orgID, holdID, userID string) error
-	ConfirmBooking(ctx context.Context, orgID, holdID, orderID, userID string, passengers []PassengerInfo) (string, error)
+	ExtendHold(ctx context.Context, orgID, holdID, userID string, extendBy time.Duration) (time.Time, error)
+	// ExtendHoldForReview keeps a hold until extendBy from now, outside the hold policy
+	ExtendHoldForReview(ctx context.Context, orgID, holdID, userID string, extendBy time.Duration) (time.Time, error)
+	ConfirmBooking(ctx context.Context, orgID, holdID, orderID, userID string, passengers []PassengerInfo) (string, []ConfirmedSeat, error)
 	CancelBooking(ctx context.Context, bookingID, orderID string) error
+	CancelBookingPassengers(ctx context.Context, bookingID, orderID string, passengerIndexes []int) error
+	TransferPassenger(ctx context.Context, bookingID, orderID string, passengerIndex int, nid, name string) error
 }
 
 type PaymentClient interface {
 	Authorize(ctx context.Context, orderID, orgID, token string, amountPaisa int64) (string, error)
 	Capture(ctx context.Context, paymentID string) error
 	Refund(ctx context.Context, paymentID string, amountPaisa int64) (string, error)
+	// Cancel cancels an order's unpaid payment and returns its resulting status
+	Cancel(ctx context.Context, orderID, reason string) (string, error)
 }
 
 type SubscriptionClient interface {
@@ -181,10 +387,11 @@ func (d *BookingDependencies) checkEntitlement(ctx context.Context, sagaCtx *Sag
 
 	// Check booking quota if defined
 	if limit, ok := ent.QuotaLimits["max_bookings_per_month"]; ok {
+		// Counted over every leg, as record_usage records it
 		usage := ent.UsageThisPeriod["ticket_sale"]
-		newUsage := usage + int64(len(req.Passengers))
-		if newUsage > limit {
-			return fmt.Errorf("booking quota exceeded: %d/%d (need %d more)", usage, limit, int64(len(req.Passengers)))
+		needed := int64(req.PassengerCount())
+		if usage+needed > limit {
+			return fmt.Errorf("booking quota exceeded: %d/%d (need %d more)", usage, limit, needed)
 		}
 	}
 
@@ -194,63 +401,172 @@ func (d *BookingDependencies) checkEntitlement(ctx context.Context, sagaCtx *Sag
 }
 
 func (d *BookingDependencies) validateNID(ctx context.Context, sagaCtx *SagaContext, req *BookingRequest) error {
-	for _, p := range req.Passengers {
-		valid, err := d.NIDService.Verify(ctx, p.NID, p.DateOfBirth, p.Name)
-		if err != nil {
-			return fmt.Errorf("NID verification failed for %s: %w", p.Name, err)
-		}
-		if !valid {
-			return fmt.Errorf("NID validation failed for %s", p.Name)
+	if req.restored {
+		return fmt.Errorf("NID verification cannot resume after a restart: dates of birth are not kept")
+	}
+	verified := make(map[string]bool)
+	for _, leg := range req.AllLegs() {
+		for _, p := range leg.Passengers {
+			if verified[p.NID] {
+				continue // The same traveller on another leg
+			}
+			valid, err := d.NIDService.Verify(ctx, p.NID, p.DateOfBirth, p.Name)
+			if err != nil {
+				return fmt.Errorf("NID verification failed for %s: %w", p.Name, err)
+			}
+			if !valid {
+				return fmt.Errorf("NID validation failed for %s", p.Name)
+			}
+			verified[p.NID] = true
 		}
 	}
 	sagaCtx.Set("nid_verified", true)
 	return nil
 }
 
-func (d *BookingDependencies) holdSeats(ctx context.Context, sagaCtx *SagaContext, req *BookingRequest) error {
-	// If we already have a hold from the frontend, verify it
-	if req.HoldID != "" {
-		sagaCtx.Set("hold_id", req.HoldID)
-		return nil
+// allLegsHeld reports whether the frontend placed the hold of every leg
+func (r *BookingRequest) allLegsHeld() bool {
+	for _, leg := range r.AllLegs() {
+		if leg.HoldID == "" {
+			return false
+		}
 	}
+	return true
+}
 
-	// Otherwise, create a new hold
-	var seatIDs []string
-	for _, p := range req.Passengers {
-		seatIDs = append(seatIDs, p.SeatID)
+// holdSeats adopts or places the hold of every leg. If one leg cannot be held, the
+// holds already taken are released so no leg is left holding seats.
+func (d *BookingDependencies) holdSeats(ctx context.Context, sagaCtx *SagaContext, req *BookingRequest) error {
+	legs := req.AllLegs()
+	holds := make([]string, 0, len(legs))
+	for i, leg := range legs {
+		// If we already have a hold from the frontend, verify it
+		holdID := leg.HoldID
+		if holdID == "" {
+			// Otherwise, create a new hold; deck and standing passengers take places by count
+			var seatIDs []string
+			var capacity []CapacityItem
+			for _, p := range leg.Passengers {
+				if p.CapacityClass != "" {
+					capacity = addCapacityItem(capacity, p.CapacityClass)
+					continue
+				}
+				seatIDs = append(seatIDs, p.SeatID)
+			}
+
+			var err error
+			holdID, err = d.InventoryService.HoldSeats(ctx, req.OrgID, leg.TripID, seatIDs, capacity, req.UserID, req.QuotaClaims)
+			if err != nil {
+				d.releaseSeats(ctx, sagaCtx)
+				if len(legs) > 1 {
+					return fmt.Errorf("failed to hold seats for leg %d: %w", i+1, err)
+				}
+				return fmt.Errorf("failed to hold seats: %w", err)
+			}
+		}
+		holds = append(holds, holdID)
+		if len(legs) > 1 {
+			sagaCtx.Set("leg_holds", holds)
+		}
 	}
 
-	holdID, err := d.InventoryService.HoldSeats(ctx, req.OrgID, req.TripID, seatIDs, req.UserID)
-	if err != nil {
-		return fmt.Errorf("failed to hold seats: %w", err)
-	}
+	sagaCtx.Set("hold_id", holds[0])
+	return nil
+}
 
-	sagaCtx.Set("hold_id", holdID)
+// HeldSeats returns the holds a booking saga placed, one per leg
+func HeldSeats(sagaCtx *SagaContext) []string {
+	return heldLegs(sagaCtx)
+}
+
+// heldLegs returns the hold of every leg recorded in the saga
+func heldLegs(sagaCtx *SagaContext) []string {
+	var holds []string
+	if sagaCtx.Decode("leg_holds", &holds) && len(holds) > 0 {
+		return holds
+	}
+	if holdID := sagaCtx.GetString("hold_id"); holdID != "" {
+		return []string{holdID}
+	}
 	return nil
 }
 
+func addCapacityItem(items []CapacityItem, class string) []CapacityItem {
+	for i := range items {
+		if items[i].CapacityClass == class {
+			items[i].Quantity++
+			return items
+		}
+	}
+	return append(items, CapacityItem{CapacityClass: class, Quantity: 1})
+}
+
 func (d *BookingDependencies) releaseSeats(ctx context.Context, sagaCtx *SagaContext) error {
-	holdID := sagaCtx.GetString("hold_id")
 	userID := sagaCtx.GetString("user_id")
 	orgID := sagaCtx.GetString("org_id")
 
-	if holdID == "" {
-		return nil // Nothing to release
+	var firstErr error
+	for _, holdID := range heldLegs(sagaCtx) {
+		if err := d.InventoryService.ReleaseSeats(ctx, orgID, holdID, userID); err != nil && firstErr == nil {
+			firstErr = err
+		}
 	}
-
-	return d.InventoryService.ReleaseSeats(ctx, orgID, holdID, userID)
+	return firstErr
 }
 
 func (d *BookingDependencies) processPayment(ctx context.Context, sagaCtx *SagaContext, req *BookingRequest) error {
+	// A booking approved after a fraud review has outlived the hold policy: its holds are
+	// renewed for the payment window, and it stops before anything is charged if they are gone
+	reviewed := heldForReview(sagaCtx)
+	if reviewed {
+		if err := d.keepHoldsForReview(ctx, sagaCtx, req, PaymentHoldExtension); err != nil {
+			return fmt.Errorf("seats no longer held after fraud review: %w", err)
+		}
+	}
+
+	if req.PaymentMethod == PaymentMethodCash {
+		return nil // Taken at the counter
+	}
+	if req.PaymentMethod == PaymentMethodAccount {
+		return d.chargeAccount(ctx, sagaCtx, req)
+	}
+
+	// One charge covers every leg
 	paymentID, err := d.PaymentService.Authorize(ctx, req.OrderID, req.OrgID, req.PaymentToken, req.TotalPaisa)
 	if err != nil {
 		return fmt.Errorf("payment authorization failed: %w", err)
 	}
 
+	// Recorded straight away, so the authorization is voided if the saga stops before capture
 	sagaCtx.Set("payment_id", paymentID)
+	sagaCtx.Set("payment_authorized", true)
+
+	// Payment session is open: keep the seats while the user pays (reviewed bookings were
+	// renewed above). Best effort - the inventory's hold policy may refuse, and the original
+	// expiry still applies
+	if !reviewed {
+		var earliest time.Time
+		for _, holdID := range heldLegs(sagaCtx) {
+			expiresAt, err := d.InventoryService.ExtendHold(ctx, req.OrgID, holdID, req.UserID, PaymentHoldExtension)
+			if err != nil {
+				fmt.Printf("Warning: Hold extension failed for hold %s: %v\n", holdID, err)
+				continue
+			}
+			if earliest.IsZero() || expiresAt.Before(earliest) {
+				earliest = expiresAt
+			}
+		}
+		if !earliest.IsZero() {
+			sagaCtx.Set("hold_expires_at", earliest.Unix())
+		}
+	}
 
-	// Capture the payment
+	// Capture the payment. A failed step is not compensated, so an authorization that
+	// cannot be captured is voided here
 	if err := d.PaymentService.Capture(ctx, paymentID); err != nil {
+		if voidErr := d.voidPayment(ctx, sagaCtx, paymentID); voidErr != nil {
+			fmt.Printf("Warning: Failed to void payment %s of order %s: %v\n", paymentID, req.OrderID, voidErr)
+		}
 		return fmt.Errorf("payment capture failed: %w", err)
 	}
 
@@ -259,18 +575,50 @@ func (d *BookingDependencies) processPayment(ctx context.Context, sagaCtx *SagaC
 }
 
 func (d *BookingDependencies) refundPayment(ctx context.Context, sagaCtx *SagaContext) error {
+	if sagaCtx.GetString("charged_account_id") != "" {
+		return d.releaseAccountCharge(ctx, sagaCtx)
+	}
+
 	paymentID := sagaCtx.GetString("payment_id")
 	if paymentID == "" {
 		return nil // No payment to refund
 	}
 
-	// Only refund if payment was captured
+	// A payment authorized but never captured, e.g. when a restart interrupted the step,
+	// is voided so the customer's funds are not left blocked
 	if captured, _ := sagaCtx.Get("payment_captured"); captured != true {
-		return nil
+		return d.voidPayment(ctx, sagaCtx, paymentID)
 	}
+	return d.refundCaptured(ctx, sagaCtx, paymentID)
+}
 
-	// Get original amount
+// voidPayment cancels an order's uncaptured payment. If the payment service finds it was
+// paid after all, the payment is refunded in full instead.
+func (d *BookingDependencies) voidPayment(ctx context.Context, sagaCtx *SagaContext, paymentID string) error {
+	status, err := d.PaymentService.Cancel(ctx, sagaCtx.GetString("order_id"), "booking_failed")
+	if err != nil {
+		return fmt.Errorf("payment void failed: %w", err)
+	}
+	if status == "captured" || status == "authorized" {
+		return d.refundCaptured(ctx, sagaCtx, paymentID)
+	}
+	sagaCtx.Set("payment_voided", true)
+	return nil
+}
+
+// refundCaptured refunds a captured payment in full
+func (d *BookingDependencies) refundCaptured(ctx context.Context, sagaCtx *SagaContext, paymentID string) error {
+	// Sagas persisted before the total was kept in the context carry it in the request
 	amount := sagaCtx.GetInt64("total_paisa")
+	if amount == 0 {
+		var req BookingRequest
+		if sagaCtx.Decode("booking_request", &req) {
+			amount = req.TotalPaisa
+		}
+	}
+	if amount <= 0 {
+		return fmt.Errorf("refund failed: no captured amount recorded for payment %s", paymentID)
+	}
 
 	refundID, err := d.PaymentService.Refund(ctx, paymentID, amount)
 	if err != nil {
@@ -281,29 +629,68 @@ func (d *BookingDependencies) refundPayment(ctx context.Context, sagaCtx *SagaCo
 	return nil
 }
 
+// confirmBooking turns the hold of every leg into a booking. Legs are confirmed together:
+// if one fails, the legs already confirmed are cancelled before the step fails.
 func (d *BookingDependencies) confirmBooking(ctx context.Context, sagaCtx *SagaContext, req *BookingRequest) error {
-	holdID := sagaCtx.GetString("hold_id")
 	orderID := sagaCtx.GetString("order_id")
 	userID := sagaCtx.GetString("user_id")
 
-	bookingID, err := d.InventoryService.ConfirmBooking(ctx, req.OrgID, holdID, orderID, userID, req.Passengers)
-	if err != nil {
-		return fmt.Errorf("booking confirmation failed: %w", err)
+	legs := req.AllLegs()
+	holds := heldLegs(sagaCtx)
+	if len(holds) != len(legs) {
+		return fmt.Errorf("booking confirmation failed: %d holds for %d legs", len(holds), len(legs))
 	}
 
-	sagaCtx.Set("booking_id", bookingID)
+	bookings := make([]LegBooking, 0, len(legs))
+	for i, leg := range legs {
+		bookingID, seats, err := d.InventoryService.ConfirmBooking(ctx, req.OrgID, holds[i], orderID, userID, leg.Passengers)
+		if err != nil {
+			if cancelErr := d.cancelBooking(ctx, sagaCtx); cancelErr != nil {
+				fmt.Printf("Warning: Failed to cancel confirmed legs of order %s: %v\n", orderID, cancelErr)
+			}
+			if len(legs) > 1 {
+				return fmt.Errorf("booking confirmation failed for leg %d: %w", i+1, err)
+			}
+			return fmt.Errorf("booking confirmation failed: %w", err)
+		}
+		bookings = append(bookings, LegBooking{BookingID: bookingID, Seats: seats})
+		if len(legs) > 1 {
+			sagaCtx.Set("leg_bookings", bookings) // Recorded as we go so compensation finds every leg
+		}
+		if i == 0 {
+			sagaCtx.Set("booking_id", bookingID)
+			sagaCtx.Set("confirmed_seats", seats)
+		}
+	}
+	return nil
+}
+
+// confirmedLegs returns the booking of every leg recorded in the saga
+func confirmedLegs(sagaCtx *SagaContext) []string {
+	var bookings []LegBooking
+	if sagaCtx.Decode("leg_bookings", &bookings) && len(bookings) > 0 {
+		ids := make([]string, 0, len(bookings))
+		for _, b := range bookings {
+			ids = append(ids, b.BookingID)
+		}
+		return ids
+	}
+	if bookingID := sagaCtx.GetString("booking_id"); bookingID != "" {
+		return []string{bookingID}
+	}
 	return nil
 }
 
 func (d *BookingDependencies) cancelBooking(ctx context.Context, sagaCtx *SagaContext) error {
-	bookingID := sagaCtx.GetString("booking_id")
 	orderID := sagaCtx.GetString("order_id")
 
-	if bookingID == "" {
-		return nil // Nothing to cancel
+	var firstErr error
+	for _, bookingID := range confirmedLegs(sagaCtx) {
+		if err := d.InventoryService.CancelBooking(ctx, bookingID, orderID); err != nil && firstErr == nil {
+			firstErr = err
+		}
 	}
-
-	return d.InventoryService.CancelBooking(ctx, bookingID, orderID)
+	return firstErr
 }
 
 func (d *BookingDependencies) sendNotification(ctx context.Context, sagaCtx *SagaContext, req *BookingRequest) error {
@@ -315,45 +702,143 @@ func (d *BookingDependencies) sendNotification(ctx context.Context, sagaCtx *Sag
 	return nil
 }
 
-// NewCancellationSaga creates a new cancellation saga
+// NewCancellationSaga creates a new cancellation saga.
+// Its steps read everything from the saga context, so a persisted saga rebuilds from it alone.
 func NewCancellationSaga(
+	o *Orchestrator,
 	deps *BookingDependencies,
-	orderID, userID, bookingID, paymentID string,
+	orderID, userID, orgID, bookingID, paymentID string,
 	email, phone string,
 	amount int64,
+	reason string,
 ) *Saga {
-	o := NewOrchestrator(nil, nil) // Persistence and DLQ handled by execution context
+	saga := o.CreateSaga(CancellationSagaName, cancellationSteps(deps))
+
+	saga.Context.Set("order_id", orderID)
+	saga.Context.Set("user_id", userID)
+	saga.Context.Set("org_id", orgID)
+	saga.Context.Set("booking_id", bookingID)
+	saga.Context.Set("payment_id", paymentID)
+	saga.Context.Set("email", email)
+	saga.Context.Set("phone", phone)
+	saga.Context.Set("refund_amount", amount)
+	saga.Context.Set("reason", reason)
+
+	return saga
+}
+
+// CancellationSagaSteps rebuilds a persisted cancellation saga's steps
+func CancellationSagaSteps(deps *BookingDependencies) func(sagaCtx *SagaContext) ([]*Step, error) {
+	return func(sagaCtx *SagaContext) ([]*Step, error) {
+		return cancellationSteps(deps), nil
+	}
+}
 
-	saga := o.CreateSaga("cancellation", []*Step{
+func cancellationSteps(deps *BookingDependencies) []*Step {
+	return []*Step{
 		{
 			Name: "cancel_booking",
 			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
-				return deps.InventoryService.CancelBooking(ctx, bookingID, orderID)
-			},
-		},
-		{
-			Name: "process_refund",
-			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
-				refundID, err := deps.PaymentService.Refund(ctx, paymentID, amount)
-				if err != nil {
-					return err
+				// Multi-leg orders cancel the booking of every leg
+				bookingIDs := []string{sagaCtx.GetString("booking_id")}
+				sagaCtx.Decode("booking_ids", &bookingIDs)
+				for _, bookingID := range bookingIDs {
+					if err := deps.InventoryService.CancelBooking(ctx, bookingID, sagaCtx.GetString("order_id")); err != nil {
+						return err
+					}
 				}
-				sagaCtx.Set("refund_id", refundID)
 				return nil
 			},
+			// Inventory ignores a booking that is already cancelled
+			Retryable: true,
 		},
+		processRefundStep(deps),
 		{
 			Name: "send_cancellation_notification",
 			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
-				return deps.NotificationSvc.SendBookingCancellation(ctx, email, phone, orderID, "user requested")
+				return deps.NotificationSvc.SendBookingCancellation(ctx, sagaCtx.GetString("email"), sagaCtx.GetString("phone"),
+					sagaCtx.GetString("order_id"), "user requested")
 			},
+			Retryable: true,
 		},
-	})
+	}
+}
+
+// NewPassengerCancellationSaga creates a saga cancelling some passengers of an order.
+// The rest of the booking stays confirmed; amount is the refund prorated to those passengers.
+func NewPassengerCancellationSaga(
+	o *Orchestrator,
+	deps *BookingDependencies,
+	orderID, userID, orgID, bookingID, paymentID string,
+	email, phone string,
+	passengerIndexes []int,
+	amount int64,
+	reason string,
+) *Saga {
+	saga := o.CreateSaga(PassengerCancellationSagaName, passengerCancellationSteps(deps))
 
 	saga.Context.Set("order_id", orderID)
 	saga.Context.Set("user_id", userID)
+	saga.Context.Set("org_id", orgID)
 	saga.Context.Set("booking_id", bookingID)
 	saga.Context.Set("payment_id", paymentID)
+	saga.Context.Set("email", email)
+	saga.Context.Set("phone", phone)
+	saga.Context.Set("passenger_indexes", passengerIndexes)
+	saga.Context.Set("refund_amount", amount)
+	saga.Context.Set("reason", reason)
 
 	return saga
 }
+
+// PassengerCancellationSagaSteps rebuilds a persisted passenger cancellation saga's steps
+func PassengerCancellationSagaSteps(deps *BookingDependencies) func(sagaCtx *SagaContext) ([]*Step, error) {
+	return func(sagaCtx *SagaContext) ([]*Step, error) {
+		return passengerCancellationSteps(deps), nil
+	}
+}
+
+func passengerCancellationSteps(deps *BookingDependencies) []*Step {
+	return []*Step{
+		{
+			Name: "cancel_passengers",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				var indexes []int
+				if !sagaCtx.Decode("passenger_indexes", &indexes) || len(indexes) == 0 {
+					return fmt.Errorf("no passengers to cancel")
+				}
+				return deps.InventoryService.CancelBookingPassengers(ctx, sagaCtx.GetString("booking_id"), sagaCtx.GetString("order_id"), indexes)
+			},
+			// Inventory skips passengers no longer on the booking
+			Retryable: true,
+		},
+		processRefundStep(deps),
+		{
+			Name: "send_cancellation_notification",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				return deps.NotificationSvc.SendBookingCancellation(ctx, sagaCtx.GetString("email"), sagaCtx.GetString("phone"),
+					sagaCtx.GetString("order_id"), "passengers cancelled")
+			},
+			Retryable: true,
+		},
+	}
+}
+
+// processRefundStep refunds the saga's refund_amount against its payment
+func processRefundStep(deps *BookingDependencies) *Step {
+	return &Step{
+		Name: "process_refund",
+		ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+			amount := sagaCtx.GetInt64("refund_amount")
+			if amount <= 0 || sagaCtx.GetString("payment_id") == "" {
+				return nil // The refund policy keeps the whole payment
+			}
+			refundID, err := deps.PaymentService.Refund(ctx, sagaCtx.GetString("payment_id"), amount)
+			if err != nil {
+				return err
+			}
+			sagaCtx.Set("refund_id", refundID)
+			return nil
+		},
+	}
+}
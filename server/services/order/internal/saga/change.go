@@ -0,0 +1,194 @@
+package saga
+
+import (
+	"context"
+	"fmt"
+)
+
+// ChangeRequest moves a confirmed order to new seats, on the same trip or on another
+// trip of its route
+type ChangeRequest struct {
+	OrderID      string
+	UserID       string
+	OrgID        string
+	OldBookingID string
+	OldPaymentID string // Refunded when the change costs less
+	TripID       string
+	HoldID       string // Hold the frontend placed on the new seats, if any
+	FromStation  string
+	ToStation    string
+	Passengers   []PassengerInfo
+	PaymentToken string
+	// Collected when positive, refunded against the order's payment when negative
+	AmountDuePaisa int64
+	Email          string
+	Phone          string
+}
+
+// booking is the booking of the new seats
+func (r *ChangeRequest) booking() *BookingRequest {
+	return &BookingRequest{
+		OrderID:     r.OrderID,
+		UserID:      r.UserID,
+		OrgID:       r.OrgID,
+		TripID:      r.TripID,
+		HoldID:      r.HoldID,
+		FromStation: r.FromStation,
+		ToStation:   r.ToStation,
+		Passengers:  r.Passengers,
+	}
+}
+
+// NewChangeSaga creates a saga swapping an order's booking for one on new seats.
+// Steps: HoldSeats -> CollectPayment -> ConfirmBooking -> CancelOldBooking -> RefundDifference -> SendNotification
+// The new booking is confirmed before the old one is cancelled. Cancelling the old booking
+// is the point of no return: a failure before it releases the new seats, refunds the charge
+// and leaves the order as it was, and nothing after it is undone.
+func NewChangeSaga(o *Orchestrator, deps *BookingDependencies, req *ChangeRequest) *Saga {
+	saga := o.CreateSaga(ChangeSagaName, changeSteps(deps, req))
+
+	persisted := *req
+	persisted.PaymentToken = "" // Kept in memory only, out of the saga store
+	saga.Context.Set("change_request", &persisted)
+	saga.Context.Set("order_id", req.OrderID)
+	saga.Context.Set("user_id", req.UserID)
+	saga.Context.Set("trip_id", req.TripID)
+	saga.Context.Set("hold_id", req.HoldID)
+	saga.Context.Set("org_id", req.OrgID)
+	saga.Context.Set("old_booking_id", req.OldBookingID)
+
+	return saga
+}
+
+// ChangeSagaSteps rebuilds a persisted change saga's steps from its context
+func ChangeSagaSteps(deps *BookingDependencies) func(sagaCtx *SagaContext) ([]*Step, error) {
+	return func(sagaCtx *SagaContext) ([]*Step, error) {
+		var req ChangeRequest
+		if !sagaCtx.Decode("change_request", &req) {
+			return nil, fmt.Errorf("change request missing from saga context")
+		}
+		return changeSteps(deps, &req), nil
+	}
+}
+
+// ChangeCommitted reports whether a change saga cancelled the old booking, after which
+// the order is on its new seats whatever happens to the remaining steps
+func ChangeCommitted(sagaCtx *SagaContext) bool {
+	done, _ := sagaCtx.Get("old_booking_cancelled")
+	return done == true
+}
+
+func changeSteps(deps *BookingDependencies, req *ChangeRequest) []*Step {
+	booking := req.booking()
+	return []*Step{
+		{
+			Name: "hold_seats",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				return deps.holdSeats(ctx, sagaCtx, booking)
+			},
+			CompensateFn: beforeChangeCommitted(deps.releaseSeats),
+			Retryable:    req.HoldID != "",
+		},
+		{
+			Name: "collect_payment",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				return deps.collectChangePayment(ctx, sagaCtx, req)
+			},
+			CompensateFn: beforeChangeCommitted(func(ctx context.Context, sagaCtx *SagaContext) error {
+				return deps.refundChangePayment(ctx, sagaCtx, req)
+			}),
+		},
+		{
+			Name: "confirm_booking",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				return deps.confirmBooking(ctx, sagaCtx, booking)
+			},
+			CompensateFn: beforeChangeCommitted(deps.cancelBooking),
+		},
+		{
+			Name: "cancel_old_booking",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				if err := deps.InventoryService.CancelBooking(ctx, req.OldBookingID, req.OrderID); err != nil {
+					return fmt.Errorf("failed to cancel old booking: %w", err)
+				}
+				sagaCtx.Set("old_booking_cancelled", true)
+				return nil
+			},
+			// Inventory ignores a booking that is already cancelled
+			Retryable: true,
+		},
+		{
+			Name: "refund_difference",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				return deps.refundChangeDifference(ctx, sagaCtx, req)
+			},
+		},
+		{
+			Name: "send_notification",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				// Best effort - the new tickets are sent once fulfillment reissues them
+				_ = deps.NotificationSvc.SendBookingConfirmation(ctx, req.Email, req.Phone, req.OrderID)
+				return nil
+			},
+			Retryable: true,
+		},
+	}
+}
+
+// beforeChangeCommitted skips a compensation once the old booking is gone: the new
+// seats, charge and booking are then the order's and must stay
+func beforeChangeCommitted(fn StepFunc) StepFunc {
+	return func(ctx context.Context, sagaCtx *SagaContext) error {
+		if ChangeCommitted(sagaCtx) {
+			return nil
+		}
+		return fn(ctx, sagaCtx)
+	}
+}
+
+// collectChangePayment charges the amount due when the change costs more
+func (d *BookingDependencies) collectChangePayment(ctx context.Context, sagaCtx *SagaContext, req *ChangeRequest) error {
+	if req.AmountDuePaisa <= 0 {
+		return nil
+	}
+
+	paymentID, err := d.PaymentService.Authorize(ctx, req.OrderID, req.OrgID, req.PaymentToken, req.AmountDuePaisa)
+	if err != nil {
+		return fmt.Errorf("payment authorization failed: %w", err)
+	}
+	sagaCtx.Set("payment_id", paymentID)
+
+	if err := d.PaymentService.Capture(ctx, paymentID); err != nil {
+		return fmt.Errorf("payment capture failed: %w", err)
+	}
+	sagaCtx.Set("payment_captured", true)
+	return nil
+}
+
+func (d *BookingDependencies) refundChangePayment(ctx context.Context, sagaCtx *SagaContext, req *ChangeRequest) error {
+	if captured, _ := sagaCtx.Get("payment_captured"); captured != true {
+		return nil
+	}
+	refundID, err := d.PaymentService.Refund(ctx, sagaCtx.GetString("payment_id"), req.AmountDuePaisa)
+	if err != nil {
+		return fmt.Errorf("refund failed: %w", err)
+	}
+	sagaCtx.Set("refund_id", refundID)
+	return nil
+}
+
+// refundChangeDifference refunds a cheaper change against the order's payment. The swap
+// is already done, so a failed refund is recorded for staff rather than failing the saga.
+func (d *BookingDependencies) refundChangeDifference(ctx context.Context, sagaCtx *SagaContext, req *ChangeRequest) error {
+	if req.AmountDuePaisa >= 0 || req.OldPaymentID == "" {
+		return nil
+	}
+	refundID, err := d.PaymentService.Refund(ctx, req.OldPaymentID, -req.AmountDuePaisa)
+	if err != nil {
+		fmt.Printf("Warning: Refund of change difference failed for order %s: %v\n", req.OrderID, err)
+		sagaCtx.Set("refund_error", err.Error())
+		return nil
+	}
+	sagaCtx.Set("refund_id", refundID)
+	return nil
+}
@@ -0,0 +1,42 @@
+package saga
+
+import (
+	"context"
+	"fmt"
+)
+
+// PaymentMethodAccount charges a booking to a corporate account's credit, settled later
+// by invoice, so account bookings skip the payment gateway too
+const PaymentMethodAccount = "account"
+
+// CreditLedger charges bookings to corporate accounts
+type CreditLedger interface {
+	// ReserveCredit charges the order to the account if it fits within the account's
+	// available credit; charging an order twice charges it once
+	ReserveCredit(ctx context.Context, accountID, orderID string, amountPaisa int64) error
+	// ReleaseCredit drops the order's charge and gives the credit back
+	ReleaseCredit(ctx context.Context, accountID, orderID string) error
+}
+
+func (d *BookingDependencies) chargeAccount(ctx context.Context, sagaCtx *SagaContext, req *BookingRequest) error {
+	if d.CreditLedger == nil {
+		return fmt.Errorf("account charge failed: no credit ledger configured")
+	}
+	// Set first, so a charge that went through before an error is still released
+	sagaCtx.Set("charged_account_id", req.AccountID)
+	if err := d.CreditLedger.ReserveCredit(ctx, req.AccountID, req.OrderID, req.TotalPaisa); err != nil {
+		return fmt.Errorf("account charge failed: %w", err)
+	}
+	return nil
+}
+
+func (d *BookingDependencies) releaseAccountCharge(ctx context.Context, sagaCtx *SagaContext) error {
+	accountID := sagaCtx.GetString("charged_account_id")
+	if accountID == "" || d.CreditLedger == nil {
+		return nil
+	}
+	if err := d.CreditLedger.ReleaseCredit(ctx, accountID, sagaCtx.GetString("order_id")); err != nil {
+		return fmt.Errorf("credit release failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ReviewHoldExtension is how long a hold is kept while a booking waits for a fraud
+// analyst, so the seats are still there when it is approved. Review holds are kept
+// outside the inventory's hold policy, whose lifetime cap is far shorter.
+const ReviewHoldExtension = 2 * time.Hour
+
+// Fraud risk levels, as scored by the fraud service
+const (
+	RiskLevelLow      = "low"
+	RiskLevelMedium   = "medium"
+	RiskLevelHigh     = "high"
+	RiskLevelCritical = "critical"
+)
+
+// ErrBookingRejected fails a booking the fraud check scored as high risk
+var ErrBookingRejected = errors.New("booking rejected by fraud check")
+
+// FraudClient scores a booking for fraud
+type FraudClient interface {
+	AnalyzeBooking(ctx context.Context, req *FraudCheckRequest) (*FraudAssessment, error)
+}
+
+// FraudCheckRequest is what a booking is scored on
+type FraudCheckRequest struct {
+	OrderID           string
+	OrgID             string
+	UserID            string
+	TripID            string
+	PassengerNIDs     []string
+	PassengerNames    []string
+	IPAddress         string
+	UserAgent         string
+	DeviceFingerprint string
+	PaymentMethod     string
+	AmountPaisa       int64
+}
+
+// FraudAssessment is the fraud service's verdict on a booking
+type FraudAssessment struct {
+	RiskScore   int      `json:"risk_score"` // 0-100
+	RiskLevel   string   `json:"risk_level"`
+	ShouldBlock bool     `json:"should_block"`
+	Summary     string   `json:"summary"`
+	RiskFactors []string `json:"risk_factors,omitempty"`
+}
+
+// NeedsReview reports whether the booking goes to an analyst before it is paid for
+func (a *FraudAssessment) NeedsReview() bool {
+	return !a.Rejected() && a.RiskLevel == RiskLevelMedium
+}
+
+// Rejected reports whether the booking is refused outright
+func (a *FraudAssessment) Rejected() bool {
+	return a.ShouldBlock || a.RiskLevel == RiskLevelHigh || a.RiskLevel == RiskLevelCritical
+}
+
+// checkFraud scores the booking before it is paid for. High-risk bookings fail the saga,
+// which releases their seats; medium-risk ones keep their holds and suspend the saga
+// until an analyst approves or rejects them. A booking whose seats cannot be kept for the
+// review fails instead of waiting on seats it no longer holds. An unavailable fraud service lets the booking
+// through, as the fraud service itself does when its model is down.
+func (d *BookingDependencies) checkFraud(ctx context.Context, sagaCtx *SagaContext, req *BookingRequest) error {
+	if d.FraudService == nil {
+		return nil
+	}
+
+	var assessment FraudAssessment
+	if !sagaCtx.Decode("fraud_assessment", &assessment) {
+		check := &FraudCheckRequest{
+			OrderID:           req.OrderID,
+			OrgID:             req.OrgID,
+			UserID:            req.UserID,
+			TripID:            req.TripID,
+			IPAddress:         req.IPAddress,
+			UserAgent:         req.UserAgent,
+			DeviceFingerprint: req.DeviceFingerprint,
+			PaymentMethod:     req.PaymentMethod,
+			AmountPaisa:       req.TotalPaisa,
+		}
+		for _, leg := range req.AllLegs() {
+			for _, p := range leg.Passengers {
+				check.PassengerNIDs = append(check.PassengerNIDs, p.NID)
+				check.PassengerNames = append(check.PassengerNames, p.Name)
+			}
+		}
+
+		result, err := d.FraudService.AnalyzeBooking(ctx, check)
+		if err != nil {
+			fmt.Printf("Warning: Fraud check failed for order %s, letting it through: %v\n", req.OrderID, err)
+			sagaCtx.Set("fraud_check_error", err.Error())
+			return nil
+		}
+		assessment = *result
+		sagaCtx.Set("fraud_assessment", assessment)
+	}
+
+	switch {
+	case assessment.Rejected():
+		return fmt.Errorf("%w: risk score %d (%s)", ErrBookingRejected, assessment.RiskScore, assessment.RiskLevel)
+	case assessment.NeedsReview():
+		if err := d.keepHoldsForReview(ctx, sagaCtx, req, ReviewHoldExtension); err != nil {
+			return fmt.Errorf("seats cannot be held for fraud review: %w", err)
+		}
+		sagaCtx.Set("held_for_review", true)
+		return Suspend("fraud review")
+	}
+	return nil
+}
+
+// heldForReview reports whether the booking's holds were kept for a fraud review
+func heldForReview(sagaCtx *SagaContext) bool {
+	held, _ := sagaCtx.Get("held_for_review")
+	return held == true
+}
+
+// keepHoldsForReview keeps every leg's hold until keepFor from now, recording when the
+// first of them lapses
+func (d *BookingDependencies) keepHoldsForReview(ctx context.Context, sagaCtx *SagaContext, req *BookingRequest, keepFor time.Duration) error {
+	var earliest time.Time
+	for _, holdID := range heldLegs(sagaCtx) {
+		expiresAt, err := d.InventoryService.ExtendHoldForReview(ctx, req.OrgID, holdID, req.UserID, keepFor)
+		if err != nil {
+			return fmt.Errorf("hold %s: %w", holdID, err)
+		}
+		if earliest.IsZero() || expiresAt.Before(earliest) {
+			earliest = expiresAt
+		}
+	}
+	if !earliest.IsZero() {
+		sagaCtx.Set("hold_expires_at", earliest.Unix())
+	}
+	return nil
+}
@@ -1,51 +1,88 @@
 package saga
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
 	"github.com/MuhibNayem/Travio/server/services/order/internal/messaging"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+const (
+	// LeaseDuration is how long a saga stays owned by an instance without a heartbeat
+	LeaseDuration  = 30 * time.Second
+	leaseHeartbeat = LeaseDuration / 3
+)
+
 // Orchestrator implements the Saga Orchestration pattern
 // It coordinates distributed transactions across multiple services
-// and handles compensating transactions on failures
+// and handles compensating transactions on failures.
+// With a database, every saga's context and step states are persisted after each
+// change, so sagas interrupted by a restart can be resumed or compensated (see Recover).
 type Orchestrator struct {
-	mu        sync.Mutex
-	sagas     map[string]*Saga
-	listeners []StatusListener
-	db        *gorm.DB
-	dlq       messaging.DLQProducer
+	mu          sync.Mutex
+	sagas       map[string]*Saga
+	listeners   []StatusListener
+	definitions map[string]Definition
+	db          *gorm.DB
+	dlq         messaging.DLQProducer
+	owner       string // Identifies this instance on the sagas it leases
 }
 
 func NewOrchestrator(db *gorm.DB, dlq messaging.DLQProducer) *Orchestrator {
 	// AutoMigrate the schema
 	if db != nil {
-		_ = db.AutoMigrate(&SagaInstance{})
+		if err := db.AutoMigrate(&SagaInstance{}); err != nil {
+			logger.Error("Failed to migrate saga instances", "error", err)
+		}
 	}
 	return &Orchestrator{
-		sagas: make(map[string]*Saga),
-		db:    db,
-		dlq:   dlq,
+		sagas:       make(map[string]*Saga),
+		definitions: make(map[string]Definition),
+		db:          db,
+		dlq:         dlq,
+		owner:       uuid.New().String(),
 	}
 }
 
 // SagaInstance represents the persistent state of a saga
 type SagaInstance struct {
-	ID            string `gorm:"primaryKey"`
-	Name          string `gorm:"index"`
-	Status        string `gorm:"index"`
-	CurrentStep   int
-	Payload       []byte `gorm:"type:jsonb"` // Ensure Postgres support or text
-	FailureReason string
-	StartedAt     time.Time
-	CompletedAt   time.Time
-	UpdatedAt     time.Time
+	ID             string `gorm:"primaryKey"`
+	Name           string `gorm:"index"`
+	Status         string `gorm:"index"`
+	OrganizationID string `gorm:"index"`
+	CurrentStep    int
+	Payload        []byte `gorm:"type:jsonb"` // SagaContext
+	Steps          []byte `gorm:"type:jsonb"` // Step states, in order
+	FailureReason  string
+	Owner          string    // Instance holding the lease, empty once the saga has finished
+	LeaseUntil     time.Time `gorm:"index"`
+	StartedAt      time.Time
+	CompletedAt    time.Time
+	UpdatedAt      time.Time
+}
+
+// Definition rebuilds a saga's steps from its persisted context, so the saga can be
+// resumed by another process. OnFinish, if set, is called when a recovered or retried
+// saga finishes, with the error Execute would have returned.
+type Definition struct {
+	Build    func(sagaCtx *SagaContext) ([]*Step, error)
+	OnFinish func(ctx context.Context, saga *Saga, err error)
+}
+
+// RegisterDefinition makes sagas with the given name recoverable
+func (o *Orchestrator) RegisterDefinition(name string, def Definition) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.definitions[name] = def
 }
 
 // Saga represents a distributed transaction
@@ -59,7 +96,11 @@ type Saga struct {
 	StartedAt     time.Time    `json:"started_at"`
 	CompletedAt   time.Time    `json:"completed_at,omitempty"`
 	FailureReason string       `json:"failure_reason,omitempty"`
+	Owner         string       `json:"owner,omitempty"` // Instance running the saga, as last persisted
+	LeaseUntil    time.Time    `json:"lease_until,omitempty"`
+	UpdatedAt     time.Time    `json:"updated_at,omitempty"`
 	mu            sync.Mutex
+	leaseLost     atomic.Bool // Another instance took the saga over
 }
 
 // Status represents the saga lifecycle state
@@ -72,8 +113,21 @@ const (
 	StatusCompensating Status = "compensating"
 	StatusCompensated  Status = "compensated"
 	StatusFailed       Status = "failed"
+	StatusInterrupted  Status = "interrupted" // Step cut short by a restart; its effect is unknown
+	StatusResolved     Status = "resolved"    // Closed by operations staff after fixing it by hand
+	StatusSuspended    Status = "suspended"   // Paused by a step until it is resumed or aborted (see Suspend)
 )
 
+// Finished reports whether a saga in this status has stopped running
+func (s Status) Finished() bool {
+	return s == StatusCompleted || s == StatusCompensated || s == StatusFailed || s == StatusResolved
+}
+
+// leased reports whether a saga in this status is held by the instance running it
+func (s Status) leased() bool {
+	return !s.Finished() && s != StatusSuspended
+}
+
 // Step represents a single step in the saga
 type Step struct {
 	Name         string    `json:"name"`
@@ -84,15 +138,34 @@ type Step struct {
 	Compensated  bool      `json:"compensated"`
 	ExecuteFn    StepFunc  `json:"-"`
 	CompensateFn StepFunc  `json:"-"`
+	// Retryable steps are safe to run again when a restart interrupted them.
+	// An interrupted step that is not retryable fails the saga, which is then compensated.
+	Retryable bool `json:"-"`
 }
 
 // StepFunc is the function signature for step execution
 type StepFunc func(ctx context.Context, sagaCtx *SagaContext) error
 
+// referenceKeys are the context values that identify what a saga touched
+var referenceKeys = []string{"order_id", "user_id", "org_id", "trip_id", "hold_id", "payment_id", "booking_id", "refund_id"}
+
+// References returns the IDs recorded in the saga's context (order, payment, booking, ...),
+// which is what staff need to look a stuck saga up in the other services
+func (s *Saga) References() map[string]string {
+	refs := make(map[string]string)
+	for _, key := range referenceKeys {
+		if v := s.Context.GetString(key); v != "" {
+			refs[key] = v
+		}
+	}
+	return refs
+}
+
 // SagaContext holds data shared across saga steps
 type SagaContext struct {
-	data map[string]interface{}
-	mu   sync.RWMutex
+	data     map[string]interface{}
+	mu       sync.RWMutex
+	onChange func() // Persists the context while a step runs
 }
 
 func NewSagaContext() *SagaContext {
@@ -101,10 +174,23 @@ func NewSagaContext() *SagaContext {
 	}
 }
 
+// Set stores a value. While the saga runs the context is persisted on every change, so an
+// ID recorded mid-step (e.g. a payment authorization) survives a restart for compensation.
 func (c *SagaContext) Set(key string, value interface{}) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
 	c.data[key] = value
+	onChange := c.onChange
+	c.mu.Unlock()
+
+	if onChange != nil {
+		onChange()
+	}
+}
+
+func (c *SagaContext) setOnChange(fn func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onChange = fn
 }
 
 func (c *SagaContext) Get(key string) (interface{}, bool) {
@@ -128,8 +214,47 @@ func (c *SagaContext) GetInt64(key string) int64 {
 	if !ok {
 		return 0
 	}
-	i, _ := v.(int64)
-	return i
+	switch i := v.(type) {
+	case int64:
+		return i
+	case json.Number: // Restored from the database
+		n, _ := i.Int64()
+		return n
+	}
+	return 0
+}
+
+// Decode copies a value into out, e.g. a struct or slice. Values restored from the
+// database are generic JSON, so typed values should be read back this way.
+func (c *SagaContext) Decode(key string, out interface{}) bool {
+	v, ok := c.Get(key)
+	if !ok {
+		return false
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(raw, out) == nil
+}
+
+func (c *SagaContext) MarshalJSON() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return json.Marshal(c.data)
+}
+
+func (c *SagaContext) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	data := make(map[string]interface{})
+	if err := dec.Decode(&data); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data = data
+	return nil
 }
 
 // StatusListener receives saga status updates
@@ -174,38 +299,48 @@ func (o *Orchestrator) CreateSaga(name string, steps []*Step) *Saga {
 func (o *Orchestrator) Execute(ctx context.Context, saga *Saga) error {
 	saga.mu.Lock()
 	saga.Status = StatusRunning
+	saga.CurrentStep = -1
 	saga.mu.Unlock()
 	o.notify(saga, nil, "saga_started")
 
-	// Persist initial state
+	// Persist initial state; the saga is leased to this instance until it finishes
 	if o.db != nil {
-		instance := SagaInstance{
-			ID:          saga.ID,
-			Name:        saga.Name,
-			Status:      string(saga.Status),
-			CurrentStep: -1,
-			StartedAt:   saga.StartedAt,
+		instance, err := o.snapshot(saga)
+		if err == nil {
+			instance.Owner = o.owner
+			instance.LeaseUntil = time.Now().Add(LeaseDuration)
+			err = o.db.WithContext(ctx).Create(instance).Error
+		}
+		if err != nil {
+			logger.Error("Failed to persist saga, it cannot be recovered", "saga_id", saga.ID, "error", err)
 		}
-		o.db.Create(&instance)
 	}
 
-	for i, step := range saga.Steps {
+	return o.withLease(saga, func() error {
+		return o.run(ctx, saga, 0)
+	})
+}
+
+// run executes the steps from index from onwards, compensating when one fails
+func (o *Orchestrator) run(ctx context.Context, saga *Saga, from int) error {
+	saga.Context.setOnChange(func() { o.persist(saga) })
+	defer saga.Context.setOnChange(nil)
+
+	for i := from; i < len(saga.Steps); i++ {
+		if saga.leaseLost.Load() {
+			return ErrSagaLeaseLost
+		}
+		step := saga.Steps[i]
+
 		saga.mu.Lock()
+		saga.Status = StatusRunning
 		saga.CurrentStep = i
 		step.Status = StatusRunning
 		step.StartedAt = time.Now()
 		saga.mu.Unlock()
 
 		o.notify(saga, step, "step_started")
-
-		// Persist Step Update
-		if o.db != nil {
-			o.db.Model(&SagaInstance{}).Where("id = ?", saga.ID).Updates(map[string]interface{}{
-				"current_step": i,
-				"status":       "running",
-				"updated_at":   time.Now(),
-			})
-		}
+		o.persist(saga)
 
 		// Execute the step
 		err := step.ExecuteFn(ctx, saga.Context)
@@ -213,6 +348,20 @@ func (o *Orchestrator) Execute(ctx context.Context, saga *Saga) error {
 		saga.mu.Lock()
 		step.CompletedAt = time.Now()
 
+		var susp *suspension
+		if errors.As(err, &susp) {
+			step.Status = StatusCompleted
+			saga.Status = StatusSuspended
+			saga.mu.Unlock()
+			saga.Context.Set("suspended_reason", susp.reason)
+
+			o.notify(saga, step, "step_completed")
+			o.notify(saga, nil, "saga_suspended")
+			o.persist(saga)
+			o.forget(saga)
+			return ErrSagaSuspended
+		}
+
 		if err != nil {
 			step.Status = StatusFailed
 			step.Error = err.Error()
@@ -228,6 +377,7 @@ func (o *Orchestrator) Execute(ctx context.Context, saga *Saga) error {
 		step.Status = StatusCompleted
 		saga.mu.Unlock()
 		o.notify(saga, step, "step_completed")
+		o.persist(saga)
 	}
 
 	// All steps completed successfully
@@ -236,41 +386,35 @@ func (o *Orchestrator) Execute(ctx context.Context, saga *Saga) error {
 	saga.CompletedAt = time.Now()
 	saga.mu.Unlock()
 	o.notify(saga, nil, "saga_completed")
-
-	if o.db != nil {
-		o.db.Model(&SagaInstance{}).Where("id = ?", saga.ID).Updates(map[string]interface{}{
-			"status":       "completed",
-			"completed_at": time.Now(),
-			"updated_at":   time.Now(),
-		})
-	}
+	o.persist(saga)
+	o.forget(saga)
 
 	return nil
 }
 
-// compensate runs compensation for all completed steps in reverse order
-func (o *Orchestrator) compensate(ctx context.Context, saga *Saga, failedStepIdx int) error {
+// compensate undoes, in reverse order, every step up to fromIdx that completed or was
+// interrupted by a restart and has not been compensated yet
+func (o *Orchestrator) compensate(ctx context.Context, saga *Saga, fromIdx int) error {
 	saga.mu.Lock()
 	saga.Status = StatusCompensating
+	saga.CurrentStep = fromIdx
 	saga.mu.Unlock()
 	o.notify(saga, nil, "compensation_started")
-
-	if o.db != nil {
-		o.db.Model(&SagaInstance{}).Where("id = ?", saga.ID).Updates(map[string]interface{}{
-			"status":         "compensating",
-			"updated_at":     time.Now(),
-			"failure_reason": saga.FailureReason,
-		})
-	}
+	o.persist(saga)
 
 	var compensationErrors []error
 
-	// Compensate in reverse order, starting from the step before the failed one
-	for i := failedStepIdx - 1; i >= 0; i-- {
+	for i := fromIdx; i >= 0; i-- {
+		if saga.leaseLost.Load() {
+			return ErrSagaLeaseLost
+		}
 		step := saga.Steps[i]
 
-		if step.CompensateFn == nil {
-			continue // No compensation defined
+		if step.CompensateFn == nil || step.Compensated {
+			continue // No compensation defined, or already done
+		}
+		if step.Status != StatusCompleted && step.Status != StatusInterrupted {
+			continue // Failed or never ran
 		}
 
 		o.notify(saga, step, "compensating_step")
@@ -283,9 +427,8 @@ func (o *Orchestrator) compensate(ctx context.Context, saga *Saga, failedStepIdx
 
 			// Publish to DLQ if compensation fails (unrecoverable error)
 			if o.dlq != nil {
-				// Best effort publish
-				go o.dlq.PublishError(saga.ID, step.Name, err.Error(), nil)
-				// Pass `nil` payload for now to avoid data race on saga.Context.data
+				// Best effort publish; the payload is built here so it does not race the saga
+				go o.dlq.PublishError(saga.ID, step.Name, err.Error(), failureContext(saga, i))
 			}
 
 			// Continue compensating other steps even if one fails
@@ -296,6 +439,7 @@ func (o *Orchestrator) compensate(ctx context.Context, saga *Saga, failedStepIdx
 		saga.mu.Unlock()
 
 		o.notify(saga, step, "step_compensated")
+		o.persist(saga)
 	}
 
 	saga.mu.Lock()
@@ -309,18 +453,8 @@ func (o *Orchestrator) compensate(ctx context.Context, saga *Saga, failedStepIdx
 	saga.mu.Unlock()
 
 	o.notify(saga, nil, "saga_compensated")
-
-	if o.db != nil {
-		finalStatus := "compensated"
-		if len(compensationErrors) > 0 {
-			finalStatus = "failed"
-		}
-		o.db.Model(&SagaInstance{}).Where("id = ?", saga.ID).Updates(map[string]interface{}{
-			"status":       finalStatus,
-			"completed_at": time.Now(),
-			"updated_at":   time.Now(),
-		})
-	}
+	o.persist(saga)
+	o.forget(saga)
 
 	if len(compensationErrors) > 0 {
 		return errors.Join(compensationErrors...)
@@ -329,17 +463,50 @@ func (o *Orchestrator) compensate(ctx context.Context, saga *Saga, failedStepIdx
 	return errors.New(saga.FailureReason)
 }
 
-// GetSaga retrieves a saga by ID
-func (o *Orchestrator) GetSaga(id string) (*Saga, bool) {
+// failureContext describes a saga for the DLQ. Called with saga.mu held.
+func failureContext(saga *Saga, stepIdx int) messaging.SagaFailure {
+	steps := make([]messaging.SagaStepState, 0, len(saga.Steps))
+	for _, step := range saga.Steps {
+		steps = append(steps, messaging.SagaStepState{
+			Name:        step.Name,
+			Status:      string(step.Status),
+			Error:       step.Error,
+			Compensated: step.Compensated,
+		})
+	}
+	return messaging.SagaFailure{
+		SagaName:       saga.Name,
+		OrganizationID: saga.Context.GetString("org_id"),
+		StepIndex:      stepIdx,
+		FailureReason:  saga.FailureReason,
+		References:     saga.References(),
+		Steps:          steps,
+	}
+}
+
+// GetSaga retrieves a saga by ID, from memory while this instance runs it or from the database
+func (o *Orchestrator) GetSaga(ctx context.Context, id string) (*Saga, bool) {
 	o.mu.Lock()
-	defer o.mu.Unlock()
 	saga, ok := o.sagas[id]
-	return saga, ok
+	o.mu.Unlock()
+	if ok || o.db == nil {
+		return saga, ok
+	}
+
+	saga, err := o.load(ctx, id)
+	if err != nil {
+		if !errors.Is(err, ErrSagaNotFound) {
+			logger.Warn("Failed to load saga", "saga_id", id, "error", err)
+		}
+		return nil, false
+	}
+	return saga, true
 }
 
-// Retry attempts to resume a failed saga
+// Retry attempts to resume a failed saga from its first step that is not in effect.
+// Steps undone by compensation run again.
 func (o *Orchestrator) Retry(ctx context.Context, sagaID string) error {
-	saga, ok := o.GetSaga(sagaID)
+	saga, ok := o.GetSaga(ctx, sagaID)
 	if !ok {
 		return ErrSagaNotFound
 	}
@@ -348,21 +515,287 @@ func (o *Orchestrator) Retry(ctx context.Context, sagaID string) error {
 		return ErrSagaNotRetryable
 	}
 
-	// Reset from the failed step
+	if o.db != nil {
+		if !o.claim(ctx, saga.ID, string(saga.Status)) {
+			return ErrSagaLeased
+		}
+		var err error
+		if saga, err = o.load(ctx, sagaID); err != nil {
+			o.release(sagaID)
+			return err
+		}
+	}
+	for _, step := range saga.Steps {
+		if step.ExecuteFn == nil {
+			o.release(saga.ID)
+			return ErrSagaNotRecoverable
+		}
+	}
+
+	from := firstNotInEffect(saga)
+	o.resetFrom(saga, from)
+
+	err := o.withLease(saga, func() error {
+		return o.run(ctx, saga, from)
+	})
+	o.finish(ctx, saga, err)
+	return err
+}
+
+// firstNotInEffect returns the index of the first step that has not completed
+func firstNotInEffect(saga *Saga) int {
 	saga.mu.Lock()
-	for i := saga.CurrentStep; i < len(saga.Steps); i++ {
+	defer saga.mu.Unlock()
+	from := 0
+	for from < len(saga.Steps) && saga.Steps[from].Status == StatusCompleted {
+		from++
+	}
+	return from
+}
+
+// resetFrom marks the steps from index from onwards as not yet run, ready for run to execute them again
+func (o *Orchestrator) resetFrom(saga *Saga, from int) {
+	saga.mu.Lock()
+	for i := from; i < len(saga.Steps); i++ {
 		saga.Steps[i].Status = StatusPending
 		saga.Steps[i].Error = ""
 		saga.Steps[i].Compensated = false
 	}
 	saga.Status = StatusPending
 	saga.FailureReason = ""
+	saga.CompletedAt = time.Time{}
 	saga.mu.Unlock()
 
-	return o.Execute(ctx, saga)
+	o.mu.Lock()
+	o.sagas[saga.ID] = saga
+	o.mu.Unlock()
+}
+
+// --- Persistence ---
+
+// snapshot captures the persistent state of a saga
+func (o *Orchestrator) snapshot(saga *Saga) (*SagaInstance, error) {
+	saga.mu.Lock()
+	defer saga.mu.Unlock()
+
+	payload, err := json.Marshal(saga.Context)
+	if err != nil {
+		return nil, fmt.Errorf("marshal saga context: %w", err)
+	}
+	steps, err := json.Marshal(saga.Steps)
+	if err != nil {
+		return nil, fmt.Errorf("marshal saga steps: %w", err)
+	}
+	return &SagaInstance{
+		ID:             saga.ID,
+		Name:           saga.Name,
+		Status:         string(saga.Status),
+		OrganizationID: saga.Context.GetString("org_id"),
+		CurrentStep:    saga.CurrentStep,
+		Payload:        payload,
+		Steps:          steps,
+		FailureReason:  saga.FailureReason,
+		StartedAt:      saga.StartedAt,
+		CompletedAt:    saga.CompletedAt,
+		UpdatedAt:      time.Now(),
+	}, nil
+}
+
+// persist writes the saga's state, renewing this instance's lease, or releasing it once the saga has finished.
+// A write that matches no row means another instance took the saga over, so this one stops driving it.
+func (o *Orchestrator) persist(saga *Saga) {
+	if o.db == nil {
+		return
+	}
+
+	instance, err := o.snapshot(saga)
+	if err != nil {
+		logger.Error("Failed to persist saga", "saga_id", saga.ID, "error", err)
+		return
+	}
+
+	updates := map[string]interface{}{
+		"status":         instance.Status,
+		"current_step":   instance.CurrentStep,
+		"payload":        instance.Payload,
+		"steps":          instance.Steps,
+		"failure_reason": instance.FailureReason,
+		"completed_at":   instance.CompletedAt,
+		"updated_at":     instance.UpdatedAt,
+		"lease_until":    time.Now().Add(LeaseDuration),
+	}
+	if !Status(instance.Status).leased() {
+		updates["owner"] = ""
+		updates["lease_until"] = time.Time{}
+	}
+
+	res := o.db.Model(&SagaInstance{}).Where("id = ? AND owner = ?", saga.ID, o.owner).Updates(updates)
+	if res.Error != nil {
+		logger.Error("Failed to persist saga", "saga_id", saga.ID, "error", res.Error)
+		return
+	}
+	if res.RowsAffected == 0 {
+		saga.leaseLost.Store(true)
+	}
+}
+
+// load rebuilds a saga from the database. Without a registered definition the steps carry
+// their state but cannot be executed.
+func (o *Orchestrator) load(ctx context.Context, id string) (*Saga, error) {
+	var instance SagaInstance
+	if err := o.db.WithContext(ctx).Where("id = ?", id).First(&instance).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSagaNotFound
+		}
+		return nil, err
+	}
+	return o.restore(&instance)
+}
+
+// restore turns a persisted instance back into a saga that can be run
+func (o *Orchestrator) restore(instance *SagaInstance) (*Saga, error) {
+	saga, err := decode(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	o.mu.Lock()
+	def, ok := o.definitions[instance.Name]
+	o.mu.Unlock()
+	if !ok {
+		return saga, nil
+	}
+
+	built, err := def.Build(saga.Context)
+	if err != nil {
+		return nil, fmt.Errorf("rebuild %s saga: %w", instance.Name, err)
+	}
+	if len(built) != len(saga.Steps) {
+		return nil, fmt.Errorf("rebuild %s saga: %d steps persisted, %d defined", instance.Name, len(saga.Steps), len(built))
+	}
+	for i, step := range built {
+		state := saga.Steps[i]
+		if step.Name != state.Name {
+			return nil, fmt.Errorf("rebuild %s saga: step %d is %q, persisted %q", instance.Name, i, step.Name, state.Name)
+		}
+		step.Status = state.Status
+		step.Error = state.Error
+		step.StartedAt = state.StartedAt
+		step.CompletedAt = state.CompletedAt
+		step.Compensated = state.Compensated
+	}
+	saga.Steps = built
+	return saga, nil
+}
+
+// decode reads a persisted instance's state. Its steps cannot be executed.
+func decode(instance *SagaInstance) (*Saga, error) {
+	sagaCtx := NewSagaContext()
+	if len(instance.Payload) > 0 {
+		if err := json.Unmarshal(instance.Payload, sagaCtx); err != nil {
+			return nil, fmt.Errorf("unmarshal saga context: %w", err)
+		}
+	}
+	var states []*Step
+	if len(instance.Steps) > 0 {
+		if err := json.Unmarshal(instance.Steps, &states); err != nil {
+			return nil, fmt.Errorf("unmarshal saga steps: %w", err)
+		}
+	}
+
+	return &Saga{
+		ID:            instance.ID,
+		Name:          instance.Name,
+		Status:        Status(instance.Status),
+		CurrentStep:   instance.CurrentStep,
+		Steps:         states,
+		Context:       sagaCtx,
+		StartedAt:     instance.StartedAt,
+		CompletedAt:   instance.CompletedAt,
+		FailureReason: instance.FailureReason,
+		Owner:         instance.Owner,
+		LeaseUntil:    instance.LeaseUntil,
+		UpdatedAt:     instance.UpdatedAt,
+	}, nil
+}
+
+// forget drops a finished saga from memory once it is persisted
+func (o *Orchestrator) forget(saga *Saga) {
+	if o.db == nil {
+		return
+	}
+	o.mu.Lock()
+	delete(o.sagas, saga.ID)
+	o.mu.Unlock()
+}
+
+// --- Leases ---
+
+// claim takes the lease on a saga that no instance holds, or whose holder stopped renewing it
+func (o *Orchestrator) claim(ctx context.Context, sagaID, status string) bool {
+	now := time.Now()
+	res := o.db.WithContext(ctx).Model(&SagaInstance{}).
+		Where("id = ? AND status = ? AND (owner = '' OR owner IS NULL OR lease_until IS NULL OR lease_until < ?)", sagaID, status, now).
+		Updates(map[string]interface{}{
+			"owner":       o.owner,
+			"lease_until": now.Add(LeaseDuration),
+		})
+	if res.Error != nil {
+		logger.Warn("Failed to claim saga", "saga_id", sagaID, "error", res.Error)
+		return false
+	}
+	return res.RowsAffected == 1
+}
+
+// release gives up this instance's lease on a saga it claimed but will not run
+func (o *Orchestrator) release(sagaID string) {
+	if o.db == nil {
+		return
+	}
+	res := o.db.Model(&SagaInstance{}).Where("id = ? AND owner = ?", sagaID, o.owner).
+		Updates(map[string]interface{}{"owner": "", "lease_until": time.Time{}})
+	if res.Error != nil {
+		logger.Warn("Failed to release saga lease", "saga_id", sagaID, "error", res.Error)
+	}
+}
+
+// withLease runs fn while renewing this instance's lease on the saga in the background
+func (o *Orchestrator) withLease(saga *Saga, fn func() error) error {
+	if o.db == nil {
+		return fn()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(leaseHeartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				res := o.db.Model(&SagaInstance{}).Where("id = ? AND owner = ?", saga.ID, o.owner).
+					Update("lease_until", time.Now().Add(LeaseDuration))
+				if res.Error != nil {
+					logger.Warn("Failed to renew saga lease", "saga_id", saga.ID, "error", res.Error)
+					continue
+				}
+				if res.RowsAffected == 0 {
+					saga.leaseLost.Store(true)
+					return
+				}
+			}
+		}
+	}()
+	defer close(done)
+
+	return fn()
 }
 
 var (
-	ErrSagaNotFound     = errors.New("saga not found")
-	ErrSagaNotRetryable = errors.New("saga is not in a retryable state")
+	ErrSagaNotFound       = errors.New("saga not found")
+	ErrSagaNotRetryable   = errors.New("saga is not in a retryable state")
+	ErrSagaNotRecoverable = errors.New("saga has no registered definition")
+	ErrSagaLeased         = errors.New("saga is being run by another instance")
+	ErrSagaLeaseLost      = errors.New("saga lease lost to another instance")
 )
@@ -0,0 +1,121 @@
+package saga
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+)
+
+// RecoverLoop recovers unfinished sagas now and then every interval, picking up sagas
+// abandoned by other instances once their lease runs out
+func (o *Orchestrator) RecoverLoop(ctx context.Context, interval time.Duration) {
+	if o.db == nil {
+		return
+	}
+	o.Recover(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			o.Recover(ctx)
+		}
+	}
+}
+
+// Recover resumes or compensates sagas that no live instance is running: those still
+// pending, running or compensating whose lease has run out. Each is claimed before it
+// is touched, so only one instance re-executes it. Returns the number of sagas claimed.
+func (o *Orchestrator) Recover(ctx context.Context) int {
+	if o.db == nil {
+		return 0
+	}
+
+	var instances []SagaInstance
+	err := o.db.WithContext(ctx).
+		Where("status IN ? AND (lease_until IS NULL OR lease_until < ?)",
+			[]string{string(StatusPending), string(StatusRunning), string(StatusCompensating)}, time.Now()).
+		Find(&instances).Error
+	if err != nil {
+		logger.Error("Failed to list unfinished sagas", "error", err)
+		return 0
+	}
+
+	claimed := 0
+	for i := range instances {
+		instance := &instances[i]
+		o.mu.Lock()
+		_, ok := o.definitions[instance.Name]
+		o.mu.Unlock()
+		if !ok {
+			continue
+		}
+		if !o.claim(ctx, instance.ID, instance.Status) {
+			continue
+		}
+		claimed++
+
+		saga, err := o.load(ctx, instance.ID)
+		if err != nil {
+			logger.Error("Failed to restore saga", "saga_id", instance.ID, "error", err)
+			continue
+		}
+		o.mu.Lock()
+		o.sagas[saga.ID] = saga
+		o.mu.Unlock()
+
+		logger.Info("Recovering saga", "saga_id", saga.ID, "name", saga.Name, "status", saga.Status, "step", saga.CurrentStep)
+		o.runDetached(saga, func(ctx context.Context) error {
+			return o.resume(ctx, saga)
+		})
+	}
+	return claimed
+}
+
+// resume continues a restored saga where it stopped. A step cut short by the restart is
+// run again if it is retryable; otherwise it is marked interrupted and the saga compensated.
+func (o *Orchestrator) resume(ctx context.Context, saga *Saga) error {
+	if saga.Status == StatusCompensating {
+		return o.compensate(ctx, saga, saga.CurrentStep)
+	}
+
+	i := saga.CurrentStep
+	if i < 0 {
+		return o.run(ctx, saga, 0)
+	}
+	step := saga.Steps[i]
+	switch {
+	case step.Status == StatusCompleted:
+		return o.run(ctx, saga, i+1)
+	case step.Status == StatusFailed:
+		return o.compensate(ctx, saga, i)
+	case step.Retryable:
+		return o.run(ctx, saga, i)
+	}
+
+	saga.mu.Lock()
+	step.Status = StatusInterrupted
+	step.Error = "interrupted by restart"
+	step.CompletedAt = time.Now()
+	saga.FailureReason = "step '" + step.Name + "' was interrupted by a restart"
+	saga.mu.Unlock()
+	o.notify(saga, step, "step_failed")
+	return o.compensate(ctx, saga, i)
+}
+
+// finish hands the outcome of a recovered, retried or force-run saga to its definition
+func (o *Orchestrator) finish(ctx context.Context, saga *Saga, err error) {
+	if err == ErrSagaLeaseLost {
+		return
+	}
+	o.mu.Lock()
+	def, ok := o.definitions[saga.Name]
+	o.mu.Unlock()
+	if ok && def.OnFinish != nil {
+		def.OnFinish(ctx, saga, err)
+	}
+}
@@ -0,0 +1,94 @@
+package saga
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrSagaSuspended is returned by Execute, Retry and recovery when a step suspended the saga
+	ErrSagaSuspended    = errors.New("saga suspended until it is resumed or aborted")
+	ErrSagaNotSuspended = errors.New("saga is not suspended")
+)
+
+// suspension is returned by a step that pauses its saga
+type suspension struct {
+	reason string
+}
+
+func (s *suspension) Error() string {
+	return "suspended: " + s.reason
+}
+
+// Suspend is returned by a step to pause its saga until a decision is made outside it,
+// e.g. by a fraud analyst. The step counts as completed, nothing is compensated, and the
+// saga waits unleased, left alone by recovery, until Resume or Abort is called.
+func Suspend(reason string) error {
+	return &suspension{reason: reason}
+}
+
+// Resume runs a suspended saga on from the step after the one that suspended it. The saga
+// runs in the background and its definition is told the outcome.
+func (o *Orchestrator) Resume(ctx context.Context, sagaID string) error {
+	saga, err := o.claimSuspended(ctx, sagaID)
+	if err != nil {
+		return err
+	}
+	from := saga.CurrentStep + 1
+
+	o.runDetached(saga, func(ctx context.Context) error {
+		return o.run(ctx, saga, from)
+	})
+	return nil
+}
+
+// Abort compensates a suspended saga, undoing the steps before it was suspended. The
+// saga runs in the background and its definition is told the outcome.
+func (o *Orchestrator) Abort(ctx context.Context, sagaID, reason string) error {
+	saga, err := o.claimSuspended(ctx, sagaID)
+	if err != nil {
+		return err
+	}
+	saga.mu.Lock()
+	saga.FailureReason = reason
+	last := saga.CurrentStep
+	saga.mu.Unlock()
+
+	o.runDetached(saga, func(ctx context.Context) error {
+		return o.compensate(ctx, saga, last)
+	})
+	return nil
+}
+
+// claimSuspended takes the lease on a suspended saga so it can be run again. Without a
+// saga store the saga is still in memory and is run from there.
+func (o *Orchestrator) claimSuspended(ctx context.Context, sagaID string) (*Saga, error) {
+	if o.db == nil {
+		saga, ok := o.GetSaga(ctx, sagaID)
+		if !ok {
+			return nil, ErrSagaNotFound
+		}
+		saga.mu.Lock()
+		defer saga.mu.Unlock()
+		if saga.Status != StatusSuspended {
+			return nil, ErrSagaNotSuspended
+		}
+		saga.Status = StatusPending // Taken, so a second decision is refused
+		return saga, nil
+	}
+
+	saga, err := o.claimForAdmin(ctx, sagaID, true, func(s Status) bool {
+		return s == StatusSuspended
+	})
+	if errors.Is(err, ErrSagaActionNotAllowed) {
+		return nil, ErrSagaNotSuspended
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	o.mu.Lock()
+	o.sagas[saga.ID] = saga
+	o.mu.Unlock()
+	return saga, nil
+}
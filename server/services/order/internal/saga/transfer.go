@@ -0,0 +1,139 @@
+package saga
+
+import (
+	"context"
+	"fmt"
+)
+
+// TransferRequest hands one passenger's ticket on a confirmed order to a new traveller
+type TransferRequest struct {
+	OrderID        string
+	UserID         string
+	OrgID          string
+	BookingID      string
+	PassengerIndex int
+	NewNID         string
+	NewName        string
+	NewDateOfBirth string
+	PaymentToken   string
+	FeePaisa       int64 // Collected when positive
+	Email          string
+	Phone          string
+}
+
+// NewTransferSaga creates a saga handing a passenger's seats to a new traveller.
+// Steps: VerifyNID -> CollectFee -> TransferBooking -> SendNotification
+// The booking is rewritten last, so a failure before it refunds the fee and leaves the
+// order as it was.
+func NewTransferSaga(o *Orchestrator, deps *BookingDependencies, req *TransferRequest) *Saga {
+	saga := o.CreateSaga(TransferSagaName, transferSteps(deps, req))
+
+	persisted := *req
+	persisted.PaymentToken = "" // Kept in memory only, out of the saga store
+	saga.Context.Set("transfer_request", &persisted)
+	saga.Context.Set("order_id", req.OrderID)
+	saga.Context.Set("user_id", req.UserID)
+	saga.Context.Set("org_id", req.OrgID)
+
+	return saga
+}
+
+// TransferSagaSteps rebuilds a persisted transfer saga's steps from its context
+func TransferSagaSteps(deps *BookingDependencies) func(sagaCtx *SagaContext) ([]*Step, error) {
+	return func(sagaCtx *SagaContext) ([]*Step, error) {
+		var req TransferRequest
+		if !sagaCtx.Decode("transfer_request", &req) {
+			return nil, fmt.Errorf("transfer request missing from saga context")
+		}
+		return transferSteps(deps, &req), nil
+	}
+}
+
+// TransferNIDRejected reports whether a transfer saga failed because the NID service
+// did not verify the new traveller
+func TransferNIDRejected(sagaCtx *SagaContext) bool {
+	rejected, _ := sagaCtx.Get("nid_rejected")
+	return rejected == true
+}
+
+func transferSteps(deps *BookingDependencies, req *TransferRequest) []*Step {
+	return []*Step{
+		{
+			Name: "verify_nid",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				valid, err := deps.NIDService.Verify(ctx, req.NewNID, req.NewDateOfBirth, req.NewName)
+				if err != nil {
+					return fmt.Errorf("NID verification failed for %s: %w", req.NewName, err)
+				}
+				if !valid {
+					sagaCtx.Set("nid_rejected", true)
+					return fmt.Errorf("NID validation failed for %s", req.NewName)
+				}
+				sagaCtx.Set("nid_verified", true)
+				return nil
+			},
+			// No compensation needed - validation is idempotent
+			Retryable: true,
+		},
+		{
+			Name: "collect_fee",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				return deps.collectTransferFee(ctx, sagaCtx, req)
+			},
+			CompensateFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				return deps.refundTransferFee(ctx, sagaCtx, req)
+			},
+		},
+		{
+			Name: "transfer_booking",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				if err := deps.InventoryService.TransferPassenger(ctx, req.BookingID, req.OrderID, req.PassengerIndex, req.NewNID, req.NewName); err != nil {
+					return fmt.Errorf("failed to transfer booked seats: %w", err)
+				}
+				return nil
+			},
+			// Inventory ignores seats already held by the new traveller
+			Retryable: true,
+		},
+		{
+			Name: "send_notification",
+			ExecuteFn: func(ctx context.Context, sagaCtx *SagaContext) error {
+				// Best effort - the new tickets are sent once fulfillment reissues them
+				_ = deps.NotificationSvc.SendBookingConfirmation(ctx, req.Email, req.Phone, req.OrderID)
+				return nil
+			},
+			Retryable: true,
+		},
+	}
+}
+
+// collectTransferFee charges the operator's transfer fee, if any
+func (d *BookingDependencies) collectTransferFee(ctx context.Context, sagaCtx *SagaContext, req *TransferRequest) error {
+	if req.FeePaisa <= 0 {
+		return nil
+	}
+
+	paymentID, err := d.PaymentService.Authorize(ctx, req.OrderID, req.OrgID, req.PaymentToken, req.FeePaisa)
+	if err != nil {
+		return fmt.Errorf("payment authorization failed: %w", err)
+	}
+	sagaCtx.Set("payment_id", paymentID)
+
+	if err := d.PaymentService.Capture(ctx, paymentID); err != nil {
+		return fmt.Errorf("payment capture failed: %w", err)
+	}
+	sagaCtx.Set("payment_captured", true)
+	return nil
+}
+
+func (d *BookingDependencies) refundTransferFee(ctx context.Context, sagaCtx *SagaContext, req *TransferRequest) error {
+	if captured, _ := sagaCtx.Get("payment_captured"); captured != true {
+		return nil
+	}
+	refundID, err := d.PaymentService.Refund(ctx, sagaCtx.GetString("payment_id"), req.FeePaisa)
+	if err != nil {
+		return fmt.Errorf("refund failed: %w", err)
+	}
+	sagaCtx.Set("refund_id", refundID)
+	return nil
+}
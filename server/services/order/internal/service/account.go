@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+)
+
+const auditEntityAccount = "corporate_account"
+
+// Account actions, as recorded in the audit log
+const (
+	AccountActionSave        = "account_save"
+	AccountActionInvoicePaid = "invoice_paid"
+)
+
+// InvoiceSchedulerInterval is how often finished billing periods are looked for. Invoices
+// are issued in the first hour after a period ends.
+const InvoiceSchedulerInterval = time.Hour
+
+// SaveAccount creates or updates a corporate or group account
+func (s *OrderService) SaveAccount(ctx context.Context, account *domain.CorporateAccount, actorID string) error {
+	if account.Currency == "" {
+		account.Currency = DefaultCurrency
+	}
+	if account.Status == "" {
+		account.Status = domain.AccountStatusActive
+	}
+	if account.PaymentTermsDays == 0 {
+		account.PaymentTermsDays = domain.DefaultPaymentTermsDays
+	}
+	if err := account.Validate(); err != nil {
+		return err
+	}
+	if err := s.accountRepo.Save(ctx, account); err != nil {
+		return err
+	}
+
+	s.auditAccount(ctx, account.ID, AccountActionSave, actorID, map[string]interface{}{
+		"status":             account.Status,
+		"credit_limit_paisa": account.CreditLimitPaisa,
+		"max_group_size":     account.MaxGroupSize,
+		"bookers":            len(account.BookerIDs),
+		"travellers":         len(account.Travellers),
+	})
+	return nil
+}
+
+// GetAccount returns one of an organization's accounts
+func (s *OrderService) GetAccount(ctx context.Context, orgID, accountID string) (*domain.CorporateAccount, error) {
+	return s.accountRepo.Get(ctx, orgID, accountID)
+}
+
+// ListAccounts returns an organization's accounts
+func (s *OrderService) ListAccounts(ctx context.Context, orgID string) ([]*domain.CorporateAccount, error) {
+	return s.accountRepo.List(ctx, orgID)
+}
+
+// ListInvoices returns an account's invoices, newest first
+func (s *OrderService) ListInvoices(ctx context.Context, orgID, accountID string) ([]*domain.AccountInvoice, error) {
+	if _, err := s.accountRepo.Get(ctx, orgID, accountID); err != nil {
+		return nil, err
+	}
+	return s.accountRepo.ListInvoices(ctx, orgID, accountID)
+}
+
+// GetInvoice returns one of an account's invoices with its lines
+func (s *OrderService) GetInvoice(ctx context.Context, orgID, accountID, invoiceID string) (*domain.AccountInvoice, error) {
+	invoice, err := s.accountRepo.GetInvoice(ctx, orgID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.AccountID != accountID {
+		return nil, domain.ErrInvoiceNotFound
+	}
+	return invoice, nil
+}
+
+// MarkInvoicePaid records the payment of an invoice, giving its total back to the account's credit
+func (s *OrderService) MarkInvoicePaid(ctx context.Context, orgID, accountID, invoiceID, actorID string) (*domain.AccountInvoice, error) {
+	if _, err := s.GetInvoice(ctx, orgID, accountID, invoiceID); err != nil {
+		return nil, err
+	}
+	if err := s.accountRepo.MarkInvoicePaid(ctx, orgID, invoiceID); err != nil {
+		return nil, err
+	}
+	invoice, err := s.accountRepo.GetInvoice(ctx, orgID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditAccount(ctx, accountID, AccountActionInvoicePaid, actorID, map[string]interface{}{
+		"invoice_id":  invoiceID,
+		"total_paisa": invoice.TotalPaisa,
+	})
+	return invoice, nil
+}
+
+// accountForOrder loads the account an order is charged to, checking the user may book on
+// it and it pays for every passenger. Orders paid otherwise have no account.
+func (s *OrderService) accountForOrder(ctx context.Context, req *CreateOrderRequest) (*domain.CorporateAccount, error) {
+	if req.PaymentMethod != domain.PaymentMethodAccount {
+		return nil, nil
+	}
+	if req.AccountID == "" {
+		return nil, domain.ErrAccountNotFound
+	}
+	account, err := s.accountRepo.Get(ctx, req.OrgID, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.Status != domain.AccountStatusActive {
+		return nil, domain.ErrAccountSuspended
+	}
+	if !account.CanBook(req.UserID) {
+		return nil, domain.ErrNotAccountBooker
+	}
+	for _, leg := range req.allLegs() {
+		for _, p := range leg.Passengers {
+			if !account.Approves(p.NID) {
+				return nil, domain.ErrTravellerNotApproved
+			}
+		}
+	}
+	return account, nil
+}
+
+// recordAccountRefund gives a refund of an order charged to an account back to its
+// credit, to be billed on the account's next invoice
+func (s *OrderService) recordAccountRefund(ctx context.Context, tx *sql.Tx, order *domain.Order, sagaID string, amountPaisa int64) error {
+	if order.PaymentMethod != domain.PaymentMethodAccount || order.AccountID == "" || amountPaisa <= 0 {
+		return nil
+	}
+	return repository.NewTxOrderRepository(tx).AddAccountRefundTx(ctx, order.AccountID, order.ID, sagaID, amountPaisa)
+}
+
+// StartInvoiceScheduler issues the invoices of each finished billing period, checking every
+// InvoiceSchedulerInterval. Blocks until ctx is done.
+func (s *OrderService) StartInvoiceScheduler(ctx context.Context) {
+	ticker := time.NewTicker(InvoiceSchedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.IssueInvoices(ctx, time.Now()); err != nil {
+				logger.Error("Invoice run failed", "error", err)
+			}
+		}
+	}
+}
+
+// IssueInvoices issues one invoice per account for the charges and refunds of the last
+// billing period finished by now, and returns how many it issued. A period already
+// invoiced is not invoiced again, and charges left over from earlier periods are billed
+// on the next invoice.
+func (s *OrderService) IssueInvoices(ctx context.Context, now time.Time) (int, error) {
+	periodEnd := domain.BillingPeriodEnd(now)
+	accounts, err := s.accountRepo.ListAccountsToInvoice(ctx, periodEnd)
+	if err != nil {
+		return 0, err
+	}
+
+	issued := 0
+	for _, account := range accounts {
+		ok, err := s.issueInvoice(ctx, account, periodEnd)
+		if err != nil {
+			logger.Error("Failed to issue account invoice", "account_id", account.ID, "period_end", periodEnd, "error", err)
+			continue
+		}
+		if ok {
+			issued++
+		}
+	}
+	if issued > 0 {
+		logger.Info("Issued account invoices", "count", issued, "period_end", periodEnd)
+	}
+	return issued, nil
+}
+
+func (s *OrderService) issueInvoice(ctx context.Context, account *domain.CorporateAccount, periodEnd time.Time) (bool, error) {
+	tx, err := s.orderRepo.BeginTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	invoice, err := repository.NewTxOrderRepository(tx).IssueInvoiceTx(ctx, account, periodEnd)
+	if err != nil || invoice == nil {
+		return false, err
+	}
+	if err := s.publisher.PublishAccountInvoiceIssued(ctx, tx, account, invoice); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *OrderService) auditAccount(ctx context.Context, accountID, action, actorID string, changes map[string]interface{}) {
+	if err := s.auditRepo.Log(ctx, repository.AuditLog{
+		EntityType: auditEntityAccount,
+		EntityID:   accountID,
+		Action:     action,
+		ActorID:    actorID,
+		Changes:    changes,
+	}); err != nil {
+		logger.Error("Failed to audit-log account action", "account_id", accountID, "action", action, "actor_id", actorID, "error", err)
+	}
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/saga"
+)
+
+var (
+	ErrOrderNotChangeable    = errors.New("order cannot be changed in its current status")
+	ErrChangeQuoteChanged    = errors.New("change costs more than the quote the user accepted")
+	ErrChangePaymentRequired = errors.New("change costs more than the order; a payment token is required")
+)
+
+type ChangeOrderRequest struct {
+	OrderID string
+	UserID  string
+	TripID  string // Empty keeps the trip and changes seats only
+	// New seat of each remaining passenger, in passenger order; empty for deck and standing passengers
+	SeatIDs      []string
+	HoldID       string // Hold the frontend placed on the new seats, if any
+	PaymentToken string
+	// Amount due the user accepted; a higher amount rejects the change
+	ExpectedAmountDue *int64
+}
+
+// ChangeOrder moves a confirmed order to another trip of its route, or to other seats on
+// its trip. The new seats are priced like a new booking and the operator's change fee is
+// added; the difference is collected from the user or refunded. The new booking replaces
+// the old one atomically and the tickets are reissued.
+func (s *OrderService) ChangeOrder(ctx context.Context, req *ChangeOrderRequest) (*domain.Order, *domain.OrderChange, error) {
+	order, leg, quote, err := s.priceChange(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.ExpectedAmountDue != nil && quote.AmountDuePaisa > *req.ExpectedAmountDue {
+		return nil, nil, ErrChangeQuoteChanged
+	}
+	if quote.AmountDuePaisa > 0 && req.PaymentToken == "" {
+		return nil, nil, ErrChangePaymentRequired
+	}
+
+	changeReq := &saga.ChangeRequest{
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		OrgID:          order.OrganizationID,
+		OldBookingID:   order.BookingID,
+		OldPaymentID:   order.PaymentID,
+		TripID:         leg.TripID,
+		HoldID:         leg.HoldID,
+		FromStation:    leg.FromStationID,
+		ToStation:      leg.ToStationID,
+		PaymentToken:   req.PaymentToken,
+		AmountDuePaisa: quote.AmountDuePaisa,
+		Email:          order.ContactEmail,
+		Phone:          order.ContactPhone,
+	}
+	for _, p := range leg.Passengers {
+		changeReq.Passengers = append(changeReq.Passengers, saga.PassengerInfo{
+			NID:           p.NID,
+			Name:          p.Name,
+			SeatID:        p.SeatID,
+			Gender:        p.Gender,
+			CapacityClass: p.CapacityClass,
+		})
+	}
+
+	changeSaga := saga.NewChangeSaga(s.orchestrator, s.sagaDeps, changeReq)
+	changeSaga.Context.Set("change_leg", leg)
+	changeSaga.Context.Set("change_quote", quote)
+
+	if err := s.orchestrator.Execute(ctx, changeSaga); err != nil {
+		return nil, nil, fmt.Errorf("change failed: %w", err)
+	}
+
+	change, err := s.completeChange(ctx, order, changeSaga)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, change, nil
+}
+
+// QuoteChange tells the user what a date or seat change would cost now
+func (s *OrderService) QuoteChange(ctx context.Context, req *ChangeOrderRequest) (*domain.Order, *domain.ChangeQuote, error) {
+	order, _, quote, err := s.priceChange(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, quote, nil
+}
+
+// priceChange loads a confirmed order, prices its remaining passengers on the new trip
+// and seats, and quotes the change under the policy covering the trip booked now
+func (s *OrderService) priceChange(ctx context.Context, req *ChangeOrderRequest) (*domain.Order, *domain.OrderLeg, *domain.ChangeQuote, error) {
+	order, err := s.orderRepo.GetByID(ctx, req.OrderID, req.UserID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if order.Status != domain.OrderStatusConfirmed {
+		return nil, nil, nil, fmt.Errorf("%w: %s", ErrOrderNotChangeable, order.Status)
+	}
+	if order.IsMultiLeg() {
+		return nil, nil, nil, fmt.Errorf("%w: multi-leg orders are changed by cancelling and rebooking", ErrOrderNotChangeable)
+	}
+	if s.catalogClient == nil || s.pricingClient == nil || s.inventoryClient == nil {
+		return nil, nil, nil, fmt.Errorf("pricing dependencies unavailable")
+	}
+
+	tripID := req.TripID
+	if tripID == "" {
+		tripID = order.TripID
+	}
+
+	// Remaining passengers take the new seats in order; deck and standing passengers keep their class
+	active := order.ActivePassengers()
+	passengers := make([]PassengerRequest, 0, len(active))
+	unchanged := tripID == order.TripID
+	for i, idx := range active {
+		p := order.Passengers[idx]
+		seatID := ""
+		if p.CapacityClass == "" {
+			if i >= len(req.SeatIDs) || req.SeatIDs[i] == "" {
+				return nil, nil, nil, fmt.Errorf("%w: a new seat is needed for every seated passenger", domain.ErrInvalidChange)
+			}
+			seatID = req.SeatIDs[i]
+		}
+		if seatID != p.SeatID {
+			unchanged = false
+		}
+		passengers = append(passengers, PassengerRequest{
+			NID:           p.NID,
+			Name:          p.Name,
+			SeatID:        seatID,
+			Gender:        p.Gender,
+			Age:           p.Age,
+			CapacityClass: p.CapacityClass,
+		})
+	}
+	if unchanged {
+		return nil, nil, nil, domain.ErrInvalidChange
+	}
+
+	current, err := s.catalogClient.GetTrip(ctx, order.OrganizationID, order.TripID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to fetch trip: %w", err)
+	}
+	routeID := order.RouteID
+	if routeID == "" {
+		routeID = current.RouteId
+	}
+
+	leg, currency, err := s.priceLeg(ctx, &CreateOrderRequest{OrgID: order.OrganizationID}, LegRequest{
+		TripID:      tripID,
+		FromStation: order.FromStationID,
+		ToStation:   order.ToStationID,
+		HoldID:      req.HoldID,
+		Passengers:  passengers,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if leg.RouteID != routeID {
+		return nil, nil, nil, fmt.Errorf("%w: trip %s is on another route", domain.ErrInvalidChange, tripID)
+	}
+	if order.Currency != "" && currency != order.Currency {
+		return nil, nil, nil, fmt.Errorf("%w: trip %s is priced in %s", domain.ErrInvalidChange, tripID, currency)
+	}
+
+	// Passengers keep everything but their seats
+	for i, idx := range active {
+		p := order.Passengers[idx]
+		p.SeatID = leg.Passengers[i].SeatID
+		p.SeatNumber = leg.Passengers[i].SeatNumber
+		p.SeatClass = leg.Passengers[i].SeatClass
+		leg.Passengers[i] = p
+	}
+
+	policy, err := s.refundPolicyFor(ctx, order.OrganizationID, routeID, current.VehicleClass)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	quote, err := policy.QuoteChange(order, leg, time.Unix(current.DepartureTime, 0), time.Now())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return order, leg, quote, nil
+}
+
+// onChangeSagaFinished moves the order once a recovered or retried change saga has swapped
+// its booking, even when a step after the swap did not complete
+func (s *OrderService) onChangeSagaFinished(ctx context.Context, sagaInstance *saga.Saga, err error) {
+	if err != nil && !saga.ChangeCommitted(sagaInstance.Context) {
+		logger.Error("Recovered change saga did not complete", "saga_id", sagaInstance.ID,
+			"order_id", sagaInstance.Context.GetString("order_id"), "error", err)
+		return
+	}
+	order, loadErr := s.orderRepo.GetByID(ctx, sagaInstance.Context.GetString("order_id"), sagaInstance.Context.GetString("user_id"))
+	if loadErr != nil {
+		logger.Error("Failed to load order of recovered change saga", "saga_id", sagaInstance.ID, "error", loadErr)
+		return
+	}
+	if order.Status != domain.OrderStatusConfirmed {
+		return
+	}
+	if _, err := s.completeChange(ctx, order, sagaInstance); err != nil {
+		logger.Error("Failed to complete recovered change", "order_id", order.ID, "error", err)
+	}
+}
+
+// completeChange moves the order onto its new booking and publishes the change so the
+// tickets are reissued. A saga already applied to the order is not applied twice.
+func (s *OrderService) completeChange(ctx context.Context, order *domain.Order, changeSaga *saga.Saga) (*domain.OrderChange, error) {
+	for i := range order.Changes {
+		if order.Changes[i].SagaID == changeSaga.ID {
+			return &order.Changes[i], nil
+		}
+	}
+
+	var leg domain.OrderLeg
+	if !changeSaga.Context.Decode("change_leg", &leg) {
+		return nil, fmt.Errorf("change saga %s has no priced leg", changeSaga.ID)
+	}
+	var quote domain.ChangeQuote
+	changeSaga.Context.Decode("change_quote", &quote)
+
+	leg.HoldID = changeSaga.Context.GetString("hold_id")
+	leg.BookingID = changeSaga.Context.GetString("booking_id")
+	var seats []saga.ConfirmedSeat
+	if changeSaga.Context.Decode("confirmed_seats", &seats) {
+		leg.Seats = convertConfirmedSeats(seats)
+	}
+
+	change := domain.OrderChange{
+		SagaID:    changeSaga.ID,
+		Quote:     &quote,
+		ChangedAt: time.Now(),
+	}
+	switch {
+	case quote.AmountDuePaisa > 0:
+		change.PaymentID = changeSaga.Context.GetString("payment_id")
+	case quote.AmountDuePaisa < 0:
+		change.RefundID = changeSaga.Context.GetString("refund_id")
+		change.RefundFailed = change.RefundID == ""
+		if !change.RefundFailed {
+			order.PaymentStatus = domain.PaymentStatusPartiallyRefunded
+		}
+	}
+	order.ApplyChange(&leg, change)
+
+	tx, err := s.orderRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	txRepo := repository.NewTxOrderRepository(tx)
+	if err := txRepo.UpdateTx(ctx, order); err != nil {
+		return nil, err
+	}
+
+	recorded := &order.Changes[len(order.Changes)-1]
+	if err := s.publisher.PublishOrderChanged(ctx, tx, order, recorded); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return recorded, nil
+}
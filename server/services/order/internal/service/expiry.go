@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/saga"
+)
+
+const (
+	// OrderExpiryInterval is how often pending orders past their expiry are looked for
+	OrderExpiryInterval = time.Minute
+
+	// orderExpiryBatch caps the orders expired in one sweep
+	orderExpiryBatch = 100
+)
+
+// StartExpirySweeper expires pending orders abandoned before they were paid, every
+// OrderExpiryInterval. Blocks until ctx is done.
+func (s *OrderService) StartExpirySweeper(ctx context.Context) {
+	ticker := time.NewTicker(OrderExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.ExpireOrders(ctx); err != nil {
+				logger.Error("Order expiry sweep failed", "error", err)
+			}
+		}
+	}
+}
+
+// ExpireOrders expires the pending orders whose payment window has passed and returns how
+// many it expired. Orders it cannot expire yet are left for a later sweep.
+func (s *OrderService) ExpireOrders(ctx context.Context) (int, error) {
+	orders, err := s.orderRepo.ListExpiredPending(ctx, time.Now(), orderExpiryBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, order := range orders {
+		ok, err := s.expireOrder(ctx, order)
+		if err != nil {
+			logger.Error("Failed to expire order", "order_id", order.ID, "error", err)
+			continue
+		}
+		if ok {
+			expired++
+		}
+	}
+	if expired > 0 {
+		logger.Info("Expired abandoned orders", "count", expired)
+	}
+	return expired, nil
+}
+
+// expireOrder cancels an abandoned order's pending payment, marks it expired and publishes
+// the expiry, then releases its holds and anti-scalp counters. An order whose booking saga
+// is still running, or whose payment went through after all, is left pending.
+func (s *OrderService) expireOrder(ctx context.Context, order *domain.Order) (bool, error) {
+	var bookingSaga *saga.Saga
+	if order.SagaID != "" {
+		if instance, ok := s.orchestrator.GetSaga(ctx, order.SagaID); ok {
+			// A running saga, or one awaiting recovery, settles the order itself
+			if !instance.Status.Finished() || instance.Status == saga.StatusCompleted {
+				return false, nil
+			}
+			bookingSaga = instance
+		}
+	}
+
+	paymentID := order.PaymentID
+	if paymentID == "" && bookingSaga != nil {
+		paymentID = bookingSaga.Context.GetString("payment_id")
+	}
+
+	paymentStatus := order.PaymentStatus
+	if s.sagaDeps != nil && s.sagaDeps.PaymentService != nil {
+		status, err := s.sagaDeps.PaymentService.Cancel(ctx, order.ID, "order_expired")
+		if err != nil {
+			return false, err
+		}
+		switch status {
+		case "captured", "authorized":
+			logger.Warn("Expired order was paid; leaving it pending for review", "order_id", order.ID)
+			return false, nil
+		case "cancelled":
+			paymentStatus = domain.PaymentStatusCancelled
+		case "failed":
+			paymentStatus = domain.PaymentStatusFailed
+		}
+	}
+
+	tx, err := s.orderRepo.BeginTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	txRepo := repository.NewTxOrderRepository(tx)
+	ok, err := txRepo.ExpireTx(ctx, order.ID, paymentStatus)
+	if err != nil || !ok {
+		return false, err
+	}
+	order.Status = domain.OrderStatusExpired
+	order.PaymentStatus = paymentStatus
+
+	if err := s.publisher.PublishOrderExpired(ctx, tx, order, paymentID); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	// Best effort - inventory holds and anti-scalp counters also run out on their own
+	s.releaseExpiredHolds(ctx, order, bookingSaga)
+	s.releaseTicketLimits(ctx, order)
+	return true, nil
+}
+
+// releaseExpiredHolds releases the seats held for an expired order, by the frontend or by
+// its booking saga
+func (s *OrderService) releaseExpiredHolds(ctx context.Context, order *domain.Order, bookingSaga *saga.Saga) {
+	if s.sagaDeps == nil || s.sagaDeps.InventoryService == nil {
+		return
+	}
+
+	holds := make(map[string]bool)
+	for _, leg := range orderLegs(order) {
+		if leg.HoldID != "" {
+			holds[leg.HoldID] = true
+		}
+	}
+	if bookingSaga != nil {
+		for _, holdID := range saga.HeldSeats(bookingSaga.Context) {
+			holds[holdID] = true
+		}
+	}
+
+	for holdID := range holds {
+		if err := s.sagaDeps.InventoryService.ReleaseSeats(ctx, order.OrganizationID, holdID, order.UserID); err != nil {
+			logger.Warn("Failed to release hold of expired order", "order_id", order.ID, "hold_id", holdID, "error", err)
+		}
+	}
+}
+
+// releaseTicketLimits gives the tickets of an order that expired, failed or was cancelled
+// back to the user's and each passenger's per-trip limit
+func (s *OrderService) releaseTicketLimits(ctx context.Context, order *domain.Order) {
+	if s.ticketLimits == nil {
+		return
+	}
+	for _, leg := range orderLegs(order) {
+		for _, p := range leg.Passengers {
+			if p.Cancelled {
+				continue
+			}
+			if err := s.ticketLimits.ReleaseTickets(ctx, leg.TripID, order.UserID, order.ClientIP, p.NID, 1); err != nil {
+				logger.Warn("Failed to release ticket limits of order", "order_id", order.ID, "trip_id", leg.TripID, "error", err)
+				return
+			}
+		}
+	}
+}
+
+// orderLegs returns every leg of the order; a single-trip order is its own leg
+func orderLegs(order *domain.Order) []domain.OrderLeg {
+	if order.IsMultiLeg() {
+		return order.Legs
+	}
+	return []domain.OrderLeg{{
+		TripID:     order.TripID,
+		HoldID:     order.HoldID,
+		Passengers: order.Passengers,
+	}}
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/saga"
+)
+
+const auditEntityOrder = "order"
+
+// Fraud review actions, as recorded in the audit log
+const (
+	FraudActionApprove = "fraud_review_approve"
+	FraudActionReject  = "fraud_review_reject"
+)
+
+type ReviewOrderRequest struct {
+	OrderID    string
+	Approve    bool
+	ReviewerID string
+	Note       string
+}
+
+// reviewPendingAttempts bounds how often a suspended saga's order is tried to be held for
+// review before the saga is aborted instead
+const reviewPendingAttempts = 3
+
+// handleOrderReviewPending holds the order for a fraud analyst with the fraud check's
+// assessment, and publishes the hold so analysts are told. An order that cannot be held
+// would never reach an analyst, so its saga is aborted rather than left suspended
+func (s *OrderService) handleOrderReviewPending(ctx context.Context, order *domain.Order, sagaInstance *saga.Saga) {
+	var assessment saga.FraudAssessment
+	sagaInstance.Context.Decode("fraud_assessment", &assessment)
+
+	review := &domain.FraudReview{
+		RiskScore:   assessment.RiskScore,
+		RiskLevel:   assessment.RiskLevel,
+		Summary:     assessment.Summary,
+		RiskFactors: assessment.RiskFactors,
+		FlaggedAt:   time.Now(),
+	}
+
+	for attempt := 1; attempt <= reviewPendingAttempts; attempt++ {
+		err := s.holdOrderForReview(ctx, order, review)
+		if err == nil {
+			return
+		}
+		logger.Error("Failed to hold order for fraud review", "order_id", order.ID, "attempt", attempt, "error", err)
+		if attempt == reviewPendingAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			attempt = reviewPendingAttempts
+		case <-time.After(time.Duration(attempt) * time.Second):
+		}
+	}
+
+	// Abort runs the saga's compensation and fails the order through the saga's finish hook
+	if err := s.orchestrator.Abort(context.WithoutCancel(ctx), sagaInstance.ID, "order could not be held for fraud review"); err != nil {
+		logger.Error("Failed to abort saga of order not held for fraud review", "order_id", order.ID, "saga_id", sagaInstance.ID, "error", err)
+	}
+}
+
+// holdOrderForReview moves the order to review pending with its review and publishes the
+// hold in the same transaction. The order is only changed once the transaction commits
+func (s *OrderService) holdOrderForReview(ctx context.Context, order *domain.Order, review *domain.FraudReview) error {
+	tx, err := s.orderRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	held := *order
+	held.Status = domain.OrderStatusReviewPending
+	held.FraudReview = review
+
+	txRepo := repository.NewTxOrderRepository(tx)
+	if err := txRepo.UpdateTx(ctx, &held); err != nil {
+		return err
+	}
+	if err := s.publisher.PublishOrderReviewPending(ctx, tx, &held); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	*order = held
+	return nil
+}
+
+// ListFraudReviews lists the orders awaiting a fraud analyst, oldest first. An empty
+// organization lists every organization's orders.
+func (s *OrderService) ListFraudReviews(ctx context.Context, orgID string, pageSize int, pageToken string) ([]*domain.Order, int, string, error) {
+	offset := parsePageToken(pageToken)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	orders, total, err := s.orderRepo.ListByStatus(ctx, orgID, domain.OrderStatusReviewPending, pageSize, offset)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	nextToken := ""
+	if offset+pageSize < total {
+		nextToken = generatePageToken(offset + pageSize)
+	}
+
+	return orders, total, nextToken, nil
+}
+
+// ReviewOrder records a fraud analyst's decision on a held order. Approving resumes its
+// booking saga, which takes the payment and confirms the seats; rejecting compensates it,
+// releasing the seats and failing the order. Either way the order is pending until the
+// saga finishes. The decision is audit-logged, rejected attempts included.
+func (s *OrderService) ReviewOrder(ctx context.Context, req *ReviewOrderRequest) (*domain.Order, error) {
+	action := FraudActionReject
+	if req.Approve {
+		action = FraudActionApprove
+	}
+
+	order, err := s.reviewOrder(ctx, req)
+
+	changes := map[string]interface{}{"note": req.Note}
+	if err != nil {
+		changes["error"] = err.Error()
+	}
+	if logErr := s.auditRepo.Log(ctx, repository.AuditLog{
+		EntityType: auditEntityOrder,
+		EntityID:   req.OrderID,
+		Action:     action,
+		ActorID:    req.ReviewerID,
+		Changes:    changes,
+	}); logErr != nil {
+		logger.Error("Failed to audit-log fraud review", "order_id", req.OrderID, "action", action, "actor_id", req.ReviewerID, "error", logErr)
+	}
+
+	return order, err
+}
+
+func (s *OrderService) reviewOrder(ctx context.Context, req *ReviewOrderRequest) (*domain.Order, error) {
+	order, err := s.orderRepo.GetByIDForStaff(ctx, req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != domain.OrderStatusReviewPending || order.FraudReview == nil || order.FraudReview.Decided() {
+		return nil, domain.ErrNotUnderReview
+	}
+
+	held := *order.FraudReview
+	decided := held
+	decided.Decision = domain.FraudDecisionRejected
+	if req.Approve {
+		decided.Decision = domain.FraudDecisionApproved
+	}
+	decided.ReviewerID = req.ReviewerID
+	decided.Note = req.Note
+	decided.DecidedAt = time.Now()
+
+	// The order leaves review before the saga runs on, so the saga's outcome is recorded on it
+	ok, err := s.transitionReview(ctx, order.ID, domain.OrderStatusReviewPending, domain.OrderStatusPending, &decided)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.ErrNotUnderReview
+	}
+
+	if req.Approve {
+		err = s.orchestrator.Resume(ctx, order.SagaID)
+	} else {
+		reason := "rejected by fraud review"
+		if req.Note != "" {
+			reason += ": " + req.Note
+		}
+		err = s.orchestrator.Abort(ctx, order.SagaID, reason)
+	}
+	if err != nil {
+		// Back under review, so the decision can be made again
+		if _, revertErr := s.transitionReview(ctx, order.ID, domain.OrderStatusPending, domain.OrderStatusReviewPending, &held); revertErr != nil {
+			logger.Error("Failed to put order back under fraud review", "order_id", order.ID, "error", revertErr)
+		}
+		return nil, err
+	}
+
+	order.Status = domain.OrderStatusPending
+	order.FraudReview = &decided
+	return order, nil
+}
+
+// transitionReview moves an order between statuses with its fraud review, if it is still in the from status
+func (s *OrderService) transitionReview(ctx context.Context, orderID string, from, to domain.OrderStatus, review *domain.FraudReview) (bool, error) {
+	tx, err := s.orderRepo.BeginTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	ok, err := repository.NewTxOrderRepository(tx).ReviewTx(ctx, orderID, from, to, review)
+	if err != nil || !ok {
+		return false, err
+	}
+	return true, tx.Commit()
+}
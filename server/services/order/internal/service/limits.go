@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+)
+
+// ticketLimitsFor returns the anti-scalp limits an order is held to. Orders on an account
+// with a group size may hold that many tickets per trip, and counter agents sell to the
+// queue at their desk, so neither is held to the per-user, per-IP and hourly limits.
+// Every passenger is still limited to one ticket per trip.
+func ticketLimitsFor(req *CreateOrderRequest, account *domain.CorporateAccount) repository.TicketLimit {
+	defaults := domain.DefaultTicketLimits()
+	limits := repository.TicketLimit{
+		MaxTicketsPerUser: defaults.MaxTicketsPerUser,
+		MaxTicketsPerIP:   defaults.MaxTicketsPerIP,
+		MaxTicketsPerNID:  defaults.MaxTicketsPerNID,
+		MaxTicketsPerHour: defaults.MaxTicketsPerHour,
+		MaxHoldsPerUser:   defaults.MaxHoldsPerUser,
+	}
+
+	switch {
+	case req.AgentID != "":
+		limits.MaxTicketsPerUser = math.MaxInt32
+		limits.MaxTicketsPerIP = math.MaxInt32
+		limits.MaxTicketsPerHour = math.MaxInt32
+	case account != nil && account.MaxGroupSize > 0:
+		limits.MaxTicketsPerUser = account.MaxGroupSize
+		limits.MaxTicketsPerIP = account.MaxGroupSize
+		if account.MaxGroupSize > limits.MaxTicketsPerHour {
+			limits.MaxTicketsPerHour = account.MaxGroupSize
+		}
+	}
+	if req.IPAddress == "" {
+		limits.MaxTicketsPerIP = math.MaxInt32 // Unknown clients would share one counter
+	}
+	return limits
+}
+
+// checkTicketLimits counts the order's tickets against the anti-scalp limits of each trip.
+// An order over a limit takes none of its tickets. The limits are best effort: if they
+// cannot be checked, the order goes ahead.
+func (s *OrderService) checkTicketLimits(ctx context.Context, req *CreateOrderRequest, account *domain.CorporateAccount) error {
+	if s.ticketLimits == nil {
+		return nil
+	}
+	limits := ticketLimitsFor(req, account)
+
+	type taken struct{ tripID, nid string }
+	var counted []taken
+	release := func() {
+		for _, t := range counted {
+			if err := s.ticketLimits.ReleaseTickets(ctx, t.tripID, req.UserID, req.IPAddress, t.nid, 1); err != nil {
+				logger.Warn("Failed to release ticket limits", "trip_id", t.tripID, "user_id", req.UserID, "error", err)
+			}
+		}
+	}
+
+	for _, leg := range req.allLegs() {
+		for _, p := range leg.Passengers {
+			err := s.ticketLimits.CheckAndIncrement(ctx, leg.TripID, req.UserID, req.IPAddress, p.NID, 1, limits)
+			if errors.Is(err, repository.ErrLimitExceeded) {
+				release()
+				return domain.ErrMaxTicketsExceeded
+			}
+			if err != nil {
+				logger.Warn("Ticket limits not checked", "trip_id", leg.TripID, "user_id", req.UserID, "error", err)
+				release()
+				return nil
+			}
+			counted = append(counted, taken{tripID: leg.TripID, nid: p.NID})
+		}
+	}
+	return nil
+}
@@ -3,12 +3,14 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
 	catalogpb "github.com/MuhibNayem/Travio/server/api/proto/catalog/v1"
 	inventorypb "github.com/MuhibNayem/Travio/server/api/proto/inventory/v1"
 	pricingpb "github.com/MuhibNayem/Travio/server/api/proto/pricing/v1"
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
 	"github.com/MuhibNayem/Travio/server/services/order/internal/clients"
 	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
 	"github.com/MuhibNayem/Travio/server/services/order/internal/events"
@@ -20,17 +22,29 @@ import (
 
 const (
 	DefaultCurrency = "BDT"
+
+	// SagaRecoveryInterval is how often sagas abandoned by a stopped instance are looked for
+	SagaRecoveryInterval = 30 * time.Second
+
+	// OrderPaymentWindow is how long a new order stays pending before it expires unpaid
+	OrderPaymentWindow = 15 * time.Minute
 )
 
 type OrderService struct {
-	db              *sql.DB
-	orderRepo       *repository.OrderRepository
-	sagaDeps        *saga.BookingDependencies
-	orchestrator    *saga.Orchestrator
-	publisher       *events.Publisher
-	catalogClient   *clients.CatalogClient
-	pricingClient   *clients.PricingClient
-	inventoryClient *clients.InventoryClient
+	db               *sql.DB
+	orderRepo        *repository.OrderRepository
+	auditRepo        *repository.AuditRepository
+	refundPolicyRepo *repository.RefundPolicyRepository
+	shiftRepo        *repository.ShiftRepository
+	accountRepo      *repository.AccountRepository
+	transferRepo     *repository.TransferRepository
+	sagaDeps         *saga.BookingDependencies
+	orchestrator     *saga.Orchestrator
+	publisher        *events.Publisher
+	catalogClient    *clients.CatalogClient
+	pricingClient    *clients.PricingClient
+	inventoryClient  *clients.InventoryClient
+	ticketLimits     *repository.TicketLimitChecker
 }
 
 func NewOrderService(
@@ -42,20 +56,59 @@ func NewOrderService(
 	catalogClient *clients.CatalogClient,
 	pricingClient *clients.PricingClient,
 	inventoryClient *clients.InventoryClient,
+	ticketLimits *repository.TicketLimitChecker,
 ) *OrderService {
-	return &OrderService{
-		db:              db,
-		orderRepo:       orderRepo,
-		sagaDeps:        sagaDeps,
-		orchestrator:    saga.NewOrchestrator(gormDB, dlq),
-		publisher:       events.NewPublisher(db),
-		catalogClient:   catalogClient,
-		pricingClient:   pricingClient,
-		inventoryClient: inventoryClient,
-	}
+	s := &OrderService{
+		db:               db,
+		orderRepo:        orderRepo,
+		auditRepo:        repository.NewAuditRepository(db),
+		refundPolicyRepo: repository.NewRefundPolicyRepository(db),
+		shiftRepo:        repository.NewShiftRepository(db),
+		accountRepo:      repository.NewAccountRepository(db),
+		transferRepo:     repository.NewTransferRepository(db),
+		sagaDeps:         sagaDeps,
+		orchestrator:     saga.NewOrchestrator(gormDB, dlq),
+		publisher:        events.NewPublisher(db),
+		catalogClient:    catalogClient,
+		pricingClient:    pricingClient,
+		inventoryClient:  inventoryClient,
+		ticketLimits:     ticketLimits,
+	}
+
+	s.orchestrator.RegisterDefinition(saga.BookingSagaName, saga.Definition{
+		Build:    saga.BookingSagaSteps(sagaDeps),
+		OnFinish: s.onBookingSagaFinished,
+	})
+	s.orchestrator.RegisterDefinition(saga.CancellationSagaName, saga.Definition{
+		Build:    saga.CancellationSagaSteps(sagaDeps),
+		OnFinish: s.onCancellationSagaFinished,
+	})
+	s.orchestrator.RegisterDefinition(saga.PassengerCancellationSagaName, saga.Definition{
+		Build:    saga.PassengerCancellationSagaSteps(sagaDeps),
+		OnFinish: s.onPassengerCancellationSagaFinished,
+	})
+	s.orchestrator.RegisterDefinition(saga.ChangeSagaName, saga.Definition{
+		Build:    saga.ChangeSagaSteps(sagaDeps),
+		OnFinish: s.onChangeSagaFinished,
+	})
+	s.orchestrator.RegisterDefinition(saga.TransferSagaName, saga.Definition{
+		Build:    saga.TransferSagaSteps(sagaDeps),
+		OnFinish: s.onTransferSagaFinished,
+	})
+	return s
+}
+
+// StartSagaRecovery resumes or compensates sagas left unfinished by a restart, then keeps
+// picking up sagas abandoned by other instances. Blocks until ctx is done.
+func (s *OrderService) StartSagaRecovery(ctx context.Context) {
+	s.orchestrator.RecoverLoop(ctx, SagaRecoveryInterval)
 }
 
-// CreateOrder initiates the booking saga with transactional outbox event
+// CreateOrder initiates the booking saga with transactional outbox event. Cash orders are
+// sold by a counter agent in their open shift, and are booked before CreateOrder returns
+// so the agent can print the tickets straight away. Account orders are charged to the
+// account's credit instead of being paid, and may be as large as its group size.
+// Every order's tickets are counted against the anti-scalp limits.
 func (s *OrderService) CreateOrder(ctx context.Context, req *CreateOrderRequest) (*domain.Order, error) {
 	// Idempotency check
 	if req.IdempotencyKey != "" {
@@ -68,6 +121,21 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *CreateOrderRequest)
 		}
 	}
 
+	var shift *domain.AgentShift
+	if req.PaymentMethod == domain.PaymentMethodCash {
+		if req.AgentID == "" {
+			return nil, domain.ErrCashNotAllowed
+		}
+		var err error
+		if shift, err = s.shiftRepo.GetOpen(ctx, req.OrgID, req.AgentID); err != nil {
+			return nil, err
+		}
+	}
+	account, err := s.accountForOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	// Start transaction
 	tx, err := s.orderRepo.BeginTx(ctx)
 	if err != nil {
@@ -79,106 +147,64 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *CreateOrderRequest)
 		return nil, fmt.Errorf("pricing dependencies unavailable")
 	}
 
-	trip, err := s.catalogClient.GetTrip(ctx, req.OrgID, req.TripID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch trip: %w", err)
+	legReqs := req.allLegs()
+	legs := make([]domain.OrderLeg, 0, len(legReqs))
+	currency := ""
+	for i, legReq := range legReqs {
+		leg, legCurrency, err := s.priceLeg(ctx, req, legReq)
+		if err != nil {
+			if len(legReqs) > 1 {
+				return nil, fmt.Errorf("leg %d: %w", i+1, err)
+			}
+			return nil, err
+		}
+		if i > 0 && legCurrency != currency {
+			return nil, fmt.Errorf("legs are priced in different currencies")
+		}
+		currency = legCurrency
+		legs = append(legs, *leg)
 	}
-	seatMap, err := s.inventoryClient.GetSeatMap(ctx, req.OrgID, req.TripID, req.FromStation, req.ToStation)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch seat map: %w", err)
+
+	if err := s.checkTicketLimits(ctx, req, account); err != nil {
+		return nil, err
 	}
-	seatInfoMap := buildSeatInfoMap(seatMap)
 
-	// Create order record
+	// Create order record; a multi-leg order is paid for all legs at once
 	order := &domain.Order{
 		OrganizationID: req.OrgID,
 		UserID:         req.UserID,
-		TripID:         req.TripID,
-		RouteID:        trip.RouteId,
-		FromStationID:  req.FromStation,
-		ToStationID:    req.ToStation,
-		HoldID:         req.HoldID,
-		Passengers:     convertPassengers(req.Passengers),
 		PaymentMethod:  req.PaymentMethod,
 		PaymentStatus:  domain.PaymentStatusPending,
 		Status:         domain.OrderStatusPending,
 		ContactEmail:   req.Email,
 		ContactPhone:   req.Phone,
-		Currency:       DefaultCurrency,
-		ExpiresAt:      time.Now().Add(15 * time.Minute),
+		Currency:       currency,
+		ExpiresAt:      time.Now().Add(OrderPaymentWindow),
 		IdempotencyKey: req.IdempotencyKey,
+		AgentID:        req.AgentID,
+		ClientIP:       req.IPAddress,
 	}
-
-	serviceDate := trip.ServiceDate
-	if serviceDate == "" && trip.DepartureTime > 0 {
-		serviceDate = time.Unix(trip.DepartureTime, 0).Format("2006-01-02")
+	if shift != nil {
+		order.ShiftID = shift.ID
 	}
-	occupancyRate := calculateOccupancyRate(trip.TotalSeats, trip.AvailableSeats)
-
-	seatPrices := make(map[string]int64)
-	var baseSubtotal int64
-	for i, passenger := range order.Passengers {
-		seatDetail, ok := seatInfoMap[passenger.SeatID]
-		if !ok {
-			return nil, fmt.Errorf("seat %s not found in seat map", passenger.SeatID)
-		}
-		seatClass := seatDetail.SeatClass
-		if seatClass == "" {
-			seatClass = trip.VehicleClass
-		}
-		seatCategory := seatDetail.SeatType
-		if seatCategory == "" {
-			seatCategory = seatClass
-		}
-		order.Passengers[i].SeatClass = seatClass
-		order.Passengers[i].SeatNumber = seatDetail.SeatNumber
-
-		basePrice := resolveBasePrice(trip.Pricing, req.FromStation, req.ToStation, seatClass, seatCategory)
-		if basePrice <= 0 {
-			return nil, fmt.Errorf("invalid base price for seat %s", passenger.SeatID)
-		}
-		baseSubtotal += basePrice
-		priceResp, err := s.pricingClient.CalculatePrice(ctx, &pricingpb.CalculatePriceRequest{
-			TripId:         trip.Id,
-			SeatClass:      seatClass,
-			SeatCategory:   seatCategory,
-			Date:           serviceDate,
-			Quantity:       1,
-			BasePricePaisa: basePrice,
-			OccupancyRate:  occupancyRate,
-			OrganizationId: req.OrgID,
-			DepartureTime:  trip.DepartureTime,
-			RouteId:        trip.RouteId,
-			ScheduleId:     trip.ScheduleId,
-			FromStationId:  req.FromStation,
-			ToStationId:    req.ToStation,
-			VehicleType:    trip.VehicleType,
-			VehicleClass:   trip.VehicleClass,
-			PromoCode:      req.CouponCode,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("pricing calculation failed: %w", err)
-		}
-		seatPrices[passenger.SeatID] = priceResp.FinalPricePaisa
+	if account != nil {
+		order.AccountID = account.ID
 	}
+	order.SetLegs(legs)
 
-	order.SubtotalPaisa = 0
-	for _, price := range seatPrices {
-		order.SubtotalPaisa += price
-	}
-	if baseSubtotal > order.SubtotalPaisa {
-		order.DiscountPaisa = baseSubtotal - order.SubtotalPaisa
-	}
-	if trip.Pricing != nil {
-		order.TaxPaisa = trip.Pricing.TaxPaisa * int64(len(order.Passengers))
-		order.BookingFeePaisa = trip.Pricing.BookingFeePaisa * int64(len(order.Passengers))
-		if trip.Pricing.Currency != "" {
-			order.Currency = trip.Pricing.Currency
+	// The tickets counted above are given back unless the order is saved to hold them
+	committed := false
+	defer func() {
+		if !committed {
+			s.releaseTicketLimits(context.WithoutCancel(ctx), order)
 		}
+	}()
+
+	if account != nil && order.TotalPaisa > account.AvailableCreditPaisa() {
+		return nil, domain.ErrCreditLimitExceeded
 	}
-	order.TotalPaisa = order.SubtotalPaisa + order.TaxPaisa + order.BookingFeePaisa - order.DiscountPaisa
-	if order.TotalPaisa < 0 {
-		order.TotalPaisa = 0
+	if order.PNR, err = s.newPNR(ctx); err != nil {
+		return nil, fmt.Errorf("failed to assign PNR: %w", err)
 	}
 
 	// Create order in transaction
@@ -196,6 +222,7 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *CreateOrderRequest)
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
+	committed = true
 
 	// Create booking saga
 	bookingReq := &saga.BookingRequest{
@@ -206,15 +233,32 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *CreateOrderRequest)
 		HoldID:        order.HoldID,
 		FromStation:   order.FromStationID,
 		ToStation:     order.ToStationID,
-		Passengers:    convertToSagaPassengers(req.Passengers),
+		Passengers:    convertToSagaPassengers(legReqs[0].Passengers),
 		PaymentToken:  req.PaymentToken,
 		PaymentMethod: req.PaymentMethod,
+		AccountID:     order.AccountID,
 		TotalPaisa:    order.TotalPaisa,
 		Email:         order.ContactEmail,
 		Phone:         order.ContactPhone,
+
+		IPAddress:         req.IPAddress,
+		UserAgent:         req.UserAgent,
+		DeviceFingerprint: req.DeviceFingerprint,
+		QuotaClaims:       req.QuotaClaims,
+	}
+	if len(legReqs) > 1 {
+		for _, legReq := range legReqs {
+			bookingReq.Legs = append(bookingReq.Legs, saga.BookingLeg{
+				TripID:      legReq.TripID,
+				HoldID:      legReq.HoldID,
+				FromStation: legReq.FromStation,
+				ToStation:   legReq.ToStation,
+				Passengers:  convertToSagaPassengers(legReq.Passengers),
+			})
+		}
 	}
 
-	sagaInstance := saga.NewBookingSaga(s.sagaDeps, bookingReq)
+	sagaInstance := saga.NewBookingSaga(s.orchestrator, s.sagaDeps, bookingReq)
 	order.SagaID = sagaInstance.ID
 
 	// Update order with saga ID
@@ -222,21 +266,183 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *CreateOrderRequest)
 		return nil, fmt.Errorf("failed to update order with saga ID: %w", err)
 	}
 
+	if order.PaymentMethod == domain.PaymentMethodCash {
+		// The passenger is waiting at the counter; the booking must not stop if they walk off
+		execCtx := context.WithoutCancel(ctx)
+		err := s.orchestrator.Execute(execCtx, sagaInstance)
+		s.finishBooking(execCtx, order, sagaInstance, err)
+		return order, nil
+	}
+
 	// Execute saga asynchronously with outbox event on completion
 	go func() {
 		execCtx := context.Background()
-		if err := s.orchestrator.Execute(execCtx, sagaInstance); err != nil {
-			// Update order status on failure and publish event
-			s.handleOrderFailed(execCtx, order, err.Error(), fmt.Sprintf("%v", sagaInstance.Status))
-		} else {
-			// Update order status on success and publish event
-			s.handleOrderConfirmed(execCtx, order, sagaInstance)
-		}
+		err := s.orchestrator.Execute(execCtx, sagaInstance)
+		s.finishBooking(execCtx, order, sagaInstance, err)
 	}()
 
 	return order, nil
 }
 
+// priceLeg prices the passengers of one trip of an order against its seat map and fares
+func (s *OrderService) priceLeg(ctx context.Context, req *CreateOrderRequest, leg LegRequest) (*domain.OrderLeg, string, error) {
+	trip, err := s.catalogClient.GetTrip(ctx, req.OrgID, leg.TripID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch trip: %w", err)
+	}
+	seatMap, err := s.inventoryClient.GetSeatMap(ctx, req.OrgID, leg.TripID, leg.FromStation, leg.ToStation)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch seat map: %w", err)
+	}
+	seatInfoMap := buildSeatInfoMap(seatMap)
+
+	// Deck and standing passengers have no seat on the map; their classes come from capacity
+	capacityPrices := make(map[string]int64)
+	for _, p := range leg.Passengers {
+		if p.CapacityClass == "" {
+			continue
+		}
+		capacity, err := s.inventoryClient.GetCapacity(ctx, req.OrgID, leg.TripID, leg.FromStation, leg.ToStation)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to fetch capacity: %w", err)
+		}
+		for _, c := range capacity {
+			capacityPrices[c.CapacityClass] = c.PricePaisa
+		}
+		break
+	}
+
+	priced := &domain.OrderLeg{
+		TripID:        leg.TripID,
+		RouteID:       trip.RouteId,
+		FromStationID: leg.FromStation,
+		ToStationID:   leg.ToStation,
+		HoldID:        leg.HoldID,
+		Passengers:    convertPassengers(leg.Passengers),
+	}
+	currency := DefaultCurrency
+
+	serviceDate := trip.ServiceDate
+	if serviceDate == "" && trip.DepartureTime > 0 {
+		serviceDate = time.Unix(trip.DepartureTime, 0).Format("2006-01-02")
+	}
+	occupancyRate := calculateOccupancyRate(trip.TotalSeats, trip.AvailableSeats)
+
+	passengerPrices := make([]int64, len(priced.Passengers))
+	var baseSubtotal int64
+	for i, passenger := range priced.Passengers {
+		var seatClass, seatCategory string
+		var basePrice int64
+		if passenger.CapacityClass != "" {
+			capacityPrice, ok := capacityPrices[passenger.CapacityClass]
+			if !ok {
+				return nil, "", fmt.Errorf("capacity class %s not sold on this trip", passenger.CapacityClass)
+			}
+			seatClass = passenger.CapacityClass
+			seatCategory = passenger.CapacityClass
+			priced.Passengers[i].SeatClass = seatClass
+
+			basePrice = resolveBasePrice(trip.Pricing, leg.FromStation, leg.ToStation, seatClass, seatCategory)
+			if basePrice <= 0 {
+				basePrice = capacityPrice
+			}
+		} else {
+			seatDetail, ok := seatInfoMap[passenger.SeatID]
+			if !ok {
+				return nil, "", fmt.Errorf("seat %s not found in seat map", passenger.SeatID)
+			}
+			seatClass = seatDetail.SeatClass
+			if seatClass == "" {
+				seatClass = trip.VehicleClass
+			}
+			seatCategory = seatDetail.SeatType
+			if seatCategory == "" {
+				seatCategory = seatClass
+			}
+			priced.Passengers[i].SeatClass = seatClass
+			priced.Passengers[i].SeatNumber = seatDetail.SeatNumber
+
+			basePrice = resolveBasePrice(trip.Pricing, leg.FromStation, leg.ToStation, seatClass, seatCategory)
+		}
+		if basePrice <= 0 {
+			return nil, "", fmt.Errorf("invalid base price for passenger %d", i)
+		}
+		baseSubtotal += basePrice
+		priceResp, err := s.pricingClient.CalculatePrice(ctx, &pricingpb.CalculatePriceRequest{
+			TripId:         trip.Id,
+			SeatClass:      seatClass,
+			SeatCategory:   seatCategory,
+			Date:           serviceDate,
+			Quantity:       1,
+			BasePricePaisa: basePrice,
+			OccupancyRate:  occupancyRate,
+			OrganizationId: req.OrgID,
+			DepartureTime:  trip.DepartureTime,
+			RouteId:        trip.RouteId,
+			ScheduleId:     trip.ScheduleId,
+			FromStationId:  leg.FromStation,
+			ToStationId:    leg.ToStation,
+			VehicleType:    trip.VehicleType,
+			VehicleClass:   trip.VehicleClass,
+			PromoCode:      req.CouponCode,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("pricing calculation failed: %w", err)
+		}
+		passengerPrices[i] = priceResp.FinalPricePaisa
+	}
+
+	priced.SubtotalPaisa = 0
+	for _, price := range passengerPrices {
+		priced.SubtotalPaisa += price
+	}
+	if baseSubtotal > priced.SubtotalPaisa {
+		priced.DiscountPaisa = baseSubtotal - priced.SubtotalPaisa
+	}
+	if trip.Pricing != nil {
+		priced.TaxPaisa = trip.Pricing.TaxPaisa * int64(len(priced.Passengers))
+		priced.BookingFeePaisa = trip.Pricing.BookingFeePaisa * int64(len(priced.Passengers))
+		if trip.Pricing.Currency != "" {
+			currency = trip.Pricing.Currency
+		}
+	}
+	priced.TotalPaisa = priced.SubtotalPaisa + priced.TaxPaisa + priced.BookingFeePaisa - priced.DiscountPaisa
+	if priced.TotalPaisa < 0 {
+		priced.TotalPaisa = 0
+	}
+	return priced, currency, nil
+}
+
+// finishBooking records the outcome of a booking saga on its order
+func (s *OrderService) finishBooking(ctx context.Context, order *domain.Order, sagaInstance *saga.Saga, err error) {
+	switch {
+	case errors.Is(err, saga.ErrSagaLeaseLost):
+		// Another instance took the saga over and will finish the order
+	case errors.Is(err, saga.ErrSagaSuspended):
+		// The fraud check held the booking; an analyst's decision runs the saga on
+		s.handleOrderReviewPending(ctx, order, sagaInstance)
+	case err != nil:
+		// Update order status on failure and publish event
+		s.handleOrderFailed(ctx, order, err.Error(), fmt.Sprintf("%v", sagaInstance.Status))
+	default:
+		// Update order status on success and publish event
+		s.handleOrderConfirmed(ctx, order, sagaInstance)
+	}
+}
+
+// onBookingSagaFinished finishes the order of a booking saga that was recovered or retried
+func (s *OrderService) onBookingSagaFinished(ctx context.Context, sagaInstance *saga.Saga, err error) {
+	order, loadErr := s.orderRepo.GetByID(ctx, sagaInstance.Context.GetString("order_id"), sagaInstance.Context.GetString("user_id"))
+	if loadErr != nil {
+		logger.Error("Failed to load order of recovered booking saga", "saga_id", sagaInstance.ID, "error", loadErr)
+		return
+	}
+	if order.Status != domain.OrderStatusPending && order.Status != domain.OrderStatusFailed {
+		return
+	}
+	s.finishBooking(ctx, order, sagaInstance, err)
+}
+
 // handleOrderConfirmed updates order and publishes confirmation event
 func (s *OrderService) handleOrderConfirmed(ctx context.Context, order *domain.Order, sagaInstance *saga.Saga) {
 	tx, err := s.orderRepo.BeginTx(ctx)
@@ -249,12 +455,30 @@ func (s *OrderService) handleOrderConfirmed(ctx context.Context, order *domain.O
 	order.PaymentStatus = domain.PaymentStatusCaptured
 	order.BookingID = sagaInstance.Context.GetString("booking_id")
 	order.PaymentID = sagaInstance.Context.GetString("payment_id")
+	var seats []saga.ConfirmedSeat
+	if sagaInstance.Context.Decode("confirmed_seats", &seats) {
+		order.Seats = convertConfirmedSeats(seats)
+	}
+	var legBookings []saga.LegBooking
+	if order.IsMultiLeg() && sagaInstance.Context.Decode("leg_bookings", &legBookings) {
+		for i := range order.Legs {
+			if i < len(legBookings) {
+				order.Legs[i].BookingID = legBookings[i].BookingID
+				order.Legs[i].Seats = convertConfirmedSeats(legBookings[i].Seats)
+			}
+		}
+		order.SetLegs(order.Legs)
+	}
 
 	txRepo := repository.NewTxOrderRepository(tx)
 	if err := txRepo.UpdateTx(ctx, order); err != nil {
 		return
 	}
 
+	if err := s.recordCashSale(ctx, tx, order); err != nil {
+		return
+	}
+
 	if err := s.publisher.PublishOrderConfirmed(ctx, tx, order); err != nil {
 		return
 	}
@@ -281,7 +505,85 @@ func (s *OrderService) handleOrderFailed(ctx context.Context, order *domain.Orde
 		return
 	}
 
-	tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return
+	}
+	s.releaseTicketLimits(ctx, order)
+}
+
+// ReaccommodateOrder applies the seat changes inventory made when the trip's vehicle was swapped.
+// Moved passengers get their new seat and ticket; unplaced passengers lose their seat.
+// The change is published so tickets are reissued and the customer is told.
+func (s *OrderService) ReaccommodateOrder(ctx context.Context, req *ReaccommodateOrderRequest) error {
+	order, err := s.orderRepo.GetByID(ctx, req.OrderID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if order.Status != domain.OrderStatusConfirmed {
+		return nil
+	}
+
+	// On a multi-leg order only the leg booked on the changed trip moves
+	seats, passengers := order.Seats, order.Passengers
+	leg := -1
+	for i := range order.Legs {
+		if order.Legs[i].BookingID == req.BookingID {
+			leg = i
+			seats, passengers = order.Legs[i].Seats, order.Legs[i].Passengers
+		}
+	}
+	if leg < 0 && order.BookingID != req.BookingID {
+		return nil
+	}
+
+	changes := make(map[string]domain.SeatReassignment, len(req.Moved)+len(req.Unplaced))
+	for _, r := range append(append([]domain.SeatReassignment{}, req.Moved...), req.Unplaced...) {
+		if r.OldTicketID != "" {
+			changes["ticket:"+r.OldTicketID] = r
+		}
+		if r.OldSeatID != "" {
+			changes["seat:"+r.OldSeatID] = r
+		}
+	}
+
+	for i := range seats {
+		seat := &seats[i]
+		r, ok := changes["ticket:"+seat.TicketID]
+		if !ok {
+			r, ok = changes["seat:"+seat.SeatID]
+		}
+		if !ok || seat.SeatID == "" {
+			continue
+		}
+		seat.SeatID, seat.SeatNumber = r.NewSeatID, r.NewSeatNumber
+		if r.NewTicketID != "" {
+			seat.TicketID = r.NewTicketID
+		}
+	}
+	for i := range passengers {
+		p := &passengers[i]
+		if r, ok := changes["seat:"+p.SeatID]; ok && p.SeatID != "" {
+			p.SeatID, p.SeatNumber = r.NewSeatID, r.NewSeatNumber
+		}
+	}
+	if leg >= 0 {
+		order.SetLegs(order.Legs)
+	}
+
+	tx, err := s.orderRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	txRepo := repository.NewTxOrderRepository(tx)
+	if err := txRepo.UpdateTx(ctx, order); err != nil {
+		return err
+	}
+	if err := s.publisher.PublishOrderReaccommodated(ctx, tx, order, req.OldVehicleID, req.NewVehicleID, req.Moved, req.Unplaced); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // GetOrder retrieves an order by ID
@@ -309,8 +611,10 @@ func (s *OrderService) ListOrders(ctx context.Context, userID, status string, pa
 	return orders, total, nextToken, nil
 }
 
-// CancelOrder initiates the cancellation saga with transactional outbox event
-func (s *OrderService) CancelOrder(ctx context.Context, orderID, userID, reason string) (*domain.Order, *RefundInfo, error) {
+// CancelOrder initiates the cancellation saga with transactional outbox event.
+// The refund is computed under the refund policy at cancel time; when expectedRefund is set
+// (the quote the user accepted) a lower refund rejects the cancellation with ErrRefundQuoteChanged.
+func (s *OrderService) CancelOrder(ctx context.Context, orderID, userID, reason string, expectedRefund int64) (*domain.Order, *RefundInfo, error) {
 	order, err := s.orderRepo.GetByID(ctx, orderID, userID)
 	if err != nil {
 		return nil, nil, err
@@ -318,60 +622,127 @@ func (s *OrderService) CancelOrder(ctx context.Context, orderID, userID, reason
 
 	// Check if cancellable
 	if order.Status != domain.OrderStatusConfirmed {
-		return nil, nil, fmt.Errorf("order cannot be cancelled in status: %s", order.Status)
+		return nil, nil, fmt.Errorf("%w: %s", ErrOrderNotCancellable, order.Status)
+	}
+
+	quote, err := s.quoteRefund(ctx, order, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	if expectedRefund > 0 && quote.RefundPaisa < expectedRefund {
+		return nil, nil, ErrRefundQuoteChanged
 	}
 
 	// Create cancellation saga
 	cancellationSaga := saga.NewCancellationSaga(
+		s.orchestrator,
 		s.sagaDeps,
 		order.ID,
 		order.UserID,
+		order.OrganizationID,
 		order.BookingID,
 		order.PaymentID,
 		order.ContactEmail,
 		order.ContactPhone,
-		order.TotalPaisa,
+		quote.RefundPaisa,
+		reason,
 	)
+	cancellationSaga.Context.Set("refund_breakdown", quote)
+	if order.IsMultiLeg() {
+		cancellationSaga.Context.Set("booking_ids", order.BookingIDs())
+	}
 
 	// Execute cancellation saga
 	if err := s.orchestrator.Execute(ctx, cancellationSaga); err != nil {
 		return nil, nil, fmt.Errorf("cancellation failed: %w", err)
 	}
 
+	refund, err := s.completeCancellation(ctx, order, cancellationSaga, reason)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, refund, nil
+}
+
+// onCancellationSagaFinished marks the order refunded once a recovered or retried cancellation saga succeeds
+func (s *OrderService) onCancellationSagaFinished(ctx context.Context, sagaInstance *saga.Saga, err error) {
+	if err != nil {
+		logger.Error("Recovered cancellation saga did not complete", "saga_id", sagaInstance.ID,
+			"order_id", sagaInstance.Context.GetString("order_id"), "error", err)
+		return
+	}
+	order, err := s.orderRepo.GetByID(ctx, sagaInstance.Context.GetString("order_id"), sagaInstance.Context.GetString("user_id"))
+	if err != nil {
+		logger.Error("Failed to load order of recovered cancellation saga", "saga_id", sagaInstance.ID, "error", err)
+		return
+	}
+	if order.Status != domain.OrderStatusConfirmed {
+		return
+	}
+	if _, err := s.completeCancellation(ctx, order, sagaInstance, sagaInstance.Context.GetString("reason")); err != nil {
+		logger.Error("Failed to complete recovered cancellation", "order_id", order.ID, "error", err)
+	}
+}
+
+// completeCancellation marks a cancelled order refunded and publishes the cancellation
+func (s *OrderService) completeCancellation(ctx context.Context, order *domain.Order, cancellationSaga *saga.Saga, reason string) (*RefundInfo, error) {
 	// Start transaction for final update
 	tx, err := s.orderRepo.BeginTx(ctx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	defer tx.Rollback()
 
 	refundID := cancellationSaga.Context.GetString("refund_id")
+	amount := cancellationSaga.Context.GetInt64("refund_amount")
+
+	var breakdown domain.RefundBreakdown
+	if cancellationSaga.Context.Decode("refund_breakdown", &breakdown) {
+		order.Refund = &breakdown
+	}
 
-	// Update order status
-	order.Status = domain.OrderStatusRefunded
-	order.PaymentStatus = domain.PaymentStatusRefunded
+	// Update order status; a cancellation the policy refunds nothing for keeps the payment
+	refundStatus := "completed"
+	switch {
+	case amount <= 0:
+		order.Status = domain.OrderStatusCancelled
+		refundStatus = "not_refundable"
+	case amount < order.TotalPaisa:
+		order.Status = domain.OrderStatusRefunded
+		order.PaymentStatus = domain.PaymentStatusPartiallyRefunded
+	default:
+		order.Status = domain.OrderStatusRefunded
+		order.PaymentStatus = domain.PaymentStatusRefunded
+	}
 
 	txRepo := repository.NewTxOrderRepository(tx)
 	if err := txRepo.UpdateTx(ctx, order); err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	if err := s.recordCashRefund(ctx, tx, order, amount); err != nil {
+		return nil, err
+	}
+	if err := s.recordAccountRefund(ctx, tx, order, cancellationSaga.ID, amount); err != nil {
+		return nil, err
 	}
 
 	// Publish cancellation event
-	if err := s.publisher.PublishOrderCancelled(ctx, tx, order, refundID, order.TotalPaisa, reason); err != nil {
-		return nil, nil, err
+	if err := s.publisher.PublishOrderCancelled(ctx, tx, order, refundID, amount, reason); err != nil {
+		return nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	s.releaseTicketLimits(ctx, order)
 
-	refund := &RefundInfo{
+	return &RefundInfo{
 		RefundID:    refundID,
-		AmountPaisa: order.TotalPaisa,
-		Status:      "completed",
-	}
-
-	return order, refund, nil
+		AmountPaisa: amount,
+		Status:      refundStatus,
+		Breakdown:   order.Refund,
+	}, nil
 }
 
 // GetOrderStatus returns order and saga status
@@ -381,7 +752,7 @@ func (s *OrderService) GetOrderStatus(ctx context.Context, orderID, userID strin
 		return nil, nil, err
 	}
 
-	sagaInstance, _ := s.orchestrator.GetSaga(order.SagaID)
+	sagaInstance, _ := s.orchestrator.GetSaga(ctx, order.SagaID)
 	return order, sagaInstance, nil
 }
 
@@ -401,6 +772,41 @@ type CreateOrderRequest struct {
 	Phone          string
 	CouponCode     string
 	IdempotencyKey string
+	// Round-trip and multi-leg orders; the single-trip fields above are ignored when set
+	Legs []LegRequest
+	// Client the order was placed from, for fraud scoring
+	IPAddress         string
+	UserAgent         string
+	DeviceFingerprint string
+	// Counter agent selling the order; only agents take cash
+	AgentID string
+	// Account the order is charged to; account payments only
+	AccountID string
+	// Reserved seat quotas the order's seats may be held from
+	QuotaClaims *saga.QuotaClaims
+}
+
+// LegRequest is one trip of a round-trip or multi-leg order
+type LegRequest struct {
+	TripID      string
+	FromStation string
+	ToStation   string
+	HoldID      string
+	Passengers  []PassengerRequest
+}
+
+// allLegs returns the trips to book; a single-trip request is one leg
+func (r *CreateOrderRequest) allLegs() []LegRequest {
+	if len(r.Legs) > 0 {
+		return r.Legs
+	}
+	return []LegRequest{{
+		TripID:      r.TripID,
+		FromStation: r.FromStation,
+		ToStation:   r.ToStation,
+		HoldID:      r.HoldID,
+		Passengers:  r.Passengers,
+	}}
 }
 
 type PassengerRequest struct {
@@ -410,12 +816,25 @@ type PassengerRequest struct {
 	DateOfBirth string
 	Gender      string
 	Age         int
+	// Deck or standing passengers carry a capacity class instead of a seat
+	CapacityClass string
 }
 
 type RefundInfo struct {
 	RefundID    string
 	AmountPaisa int64
 	Status      string
+	Breakdown   *domain.RefundBreakdown
+}
+
+type ReaccommodateOrderRequest struct {
+	OrderID      string
+	UserID       string
+	BookingID    string
+	OldVehicleID string
+	NewVehicleID string
+	Moved        []domain.SeatReassignment
+	Unplaced     []domain.SeatReassignment
 }
 
 // --- Helpers ---
@@ -424,11 +843,12 @@ func convertPassengers(reqs []PassengerRequest) []domain.OrderPassenger {
 	var passengers []domain.OrderPassenger
 	for _, r := range reqs {
 		passengers = append(passengers, domain.OrderPassenger{
-			NID:    r.NID,
-			Name:   r.Name,
-			SeatID: r.SeatID,
-			Gender: r.Gender,
-			Age:    r.Age,
+			NID:           r.NID,
+			Name:          r.Name,
+			SeatID:        r.SeatID,
+			Gender:        r.Gender,
+			Age:           r.Age,
+			CapacityClass: r.CapacityClass,
 		})
 	}
 	return passengers
@@ -438,16 +858,35 @@ func convertToSagaPassengers(reqs []PassengerRequest) []saga.PassengerInfo {
 	var passengers []saga.PassengerInfo
 	for _, r := range reqs {
 		passengers = append(passengers, saga.PassengerInfo{
-			NID:         r.NID,
-			Name:        r.Name,
-			SeatID:      r.SeatID,
-			DateOfBirth: r.DateOfBirth,
-			Gender:      r.Gender,
+			NID:           r.NID,
+			Name:          r.Name,
+			SeatID:        r.SeatID,
+			DateOfBirth:   r.DateOfBirth,
+			Gender:        r.Gender,
+			CapacityClass: r.CapacityClass,
 		})
 	}
 	return passengers
 }
 
+func convertConfirmedSeats(confirmed []saga.ConfirmedSeat) []domain.BookedSeat {
+	seats := make([]domain.BookedSeat, 0, len(confirmed))
+	for _, c := range confirmed {
+		seats = append(seats, domain.BookedSeat{
+			SeatID:         c.SeatID,
+			SeatNumber:     c.SeatNumber,
+			SeatClass:      c.SeatClass,
+			TicketID:       c.TicketID,
+			PricePaisa:     c.PricePaisa,
+			PassengerIndex: c.PassengerIndex,
+			FromStationID:  c.FromStationID,
+			ToStationID:    c.ToStationID,
+			CapacityClass:  c.CapacityClass,
+		})
+	}
+	return seats
+}
+
 type seatInfo struct {
 	SeatNumber string
 	SeatClass  string
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/saga"
+)
+
+type CancelPassengersRequest struct {
+	OrderID          string
+	UserID           string
+	PassengerIndexes []int
+	SeatIDs          []string // Cancels the passengers holding these seats
+	Reason           string
+	ExpectedRefund   int64 // Quote the user accepted; a lower refund rejects the cancellation
+}
+
+// CancelPassengers cancels some passengers of a confirmed order. Their seats are released
+// across their segments, their tickets are cancelled and they are refunded their share of
+// the order under the refund policy; the rest of the order stays confirmed with its totals
+// reduced. Selecting every remaining passenger cancels the whole order.
+func (s *OrderService) CancelPassengers(ctx context.Context, req *CancelPassengersRequest) (*domain.Order, *RefundInfo, error) {
+	order, part, indexes, err := s.passengersPart(ctx, req.OrderID, req.UserID, req.PassengerIndexes, req.SeatIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(indexes) == len(order.ActivePassengers()) {
+		return s.CancelOrder(ctx, req.OrderID, req.UserID, req.Reason, req.ExpectedRefund)
+	}
+
+	quote, err := s.quoteRefund(ctx, part, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.ExpectedRefund > 0 && quote.RefundPaisa < req.ExpectedRefund {
+		return nil, nil, ErrRefundQuoteChanged
+	}
+
+	cancellationSaga := saga.NewPassengerCancellationSaga(
+		s.orchestrator,
+		s.sagaDeps,
+		order.ID,
+		order.UserID,
+		order.OrganizationID,
+		order.BookingID,
+		order.PaymentID,
+		order.ContactEmail,
+		order.ContactPhone,
+		indexes,
+		quote.RefundPaisa,
+		req.Reason,
+	)
+	cancellationSaga.Context.Set("refund_breakdown", quote)
+
+	if err := s.orchestrator.Execute(ctx, cancellationSaga); err != nil {
+		return nil, nil, fmt.Errorf("cancellation failed: %w", err)
+	}
+
+	refund, err := s.completePassengerCancellation(ctx, order, cancellationSaga)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, refund, nil
+}
+
+// QuotePassengerRefund tells the user what cancelling some passengers now would refund
+func (s *OrderService) QuotePassengerRefund(ctx context.Context, orderID, userID string, indexes []int, seatIDs []string) (*domain.Order, *domain.RefundBreakdown, error) {
+	order, part, _, err := s.passengersPart(ctx, orderID, userID, indexes, seatIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	quote, err := s.quoteRefund(ctx, part, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, quote, nil
+}
+
+// passengersPart loads a confirmed order and the share of it owed for the selected
+// passengers, whose indexes it returns sorted
+func (s *OrderService) passengersPart(ctx context.Context, orderID, userID string, indexes []int, seatIDs []string) (*domain.Order, *domain.Order, []int, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if order.Status != domain.OrderStatusConfirmed {
+		return nil, nil, nil, fmt.Errorf("%w: %s", ErrOrderNotCancellable, order.Status)
+	}
+	if order.IsMultiLeg() {
+		return nil, nil, nil, fmt.Errorf("%w: passengers of a multi-leg order are cancelled with the whole order", ErrOrderNotCancellable)
+	}
+
+	selected := make(map[int]bool)
+	for _, idx := range indexes {
+		selected[idx] = true
+	}
+	if len(seatIDs) > 0 {
+		bySeat := order.PassengerIndexesForSeats(seatIDs)
+		if len(bySeat) == 0 {
+			return nil, nil, nil, domain.ErrInvalidPassengers
+		}
+		for _, idx := range bySeat {
+			selected[idx] = true
+		}
+	}
+	all := make([]int, 0, len(selected))
+	for idx := range selected {
+		all = append(all, idx)
+	}
+	sort.Ints(all)
+
+	part, err := order.PassengersPart(all)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return order, part, all, nil
+}
+
+// onPassengerCancellationSagaFinished updates the order once a recovered or retried passenger cancellation succeeds
+func (s *OrderService) onPassengerCancellationSagaFinished(ctx context.Context, sagaInstance *saga.Saga, err error) {
+	if err != nil {
+		logger.Error("Recovered passenger cancellation saga did not complete", "saga_id", sagaInstance.ID,
+			"order_id", sagaInstance.Context.GetString("order_id"), "error", err)
+		return
+	}
+	order, err := s.orderRepo.GetByID(ctx, sagaInstance.Context.GetString("order_id"), sagaInstance.Context.GetString("user_id"))
+	if err != nil {
+		logger.Error("Failed to load order of recovered passenger cancellation saga", "saga_id", sagaInstance.ID, "error", err)
+		return
+	}
+	if order.Status != domain.OrderStatusConfirmed {
+		return
+	}
+	if _, err := s.completePassengerCancellation(ctx, order, sagaInstance); err != nil {
+		logger.Error("Failed to complete recovered passenger cancellation", "order_id", order.ID, "error", err)
+	}
+}
+
+// completePassengerCancellation takes the cancelled passengers off the order and publishes
+// the cancellation. A saga already applied to the order is not applied twice.
+func (s *OrderService) completePassengerCancellation(ctx context.Context, order *domain.Order, cancellationSaga *saga.Saga) (*RefundInfo, error) {
+	refundID := cancellationSaga.Context.GetString("refund_id")
+	amount := cancellationSaga.Context.GetInt64("refund_amount")
+
+	var breakdown *domain.RefundBreakdown
+	var decoded domain.RefundBreakdown
+	if cancellationSaga.Context.Decode("refund_breakdown", &decoded) {
+		breakdown = &decoded
+	}
+	info := &RefundInfo{
+		RefundID:    refundID,
+		AmountPaisa: amount,
+		Status:      "completed",
+		Breakdown:   breakdown,
+	}
+	if amount <= 0 {
+		info.Status = "not_refundable"
+	}
+
+	for _, c := range order.PassengerCancellations {
+		if c.SagaID == cancellationSaga.ID {
+			return info, nil
+		}
+	}
+
+	var indexes []int
+	cancellationSaga.Context.Decode("passenger_indexes", &indexes)
+	part, err := order.PassengersPart(indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	cancellation := domain.PassengerCancellation{
+		SagaID:           cancellationSaga.ID,
+		PassengerIndexes: indexes,
+		Reason:           cancellationSaga.Context.GetString("reason"),
+		RefundID:         refundID,
+		Refund:           breakdown,
+		CancelledAt:      time.Now(),
+	}
+	order.RemovePassengers(part, cancellation)
+	if amount > 0 {
+		order.PaymentStatus = domain.PaymentStatusPartiallyRefunded
+	}
+
+	tx, err := s.orderRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	txRepo := repository.NewTxOrderRepository(tx)
+	if err := txRepo.UpdateTx(ctx, order); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordCashRefund(ctx, tx, order, amount); err != nil {
+		return nil, err
+	}
+	if err := s.recordAccountRefund(ctx, tx, order, cancellationSaga.ID, amount); err != nil {
+		return nil, err
+	}
+
+	recorded := order.PassengerCancellations[len(order.PassengerCancellations)-1]
+	if err := s.publisher.PublishOrderPassengersCancelled(ctx, tx, order, &recorded); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
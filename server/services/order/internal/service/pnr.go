@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+)
+
+// pnrAttempts bounds how many references are drawn before giving up on a unique one
+const pnrAttempts = 5
+
+// newPNR draws a booking reference no other order uses. The unique index on orders
+// still guards against two orders drawing the same one at once.
+func (s *OrderService) newPNR(ctx context.Context) (string, error) {
+	for i := 0; i < pnrAttempts; i++ {
+		pnr, err := domain.NewPNR()
+		if err != nil {
+			return "", err
+		}
+		exists, err := s.orderRepo.PNRExists(ctx, pnr)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return pnr, nil
+		}
+	}
+	return "", fmt.Errorf("no unique PNR after %d attempts", pnrAttempts)
+}
+
+// GetOrderByPNR finds an order for a guest by its booking reference and the phone
+// number or email it was booked with. A wrong contact reads as an unknown PNR, so the
+// lookup cannot be used to learn which references exist.
+func (s *OrderService) GetOrderByPNR(ctx context.Context, pnr, contact string) (*domain.Order, error) {
+	pnr, err := domain.NormalizePNR(pnr)
+	if err != nil {
+		return nil, err
+	}
+	if contact == "" {
+		return nil, domain.ErrContactMissing
+	}
+	order, err := s.orderRepo.GetByPNR(ctx, pnr)
+	if err != nil {
+		return nil, err
+	}
+	if !order.MatchesContact(contact) {
+		return nil, repository.ErrOrderNotFound
+	}
+	return order, nil
+}
+
+// QuoteRefundByPNR tells a guest what cancelling the order found by PNR would refund now
+func (s *OrderService) QuoteRefundByPNR(ctx context.Context, pnr, contact string) (*domain.Order, *domain.RefundBreakdown, error) {
+	order, err := s.GetOrderByPNR(ctx, pnr, contact)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.QuoteRefund(ctx, order.ID, order.UserID)
+}
+
+// CancelOrderByPNR cancels the order found by PNR on behalf of a guest, as CancelOrder
+// would for its owner
+func (s *OrderService) CancelOrderByPNR(ctx context.Context, pnr, contact, reason string, expectedRefund int64) (*domain.Order, *RefundInfo, error) {
+	order, err := s.GetOrderByPNR(ctx, pnr, contact)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.CancelOrder(ctx, order.ID, order.UserID, reason, expectedRefund)
+}
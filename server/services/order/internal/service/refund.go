@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+)
+
+var (
+	ErrOrderNotCancellable = errors.New("order cannot be cancelled in its current status")
+	ErrRefundQuoteChanged  = errors.New("refund is lower than the quote the user accepted")
+)
+
+// SaveRefundPolicy creates or replaces the organization's policy for the policy's route and vehicle class
+func (s *OrderService) SaveRefundPolicy(ctx context.Context, policy *domain.RefundPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	return s.refundPolicyRepo.Upsert(ctx, policy)
+}
+
+// ListRefundPolicies returns an organization's refund policies
+func (s *OrderService) ListRefundPolicies(ctx context.Context, orgID string) ([]*domain.RefundPolicy, error) {
+	return s.refundPolicyRepo.ListByOrg(ctx, orgID)
+}
+
+// DeleteRefundPolicy removes a refund policy; its orders fall back to a broader one
+func (s *OrderService) DeleteRefundPolicy(ctx context.Context, orgID, policyID string) error {
+	return s.refundPolicyRepo.Delete(ctx, orgID, policyID)
+}
+
+// QuoteRefund tells the user what cancelling the order now would refund
+func (s *OrderService) QuoteRefund(ctx context.Context, orderID, userID string) (*domain.Order, *domain.RefundBreakdown, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if order.Status != domain.OrderStatusConfirmed {
+		return nil, nil, ErrOrderNotCancellable
+	}
+
+	quote, err := s.quoteRefund(ctx, order, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, quote, nil
+}
+
+// quoteRefund evaluates the policy covering the order's trip as of now. Each leg of a
+// multi-leg order is quoted under its own trip's policy and departure.
+func (s *OrderService) quoteRefund(ctx context.Context, order *domain.Order, now time.Time) (*domain.RefundBreakdown, error) {
+	if order.IsMultiLeg() {
+		quotes := make([]*domain.RefundBreakdown, 0, len(order.Legs))
+		for i := range order.Legs {
+			quote, err := s.quoteRefund(ctx, order.LegOrder(i), now)
+			if err != nil {
+				return nil, err
+			}
+			quotes = append(quotes, quote)
+		}
+		return domain.CombineRefunds(quotes), nil
+	}
+	if s.catalogClient == nil {
+		return nil, fmt.Errorf("catalog client not configured")
+	}
+	trip, err := s.catalogClient.GetTrip(ctx, order.OrganizationID, order.TripID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch trip: %w", err)
+	}
+
+	routeID := order.RouteID
+	if routeID == "" {
+		routeID = trip.RouteId
+	}
+	policy, err := s.refundPolicyFor(ctx, order.OrganizationID, routeID, trip.VehicleClass)
+	if err != nil {
+		return nil, err
+	}
+	return policy.Quote(order, time.Unix(trip.DepartureTime, 0), now), nil
+}
+
+// refundPolicyFor picks the most specific policy covering the route and vehicle class
+func (s *OrderService) refundPolicyFor(ctx context.Context, orgID, routeID, vehicleClass string) (*domain.RefundPolicy, error) {
+	policies, err := s.refundPolicyRepo.ListByOrg(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load refund policies: %w", err)
+	}
+
+	var best *domain.RefundPolicy
+	for _, p := range policies {
+		if p.Matches(routeID, vehicleClass) && (best == nil || p.Specificity() > best.Specificity()) {
+			best = p
+		}
+	}
+	if best == nil {
+		policy := domain.DefaultRefundPolicy
+		return &policy, nil
+	}
+	return best, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/saga"
+)
+
+const auditEntitySaga = "saga"
+
+// Saga admin actions, as recorded in the audit log
+const (
+	SagaActionRetry      = "saga_retry"
+	SagaActionCompensate = "saga_compensate"
+	SagaActionResolve    = "saga_resolve"
+)
+
+// ListSagas lists persisted sagas for operations staff, oldest first
+func (s *OrderService) ListSagas(ctx context.Context, filter saga.SagaFilter, pageSize int, pageToken string) ([]*saga.Saga, int, string, error) {
+	offset := parsePageToken(pageToken)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	filter.Limit = pageSize
+	filter.Offset = offset
+
+	sagas, total, err := s.orchestrator.ListSagas(ctx, filter)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	nextToken := ""
+	if int64(offset+pageSize) < total {
+		nextToken = generatePageToken(offset + pageSize)
+	}
+
+	return sagas, int(total), nextToken, nil
+}
+
+// GetSagaDetails returns a saga with every step and the admin actions taken on it
+func (s *OrderService) GetSagaDetails(ctx context.Context, sagaID string) (*saga.Saga, []repository.AuditLog, error) {
+	sagaInstance, err := s.orchestrator.InspectSaga(ctx, sagaID)
+	if err != nil {
+		return nil, nil, err
+	}
+	audit, err := s.auditRepo.ListByEntity(ctx, auditEntitySaga, sagaID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sagaInstance, audit, nil
+}
+
+// RetrySaga re-runs a saga from a step, or from its first step not in effect when step is empty
+func (s *OrderService) RetrySaga(ctx context.Context, sagaID, step, actorID, reason string) (*saga.Saga, error) {
+	return s.sagaAction(ctx, sagaID, SagaActionRetry, actorID, map[string]interface{}{"reason": reason, "from_step": step},
+		func() (*saga.Saga, error) {
+			return s.orchestrator.ForceRetry(ctx, sagaID, step)
+		})
+}
+
+// CompensateSaga undoes every step of a failed or stuck saga that is still in effect
+func (s *OrderService) CompensateSaga(ctx context.Context, sagaID, actorID, reason string) (*saga.Saga, error) {
+	return s.sagaAction(ctx, sagaID, SagaActionCompensate, actorID, map[string]interface{}{"reason": reason},
+		func() (*saga.Saga, error) {
+			return s.orchestrator.ForceCompensate(ctx, sagaID, reason)
+		})
+}
+
+// ResolveSaga closes a failed or stuck saga that staff fixed by hand
+func (s *OrderService) ResolveSaga(ctx context.Context, sagaID, actorID, reason string) (*saga.Saga, error) {
+	return s.sagaAction(ctx, sagaID, SagaActionResolve, actorID, map[string]interface{}{"reason": reason},
+		func() (*saga.Saga, error) {
+			return s.orchestrator.Resolve(ctx, sagaID, reason)
+		})
+}
+
+// sagaAction performs an admin action and audit-logs it, rejected attempts included
+func (s *OrderService) sagaAction(ctx context.Context, sagaID, action, actorID string, changes map[string]interface{}, fn func() (*saga.Saga, error)) (*saga.Saga, error) {
+	if before, err := s.orchestrator.InspectSaga(ctx, sagaID); err == nil {
+		changes["status_from"] = string(before.Status)
+	}
+
+	result, err := fn()
+	if err != nil {
+		changes["error"] = err.Error()
+	} else {
+		changes["status_to"] = string(result.Status)
+	}
+
+	if logErr := s.auditRepo.Log(ctx, repository.AuditLog{
+		EntityType: auditEntitySaga,
+		EntityID:   sagaID,
+		Action:     action,
+		ActorID:    actorID,
+		Changes:    changes,
+	}); logErr != nil {
+		logger.Error("Failed to audit-log saga action", "saga_id", sagaID, "action", action, "actor_id", actorID, "error", logErr)
+	}
+
+	return result, err
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+)
+
+const auditEntityShift = "agent_shift"
+
+// Shift actions, as recorded in the audit log
+const (
+	ShiftActionOpen  = "shift_open"
+	ShiftActionClose = "shift_close"
+)
+
+type CloseShiftRequest struct {
+	OrgID            string
+	AgentID          string
+	CountedCashPaisa int64
+	Note             string
+}
+
+// OpenShift opens a till session for a counter agent with the cash already in the drawer.
+// An agent sells for cash only while a shift is open, and has one open shift at a time.
+func (s *OrderService) OpenShift(ctx context.Context, orgID, agentID string, openingFloatPaisa int64) (*domain.AgentShift, error) {
+	if openingFloatPaisa < 0 {
+		return nil, domain.ErrInvalidCashAmount
+	}
+
+	shift := &domain.AgentShift{
+		OrganizationID:    orgID,
+		AgentID:           agentID,
+		OpeningFloatPaisa: openingFloatPaisa,
+		Currency:          DefaultCurrency,
+	}
+	if err := s.shiftRepo.Open(ctx, shift); err != nil {
+		return nil, err
+	}
+
+	s.auditShift(ctx, shift.ID, ShiftActionOpen, agentID, map[string]interface{}{
+		"opening_float_paisa": openingFloatPaisa,
+	})
+	return shift, nil
+}
+
+// GetCurrentShift reports on the agent's open shift so far
+func (s *OrderService) GetCurrentShift(ctx context.Context, orgID, agentID string) (*domain.ShiftReport, error) {
+	shift, err := s.shiftRepo.GetOpen(ctx, orgID, agentID)
+	if err != nil {
+		return nil, err
+	}
+	return s.shiftReport(ctx, shift)
+}
+
+// GetShiftReport reports on one of an organization's shifts, open or closed
+func (s *OrderService) GetShiftReport(ctx context.Context, orgID, shiftID string) (*domain.ShiftReport, error) {
+	shift, err := s.shiftRepo.Get(ctx, orgID, shiftID)
+	if err != nil {
+		return nil, err
+	}
+	return s.shiftReport(ctx, shift)
+}
+
+// CloseShift closes the agent's open shift with the cash counted in the drawer and
+// returns the closing report, reconciling the count against the cash orders confirmed
+// in the shift. Orders still booking when the shift closes are counted in its report
+// once they are confirmed.
+func (s *OrderService) CloseShift(ctx context.Context, req *CloseShiftRequest) (*domain.ShiftReport, error) {
+	if req.CountedCashPaisa < 0 {
+		return nil, domain.ErrInvalidCashAmount
+	}
+	shift, err := s.shiftRepo.GetOpen(ctx, req.OrgID, req.AgentID)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.shiftRepo.SumOrders(ctx, shift.ID)
+	if err != nil {
+		return nil, err
+	}
+	report := shift.Close(orders, req.CountedCashPaisa, req.Note)
+
+	ok, err := s.shiftRepo.Close(ctx, shift)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.ErrNoOpenShift
+	}
+
+	s.auditShift(ctx, shift.ID, ShiftActionClose, req.AgentID, map[string]interface{}{
+		"counted_cash_paisa":  shift.CountedCashPaisa,
+		"expected_cash_paisa": shift.ExpectedCashPaisa,
+		"variance_paisa":      shift.VariancePaisa,
+		"note":                req.Note,
+	})
+	return report, nil
+}
+
+func (s *OrderService) shiftReport(ctx context.Context, shift *domain.AgentShift) (*domain.ShiftReport, error) {
+	orders, err := s.shiftRepo.SumOrders(ctx, shift.ID)
+	if err != nil {
+		return nil, err
+	}
+	return shift.Reconcile(orders), nil
+}
+
+// recordCashSale adds a confirmed cash order to the running total of its shift
+func (s *OrderService) recordCashSale(ctx context.Context, tx *sql.Tx, order *domain.Order) error {
+	if order.PaymentMethod != domain.PaymentMethodCash || order.ShiftID == "" {
+		return nil
+	}
+	return repository.NewTxOrderRepository(tx).AddShiftCashSaleTx(ctx, order.ShiftID, order.TotalPaisa)
+}
+
+// recordCashRefund takes a cash refund off the open shift of the agent who sold the
+// order, as it is paid out of their drawer. A refund made while the agent has no open
+// shift is left to be settled by hand.
+func (s *OrderService) recordCashRefund(ctx context.Context, tx *sql.Tx, order *domain.Order, amountPaisa int64) error {
+	if order.PaymentMethod != domain.PaymentMethodCash || order.AgentID == "" || amountPaisa <= 0 {
+		return nil
+	}
+	ok, err := repository.NewTxOrderRepository(tx).AddShiftCashRefundTx(ctx, order.OrganizationID, order.AgentID, amountPaisa)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		logger.Warn("Cash refund made outside a shift", "order_id", order.ID, "agent_id", order.AgentID, "amount_paisa", amountPaisa)
+	}
+	return nil
+}
+
+func (s *OrderService) auditShift(ctx context.Context, shiftID, action, actorID string, changes map[string]interface{}) {
+	if err := s.auditRepo.Log(ctx, repository.AuditLog{
+		EntityType: auditEntityShift,
+		EntityID:   shiftID,
+		Action:     action,
+		ActorID:    actorID,
+		Changes:    changes,
+	}); err != nil {
+		logger.Error("Failed to audit-log shift action", "shift_id", shiftID, "action", action, "actor_id", actorID, "error", err)
+	}
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/domain"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/repository"
+	"github.com/MuhibNayem/Travio/server/services/order/internal/saga"
+)
+
+var (
+	ErrOrderNotTransferable    = errors.New("order cannot be transferred in its current status")
+	ErrTransferQuoteChanged    = errors.New("transfer costs more than the quote the user accepted")
+	ErrTransferPaymentRequired = errors.New("the transfer has a fee; a payment token is required")
+)
+
+type TransferPassengerRequest struct {
+	OrderID        string
+	UserID         string
+	PassengerIndex int
+	NewNID         string
+	NewName        string
+	NewDateOfBirth string
+	PaymentToken   string
+	// Fee the user accepted; a higher fee rejects the transfer
+	ExpectedFee *int64
+}
+
+// TransferPassenger hands one passenger's ticket on a confirmed order to another
+// traveller, such as a family member travelling instead. The new traveller's NID is
+// verified with the NID service and takes over the passenger's one-ticket-per-trip
+// allowance; the operator's transfer fee is collected. The passenger keeps the seat, the
+// old tickets are cancelled and new ones issued, and the transfer is kept in the history.
+func (s *OrderService) TransferPassenger(ctx context.Context, req *TransferPassengerRequest) (*domain.Order, *domain.PassengerTransfer, error) {
+	req.NewNID = strings.TrimSpace(req.NewNID)
+	req.NewName = strings.TrimSpace(req.NewName)
+	if req.NewName == "" {
+		return nil, nil, fmt.Errorf("%w: the new passenger needs a name", domain.ErrInvalidTransfer)
+	}
+	validator := domain.NIDValidator{}
+	if err := validator.Validate(req.NewNID); err != nil {
+		return nil, nil, err
+	}
+
+	order, quote, err := s.quoteTransfer(ctx, req.OrderID, req.UserID, req.PassengerIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := order.TransferablePassenger(req.PassengerIndex, req.NewNID); err != nil {
+		return nil, nil, err
+	}
+	if req.ExpectedFee != nil && quote.FeePaisa > *req.ExpectedFee {
+		return nil, nil, ErrTransferQuoteChanged
+	}
+	if quote.FeePaisa > 0 && req.PaymentToken == "" {
+		return nil, nil, ErrTransferPaymentRequired
+	}
+
+	oldNID := order.Passengers[req.PassengerIndex].NID
+	counted, err := s.moveTicketLimit(ctx, order.TripID, oldNID, req.NewNID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transferSaga := saga.NewTransferSaga(s.orchestrator, s.sagaDeps, &saga.TransferRequest{
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		OrgID:          order.OrganizationID,
+		BookingID:      order.BookingID,
+		PassengerIndex: req.PassengerIndex,
+		NewNID:         req.NewNID,
+		NewName:        req.NewName,
+		NewDateOfBirth: req.NewDateOfBirth,
+		PaymentToken:   req.PaymentToken,
+		FeePaisa:       quote.FeePaisa,
+		Email:          order.ContactEmail,
+		Phone:          order.ContactPhone,
+	})
+	transferSaga.Context.Set("old_nid", oldNID)
+	transferSaga.Context.Set("trip_id", order.TripID)
+	transferSaga.Context.Set("nid_counted", counted)
+
+	if err := s.orchestrator.Execute(ctx, transferSaga); err != nil {
+		s.restoreTicketLimit(ctx, transferSaga)
+		if saga.TransferNIDRejected(transferSaga.Context) {
+			return nil, nil, domain.ErrTransferNIDNotVerified
+		}
+		return nil, nil, fmt.Errorf("transfer failed: %w", err)
+	}
+
+	transfer, err := s.completeTransfer(ctx, order, transferSaga)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, transfer, nil
+}
+
+// QuoteTransfer tells the user what transferring a passenger's ticket would cost now
+func (s *OrderService) QuoteTransfer(ctx context.Context, orderID, userID string, passengerIndex int) (*domain.Order, *domain.TransferQuote, error) {
+	return s.quoteTransfer(ctx, orderID, userID, passengerIndex)
+}
+
+// ListTransfers returns the ticket transfer history, newest first, with the total count
+func (s *OrderService) ListTransfers(ctx context.Context, filter domain.TransferFilter) ([]*domain.PassengerTransfer, int, error) {
+	if filter.Limit <= 0 || filter.Limit > 100 {
+		filter.Limit = 50
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return s.transferRepo.List(ctx, filter)
+}
+
+// quoteTransfer loads a confirmed single-trip order and quotes transferring one of its
+// active passengers under the policy covering its trip
+func (s *OrderService) quoteTransfer(ctx context.Context, orderID, userID string, passengerIndex int) (*domain.Order, *domain.TransferQuote, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if order.Status != domain.OrderStatusConfirmed {
+		return nil, nil, fmt.Errorf("%w: %s", ErrOrderNotTransferable, order.Status)
+	}
+	if order.IsMultiLeg() {
+		return nil, nil, fmt.Errorf("%w: passengers of a multi-leg order travel on every leg", ErrOrderNotTransferable)
+	}
+	if passengerIndex < 0 || passengerIndex >= len(order.Passengers) || order.Passengers[passengerIndex].Cancelled {
+		return nil, nil, domain.ErrInvalidPassengers
+	}
+	if s.catalogClient == nil {
+		return nil, nil, fmt.Errorf("catalog unavailable")
+	}
+
+	trip, err := s.catalogClient.GetTrip(ctx, order.OrganizationID, order.TripID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch trip: %w", err)
+	}
+	routeID := order.RouteID
+	if routeID == "" {
+		routeID = trip.RouteId
+	}
+	policy, err := s.refundPolicyFor(ctx, order.OrganizationID, routeID, trip.VehicleClass)
+	if err != nil {
+		return nil, nil, err
+	}
+	quote, err := policy.QuoteTransfer(time.Unix(trip.DepartureTime, 0), time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, quote, nil
+}
+
+// moveTicketLimit moves the passenger's ticket on the trip to the new NID's anti-scalp
+// counter. A new NID already holding a ticket on the trip is refused. The counters are
+// best effort: if they cannot be updated the transfer goes ahead, and false is returned.
+func (s *OrderService) moveTicketLimit(ctx context.Context, tripID, oldNID, newNID string) (bool, error) {
+	if s.ticketLimits == nil {
+		return false, nil
+	}
+	err := s.ticketLimits.MoveNIDTicket(ctx, tripID, oldNID, newNID, domain.DefaultTicketLimits().MaxTicketsPerNID)
+	if errors.Is(err, repository.ErrLimitExceeded) {
+		return false, domain.ErrDuplicateNID
+	}
+	if err != nil {
+		logger.Warn("Ticket limits not moved for transfer", "trip_id", tripID, "error", err)
+		return false, nil
+	}
+	return true, nil
+}
+
+// restoreTicketLimit gives a failed transfer's ticket back to the old NID's counter
+func (s *OrderService) restoreTicketLimit(ctx context.Context, transferSaga *saga.Saga) {
+	if counted, _ := transferSaga.Context.Get("nid_counted"); counted != true || s.ticketLimits == nil {
+		return
+	}
+	var req saga.TransferRequest
+	if !transferSaga.Context.Decode("transfer_request", &req) {
+		return
+	}
+	tripID := transferSaga.Context.GetString("trip_id")
+	if err := s.ticketLimits.MoveNIDTicket(ctx, tripID, req.NewNID, transferSaga.Context.GetString("old_nid"), math.MaxInt32); err != nil {
+		logger.Warn("Failed to restore ticket limits of failed transfer", "order_id", req.OrderID, "trip_id", tripID, "error", err)
+	}
+}
+
+// onTransferSagaFinished records a recovered or retried transfer once it has moved the booking
+func (s *OrderService) onTransferSagaFinished(ctx context.Context, sagaInstance *saga.Saga, err error) {
+	if err != nil {
+		logger.Error("Recovered transfer saga did not complete", "saga_id", sagaInstance.ID,
+			"order_id", sagaInstance.Context.GetString("order_id"), "error", err)
+		s.restoreTicketLimit(ctx, sagaInstance)
+		return
+	}
+	order, err := s.orderRepo.GetByID(ctx, sagaInstance.Context.GetString("order_id"), sagaInstance.Context.GetString("user_id"))
+	if err != nil {
+		logger.Error("Failed to load order of recovered transfer saga", "saga_id", sagaInstance.ID, "error", err)
+		return
+	}
+	if order.Status != domain.OrderStatusConfirmed {
+		return
+	}
+	if _, err := s.completeTransfer(ctx, order, sagaInstance); err != nil {
+		logger.Error("Failed to complete recovered transfer", "order_id", order.ID, "error", err)
+	}
+}
+
+// completeTransfer puts the new traveller on the order, records the transfer and
+// publishes it so the tickets are reissued. A saga already recorded is not applied twice.
+func (s *OrderService) completeTransfer(ctx context.Context, order *domain.Order, transferSaga *saga.Saga) (*domain.PassengerTransfer, error) {
+	var req saga.TransferRequest
+	if !transferSaga.Context.Decode("transfer_request", &req) {
+		return nil, fmt.Errorf("transfer saga %s has no request", transferSaga.ID)
+	}
+	if req.PassengerIndex < 0 || req.PassengerIndex >= len(order.Passengers) {
+		return nil, domain.ErrInvalidPassengers
+	}
+
+	transfer := &domain.PassengerTransfer{
+		OrderID:        order.ID,
+		OrganizationID: order.OrganizationID,
+		UserID:         order.UserID,
+		TripID:         order.TripID,
+		PassengerIndex: req.PassengerIndex,
+		NewNID:         req.NewNID,
+		NewName:        req.NewName,
+		FeePaisa:       req.FeePaisa,
+		PaymentID:      transferSaga.Context.GetString("payment_id"),
+		SagaID:         transferSaga.ID,
+		TransferredAt:  time.Now(),
+	}
+	order.ApplyTransfer(transfer)
+
+	tx, err := s.orderRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	txRepo := repository.NewTxOrderRepository(tx)
+	added, err := txRepo.AddTransferTx(ctx, transfer)
+	if err != nil {
+		return nil, err
+	}
+	if !added {
+		return transfer, nil
+	}
+	if err := txRepo.UpdateTx(ctx, order); err != nil {
+		return nil, err
+	}
+	if err := s.publisher.PublishOrderPassengerTransferred(ctx, tx, order, transfer); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
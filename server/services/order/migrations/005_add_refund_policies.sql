@@ -0,0 +1,16 @@
+-- Cancellation refund policies per organization, route and vehicle class
+CREATE TABLE IF NOT EXISTS refund_policies (
+    id UUID PRIMARY KEY,
+    organization_id UUID NOT NULL,
+    route_id VARCHAR(255) NOT NULL DEFAULT '',
+    vehicle_class VARCHAR(50) NOT NULL DEFAULT '',
+    name VARCHAR(255) NOT NULL,
+    tiers JSONB NOT NULL,
+    refund_booking_fee BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (organization_id, route_id, vehicle_class)
+);
+
+-- How the refund of a cancelled order was computed
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS refund_breakdown JSONB;
@@ -0,0 +1,2 @@
+-- Passengers cancelled out of orders that stay confirmed, with their refunds
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS passenger_cancellations JSONB;
@@ -0,0 +1,2 @@
+-- Legs of round-trip and multi-leg orders, each with its own trip, hold and booking
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS legs JSONB;
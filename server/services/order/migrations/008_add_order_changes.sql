@@ -0,0 +1,6 @@
+-- Date and seat changes of confirmed orders
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS changes JSONB;
+
+-- Change fee per passenger and how long before departure changes close
+ALTER TABLE refund_policies ADD COLUMN IF NOT EXISTS change_fee_paisa BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE refund_policies ADD COLUMN IF NOT EXISTS change_cutoff_hours INT NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Lets the expiry sweeper find overdue pending orders without scanning every order
+CREATE INDEX IF NOT EXISTS idx_orders_pending_expires_at ON orders(expires_at) WHERE status = 'pending';
@@ -0,0 +1,5 @@
+-- Fraud check assessment and analyst decision of bookings held for manual review
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS fraud_review JSONB;
+
+-- Lets analysts list the orders awaiting review without scanning every order
+CREATE INDEX IF NOT EXISTS idx_orders_review_pending ON orders(organization_id, created_at) WHERE status = 'review_pending';
@@ -0,0 +1,25 @@
+-- Counter agents' till sessions, reconciled against the cash orders sold in them
+CREATE TABLE IF NOT EXISTS agent_shifts (
+    id UUID PRIMARY KEY,
+    organization_id UUID NOT NULL,
+    agent_id VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    opening_float_paisa BIGINT NOT NULL DEFAULT 0,
+    cash_sales_paisa BIGINT NOT NULL DEFAULT 0,
+    cash_refunds_paisa BIGINT NOT NULL DEFAULT 0,
+    currency VARCHAR(10) NOT NULL,
+    counted_cash_paisa BIGINT NOT NULL DEFAULT 0,
+    expected_cash_paisa BIGINT NOT NULL DEFAULT 0,
+    variance_paisa BIGINT NOT NULL DEFAULT 0,
+    note TEXT NOT NULL DEFAULT '',
+    opened_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    closed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- An agent has at most one open shift
+CREATE UNIQUE INDEX IF NOT EXISTS idx_agent_shifts_open ON agent_shifts(organization_id, agent_id) WHERE status = 'open';
+
+-- The agent and shift a counter order was sold by
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS agent_id VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS shift_id VARCHAR(255) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_orders_shift_id ON orders(shift_id) WHERE shift_id <> '';
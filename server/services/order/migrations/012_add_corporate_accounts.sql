@@ -0,0 +1,53 @@
+-- Corporate and group accounts that book on credit and pay by invoice
+CREATE TABLE IF NOT EXISTS corporate_accounts (
+    id UUID PRIMARY KEY,
+    organization_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    billing_email VARCHAR(255) NOT NULL DEFAULT '',
+    currency VARCHAR(10) NOT NULL,
+    credit_limit_paisa BIGINT NOT NULL DEFAULT 0,
+    outstanding_paisa BIGINT NOT NULL DEFAULT 0,
+    payment_terms_days INT NOT NULL DEFAULT 30,
+    booker_ids JSONB NOT NULL DEFAULT '[]',
+    travellers JSONB NOT NULL DEFAULT '[]',
+    max_group_size INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_corporate_accounts_org ON corporate_accounts(organization_id);
+
+-- Monthly invoices; an account is invoiced once per period
+CREATE TABLE IF NOT EXISTS account_invoices (
+    id UUID PRIMARY KEY,
+    account_id UUID NOT NULL,
+    organization_id UUID NOT NULL,
+    period_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    period_end TIMESTAMP WITH TIME ZONE NOT NULL,
+    total_paisa BIGINT NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    issued_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    paid_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (account_id, period_end)
+);
+
+-- Orders charged to accounts and refunds given back, until they are invoiced.
+-- The reference makes charging an order or recording a refund twice count once.
+CREATE TABLE IF NOT EXISTS account_charges (
+    id UUID PRIMARY KEY,
+    account_id UUID NOT NULL,
+    order_id UUID NOT NULL,
+    reference VARCHAR(255) NOT NULL UNIQUE,
+    amount_paisa BIGINT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    invoice_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_account_charges_uninvoiced ON account_charges(account_id, created_at) WHERE invoice_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_account_charges_invoice ON account_charges(invoice_id);
+
+-- The account an order was charged to
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS account_id VARCHAR(255) NOT NULL DEFAULT '';
@@ -0,0 +1,26 @@
+-- Transfer fee per passenger and how long before departure transfers close
+ALTER TABLE refund_policies ADD COLUMN IF NOT EXISTS transfer_fee_paisa BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE refund_policies ADD COLUMN IF NOT EXISTS transfer_cutoff_hours INT NOT NULL DEFAULT 0;
+
+-- Tickets handed from one passenger to another. Kept so staff can spot NIDs that
+-- book seats only to pass them on; a saga is applied once.
+CREATE TABLE IF NOT EXISTS passenger_transfers (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL,
+    organization_id UUID NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    trip_id VARCHAR(255) NOT NULL,
+    passenger_index INT NOT NULL,
+    old_nid VARCHAR(50) NOT NULL,
+    old_name VARCHAR(255) NOT NULL,
+    new_nid VARCHAR(50) NOT NULL,
+    new_name VARCHAR(255) NOT NULL,
+    fee_paisa BIGINT NOT NULL DEFAULT 0,
+    payment_id VARCHAR(255) NOT NULL DEFAULT '',
+    saga_id VARCHAR(255) NOT NULL UNIQUE,
+    transferred_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_passenger_transfers_order ON passenger_transfers(order_id);
+CREATE INDEX IF NOT EXISTS idx_passenger_transfers_user ON passenger_transfers(user_id, transferred_at);
+CREATE INDEX IF NOT EXISTS idx_passenger_transfers_old_nid ON passenger_transfers(old_nid, transferred_at);
+CREATE INDEX IF NOT EXISTS idx_passenger_transfers_new_nid ON passenger_transfers(new_nid, transferred_at);
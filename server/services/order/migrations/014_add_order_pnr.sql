@@ -0,0 +1,4 @@
+-- Short booking reference customers quote to find their order without an account.
+-- Orders placed before PNRs existed keep an empty one.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS pnr VARCHAR(10) NOT NULL DEFAULT '';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_pnr ON orders(pnr) WHERE pnr <> '';
@@ -0,0 +1,3 @@
+-- Client IP the order was placed from, so the per-IP ticket limit is given back when the
+-- order expires, fails or is cancelled. Orders placed earlier keep an empty one.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS client_ip VARCHAR(64) NOT NULL DEFAULT '';
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	pb "github.com/MuhibNayem/Travio/server/api/proto/payment/v1"
 	"github.com/MuhibNayem/Travio/server/services/payment/internal/gateway"
@@ -97,6 +98,26 @@ func (h *GrpcHandler) RefundPayment(ctx context.Context, req *pb.RefundPaymentRe
 	}, nil
 }
 
+func (h *GrpcHandler) CancelPayment(ctx context.Context, req *pb.CancelPaymentRequest) (*pb.PaymentStatusResponse, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id is required")
+	}
+	result, err := h.paymentService.CancelPayment(ctx, req.OrderId, req.Reason)
+	if err != nil {
+		if errors.Is(err, service.ErrTransactionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.PaymentStatusResponse{
+		TransactionId: result.TransactionID,
+		GatewayRef:    result.GatewayRef,
+		Status:        string(result.Status),
+		AmountPaisa:   result.AmountPaisa,
+		Currency:      result.Currency,
+	}, nil
+}
+
 func (h *GrpcHandler) HandleIPN(ctx context.Context, req *pb.IPNRequest) (*pb.IPNResponse, error) {
 	providerName := h.registry.ResolveProvider(req.Gateway)
 	factory, err := h.registry.GetFactory(providerName)
@@ -16,7 +16,7 @@ type Transaction struct {
 	Currency       string `gorm:"size:3;not null"`
 	Gateway        string `gorm:"size:50;not null"`
 	GatewayTxID    string `gorm:"index"`                                     // External Transaction ID from Gateway
-	Status         string `gorm:"size:20;index;not null"`                    // PENDING, SUCCESS, FAILED
+	Status         string `gorm:"size:20;index;not null"`                    // PENDING, SUCCESS, FAILED, CANCELLED
 	IdempotencyKey string `gorm:"uniqueIndex:idx_idempotency_key;type:uuid"` // Deterministic Key
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
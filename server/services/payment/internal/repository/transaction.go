@@ -54,6 +54,17 @@ func (r *TransactionRepository) UpdateStatus(ctx context.Context, id, status, ga
 	return r.db.WithContext(ctx).Model(&model.Transaction{}).Where("id = ?", id).Updates(updates).Error
 }
 
+// CancelPending marks a transaction cancelled unless it stopped being pending meanwhile
+func (r *TransactionRepository) CancelPending(ctx context.Context, id string) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&model.Transaction{}).
+		Where("id = ? AND status = ?", id, "PENDING").
+		Updates(map[string]interface{}{
+			"status":     "CANCELLED",
+			"updated_at": r.db.NowFunc(),
+		})
+	return res.RowsAffected == 1, res.Error
+}
+
 func (r *TransactionRepository) FindsPending(ctx context.Context, olderThanMinutes int) ([]model.Transaction, error) {
 	var txs []model.Transaction
 	cutoff := time.Now().Add(time.Duration(-olderThanMinutes) * time.Minute)
@@ -3,16 +3,21 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"time"
 
+	"github.com/MuhibNayem/Travio/server/pkg/logger"
 	"github.com/MuhibNayem/Travio/server/services/payment/internal/gateway"
 	"github.com/MuhibNayem/Travio/server/services/payment/internal/model"
 	"github.com/MuhibNayem/Travio/server/services/payment/internal/repository"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+var ErrTransactionNotFound = errors.New("transaction not found")
+
 type PaymentService struct {
 	registry   *gateway.Registry
 	repo       *repository.TransactionRepository
@@ -92,7 +97,7 @@ func (s *PaymentService) CreatePayment(ctx context.Context, req *CreatePaymentRe
 	}
 	payConfig, err := s.configRepo.GetConfig(ctx, req.OrganizationID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get payment config for organization %s: %w", err)
+		return nil, fmt.Errorf("failed to get payment config for organization %s: %w", req.OrganizationID, err)
 	}
 
 	// Instantiate Gateway client using sandbox flag from org config
@@ -249,6 +254,90 @@ func (s *PaymentService) RefundPayment(ctx context.Context, gatewayName, transac
 	return gw.RefundPayment(ctx, transactionID, amount, reason)
 }
 
+// CancelPayment cancels the pending payment of an abandoned order so it can no longer be
+// completed. The gateway is asked first: a payment made just before the cancellation is
+// recorded and reported as captured instead. Payments that are no longer pending are
+// reported as they are.
+func (s *PaymentService) CancelPayment(ctx context.Context, orderID, reason string) (*gateway.PaymentStatus, error) {
+	// 1. Load the order's latest transaction
+	tx, err := s.repo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to load transaction: %w", err)
+	}
+
+	result := &gateway.PaymentStatus{
+		TransactionID: tx.ID,
+		GatewayRef:    tx.GatewayTxID,
+		Status:        transactionStatus(tx.Status),
+		AmountPaisa:   tx.Amount,
+		Currency:      tx.Currency,
+		ProcessedAt:   time.Now().Unix(),
+	}
+	if tx.Status != "PENDING" {
+		return result, nil
+	}
+
+	// 2. Check the gateway session; dev sessions are never paid outside the flow
+	if tx.GatewayTxID != "" && os.Getenv("APP_ENV") != "development" {
+		payConfig, err := s.configRepo.GetConfig(ctx, tx.OrganizationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get payment config: %w", err)
+		}
+		factory, err := s.registry.GetFactory(s.registry.ResolveProvider(tx.Gateway))
+		if err != nil {
+			return nil, err
+		}
+		gw, err := factory.Create(payConfig.Credentials, payConfig.IsSandbox)
+		if err != nil {
+			return nil, err
+		}
+		paid, err := gw.VerifyPayment(ctx, tx.GatewayTxID)
+		if err != nil {
+			return nil, fmt.Errorf("gateway error: %w", err)
+		}
+		if paid.Status == gateway.StatusCaptured || paid.Status == gateway.StatusAuthorized {
+			_ = s.repo.UpdateStatus(ctx, tx.ID, "SUCCESS", "")
+			result.Status = gateway.StatusCaptured
+			return result, nil
+		}
+	}
+
+	// 3. Cancel unless the reconciler or an IPN settled it meanwhile
+	cancelled, err := s.repo.CancelPending(ctx, tx.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel transaction: %w", err)
+	}
+	if !cancelled {
+		current, err := s.repo.GetByID(ctx, tx.ID)
+		if err != nil {
+			return nil, fmt.Errorf("transaction not found: %w", err)
+		}
+		result.Status = transactionStatus(current.Status)
+		return result, nil
+	}
+
+	logger.Info("Payment cancelled", "tx_id", tx.ID, "order_id", orderID, "reason", reason)
+	result.Status = gateway.StatusCancelled
+	return result, nil
+}
+
+// transactionStatus maps a stored transaction status to the gateway status reported to callers
+func transactionStatus(status string) gateway.Status {
+	switch status {
+	case "SUCCESS":
+		return gateway.StatusCaptured
+	case "FAILED":
+		return gateway.StatusFailed
+	case "CANCELLED":
+		return gateway.StatusCancelled
+	default:
+		return gateway.StatusPending
+	}
+}
+
 type CreatePaymentReq struct {
 	OrderID        string
 	OrganizationID string
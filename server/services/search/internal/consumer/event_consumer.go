@@ -15,7 +15,7 @@ type EventConsumer struct {
 }
 
 func New(brokers []string, groupID string, indexer *indexer.Indexer) (*EventConsumer, error) {
-	topics := []string{kafka.TopicCatalog, kafka.TopicEvents}
+	topics := []string{kafka.TopicCatalog, kafka.TopicEvents, kafka.TopicInventory}
 	consumer, err := kafka.NewConsumer(brokers, groupID, topics)
 	if err != nil {
 		return nil, err
@@ -32,6 +32,8 @@ func New(brokers []string, groupID string, indexer *indexer.Indexer) (*EventCons
 	consumer.RegisterHandler(kafka.EventEventCreated, c.handleEventUpsert)
 	consumer.RegisterHandler(kafka.EventEventUpdated, c.handleEventUpsert)
 	consumer.RegisterHandler(kafka.EventEventPublished, c.handleEventUpsert)
+	consumer.RegisterHandler(kafka.EventCapacityUpdated, c.handleCapacityUpdated)
+	consumer.RegisterHandler(kafka.EventAvailabilityUpdated, c.handleAvailabilityUpdated)
 
 	return c, nil
 }
@@ -60,7 +62,62 @@ func (c *EventConsumer) upsertTrip(ctx context.Context, event *kafka.Event) erro
 		return err
 	}
 
-	return c.indexer.IndexDocument(ctx, "trips", event.AggregateID, string(payloadBytes))
+	// Merge rather than replace, so capacity counts indexed from inventory survive trip updates
+	return c.indexer.UpsertDocument(ctx, "trips", event.AggregateID, string(payloadBytes))
+}
+
+// handleCapacityUpdated refreshes the deck and standing places shown on a trip
+func (c *EventConsumer) handleCapacityUpdated(ctx context.Context, event *kafka.Event) error {
+	logger.Info("Updating trip capacity", "id", event.AggregateID)
+
+	payloadBytes, err := json.Marshal(event.Payload)
+	if err != nil {
+		return err
+	}
+
+	var payload struct {
+		Capacity json.RawMessage `json:"capacity"`
+	}
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		return err
+	}
+
+	doc, err := json.Marshal(map[string]interface{}{
+		"trip_id":  event.AggregateID,
+		"capacity": payload.Capacity,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.indexer.UpsertDocument(ctx, "trips", event.AggregateID, string(doc))
+}
+
+// handleAvailabilityUpdated refreshes the free seats shown on a trip from inventory's counters
+func (c *EventConsumer) handleAvailabilityUpdated(ctx context.Context, event *kafka.Event) error {
+	logger.Info("Updating trip availability", "id", event.AggregateID)
+
+	payloadBytes, err := json.Marshal(event.Payload)
+	if err != nil {
+		return err
+	}
+
+	var payload struct {
+		AvailableSeats int `json:"available_seats"`
+	}
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		return err
+	}
+
+	doc, err := json.Marshal(map[string]interface{}{
+		"trip_id":         event.AggregateID,
+		"available_seats": payload.AvailableSeats,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.indexer.UpsertDocument(ctx, "trips", event.AggregateID, string(doc))
 }
 
 func (c *EventConsumer) handleStationCreated(ctx context.Context, event *kafka.Event) error {
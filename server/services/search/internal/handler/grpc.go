@@ -29,6 +29,15 @@ func (h *GrpcHandler) SearchTrips(ctx context.Context, req *pb.SearchTripsReques
 
 	var results []*pb.TripResult
 	for _, t := range trips {
+		var capacity []*pb.CapacityAvailability
+		for _, c := range t.Capacity {
+			capacity = append(capacity, &pb.CapacityAvailability{
+				CapacityClass: c.CapacityClass,
+				MaxCount:      int32(c.MaxCount),
+				Available:     int32(c.Available),
+				PricePaisa:    c.PricePaisa,
+			})
+		}
 		results = append(results, &pb.TripResult{
 			TripId:          t.TripID,
 			VehicleType:     t.VehicleType,
@@ -48,6 +57,7 @@ func (h *GrpcHandler) SearchTrips(ctx context.Context, req *pb.SearchTripsReques
 			Status:          t.Status,
 			RouteId:         t.RouteID,
 			OrganizationId:  t.OrganizationID,
+			Capacity:        capacity,
 		})
 	}
 
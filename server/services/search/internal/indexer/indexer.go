@@ -73,3 +73,27 @@ func (i *Indexer) IndexDocument(ctx context.Context, index, id, body string) err
 
 	return nil
 }
+
+// UpsertDocument merges fields into a document, creating it when missing.
+// Fields the body does not mention are left as they are.
+func (i *Indexer) UpsertDocument(ctx context.Context, index, id, body string) error {
+	req := opensearchapi.UpdateRequest{
+		Index:      index,
+		DocumentID: id,
+		Body:       strings.NewReader(fmt.Sprintf(`{"doc":%s,"doc_as_upsert":true}`, body)),
+		Refresh:    "true",
+	}
+
+	res, err := req.Do(ctx, i.client)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		logger.Error("Error upserting document", "index", index, "id", id, "status", res.Status())
+		return fmt.Errorf("failed to upsert document: %s", res.Status())
+	}
+
+	return nil
+}
@@ -37,26 +37,35 @@ func generateCacheKey(prefix string, params ...interface{}) string {
 }
 
 type TripDocument struct {
-	TripID          string `json:"trip_id"`
-	RouteID         string `json:"route_id"`
-	OrganizationID  string `json:"organization_id"`
-	VehicleID       string `json:"vehicle_id"`
-	VehicleType     string `json:"vehicle_type"`
-	VehicleClass    string `json:"vehicle_class"`
-	DepartureTime   int64  `json:"departure_time"`
-	ArrivalTime     int64  `json:"arrival_time"`
-	PricePaisa      int64  `json:"price_paisa"`
-	TotalSeats      int    `json:"total_seats"`
-	AvailableSeats  int    `json:"available_seats"`
-	FromStationID   string `json:"from_station_id"`
-	FromStationName string `json:"from_station_name"`
-	FromCity        string `json:"from_city"`
-	ToStationID     string `json:"to_station_id"`
-	ToStationName   string `json:"to_station_name"`
-	ToCity          string `json:"to_city"`
-	Date            string `json:"date"`
-	ServiceDate     string `json:"service_date"`
-	Status          string `json:"status"`
+	TripID          string                 `json:"trip_id"`
+	RouteID         string                 `json:"route_id"`
+	OrganizationID  string                 `json:"organization_id"`
+	VehicleID       string                 `json:"vehicle_id"`
+	VehicleType     string                 `json:"vehicle_type"`
+	VehicleClass    string                 `json:"vehicle_class"`
+	DepartureTime   int64                  `json:"departure_time"`
+	ArrivalTime     int64                  `json:"arrival_time"`
+	PricePaisa      int64                  `json:"price_paisa"`
+	TotalSeats      int                    `json:"total_seats"`
+	AvailableSeats  int                    `json:"available_seats"`
+	FromStationID   string                 `json:"from_station_id"`
+	FromStationName string                 `json:"from_station_name"`
+	FromCity        string                 `json:"from_city"`
+	ToStationID     string                 `json:"to_station_id"`
+	ToStationName   string                 `json:"to_station_name"`
+	ToCity          string                 `json:"to_city"`
+	Date            string                 `json:"date"`
+	ServiceDate     string                 `json:"service_date"`
+	Status          string                 `json:"status"`
+	Capacity        []CapacityAvailability `json:"capacity,omitempty"`
+}
+
+// CapacityAvailability counts deck or standing places still free over the whole route
+type CapacityAvailability struct {
+	CapacityClass string `json:"capacity_class"`
+	MaxCount      int    `json:"max_count"`
+	Available     int    `json:"available"`
+	PricePaisa    int64  `json:"price_paisa"`
 }
 
 func (s *Searcher) SearchTrips(ctx context.Context, query, fromID, toID, date string, limit, offset int) ([]TripDocument, int64, error) {